@@ -0,0 +1,50 @@
+// parse-opening-hours は自由記述の営業時間しかない店舗について、記述から構造化した営業時間を推測して保存します。
+// 構造化した営業時間の追加前に登録された店舗のバックフィルに使います。読み取れなかった店舗は一覧を出力し、手で登録してもらいます。
+// 自由記述の営業時間はそのまま残します。
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/hours"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+)
+
+func main() {
+	// サーバー用の config.Load は Supabase の設定やポートの確保まで行うため、DB 接続先だけを環境変数から読む
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		log.Fatal("DATABASE_URL is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("failed to connect database: %v", err)
+	}
+
+	ctx := context.Background()
+	storeRepo := repository.NewStoreRepository(db)
+	stores, err := storeRepo.FindWithUnparsedOpeningHours(ctx)
+	if err != nil {
+		log.Fatalf("failed to load stores: %v", err)
+	}
+
+	parsed := 0
+	for _, store := range stores {
+		schedule, ok := hours.Parse(*store.OpeningHours)
+		if !ok {
+			log.Printf("Could not parse opening hours of store %s (%s): %q", store.StoreID, store.Name, *store.OpeningHours)
+			continue
+		}
+		if err := storeRepo.UpdateOpeningSchedule(ctx, store.StoreID, schedule); err != nil {
+			log.Fatalf("failed to save opening hours of store %s: %v", store.StoreID, err)
+		}
+		parsed++
+	}
+	log.Printf("Parsed opening hours for %d of %d stores", parsed, len(stores))
+}
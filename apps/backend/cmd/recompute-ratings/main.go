@@ -0,0 +1,34 @@
+// recompute-ratings はレビューから全店舗の評価集計（平均・件数・星別件数・項目別平均）を再計算します。
+// 集計カラム追加前のデータのバックフィルや、集計がずれた場合の修復に使います。
+// 公開中のレビューがなく、レビュー件数も 0 の店舗は更新しないため、シードデータの average_rating は残ります。
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+)
+
+func main() {
+	// サーバー用の config.Load は Supabase の設定やポートの確保まで行うため、DB 接続先だけを環境変数から読む
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		log.Fatal("DATABASE_URL is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("failed to connect database: %v", err)
+	}
+
+	updated, err := repository.NewStoreRatingRepository(db).RecomputeAll(context.Background())
+	if err != nil {
+		log.Fatalf("failed to recompute ratings: %v", err)
+	}
+	log.Printf("Recomputed ratings for %d stores", updated)
+}
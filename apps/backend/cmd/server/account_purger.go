@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+// runAccountPurger は起動直後と interval ごとに、退会したユーザーの認証ユーザーとファイルの削除を再試行します。ctx が終了するまで戻りません
+func runAccountPurger(ctx context.Context, purger input.AccountPurgeUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purgeAccounts(ctx, purger)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeAccounts は1回分の再試行を実行し、結果をログに残します
+func purgeAccounts(ctx context.Context, purger input.AccountPurgeUseCase) {
+	result, err := purger.PurgeDeletedAccounts(ctx)
+	if err != nil {
+		log.Printf("account purge failed: %v", err)
+	}
+	if result != nil && (result.Purged > 0 || result.Failed > 0) {
+		log.Printf("account purge: purged=%d failed=%d", result.Purged, result.Failed)
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+// runAuditPartitioner は起動直後と interval ごとに、監査ログの先の月のパーティションを作成します。ctx が終了するまで戻りません
+func runAuditPartitioner(ctx context.Context, partitioner input.AuditPartitionUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := partitioner.CreateAuditLogPartitions(ctx); err != nil {
+			log.Printf("audit log partitioning failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
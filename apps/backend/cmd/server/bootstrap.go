@@ -7,26 +7,44 @@ import (
 
 	"github.com/TeamH04/team-production/apps/backend/internal/config"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/infra/imaging"
+	"github.com/TeamH04/team-production/apps/backend/internal/infra/localstorage"
+	"github.com/TeamH04/team-production/apps/backend/internal/infra/s3"
 	"github.com/TeamH04/team-production/apps/backend/internal/infra/supabase"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository"
 	"github.com/TeamH04/team-production/apps/backend/internal/router"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
 
 // buildRouterDependencies wires the production dependencies for the HTTP server.
-func buildRouterDependencies(cfg *config.Config, db *gorm.DB) *router.Dependencies {
+// imageQueue receives the files attached to reviews so that they are processed in the background.
+func buildRouterDependencies(cfg *config.Config, db *gorm.DB, imageQueue output.ImageProcessingQueue) *router.Dependencies {
 	log.Println("Setting up dependencies...")
 
 	// Repository layer
 	log.Println("  - Initializing repositories...")
 	storeRepo := repository.NewStoreRepository(db)
 	menuRepo := repository.NewMenuRepository(db)
+	storePhotoRepo := repository.NewStorePhotoRepository(db)
 	reviewRepo := repository.NewReviewRepository(db)
 	userRepo := repository.NewUserRepository(db)
 	favoriteRepo := repository.NewFavoriteRepository(db)
 	reportRepo := repository.NewReportRepository(db)
 	fileRepo := repository.NewFileRepository(db)
 	stationRepo := repository.NewStationRepository(db)
+	storeOwnerRepo := repository.NewStoreOwnerRepository(db)
+	storeTagRepo := repository.NewStoreTagRepository(db)
+	searchRepo := repository.NewSearchRepository(db)
+	storeClaimRepo := repository.NewStoreClaimRepository(db)
+	storeApprovalEventRepo := repository.NewStoreApprovalEventRepository(db)
+	storeEditRepo := repository.NewStoreEditRepository(db)
+	storeRatingRepo := repository.NewStoreRatingRepository(db)
+	userModerationRepo := repository.NewUserModerationEventRepository(db)
+	auditLogRepo := repository.NewAuditLogRepository(db)
+	followRepo := repository.NewFollowRepository(db)
+	visitRepo := repository.NewVisitRepository(db)
 	transaction := repository.NewGormTransaction(db)
 
 	// External services
@@ -35,56 +53,205 @@ func buildRouterDependencies(cfg *config.Config, db *gorm.DB) *router.Dependenci
 		cfg.SupabasePublishableKey,
 		cfg.SupabaseSecretKey,
 	)
+	storage := buildStorageProvider(cfg)
 
 	// Use cases
 	log.Println("  - Initializing use cases...")
-	storeUseCase := usecase.NewStoreUseCase(storeRepo)
-	menuUseCase := usecase.NewMenuUseCase(menuRepo, storeRepo)
-	reviewUseCase := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, transaction)
-	mediaUseCase := usecase.NewMediaUseCase(supabaseClient, fileRepo, storeRepo, cfg.SupabaseStorageBucket)
-	userUseCase := usecase.NewUserUseCase(userRepo, reviewRepo)
+	storeUseCase := usecase.NewAuditedStoreUseCase(
+		usecase.NewStoreUseCase(storeRepo, stationRepo, storeOwnerRepo, storeTagRepo, storeEditRepo, transaction),
+		storeRepo, auditLogRepo,
+	)
+	menuUseCase := usecase.NewAuditedMenuUseCase(
+		usecase.NewMenuUseCase(menuRepo, storeRepo, storeOwnerRepo, fileRepo, transaction),
+		menuRepo, auditLogRepo,
+	)
+	reviewUseCase := usecase.NewAuditedReviewUseCase(
+		usecase.NewImageProcessingReviewUseCase(
+			usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, storeRatingRepo, visitRepo, transaction),
+			imageQueue,
+		),
+		reviewRepo, auditLogRepo,
+	)
+	storePhotoUseCase := usecase.NewAuditedStorePhotoUseCase(
+		usecase.NewStorePhotoUseCase(storePhotoRepo, storeRepo, storeOwnerRepo, storeEditRepo, transaction),
+		storePhotoRepo, auditLogRepo,
+	)
+	mediaUseCase := usecase.NewAuditedMediaUseCase(
+		usecase.NewMediaUseCase(storage, fileRepo, storeRepo, storeOwnerRepo, storePhotoRepo, cfg.SupabaseStorageBucket),
+		auditLogRepo,
+	)
+	userUseCase := usecase.NewUserUseCase(userRepo, reviewRepo, fileRepo)
 	favoriteUseCase := usecase.NewFavoriteUseCase(favoriteRepo, userRepo, storeRepo)
-	reportUseCase := usecase.NewReportUseCase(reportRepo, userRepo)
+	followUseCase := usecase.NewFollowUseCase(followRepo, userRepo, reviewRepo)
+	visitUseCase := usecase.NewVisitUseCase(visitRepo, storeRepo)
+	reportUseCase := usecase.NewAuditedReportUseCase(
+		usecase.NewReportUseCase(reportRepo, userRepo, storeRepo, reviewRepo, menuRepo, storeRatingRepo, storeApprovalEventRepo, transaction),
+		reportRepo, auditLogRepo,
+	)
 	stationUseCase := usecase.NewStationUseCase(stationRepo)
-	adminUseCase := usecase.NewAdminUseCase(storeRepo)
+	tagUseCase := usecase.NewTagUseCase(storeTagRepo, storeRepo)
+	searchUseCase := usecase.NewSearchUseCase(searchRepo, storeRepo, reviewRepo)
+	adminUseCase := usecase.NewAuditedAdminUseCase(
+		usecase.NewAdminUseCase(storeRepo, reviewRepo, storeRatingRepo, transaction),
+		storeRepo, reviewRepo, auditLogRepo,
+	)
+	storeClaimUseCase := usecase.NewAuditedStoreClaimUseCase(
+		usecase.NewStoreClaimUseCase(storeClaimRepo, storeRepo, storeOwnerRepo, fileRepo, transaction),
+		storeClaimRepo, auditLogRepo,
+	)
+	storeApprovalUseCase := usecase.NewAuditedStoreApprovalUseCase(
+		usecase.NewStoreApprovalUseCase(storeRepo, storeOwnerRepo, storeApprovalEventRepo, transaction),
+		storeRepo, auditLogRepo,
+	)
+	storeEditUseCase := usecase.NewAuditedStoreEditUseCase(
+		usecase.NewStoreEditUseCase(storeEditRepo, storeRepo, transaction),
+		storeEditRepo, auditLogRepo,
+	)
+	adminUserUseCase := usecase.NewAuditedAdminUserUseCase(
+		usecase.NewAdminUserUseCase(userRepo, userModerationRepo, supabaseClient, transaction),
+		userRepo, auditLogRepo,
+	)
+	auditLogUseCase := usecase.NewAuditLogUseCase(auditLogRepo)
 	authUseCase := usecase.NewAuthUseCase(supabaseClient, userRepo)
-	ownerUseCase := usecase.NewOwnerUseCase(
+	accountUseCase := usecase.NewAccountUseCase(
 		userRepo,
-		transaction,
+		reviewRepo,
+		favoriteRepo,
+		followRepo,
+		visitRepo,
+		fileRepo,
+		reportRepo,
+		storeRatingRepo,
 		supabaseClient,
+		storage,
+		transaction,
+		cfg.SupabaseStorageBucket,
+		cfg.AccountDeletionReviewPolicy,
+	)
+	ownerUseCase := usecase.NewAuditedOwnerUseCase(
+		usecase.NewOwnerUseCase(
+			userRepo,
+			transaction,
+			supabaseClient,
+		),
+		auditLogRepo,
 	)
 
 	// Application handlers (use case adapters)
 	log.Println("  - Initializing handlers...")
-	storeHandler := handlers.NewStoreHandler(storeUseCase, supabaseClient, cfg.SupabaseStorageBucket)
-	menuHandler := handlers.NewMenuHandler(menuUseCase)
-	userHandler := handlers.NewUserHandler(userUseCase, supabaseClient, cfg.SupabaseStorageBucket)
+	storeHandler := handlers.NewStoreHandler(storeUseCase, storage, cfg.SupabaseStorageBucket)
+	menuHandler := handlers.NewMenuHandler(menuUseCase, storage, cfg.SupabaseStorageBucket)
+	photoHandler := handlers.NewStorePhotoHandler(storePhotoUseCase, storage, cfg.SupabaseStorageBucket)
+	userHandler := handlers.NewUserHandler(userUseCase, storage, cfg.SupabaseStorageBucket)
 	favoriteHandler := handlers.NewFavoriteHandler(favoriteUseCase)
 	reportHandler := handlers.NewReportHandler(reportUseCase)
 	stationHandler := handlers.NewStationHandler(stationUseCase)
+	tagHandler := handlers.NewTagHandler(tagUseCase, storage, cfg.SupabaseStorageBucket)
+	searchHandler := handlers.NewSearchHandler(searchUseCase, storage, cfg.SupabaseStorageBucket)
 	authHandler := handlers.NewAuthHandler(authUseCase, userUseCase)
 	ownerHandler := handlers.NewOwnerHandler(ownerUseCase)
 	adminHandler := handlers.NewAdminHandler(adminUseCase, reportUseCase, userUseCase)
-	// supabaseClient は TokenVerifier（JWT検証）と StorageProvider（署名付きURL生成）の両方を実装しているため、
-	// 同一インスタンスをそれぞれの依存として注入する。
-	reviewHandler := handlers.NewReviewHandler(reviewUseCase, supabaseClient, supabaseClient, cfg.SupabaseStorageBucket)
+	// JWT の検証は常に supabaseClient で行い、署名付き URL の生成は STORAGE_BACKEND で選んだストレージで行う
+	reviewHandler := handlers.NewReviewHandler(reviewUseCase, supabaseClient, storage, cfg.SupabaseStorageBucket)
 	mediaHandler := handlers.NewMediaHandler(mediaUseCase)
+	claimHandler := handlers.NewStoreClaimHandler(storeClaimUseCase, storage, cfg.SupabaseStorageBucket)
+	approvalHandler := handlers.NewStoreApprovalHandler(storeApprovalUseCase, storage, cfg.SupabaseStorageBucket)
+	editHandler := handlers.NewStoreEditHandler(storeEditUseCase)
+	adminUserHandler := handlers.NewAdminUserHandler(adminUserUseCase)
+	auditLogHandler := handlers.NewAuditLogHandler(auditLogUseCase)
+	accountHandler := handlers.NewAccountHandler(accountUseCase, storage, cfg.SupabaseStorageBucket)
+	followHandler := handlers.NewFollowHandler(followUseCase, storage, cfg.SupabaseStorageBucket)
+	visitHandler := handlers.NewVisitHandler(visitUseCase, storage, cfg.SupabaseStorageBucket)
+
+	// ローカルストレージは署名付き URL をこのサーバーで受け付ける
+	var storageHandler *handlers.StorageHandler
+	if store, ok := storage.(output.SignedObjectStore); ok {
+		storageHandler = handlers.NewStorageHandler(store)
+	}
 
 	log.Println("Dependencies setup completed!")
 
 	return &router.Dependencies{
-		UserUC:          userUseCase,
-		StoreHandler:    storeHandler,
-		MenuHandler:     menuHandler,
-		StationHandler:  stationHandler,
-		ReviewHandler:   reviewHandler,
-		UserHandler:     userHandler,
-		FavoriteHandler: favoriteHandler,
-		ReportHandler:   reportHandler,
-		AuthHandler:     authHandler,
-		OwnerHandler:    ownerHandler,
-		AdminHandler:    adminHandler,
-		TokenVerifier:   supabaseClient,
-		MediaHandler:    mediaHandler,
+		UserUC:           userUseCase,
+		StoreHandler:     storeHandler,
+		MenuHandler:      menuHandler,
+		PhotoHandler:     photoHandler,
+		StationHandler:   stationHandler,
+		TagHandler:       tagHandler,
+		SearchHandler:    searchHandler,
+		ReviewHandler:    reviewHandler,
+		UserHandler:      userHandler,
+		FavoriteHandler:  favoriteHandler,
+		ReportHandler:    reportHandler,
+		AuthHandler:      authHandler,
+		OwnerHandler:     ownerHandler,
+		AdminHandler:     adminHandler,
+		TokenVerifier:    supabaseClient,
+		MediaHandler:     mediaHandler,
+		ClaimHandler:     claimHandler,
+		ApprovalHandler:  approvalHandler,
+		EditHandler:      editHandler,
+		AdminUserHandler: adminUserHandler,
+		AuditLogHandler:  auditLogHandler,
+		AccountHandler:   accountHandler,
+		FollowHandler:    followHandler,
+		VisitHandler:     visitHandler,
+		StorageHandler:   storageHandler,
+	}
+}
+
+// buildFileSweeper wires the background job that cleans up abandoned uploads.
+func buildFileSweeper(cfg *config.Config, db *gorm.DB) input.FileSweepUseCase {
+	return usecase.NewFileSweepUseCase(
+		repository.NewFileRepository(db),
+		buildStorageProvider(cfg),
+		repository.NewGormTransaction(db),
+		cfg.SupabaseStorageBucket,
+		cfg.FileSweepMinAge,
+	)
+}
+
+// buildAccountPurger wires the background job that retries removing the auth users and uploaded files of deleted accounts.
+func buildAccountPurger(cfg *config.Config, db *gorm.DB) input.AccountPurgeUseCase {
+	return usecase.NewAccountPurgeUseCase(
+		repository.NewUserRepository(db),
+		repository.NewFileRepository(db),
+		buildStorageProvider(cfg),
+		supabase.NewClient(cfg.SupabaseURL, cfg.SupabasePublishableKey, cfg.SupabaseSecretKey),
+		repository.NewGormTransaction(db),
+		cfg.SupabaseStorageBucket,
+	)
+}
+
+// buildAuditPartitioner wires the background job that creates the monthly audit log partitions ahead of time.
+func buildAuditPartitioner(db *gorm.DB) input.AuditPartitionUseCase {
+	return usecase.NewAuditPartitionUseCase(repository.NewAuditLogRepository(db))
+}
+
+// buildImageProcessor wires the background job that strips metadata from review images and generates resized variants.
+func buildImageProcessor(cfg *config.Config, db *gorm.DB) input.ImageProcessingUseCase {
+	return usecase.NewImageProcessingUseCase(
+		repository.NewFileRepository(db),
+		buildStorageProvider(cfg),
+		imaging.NewProcessor(),
+		cfg.SupabaseStorageBucket,
+	)
+}
+
+// buildStorageProvider returns the storage selected by STORAGE_BACKEND.
+func buildStorageProvider(cfg *config.Config) output.StorageProvider {
+	switch cfg.StorageBackend {
+	case config.StorageBackendLocal:
+		return localstorage.NewStorage(cfg.LocalStorageDir, cfg.LocalStorageBaseURL, []byte(cfg.LocalStorageSigningKey))
+	case config.StorageBackendS3:
+		return s3.NewClient(s3.Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			UsePathStyle:    cfg.S3UsePathStyle,
+		})
+	default:
+		return supabase.NewClient(cfg.SupabaseURL, cfg.SupabasePublishableKey, cfg.SupabaseSecretKey)
 	}
 }
@@ -1,13 +1,21 @@
 package main
 
 import (
+	"context"
+	"reflect"
 	"testing"
+	"time"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/config"
+	"github.com/TeamH04/team-production/apps/backend/internal/infra/localstorage"
+	"github.com/TeamH04/team-production/apps/backend/internal/infra/s3"
+	"github.com/TeamH04/team-production/apps/backend/internal/infra/supabase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
 
 func TestBuildRouterDependencies(t *testing.T) {
@@ -30,7 +38,7 @@ func TestBuildRouterDependencies(t *testing.T) {
 	}
 
 	// Should not panic
-	deps := buildRouterDependencies(cfg, db)
+	deps := buildRouterDependencies(cfg, db, newImageProcessingQueue(1))
 
 	// Verify dependencies are created
 	if deps == nil {
@@ -70,3 +78,232 @@ func TestBuildRouterDependencies(t *testing.T) {
 		t.Error("UserUC is nil")
 	}
 }
+
+func TestBuildStorageProvider(t *testing.T) {
+	base := config.Config{
+		SupabaseURL:            "https://test.supabase.co",
+		SupabasePublishableKey: "test-publishable-key",
+		SupabaseSecretKey:      "test-secret-key",
+	}
+
+	local := base
+	local.StorageBackend = config.StorageBackendLocal
+	local.LocalStorageDir = t.TempDir()
+	local.LocalStorageBaseURL = "http://localhost:8080"
+	local.LocalStorageSigningKey = "local-secret"
+
+	s3Config := base
+	s3Config.StorageBackend = config.StorageBackendS3
+	s3Config.S3Endpoint = "http://localhost:9000"
+	s3Config.S3Region = config.DefaultS3Region
+	s3Config.S3AccessKeyID = "minio"
+	s3Config.S3SecretAccessKey = "minio-secret"
+	s3Config.S3UsePathStyle = true
+
+	tests := []struct {
+		name string
+		cfg  config.Config
+		want output.StorageProvider
+	}{
+		{name: "supabase by default", cfg: base, want: &supabase.Client{}},
+		{name: "local", cfg: local, want: &localstorage.Storage{}},
+		{name: "s3", cfg: s3Config, want: &s3.Client{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildStorageProvider(&tt.cfg)
+			if reflect.TypeOf(got) != reflect.TypeOf(tt.want) {
+				t.Errorf("expected %T, got %T", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBuildRouterDependencies_LocalStorageServesSignedURLs(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+
+	cfg := &config.Config{
+		SupabaseURL:            "https://test.supabase.co",
+		SupabasePublishableKey: "test-publishable-key",
+		SupabaseSecretKey:      "test-secret-key",
+		SupabaseStorageBucket:  "test-bucket",
+	}
+	if deps := buildRouterDependencies(cfg, db, newImageProcessingQueue(1)); deps.StorageHandler != nil {
+		t.Error("expected no StorageHandler for supabase storage")
+	}
+
+	cfg.StorageBackend = config.StorageBackendLocal
+	cfg.LocalStorageDir = t.TempDir()
+	cfg.LocalStorageBaseURL = "http://localhost:8080"
+	cfg.LocalStorageSigningKey = "local-secret"
+	if deps := buildRouterDependencies(cfg, db, newImageProcessingQueue(1)); deps.StorageHandler == nil {
+		t.Error("expected StorageHandler for local storage")
+	}
+}
+
+func TestBuildFileSweeper(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+
+	sweeper := buildFileSweeper(&config.Config{SupabaseStorageBucket: "test-bucket", FileSweepMinAge: time.Hour}, db)
+	if sweeper == nil {
+		t.Fatal("buildFileSweeper returned nil")
+	}
+}
+
+type countingSweeper struct {
+	calls chan struct{}
+}
+
+func (s *countingSweeper) SweepFiles(ctx context.Context) (*input.FileSweepResult, error) {
+	s.calls <- struct{}{}
+	return &input.FileSweepResult{}, nil
+}
+
+func TestRunFileSweeper_SweepsUntilCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sweeper := &countingSweeper{calls: make(chan struct{}, 10)}
+	done := make(chan struct{})
+
+	go func() {
+		runFileSweeper(ctx, sweeper, time.Millisecond)
+		close(done)
+	}()
+
+	// 起動直後と、少なくとも1回の定期実行
+	for i := 0; i < 2; i++ {
+		select {
+		case <-sweeper.calls:
+		case <-time.After(time.Second):
+			t.Fatalf("expected sweep %d to run", i+1)
+		}
+	}
+	cancel()
+	for {
+		select {
+		case <-done:
+			return
+		case <-sweeper.calls:
+		case <-time.After(time.Second):
+			t.Fatal("expected runFileSweeper to return after cancel")
+		}
+	}
+}
+
+func TestBuildAccountPurger(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+
+	purger := buildAccountPurger(&config.Config{SupabaseStorageBucket: "test-bucket"}, db)
+	if purger == nil {
+		t.Fatal("buildAccountPurger returned nil")
+	}
+}
+
+func TestBuildAuditPartitioner(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+
+	if partitioner := buildAuditPartitioner(db); partitioner == nil {
+		t.Fatal("buildAuditPartitioner returned nil")
+	}
+}
+
+func TestBuildImageProcessor(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+
+	processor := buildImageProcessor(&config.Config{SupabaseStorageBucket: "test-bucket"}, db)
+	if processor == nil {
+		t.Fatal("buildImageProcessor returned nil")
+	}
+}
+
+func TestImageProcessingQueue_DropsWhenFull(t *testing.T) {
+	queue := newImageProcessingQueue(1)
+	fileIDs := []string{"file-1"}
+	queue.Enqueue(fileIDs)
+	queue.Enqueue([]string{"file-2"})
+	fileIDs[0] = "changed"
+
+	if got := <-queue; len(got) != 1 || got[0] != "file-1" {
+		t.Errorf("expected the first request to be kept as enqueued, got %v", got)
+	}
+	select {
+	case got := <-queue:
+		t.Errorf("expected the second request to be dropped, got %v", got)
+	default:
+	}
+}
+
+type recordingImageProcessor struct {
+	files   chan []string
+	pending chan struct{}
+}
+
+func (p *recordingImageProcessor) ProcessFiles(ctx context.Context, fileIDs []string) (*input.ImageProcessingResult, error) {
+	p.files <- fileIDs
+	return &input.ImageProcessingResult{Processed: len(fileIDs)}, nil
+}
+
+func (p *recordingImageProcessor) ProcessPending(ctx context.Context) (*input.ImageProcessingResult, error) {
+	p.pending <- struct{}{}
+	return &input.ImageProcessingResult{}, nil
+}
+
+func TestRunImageProcessor_ProcessesQueuedAndPendingImages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	processor := &recordingImageProcessor{files: make(chan []string, 10), pending: make(chan struct{}, 10)}
+	queue := newImageProcessingQueue(10)
+	done := make(chan struct{})
+
+	go func() {
+		runImageProcessor(ctx, processor, queue, time.Hour)
+		close(done)
+	}()
+
+	// 起動直後の未加工の画像の処理
+	select {
+	case <-processor.pending:
+	case <-time.After(time.Second):
+		t.Fatal("expected pending images to be processed on start")
+	}
+
+	queue.Enqueue([]string{"file-1", "file-2"})
+	select {
+	case got := <-processor.files:
+		if len(got) != 2 || got[0] != "file-1" || got[1] != "file-2" {
+			t.Errorf("unexpected files: %v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected queued files to be processed")
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected runImageProcessor to return after cancel")
+	}
+}
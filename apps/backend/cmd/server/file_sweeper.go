@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+// runFileSweeper は起動直後と interval ごとにファイルを掃除します。ctx が終了するまで戻りません
+func runFileSweeper(ctx context.Context, sweeper input.FileSweepUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		sweepFiles(ctx, sweeper)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweepFiles は1回分の掃除を実行し、結果をログに残します。失敗しても次回の掃除で続きから処理する
+func sweepFiles(ctx context.Context, sweeper input.FileSweepUseCase) {
+	result, err := sweeper.SweepFiles(ctx)
+	if err != nil {
+		log.Printf("file sweep failed: %v", err)
+	}
+	if result != nil && (result.Confirmed > 0 || result.Deleted > 0 || result.Skipped > 0) {
+		log.Printf("file sweep: confirmed=%d deleted=%d skipped=%d", result.Confirmed, result.Deleted, result.Skipped)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// imageProcessingQueue は加工を依頼されたファイル ID を画像加工のワーカーに渡すキュー
+type imageProcessingQueue chan []string
+
+var _ output.ImageProcessingQueue = imageProcessingQueue(nil)
+
+func newImageProcessingQueue(size int) imageProcessingQueue {
+	return make(imageProcessingQueue, size)
+}
+
+// Enqueue はリクエストを待たせないよう、キューがいっぱいのときは依頼を捨てます。捨てた画像は定期処理で加工する
+func (q imageProcessingQueue) Enqueue(fileIDs []string) {
+	select {
+	case q <- append([]string(nil), fileIDs...):
+	default:
+		log.Printf("image processing queue is full, deferring %d files to the next scan", len(fileIDs))
+	}
+}
+
+// runImageProcessor は依頼された画像を順に加工し、起動直後と interval ごとに未加工の画像をまとめて加工します。
+// ctx が終了するまで戻りません
+func runImageProcessor(ctx context.Context, processor input.ImageProcessingUseCase, queue imageProcessingQueue, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	processPendingImages(ctx, processor)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case fileIDs := <-queue:
+			result, err := processor.ProcessFiles(ctx, fileIDs)
+			logImageProcessing(result, err)
+		case <-ticker.C:
+			processPendingImages(ctx, processor)
+		}
+	}
+}
+
+// processPendingImages は未加工の画像を1回分加工します。失敗しても次回の定期処理で続きから処理する
+func processPendingImages(ctx context.Context, processor input.ImageProcessingUseCase) {
+	result, err := processor.ProcessPending(ctx)
+	logImageProcessing(result, err)
+}
+
+func logImageProcessing(result *input.ImageProcessingResult, err error) {
+	if err != nil {
+		log.Printf("image processing failed: %v", err)
+	}
+	if result != nil && (result.Processed > 0 || result.Unsupported > 0 || result.Skipped > 0 || result.Failed > 0) {
+		log.Printf("image processing: processed=%d unsupported=%d skipped=%d failed=%d",
+			result.Processed, result.Unsupported, result.Skipped, result.Failed)
+	}
+}
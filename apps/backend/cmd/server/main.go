@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	"gorm.io/driver/postgres"
@@ -32,7 +33,22 @@ func main() {
 	}
 
 	// 依存性の構築
-	deps := buildRouterDependencies(cfg, db)
+	imageQueue := newImageProcessingQueue(config.ImageProcessingQueueSize)
+	deps := buildRouterDependencies(cfg, db, imageQueue)
+
+	// レビューの画像の加工（EXIF の除去と縮小版の生成）
+	go runImageProcessor(context.Background(), buildImageProcessor(cfg, db), imageQueue, config.ImageProcessingScanInterval)
+
+	// 未確認・未参照のファイルの定期的な掃除
+	if cfg.FileSweepInterval > 0 {
+		go runFileSweeper(context.Background(), buildFileSweeper(cfg, db), cfg.FileSweepInterval)
+	}
+
+	// 退会時に削除しきれなかった認証ユーザーとファイルの削除の再試行
+	go runAccountPurger(context.Background(), buildAccountPurger(cfg, db), config.AccountPurgeInterval)
+
+	// 監査ログの先の月のパーティションの作成
+	go runAuditPartitioner(context.Background(), buildAuditPartitioner(db), config.AuditLogPartitionInterval)
 
 	// サーバーの構築とルーティング設定
 	e := router.NewServer(deps)
@@ -8,6 +8,9 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 )
 
 const (
@@ -24,7 +27,29 @@ type Config struct {
 	SupabaseURL            string
 	SupabasePublishableKey string
 	SupabaseSecretKey      string
-	SupabaseStorageBucket  string
+	// SupabaseStorageBucket はオブジェクトを保存するバケット。local / s3 のストレージでもこのバケット名を使う
+	SupabaseStorageBucket string
+	// AccountDeletionReviewPolicy は退会したユーザーのレビューの扱い（anonymize / delete）
+	AccountDeletionReviewPolicy string
+	// FileSweepInterval は未確認・未参照のファイルを掃除する間隔。0 の場合は掃除しない
+	FileSweepInterval time.Duration
+	// FileSweepMinAge は掃除の対象にするまでの、ファイルの作成からの経過時間
+	FileSweepMinAge time.Duration
+	// StorageBackend はオブジェクトの保存先（supabase / local / s3）
+	StorageBackend string
+	// LocalStorageDir はローカルストレージがオブジェクトを保存するディレクトリ
+	LocalStorageDir string
+	// LocalStorageBaseURL はローカルストレージの署名付き URL の起点になる、このサーバーの URL
+	LocalStorageBaseURL string
+	// LocalStorageSigningKey はローカルストレージの署名付き URL の HMAC 鍵
+	LocalStorageSigningKey string
+	// S3Endpoint は S3 互換ストレージの API の URL
+	S3Endpoint        string
+	S3Region          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	// S3UsePathStyle はバケットをホスト名ではなくパスで指定するか（MinIO などで使う）
+	S3UsePathStyle bool
 }
 
 func Load() (*Config, error) {
@@ -77,9 +102,73 @@ func Load() (*Config, error) {
 		return nil, errors.New("SUPABASE_STORAGE_BUCKET is not set")
 	}
 
+	cfg.AccountDeletionReviewPolicy = strings.ToLower(strings.TrimSpace(
+		getenv("ACCOUNT_DELETION_REVIEW_POLICY", constants.ReviewDeletionPolicyAnonymize),
+	))
+	switch cfg.AccountDeletionReviewPolicy {
+	case constants.ReviewDeletionPolicyAnonymize, constants.ReviewDeletionPolicyDelete:
+	default:
+		return nil, fmt.Errorf("ACCOUNT_DELETION_REVIEW_POLICY must be %q or %q", constants.ReviewDeletionPolicyAnonymize, constants.ReviewDeletionPolicyDelete)
+	}
+
+	sweepMinutes, err := getenvNonNegativeInt("FILE_SWEEP_INTERVAL_MINUTES", int(DefaultFileSweepInterval/time.Minute))
+	if err != nil {
+		return nil, err
+	}
+	cfg.FileSweepInterval = time.Duration(sweepMinutes) * time.Minute
+
+	minAgeHours, err := getenvNonNegativeInt("FILE_SWEEP_MIN_AGE_HOURS", int(DefaultFileSweepMinAge/time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if minAgeHours == 0 {
+		return nil, errors.New("FILE_SWEEP_MIN_AGE_HOURS must be positive")
+	}
+	cfg.FileSweepMinAge = time.Duration(minAgeHours) * time.Hour
+
+	if err := loadStorageConfig(cfg); err != nil {
+		return nil, err
+	}
+
 	return cfg, nil
 }
 
+// loadStorageConfig は STORAGE_BACKEND で選んだストレージの設定を読み込みます
+func loadStorageConfig(cfg *Config) error {
+	cfg.StorageBackend = strings.ToLower(strings.TrimSpace(getenv("STORAGE_BACKEND", StorageBackendSupabase)))
+	switch cfg.StorageBackend {
+	case StorageBackendSupabase:
+		return nil
+	case StorageBackendLocal:
+		cfg.LocalStorageDir = getenv("LOCAL_STORAGE_DIR", DefaultLocalStorageDir)
+		cfg.LocalStorageBaseURL = getenv("LOCAL_STORAGE_BASE_URL", "http://localhost:"+cfg.Port)
+		cfg.LocalStorageSigningKey = os.Getenv("LOCAL_STORAGE_SIGNING_KEY")
+		if cfg.LocalStorageSigningKey == "" {
+			return errors.New("LOCAL_STORAGE_SIGNING_KEY is not set")
+		}
+		return nil
+	case StorageBackendS3:
+		cfg.S3Endpoint = os.Getenv("S3_ENDPOINT")
+		if cfg.S3Endpoint == "" {
+			return errors.New("S3_ENDPOINT is not set")
+		}
+		cfg.S3Region = getenv("S3_REGION", DefaultS3Region)
+		cfg.S3AccessKeyID = os.Getenv("S3_ACCESS_KEY_ID")
+		cfg.S3SecretAccessKey = os.Getenv("S3_SECRET_ACCESS_KEY")
+		if cfg.S3AccessKeyID == "" || cfg.S3SecretAccessKey == "" {
+			return errors.New("S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY must be set")
+		}
+		usePathStyle, err := strconv.ParseBool(getenv("S3_USE_PATH_STYLE", "false"))
+		if err != nil {
+			return errors.New("S3_USE_PATH_STYLE must be a boolean")
+		}
+		cfg.S3UsePathStyle = usePathStyle
+		return nil
+	default:
+		return fmt.Errorf("STORAGE_BACKEND must be %q, %q or %q", StorageBackendSupabase, StorageBackendLocal, StorageBackendS3)
+	}
+}
+
 func resolvePort(port string, isExplicit bool) (string, error) {
 	if port == "" {
 		port = defaultPort
@@ -134,6 +223,19 @@ func sanitizePort(port string) string {
 	return port
 }
 
+// getenvNonNegativeInt は環境変数を 0 以上の整数として読み、未設定の場合は def を返します
+func getenvNonNegativeInt(k string, def int) (int, error) {
+	v := strings.TrimSpace(os.Getenv(k))
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer", k)
+	}
+	return n, nil
+}
+
 func getenv(k, def string) string {
 	if v := os.Getenv(k); v != "" {
 		return v
@@ -142,6 +142,169 @@ func TestLoad_DefaultValues(t *testing.T) {
 	if len(cfg.AllowOrigins) != 1 || cfg.AllowOrigins[0] != "*" {
 		t.Errorf("expected default AllowOrigins [*], got %v", cfg.AllowOrigins)
 	}
+
+	// Reviews of deleted accounts should be anonymized by default
+	if cfg.AccountDeletionReviewPolicy != "anonymize" {
+		t.Errorf("expected default AccountDeletionReviewPolicy anonymize, got %s", cfg.AccountDeletionReviewPolicy)
+	}
+}
+
+func TestLoad_AccountDeletionReviewPolicy(t *testing.T) {
+	clearEnvVars(t, []string{"PORT", "DATABASE_URL", "CORS_ALLOW_ORIGIN"})
+
+	setEnvVars(t, map[string]string{
+		"SUPABASE_URL":                   "https://test.supabase.co",
+		"SUPABASE_PUBLISHABLE_KEY":       "test-publishable-key",
+		"SUPABASE_SECRET_KEY":            "test-secret-key",
+		"SUPABASE_STORAGE_BUCKET":        "test-bucket",
+		"ACCOUNT_DELETION_REVIEW_POLICY": " Delete ",
+	})
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.AccountDeletionReviewPolicy != "delete" {
+		t.Errorf("expected AccountDeletionReviewPolicy delete, got %s", cfg.AccountDeletionReviewPolicy)
+	}
+
+	t.Setenv("ACCOUNT_DELETION_REVIEW_POLICY", "keep")
+	_, err = Load()
+	if err == nil {
+		t.Fatal("expected error for unknown ACCOUNT_DELETION_REVIEW_POLICY")
+	}
+	if !strings.Contains(err.Error(), "ACCOUNT_DELETION_REVIEW_POLICY") {
+		t.Errorf("error should mention ACCOUNT_DELETION_REVIEW_POLICY: %v", err)
+	}
+}
+
+func TestLoad_FileSweepSettings(t *testing.T) {
+	clearEnvVars(t, []string{"PORT", "DATABASE_URL", "CORS_ALLOW_ORIGIN", "FILE_SWEEP_INTERVAL_MINUTES", "FILE_SWEEP_MIN_AGE_HOURS"})
+
+	setEnvVars(t, map[string]string{
+		"SUPABASE_URL":             "https://test.supabase.co",
+		"SUPABASE_PUBLISHABLE_KEY": "test-publishable-key",
+		"SUPABASE_SECRET_KEY":      "test-secret-key",
+		"SUPABASE_STORAGE_BUCKET":  "test-bucket",
+	})
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.FileSweepInterval != time.Hour || cfg.FileSweepMinAge != 24*time.Hour {
+		t.Errorf("unexpected default sweep settings: interval=%v minAge=%v", cfg.FileSweepInterval, cfg.FileSweepMinAge)
+	}
+
+	t.Setenv("FILE_SWEEP_INTERVAL_MINUTES", "0")
+	t.Setenv("FILE_SWEEP_MIN_AGE_HOURS", "6")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.FileSweepInterval != 0 || cfg.FileSweepMinAge != 6*time.Hour {
+		t.Errorf("unexpected sweep settings: interval=%v minAge=%v", cfg.FileSweepInterval, cfg.FileSweepMinAge)
+	}
+
+	for key, value := range map[string]string{
+		"FILE_SWEEP_INTERVAL_MINUTES": "-1",
+		"FILE_SWEEP_MIN_AGE_HOURS":    "0",
+	} {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv("FILE_SWEEP_INTERVAL_MINUTES", "")
+			t.Setenv("FILE_SWEEP_MIN_AGE_HOURS", "")
+			t.Setenv(key, value)
+
+			_, err := Load()
+			if err == nil || !strings.Contains(err.Error(), key) {
+				t.Errorf("expected error mentioning %s, got %v", key, err)
+			}
+		})
+	}
+}
+
+func TestLoad_StorageBackend(t *testing.T) {
+	storageEnv := []string{
+		"PORT", "DATABASE_URL", "CORS_ALLOW_ORIGIN", "STORAGE_BACKEND",
+		"LOCAL_STORAGE_DIR", "LOCAL_STORAGE_BASE_URL", "LOCAL_STORAGE_SIGNING_KEY",
+		"S3_ENDPOINT", "S3_REGION", "S3_ACCESS_KEY_ID", "S3_SECRET_ACCESS_KEY", "S3_USE_PATH_STYLE",
+	}
+	clearEnvVars(t, storageEnv)
+	setEnvVars(t, map[string]string{
+		"SUPABASE_URL":             "https://test.supabase.co",
+		"SUPABASE_PUBLISHABLE_KEY": "test-publishable-key",
+		"SUPABASE_SECRET_KEY":      "test-secret-key",
+		"SUPABASE_STORAGE_BUCKET":  "test-bucket",
+	})
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.StorageBackend != StorageBackendSupabase {
+		t.Errorf("expected default backend %q, got %q", StorageBackendSupabase, cfg.StorageBackend)
+	}
+
+	t.Run("local", func(t *testing.T) {
+		clearEnvVars(t, storageEnv)
+		t.Setenv("STORAGE_BACKEND", "local")
+
+		if _, err := Load(); err == nil || !strings.Contains(err.Error(), "LOCAL_STORAGE_SIGNING_KEY") {
+			t.Errorf("expected error mentioning LOCAL_STORAGE_SIGNING_KEY, got %v", err)
+		}
+
+		t.Setenv("LOCAL_STORAGE_SIGNING_KEY", "local-secret")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.LocalStorageDir != DefaultLocalStorageDir {
+			t.Errorf("expected dir %q, got %q", DefaultLocalStorageDir, cfg.LocalStorageDir)
+		}
+		if cfg.LocalStorageBaseURL != "http://localhost:"+cfg.Port {
+			t.Errorf("unexpected base URL %q", cfg.LocalStorageBaseURL)
+		}
+		if cfg.LocalStorageSigningKey != "local-secret" {
+			t.Errorf("unexpected signing key %q", cfg.LocalStorageSigningKey)
+		}
+	})
+
+	t.Run("s3", func(t *testing.T) {
+		clearEnvVars(t, storageEnv)
+		setEnvVars(t, map[string]string{
+			"STORAGE_BACKEND":   "S3",
+			"S3_ENDPOINT":       "http://localhost:9000",
+			"S3_USE_PATH_STYLE": "true",
+		})
+
+		if _, err := Load(); err == nil || !strings.Contains(err.Error(), "S3_ACCESS_KEY_ID") {
+			t.Errorf("expected error mentioning S3_ACCESS_KEY_ID, got %v", err)
+		}
+
+		t.Setenv("S3_ACCESS_KEY_ID", "minio")
+		t.Setenv("S3_SECRET_ACCESS_KEY", "minio-secret")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.StorageBackend != StorageBackendS3 || cfg.S3Region != DefaultS3Region || !cfg.S3UsePathStyle {
+			t.Errorf("unexpected s3 settings: backend=%q region=%q pathStyle=%v", cfg.StorageBackend, cfg.S3Region, cfg.S3UsePathStyle)
+		}
+
+		t.Setenv("S3_USE_PATH_STYLE", "maybe")
+		if _, err := Load(); err == nil || !strings.Contains(err.Error(), "S3_USE_PATH_STYLE") {
+			t.Errorf("expected error mentioning S3_USE_PATH_STYLE, got %v", err)
+		}
+	})
+
+	t.Run("unknown backend", func(t *testing.T) {
+		clearEnvVars(t, storageEnv)
+		t.Setenv("STORAGE_BACKEND", "gcs")
+
+		if _, err := Load(); err == nil || !strings.Contains(err.Error(), "STORAGE_BACKEND") {
+			t.Errorf("expected error mentioning STORAGE_BACKEND, got %v", err)
+		}
+	})
 }
 
 func TestLoad_MissingSupabaseURL(t *testing.T) {
@@ -13,3 +13,34 @@ const JWKSCacheTTL = 10 * time.Minute
 
 // DBConnMaxLifetime is the maximum lifetime of a database connection
 const DBConnMaxLifetime = 30 * time.Minute
+
+// DefaultFileSweepInterval is how often unconfirmed and unreferenced files are swept
+const DefaultFileSweepInterval = time.Hour
+
+// DefaultFileSweepMinAge is how old a file must be before it is swept
+const DefaultFileSweepMinAge = 24 * time.Hour
+
+// AccountPurgeInterval is how often removing the auth users and uploaded files of deleted accounts is retried
+const AccountPurgeInterval = 10 * time.Minute
+
+// AuditLogPartitionInterval is how often the monthly partitions of the audit log are created ahead
+const AuditLogPartitionInterval = 24 * time.Hour
+
+// ImageProcessingScanInterval is how often review images that were not processed on upload are picked up
+const ImageProcessingScanInterval = 10 * time.Minute
+
+// ImageProcessingQueueSize is how many image processing requests can wait for the worker
+const ImageProcessingQueueSize = 100
+
+// Storage backends selected by STORAGE_BACKEND
+const (
+	StorageBackendSupabase = "supabase"
+	StorageBackendLocal    = "local"
+	StorageBackendS3       = "s3"
+)
+
+// DefaultLocalStorageDir is where the local storage keeps objects
+const DefaultLocalStorageDir = "./tmp/storage"
+
+// DefaultS3Region is the region used to sign S3 requests
+const DefaultS3Region = "us-east-1"
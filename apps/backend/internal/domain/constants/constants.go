@@ -7,12 +7,62 @@ const (
 	ReportStatusRejected = "rejected"
 )
 
+// Store claim statuses
+const (
+	ClaimStatusPending  = "pending"
+	ClaimStatusApproved = "approved"
+	ClaimStatusDenied   = "denied"
+)
+
+// Store approval statuses. 却下された店舗は修正して再申請すると resubmitted になる
+const (
+	StoreApprovalDraft       = "draft"
+	StoreApprovalSubmitted   = "submitted"
+	StoreApprovalApproved    = "approved"
+	StoreApprovalRejected    = "rejected"
+	StoreApprovalResubmitted = "resubmitted"
+)
+
+// Store edit statuses
+const (
+	StoreEditStatusPending  = "pending"
+	StoreEditStatusApproved = "approved"
+	StoreEditStatusRejected = "rejected"
+)
+
 // Sort options for reviews
 const (
 	SortByNew   = "new"
 	SortByLiked = "liked"
 )
 
+// Sort options for store listings
+const (
+	StoreSortByNew    = "new"
+	StoreSortByRating = "rating"
+	StoreSortByOpened = "opened"
+)
+
+// Store listing pagination
+const (
+	DefaultStoreListLimit = 20
+	MaxStoreListLimit     = 100
+)
+
+// Nearby store search
+const (
+	DefaultNearbyRadiusMeters = 1000
+	MaxNearbyRadiusMeters     = 5000
+	// WalkingMetersPerMinute は不動産表示の公正競争規約に基づく徒歩1分あたりの距離
+	WalkingMetersPerMinute = 80
+)
+
+// Nearest station lookup
+const (
+	DefaultNearestStationLimit = 5
+	MaxNearestStationLimit     = 20
+)
+
 // Auth providers
 const (
 	ProviderEmail  = "email"
@@ -25,11 +75,243 @@ const (
 const (
 	TargetTypeReview = "review"
 	TargetTypeStore  = "store"
+	TargetTypeUser   = "user"
+	TargetTypeMenu   = "menu"
+)
+
+// Report enforcement actions
+const (
+	// ReportEnforcementDeleteReview は通報されたレビューを削除する
+	ReportEnforcementDeleteReview = "delete_review"
+	// ReportEnforcementHideReview は通報されたレビューを非表示にする
+	ReportEnforcementHideReview = "hide_review"
+	// ReportEnforcementUnapproveStore は通報された店舗の承認を取り消して承認待ちに戻す
+	ReportEnforcementUnapproveStore = "unapprove_store"
+	// ReportEnforcementHideStore は通報された店舗を非表示にする
+	ReportEnforcementHideStore = "hide_store"
+	// ReportEnforcementDeleteMenu は通報されたメニューを削除する
+	ReportEnforcementDeleteMenu = "delete_menu"
+)
+
+// Content visibility
+const (
+	// VisibilityPublished は誰でも閲覧できる状態
+	VisibilityPublished = "published"
+	// VisibilityPending は承認待ちで、投稿者・作成者と admin だけが閲覧できる状態
+	VisibilityPending = "pending"
+	// VisibilityHidden は admin が非表示にした状態で、投稿者・作成者と admin だけが閲覧できる
+	VisibilityHidden = "hidden"
+)
+
+// Report list limits
+const (
+	DefaultReportListLimit = 20
+	MaxReportListLimit     = 100
+)
+
+// User moderation actions. 期限なしの利用停止は ban として記録する
+const (
+	ModerationActionSuspend    = "suspend"
+	ModerationActionBan        = "ban"
+	ModerationActionUnsuspend  = "unsuspend"
+	ModerationActionChangeRole = "change_role"
+)
+
+// Admin user list limits
+const (
+	DefaultUserListLimit = 20
+	MaxUserListLimit     = 100
+)
+
+// Follow list and feed limits
+const (
+	DefaultFollowListLimit = 20
+	MaxFollowListLimit     = 100
+	DefaultFeedLimit       = 20
+	MaxFeedLimit           = 50
+)
+
+// Visit history limits
+const (
+	DefaultVisitListLimit = 20
+	MaxVisitListLimit     = 100
+	// MaxVisitNoteLength は訪問メモの最大文字数（rune 数）
+	MaxVisitNoteLength = 500
+)
+
+// Service time zone
+const (
+	// ServiceTimeZone は日付や営業時間を扱うときのタイムゾーン。日本は夏時間がないため固定のオフセットで扱う
+	ServiceTimeZone = "Asia/Tokyo"
+	// ServiceUTCOffsetSeconds は ServiceTimeZone の UTC からのオフセット（秒）
+	ServiceUTCOffsetSeconds = 9 * 60 * 60
+)
+
+// Opening hours limits
+const (
+	// MaxOpeningIntervalsPerDay は1日あたりの営業時間帯の最大数
+	MaxOpeningIntervalsPerDay = 6
+	// MaxSpecialHours は特定日の営業時間の最大件数
+	MaxSpecialHours = 60
+	// MaxStoreClosures は休業期間の最大件数
+	MaxStoreClosures = 30
+	// MaxStoreClosureDays は1つの休業期間の最大日数
+	MaxStoreClosureDays = 366
+	// OpeningStatusLookaheadDays は次に開く日時を探す日数
+	OpeningStatusLookaheadDays = 14
+)
+
+// PublicProfileTopCategoryLimit は公開プロフィールに表示する、よくレビューするカテゴリの件数
+const PublicProfileTopCategoryLimit = 3
+
+// Audit log actions. "<対象>.<操作>" の形式で記録する
+const (
+	AuditActionStoreCreate         = "store.create"
+	AuditActionStoreUpdate         = "store.update"
+	AuditActionStoreDelete         = "store.delete"
+	AuditActionStoreSubmit         = "store.submit"
+	AuditActionStoreApprove        = "store.approve"
+	AuditActionStoreReject         = "store.reject"
+	AuditActionStoreVisibility     = "store.visibility"
+	AuditActionReviewVisibility    = "review.visibility"
+	AuditActionReviewDelete        = "review.delete"
+	AuditActionMenuCreate          = "menu.create"
+	AuditActionMenuUpdate          = "menu.update"
+	AuditActionMenuDelete          = "menu.delete"
+	AuditActionMenuReorder         = "menu.reorder"
+	AuditActionStorePhotoAdd       = "store_photo.add"
+	AuditActionStorePhotoDelete    = "store_photo.delete"
+	AuditActionReportResolve       = "report.resolve"
+	AuditActionReportReject        = "report.reject"
+	AuditActionClaimApprove        = "store_claim.approve"
+	AuditActionClaimDeny           = "store_claim.deny"
+	AuditActionStoreEditApprove    = "store_edit.approve"
+	AuditActionStoreEditReject     = "store_edit.reject"
+	AuditActionUserSuspend         = "user.suspend"
+	AuditActionUserUnsuspend       = "user.unsuspend"
+	AuditActionUserChangeRole      = "user.change_role"
+	AuditActionOwnerSignupComplete = "owner.signup_complete"
+)
+
+// Audit log target types
+const (
+	AuditTargetStore      = "store"
+	AuditTargetReview     = "review"
+	AuditTargetReport     = "report"
+	AuditTargetClaim      = "store_claim"
+	AuditTargetStoreEdit  = "store_edit"
+	AuditTargetUser       = "user"
+	AuditTargetMenu       = "menu"
+	AuditTargetStorePhoto = "store_photo"
+)
+
+// Audit log list limits
+const (
+	DefaultAuditLogListLimit = 50
+	MaxAuditLogListLimit     = 200
+)
+
+// File kinds
+const (
+	// FileKindClaimEvidence は店舗オーナー申請の証拠書類。店舗画像としては公開しない
+	FileKindClaimEvidence = "claim_evidence"
+	// FileKindStoreImage は店舗のギャラリーの写真。カバー画像もギャラリーの写真から選ぶ
+	FileKindStoreImage = "store_image"
+)
+
+// File sweeping
+const (
+	// FileSweepBatchSize は未確認・未参照のファイルの掃除で1回に処理する件数
+	FileSweepBatchSize = 100
+	// MaxFileSweepBatches は1回の掃除で処理するバッチ数の上限。残りは次回に回す
+	MaxFileSweepBatches = 10
+)
+
+// AccountPurgeBatchSize は退会したユーザーの認証ユーザーとファイルの削除を1回に再試行する件数
+const AccountPurgeBatchSize = 100
+
+// AuditLogPartitionMonthsAhead は監査ログのパーティションを今月から何か月先まで作成しておくか
+const AuditLogPartitionMonthsAhead = 3
+
+// Image processing
+const (
+	// ImageFormatJPEG と ImageFormatWebP は画像の派生ファイルの形式
+	ImageFormatJPEG = "jpeg"
+	ImageFormatWebP = "webp"
+	// ImageJPEGQuality は派生ファイルを JPEG で書き出すときの品質
+	ImageJPEGQuality = 82
+	// ImageOriginalJPEGQuality は EXIF を除いた元の大きさの JPEG を書き出すときの品質
+	ImageOriginalJPEGQuality = 90
+	// MaxImageProcessingBytes は加工する画像のファイルサイズの上限。超える画像は加工しない
+	MaxImageProcessingBytes = 25 << 20
+	// MaxImageProcessingPixels は加工する画像の画素数の上限（展開後のメモリを抑えるため）
+	MaxImageProcessingPixels = 50_000_000
+	// ImageProcessingBatchSize は未加工の画像の定期処理で1回に処理する件数
+	ImageProcessingBatchSize = 20
+	// MaxImageProcessingAttempts は画像の加工を試みる回数の上限。失敗が続いたファイルは定期処理で選ばない
+	MaxImageProcessingAttempts = 3
+	// ImageVariantSmallWidth・ImageVariantMediumWidth・ImageVariantLargeWidth は画像の派生ファイルの幅（px）。
+	// 元の画像より大きくは拡大しない
+	ImageVariantSmallWidth  = 200
+	ImageVariantMediumWidth = 600
+	ImageVariantLargeWidth  = 1200
+)
+
+// Store photos
+const (
+	MaxStorePhotos = 30
+	// MaxStorePhotoCaptionLength はキャプションの最大文字数（rune 数）
+	MaxStorePhotoCaptionLength = 200
+	// MaxStorePhotoAltTextLength は代替テキストの最大文字数（rune 数）
+	MaxStorePhotoAltTextLength = 200
+)
+
+// Store tags
+const (
+	MaxStoreTags = 10
+	// MaxTagLength は正規化後のタグの最大文字数（rune 数）
+	MaxTagLength = 30
+)
+
+// Menu sections
+const (
+	MenuSectionDrink   = "drink"
+	MenuSectionFood    = "food"
+	MenuSectionDessert = "dessert"
+	MenuSectionOther   = "other"
+)
+
+// Menu dietary tags. contains_* はアレルギー表示対象の特定原材料を示す
+const (
+	DietaryTagVegetarian        = "vegetarian"
+	DietaryTagVegan             = "vegan"
+	DietaryTagGlutenFree        = "gluten_free"
+	DietaryTagHalal             = "halal"
+	DietaryTagContainsEgg       = "contains_egg"
+	DietaryTagContainsMilk      = "contains_milk"
+	DietaryTagContainsWheat     = "contains_wheat"
+	DietaryTagContainsBuckwheat = "contains_buckwheat"
+	DietaryTagContainsPeanut    = "contains_peanut"
+	DietaryTagContainsShrimp    = "contains_shrimp"
+	DietaryTagContainsCrab      = "contains_crab"
+	DietaryTagContainsWalnut    = "contains_walnut"
+)
+
+// Review policies on account deletion
+const (
+	// ReviewDeletionPolicyAnonymize は退会したユーザーのレビューを匿名化したユーザーの投稿として残す
+	ReviewDeletionPolicyAnonymize = "anonymize"
+	// ReviewDeletionPolicyDelete は退会したユーザーのレビューを削除する
+	ReviewDeletionPolicyDelete = "delete"
 )
 
 // Default values
 const (
 	DefaultUserName = "user"
+	// DeletedUserName は退会したユーザーの表示名
+	DeletedUserName = "退会済みユーザー"
+	// DeletedUserEmailDomain は退会したユーザーの匿名化したメールアドレスのドメイン（予約済みで配送されない）
+	DeletedUserEmailDomain = "deleted.invalid"
 )
 
 // Validation
@@ -42,3 +324,19 @@ const (
 	DBMaxOpenConns = 10
 	DBMaxIdleConns = 5
 )
+
+// Search result types
+const (
+	SearchTypeStore  = "store"
+	SearchTypeReview = "review"
+)
+
+// Search limits
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 50
+	// MaxSearchQueryLength は正規化後の検索語の最大文字数（rune 数）
+	MaxSearchQueryLength = 100
+	// SearchSnippetLength はハイライト付き抜粋の最大文字数（rune 数）
+	SearchSnippetLength = 80
+)
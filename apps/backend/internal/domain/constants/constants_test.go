@@ -81,3 +81,25 @@ func TestDatabaseSettings(t *testing.T) {
 		t.Errorf("DBMaxIdleConns = %d, want %d", DBMaxIdleConns, 5)
 	}
 }
+
+// TestMenuSections は menus_section_check 制約と値が一致していることを確認します
+func TestMenuSections(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant string
+		expected string
+	}{
+		{"MenuSectionDrink", MenuSectionDrink, "drink"},
+		{"MenuSectionFood", MenuSectionFood, "food"},
+		{"MenuSectionDessert", MenuSectionDessert, "dessert"},
+		{"MenuSectionOther", MenuSectionOther, "other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.constant != tt.expected {
+				t.Errorf("%s = %q, want %q", tt.name, tt.constant, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditLog は管理者・オーナーによる更新操作の監査ログを表すエンティティ
+type AuditLog struct {
+	AuditID    int64
+	ActorID    *string
+	ActorRole  string
+	Action     string // "store.approve" など
+	TargetType string // "store", "review", "report", "store_claim", "store_edit", "user"
+	TargetID   string
+	Before     json.RawMessage // 操作前の対象。作成時は nil
+	After      json.RawMessage // 操作後の対象。削除時は nil
+	RequestID  *string
+	CreatedAt  time.Time
+}
@@ -12,4 +12,34 @@ type File struct {
 	IsDeleted   bool
 	CreatedAt   time.Time
 	CreatedBy   *string
+	// UploadedAt は Storage にオブジェクトがあることを確認した日時。アップロード完了の通知前は nil
+	UploadedAt *time.Time
+	// ProcessedAt は画像の加工（EXIF の除去と派生ファイルの作成）を終えた日時。未加工の場合は nil
+	ProcessedAt *time.Time
+	// Variants は画像を縮小・変換した派生ファイル。画像として読めなかったファイルは空
+	Variants []FileVariant
+	// ProcessingAttempts は画像の加工に失敗した回数
+	ProcessingAttempts int
+}
+
+// FileVariant は画像を指定した幅に縮小し、別の形式で書き出した派生ファイル
+type FileVariant struct {
+	Width       int
+	Height      int
+	Format      string
+	ContentType string
+	ObjectKey   string
+	FileSize    int64
+}
+
+// ObjectKeys は元のファイルと派生ファイルのオブジェクトキーを返します
+func (f File) ObjectKeys() []string {
+	keys := make([]string, 0, len(f.Variants)+1)
+	if f.ObjectKey != "" {
+		keys = append(keys, f.ObjectKey)
+	}
+	for _, v := range f.Variants {
+		keys = append(keys, v.ObjectKey)
+	}
+	return keys
 }
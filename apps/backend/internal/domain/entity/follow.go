@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// Follow はユーザー間のフォロー関係を表すエンティティ。FollowerID のユーザーが FolloweeID のユーザーをフォローしている
+type Follow struct {
+	FollowerID string
+	FolloweeID string
+	CreatedAt  time.Time
+	// User は一覧に表示する相手のユーザー。フォロワー一覧ではフォロワー、フォロー中一覧ではフォロー先
+	User *User
+}
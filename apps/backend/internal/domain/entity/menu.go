@@ -8,5 +8,19 @@ type Menu struct {
 	Name        string
 	Price       *int
 	Description *string
+	Section     *string
+	IsAvailable bool
+	SortOrder   int
+	ImageFileID *string
+	ImageFile   *File
+	DietaryTags []string
 	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	// DeletedAt はメニューが論理削除された日時。削除済みメニューはレビューからのみ参照されます
+	DeletedAt *time.Time
+}
+
+// IsDeleted はメニューが論理削除済みかを返します
+func (m Menu) IsDeleted() bool {
+	return m.DeletedAt != nil
 }
@@ -0,0 +1,82 @@
+package entity
+
+import "time"
+
+// MinutesPerDay は1日の分数
+const MinutesPerDay = 24 * 60
+
+// OpeningInterval は営業時間帯。Open・Close はその日の 0:00 からの分数で、
+// 日付をまたいで営業する場合の Close は MinutesPerDay を超える（例: 18:00〜翌2:00 は 1080〜1560）
+type OpeningInterval struct {
+	Open  int
+	Close int
+}
+
+// Contains は minute（その日の 0:00 からの分数）が営業時間帯に含まれるかを返します
+func (i OpeningInterval) Contains(minute int) bool {
+	return i.Open <= minute && minute < i.Close
+}
+
+// SpecialHours は特定の日の営業時間。Intervals が空の場合はその日を休業とする
+type SpecialHours struct {
+	// Date は時刻を持たない日付として UTC の 0 時で表す
+	Date      time.Time
+	Intervals []OpeningInterval
+}
+
+// StoreClosure は年末年始・臨時休業などの休業期間。From・To はどちらもその日を含む日付（UTC の 0 時）
+type StoreClosure struct {
+	From time.Time
+	To   time.Time
+	Note *string
+}
+
+// Covers は date が休業期間に含まれるかを返します
+func (c StoreClosure) Covers(date time.Time) bool {
+	return !date.Before(c.From) && !date.After(c.To)
+}
+
+// OpeningSchedule は店舗の構造化された営業時間。日付・時刻はすべて constants.ServiceTimeZone で扱う
+type OpeningSchedule struct {
+	// Weekly は time.Weekday ごとの営業時間帯。空の曜日は定休日
+	Weekly [7][]OpeningInterval
+	// Special は特定の日の営業時間。Weekly と休業期間より優先される
+	Special []SpecialHours
+	// Closures は休業期間。期間中の日は Weekly の営業時間があっても休業とする
+	Closures []StoreClosure
+}
+
+// IsEmpty は営業時間が1つも登録されていないかを返します
+func (s OpeningSchedule) IsEmpty() bool {
+	for _, intervals := range s.Weekly {
+		if len(intervals) > 0 {
+			return false
+		}
+	}
+	return len(s.Special) == 0 && len(s.Closures) == 0
+}
+
+// IntervalsOn は date（UTC の 0 時の日付）に始まる営業時間帯を返します。
+// 特定日の営業時間、休業期間、曜日ごとの営業時間の順に優先されます
+func (s OpeningSchedule) IntervalsOn(date time.Time) []OpeningInterval {
+	for _, special := range s.Special {
+		if special.Date.Equal(date) {
+			return special.Intervals
+		}
+	}
+	for _, closure := range s.Closures {
+		if closure.Covers(date) {
+			return nil
+		}
+	}
+	return s.Weekly[date.Weekday()]
+}
+
+// OpeningStatus はある時点での営業状況
+type OpeningStatus struct {
+	OpenNow bool
+	// ClosesAt は営業中の場合に、続けて営業する時間帯を含めて閉店する日時
+	ClosesAt *time.Time
+	// NextOpenAt は営業時間外の場合に、次に開店する日時。見つからない場合は nil
+	NextOpenAt *time.Time
+}
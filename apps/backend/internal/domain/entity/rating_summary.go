@@ -0,0 +1,22 @@
+package entity
+
+// RatingHistogram は星1〜5それぞれのレビュー件数です（Histogram[0] が星1）
+type RatingHistogram [5]int
+
+// RatingAverages は詳細評価の項目別平均です。該当する評価が1件もない項目は nil になります
+type RatingAverages struct {
+	Taste       *float64
+	Atmosphere  *float64
+	Service     *float64
+	Speed       *float64
+	Cleanliness *float64
+}
+
+// RatingSummary は店舗に対するレビュー評価の集計値です
+type RatingSummary struct {
+	StoreID       string
+	AverageRating float64
+	ReviewCount   int
+	Histogram     RatingHistogram
+	Averages      RatingAverages
+}
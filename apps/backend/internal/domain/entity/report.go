@@ -6,10 +6,17 @@ import "time"
 type Report struct {
 	ReportID   int64
 	UserID     string
-	TargetType string // "review","store"など
-	TargetID   int64
+	TargetType string // "review","store","user","menu"
+	TargetID   string
 	Reason     string
 	Status     string // "pending", "resolved", "rejected"
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	// Enforcement は解決時に対象へ実施した措置（"delete_review" など）。措置なしの場合は nil
+	Enforcement    *string
+	ResolutionNote *string
+	ResolvedBy     *string
+	ResolvedAt     *time.Time
+	// ReportCount は同じ対象・同じステータスの通報をまとめた件数。一覧と対応結果でのみ設定される
+	ReportCount int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
@@ -21,5 +21,19 @@ type Review struct {
 	Files         []File
 	LikesCount    int
 	LikedByMe     bool
+	Visibility    string
 	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ReviewLike はユーザーがレビューにつけたいいねを表します
+type ReviewLike struct {
+	ReviewID  string
+	UserID    string
+	CreatedAt time.Time
+}
+
+// IsEdited は投稿後にレビューが編集されたかを返します
+func (r Review) IsEdited() bool {
+	return r.UpdatedAt.After(r.CreatedAt)
 }
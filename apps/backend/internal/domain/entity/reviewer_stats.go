@@ -0,0 +1,17 @@
+package entity
+
+// ReviewerStats はユーザーが書いた公開中のレビューと訪問記録の集計値です
+type ReviewerStats struct {
+	ReviewCount   int
+	LikesReceived int
+	// StoresVisited は訪問記録がある公開中の店舗の数
+	StoresVisited int
+	// TopCategories はレビューの多い店舗カテゴリ。件数の多い順
+	TopCategories []CategoryCount
+}
+
+// CategoryCount は店舗カテゴリごとのレビュー件数です
+type CategoryCount struct {
+	Category    string
+	ReviewCount int
+}
@@ -0,0 +1,29 @@
+package entity
+
+// SearchHit は検索エンジンが返す1件の一致です。ID は Type に応じて店舗IDまたはレビューIDを表します
+type SearchHit struct {
+	Type  string
+	ID    string
+	Score float64
+}
+
+// SearchResult は検索結果1件です。レビューの一致では Store にレビュー対象の店舗を設定します
+type SearchResult struct {
+	Type       string
+	Score      float64
+	Store      *Store
+	Review     *Review
+	Highlights []SearchHighlight
+}
+
+// SearchHighlight は一致したフィールドの抜粋です
+type SearchHighlight struct {
+	Field    string
+	Segments []SearchSegment
+}
+
+// SearchSegment は抜粋の一部分です。Match が true の部分が検索語に一致しています
+type SearchSegment struct {
+	Text  string
+	Match bool
+}
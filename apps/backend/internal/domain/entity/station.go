@@ -7,4 +7,12 @@ type Station struct {
 	Kind string   `json:"kind"`
 	Lat  *float64 `json:"lat"`
 	Lng  *float64 `json:"lng"`
+	// DistanceMeters is set only by nearest-station lookups.
+	DistanceMeters *float64 `json:"distance_meters,omitempty"`
+}
+
+// StationGroup is a set of stations sharing the same kind (area).
+type StationGroup struct {
+	Kind     string    `json:"kind"`
+	Stations []Station `json:"stations"`
 }
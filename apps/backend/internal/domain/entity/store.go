@@ -2,6 +2,8 @@ package entity
 
 import (
 	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 )
 
 type Store struct {
@@ -9,23 +11,43 @@ type Store struct {
 	ThumbnailFileID *string
 	ThumbnailFile   *File
 	Name            string
+	NameKana        *string
 	OpenedAt        *time.Time
 	Description     *string
 	Address         string
 	PlaceID         string
-	OpeningHours    *string
+	OpeningHours    *string // 自由記述の営業時間。構造化した後も元の記述として残す
+	OpeningSchedule *OpeningSchedule
 	Latitude        float64
 	Longitude       float64
 	GoogleMapURL    *string
-	IsApproved      bool
+	Visibility      string
+	ApprovalStatus  string // "draft", "submitted", "approved", "rejected", "resubmitted"
 	Category        string
 	Budget          string
 	AverageRating   float64
+	ReviewCount     int
+	RatingHistogram RatingHistogram
+	RatingAverages  RatingAverages
 	DistanceMinutes int
+	DistanceMeters  *float64
+	VisitedByMe     *bool // 閲覧者が店舗に行ったことがあるか。未ログインの閲覧では nil
 	Tags            []string
 	Files           []File
+	Photos          []StorePhoto // ギャラリーの写真（表示順）
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	Menus           []Menu
 	Reviews         []Review
+	PendingEdit     *StoreEdit // 更新時に管理者の承認待ちになった変更。通常の取得では nil
+}
+
+// IsPublished は店舗が公開されているかを返します
+func (s Store) IsPublished() bool {
+	return s.Visibility == constants.VisibilityPublished
+}
+
+// IsAwaitingApproval は店舗が管理者の審査待ちかを返します
+func (s Store) IsAwaitingApproval() bool {
+	return s.ApprovalStatus == constants.StoreApprovalSubmitted || s.ApprovalStatus == constants.StoreApprovalResubmitted
 }
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// StoreApprovalEvent は店舗の審査状態の遷移を記録する監査ログのエンティティ
+type StoreApprovalEvent struct {
+	EventID    int64
+	StoreID    string
+	FromStatus string
+	ToStatus   string
+	ActorID    *string
+	Reason     *string
+	CreatedAt  time.Time
+}
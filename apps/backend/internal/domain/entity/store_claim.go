@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// StoreClaim は既存店舗の管理権限を求めるオーナー申請を表すエンティティ
+type StoreClaim struct {
+	ClaimID    string
+	StoreID    string
+	UserID     string
+	Note       string
+	Status     string // "pending", "approved", "denied"
+	ReviewedBy *string
+	ReviewNote *string
+	ReviewedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Files      []File
+}
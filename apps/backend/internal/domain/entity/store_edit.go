@@ -0,0 +1,87 @@
+package entity
+
+import "time"
+
+// StoreEdit は承認済み店舗の重要な項目に対する、管理者の承認待ちの変更を表すエンティティ。
+// nil の項目は変更しません
+type StoreEdit struct {
+	EditID          string
+	StoreID         string
+	UserID          string
+	Name            *string
+	Address         *string
+	Latitude        *float64
+	Longitude       *float64
+	PlaceID         *string
+	ThumbnailFileID *string
+	Status          string // "pending", "approved", "rejected"
+	ReviewedBy      *string
+	ReviewNote      *string
+	ReviewedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Changes         []StoreFieldChange // 現在の店舗との差分。管理者向けの取得時にだけ設定します
+}
+
+// StoreFieldChange は変更前後の値を項目ごとに表します
+type StoreFieldChange struct {
+	Field    string
+	Current  any
+	Proposed any
+}
+
+// IsEmpty は変更する項目がないかを返します
+func (e StoreEdit) IsEmpty() bool {
+	return e.Name == nil && e.Address == nil && e.Latitude == nil && e.Longitude == nil &&
+		e.PlaceID == nil && e.ThumbnailFileID == nil
+}
+
+// Merge は next で指定された項目を上書きします
+func (e *StoreEdit) Merge(next StoreEdit) {
+	if next.Name != nil {
+		e.Name = next.Name
+	}
+	if next.Address != nil {
+		e.Address = next.Address
+	}
+	if next.Latitude != nil {
+		e.Latitude = next.Latitude
+	}
+	if next.Longitude != nil {
+		e.Longitude = next.Longitude
+	}
+	if next.PlaceID != nil {
+		e.PlaceID = next.PlaceID
+	}
+	if next.ThumbnailFileID != nil {
+		e.ThumbnailFileID = next.ThumbnailFileID
+	}
+}
+
+// Diff は現在の店舗と比べた変更を項目ごとに返します。値が同じ項目は含めません
+func (e StoreEdit) Diff(store Store) []StoreFieldChange {
+	changes := make([]StoreFieldChange, 0, 6)
+	if e.Name != nil && *e.Name != store.Name {
+		changes = append(changes, StoreFieldChange{Field: "name", Current: store.Name, Proposed: *e.Name})
+	}
+	if e.Address != nil && *e.Address != store.Address {
+		changes = append(changes, StoreFieldChange{Field: "address", Current: store.Address, Proposed: *e.Address})
+	}
+	if e.Latitude != nil && *e.Latitude != store.Latitude {
+		changes = append(changes, StoreFieldChange{Field: "latitude", Current: store.Latitude, Proposed: *e.Latitude})
+	}
+	if e.Longitude != nil && *e.Longitude != store.Longitude {
+		changes = append(changes, StoreFieldChange{Field: "longitude", Current: store.Longitude, Proposed: *e.Longitude})
+	}
+	if e.PlaceID != nil && *e.PlaceID != store.PlaceID {
+		changes = append(changes, StoreFieldChange{Field: "place_id", Current: store.PlaceID, Proposed: *e.PlaceID})
+	}
+	if e.ThumbnailFileID != nil && (store.ThumbnailFileID == nil || *e.ThumbnailFileID != *store.ThumbnailFileID) {
+		var current any
+		if store.ThumbnailFileID != nil {
+			current = *store.ThumbnailFileID
+		}
+		changes = append(changes, StoreFieldChange{Field: "thumbnail_file_id", Current: current, Proposed: *e.ThumbnailFileID})
+	}
+	return changes
+}
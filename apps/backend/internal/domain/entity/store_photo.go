@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// StorePhoto は店舗のギャラリーに並ぶ写真
+type StorePhoto struct {
+	StoreID   string
+	File      File
+	SortOrder int
+	Caption   *string
+	AltText   *string
+	IsCover   bool // 店舗のカバー画像（stores.thumbnail_file_id）か
+	CreatedAt time.Time
+}
@@ -0,0 +1,7 @@
+package entity
+
+// TagCount は承認済み店舗のうち、そのタグが付いた店舗の件数です
+type TagCount struct {
+	Tag        string
+	StoreCount int
+}
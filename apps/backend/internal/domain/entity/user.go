@@ -4,16 +4,39 @@ import "time"
 
 // User はユーザー情報を表すエンティティ
 type User struct {
-	UserID     string
-	Name       string
-	Email      string
-	Phone      *string
-	IconFileID *string
-	Provider   string
-	IconURL    *string
-	Gender     *string
-	Birthday   *time.Time
-	Role       string
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	UserID           string
+	Name             string
+	Email            string
+	Phone            *string
+	IconFileID       *string
+	Provider         string
+	IconURL          *string
+	Gender           *string
+	Birthday         *time.Time
+	Role             string
+	SuspendedAt      *time.Time // 管理者が利用停止にした日時。停止されていない場合は nil
+	SuspendedUntil   *time.Time // 利用停止の期限。nil の場合は無期限（ban）
+	SuspensionReason *string
+	DeletedAt        *time.Time // 退会した日時。退会したユーザーの個人情報は匿名化されている
+	PurgedAt         *time.Time // 退会後に認証ユーザーとアップロードしたファイルを削除し終えた日時
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// IsSuspended は now の時点で利用停止中かを返します。期限を過ぎた停止は解除済みとして扱います
+func (u User) IsSuspended(now time.Time) bool {
+	if u.SuspendedAt == nil {
+		return false
+	}
+	return u.SuspendedUntil == nil || now.Before(*u.SuspendedUntil)
+}
+
+// IsDeleted は退会済みのユーザーかを返します
+func (u User) IsDeleted() bool {
+	return u.DeletedAt != nil
+}
+
+// IsPurgePending は退会済みで、認証ユーザーやアップロードしたファイルの削除が終わっていないかを返します
+func (u User) IsPurgePending() bool {
+	return u.DeletedAt != nil && u.PurgedAt == nil
 }
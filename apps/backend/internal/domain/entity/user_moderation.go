@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// UserModerationEvent は管理者がユーザーに対して行った操作（利用停止・解除・ロール変更）の履歴を表すエンティティ
+type UserModerationEvent struct {
+	EventID        int64
+	UserID         string
+	AdminID        *string
+	Action         string // "suspend", "ban", "unsuspend", "change_role"
+	Reason         *string
+	FromRole       *string    // ロール変更時のみ
+	ToRole         *string    // ロール変更時のみ
+	SuspendedUntil *time.Time // 期限付きの利用停止時のみ
+	CreatedAt      time.Time
+}
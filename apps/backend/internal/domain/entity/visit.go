@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// Visit はユーザーが店舗に行った記録を表すエンティティ。同じ店舗・同じ日の訪問は 1 件にまとめる
+type Visit struct {
+	VisitID int64
+	UserID  string
+	StoreID string
+	// VisitedOn は訪問日。時刻を持たない日付として UTC の 0 時で表す
+	VisitedOn time.Time
+	Note      *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Store     *Store
+}
+
+// VisitCounts はユーザーの訪問記録の件数
+type VisitCounts struct {
+	VisitCount int
+	StoreCount int
+}
@@ -0,0 +1,61 @@
+package hours
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// Location は営業時間を扱うタイムゾーン（constants.ServiceTimeZone）
+var Location = time.FixedZone(constants.ServiceTimeZone, constants.ServiceUTCOffsetSeconds)
+
+// ErrInvalidClock は HH:MM 形式でない時刻を表します
+var ErrInvalidClock = errors.New("invalid clock time")
+
+// Date は t の Location での日付を、時刻を持たない日付として UTC の 0 時で返します
+func Date(t time.Time) time.Time {
+	y, m, d := t.In(Location).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Clock は t の Location での日付（UTC の 0 時）と、その日の 0:00 からの分数を返します
+func Clock(t time.Time) (time.Time, int) {
+	local := t.In(Location)
+	return Date(t), local.Hour()*60 + local.Minute()
+}
+
+// At は date（UTC の 0 時の日付）の 0:00 から minute 分後の日時を Location で返します
+func At(date time.Time, minute int) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, Location).Add(time.Duration(minute) * time.Minute)
+}
+
+// FormatMinutes は 0:00 からの分数を HH:MM で返します。日付をまたぐ時刻は 26:00 のように 24 時以降で表します
+func FormatMinutes(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+// ParseMinutes は HH:MM を 0:00 からの分数に変換します。翌日の時刻を表す 48:00 までを受け付けます
+func ParseMinutes(value string) (int, error) {
+	h, m, ok := strings.Cut(strings.TrimSpace(value), ":")
+	if !ok || len(m) != 2 {
+		return 0, ErrInvalidClock
+	}
+	hour, err := strconv.Atoi(h)
+	if err != nil || hour < 0 {
+		return 0, ErrInvalidClock
+	}
+	minute, err := strconv.Atoi(m)
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, ErrInvalidClock
+	}
+	total := hour*60 + minute
+	if total > 2*entity.MinutesPerDay {
+		return 0, ErrInvalidClock
+	}
+	return total, nil
+}
@@ -0,0 +1,2 @@
+// Package hours は店舗の営業時間の扱い（時刻の表記、営業状況の計算、自由記述の営業時間の解析）を提供します。
+package hours
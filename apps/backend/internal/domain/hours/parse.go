@@ -0,0 +1,310 @@
+package hours
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+const (
+	// rangeSeparator は「11:00〜22:00」「月-金」などの範囲の区切り（NFKC 後）
+	rangeSeparator = `\s*(?:[-~〜–—―ー]|から)\s*`
+	// clockPattern は「11:00」「11時」「11時半」「翌2時30分」などの時刻
+	clockPattern = `(?:翌\s*)?\d{1,2}(?::\d{2}|時(?:半|\d{1,2}分?)?)`
+)
+
+var (
+	clauseSeparator = regexp.MustCompile(`[\n/;|。、,]`)
+	timeRange       = regexp.MustCompile(`(` + clockPattern + `)` + rangeSeparator + `(` + clockPattern + `)`)
+	clockParts      = regexp.MustCompile(`^(翌)?\s*(\d{1,2})(?::(\d{2})|時(半|(\d{1,2})分?)?)$`)
+	lastOrder       = regexp.MustCompile(`(?:l\.?\s?o\.?|ラストオーダー|ラスト)\s*:?\s*` + clockPattern)
+	japaneseDays    = regexp.MustCompile(`([日月火水木金土])(?:` + rangeSeparator + `([日月火水木金土]))?`)
+	englishDays     = regexp.MustCompile(`\b(sun|mon|tue|wed|thu|fri|sat)[a-z]*\.?(?:` + rangeSeparator + `(sun|mon|tue|wed|thu|fri|sat)[a-z]*\.?)?`)
+)
+
+var (
+	japaneseWeekdays = map[string]int{"日": 0, "月": 1, "火": 2, "水": 3, "木": 4, "金": 5, "土": 6}
+	englishWeekdays  = map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}
+)
+
+// daySet は曜日（time.Weekday）の集合
+type daySet [7]bool
+
+func (d daySet) size() int {
+	n := 0
+	for _, ok := range d {
+		if ok {
+			n++
+		}
+	}
+	return n
+}
+
+func allDays() daySet {
+	return daySet{true, true, true, true, true, true, true}
+}
+
+// dayRange は from から to まで（週をまたぐ「金〜月」も含む）の曜日を返します
+func dayRange(from, to int) daySet {
+	var days daySet
+	for d := from; ; d = (d + 1) % 7 {
+		days[d] = true
+		if d == to {
+			return days
+		}
+	}
+}
+
+// Parse は自由記述の営業時間から、構造化した営業時間を推測します。
+// 「月〜金 11:00〜22:00 / 土日祝 10時-18時」「11:30-14:00、17:00-翌2:00」「定休日：水曜」「24時間営業」などの
+// よくある書き方に対応します。曜日の指定がない時間帯は直前の曜日、最初の時間帯なら毎日に適用します。
+// 祝日は曜日として扱えないため無視します。時間帯を1つも読み取れない場合は nil, false を返します
+func Parse(text string) (*entity.OpeningSchedule, bool) {
+	s := strings.ToLower(norm.NFKC.String(text))
+	s = lastOrder.ReplaceAllString(s, "")
+
+	var (
+		schedule entity.OpeningSchedule
+		// generality は曜日ごとに、その営業時間を決めた指定が何曜日分だったか。より狭い指定で上書きする
+		generality [7]int
+		closed     daySet
+		lastDays   daySet
+		pending    daySet
+		// closing は「定休日、水、木」のように休業の指定と曜日が区切られている場合に、続く曜日を休業日とするか
+		closing bool
+		found   bool
+	)
+	for _, clause := range clauseSeparator.Split(s, -1) {
+		intervals := parseIntervals(clause)
+		if strings.Contains(clause, "24時間") {
+			intervals = append(intervals, entity.OpeningInterval{Open: 0, Close: entity.MinutesPerDay})
+		}
+		days, closedDays, hasDays, isClosed := clauseDays(clause)
+		for d, ok := range pending {
+			days[d] = days[d] || ok
+		}
+		hasDays = hasDays || pending.size() > 0
+		pending = daySet{}
+
+		if len(intervals) == 0 && (isClosed || (closing && hasDays)) {
+			for d := range closed {
+				closed[d] = closed[d] || days[d] || closedDays[d]
+			}
+			closing = true
+			continue
+		}
+		for d, ok := range closedDays {
+			closed[d] = closed[d] || ok
+		}
+		closing = false
+		if len(intervals) == 0 {
+			// 「土、日 10:00-18:00」のように曜日だけが区切られている場合は次の時間帯に含める
+			if hasDays {
+				pending = days
+			}
+			continue
+		}
+
+		if !hasDays {
+			days = lastDays
+			if days.size() == 0 {
+				days = allDays()
+			}
+		}
+		for d, ok := range days {
+			if !ok {
+				continue
+			}
+			// 前の指定より狭い曜日の指定（「毎日」の後の「土日」など）はその曜日を置き換える
+			if hasDays && days.size() < generality[d] {
+				schedule.Weekly[d] = nil
+			}
+			if hasDays || generality[d] == 0 {
+				generality[d] = days.size()
+			}
+			schedule.Weekly[d] = append(schedule.Weekly[d], intervals...)
+		}
+		lastDays = days
+		found = true
+	}
+	if !found {
+		return nil, false
+	}
+
+	for d := range schedule.Weekly {
+		if closed[d] {
+			schedule.Weekly[d] = nil
+			continue
+		}
+		merged, ok := mergeIntervals(schedule.Weekly[d])
+		if !ok {
+			return nil, false
+		}
+		schedule.Weekly[d] = merged
+	}
+	if schedule.IsEmpty() {
+		return nil, false
+	}
+	return &schedule, true
+}
+
+// clauseDays は節の曜日の指定を、営業する曜日と休業する曜日に分けて返します。
+// 「月〜金 11:00-22:00 土日定休」のように、時間帯で区切られた部分ごとに休業の指定かを判断します。
+// 「昼休み 14:00-17:00」のように曜日を含まない休業の指定でも isClosed は true になります
+func clauseDays(clause string) (open, closed daySet, hasOpen, isClosed bool) {
+	for _, segment := range timeRange.Split(clause, -1) {
+		days, ok := parseDays(segment)
+		if isClosedClause(segment) {
+			isClosed = true
+			for d, on := range days {
+				closed[d] = closed[d] || on
+			}
+			continue
+		}
+		for d, on := range days {
+			open[d] = open[d] || on
+		}
+		hasOpen = hasOpen || ok
+	}
+	return open, closed, hasOpen, isClosed
+}
+
+// parseIntervals は節に含まれる「11:00-22:00」などの時間帯を返します。日付をまたぐ時間帯は Close を翌日の分数にします
+func parseIntervals(clause string) []entity.OpeningInterval {
+	var intervals []entity.OpeningInterval
+	for _, m := range timeRange.FindAllStringSubmatch(clause, -1) {
+		open, ok := parseClock(m[1])
+		if !ok {
+			continue
+		}
+		closeAt, ok := parseClock(m[2])
+		if !ok {
+			continue
+		}
+		if open >= entity.MinutesPerDay {
+			continue
+		}
+		if closeAt <= open {
+			closeAt += entity.MinutesPerDay
+		}
+		if closeAt-open > entity.MinutesPerDay {
+			continue
+		}
+		intervals = append(intervals, entity.OpeningInterval{Open: open, Close: closeAt})
+	}
+	return intervals
+}
+
+// parseClock は「11:00」「11時半」「翌2時」などを 0:00 からの分数に変換します
+func parseClock(value string) (int, bool) {
+	m := clockParts.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return 0, false
+	}
+	hour, _ := strconv.Atoi(m[2]) //nolint:errcheck // the pattern only matches digits
+	minute := 0
+	switch {
+	case m[3] != "":
+		minute, _ = strconv.Atoi(m[3]) //nolint:errcheck // the pattern only matches digits
+	case m[4] == "半":
+		minute = 30
+	case m[5] != "":
+		minute, _ = strconv.Atoi(m[5]) //nolint:errcheck // the pattern only matches digits
+	}
+	if minute > 59 {
+		return 0, false
+	}
+	total := hour*60 + minute
+	if m[1] != "" {
+		total += entity.MinutesPerDay
+	}
+	if total > 2*entity.MinutesPerDay {
+		return 0, false
+	}
+	return total, true
+}
+
+// parseDays は節に含まれる曜日の指定を返します
+func parseDays(rest string) (daySet, bool) {
+	var days daySet
+	// 曜日の文字を含む語を先に取り除く
+	rest = strings.NewReplacer(
+		"祝日", " ", "祝", " ", "曜日", " ", "曜", " ",
+		"定休日", " 定休 ", "休業日", " 休業 ", "休館日", " 休館 ", "休日", " 休み ",
+		"営業日", " ", "本日", " ",
+		"毎月", " ", "月末", " ", "日替わり", " ",
+	).Replace(rest)
+
+	switch {
+	case strings.Contains(rest, "毎日"), strings.Contains(rest, "無休"),
+		strings.Contains(rest, "daily"), strings.Contains(rest, "every day"):
+		return allDays(), true
+	}
+	if strings.Contains(rest, "平日") || strings.Contains(rest, "weekdays") {
+		for d := 1; d <= 5; d++ {
+			days[d] = true
+		}
+	}
+	if strings.Contains(rest, "週末") || strings.Contains(rest, "weekends") {
+		days[0], days[6] = true, true
+	}
+	for _, m := range japaneseDays.FindAllStringSubmatch(rest, -1) {
+		markDays(&days, japaneseWeekdays[m[1]], m[2], japaneseWeekdays)
+	}
+	for _, m := range englishDays.FindAllStringSubmatch(rest, -1) {
+		markDays(&days, englishWeekdays[m[1]], m[2], englishWeekdays)
+	}
+	return days, days.size() > 0
+}
+
+func markDays(days *daySet, from int, to string, names map[string]int) {
+	if to == "" {
+		days[from] = true
+		return
+	}
+	for d, ok := range dayRange(from, names[to]) {
+		days[d] = days[d] || ok
+	}
+}
+
+// isClosedClause は「定休日」「水曜休み」「closed」などの休業日の指定かを返します。「年中無休」は含めません
+func isClosedClause(rest string) bool {
+	rest = strings.ReplaceAll(rest, "無休", "")
+	for _, word := range []string{"定休", "休み", "休業", "休館", "closed"} {
+		if strings.Contains(rest, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeIntervals は営業時間帯を開始の早い順に並べ、重なる時間帯をまとめます。上限を超える場合は false を返します
+func mergeIntervals(intervals []entity.OpeningInterval) ([]entity.OpeningInterval, bool) {
+	if len(intervals) == 0 {
+		return nil, true
+	}
+	sorted := append([]entity.OpeningInterval(nil), intervals...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Open < sorted[j].Open })
+
+	merged := []entity.OpeningInterval{sorted[0]}
+	for _, interval := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if interval.Open <= last.Close {
+			if interval.Close > last.Close {
+				last.Close = min(interval.Close, last.Open+entity.MinutesPerDay)
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	if len(merged) > constants.MaxOpeningIntervalsPerDay {
+		return nil, false
+	}
+	return merged, true
+}
@@ -0,0 +1,121 @@
+package hours
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+func iv(open, closeAt string) entity.OpeningInterval {
+	o, err := ParseMinutes(open)
+	if err != nil {
+		panic(err)
+	}
+	c, err := ParseMinutes(closeAt)
+	if err != nil {
+		panic(err)
+	}
+	return entity.OpeningInterval{Open: o, Close: c}
+}
+
+func everyDay(intervals ...entity.OpeningInterval) [7][]entity.OpeningInterval {
+	var weekly [7][]entity.OpeningInterval
+	for d := range weekly {
+		weekly[d] = intervals
+	}
+	return weekly
+}
+
+func TestParse(t *testing.T) {
+	lunchDinner := []entity.OpeningInterval{iv("11:30", "14:00"), iv("17:00", "22:00")}
+	weekdaysAndWeekend := [7][]entity.OpeningInterval{
+		{iv("10:00", "18:00")},
+		{iv("11:00", "22:00")}, {iv("11:00", "22:00")}, {iv("11:00", "22:00")}, {iv("11:00", "22:00")}, {iv("11:00", "22:00")},
+		{iv("10:00", "18:00")},
+	}
+	closedWednesday := everyDay(iv("11:00", "22:00"))
+	closedWednesday[3] = nil
+
+	tests := []struct {
+		name string
+		in   string
+		want [7][]entity.OpeningInterval
+	}{
+		{"single range every day", "11:00-22:00", everyDay(iv("11:00", "22:00"))},
+		{"full-width and wave dash", "１１：００〜２２：００", everyDay(iv("11:00", "22:00"))},
+		{"japanese clock", "11時半~22時", everyDay(iv("11:30", "22:00"))},
+		{"two intervals", "11:30-14:00、17:00-22:00", everyDay(lunchDinner...)},
+		{"overnight", "18:00-翌2:00", everyDay(iv("18:00", "26:00"))},
+		{"overnight past 24", "18:00-26:00", everyDay(iv("18:00", "26:00"))},
+		{"overnight without marker", "18:00-02:00", everyDay(iv("18:00", "26:00"))},
+		{"day ranges", "月〜金 11:00〜22:00 / 土日祝 10:00〜18:00", weekdaysAndWeekend},
+		{"weekday keyword", "平日 11:00-22:00\n土日 10:00-18:00", weekdaysAndWeekend},
+		{"narrower days override", "11:00-22:00 / 土・日 10:00-18:00", func() [7][]entity.OpeningInterval {
+			w := everyDay(iv("11:00", "22:00"))
+			w[0], w[6] = []entity.OpeningInterval{iv("10:00", "18:00")}, []entity.OpeningInterval{iv("10:00", "18:00")}
+			return w
+		}()},
+		{"regular holiday", "11:00-22:00（定休日：水曜日）", closedWednesday},
+		{"regular holiday in separate clause", "定休日、水 / 11:00-22:00", closedWednesday},
+		{"day off suffix", "11:00-22:00 水曜休み", closedWednesday},
+		{"open and closed days in one clause", "月〜金 11:00〜22:00 土日定休", func() [7][]entity.OpeningInterval {
+			w := everyDay(iv("11:00", "22:00"))
+			w[0], w[6] = nil, nil
+			return w
+		}()},
+		{"last order is ignored", "11:00~22:00(L.O.21:30) 水曜定休", closedWednesday},
+		{"english", "Mon-Fri 11:00-22:00; Sat, Sun 10:00-18:00", weekdaysAndWeekend},
+		{"wrapping day range", "金-月 18:00-24:00", [7][]entity.OpeningInterval{
+			{iv("18:00", "24:00")}, {iv("18:00", "24:00")}, nil, nil, nil, {iv("18:00", "24:00")}, {iv("18:00", "24:00")},
+		}},
+		{"open all day", "24時間営業 年中無休", everyDay(iv("00:00", "24:00"))},
+		{"break does not close the day", "11:00-22:00（昼休み 14:00-17:00）", everyDay(iv("11:00", "22:00"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Parse(tt.in)
+			if !ok {
+				t.Fatalf("Parse(%q) failed", tt.in)
+			}
+			if !reflect.DeepEqual(got.Weekly, tt.want) {
+				t.Errorf("Parse(%q).Weekly = %v, want %v", tt.in, got.Weekly, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Unparseable(t *testing.T) {
+	for _, in := range []string{"", "不定休", "お問い合わせください", "水曜定休", "25:00-27:00"} {
+		if got, ok := Parse(in); ok {
+			t.Errorf("Parse(%q) = %+v, want failure", in, got)
+		}
+	}
+}
+
+func TestParseMinutes(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{"00:00", 0, false},
+		{"9:05", 545, false},
+		{"26:30", 1590, false},
+		{"48:00", 2880, false},
+		{"48:01", 0, true},
+		{"12:60", 0, true},
+		{"12", 0, true},
+		{"ab:cd", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseMinutes(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseMinutes(%q) = %d, %v; want %d, err=%v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+	if got := FormatMinutes(1590); got != "26:30" {
+		t.Errorf("FormatMinutes(1590) = %q, want 26:30", got)
+	}
+}
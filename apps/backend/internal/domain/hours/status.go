@@ -0,0 +1,69 @@
+package hours
+
+import (
+	"sort"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// span は営業時間帯を日時に置き換えたもの
+type span struct {
+	start time.Time
+	end   time.Time
+}
+
+// Status は now 時点の営業状況を返します。
+// 前日に始まった深夜営業も営業中として扱い、閉店時刻は続けて営業する時間帯（24時間営業の翌日など）までを含めます。
+// 閉店・開店の時刻は constants.OpeningStatusLookaheadDays 日先まで探し、見つからない場合は nil にします
+func Status(schedule entity.OpeningSchedule, now time.Time) entity.OpeningStatus {
+	today, _ := Clock(now)
+	// 前日の営業時間帯は深夜営業で今日にかかっている場合がある
+	from := today.AddDate(0, 0, -1)
+	spans := spansBetween(schedule, from, constants.OpeningStatusLookaheadDays+2)
+	if len(spans) == 0 {
+		return entity.OpeningStatus{}
+	}
+	horizon := At(from, (constants.OpeningStatusLookaheadDays+2)*entity.MinutesPerDay)
+
+	var closesAt time.Time
+	for _, s := range spans {
+		switch {
+		case closesAt.IsZero() && !s.start.After(now) && now.Before(s.end):
+			closesAt = s.end
+		case !closesAt.IsZero() && !s.start.After(closesAt) && s.end.After(closesAt):
+			closesAt = s.end
+		}
+	}
+	if !closesAt.IsZero() {
+		status := entity.OpeningStatus{OpenNow: true}
+		if closesAt.Before(horizon) {
+			status.ClosesAt = &closesAt
+		}
+		return status
+	}
+
+	for _, s := range spans {
+		if s.start.After(now) {
+			next := s.start
+			return entity.OpeningStatus{NextOpenAt: &next}
+		}
+	}
+	return entity.OpeningStatus{}
+}
+
+// spansBetween は from から days 日分の日に始まる営業時間帯を開始の早い順に返します
+func spansBetween(schedule entity.OpeningSchedule, from time.Time, days int) []span {
+	var spans []span
+	for i := 0; i < days; i++ {
+		date := from.AddDate(0, 0, i)
+		for _, interval := range schedule.IntervalsOn(date) {
+			spans = append(spans, span{start: At(date, interval.Open), end: At(date, interval.Close)})
+		}
+	}
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].start.Before(spans[j].start)
+	})
+	return spans
+}
@@ -0,0 +1,82 @@
+package hours
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// jst は 2026-03-02（月）を基準にした日本時間の日時を返します
+func jst(day, hour, minute int) time.Time {
+	return time.Date(2026, 3, day, hour, minute, 0, 0, Location)
+}
+
+func date(day int) time.Time {
+	return time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestStatus(t *testing.T) {
+	weekdays := entity.OpeningSchedule{}
+	for d := time.Monday; d <= time.Friday; d++ {
+		weekdays.Weekly[d] = []entity.OpeningInterval{iv("11:30", "14:00"), iv("17:00", "26:00")}
+	}
+	allDay := entity.OpeningSchedule{Weekly: everyDay(iv("00:00", "24:00"))}
+	withExceptions := weekdays
+	withExceptions.Special = []entity.SpecialHours{{Date: date(4), Intervals: []entity.OpeningInterval{iv("12:00", "15:00")}}}
+	withExceptions.Closures = []entity.StoreClosure{{From: date(3), To: date(5)}}
+
+	tests := []struct {
+		name       string
+		schedule   entity.OpeningSchedule
+		now        time.Time
+		wantOpen   bool
+		closesAt   *time.Time
+		nextOpenAt *time.Time
+	}{
+		{"open at lunch", weekdays, jst(2, 12, 0), true, ptr(jst(2, 14, 0)), nil},
+		{"closed between lunch and dinner", weekdays, jst(2, 15, 0), false, nil, ptr(jst(2, 17, 0))},
+		{"open past midnight", weekdays, jst(3, 1, 30), true, ptr(jst(3, 2, 0)), nil},
+		{"friday night spills into saturday", weekdays, jst(7, 1, 0), true, ptr(jst(7, 2, 0)), nil},
+		{"closed over the weekend", weekdays, jst(7, 12, 0), false, nil, ptr(jst(9, 11, 30))},
+		{"closure keeps the previous night", withExceptions, jst(3, 1, 0), true, ptr(jst(3, 2, 0)), nil},
+		{"closed during closure", withExceptions, jst(3, 12, 0), false, nil, ptr(jst(4, 12, 0))},
+		{"special hours win over closure", withExceptions, jst(4, 13, 0), true, ptr(jst(4, 15, 0)), nil},
+		{"open all day has no closing time", allDay, jst(2, 12, 0), true, nil, nil},
+		{"empty schedule", entity.OpeningSchedule{}, jst(2, 12, 0), false, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Status(tt.schedule, tt.now)
+			if got.OpenNow != tt.wantOpen {
+				t.Errorf("OpenNow = %v, want %v", got.OpenNow, tt.wantOpen)
+			}
+			if !sameTime(got.ClosesAt, tt.closesAt) {
+				t.Errorf("ClosesAt = %v, want %v", got.ClosesAt, tt.closesAt)
+			}
+			if !sameTime(got.NextOpenAt, tt.nextOpenAt) {
+				t.Errorf("NextOpenAt = %v, want %v", got.NextOpenAt, tt.nextOpenAt)
+			}
+		})
+	}
+}
+
+func TestClock(t *testing.T) {
+	// UTC 2026-03-01 16:30 は日本時間 2026-03-02 01:30
+	day, minute := Clock(time.Date(2026, 3, 1, 16, 30, 0, 0, time.UTC))
+	if !day.Equal(date(2)) || minute != 90 {
+		t.Errorf("Clock() = %v, %d; want %v, 90", day, minute, date(2))
+	}
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,2 @@
+// Package search は検索語と検索対象テキストの正規化、およびハイライト付きスニペットの生成を提供します。
+package search
@@ -0,0 +1,41 @@
+package search
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/tag"
+)
+
+// Normalize は検索語を照合用の正規形に変換します。
+// タグと同じ規則（NFKC・空白の整理・小文字化）に加えて、カタカナをひらがなにそろえます。
+// DB 側の search_normalize 関数と同じ結果になるように保つ必要があります。
+func Normalize(s string) string {
+	return KatakanaToHiragana(tag.Normalize(s))
+}
+
+// Terms は検索語を正規化し、空白区切りの語に分割します
+func Terms(s string) []string {
+	return strings.Fields(Normalize(s))
+}
+
+// KatakanaToHiragana は全角カタカナをひらがなに変換します。
+// stations.kana や stores.name_kana はひらがなで保持しているため、読みの照合にも使います
+func KatakanaToHiragana(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'ァ' && r <= 'ヶ' {
+			return r - ('ァ' - 'ぁ')
+		}
+		return r
+	}, s)
+}
+
+// normalizeRune は1文字を Normalize と同じ規則で変換します。空白は半角スペース1文字になります
+func normalizeRune(r rune) []rune {
+	s := norm.NFKC.String(string(r))
+	if strings.TrimSpace(s) == "" {
+		return []rune{' '}
+	}
+	return []rune(KatakanaToHiragana(strings.ToLower(s)))
+}
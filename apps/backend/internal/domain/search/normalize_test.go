@@ -0,0 +1,42 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"katakana to hiragana", "カフェ", "かふぇ"},
+		{"half-width katakana", "ｺｰﾋｰ", "こーひー"},
+		{"full-width alphanumerics", "ＣＡＦＥ　Ｌａｔｔｅ", "cafe latte"},
+		{"kanji is kept", "三宮 カフェ", "三宮 かふぇ"},
+		{"blank", " 　 ", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTerms(t *testing.T) {
+	got := Terms("  三宮　カフェ  ")
+	want := []string{"三宮", "かふぇ"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Terms() = %v, want %v", got, want)
+	}
+}
+
+func TestKatakanaToHiragana(t *testing.T) {
+	if got := KatakanaToHiragana("サンノミヤ駅ヶ"); got != "さんのみや駅ゖ" {
+		t.Errorf("KatakanaToHiragana() = %q", got)
+	}
+}
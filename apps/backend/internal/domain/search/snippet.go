@@ -0,0 +1,112 @@
+package search
+
+import (
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+const ellipsis = "…"
+
+// Snippet は text のうち terms に一致する箇所を含む最大 width 文字の抜粋を、一致箇所とそれ以外に分けて返します。
+// 照合は Normalize と同じ規則で行うため、全角・半角やカタカナ・ひらがなの違いがあっても一致箇所として扱います。
+// どの語にも一致しない場合は nil, false を返します。
+func Snippet(text string, terms []string, width int) ([]entity.SearchSegment, bool) {
+	original := []rune(text)
+	matched := matchRunes(original, terms)
+
+	first := -1
+	for i, m := range matched {
+		if m {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return nil, false
+	}
+
+	start, end := 0, len(original)
+	if width > 0 && len(original) > width {
+		// 一致箇所の前に少し文脈を残して切り出す
+		start = first - width/4
+		if start < 0 {
+			start = 0
+		}
+		end = start + width
+		if end > len(original) {
+			end = len(original)
+			start = end - width
+		}
+	}
+
+	var segments []entity.SearchSegment
+	if start > 0 {
+		segments = append(segments, entity.SearchSegment{Text: ellipsis})
+	}
+	segStart := start
+	for i := start + 1; i <= end; i++ {
+		if i < end && matched[i] == matched[segStart] {
+			continue
+		}
+		segments = appendSegment(segments, entity.SearchSegment{
+			Text:  string(original[segStart:i]),
+			Match: matched[segStart],
+		})
+		segStart = i
+	}
+	if end < len(original) {
+		segments = appendSegment(segments, entity.SearchSegment{Text: ellipsis})
+	}
+	return segments, true
+}
+
+// appendSegment は一致していない区間が続く場合に1つにまとめて追加します
+func appendSegment(segments []entity.SearchSegment, seg entity.SearchSegment) []entity.SearchSegment {
+	if n := len(segments); n > 0 && !segments[n-1].Match && !seg.Match {
+		segments[n-1].Text += seg.Text
+		return segments
+	}
+	return append(segments, seg)
+}
+
+// matchRunes は original の各文字が terms のいずれかに一致する区間に含まれるかを返します
+func matchRunes(original []rune, terms []string) []bool {
+	var normalized []rune
+	var origin []int
+	for i, r := range original {
+		for _, n := range normalizeRune(r) {
+			normalized = append(normalized, n)
+			origin = append(origin, i)
+		}
+	}
+
+	matched := make([]bool, len(original))
+	for _, term := range terms {
+		needle := []rune(term)
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(normalized); {
+			if !hasPrefix(normalized[i:], needle) {
+				i++
+				continue
+			}
+			for j := origin[i]; j <= origin[i+len(needle)-1]; j++ {
+				matched[j] = true
+			}
+			i += len(needle)
+		}
+	}
+	return matched
+}
+
+func hasPrefix(s, prefix []rune) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if s[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+func TestSnippet_HighlightsNormalizedMatches(t *testing.T) {
+	segments, ok := Snippet("神戸のカフェ ＬＡＴＴＥ", []string{"かふぇ", "latte"}, 0)
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	want := []entity.SearchSegment{
+		{Text: "神戸の"},
+		{Text: "カフェ", Match: true},
+		{Text: " "},
+		{Text: "ＬＡＴＴＥ", Match: true},
+	}
+	if !reflect.DeepEqual(segments, want) {
+		t.Errorf("Snippet() = %+v, want %+v", segments, want)
+	}
+}
+
+func TestSnippet_TruncatesAroundFirstMatch(t *testing.T) {
+	text := "あいうえおかきくけこさしすせそたちつてとなにぬねの"
+	segments, ok := Snippet(text, []string{"たち"}, 8)
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	want := []entity.SearchSegment{
+		{Text: "…せそ"},
+		{Text: "たち", Match: true},
+		{Text: "つてとな…"},
+	}
+	if !reflect.DeepEqual(segments, want) {
+		t.Errorf("Snippet() = %+v, want %+v", segments, want)
+	}
+}
+
+func TestSnippet_KeepsWindowInsideText(t *testing.T) {
+	segments, ok := Snippet("あいうえおかきくけこ", []string{"こ"}, 4)
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	want := []entity.SearchSegment{
+		{Text: "…きくけ"},
+		{Text: "こ", Match: true},
+	}
+	if !reflect.DeepEqual(segments, want) {
+		t.Errorf("Snippet() = %+v, want %+v", segments, want)
+	}
+}
+
+func TestSnippet_NoMatch(t *testing.T) {
+	segments, ok := Snippet("静かな喫茶店", []string{"かふぇ"}, 0)
+	if ok || segments != nil {
+		t.Errorf("expected no match, got %+v", segments)
+	}
+}
@@ -0,0 +1,2 @@
+// Package tag は店舗タグの正規化を提供します。
+package tag
@@ -0,0 +1,16 @@
+package tag
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize はタグを保存・比較用の正規形に変換します。
+// NFKC で全角英数字・記号を半角に、半角カナを全角にそろえ、小文字化したうえで
+// 前後の空白を除き、連続する空白を1つにまとめます。
+func Normalize(s string) string {
+	s = norm.NFKC.String(s)
+	s = strings.Join(strings.Fields(s), " ")
+	return strings.ToLower(s)
+}
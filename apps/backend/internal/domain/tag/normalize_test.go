@@ -0,0 +1,27 @@
+package tag
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"already normalized", "wi-fi", "wi-fi"},
+		{"uppercase", "Wi-Fi", "wi-fi"},
+		{"full-width alphanumerics", "ＷＩ－ＦＩ", "wi-fi"},
+		{"surrounding and inner whitespace", "  quiet 　 space ", "quiet space"},
+		{"half-width katakana", "ｺｰﾋｰ", "コーヒー"},
+		{"japanese is kept", "静かな空間", "静かな空間"},
+		{"blank", " 　 ", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type AccountHandler struct {
+	accountUseCase input.AccountUseCase
+	storage        output.StorageProvider
+	bucket         string
+}
+
+func NewAccountHandler(accountUseCase input.AccountUseCase, storage output.StorageProvider, bucket string) *AccountHandler {
+	return &AccountHandler{
+		accountUseCase: accountUseCase,
+		storage:        storage,
+		bucket:         bucket,
+	}
+}
+
+// DeleteMe はログイン中のユーザーを退会させます
+func (h *AccountHandler) DeleteMe(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.accountUseCase.DeleteAccount(c.Request().Context(), user); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ExportMe はログイン中のユーザーのデータを JSON アーカイブとして返します
+func (h *AccountHandler) ExportMe(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	export, err := h.accountUseCase.ExportAccount(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	resp := presenter.NewAccountExportResponse(export)
+	attachSignedURLsToFileResponses(ctx, h.storage, h.bucket, resp.Files)
+	attachSignedURLsToReviewResponses(ctx, h.storage, h.bucket, resp.Reviews)
+
+	filename := fmt.Sprintf("account-export-%s.json", export.ExportedAt.UTC().Format("20060102T150405Z"))
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	return c.JSON(http.StatusOK, resp)
+}
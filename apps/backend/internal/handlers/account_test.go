@@ -0,0 +1,112 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+func TestAccountHandler_DeleteMe_Success(t *testing.T) {
+	user := testutil.NewTestUser(testutil.WithUserID("user-1"))
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/api/users/me").SetUser(user, "user")
+	mockUC := &testutil.MockAccountUseCase{}
+
+	err := handlers.NewAccountHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket").DeleteMe(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusNoContent)
+	if mockUC.DeleteCalledWith.UserID != "user-1" {
+		t.Errorf("expected DeleteAccount to be called with user-1, got %q", mockUC.DeleteCalledWith.UserID)
+	}
+}
+
+func TestAccountHandler_DeleteMe_Unauthorized(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/api/users/me")
+
+	err := handlers.NewAccountHandler(&testutil.MockAccountUseCase{}, nil, "").DeleteMe(tc.Context)
+
+	testutil.AssertError(t, err, "expected error without user")
+}
+
+func TestAccountHandler_DeleteMe_UseCaseError(t *testing.T) {
+	user := testutil.NewTestUser()
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/api/users/me").SetUser(user, "user")
+	mockUC := &testutil.MockAccountUseCase{DeleteErr: usecase.ErrUserNotFound}
+
+	err := handlers.NewAccountHandler(mockUC, nil, "").DeleteMe(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrUserNotFound, "expected user not found error")
+}
+
+func TestAccountHandler_ExportMe_SignsFileURLs(t *testing.T) {
+	user := testutil.NewTestUser(testutil.WithUserID("user-1"))
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/api/users/me/export").SetUser(user, "user")
+	photo := testutil.NewTestFile(testutil.WithFileID("file-1"))
+	photo.ObjectKey = "reviews/file-1.jpg"
+	review := testutil.NewTestReview(testutil.WithReviewID("review-1"))
+	review.Files = []entity.File{photo}
+	mockUC := &testutil.MockAccountUseCase{Export: &input.AccountExport{
+		User:       user,
+		Reviews:    []entity.Review{review},
+		Likes:      []entity.ReviewLike{{ReviewID: "review-2", UserID: "user-1"}},
+		Files:      []entity.File{photo},
+		ExportedAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}}
+	storage := &testutil.MockStorageProvider{SignedURLsByKey: map[string]string{
+		"reviews/file-1.jpg": "https://example.com/signed/file-1.jpg",
+	}}
+
+	err := handlers.NewAccountHandler(mockUC, storage, "test-bucket").ExportMe(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if disposition := tc.Recorder.Header().Get("Content-Disposition"); !strings.Contains(disposition, "account-export-20260301T120000Z.json") {
+		t.Errorf("unexpected Content-Disposition: %q", disposition)
+	}
+
+	var response struct {
+		User struct {
+			UserID string `json:"user_id"`
+		} `json:"user"`
+		Reviews []struct {
+			Files []struct {
+				URL *string `json:"url"`
+			} `json:"files"`
+		} `json:"reviews"`
+		Favorites []json.RawMessage `json:"favorites"`
+		Likes     []struct {
+			ReviewID string `json:"review_id"`
+		} `json:"likes"`
+		Files []struct {
+			URL *string `json:"url"`
+		} `json:"files"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if response.User.UserID != "user-1" || response.Favorites == nil || len(response.Likes) != 1 {
+		t.Errorf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+	if len(response.Files) != 1 || response.Files[0].URL == nil || *response.Files[0].URL != "https://example.com/signed/file-1.jpg" {
+		t.Errorf("expected signed file URL, got %s", tc.Recorder.Body.String())
+	}
+	if len(response.Reviews) != 1 || len(response.Reviews[0].Files) != 1 || response.Reviews[0].Files[0].URL == nil {
+		t.Errorf("expected signed review photo URL, got %s", tc.Recorder.Body.String())
+	}
+}
+
+func TestAccountHandler_ExportMe_UseCaseError(t *testing.T) {
+	user := testutil.NewTestUser()
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/api/users/me/export").SetUser(user, "user")
+	mockUC := &testutil.MockAccountUseCase{ExportErr: usecase.ErrUserNotFound}
+
+	err := handlers.NewAccountHandler(mockUC, nil, "").ExportMe(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrUserNotFound, "expected user not found error")
+}
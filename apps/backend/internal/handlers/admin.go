@@ -7,7 +7,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
-	"github.com/TeamH04/team-production/apps/backend/internal/presentation"
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
 	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
@@ -20,7 +20,9 @@ type AdminHandler struct {
 }
 
 type HandleReportCommand struct {
-	Action string
+	Action      string
+	Enforcement *string
+	Note        *string
 }
 
 func (c HandleReportCommand) Validate() error {
@@ -46,37 +48,76 @@ func (h *AdminHandler) GetPendingStores(c echo.Context) error {
 	return c.JSON(http.StatusOK, presenter.NewStoreResponses(stores))
 }
 
-func (h *AdminHandler) ApproveStore(c echo.Context) error {
+// SetStoreVisibility changes whether a store is published, pending or hidden.
+func (h *AdminHandler) SetStoreVisibility(c echo.Context) error {
+	admin, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
 	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
 	if err != nil {
 		return err
 	}
-	if err := h.adminUseCase.ApproveStore(c.Request().Context(), storeID); err != nil {
+	var dto setVisibilityDTO
+	if err := bindJSON(c, &dto); err != nil {
+		return err
+	}
+	store, err := h.adminUseCase.SetStoreVisibility(c.Request().Context(), admin, storeID, dto.Visibility)
+	if err != nil {
 		return err
 	}
-	return c.JSON(http.StatusOK, presentation.NewMessageResponse("store approved successfully"))
+	return c.JSON(http.StatusOK, presenter.NewStoreResponse(*store))
 }
 
-func (h *AdminHandler) RejectStore(c echo.Context) error {
-	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+// SetReviewVisibility changes whether a review is published, pending or hidden.
+func (h *AdminHandler) SetReviewVisibility(c echo.Context) error {
+	admin, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	reviewID, err := parseUUIDParam(c, "id", ErrMsgInvalidReviewID)
 	if err != nil {
 		return err
 	}
-	if err := h.adminUseCase.RejectStore(c.Request().Context(), storeID); err != nil {
+	var dto setVisibilityDTO
+	if err := bindJSON(c, &dto); err != nil {
 		return err
 	}
-	return c.JSON(http.StatusOK, presentation.NewMessageResponse("store rejected successfully"))
+	review, err := h.adminUseCase.SetReviewVisibility(c.Request().Context(), admin, reviewID, dto.Visibility)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewReviewResponse(*review))
 }
 
+// GetReports returns one page of reports, collapsed per target and status, newest first.
+// The cursor for the next page is sent in the X-Next-Cursor header.
 func (h *AdminHandler) GetReports(c echo.Context) error {
-	reports, err := h.reportUseCase.GetAllReports(c.Request().Context())
+	limit, err := parseIntQuery(c, "limit", "invalid limit")
+	if err != nil {
+		return err
+	}
+	page, err := h.reportUseCase.ListReports(c.Request().Context(), input.ListReportsQuery{
+		Status:     c.QueryParam("status"),
+		TargetType: c.QueryParam("target_type"),
+		Limit:      limit,
+		Cursor:     c.QueryParam("cursor"),
+	})
 	if err != nil {
 		return err
 	}
-	return c.JSON(http.StatusOK, presenter.NewReportResponses(reports))
+	if page.NextCursor != "" {
+		c.Response().Header().Set(infrahttp.HeaderNextCursor, page.NextCursor)
+	}
+	return c.JSON(http.StatusOK, presenter.NewReportResponses(page.Reports))
 }
 
+// HandleReport resolves or rejects a report together with the other pending reports on the same target.
 func (h *AdminHandler) HandleReport(c echo.Context) error {
+	admin, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
 	reportID, err := parseInt64Param(c, "id", "invalid report id")
 	if err != nil {
 		return err
@@ -89,18 +130,29 @@ func (h *AdminHandler) HandleReport(c echo.Context) error {
 	if err := cmd.Validate(); err != nil {
 		return err
 	}
-	if err := h.reportUseCase.HandleReport(c.Request().Context(), reportID, input.HandleReportAction(cmd.Action)); err != nil {
+	report, err := h.reportUseCase.HandleReport(c.Request().Context(), admin, reportID, input.HandleReportInput{
+		Action:      input.HandleReportAction(cmd.Action),
+		Enforcement: cmd.Enforcement,
+		Note:        cmd.Note,
+	})
+	if err != nil {
 		return err
 	}
-	return c.JSON(http.StatusOK, presentation.NewMessageResponse("report handled successfully"))
+	return c.JSON(http.StatusOK, presenter.NewReportResponse(*report))
 }
 
 func (h *AdminHandler) GetUserByID(c echo.Context) error {
 	return fetchAndRespondWithCurrentUser(c, h.userUseCase)
 }
 
+type setVisibilityDTO struct {
+	Visibility string `json:"visibility"`
+}
+
 type handleReportDTO struct {
-	Action string `json:"action"`
+	Action      string  `json:"action"`
+	Enforcement *string `json:"enforcement"`
+	Note        *string `json:"note"`
 }
 
 func (dto handleReportDTO) toCommand() HandleReportCommand {
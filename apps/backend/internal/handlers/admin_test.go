@@ -10,7 +10,10 @@ import (
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 )
 
 // --- GetPendingStores Tests ---
@@ -72,112 +75,102 @@ func TestAdminHandler_GetPendingStores_UseCaseError(t *testing.T) {
 	testutil.AssertError(t, err, "usecase error")
 }
 
-// --- ApproveStore Tests ---
+// --- Visibility Tests ---
 
-func TestAdminHandler_ApproveStore_Success(t *testing.T) {
+func TestAdminHandler_SetStoreVisibility_Success(t *testing.T) {
 	storeID := uuid.New().String()
-	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/stores/"+storeID+"/approve")
-	tc.SetPath("/admin/stores/:id/approve", []string{"id"}, []string{storeID})
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/admin/stores/"+storeID+"/visibility", `{"visibility":"hidden"}`)
+	tc.SetPath("/admin/stores/:id/visibility", []string{"id"}, []string{storeID})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
 
 	mockAdminUC := &testutil.MockAdminUseCase{}
-	mockReportUC := &testutil.MockReportUseCase{}
-	mockUserUC := &testutil.MockUserUseCase{}
-	h := handlers.NewAdminHandler(mockAdminUC, mockReportUC, mockUserUC)
+	h := handlers.NewAdminHandler(mockAdminUC, &testutil.MockReportUseCase{}, &testutil.MockUserUseCase{})
 
-	err := h.ApproveStore(tc.Context)
+	err := h.SetStoreVisibility(tc.Context)
 
 	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockAdminUC.VisibilityWith.ID != storeID || mockAdminUC.VisibilityWith.Visibility != "hidden" {
+		t.Errorf("unexpected call: %+v", mockAdminUC.VisibilityWith)
+	}
+	var response presenter.StoreResponse
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if response.Visibility != "hidden" || response.IsApproved {
+		t.Errorf("unexpected response: %+v", response)
+	}
 }
 
-func TestAdminHandler_ApproveStore_InvalidUUID(t *testing.T) {
-	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/stores/invalid-uuid/approve")
-	tc.SetPath("/admin/stores/:id/approve", []string{"id"}, []string{"invalid-uuid"})
+func TestAdminHandler_SetStoreVisibility_InvalidUUID(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/admin/stores/invalid-uuid/visibility", `{"visibility":"hidden"}`)
+	tc.SetPath("/admin/stores/:id/visibility", []string{"id"}, []string{"invalid-uuid"})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
 
-	mockAdminUC := &testutil.MockAdminUseCase{}
-	mockReportUC := &testutil.MockReportUseCase{}
-	mockUserUC := &testutil.MockUserUseCase{}
-	h := handlers.NewAdminHandler(mockAdminUC, mockReportUC, mockUserUC)
+	h := handlers.NewAdminHandler(&testutil.MockAdminUseCase{}, &testutil.MockReportUseCase{}, &testutil.MockUserUseCase{})
 
-	err := h.ApproveStore(tc.Context)
+	err := h.SetStoreVisibility(tc.Context)
 
 	testutil.AssertError(t, err, "invalid UUID")
 }
 
-func TestAdminHandler_ApproveStore_NotFound(t *testing.T) {
+func TestAdminHandler_SetStoreVisibility_InvalidVisibility(t *testing.T) {
 	storeID := uuid.New().String()
-	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/stores/"+storeID+"/approve")
-	tc.SetPath("/admin/stores/:id/approve", []string{"id"}, []string{storeID})
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/admin/stores/"+storeID+"/visibility", `{"visibility":"deleted"}`)
+	tc.SetPath("/admin/stores/:id/visibility", []string{"id"}, []string{storeID})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
 
-	mockAdminUC := &testutil.MockAdminUseCase{
-		ApproveErr: usecase.ErrStoreNotFound,
-	}
-	mockReportUC := &testutil.MockReportUseCase{}
-	mockUserUC := &testutil.MockUserUseCase{}
-	h := handlers.NewAdminHandler(mockAdminUC, mockReportUC, mockUserUC)
+	mockAdminUC := &testutil.MockAdminUseCase{VisibilityErr: usecase.ErrInvalidVisibility}
+	h := handlers.NewAdminHandler(mockAdminUC, &testutil.MockReportUseCase{}, &testutil.MockUserUseCase{})
 
-	err := h.ApproveStore(tc.Context)
+	err := h.SetStoreVisibility(tc.Context)
 
-	testutil.AssertError(t, err, "store not found")
+	testutil.AssertErrorIs(t, err, usecase.ErrInvalidVisibility, "invalid visibility")
 }
 
-// --- RejectStore Tests ---
-
-func TestAdminHandler_RejectStore_Success(t *testing.T) {
-	storeID := uuid.New().String()
-	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/stores/"+storeID+"/reject")
-	tc.SetPath("/admin/stores/:id/reject", []string{"id"}, []string{storeID})
+func TestAdminHandler_SetReviewVisibility_Success(t *testing.T) {
+	reviewID := uuid.New().String()
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/admin/reviews/"+reviewID+"/visibility", `{"visibility":"hidden"}`)
+	tc.SetPath("/admin/reviews/:id/visibility", []string{"id"}, []string{reviewID})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
 
 	mockAdminUC := &testutil.MockAdminUseCase{}
-	mockReportUC := &testutil.MockReportUseCase{}
-	mockUserUC := &testutil.MockUserUseCase{}
-	h := handlers.NewAdminHandler(mockAdminUC, mockReportUC, mockUserUC)
+	h := handlers.NewAdminHandler(mockAdminUC, &testutil.MockReportUseCase{}, &testutil.MockUserUseCase{})
 
-	err := h.RejectStore(tc.Context)
+	err := h.SetReviewVisibility(tc.Context)
 
 	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockAdminUC.VisibilityWith.ID != reviewID || mockAdminUC.VisibilityWith.Visibility != "hidden" {
+		t.Errorf("unexpected call: %+v", mockAdminUC.VisibilityWith)
+	}
 }
 
-func TestAdminHandler_RejectStore_InvalidUUID(t *testing.T) {
-	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/stores/invalid-uuid/reject")
-	tc.SetPath("/admin/stores/:id/reject", []string{"id"}, []string{"invalid-uuid"})
-
-	mockAdminUC := &testutil.MockAdminUseCase{}
-	mockReportUC := &testutil.MockReportUseCase{}
-	mockUserUC := &testutil.MockUserUseCase{}
-	h := handlers.NewAdminHandler(mockAdminUC, mockReportUC, mockUserUC)
-
-	err := h.RejectStore(tc.Context)
-
-	testutil.AssertError(t, err, "invalid UUID")
-}
-
-func TestAdminHandler_RejectStore_NotFound(t *testing.T) {
-	storeID := uuid.New().String()
-	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/stores/"+storeID+"/reject")
-	tc.SetPath("/admin/stores/:id/reject", []string{"id"}, []string{storeID})
+func TestAdminHandler_SetReviewVisibility_NotFound(t *testing.T) {
+	reviewID := uuid.New().String()
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/admin/reviews/"+reviewID+"/visibility", `{"visibility":"hidden"}`)
+	tc.SetPath("/admin/reviews/:id/visibility", []string{"id"}, []string{reviewID})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
 
-	mockAdminUC := &testutil.MockAdminUseCase{
-		RejectErr: usecase.ErrStoreNotFound,
-	}
-	mockReportUC := &testutil.MockReportUseCase{}
-	mockUserUC := &testutil.MockUserUseCase{}
-	h := handlers.NewAdminHandler(mockAdminUC, mockReportUC, mockUserUC)
+	mockAdminUC := &testutil.MockAdminUseCase{VisibilityErr: usecase.ErrReviewNotFound}
+	h := handlers.NewAdminHandler(mockAdminUC, &testutil.MockReportUseCase{}, &testutil.MockUserUseCase{})
 
-	err := h.RejectStore(tc.Context)
+	err := h.SetReviewVisibility(tc.Context)
 
-	testutil.AssertError(t, err, "store not found")
+	testutil.AssertErrorIs(t, err, usecase.ErrReviewNotFound, "review not found")
 }
 
 // --- GetReports Tests ---
 
 func TestAdminHandler_GetReports_Success(t *testing.T) {
-	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/reports")
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/reports?status=pending&target_type=review&limit=10&cursor=abc")
 
 	mockAdminUC := &testutil.MockAdminUseCase{}
 	mockReportUC := &testutil.MockReportUseCase{
-		GetAllResult: []entity.Report{
-			{ReportID: 1, TargetType: "review"},
-			{ReportID: 2, TargetType: "store"},
+		ListResult: &input.ReportPage{
+			Reports: []entity.Report{
+				{ReportID: 2, TargetType: "review", ReportCount: 3},
+				{ReportID: 1, TargetType: "store", ReportCount: 1},
+			},
+			NextCursor: "next",
 		},
 	}
 	mockUserUC := &testutil.MockUserUseCase{}
@@ -186,21 +179,46 @@ func TestAdminHandler_GetReports_Success(t *testing.T) {
 	err := h.GetReports(tc.Context)
 
 	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	want := input.ListReportsQuery{Status: "pending", TargetType: "review", Limit: 10, Cursor: "abc"}
+	if mockReportUC.ListCalledWith != want {
+		t.Errorf("expected query %+v, got %+v", want, mockReportUC.ListCalledWith)
+	}
+	if got := tc.Recorder.Header().Get(infrahttp.HeaderNextCursor); got != "next" {
+		t.Errorf("expected next cursor header %q, got %q", "next", got)
+	}
+	var response []presenter.ReportResponse
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(response) != 2 || response[0].ReportCount != 3 {
+		t.Errorf("unexpected response: %+v", response)
+	}
 }
 
 func TestAdminHandler_GetReports_Empty(t *testing.T) {
 	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/reports")
 
 	mockAdminUC := &testutil.MockAdminUseCase{}
-	mockReportUC := &testutil.MockReportUseCase{
-		GetAllResult: []entity.Report{},
-	}
+	mockReportUC := &testutil.MockReportUseCase{}
 	mockUserUC := &testutil.MockUserUseCase{}
 	h := handlers.NewAdminHandler(mockAdminUC, mockReportUC, mockUserUC)
 
 	err := h.GetReports(tc.Context)
 
 	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if tc.Recorder.Header().Get(infrahttp.HeaderNextCursor) != "" {
+		t.Error("expected no next cursor header")
+	}
+}
+
+func TestAdminHandler_GetReports_InvalidLimit(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/reports?limit=abc")
+
+	h := handlers.NewAdminHandler(&testutil.MockAdminUseCase{}, &testutil.MockReportUseCase{}, &testutil.MockUserUseCase{})
+
+	err := h.GetReports(tc.Context)
+
+	testutil.AssertError(t, err, "invalid limit")
 }
 
 func TestAdminHandler_GetReports_UseCaseError(t *testing.T) {
@@ -208,7 +226,7 @@ func TestAdminHandler_GetReports_UseCaseError(t *testing.T) {
 
 	mockAdminUC := &testutil.MockAdminUseCase{}
 	mockReportUC := &testutil.MockReportUseCase{
-		GetAllErr: usecase.ErrInvalidInput,
+		ListErr: usecase.ErrInvalidInput,
 	}
 	mockUserUC := &testutil.MockUserUseCase{}
 	h := handlers.NewAdminHandler(mockAdminUC, mockReportUC, mockUserUC)
@@ -220,23 +238,58 @@ func TestAdminHandler_GetReports_UseCaseError(t *testing.T) {
 
 // --- HandleReport Tests ---
 
+var testReportAdmin = entity.User{UserID: "admin-1", Role: "admin"}
+
 func TestAdminHandler_HandleReport_Success(t *testing.T) {
-	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/reports/1/handle", `{"action":"resolve"}`)
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/reports/1/handle",
+		`{"action":"resolve","enforcement":"delete_review","note":"spam"}`)
 	tc.SetPath("/admin/reports/:id/handle", []string{"id"}, []string{"1"})
+	tc.SetUser(testReportAdmin, "admin")
 
 	mockAdminUC := &testutil.MockAdminUseCase{}
-	mockReportUC := &testutil.MockReportUseCase{}
+	mockReportUC := &testutil.MockReportUseCase{
+		HandleResult: &entity.Report{ReportID: 1, Status: "resolved", ReportCount: 2},
+	}
 	mockUserUC := &testutil.MockUserUseCase{}
 	h := handlers.NewAdminHandler(mockAdminUC, mockReportUC, mockUserUC)
 
 	err := h.HandleReport(tc.Context)
 
 	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	called := mockReportUC.HandleCalledWith
+	if called.Admin.UserID != testReportAdmin.UserID || called.ReportID != 1 || called.Input.Action != input.HandleReportResolve {
+		t.Errorf("unexpected call: %+v", called)
+	}
+	if called.Input.Enforcement == nil || *called.Input.Enforcement != "delete_review" {
+		t.Errorf("expected enforcement to be passed, got %v", called.Input.Enforcement)
+	}
+	if called.Input.Note == nil || *called.Input.Note != "spam" {
+		t.Errorf("expected note to be passed, got %v", called.Input.Note)
+	}
+	var response presenter.ReportResponse
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if response.Status != "resolved" || response.ReportCount != 2 {
+		t.Errorf("unexpected response: %+v", response)
+	}
+}
+
+func TestAdminHandler_HandleReport_Unauthorized(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/reports/1/handle", `{"action":"resolve"}`)
+	tc.SetPath("/admin/reports/:id/handle", []string{"id"}, []string{"1"})
+
+	h := handlers.NewAdminHandler(&testutil.MockAdminUseCase{}, &testutil.MockReportUseCase{}, &testutil.MockUserUseCase{})
+
+	err := h.HandleReport(tc.Context)
+
+	testutil.AssertError(t, err, "unauthorized")
 }
 
 func TestAdminHandler_HandleReport_InvalidID(t *testing.T) {
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/reports/invalid/handle", `{"action":"resolve"}`)
 	tc.SetPath("/admin/reports/:id/handle", []string{"id"}, []string{"invalid"})
+	tc.SetUser(testReportAdmin, "admin")
 
 	mockAdminUC := &testutil.MockAdminUseCase{}
 	mockReportUC := &testutil.MockReportUseCase{}
@@ -251,6 +304,7 @@ func TestAdminHandler_HandleReport_InvalidID(t *testing.T) {
 func TestAdminHandler_HandleReport_InvalidJSON(t *testing.T) {
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/reports/1/handle", `{invalid}`)
 	tc.SetPath("/admin/reports/:id/handle", []string{"id"}, []string{"1"})
+	tc.SetUser(testReportAdmin, "admin")
 
 	mockAdminUC := &testutil.MockAdminUseCase{}
 	mockReportUC := &testutil.MockReportUseCase{}
@@ -265,6 +319,7 @@ func TestAdminHandler_HandleReport_InvalidJSON(t *testing.T) {
 func TestAdminHandler_HandleReport_EmptyAction(t *testing.T) {
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/reports/1/handle", `{"action":""}`)
 	tc.SetPath("/admin/reports/:id/handle", []string{"id"}, []string{"1"})
+	tc.SetUser(testReportAdmin, "admin")
 
 	mockAdminUC := &testutil.MockAdminUseCase{}
 	mockReportUC := &testutil.MockReportUseCase{}
@@ -279,6 +334,7 @@ func TestAdminHandler_HandleReport_EmptyAction(t *testing.T) {
 func TestAdminHandler_HandleReport_UseCaseError(t *testing.T) {
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/reports/1/handle", `{"action":"resolve"}`)
 	tc.SetPath("/admin/reports/:id/handle", []string{"id"}, []string{"1"})
+	tc.SetUser(testReportAdmin, "admin")
 
 	mockAdminUC := &testutil.MockAdminUseCase{}
 	mockReportUC := &testutil.MockReportUseCase{
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+// AdminUserHandler serves the admin user management endpoints.
+type AdminUserHandler struct {
+	adminUserUseCase input.AdminUserUseCase
+}
+
+func NewAdminUserHandler(adminUserUseCase input.AdminUserUseCase) *AdminUserHandler {
+	return &AdminUserHandler{adminUserUseCase: adminUserUseCase}
+}
+
+// ListUsers searches users by email or name, newest first.
+// The cursor for the next page is sent in the X-Next-Cursor header.
+func (h *AdminUserHandler) ListUsers(c echo.Context) error {
+	limit, err := parseIntQuery(c, "limit", "invalid limit")
+	if err != nil {
+		return err
+	}
+	page, err := h.adminUserUseCase.ListUsers(c.Request().Context(), input.ListUsersQuery{
+		Query:  c.QueryParam("q"),
+		Role:   c.QueryParam("role"),
+		Limit:  limit,
+		Cursor: c.QueryParam("cursor"),
+	})
+	if err != nil {
+		return err
+	}
+	if page.NextCursor != "" {
+		c.Response().Header().Set(infrahttp.HeaderNextCursor, page.NextCursor)
+	}
+	return c.JSON(http.StatusOK, presenter.NewUserResponses(page.Users))
+}
+
+// SuspendUser suspends a user until the given time, or bans them when no time is given.
+func (h *AdminUserHandler) SuspendUser(c echo.Context) error {
+	admin, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	var dto suspendUserDTO
+	if err := bindJSON(c, &dto); err != nil {
+		return err
+	}
+	user, err := h.adminUserUseCase.SuspendUser(c.Request().Context(), admin, c.Param("id"), input.SuspendUserInput(dto))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewUserResponse(*user))
+}
+
+func (h *AdminUserHandler) UnsuspendUser(c echo.Context) error {
+	admin, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	var dto unsuspendUserDTO
+	if err := bindJSON(c, &dto); err != nil {
+		return err
+	}
+	user, err := h.adminUserUseCase.UnsuspendUser(c.Request().Context(), admin, c.Param("id"), input.UnsuspendUserInput(dto))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewUserResponse(*user))
+}
+
+func (h *AdminUserHandler) ChangeUserRole(c echo.Context) error {
+	admin, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	var dto changeUserRoleDTO
+	if err := bindJSON(c, &dto); err != nil {
+		return err
+	}
+	user, err := h.adminUserUseCase.ChangeUserRole(c.Request().Context(), admin, c.Param("id"), input.ChangeUserRoleInput(dto))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewUserResponse(*user))
+}
+
+// GetModerationHistory returns the admin actions taken on a user, newest first.
+func (h *AdminUserHandler) GetModerationHistory(c echo.Context) error {
+	events, err := h.adminUserUseCase.GetModerationHistory(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewUserModerationEventResponses(events))
+}
+
+type suspendUserDTO struct {
+	Until  *time.Time `json:"until"`
+	Reason string     `json:"reason"`
+}
+
+type unsuspendUserDTO struct {
+	Reason *string `json:"reason"`
+}
+
+type changeUserRoleDTO struct {
+	Role   string  `json:"role"`
+	Reason *string `json:"reason"`
+}
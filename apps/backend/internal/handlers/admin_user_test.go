@@ -0,0 +1,171 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+// --- ListUsers Tests ---
+
+func TestAdminUserHandler_ListUsers_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/users?q=alice&role=owner&limit=10&cursor=abc")
+	mockUC := &testutil.MockAdminUserUseCase{Page: &input.UserPage{
+		Users:      []entity.User{{UserID: "user-1", Email: "alice@example.com"}},
+		NextCursor: "next",
+	}}
+
+	err := handlers.NewAdminUserHandler(mockUC).ListUsers(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	want := input.ListUsersQuery{Query: "alice", Role: "owner", Limit: 10, Cursor: "abc"}
+	if mockUC.ListCalledWith != want {
+		t.Errorf("expected query %+v, got %+v", want, mockUC.ListCalledWith)
+	}
+	if got := tc.Recorder.Header().Get(infrahttp.HeaderNextCursor); got != "next" {
+		t.Errorf("expected next cursor header, got %q", got)
+	}
+}
+
+func TestAdminUserHandler_ListUsers_InvalidLimit(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/users?limit=abc")
+
+	err := handlers.NewAdminUserHandler(&testutil.MockAdminUserUseCase{}).ListUsers(tc.Context)
+
+	testutil.AssertError(t, err, "expected error for invalid limit")
+}
+
+// --- SuspendUser Tests ---
+
+func TestAdminUserHandler_SuspendUser_Success(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/users/user-1/suspend", `{"until":"2030-01-02T03:04:05Z","reason":"spam"}`)
+	tc.SetPath("/admin/users/:id/suspend", []string{"id"}, []string{"user-1"})
+	admin := entity.User{UserID: "admin-1"}
+	tc.SetUser(admin, "admin")
+
+	mockUC := &testutil.MockAdminUserUseCase{}
+	err := handlers.NewAdminUserHandler(mockUC).SuspendUser(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.CalledWith.UserID != "user-1" || mockUC.CalledWith.Admin.UserID != admin.UserID {
+		t.Errorf("unexpected call: %+v", mockUC.CalledWith)
+	}
+	if mockUC.SuspendInput.Reason != "spam" {
+		t.Errorf("expected reason spam, got %q", mockUC.SuspendInput.Reason)
+	}
+	if mockUC.SuspendInput.Until == nil || mockUC.SuspendInput.Until.Year() != 2030 {
+		t.Errorf("expected expiry to be parsed, got %v", mockUC.SuspendInput.Until)
+	}
+}
+
+func TestAdminUserHandler_SuspendUser_Unauthorized(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/users/user-1/suspend", `{"reason":"spam"}`)
+	tc.SetPath("/admin/users/:id/suspend", []string{"id"}, []string{"user-1"})
+
+	err := handlers.NewAdminUserHandler(&testutil.MockAdminUserUseCase{}).SuspendUser(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrUnauthorized, "expected unauthorized error")
+}
+
+func TestAdminUserHandler_SuspendUser_InvalidJSON(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/users/user-1/suspend", `{"until":"tomorrow"}`)
+	tc.SetPath("/admin/users/:id/suspend", []string{"id"}, []string{"user-1"})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+
+	err := handlers.NewAdminUserHandler(&testutil.MockAdminUserUseCase{}).SuspendUser(tc.Context)
+
+	testutil.AssertError(t, err, "expected error for invalid until")
+}
+
+// --- UnsuspendUser Tests ---
+
+func TestAdminUserHandler_UnsuspendUser_NotSuspended(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/users/user-1/unsuspend")
+	tc.SetPath("/admin/users/:id/unsuspend", []string{"id"}, []string{"user-1"})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+
+	mockUC := &testutil.MockAdminUserUseCase{Err: usecase.ErrUserNotSuspended}
+	err := handlers.NewAdminUserHandler(mockUC).UnsuspendUser(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrUserNotSuspended, "expected conflict error")
+	if mockUC.CalledWith.Action != "unsuspend" {
+		t.Errorf("expected unsuspend, got %s", mockUC.CalledWith.Action)
+	}
+}
+
+// --- ChangeUserRole Tests ---
+
+func TestAdminUserHandler_ChangeUserRole_Success(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/admin/users/user-1/role", `{"role":"owner","reason":"verified"}`)
+	tc.SetPath("/admin/users/:id/role", []string{"id"}, []string{"user-1"})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+
+	mockUC := &testutil.MockAdminUserUseCase{User: &entity.User{UserID: "user-1", Role: "owner"}}
+	err := handlers.NewAdminUserHandler(mockUC).ChangeUserRole(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.RoleInput.Role != "owner" {
+		t.Errorf("expected role owner, got %q", mockUC.RoleInput.Role)
+	}
+	if mockUC.RoleInput.Reason == nil || *mockUC.RoleInput.Reason != "verified" {
+		t.Errorf("expected reason to be passed, got %v", mockUC.RoleInput.Reason)
+	}
+
+	var response struct {
+		Role string `json:"role"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if response.Role != "owner" {
+		t.Errorf("expected role owner, got %q", response.Role)
+	}
+}
+
+func TestAdminUserHandler_ChangeUserRole_Self(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/admin/users/admin-1/role", `{"role":"user"}`)
+	tc.SetPath("/admin/users/:id/role", []string{"id"}, []string{"admin-1"})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+
+	mockUC := &testutil.MockAdminUserUseCase{Err: usecase.ErrCannotModerateSelf}
+	err := handlers.NewAdminUserHandler(mockUC).ChangeUserRole(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrCannotModerateSelf, "expected forbidden error")
+}
+
+// --- GetModerationHistory Tests ---
+
+func TestAdminUserHandler_GetModerationHistory_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/users/user-1/moderation-history")
+	tc.SetPath("/admin/users/:id/moderation-history", []string{"id"}, []string{"user-1"})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+
+	mockUC := &testutil.MockAdminUserUseCase{Events: []entity.UserModerationEvent{
+		{EventID: 1, UserID: "user-1", AdminID: testutil.StringPtr("admin-1"), Action: constants.ModerationActionBan, Reason: testutil.StringPtr("fraud")},
+	}}
+	err := handlers.NewAdminUserHandler(mockUC).GetModerationHistory(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.HistoryCalledWith != "user-1" {
+		t.Errorf("expected history of user-1, got %q", mockUC.HistoryCalledWith)
+	}
+
+	var response []struct {
+		Action  string  `json:"action"`
+		AdminID *string `json:"admin_id"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if len(response) != 1 || response[0].Action != constants.ModerationActionBan || response[0].AdminID == nil {
+		t.Errorf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+}
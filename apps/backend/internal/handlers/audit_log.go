@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+// AuditLogHandler serves the admin audit log endpoint.
+type AuditLogHandler struct {
+	auditLogUseCase input.AuditLogUseCase
+}
+
+func NewAuditLogHandler(auditLogUseCase input.AuditLogUseCase) *AuditLogHandler {
+	return &AuditLogHandler{auditLogUseCase: auditLogUseCase}
+}
+
+// ListAuditLogs returns one page of audit logs, newest first.
+// The cursor for the next page is sent in the X-Next-Cursor header.
+func (h *AuditLogHandler) ListAuditLogs(c echo.Context) error {
+	limit, err := parseIntQuery(c, "limit", "invalid limit")
+	if err != nil {
+		return err
+	}
+	from, err := parseOptionalDateQuery(c, "from", "invalid from")
+	if err != nil {
+		return err
+	}
+	to, err := parseOptionalDateQuery(c, "to", "invalid to")
+	if err != nil {
+		return err
+	}
+	page, err := h.auditLogUseCase.ListAuditLogs(c.Request().Context(), input.ListAuditLogsQuery{
+		ActorID:    c.QueryParam("actor_id"),
+		Action:     c.QueryParam("action"),
+		TargetType: c.QueryParam("target_type"),
+		TargetID:   c.QueryParam("target_id"),
+		From:       from,
+		To:         to,
+		Limit:      limit,
+		Cursor:     c.QueryParam("cursor"),
+	})
+	if err != nil {
+		return err
+	}
+	if page.NextCursor != "" {
+		c.Response().Header().Set(infrahttp.HeaderNextCursor, page.NextCursor)
+	}
+	return c.JSON(http.StatusOK, presenter.NewAuditLogResponses(page.Logs))
+}
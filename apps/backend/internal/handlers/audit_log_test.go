@@ -0,0 +1,80 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+func TestAuditLogHandler_ListAuditLogs_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet,
+		"/admin/audit-logs?actor_id=admin-1&action=store.approve&target_type=store&target_id=store-1&from=2026-01-01&to=2026-02-01T00:00:00Z&limit=20&cursor=abc")
+	mockUC := &testutil.MockAuditLogUseCase{Page: &input.AuditLogPage{
+		Logs: []entity.AuditLog{{
+			AuditID:    1,
+			ActorID:    testutil.StringPtr("admin-1"),
+			ActorRole:  "admin",
+			Action:     constants.AuditActionStoreApprove,
+			TargetType: constants.AuditTargetStore,
+			TargetID:   "store-1",
+			After:      json.RawMessage(`{"approval_status":"approved"}`),
+			RequestID:  testutil.StringPtr("req-1"),
+		}},
+		NextCursor: "next",
+	}}
+
+	err := handlers.NewAuditLogHandler(mockUC).ListAuditLogs(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	got := mockUC.CalledWith
+	if got.ActorID != "admin-1" || got.Action != constants.AuditActionStoreApprove || got.TargetType != "store" || got.TargetID != "store-1" {
+		t.Errorf("unexpected query: %+v", got)
+	}
+	if got.Limit != 20 || got.Cursor != "abc" || got.From == nil || got.To == nil || got.To.Month() != 2 {
+		t.Errorf("unexpected query: %+v", got)
+	}
+	if header := tc.Recorder.Header().Get(infrahttp.HeaderNextCursor); header != "next" {
+		t.Errorf("expected next cursor header, got %q", header)
+	}
+
+	var response []struct {
+		Action    string          `json:"action"`
+		Before    json.RawMessage `json:"before"`
+		After     json.RawMessage `json:"after"`
+		RequestID *string         `json:"request_id"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if len(response) != 1 || response[0].Before != nil || string(response[0].After) != `{"approval_status":"approved"}` {
+		t.Errorf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+	if response[0].RequestID == nil || *response[0].RequestID != "req-1" {
+		t.Errorf("expected request id, got %v", response[0].RequestID)
+	}
+}
+
+func TestAuditLogHandler_ListAuditLogs_InvalidFrom(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/audit-logs?from=yesterday")
+
+	err := handlers.NewAuditLogHandler(&testutil.MockAuditLogUseCase{}).ListAuditLogs(tc.Context)
+
+	testutil.AssertError(t, err, "expected error for invalid from")
+}
+
+func TestAuditLogHandler_ListAuditLogs_UseCaseError(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/audit-logs?target_type=menu")
+	mockUC := &testutil.MockAuditLogUseCase{Err: usecase.ErrInvalidTargetType}
+
+	err := handlers.NewAuditLogHandler(mockUC).ListAuditLogs(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrInvalidTargetType, "expected invalid target type error")
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/config"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
@@ -37,6 +38,18 @@ func attachSignedURLsToFileResponses(
 	applySignedURLsToFiles(files, urlByKey)
 }
 
+// signedFileURL returns a signed download URL for the file, or nil if it cannot be signed.
+func signedFileURL(
+	ctx context.Context,
+	storage output.StorageProvider,
+	bucket string,
+	file entity.File,
+) *string {
+	files := []presenter.FileResponse{presenter.NewFileResponse(file)}
+	attachSignedURLsToFileResponses(ctx, storage, bucket, files)
+	return files[0].URL
+}
+
 func normalizeAndSignImageURLs(
 	ctx context.Context,
 	storage output.StorageProvider,
@@ -86,6 +99,49 @@ func attachSignedURLsToStoreResponses(
 		}
 
 		stores[i].ImageUrls = normalizeAndSignImageURLs(ctx, storage, bucket, stores[i].ImageUrls)
+		attachSignedURLsToStorePhotoResponses(ctx, storage, bucket, stores[i].Photos)
+		attachSignedURLsToMenuResponses(ctx, storage, bucket, stores[i].Menus)
+	}
+}
+
+// attachSignedURLsToStorePhotoResponses signs the files of store gallery photos.
+func attachSignedURLsToStorePhotoResponses(
+	ctx context.Context,
+	storage output.StorageProvider,
+	bucket string,
+	photos []presenter.StorePhotoResponse,
+) {
+	if !isStorageAvailable(storage, bucket) || len(photos) == 0 {
+		return
+	}
+
+	files := make([]presenter.FileResponse, len(photos))
+	for i := range photos {
+		files[i] = photos[i].File
+	}
+	attachSignedURLsToFileResponses(ctx, storage, bucket, files)
+	for i := range photos {
+		photos[i].File = files[i]
+	}
+}
+
+func attachSignedURLsToMenuResponses(
+	ctx context.Context,
+	storage output.StorageProvider,
+	bucket string,
+	menus []presenter.MenuResponse,
+) {
+	if !isStorageAvailable(storage, bucket) || len(menus) == 0 {
+		return
+	}
+
+	for i := range menus {
+		if menus[i].ImageFile == nil {
+			continue
+		}
+		files := []presenter.FileResponse{*menus[i].ImageFile}
+		attachSignedURLsToFileResponses(ctx, storage, bucket, files)
+		menus[i].ImageFile = &files[0]
 	}
 }
 
@@ -114,20 +170,72 @@ func attachSignedURLsToReviewResponses(
 	applySignedURLsToReviews(reviews, urlByKey)
 }
 
+// attachSignedURLsToSearchResults signs the thumbnails of the stores and the files of the reviews in search results.
+func attachSignedURLsToSearchResults(
+	ctx context.Context,
+	storage output.StorageProvider,
+	bucket string,
+	results []presenter.SearchResultResponse,
+) {
+	if !isStorageAvailable(storage, bucket) || len(results) == 0 {
+		return
+	}
+
+	for i := range results {
+		if results[i].Store != nil {
+			stores := []presenter.StoreResponse{*results[i].Store}
+			attachSignedURLsToStoreResponses(ctx, storage, bucket, stores)
+			results[i].Store = &stores[0]
+		}
+		if results[i].Review != nil {
+			reviews := []presenter.ReviewResponse{*results[i].Review}
+			attachSignedURLsToReviewResponses(ctx, storage, bucket, reviews)
+			results[i].Review = &reviews[0]
+		}
+	}
+}
+
+// attachSignedURLsToVisitResponses signs the thumbnails of the visited stores.
+func attachSignedURLsToVisitResponses(
+	ctx context.Context,
+	storage output.StorageProvider,
+	bucket string,
+	visits []presenter.VisitResponse,
+) {
+	if !isStorageAvailable(storage, bucket) || len(visits) == 0 {
+		return
+	}
+
+	for i := range visits {
+		if visits[i].Store != nil {
+			stores := []presenter.StoreResponse{*visits[i].Store}
+			attachSignedURLsToStoreResponses(ctx, storage, bucket, stores)
+			visits[i].Store = &stores[0]
+		}
+	}
+}
+
+// collectObjectKeys returns the object keys of the files and their image variants.
 func collectObjectKeys(files []presenter.FileResponse) []string {
 	keys := make([]string, 0, len(files))
 	seen := make(map[string]struct{}, len(files))
-	for _, f := range files {
-		key := strings.TrimSpace(f.ObjectKey)
+	add := func(key string) {
+		key = strings.TrimSpace(key)
 		if key == "" {
-			continue
+			return
 		}
 		if _, ok := seen[key]; ok {
-			continue
+			return
 		}
 		seen[key] = struct{}{}
 		keys = append(keys, key)
 	}
+	for _, f := range files {
+		add(f.ObjectKey)
+		for _, v := range f.Variants {
+			add(v.ObjectKey)
+		}
+	}
 	return keys
 }
 
@@ -167,14 +275,16 @@ func buildSignedURLMap(
 
 func applySignedURLsToFiles(files []presenter.FileResponse, urlByKey map[string]string) {
 	for i := range files {
-		key := files[i].ObjectKey
-		if key == "" {
-			continue
-		}
-		if url, ok := urlByKey[key]; ok {
+		if url, ok := urlByKey[files[i].ObjectKey]; ok && files[i].ObjectKey != "" {
 			u := url
 			files[i].URL = &u
 		}
+		for j := range files[i].Variants {
+			if url, ok := urlByKey[files[i].Variants[j].ObjectKey]; ok {
+				u := url
+				files[i].Variants[j].URL = &u
+			}
+		}
 	}
 }
 
@@ -207,14 +317,20 @@ func collectReviewFiles(reviews []presenter.ReviewResponse) []presenter.FileResp
 
 func buildFileURLPointerMap(files []presenter.FileResponse) map[string]*string {
 	urlByKey := make(map[string]*string, len(files))
-	for i := range files {
-		if files[i].ObjectKey == "" || files[i].URL == nil || *files[i].URL == "" {
-			continue
+	add := func(key string, url *string) {
+		if key == "" || url == nil || *url == "" {
+			return
 		}
-		if _, ok := urlByKey[files[i].ObjectKey]; ok {
-			continue
+		if _, ok := urlByKey[key]; ok {
+			return
+		}
+		urlByKey[key] = url
+	}
+	for i := range files {
+		add(files[i].ObjectKey, files[i].URL)
+		for _, v := range files[i].Variants {
+			add(v.ObjectKey, v.URL)
 		}
-		urlByKey[files[i].ObjectKey] = files[i].URL
 	}
 	return urlByKey
 }
@@ -229,6 +345,12 @@ func applySignedURLsToReviews(reviews []presenter.ReviewResponse, urlByKey map[s
 			if u, ok := urlByKey[key]; ok && u != nil && *u != "" {
 				reviews[i].Files[j].URL = u
 			}
+			variants := reviews[i].Files[j].Variants
+			for k := range variants {
+				if u, ok := urlByKey[variants[k].ObjectKey]; ok {
+					variants[k].URL = u
+				}
+			}
 		}
 	}
 }
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
 	"time"
 
@@ -32,6 +33,22 @@ func (m *mockStorageProvider) CreateSignedUpload(ctx context.Context, bucket, ob
 	return nil, nil
 }
 
+func (m *mockStorageProvider) StatObject(ctx context.Context, bucket, objectPath string) (*output.ObjectInfo, error) {
+	return nil, output.ErrObjectNotFound
+}
+
+func (m *mockStorageProvider) DeleteObjects(ctx context.Context, bucket string, objectPaths []string) error {
+	return nil
+}
+
+func (m *mockStorageProvider) GetObject(ctx context.Context, bucket, objectPath string) (io.ReadCloser, error) {
+	return nil, output.ErrObjectNotFound
+}
+
+func (m *mockStorageProvider) PutObject(ctx context.Context, bucket, objectPath, contentType string, content []byte) error {
+	return nil
+}
+
 func (m *mockStorageProvider) CreateSignedDownload(ctx context.Context, bucket, objectPath string, expiresIn time.Duration) (*output.SignedDownload, error) {
 	if m.errorsByKey != nil {
 		if err, ok := m.errorsByKey[objectPath]; ok {
@@ -534,6 +551,28 @@ func TestApplySignedURLsToFiles_AppliesURLs(t *testing.T) {
 	}
 }
 
+func TestApplySignedURLsToFiles_AppliesVariantURLs(t *testing.T) {
+	files := []presenter.FileResponse{
+		{FileID: "file-1", ObjectKey: testKey1, Variants: []presenter.FileVariantResponse{
+			{Width: 200, ObjectKey: testKey2},
+			{Width: 600, ObjectKey: "missing"},
+		}},
+	}
+	urlByKey := map[string]string{
+		testKey1: testURL1,
+		testKey2: testURL2,
+	}
+
+	applySignedURLsToFiles(files, urlByKey)
+
+	if files[0].Variants[0].URL == nil || *files[0].Variants[0].URL != testURL2 {
+		t.Errorf("expected variant URL to be set, got %v", files[0].Variants[0].URL)
+	}
+	if files[0].Variants[1].URL != nil {
+		t.Errorf("expected unsigned variant URL to remain nil, got %v", *files[0].Variants[1].URL)
+	}
+}
+
 // --- applySignedURLsToImages Tests ---
 
 func TestApplySignedURLsToImages_EmptySlice(t *testing.T) {
@@ -813,6 +852,30 @@ func TestApplySignedURLsToReviews_AppliesURLs(t *testing.T) {
 	}
 }
 
+func TestAttachSignedURLsToReviewResponses_SignsVariants(t *testing.T) {
+	storage := &mockStorageProvider{
+		signedURLs: map[string]string{
+			testKey1: testSignedURL1,
+			testKey2: testSignedURL2,
+		},
+	}
+	reviews := []presenter.ReviewResponse{
+		{
+			ReviewID: "review-1",
+			Files: []presenter.FileResponse{
+				{FileID: "file-1", ObjectKey: testKey1, Variants: []presenter.FileVariantResponse{{Width: 200, ObjectKey: testKey2}}},
+			},
+		},
+	}
+
+	attachSignedURLsToReviewResponses(context.Background(), storage, "bucket", reviews)
+
+	variant := reviews[0].Files[0].Variants[0]
+	if variant.URL == nil || *variant.URL != testSignedURL2 {
+		t.Errorf("expected variant URL to be signed, got %v", variant.URL)
+	}
+}
+
 // --- attachSignedURLsToReviewResponses Tests ---
 
 func TestAttachSignedURLsToReviewResponses_NilStorage(t *testing.T) {
@@ -923,3 +986,38 @@ func TestAttachSignedURLsToReviewResponses_StorageError_AllFail(t *testing.T) {
 		t.Error("expected URL to remain nil when storage fails for all keys")
 	}
 }
+
+// --- attachSignedURLsToMenuResponses Tests ---
+func TestAttachSignedURLsToMenuResponses_SignsPhotos(t *testing.T) {
+	storage := &mockStorageProvider{signedURLs: map[string]string{testKey1: testSignedURL1}}
+	menus := []presenter.MenuResponse{
+		{MenuID: "menu-1", ImageFile: &presenter.FileResponse{FileID: "file-1", ObjectKey: testKey1}},
+		{MenuID: "menu-2"},
+	}
+
+	attachSignedURLsToMenuResponses(context.Background(), storage, "bucket", menus)
+
+	if menus[0].ImageFile.URL == nil || *menus[0].ImageFile.URL != testSignedURL1 {
+		t.Errorf("expected menu photo URL to be signed, got %v", menus[0].ImageFile.URL)
+	}
+	if menus[1].ImageFile != nil {
+		t.Errorf("expected menu without photo to stay without photo, got %v", menus[1].ImageFile)
+	}
+}
+
+func TestAttachSignedURLsToStoreResponses_SignsMenuPhotos(t *testing.T) {
+	storage := &mockStorageProvider{signedURLs: map[string]string{testKey2: testSignedURL2}}
+	stores := []presenter.StoreResponse{{
+		StoreID: "store-1",
+		Menus: []presenter.MenuResponse{
+			{MenuID: "menu-1", ImageFile: &presenter.FileResponse{FileID: "file-2", ObjectKey: testKey2}},
+		},
+	}}
+
+	attachSignedURLsToStoreResponses(context.Background(), storage, "bucket", stores)
+
+	got := stores[0].Menus[0].ImageFile.URL
+	if got == nil || *got != testSignedURL2 {
+		t.Errorf("expected nested menu photo URL to be signed, got %v", got)
+	}
+}
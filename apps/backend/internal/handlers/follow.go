@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type FollowHandler struct {
+	followUseCase input.FollowUseCase
+	storage       output.StorageProvider
+	bucket        string
+}
+
+func NewFollowHandler(followUseCase input.FollowUseCase, storage output.StorageProvider, bucket string) *FollowHandler {
+	return &FollowHandler{
+		followUseCase: followUseCase,
+		storage:       storage,
+		bucket:        bucket,
+	}
+}
+
+// Follow はログイン中のユーザーで指定ユーザーをフォローします
+func (h *FollowHandler) Follow(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.followUseCase.Follow(c.Request().Context(), user, c.Param("id")); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Unfollow は指定ユーザーのフォローを解除します
+func (h *FollowHandler) Unfollow(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.followUseCase.Unfollow(c.Request().Context(), user, c.Param("id")); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListFollowers returns one page of the users following the user, most recent first.
+// The cursor for the next page is sent in the X-Next-Cursor header.
+func (h *FollowHandler) ListFollowers(c echo.Context) error {
+	return h.list(c, h.followUseCase.ListFollowers)
+}
+
+// ListFollowing returns one page of the users the user follows, most recent first.
+// The cursor for the next page is sent in the X-Next-Cursor header.
+func (h *FollowHandler) ListFollowing(c echo.Context) error {
+	return h.list(c, h.followUseCase.ListFollowing)
+}
+
+type listFollowsFunc func(ctx context.Context, userID string, query input.ListFollowsQuery) (*input.FollowPage, error)
+
+func (h *FollowHandler) list(c echo.Context, listFn listFollowsFunc) error {
+	limit, err := parseIntQuery(c, "limit", "invalid limit")
+	if err != nil {
+		return err
+	}
+	page, err := listFn(c.Request().Context(), c.Param("id"), input.ListFollowsQuery{
+		Limit:  limit,
+		Cursor: c.QueryParam("cursor"),
+	})
+	if err != nil {
+		return err
+	}
+	if page.NextCursor != "" {
+		c.Response().Header().Set(infrahttp.HeaderNextCursor, page.NextCursor)
+	}
+	return c.JSON(http.StatusOK, presenter.NewFollowUserResponses(page.Follows))
+}
+
+// GetFeed returns one page of recent reviews by followed users and on favorited stores, newest first.
+// The cursor for the next page is sent in the X-Next-Cursor header.
+func (h *FollowHandler) GetFeed(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	limit, err := parseIntQuery(c, "limit", "invalid limit")
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	page, err := h.followUseCase.GetFeed(ctx, user, input.FeedQuery{
+		Limit:  limit,
+		Cursor: c.QueryParam("cursor"),
+	})
+	if err != nil {
+		return err
+	}
+
+	resp := presenter.NewReviewResponses(page.Reviews)
+	attachSignedURLsToReviewResponses(ctx, h.storage, h.bucket, resp)
+	if page.NextCursor != "" {
+		c.Response().Header().Set(infrahttp.HeaderNextCursor, page.NextCursor)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
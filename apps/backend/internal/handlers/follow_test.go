@@ -0,0 +1,122 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+func TestFollowHandler_Follow_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/api/users/user-2/follow").
+		SetPath("/api/users/:id/follow", []string{"id"}, []string{"user-2"}).
+		SetUser(entity.User{UserID: "user-1"}, "user")
+	mockUC := &testutil.MockFollowUseCase{}
+
+	err := handlers.NewFollowHandler(mockUC, nil, "").Follow(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusNoContent)
+	if mockUC.FollowCalledWith.FollowerID != "user-1" || mockUC.FollowCalledWith.FolloweeID != "user-2" {
+		t.Errorf("unexpected follow: %+v", mockUC.FollowCalledWith)
+	}
+}
+
+func TestFollowHandler_Follow_Self(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/api/users/user-1/follow").
+		SetPath("/api/users/:id/follow", []string{"id"}, []string{"user-1"}).
+		SetUser(entity.User{UserID: "user-1"}, "user")
+	mockUC := &testutil.MockFollowUseCase{FollowErr: usecase.ErrCannotFollowSelf}
+
+	err := handlers.NewFollowHandler(mockUC, nil, "").Follow(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrCannotFollowSelf, "expected cannot follow self error")
+}
+
+func TestFollowHandler_Unfollow_Unauthorized(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/api/users/user-2/follow").
+		SetPath("/api/users/:id/follow", []string{"id"}, []string{"user-2"})
+
+	err := handlers.NewFollowHandler(&testutil.MockFollowUseCase{}, nil, "").Unfollow(tc.Context)
+
+	testutil.AssertError(t, err, "expected error without user")
+}
+
+func TestFollowHandler_ListFollowers_SetsNextCursor(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/api/users/user-2/followers?limit=1&cursor=abc").
+		SetPath("/api/users/:id/followers", []string{"id"}, []string{"user-2"})
+	followedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockUC := &testutil.MockFollowUseCase{ListResult: &input.FollowPage{
+		Follows: []entity.Follow{{
+			FollowerID: "user-3",
+			FolloweeID: "user-2",
+			CreatedAt:  followedAt,
+			User:       &entity.User{UserID: "user-3", Name: "Hanako", Email: "hanako@example.com"},
+		}},
+		NextCursor: "next",
+	}}
+
+	err := handlers.NewFollowHandler(mockUC, nil, "").ListFollowers(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	want := input.ListFollowsQuery{Limit: 1, Cursor: "abc"}
+	if mockUC.ListCalledWith.UserID != "user-2" || mockUC.ListCalledWith.Query != want {
+		t.Errorf("unexpected query: %+v", mockUC.ListCalledWith)
+	}
+	if got := tc.Recorder.Header().Get(infrahttp.HeaderNextCursor); got != "next" {
+		t.Errorf("expected next cursor header, got %q", got)
+	}
+
+	var response []map[string]interface{}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if len(response) != 1 || response[0]["user_id"] != "user-3" || response[0]["name"] != "Hanako" {
+		t.Errorf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+	if _, ok := response[0]["email"]; ok {
+		t.Error("expected email not to be exposed")
+	}
+}
+
+func TestFollowHandler_ListFollowing_InvalidLimit(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/api/users/user-2/following?limit=abc").
+		SetPath("/api/users/:id/following", []string{"id"}, []string{"user-2"})
+
+	err := handlers.NewFollowHandler(&testutil.MockFollowUseCase{}, nil, "").ListFollowing(tc.Context)
+
+	testutil.AssertError(t, err, "expected invalid limit error")
+}
+
+func TestFollowHandler_GetFeed_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/api/feed?limit=10").
+		SetUser(entity.User{UserID: "user-1"}, "user")
+	mockUC := &testutil.MockFollowUseCase{FeedResult: &input.ReviewPage{
+		Reviews:    []entity.Review{testutil.NewTestReview(testutil.WithReviewID("review-1"))},
+		NextCursor: "next",
+	}}
+
+	err := handlers.NewFollowHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket").GetFeed(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.FeedCalledWith.UserID != "user-1" || mockUC.FeedCalledWith.Query.Limit != 10 {
+		t.Errorf("unexpected feed query: %+v", mockUC.FeedCalledWith)
+	}
+	if got := tc.Recorder.Header().Get(infrahttp.HeaderNextCursor); got != "next" {
+		t.Errorf("expected next cursor header, got %q", got)
+	}
+}
+
+func TestFollowHandler_GetFeed_Unauthorized(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/api/feed")
+
+	err := handlers.NewFollowHandler(&testutil.MockFollowUseCase{}, nil, "").GetFeed(tc.Context)
+
+	testutil.AssertError(t, err, "expected error without user")
+}
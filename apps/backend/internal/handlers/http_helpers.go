@@ -3,6 +3,8 @@ package handlers
 import (
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -18,9 +20,16 @@ import (
 
 // Error message constants
 const (
-	ErrMsgInvalidJSON     = "invalid JSON"
-	ErrMsgInvalidStoreID  = "invalid store id"
-	ErrMsgInvalidReviewID = "invalid review id"
+	ErrMsgInvalidJSON        = "invalid JSON"
+	ErrMsgInvalidStoreID     = "invalid store id"
+	ErrMsgInvalidReviewID    = "invalid review id"
+	ErrMsgInvalidClaimID     = "invalid claim id"
+	ErrMsgInvalidMenuID      = "invalid menu id"
+	ErrMsgInvalidStoreEditID = "invalid store edit id"
+	ErrMsgInvalidVisitID     = "invalid visit id"
+	ErrMsgInvalidFileID      = "invalid file id"
+
+	ErrMsgInvalidOpeningSchedule = "invalid opening_schedule"
 )
 
 // getRequiredUser extracts the authenticated user from the request context.
@@ -33,6 +42,15 @@ func getRequiredUser(c echo.Context) (entity.User, error) {
 	return user, nil
 }
 
+// getOptionalUser returns the user attached by OptionalAuth, or the zero User for anonymous requests.
+func getOptionalUser(c echo.Context) entity.User {
+	user, err := requestcontext.GetUserFromContext(c.Request().Context())
+	if err != nil {
+		return entity.User{}
+	}
+	return user
+}
+
 // bindJSON binds JSON request body to the given struct and returns BadRequest on error.
 func bindJSON[T any](c echo.Context, dst *T) error {
 	if err := c.Bind(dst); err != nil {
@@ -58,6 +76,79 @@ func parseUUIDParam(c echo.Context, name, errMsg string) (string, error) {
 	return value, nil
 }
 
+// optionalStringQuery returns a pointer to the trimmed query value, or nil when it is absent or blank.
+func optionalStringQuery(c echo.Context, name string) *string {
+	value := strings.TrimSpace(c.QueryParam(name))
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func parseIntQuery(c echo.Context, name, errMsg string) (int, error) {
+	value := strings.TrimSpace(c.QueryParam(name))
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, presentation.NewBadRequest(errMsg)
+	}
+	return n, nil
+}
+
+func parseOptionalInt64Query(c echo.Context, name, errMsg string) (*int64, error) {
+	value := strings.TrimSpace(c.QueryParam(name))
+	if value == "" {
+		return nil, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, presentation.NewBadRequest(errMsg)
+	}
+	return &n, nil
+}
+
+func parseOptionalFloatQuery(c echo.Context, name, errMsg string) (*float64, error) {
+	value := strings.TrimSpace(c.QueryParam(name))
+	if value == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, presentation.NewBadRequest(errMsg)
+	}
+	return &f, nil
+}
+
+func parseOptionalBoolQuery(c echo.Context, name, errMsg string) (*bool, error) {
+	value := strings.TrimSpace(c.QueryParam(name))
+	if value == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, presentation.NewBadRequest(errMsg)
+	}
+	return &b, nil
+}
+
+// parseOptionalDateQuery accepts either a YYYY-MM-DD date or an RFC3339 timestamp.
+func parseOptionalDateQuery(c echo.Context, name, errMsg string) (*time.Time, error) {
+	value := strings.TrimSpace(c.QueryParam(name))
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, presentation.NewBadRequest(errMsg)
+	}
+	return &t, nil
+}
+
 func bearerTokenFromHeader(value string) string {
 	token, _ := security.ExtractBearerToken(value) //nolint:errcheck // ExtractBearerToken only returns nil error
 	return token
@@ -5,6 +5,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 )
@@ -25,11 +26,13 @@ type createUploadDTO struct {
 }
 
 type uploadFileResponse struct {
-	FileID      string `json:"file_id"`
-	ObjectKey   string `json:"object_key"`
-	Path        string `json:"path"`
-	Token       string `json:"token"`
-	ContentType string `json:"content_type"`
+	FileID        string            `json:"file_id"`
+	ObjectKey     string            `json:"object_key"`
+	Path          string            `json:"path"`
+	Token         string            `json:"token"`
+	UploadURL     string            `json:"upload_url"`
+	UploadHeaders map[string]string `json:"upload_headers"`
+	ContentType   string            `json:"content_type"`
 }
 
 type uploadResponse struct {
@@ -54,30 +57,118 @@ func (h *MediaHandler) CreateReviewUploads(c echo.Context) error {
 		return usecase.ErrInvalidInput
 	}
 
-	inputs := make([]input.UploadFileInput, len(dto.Files))
-	for i, f := range dto.Files {
+	uploads, err := h.mediaUseCase.CreateReviewUploads(c.Request().Context(), dto.StoreID, user.UserID, toUploadFileInputs(dto.Files))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newUploadResponse(uploads))
+}
+
+type createClaimUploadDTO struct {
+	Files []uploadFileDTO `json:"files"`
+}
+
+// CreateClaimUploads issues signed upload URLs for the evidence documents of a store claim.
+func (h *MediaHandler) CreateClaimUploads(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+
+	var dto createClaimUploadDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	if len(dto.Files) == 0 {
+		return usecase.ErrInvalidInput
+	}
+
+	uploads, err := h.mediaUseCase.CreateClaimUploads(c.Request().Context(), storeID, user.UserID, toUploadFileInputs(dto.Files))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newUploadResponse(uploads))
+}
+
+type createStorePhotoUploadDTO struct {
+	Files []uploadFileDTO `json:"files"`
+}
+
+// CreateStorePhotoUploads issues signed upload URLs for photos added to the end of a store gallery.
+func (h *MediaHandler) CreateStorePhotoUploads(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+
+	var dto createStorePhotoUploadDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	if len(dto.Files) == 0 {
+		return usecase.ErrInvalidInput
+	}
+
+	uploads, err := h.mediaUseCase.CreateStorePhotoUploads(c.Request().Context(), user, storeID, toUploadFileInputs(dto.Files))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newUploadResponse(uploads))
+}
+
+// CompleteUpload はアップロード完了の通知を受け、Storage で確認したサイズと Content-Type を記録したファイルを返す
+func (h *MediaHandler) CompleteUpload(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	fileID, err := parseUUIDParam(c, "file_id", ErrMsgInvalidFileID)
+	if err != nil {
+		return err
+	}
+
+	file, err := h.mediaUseCase.CompleteUpload(c.Request().Context(), user, fileID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewFileResponse(*file))
+}
+
+func toUploadFileInputs(files []uploadFileDTO) []input.UploadFileInput {
+	inputs := make([]input.UploadFileInput, len(files))
+	for i, f := range files {
 		inputs[i] = input.UploadFileInput{
 			FileName:    f.FileName,
 			FileSize:    f.FileSize,
 			ContentType: f.ContentType,
 		}
 	}
+	return inputs
+}
 
-	uploads, err := h.mediaUseCase.CreateReviewUploads(c.Request().Context(), dto.StoreID, user.UserID, inputs)
-	if err != nil {
-		return err
-	}
-
+func newUploadResponse(uploads []input.SignedUploadFile) uploadResponse {
 	resp := make([]uploadFileResponse, len(uploads))
 	for i, upload := range uploads {
 		resp[i] = uploadFileResponse{
-			FileID:      upload.FileID,
-			ObjectKey:   upload.ObjectKey,
-			Path:        upload.Path,
-			Token:       upload.Token,
-			ContentType: upload.ContentType,
+			FileID:        upload.FileID,
+			ObjectKey:     upload.ObjectKey,
+			Path:          upload.Path,
+			Token:         upload.Token,
+			UploadURL:     upload.UploadURL,
+			UploadHeaders: upload.UploadHeaders,
+			ContentType:   upload.ContentType,
 		}
 	}
-
-	return c.JSON(http.StatusOK, uploadResponse{Files: resp})
+	return uploadResponse{Files: resp}
 }
@@ -3,7 +3,9 @@ package handlers_test
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
@@ -24,11 +26,13 @@ func TestMediaHandler_CreateReviewUploads_Success(t *testing.T) {
 	mockUC := &testutil.MockMediaUseCase{
 		CreateResult: []input.SignedUploadFile{
 			{
-				FileID:      "file-1",
-				ObjectKey:   "uploads/file-1.jpg",
-				Path:        "/uploads/file-1.jpg",
-				Token:       "test-token",
-				ContentType: "image/jpeg",
+				FileID:        "file-1",
+				ObjectKey:     "uploads/file-1.jpg",
+				Path:          "/uploads/file-1.jpg",
+				Token:         "test-token",
+				UploadURL:     "https://storage.example.com/uploads/file-1.jpg?signature=abc",
+				UploadHeaders: map[string]string{"Content-Type": "image/jpeg"},
+				ContentType:   "image/jpeg",
 			},
 		},
 	}
@@ -48,7 +52,14 @@ func TestMediaHandler_CreateReviewUploads_Success(t *testing.T) {
 		t.Fatal("expected files array in response")
 	}
 	if len(files) != 1 {
-		t.Errorf("expected 1 upload result, got %d", len(files))
+		t.Fatalf("expected 1 upload result, got %d", len(files))
+	}
+	file, _ := files[0].(map[string]interface{})
+	if file["upload_url"] != "https://storage.example.com/uploads/file-1.jpg?signature=abc" {
+		t.Errorf("unexpected upload_url: %v", file["upload_url"])
+	}
+	if headers, _ := file["upload_headers"].(map[string]interface{}); headers["Content-Type"] != "image/jpeg" {
+		t.Errorf("unexpected upload_headers: %v", file["upload_headers"])
 	}
 }
 
@@ -124,3 +135,125 @@ func TestMediaHandler_CreateReviewUploads_UseCaseError(t *testing.T) {
 
 	testutil.AssertError(t, err, "usecase error")
 }
+
+// --- CreateClaimUploads Tests ---
+
+func TestMediaHandler_CreateClaimUploads_Success(t *testing.T) {
+	storeID := "550e8400-e29b-41d4-a716-446655440000"
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+storeID+"/claims/uploads",
+		`{"files":[{"file_name":"license.pdf","content_type":"application/pdf"}]}`)
+	tc.SetPath("/stores/:id/claims/uploads", []string{"id"}, []string{storeID})
+	tc.SetUser(entity.User{UserID: "owner-1"}, "owner")
+
+	mockUC := &testutil.MockMediaUseCase{
+		CreateResult: []input.SignedUploadFile{{FileID: "file-1", ObjectKey: "claims/x", Path: "/claims/x", Token: "t"}},
+	}
+	h := handlers.NewMediaHandler(mockUC)
+
+	err := h.CreateClaimUploads(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.CreateClaimUploadsCalledWith.StoreID != storeID {
+		t.Errorf("expected store id %s, got %s", storeID, mockUC.CreateClaimUploadsCalledWith.StoreID)
+	}
+	if mockUC.CreateClaimUploadsCalledWith.UserID != "owner-1" {
+		t.Errorf("expected user id owner-1, got %s", mockUC.CreateClaimUploadsCalledWith.UserID)
+	}
+	if mockUC.CreateReviewUploadsCalled {
+		t.Error("expected review uploads not to be used")
+	}
+}
+
+func TestMediaHandler_CreateClaimUploads_InvalidStoreID(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/bad/claims/uploads",
+		`{"files":[{"file_name":"license.pdf","content_type":"application/pdf"}]}`)
+	tc.SetPath("/stores/:id/claims/uploads", []string{"id"}, []string{"bad"})
+	tc.SetUser(entity.User{UserID: "owner-1"}, "owner")
+
+	mockUC := &testutil.MockMediaUseCase{}
+	h := handlers.NewMediaHandler(mockUC)
+
+	err := h.CreateClaimUploads(tc.Context)
+
+	testutil.AssertError(t, err, "expected error for invalid store id")
+	if mockUC.CreateClaimUploadsCalled {
+		t.Error("expected use case not to be called")
+	}
+}
+
+// --- CreateStorePhotoUploads Tests ---
+
+func TestMediaHandler_CreateStorePhotoUploads_Success(t *testing.T) {
+	storeID := "550e8400-e29b-41d4-a716-446655440000"
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+storeID+"/media/upload",
+		`{"files":[{"file_name":"interior.jpg","content_type":"image/jpeg"}]}`)
+	tc.SetPath("/stores/:id/media/upload", []string{"id"}, []string{storeID})
+	tc.SetUser(entity.User{UserID: "owner-1"}, "owner")
+
+	mockUC := &testutil.MockMediaUseCase{
+		CreateResult: []input.SignedUploadFile{{FileID: "file-1", ObjectKey: "stores/x", Path: "/stores/x", Token: "t"}},
+	}
+	h := handlers.NewMediaHandler(mockUC)
+
+	err := h.CreateStorePhotoUploads(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	called := mockUC.CreateStorePhotoUploadsCalledWith
+	if called.StoreID != storeID || called.Actor.UserID != "owner-1" || len(called.Files) != 1 {
+		t.Errorf("unexpected call: %+v", called)
+	}
+}
+
+func TestMediaHandler_CreateStorePhotoUploads_EmptyFiles(t *testing.T) {
+	storeID := "550e8400-e29b-41d4-a716-446655440000"
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+storeID+"/media/upload", `{"files":[]}`)
+	tc.SetPath("/stores/:id/media/upload", []string{"id"}, []string{storeID})
+	tc.SetUser(entity.User{UserID: "owner-1"}, "owner")
+
+	err := handlers.NewMediaHandler(&testutil.MockMediaUseCase{}).CreateStorePhotoUploads(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrInvalidInput, "expected invalid input error")
+}
+
+// --- CompleteUpload Tests ---
+
+func TestMediaHandler_CompleteUpload_Success(t *testing.T) {
+	fileID := "550e8400-e29b-41d4-a716-446655440000"
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/media/"+fileID+"/complete")
+	tc.SetPath("/media/:file_id/complete", []string{"file_id"}, []string{fileID})
+	tc.SetUser(entity.User{UserID: "user-1"}, "user")
+
+	uploadedAt := time.Now()
+	mockUC := &testutil.MockMediaUseCase{CompleteResult: &entity.File{FileID: fileID, UploadedAt: &uploadedAt}}
+
+	err := handlers.NewMediaHandler(mockUC).CompleteUpload(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.CompleteCalledWith.FileID != fileID || mockUC.CompleteCalledWith.Actor.UserID != "user-1" {
+		t.Errorf("unexpected call: %+v", mockUC.CompleteCalledWith)
+	}
+	if !strings.Contains(tc.Recorder.Body.String(), `"uploaded_at"`) {
+		t.Errorf("expected uploaded_at in response, got %s", tc.Recorder.Body.String())
+	}
+}
+
+func TestMediaHandler_CompleteUpload_InvalidFileID(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/media/bad/complete")
+	tc.SetPath("/media/:file_id/complete", []string{"file_id"}, []string{"bad"})
+	tc.SetUser(entity.User{UserID: "user-1"}, "user")
+
+	err := handlers.NewMediaHandler(&testutil.MockMediaUseCase{}).CompleteUpload(tc.Context)
+
+	testutil.AssertError(t, err, "expected error for invalid file id")
+}
+
+func TestMediaHandler_CompleteUpload_UseCaseError(t *testing.T) {
+	fileID := "550e8400-e29b-41d4-a716-446655440000"
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/media/"+fileID+"/complete")
+	tc.SetPath("/media/:file_id/complete", []string{"file_id"}, []string{fileID})
+	tc.SetUser(entity.User{UserID: "user-1"}, "user")
+
+	err := handlers.NewMediaHandler(&testutil.MockMediaUseCase{CompleteErr: usecase.ErrUploadNotFound}).CompleteUpload(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrUploadNotFound, "expected upload not found error")
+}
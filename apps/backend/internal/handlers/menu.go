@@ -5,33 +5,57 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
 
 type MenuHandler struct {
 	menuUseCase input.MenuUseCase
+	storage     output.StorageProvider
+	bucket      string
 }
 
-func NewMenuHandler(menuUseCase input.MenuUseCase) *MenuHandler {
+func NewMenuHandler(menuUseCase input.MenuUseCase, storage output.StorageProvider, bucket string) *MenuHandler {
 	return &MenuHandler{
 		menuUseCase: menuUseCase,
+		storage:     storage,
+		bucket:      bucket,
 	}
 }
 
+// respondWithMenus はメニュー一覧を写真の署名付き URL 付きで返す
+func (h *MenuHandler) respondWithMenus(c echo.Context, menus []entity.Menu) error {
+	resp := presenter.NewMenuResponses(menus)
+	attachSignedURLsToMenuResponses(c.Request().Context(), h.storage, h.bucket, resp)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// respondWithMenu は単一のメニューを写真の署名付き URL 付きで返す
+func (h *MenuHandler) respondWithMenu(c echo.Context, menu *entity.Menu, status int) error {
+	responses := []presenter.MenuResponse{presenter.NewMenuResponse(*menu)}
+	attachSignedURLsToMenuResponses(c.Request().Context(), h.storage, h.bucket, responses)
+	return c.JSON(status, responses[0])
+}
+
 func (h *MenuHandler) GetMenusByStoreID(c echo.Context) error {
 	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
 	if err != nil {
 		return err
 	}
-	menus, err := h.menuUseCase.GetMenusByStoreID(c.Request().Context(), storeID)
+	menus, err := h.menuUseCase.GetMenusByStoreID(c.Request().Context(), getOptionalUser(c), storeID)
 	if err != nil {
 		return err
 	}
-	return c.JSON(http.StatusOK, presenter.NewMenuResponses(menus))
+	return h.respondWithMenus(c, menus)
 }
 
 func (h *MenuHandler) CreateMenu(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
 	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
 	if err != nil {
 		return err
@@ -40,18 +64,85 @@ func (h *MenuHandler) CreateMenu(c echo.Context) error {
 	if err = bindJSON(c, &dto); err != nil {
 		return err
 	}
-	menu, err := h.menuUseCase.CreateMenu(c.Request().Context(), storeID, dto.toInput())
+	menu, err := h.menuUseCase.CreateMenu(c.Request().Context(), user, storeID, dto.toInput())
+	if err != nil {
+		return err
+	}
+	return h.respondWithMenu(c, menu, http.StatusCreated)
+}
+
+func (h *MenuHandler) UpdateMenu(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	menuID, err := parseUUIDParam(c, "menu_id", ErrMsgInvalidMenuID)
+	if err != nil {
+		return err
+	}
+	var dto updateMenuDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	menu, err := h.menuUseCase.UpdateMenu(c.Request().Context(), user, storeID, menuID, dto.toInput())
+	if err != nil {
+		return err
+	}
+	return h.respondWithMenu(c, menu, http.StatusOK)
+}
+
+func (h *MenuHandler) DeleteMenu(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	menuID, err := parseUUIDParam(c, "menu_id", ErrMsgInvalidMenuID)
+	if err != nil {
+		return err
+	}
+	if err := h.menuUseCase.DeleteMenu(c.Request().Context(), user, storeID, menuID); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ReorderMenus は店舗のメニューを指定順に並べ替え、並べ替え後の一覧を返す
+func (h *MenuHandler) ReorderMenus(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	var dto reorderMenusDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	menus, err := h.menuUseCase.ReorderMenus(c.Request().Context(), user, storeID, dto.MenuIDs)
 	if err != nil {
 		return err
 	}
-	resp := presenter.NewMenuResponse(*menu)
-	return c.JSON(http.StatusCreated, resp)
+	return h.respondWithMenus(c, menus)
 }
 
 type createMenuDTO struct {
-	Name        string  `json:"name"`
-	Price       *int    `json:"price"`
-	Description *string `json:"description"`
+	Name        string   `json:"name"`
+	Price       *int     `json:"price"`
+	Description *string  `json:"description"`
+	Section     *string  `json:"section"`
+	IsAvailable *bool    `json:"is_available"`
+	ImageFileID *string  `json:"image_file_id"`
+	DietaryTags []string `json:"dietary_tags"`
 }
 
 func (dto createMenuDTO) toInput() input.CreateMenuInput {
@@ -59,5 +150,35 @@ func (dto createMenuDTO) toInput() input.CreateMenuInput {
 		Name:        dto.Name,
 		Price:       dto.Price,
 		Description: dto.Description,
+		Section:     dto.Section,
+		IsAvailable: dto.IsAvailable,
+		ImageFileID: dto.ImageFileID,
+		DietaryTags: dto.DietaryTags,
+	}
+}
+
+type updateMenuDTO struct {
+	Name        *string  `json:"name"`
+	Price       *int     `json:"price"`
+	Description *string  `json:"description"`
+	Section     *string  `json:"section"`
+	IsAvailable *bool    `json:"is_available"`
+	ImageFileID *string  `json:"image_file_id"`
+	DietaryTags []string `json:"dietary_tags"`
+}
+
+func (dto updateMenuDTO) toInput() input.UpdateMenuInput {
+	return input.UpdateMenuInput{
+		Name:        dto.Name,
+		Price:       dto.Price,
+		Description: dto.Description,
+		Section:     dto.Section,
+		IsAvailable: dto.IsAvailable,
+		ImageFileID: dto.ImageFileID,
+		DietaryTags: dto.DietaryTags,
 	}
 }
+
+type reorderMenusDTO struct {
+	MenuIDs []string `json:"menu_ids"`
+}
@@ -26,6 +26,7 @@ func TestMenuHandler_GetMenusByStoreID_Success(t *testing.T) {
 	storeID := uuid.New().String()
 	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/"+storeID+"/menus")
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{
 		GetByStoreIDResult: []entity.Menu{
@@ -33,7 +34,7 @@ func TestMenuHandler_GetMenusByStoreID_Success(t *testing.T) {
 			{MenuID: "menu-2", StoreID: storeID, Name: "Menu 2"},
 		},
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.GetMenusByStoreID(tc.Context)
 
@@ -53,11 +54,12 @@ func TestMenuHandler_GetMenusByStoreID_Empty(t *testing.T) {
 	storeID := uuid.New().String()
 	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/"+storeID+"/menus")
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{
 		GetByStoreIDResult: []entity.Menu{},
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.GetMenusByStoreID(tc.Context)
 
@@ -69,7 +71,7 @@ func TestMenuHandler_GetMenusByStoreID_InvalidUUID(t *testing.T) {
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{"invalid-uuid"})
 
 	mockUC := &testutil.MockMenuUseCase{}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.GetMenusByStoreID(tc.Context)
 
@@ -80,11 +82,12 @@ func TestMenuHandler_GetMenusByStoreID_UseCaseError(t *testing.T) {
 	storeID := uuid.New().String()
 	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/"+storeID+"/menus")
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{
 		GetByStoreIDErr: usecase.ErrStoreNotFound,
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.GetMenusByStoreID(tc.Context)
 
@@ -97,11 +100,12 @@ func TestMenuHandler_GetMenusByStoreID_NilResult(t *testing.T) {
 	storeID := uuid.New().String()
 	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/"+storeID+"/menus")
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{
 		GetByStoreIDResult: nil, // Explicitly nil
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.GetMenusByStoreID(tc.Context)
 
@@ -113,6 +117,7 @@ func TestMenuHandler_GetMenusByStoreID_ManyMenus(t *testing.T) {
 	storeID := uuid.New().String()
 	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/"+storeID+"/menus")
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	// Create multiple menus
 	menus := make([]entity.Menu, 10)
@@ -127,7 +132,7 @@ func TestMenuHandler_GetMenusByStoreID_ManyMenus(t *testing.T) {
 	mockUC := &testutil.MockMenuUseCase{
 		GetByStoreIDResult: menus,
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.GetMenusByStoreID(tc.Context)
 
@@ -149,6 +154,7 @@ func TestMenuHandler_CreateMenu_Success(t *testing.T) {
 
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+storeID+"/menus", string(bodyBytes))
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{
 		CreateResult: &entity.Menu{
@@ -157,7 +163,7 @@ func TestMenuHandler_CreateMenu_Success(t *testing.T) {
 			Name:    "New Menu",
 		},
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.CreateMenu(tc.Context)
 
@@ -175,7 +181,7 @@ func TestMenuHandler_CreateMenu_InvalidUUID(t *testing.T) {
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{"invalid-uuid"})
 
 	mockUC := &testutil.MockMenuUseCase{}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.CreateMenu(tc.Context)
 
@@ -186,9 +192,10 @@ func TestMenuHandler_CreateMenu_InvalidJSON(t *testing.T) {
 	storeID := uuid.New().String()
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+storeID+"/menus", `{invalid}`)
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.CreateMenu(tc.Context)
 
@@ -206,11 +213,12 @@ func TestMenuHandler_CreateMenu_UseCaseError(t *testing.T) {
 
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+storeID+"/menus", string(bodyBytes))
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{
 		CreateErr: usecase.ErrInvalidInput,
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.CreateMenu(tc.Context)
 
@@ -230,6 +238,7 @@ func TestMenuHandler_CreateMenu_OnlyName(t *testing.T) {
 
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+storeID+"/menus", string(bodyBytes))
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{
 		CreateResult: &entity.Menu{
@@ -238,7 +247,7 @@ func TestMenuHandler_CreateMenu_OnlyName(t *testing.T) {
 			Name:    "Simple Menu",
 		},
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.CreateMenu(tc.Context)
 
@@ -257,6 +266,7 @@ func TestMenuHandler_CreateMenu_WithPriceOnly(t *testing.T) {
 
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+storeID+"/menus", string(bodyBytes))
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{
 		CreateResult: &entity.Menu{
@@ -265,7 +275,7 @@ func TestMenuHandler_CreateMenu_WithPriceOnly(t *testing.T) {
 			Name:    "Priced Menu",
 		},
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.CreateMenu(tc.Context)
 
@@ -286,11 +296,12 @@ func TestMenuHandler_CreateMenu_EmptyName(t *testing.T) {
 
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+storeID+"/menus", string(bodyBytes))
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{
 		CreateErr: usecase.ErrInvalidInput,
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.CreateMenu(tc.Context)
 
@@ -310,6 +321,7 @@ func TestMenuHandler_CreateMenu_ZeroPrice(t *testing.T) {
 
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+storeID+"/menus", string(bodyBytes))
 	tc.SetPath("/stores/:id/menus", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
 
 	mockUC := &testutil.MockMenuUseCase{
 		CreateResult: &entity.Menu{
@@ -318,9 +330,151 @@ func TestMenuHandler_CreateMenu_ZeroPrice(t *testing.T) {
 			Name:    "Free Item",
 		},
 	}
-	h := handlers.NewMenuHandler(mockUC)
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
 	err := h.CreateMenu(tc.Context)
 
 	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusCreated)
 }
+
+// --- UpdateMenu Tests ---
+
+func TestMenuHandler_UpdateMenu_Success(t *testing.T) {
+	storeID := uuid.New().String()
+	menuID := uuid.New().String()
+	body := `{"is_available": false, "section": "drink", "dietary_tags": ["vegan"]}`
+
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+storeID+"/menus/"+menuID, body)
+	tc.SetPath("/stores/:id/menus/:menu_id", []string{"id", "menu_id"}, []string{storeID, menuID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
+
+	mockUC := &testutil.MockMenuUseCase{
+		UpdateResult: &entity.Menu{MenuID: menuID, StoreID: storeID, Name: "Latte", DietaryTags: []string{"vegan"}},
+	}
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.UpdateMenu(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	in := mockUC.UpdateCalledWith.Input
+	if in.IsAvailable == nil || *in.IsAvailable {
+		t.Errorf("expected is_available=false to be passed, got %v", in.IsAvailable)
+	}
+	if in.Section == nil || *in.Section != "drink" {
+		t.Errorf("expected section drink, got %v", in.Section)
+	}
+	if in.Name != nil {
+		t.Errorf("expected name to be left unchanged, got %v", *in.Name)
+	}
+	if mockUC.UpdateCalledWith.MenuID != menuID {
+		t.Errorf("expected menu id %s, got %s", menuID, mockUC.UpdateCalledWith.MenuID)
+	}
+}
+
+func TestMenuHandler_UpdateMenu_InvalidMenuID(t *testing.T) {
+	storeID := uuid.New().String()
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+storeID+"/menus/invalid", `{}`)
+	tc.SetPath("/stores/:id/menus/:menu_id", []string{"id", "menu_id"}, []string{storeID, "invalid"})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
+
+	h := handlers.NewMenuHandler(&testutil.MockMenuUseCase{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.UpdateMenu(tc.Context)
+
+	testutil.AssertError(t, err, handlers.ErrMsgInvalidMenuID)
+}
+
+func TestMenuHandler_UpdateMenu_UseCaseError(t *testing.T) {
+	storeID := uuid.New().String()
+	menuID := uuid.New().String()
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+storeID+"/menus/"+menuID, `{"section": "alcohol"}`)
+	tc.SetPath("/stores/:id/menus/:menu_id", []string{"id", "menu_id"}, []string{storeID, menuID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
+
+	mockUC := &testutil.MockMenuUseCase{UpdateErr: usecase.ErrInvalidMenuSection}
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.UpdateMenu(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrInvalidMenuSection, "expected ErrInvalidMenuSection")
+}
+
+// --- DeleteMenu Tests ---
+
+func TestMenuHandler_DeleteMenu_Success(t *testing.T) {
+	storeID := uuid.New().String()
+	menuID := uuid.New().String()
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/stores/"+storeID+"/menus/"+menuID)
+	tc.SetPath("/stores/:id/menus/:menu_id", []string{"id", "menu_id"}, []string{storeID, menuID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
+
+	mockUC := &testutil.MockMenuUseCase{}
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.DeleteMenu(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusNoContent)
+	if mockUC.DeleteCalledWith.StoreID != storeID || mockUC.DeleteCalledWith.MenuID != menuID {
+		t.Errorf("unexpected delete call: %+v", mockUC.DeleteCalledWith)
+	}
+}
+
+func TestMenuHandler_DeleteMenu_NotFound(t *testing.T) {
+	storeID := uuid.New().String()
+	menuID := uuid.New().String()
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/stores/"+storeID+"/menus/"+menuID)
+	tc.SetPath("/stores/:id/menus/:menu_id", []string{"id", "menu_id"}, []string{storeID, menuID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
+
+	mockUC := &testutil.MockMenuUseCase{DeleteErr: usecase.ErrMenuNotFound}
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.DeleteMenu(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrMenuNotFound, "expected ErrMenuNotFound")
+}
+
+// --- ReorderMenus Tests ---
+
+func TestMenuHandler_ReorderMenus_Success(t *testing.T) {
+	storeID := uuid.New().String()
+	body := `{"menu_ids": ["menu-2", "menu-1"]}`
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+storeID+"/menus/order", body)
+	tc.SetPath("/stores/:id/menus/order", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
+
+	mockUC := &testutil.MockMenuUseCase{
+		ReorderResult: []entity.Menu{
+			{MenuID: "menu-2", StoreID: storeID, SortOrder: 0},
+			{MenuID: "menu-1", StoreID: storeID, SortOrder: 1},
+		},
+	}
+	h := handlers.NewMenuHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.ReorderMenus(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if got := mockUC.ReorderCalledWith.MenuIDs; len(got) != 2 || got[0] != "menu-2" {
+		t.Errorf("expected order [menu-2 menu-1], got %v", got)
+	}
+	var response []map[string]interface{}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if len(response) != 2 || response[0]["menu_id"] != "menu-2" {
+		t.Errorf("expected reordered menus, got %v", response)
+	}
+}
+
+func TestMenuHandler_ReorderMenus_InvalidJSON(t *testing.T) {
+	storeID := uuid.New().String()
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+storeID+"/menus/order", `{invalid`)
+	tc.SetPath("/stores/:id/menus/order", []string{"id"}, []string{storeID})
+	tc.SetUser(testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
+
+	h := handlers.NewMenuHandler(&testutil.MockMenuUseCase{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.ReorderMenus(tc.Context)
+
+	testutil.AssertError(t, err, handlers.ErrMsgInvalidJSON)
+}
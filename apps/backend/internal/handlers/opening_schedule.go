@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/hours"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation"
+)
+
+// openingScheduleDTO is the structured opening hours in store requests.
+// Times are HH:MM in Asia/Tokyo; a close time at or before the open time runs into the next day.
+type openingScheduleDTO struct {
+	Weekly       weeklyHoursDTO    `json:"weekly"`
+	SpecialHours []specialHoursDTO `json:"special_hours"`
+	Closures     []closureDTO      `json:"closures"`
+}
+
+// weeklyHoursDTO lists the intervals per weekday. A missing or empty day is a regular holiday.
+type weeklyHoursDTO struct {
+	Mon []openingIntervalDTO `json:"mon"`
+	Tue []openingIntervalDTO `json:"tue"`
+	Wed []openingIntervalDTO `json:"wed"`
+	Thu []openingIntervalDTO `json:"thu"`
+	Fri []openingIntervalDTO `json:"fri"`
+	Sat []openingIntervalDTO `json:"sat"`
+	Sun []openingIntervalDTO `json:"sun"`
+}
+
+type openingIntervalDTO struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// specialHoursDTO overrides the weekly hours on a date. Empty intervals close the store for the day.
+type specialHoursDTO struct {
+	Date      string               `json:"date"`
+	Intervals []openingIntervalDTO `json:"intervals"`
+}
+
+type closureDTO struct {
+	From string  `json:"from"`
+	To   string  `json:"to"`
+	Note *string `json:"note"`
+}
+
+// toEntity converts the request body into the domain schedule. A nil DTO means the field was omitted.
+func (dto *openingScheduleDTO) toEntity() (*entity.OpeningSchedule, error) {
+	if dto == nil {
+		return nil, nil
+	}
+	var schedule entity.OpeningSchedule
+	days := map[time.Weekday][]openingIntervalDTO{
+		time.Sunday:    dto.Weekly.Sun,
+		time.Monday:    dto.Weekly.Mon,
+		time.Tuesday:   dto.Weekly.Tue,
+		time.Wednesday: dto.Weekly.Wed,
+		time.Thursday:  dto.Weekly.Thu,
+		time.Friday:    dto.Weekly.Fri,
+		time.Saturday:  dto.Weekly.Sat,
+	}
+	for day, intervals := range days {
+		parsed, err := parseOpeningIntervals(intervals)
+		if err != nil {
+			return nil, err
+		}
+		schedule.Weekly[day] = parsed
+	}
+	for _, special := range dto.SpecialHours {
+		date, err := parseScheduleDate(special.Date)
+		if err != nil {
+			return nil, err
+		}
+		intervals, err := parseOpeningIntervals(special.Intervals)
+		if err != nil {
+			return nil, err
+		}
+		schedule.Special = append(schedule.Special, entity.SpecialHours{Date: date, Intervals: intervals})
+	}
+	for _, closure := range dto.Closures {
+		from, err := parseScheduleDate(closure.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := parseScheduleDate(closure.To)
+		if err != nil {
+			return nil, err
+		}
+		schedule.Closures = append(schedule.Closures, entity.StoreClosure{From: from, To: to, Note: closure.Note})
+	}
+	return &schedule, nil
+}
+
+func parseOpeningIntervals(intervals []openingIntervalDTO) ([]entity.OpeningInterval, error) {
+	var result []entity.OpeningInterval
+	for _, interval := range intervals {
+		open, err := hours.ParseMinutes(interval.Open)
+		if err != nil {
+			return nil, presentation.NewBadRequest(ErrMsgInvalidOpeningSchedule)
+		}
+		closeAt, err := hours.ParseMinutes(interval.Close)
+		if err != nil {
+			return nil, presentation.NewBadRequest(ErrMsgInvalidOpeningSchedule)
+		}
+		result = append(result, entity.OpeningInterval{Open: open, Close: closeAt})
+	}
+	return result, nil
+}
+
+func parseScheduleDate(value string) (time.Time, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, presentation.NewBadRequest(ErrMsgInvalidOpeningSchedule)
+	}
+	return date, nil
+}
@@ -3,8 +3,10 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation"
 	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 )
@@ -29,6 +31,9 @@ func (h *ReportHandler) CreateReport(c echo.Context) error {
 	if err = bindJSON(c, &dto); err != nil {
 		return err
 	}
+	if _, err = uuid.Parse(dto.TargetID); err != nil {
+		return presentation.NewBadRequest("invalid target id")
+	}
 
 	report, err := h.reportUseCase.CreateReport(c.Request().Context(), dto.toInput(user.UserID))
 	if err != nil {
@@ -40,7 +45,7 @@ func (h *ReportHandler) CreateReport(c echo.Context) error {
 
 type createReportDTO struct {
 	TargetType string `json:"target_type"`
-	TargetID   int64  `json:"target_id"`
+	TargetID   string `json:"target_id"`
 	Reason     string `json:"reason"`
 }
 
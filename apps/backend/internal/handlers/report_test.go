@@ -15,7 +15,7 @@ import (
 
 func TestReportHandler_CreateReport_Success(t *testing.T) {
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/reports",
-		`{"target_type":"review","target_id":123,"reason":"spam"}`)
+		`{"target_type":"review","target_id":"11111111-1111-1111-1111-111111111111","reason":"spam"}`)
 
 	user := entity.User{UserID: "user-1"}
 	tc.SetUser(user, "user")
@@ -25,7 +25,7 @@ func TestReportHandler_CreateReport_Success(t *testing.T) {
 			ReportID:   1,
 			UserID:     "user-1",
 			TargetType: "review",
-			TargetID:   123,
+			TargetID:   "11111111-1111-1111-1111-111111111111",
 			Reason:     "spam",
 		},
 	}
@@ -48,7 +48,7 @@ func TestReportHandler_CreateReport_Success(t *testing.T) {
 
 func TestReportHandler_CreateReport_Unauthorized(t *testing.T) {
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/reports",
-		`{"target_type":"review","target_id":123,"reason":"spam"}`)
+		`{"target_type":"review","target_id":"11111111-1111-1111-1111-111111111111","reason":"spam"}`)
 
 	mockUC := &testutil.MockReportUseCase{}
 	h := handlers.NewReportHandler(mockUC)
@@ -72,9 +72,25 @@ func TestReportHandler_CreateReport_InvalidJSON(t *testing.T) {
 	testutil.AssertError(t, err, "invalid JSON")
 }
 
+func TestReportHandler_CreateReport_InvalidTargetID(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/reports",
+		`{"target_type":"review","target_id":"123","reason":"spam"}`)
+	tc.SetUser(entity.User{UserID: "user-1"}, "user")
+
+	mockUC := &testutil.MockReportUseCase{}
+	h := handlers.NewReportHandler(mockUC)
+
+	err := h.CreateReport(tc.Context)
+
+	testutil.AssertError(t, err, "invalid target id")
+	if mockUC.CreateCalled {
+		t.Error("expected use case not to be called")
+	}
+}
+
 func TestReportHandler_CreateReport_UseCaseError(t *testing.T) {
 	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/reports",
-		`{"target_type":"review","target_id":123,"reason":"spam"}`)
+		`{"target_type":"review","target_id":"11111111-1111-1111-1111-111111111111","reason":"spam"}`)
 
 	user := entity.User{UserID: "user-1"}
 	tc.SetUser(user, "user")
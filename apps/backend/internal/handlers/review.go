@@ -5,6 +5,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
 	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
 	"github.com/TeamH04/team-production/apps/backend/internal/security"
@@ -40,16 +41,16 @@ func (h *ReviewHandler) GetReviewsByStoreID(c echo.Context) error {
 	}
 
 	sort := c.QueryParam("sort")
-	viewerID := ""
+	var viewer entity.User
 	if token := bearerTokenFromHeader(c.Request().Header.Get(infrahttp.HeaderAuthorization)); token != "" {
 		claims, verifyErr := h.tokenVerifier.Verify(c.Request().Context(), token)
 		if verifyErr == nil {
-			viewerID = claims.UserID
+			viewer = entity.User{UserID: claims.UserID, Role: claims.Role}
 		}
-		// 無効なトークンでもエラーを返さず、viewerIDを空のまま続行
+		// 無効なトークンでもエラーを返さず、未ログインの閲覧者として続行
 	}
 
-	reviews, err := h.reviewUseCase.GetReviewsByStoreID(c.Request().Context(), storeID, sort, viewerID)
+	reviews, err := h.reviewUseCase.GetReviewsByStoreID(c.Request().Context(), storeID, sort, viewer)
 	if err != nil {
 		return err
 	}
@@ -58,6 +59,19 @@ func (h *ReviewHandler) GetReviewsByStoreID(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+// GetRatingSummary returns the aggregated ratings of a store the viewer can see.
+func (h *ReviewHandler) GetRatingSummary(c echo.Context) error {
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	summary, err := h.reviewUseCase.GetRatingSummary(c.Request().Context(), storeID, getOptionalUser(c))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewRatingSummaryResponse(*summary))
+}
+
 func (h *ReviewHandler) Create(c echo.Context) error {
 	user, err := getRequiredUser(c)
 	if err != nil {
@@ -80,6 +94,47 @@ func (h *ReviewHandler) Create(c echo.Context) error {
 	return c.NoContent(http.StatusCreated)
 }
 
+// Update rewrites the authenticated user's own review.
+func (h *ReviewHandler) Update(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+
+	reviewID, err := parseUUIDParam(c, "id", ErrMsgInvalidReviewID)
+	if err != nil {
+		return err
+	}
+
+	var in input.UpdateReview
+	if err = bindJSON(c, &in); err != nil {
+		return err
+	}
+
+	if err = h.reviewUseCase.Update(c.Request().Context(), user, reviewID, in); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Delete removes a review. Authors can delete their own reviews and admins can delete any review.
+func (h *ReviewHandler) Delete(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+
+	reviewID, err := parseUUIDParam(c, "id", ErrMsgInvalidReviewID)
+	if err != nil {
+		return err
+	}
+
+	if err = h.reviewUseCase.Delete(c.Request().Context(), user, reviewID); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
 func (h *ReviewHandler) LikeReview(c echo.Context) error {
 	user, err := getRequiredUser(c)
 	if err != nil {
@@ -226,6 +226,96 @@ func TestGetReviewsByStoreID_WithSortParam(t *testing.T) {
 	}
 }
 
+// --- GetRatingSummary Tests ---
+
+func TestReviewHandler_GetRatingSummary_Success(t *testing.T) {
+	storeID := uuid.New().String()
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/"+storeID+"/rating-summary")
+	tc.SetPath("/stores/:id/rating-summary", []string{"id"}, []string{storeID})
+
+	taste := 4.5
+	mockUC := &testutil.MockReviewUseCase{
+		RatingSummary: &entity.RatingSummary{
+			StoreID:       storeID,
+			AverageRating: 4.33,
+			ReviewCount:   3,
+			Histogram:     entity.RatingHistogram{0, 0, 0, 2, 1},
+			Averages:      entity.RatingAverages{Taste: &taste},
+		},
+	}
+	h := handlers.NewReviewHandler(mockUC, &testutil.MockTokenVerifier{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.GetRatingSummary(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.RatingSummaryCalledWith != storeID {
+		t.Errorf("expected storeID %s, got %s", storeID, mockUC.RatingSummaryCalledWith)
+	}
+
+	var response struct {
+		ReviewCount int                 `json:"review_count"`
+		Histogram   map[string]int      `json:"histogram"`
+		Averages    map[string]*float64 `json:"averages"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if response.ReviewCount != 3 {
+		t.Errorf("expected review_count 3, got %d", response.ReviewCount)
+	}
+	if response.Histogram["4"] != 2 || response.Histogram["5"] != 1 || response.Histogram["1"] != 0 {
+		t.Errorf("unexpected histogram: %v", response.Histogram)
+	}
+	if response.Averages["taste"] == nil || *response.Averages["taste"] != 4.5 {
+		t.Errorf("unexpected taste average: %v", response.Averages["taste"])
+	}
+	if v, ok := response.Averages["service"]; !ok || v != nil {
+		t.Errorf("expected service average to be null, got %v", v)
+	}
+}
+
+func TestReviewHandler_GetRatingSummary_PassesViewer(t *testing.T) {
+	storeID := uuid.New().String()
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/"+storeID+"/rating-summary")
+	tc.SetPath("/stores/:id/rating-summary", []string{"id"}, []string{storeID})
+	tc.SetUser(entity.User{UserID: "owner-1", Role: "owner"}, "owner")
+
+	mockUC := &testutil.MockReviewUseCase{RatingSummary: &entity.RatingSummary{StoreID: storeID}}
+	h := handlers.NewReviewHandler(mockUC, &testutil.MockTokenVerifier{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.GetRatingSummary(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.RatingSummaryViewer.UserID != "owner-1" {
+		t.Errorf("expected viewer owner-1, got %+v", mockUC.RatingSummaryViewer)
+	}
+}
+
+func TestReviewHandler_GetRatingSummary_InvalidUUID(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/invalid-uuid/rating-summary")
+	tc.SetPath("/stores/:id/rating-summary", []string{"id"}, []string{"invalid-uuid"})
+
+	mockUC := &testutil.MockReviewUseCase{}
+	h := handlers.NewReviewHandler(mockUC, &testutil.MockTokenVerifier{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.GetRatingSummary(tc.Context)
+
+	testutil.AssertError(t, err, "invalid UUID")
+}
+
+func TestReviewHandler_GetRatingSummary_UseCaseError(t *testing.T) {
+	storeID := uuid.New().String()
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/"+storeID+"/rating-summary")
+	tc.SetPath("/stores/:id/rating-summary", []string{"id"}, []string{storeID})
+
+	mockUC := &testutil.MockReviewUseCase{RatingSummaryErr: usecase.ErrStoreNotFound}
+	h := handlers.NewReviewHandler(mockUC, &testutil.MockTokenVerifier{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.GetRatingSummary(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrStoreNotFound, "store not found")
+}
+
 // --- Create Tests ---
 
 func TestReviewHandler_Create_Success(t *testing.T) {
@@ -343,6 +433,105 @@ func TestReviewHandler_Create_UseCaseError(t *testing.T) {
 	testutil.AssertError(t, err, "usecase error")
 }
 
+// --- Update / Delete Tests ---
+
+func TestReviewHandler_Update_Success(t *testing.T) {
+	reviewID := uuid.New().String()
+	bodyBytes := testutil.MustMarshal(t, createReviewRequest{
+		MenuIDs: []string{"menu-1"},
+		Rating:  4,
+		Content: "Edited",
+	})
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/reviews/"+reviewID, string(bodyBytes))
+	tc.SetPath("/reviews/:id", []string{"id"}, []string{reviewID})
+
+	user := entity.User{UserID: "user-1"}
+	tc.SetUser(user, "user")
+
+	mockUC := &testutil.MockReviewUseCase{}
+	h := handlers.NewReviewHandler(mockUC, &testutil.MockTokenVerifier{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.Update(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusNoContent)
+	got := mockUC.UpdateCalledWith
+	if got.ReviewID != reviewID || got.Actor.UserID != "user-1" {
+		t.Errorf("unexpected update call: %+v", got)
+	}
+	if got.Input.Rating != 4 || got.Input.Content == nil || *got.Input.Content != "Edited" {
+		t.Errorf("unexpected update input: %+v", got.Input)
+	}
+}
+
+func TestReviewHandler_Update_Unauthorized(t *testing.T) {
+	reviewID := uuid.New().String()
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/reviews/"+reviewID, `{"rating":4}`)
+	tc.SetPath("/reviews/:id", []string{"id"}, []string{reviewID})
+
+	h := handlers.NewReviewHandler(&testutil.MockReviewUseCase{}, &testutil.MockTokenVerifier{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.Update(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrUnauthorized, "unauthorized")
+}
+
+func TestReviewHandler_Update_InvalidUUID(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/reviews/invalid-uuid", `{"rating":4}`)
+	tc.SetPath("/reviews/:id", []string{"id"}, []string{"invalid-uuid"})
+	tc.SetUser(entity.User{UserID: "user-1"}, "user")
+
+	h := handlers.NewReviewHandler(&testutil.MockReviewUseCase{}, &testutil.MockTokenVerifier{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.Update(tc.Context)
+
+	testutil.AssertError(t, err, "invalid UUID")
+}
+
+func TestReviewHandler_Update_Forbidden(t *testing.T) {
+	reviewID := uuid.New().String()
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/reviews/"+reviewID, `{"rating":4}`)
+	tc.SetPath("/reviews/:id", []string{"id"}, []string{reviewID})
+	tc.SetUser(entity.User{UserID: "user-2"}, "user")
+
+	mockUC := &testutil.MockReviewUseCase{UpdateErr: usecase.ErrForbidden}
+	h := handlers.NewReviewHandler(mockUC, &testutil.MockTokenVerifier{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.Update(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrForbidden, "forbidden")
+}
+
+func TestReviewHandler_Delete_Success(t *testing.T) {
+	reviewID := uuid.New().String()
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/reviews/"+reviewID)
+	tc.SetPath("/reviews/:id", []string{"id"}, []string{reviewID})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+
+	mockUC := &testutil.MockReviewUseCase{}
+	h := handlers.NewReviewHandler(mockUC, &testutil.MockTokenVerifier{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.Delete(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusNoContent)
+	if mockUC.DeleteCalledWith.ReviewID != reviewID || mockUC.DeleteCalledWith.Actor.UserID != "admin-1" {
+		t.Errorf("unexpected delete call: %+v", mockUC.DeleteCalledWith)
+	}
+}
+
+func TestReviewHandler_Delete_UseCaseError(t *testing.T) {
+	reviewID := uuid.New().String()
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/reviews/"+reviewID)
+	tc.SetPath("/reviews/:id", []string{"id"}, []string{reviewID})
+	tc.SetUser(entity.User{UserID: "user-1"}, "user")
+
+	mockUC := &testutil.MockReviewUseCase{DeleteErr: usecase.ErrReviewNotFound}
+	h := handlers.NewReviewHandler(mockUC, &testutil.MockTokenVerifier{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.Delete(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrReviewNotFound, "review not found")
+}
+
 // --- LikeReview Tests ---
 
 func TestReviewHandler_LikeReview_Success(t *testing.T) {
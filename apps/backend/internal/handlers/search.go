@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type SearchHandler struct {
+	searchUseCase input.SearchUseCase
+	storage       output.StorageProvider
+	bucket        string
+}
+
+func NewSearchHandler(searchUseCase input.SearchUseCase, storage output.StorageProvider, bucket string) *SearchHandler {
+	return &SearchHandler{
+		searchUseCase: searchUseCase,
+		storage:       storage,
+		bucket:        bucket,
+	}
+}
+
+// Search returns one page of stores and reviews matching ?q=, most relevant first.
+// The cursor for the next page is sent in the X-Next-Cursor header, as with GetStores.
+func (h *SearchHandler) Search(c echo.Context) error {
+	limit, err := parseIntQuery(c, "limit", "invalid limit")
+	if err != nil {
+		return err
+	}
+	page, err := h.searchUseCase.Search(c.Request().Context(), input.SearchQuery{
+		Q:      c.QueryParam("q"),
+		Type:   c.QueryParam("type"),
+		Limit:  limit,
+		Cursor: c.QueryParam("cursor"),
+	})
+	if err != nil {
+		return err
+	}
+	if page.NextCursor != "" {
+		c.Response().Header().Set(infrahttp.HeaderNextCursor, page.NextCursor)
+	}
+	resp := presenter.NewSearchResultResponses(page.Results)
+	attachSignedURLsToSearchResults(c.Request().Context(), h.storage, h.bucket, resp)
+	return c.JSON(http.StatusOK, resp)
+}
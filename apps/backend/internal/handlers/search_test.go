@@ -0,0 +1,93 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+func TestSearchHandler_Search_Success(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/search?q=cafe&type=store&limit=5&cursor=abc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := &testutil.MockSearchUseCase{
+		Page: &input.SearchPage{
+			Results: []entity.SearchResult{
+				{
+					Type:  constants.SearchTypeStore,
+					Score: 0.75,
+					Store: &entity.Store{StoreID: "store-1", Name: "Cafe Blue"},
+					Highlights: []entity.SearchHighlight{
+						{Field: "name", Segments: []entity.SearchSegment{{Text: "Cafe", Match: true}, {Text: " Blue"}}},
+					},
+				},
+			},
+			NextCursor: "next",
+		},
+	}
+	h := handlers.NewSearchHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.Search(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if got := rec.Header().Get(infrahttp.HeaderNextCursor); got != "next" {
+		t.Errorf("expected next cursor header %q, got %q", "next", got)
+	}
+	want := input.SearchQuery{Q: "cafe", Type: "store", Limit: 5, Cursor: "abc"}
+	if mockUC.SearchCalledWith != want {
+		t.Errorf("expected query %+v, got %+v", want, mockUC.SearchCalledWith)
+	}
+
+	var response []presenter.SearchResultResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(response) != 1 || response[0].Store == nil || response[0].Store.StoreID != "store-1" {
+		t.Fatalf("unexpected response: %+v", response)
+	}
+	if len(response[0].Highlights) != 1 || !response[0].Highlights[0].Segments[0].Match {
+		t.Errorf("unexpected highlights: %+v", response[0].Highlights)
+	}
+}
+
+func TestSearchHandler_Search_InvalidLimit(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/search?q=cafe&limit=abc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	h := handlers.NewSearchHandler(&testutil.MockSearchUseCase{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	testutil.AssertError(t, h.Search(c), "invalid limit")
+}
+
+func TestSearchHandler_Search_UseCaseError(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/search?q=cafe", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	searchErr := errors.New("search failed")
+	h := handlers.NewSearchHandler(&testutil.MockSearchUseCase{SearchErr: searchErr}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.Search(c); !errors.Is(err, searchErr) {
+		t.Errorf("expected use case error, got %v", err)
+	}
+}
@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 	"github.com/labstack/echo/v4"
 )
@@ -15,8 +17,9 @@ func NewStationHandler(u input.StationUseCase) *StationHandler {
 	return &StationHandler{u: u}
 }
 
+// ListStations returns stations, optionally filtered by ?q= (name/kana prefix) and ?kind=.
 func (h *StationHandler) ListStations(c echo.Context) error {
-	stations, err := h.u.ListStations(c.Request().Context())
+	stations, err := h.u.ListStations(c.Request().Context(), stationQueryFromRequest(c))
 	if err != nil {
 		// Log the actual error for debugging
 		c.Logger().Error("Failed to list stations: ", err)
@@ -24,3 +27,44 @@ func (h *StationHandler) ListStations(c echo.Context) error {
 	}
 	return c.JSON(http.StatusOK, stations)
 }
+
+// ListStationGroups returns stations grouped by kind (area), accepting the same filters as ListStations.
+func (h *StationHandler) ListStationGroups(c echo.Context) error {
+	groups, err := h.u.GroupStationsByKind(c.Request().Context(), stationQueryFromRequest(c))
+	if err != nil {
+		c.Logger().Error("Failed to group stations: ", err)
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "駅情報の取得に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, groups)
+}
+
+// GetNearestStations returns the stations closest to ?lat=&lng=.
+func (h *StationHandler) GetNearestStations(c echo.Context) error {
+	lat, err := parseOptionalFloatQuery(c, "lat", "invalid lat")
+	if err != nil {
+		return err
+	}
+	lng, err := parseOptionalFloatQuery(c, "lng", "invalid lng")
+	if err != nil {
+		return err
+	}
+	if lat == nil || lng == nil {
+		return presentation.NewBadRequest("lat and lng are required")
+	}
+	limit, err := parseIntQuery(c, "limit", "invalid limit")
+	if err != nil {
+		return err
+	}
+	stations, err := h.u.FindNearestStations(c.Request().Context(), *lat, *lng, limit)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, stations)
+}
+
+func stationQueryFromRequest(c echo.Context) input.StationQuery {
+	return input.StationQuery{
+		Q:    strings.TrimSpace(c.QueryParam("q")),
+		Kind: strings.TrimSpace(c.QueryParam("kind")),
+	}
+}
@@ -0,0 +1,136 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+)
+
+// --- ListStations Tests ---
+
+func TestStationHandler_ListStations_PassesQuery(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/stations?q="+url.QueryEscape("さん")+"&kind="+url.QueryEscape("三宮・元町"), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := &testutil.MockStationUseCase{
+		Stations: []entity.Station{{ID: 1, Name: "三ノ宮駅(ＪＲ)", Kana: "さんのみや", Kind: "三宮・元町"}},
+	}
+	h := handlers.NewStationHandler(mockUC)
+
+	if err := h.ListStations(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if mockUC.ListStationsCalledWith.Q != "さん" || mockUC.ListStationsCalledWith.Kind != "三宮・元町" {
+		t.Errorf("unexpected query: %+v", mockUC.ListStationsCalledWith)
+	}
+}
+
+func TestStationHandler_ListStations_Error(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/stations", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	h := handlers.NewStationHandler(&testutil.MockStationUseCase{ListErr: errors.New("database error")})
+
+	if err := h.ListStations(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+}
+
+// --- ListStationGroups Tests ---
+
+func TestStationHandler_ListStationGroups(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/stations/groups", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := &testutil.MockStationUseCase{
+		Groups: []entity.StationGroup{
+			{Kind: "須磨", Stations: []entity.Station{{ID: 2, Name: "須磨駅", Kind: "須磨"}}},
+		},
+	}
+	h := handlers.NewStationHandler(mockUC)
+
+	if err := h.ListStationGroups(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response []map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(response) != 1 || response[0]["kind"] != "須磨" {
+		t.Errorf("unexpected response: %v", response)
+	}
+}
+
+// --- GetNearestStations Tests ---
+
+func TestStationHandler_GetNearestStations_Success(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/stations/nearest?lat=34.69&lng=135.19&limit=3", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	distance := 120.5
+	mockUC := &testutil.MockStationUseCase{
+		Stations: []entity.Station{{ID: 1, Name: "三ノ宮駅(ＪＲ)", DistanceMeters: &distance}},
+	}
+	h := handlers.NewStationHandler(mockUC)
+
+	if err := h.GetNearestStations(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := mockUC.FindNearestStationsCalledWith
+	if got.Lat != 34.69 || got.Lng != 135.19 || got.Limit != 3 {
+		t.Errorf("unexpected arguments: %+v", got)
+	}
+
+	var response []map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(response) != 1 || response[0]["distance_meters"] != distance {
+		t.Errorf("expected distance_meters in response, got %v", response)
+	}
+}
+
+func TestStationHandler_GetNearestStations_InvalidQuery(t *testing.T) {
+	for _, query := range []string{"", "lat=34.69", "lat=abc&lng=135", "lat=34&lng=135&limit=x"} {
+		t.Run(query, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/stations/nearest?"+query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockUC := &testutil.MockStationUseCase{}
+			h := handlers.NewStationHandler(mockUC)
+
+			if err := h.GetNearestStations(c); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if mockUC.FindNearestStationsCalled {
+				t.Error("use case should not be called for invalid query")
+			}
+		})
+	}
+}
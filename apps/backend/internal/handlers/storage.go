@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// StorageHandler は自前のオブジェクトサーバーを持たないストレージ（ローカルストレージ）の署名付き URL を受け付けます。
+// 認証は URL の署名で行うため、JWT は不要
+type StorageHandler struct {
+	store output.SignedObjectStore
+}
+
+func NewStorageHandler(store output.SignedObjectStore) *StorageHandler {
+	return &StorageHandler{store: store}
+}
+
+// UploadObject は署名付きアップロード URL への PUT でオブジェクトを保存します
+func (h *StorageHandler) UploadObject(c echo.Context) error {
+	objectPath, err := objectPathParam(c)
+	if err != nil {
+		return err
+	}
+	req := c.Request()
+	err = h.store.ReceiveSignedUpload(
+		req.Context(),
+		c.Param("bucket"),
+		objectPath,
+		c.QueryParams(),
+		req.Header.Get(echo.HeaderContentType),
+		req.Body,
+	)
+	if err != nil {
+		return storageError(err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// DownloadObject は署名付きダウンロード URL のオブジェクトを返します
+func (h *StorageHandler) DownloadObject(c echo.Context) error {
+	objectPath, err := objectPathParam(c)
+	if err != nil {
+		return err
+	}
+	body, info, err := h.store.OpenSignedDownload(c.Request().Context(), c.Param("bucket"), objectPath, c.QueryParams())
+	if err != nil {
+		return storageError(err)
+	}
+	defer body.Close()
+
+	c.Response().Header().Set(echo.HeaderContentLength, strconv.FormatInt(info.Size, 10))
+	return c.Stream(http.StatusOK, info.ContentType, body)
+}
+
+// objectPathParam はオブジェクトのパスを返します。
+// Echo は URL.RawPath がある（%2F などを含む）リクエストではエスケープしたままのパスでルーティングするため、その場合だけ戻す
+func objectPathParam(c echo.Context) (string, error) {
+	objectPath := c.Param("*")
+	if c.Request().URL.RawPath != "" {
+		var err error
+		if objectPath, err = url.PathUnescape(objectPath); err != nil {
+			return "", presentation.NewBadRequest("invalid object path")
+		}
+	}
+	if objectPath == "" {
+		return "", presentation.NewBadRequest("invalid object path")
+	}
+	return objectPath, nil
+}
+
+// storageError はストレージのエラーを Supabase Storage の署名付き URL と同じ意味のステータスに変換します
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, output.ErrInvalidSignedURL):
+		return presentation.NewForbidden(err.Error())
+	case errors.Is(err, output.ErrContentTypeMismatch):
+		return presentation.NewBadRequest(err.Error())
+	case errors.Is(err, output.ErrObjectExists):
+		return presentation.NewHTTPError(http.StatusConflict, presentation.NewErrorResponse(err.Error()))
+	case errors.Is(err, output.ErrObjectNotFound):
+		return presentation.NewHTTPError(http.StatusNotFound, presentation.NewErrorResponse(err.Error()))
+	default:
+		return err
+	}
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type mockSignedObjectStore struct {
+	err         error
+	content     string
+	bucket      string
+	objectPath  string
+	query       url.Values
+	contentType string
+	received    string
+}
+
+func (m *mockSignedObjectStore) ReceiveSignedUpload(ctx context.Context, bucket, objectPath string, query url.Values, contentType string, content io.Reader) error {
+	m.bucket, m.objectPath, m.query, m.contentType = bucket, objectPath, query, contentType
+	if m.err != nil {
+		return m.err
+	}
+	body, err := io.ReadAll(content)
+	m.received = string(body)
+	return err
+}
+
+func (m *mockSignedObjectStore) OpenSignedDownload(ctx context.Context, bucket, objectPath string, query url.Values) (io.ReadCloser, *output.ObjectInfo, error) {
+	m.bucket, m.objectPath, m.query = bucket, objectPath, query
+	if m.err != nil {
+		return nil, nil, m.err
+	}
+	info := &output.ObjectInfo{Size: int64(len(m.content)), ContentType: "image/png"}
+	return io.NopCloser(strings.NewReader(m.content)), info, nil
+}
+
+// serveStorage は router と同じパスで StorageHandler を呼び出し、ハンドラーが返したエラーを返します
+func serveStorage(t *testing.T, store *mockSignedObjectStore, req *http.Request) (*httptest.ResponseRecorder, error) {
+	t.Helper()
+	h := NewStorageHandler(store)
+	var handlerErr error
+	capture := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			handlerErr = next(c)
+			return nil
+		}
+	}
+	e := echo.New()
+	e.PUT("/storage/:bucket/*", capture(h.UploadObject))
+	e.GET("/storage/:bucket/*", capture(h.DownloadObject))
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec, handlerErr
+}
+
+func TestStorageHandler_UploadObject(t *testing.T) {
+	store := &mockSignedObjectStore{}
+	req := httptest.NewRequest(http.MethodPut, "/storage/test-bucket/reviews/a%20b.png?expires=1&signature=sig", strings.NewReader("png"))
+	req.Header.Set(echo.HeaderContentType, "image/png")
+
+	rec, err := serveStorage(t, store, req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "test-bucket", store.bucket)
+	assert.Equal(t, "reviews/a b.png", store.objectPath)
+	assert.Equal(t, "sig", store.query.Get("signature"))
+	assert.Equal(t, "image/png", store.contentType)
+	assert.Equal(t, "png", store.received)
+}
+
+func TestStorageHandler_ObjectPathEscaping(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/storage/test-bucket/reviews/100%25.png", want: "reviews/100%.png"},
+		{path: "/storage/test-bucket/reviews/a%2Fb%25.png", want: "reviews/a/b%.png"},
+		{path: "/storage/test-bucket/%E5%86%99%E7%9C%9F.png", want: "写真.png"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			store := &mockSignedObjectStore{}
+			_, err := serveStorage(t, store, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, store.objectPath)
+		})
+	}
+}
+
+func TestStorageHandler_DownloadObject(t *testing.T) {
+	store := &mockSignedObjectStore{content: "png-bytes"}
+
+	rec, err := serveStorage(t, store, httptest.NewRequest(http.MethodGet, "/storage/test-bucket/reviews/a.png?signature=sig", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "png-bytes", rec.Body.String())
+	assert.Equal(t, "image/png", rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "9", rec.Header().Get(echo.HeaderContentLength))
+	assert.Equal(t, "reviews/a.png", store.objectPath)
+}
+
+func TestStorageHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "invalid signature", err: output.ErrInvalidSignedURL, status: http.StatusForbidden},
+		{name: "content type mismatch", err: output.ErrContentTypeMismatch, status: http.StatusBadRequest},
+		{name: "object exists", err: output.ErrObjectExists, status: http.StatusConflict},
+		{name: "not found", err: output.ErrObjectNotFound, status: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockSignedObjectStore{err: tt.err}
+			_, err := serveStorage(t, store, httptest.NewRequest(http.MethodPut, "/storage/test-bucket/a.png", strings.NewReader("x")))
+
+			var httpErr *presentation.HTTPError
+			require.True(t, errors.As(err, &httpErr), "expected *presentation.HTTPError, got %T", err)
+			assert.Equal(t, tt.status, httpErr.Status)
+		})
+	}
+
+	unexpected := errors.New("disk full")
+	_, err := serveStorage(t, &mockSignedObjectStore{err: unexpected}, httptest.NewRequest(http.MethodGet, "/storage/test-bucket/a.png", nil))
+	assert.ErrorIs(t, err, unexpected)
+}
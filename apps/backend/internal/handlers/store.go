@@ -7,6 +7,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
 	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
@@ -34,8 +35,117 @@ func (h *StoreHandler) respondWithStore(c echo.Context, store *entity.Store, sta
 	return c.JSON(status, responses[0])
 }
 
+// GetStores returns one page of stores. The cursor for the next page is sent in the X-Next-Cursor header
+// so that the response body stays a plain array.
 func (h *StoreHandler) GetStores(c echo.Context) error {
-	stores, err := h.storeUseCase.GetAllStores(c.Request().Context())
+	query, err := parseListStoresQuery(c)
+	if err != nil {
+		return err
+	}
+	page, err := h.storeUseCase.ListStores(c.Request().Context(), getOptionalUser(c), query)
+	if err != nil {
+		return err
+	}
+	if page.NextCursor != "" {
+		c.Response().Header().Set(infrahttp.HeaderNextCursor, page.NextCursor)
+	}
+	resp := presenter.NewStoreResponses(page.Stores)
+	attachSignedURLsToStoreResponses(c.Request().Context(), h.storage, h.bucket, resp)
+	return c.JSON(http.StatusOK, resp)
+}
+
+func parseListStoresQuery(c echo.Context) (input.ListStoresQuery, error) {
+	limit, err := parseIntQuery(c, "limit", "invalid limit")
+	if err != nil {
+		return input.ListStoresQuery{}, err
+	}
+	minRating, err := parseOptionalFloatQuery(c, "min_rating", "invalid min_rating")
+	if err != nil {
+		return input.ListStoresQuery{}, err
+	}
+	openedAfter, err := parseOptionalDateQuery(c, "opened_after", "invalid opened_after")
+	if err != nil {
+		return input.ListStoresQuery{}, err
+	}
+	approved, err := parseOptionalBoolQuery(c, "approved", "invalid approved")
+	if err != nil {
+		return input.ListStoresQuery{}, err
+	}
+	openNow, err := parseOptionalBoolQuery(c, "open_now", "invalid open_now")
+	if err != nil {
+		return input.ListStoresQuery{}, err
+	}
+	return input.ListStoresQuery{
+		Limit:       limit,
+		Cursor:      c.QueryParam("cursor"),
+		Category:    optionalStringQuery(c, "category"),
+		Budget:      optionalStringQuery(c, "budget"),
+		Tag:         optionalStringQuery(c, "tag"),
+		MinRating:   minRating,
+		OpenedAfter: openedAfter,
+		IsApproved:  approved,
+		OpenNow:     openNow != nil && *openNow,
+		Sort:        c.QueryParam("sort"),
+	}, nil
+}
+
+// GetNearbyStores returns stores around ?lat=&lng= or ?station_id=, nearest first.
+func (h *StoreHandler) GetNearbyStores(c echo.Context) error {
+	query, err := parseNearbyStoresQuery(c)
+	if err != nil {
+		return err
+	}
+	stores, err := h.storeUseCase.FindNearbyStores(c.Request().Context(), getOptionalUser(c), query)
+	if err != nil {
+		return err
+	}
+	resp := presenter.NewStoreResponses(stores)
+	attachSignedURLsToStoreResponses(c.Request().Context(), h.storage, h.bucket, resp)
+	return c.JSON(http.StatusOK, resp)
+}
+
+func parseNearbyStoresQuery(c echo.Context) (input.NearbyStoresQuery, error) {
+	lat, err := parseOptionalFloatQuery(c, "lat", "invalid lat")
+	if err != nil {
+		return input.NearbyStoresQuery{}, err
+	}
+	lng, err := parseOptionalFloatQuery(c, "lng", "invalid lng")
+	if err != nil {
+		return input.NearbyStoresQuery{}, err
+	}
+	stationID, err := parseOptionalInt64Query(c, "station_id", "invalid station_id")
+	if err != nil {
+		return input.NearbyStoresQuery{}, err
+	}
+	radius, err := parseIntQuery(c, "radius_m", "invalid radius_m")
+	if err != nil {
+		return input.NearbyStoresQuery{}, err
+	}
+	limit, err := parseIntQuery(c, "limit", "invalid limit")
+	if err != nil {
+		return input.NearbyStoresQuery{}, err
+	}
+	openNow, err := parseOptionalBoolQuery(c, "open_now", "invalid open_now")
+	if err != nil {
+		return input.NearbyStoresQuery{}, err
+	}
+	return input.NearbyStoresQuery{
+		Latitude:     lat,
+		Longitude:    lng,
+		StationID:    stationID,
+		RadiusMeters: radius,
+		Limit:        limit,
+		OpenNow:      openNow != nil && *openNow,
+	}, nil
+}
+
+// GetOwnedStores returns the stores managed by the authenticated owner.
+func (h *StoreHandler) GetOwnedStores(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	stores, err := h.storeUseCase.ListOwnedStores(c.Request().Context(), user.UserID)
 	if err != nil {
 		return err
 	}
@@ -49,7 +159,7 @@ func (h *StoreHandler) GetStoreByID(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	store, err := h.storeUseCase.GetStoreByID(c.Request().Context(), id)
+	store, err := h.storeUseCase.GetStoreByID(c.Request().Context(), getOptionalUser(c), id)
 	if err != nil {
 		return err
 	}
@@ -57,11 +167,19 @@ func (h *StoreHandler) GetStoreByID(c echo.Context) error {
 }
 
 func (h *StoreHandler) CreateStore(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
 	var dto createStoreDTO
-	if err := bindJSON(c, &dto); err != nil {
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	in, err := dto.toInput()
+	if err != nil {
 		return err
 	}
-	store, err := h.storeUseCase.CreateStore(c.Request().Context(), dto.toInput())
+	store, err := h.storeUseCase.CreateStore(c.Request().Context(), user, in)
 	if err != nil {
 		return err
 	}
@@ -69,6 +187,10 @@ func (h *StoreHandler) CreateStore(c echo.Context) error {
 }
 
 func (h *StoreHandler) UpdateStore(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
 	id, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
 	if err != nil {
 		return err
@@ -77,7 +199,11 @@ func (h *StoreHandler) UpdateStore(c echo.Context) error {
 	if err = bindJSON(c, &dto); err != nil {
 		return err
 	}
-	store, err := h.storeUseCase.UpdateStore(c.Request().Context(), id, dto.toInput())
+	in, err := dto.toInput()
+	if err != nil {
+		return err
+	}
+	store, err := h.storeUseCase.UpdateStore(c.Request().Context(), user, id, in)
 	if err != nil {
 		return err
 	}
@@ -85,68 +211,88 @@ func (h *StoreHandler) UpdateStore(c echo.Context) error {
 }
 
 func (h *StoreHandler) DeleteStore(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
 	id, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
 	if err != nil {
 		return err
 	}
-	if err = h.storeUseCase.DeleteStore(c.Request().Context(), id); err != nil {
+	if err = h.storeUseCase.DeleteStore(c.Request().Context(), user, id); err != nil {
 		return err
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
 type createStoreDTO struct {
-	Name            string     `json:"name"`
-	Address         string     `json:"address"`
-	ThumbnailFileID *string    `json:"thumbnail_file_id"`
-	OpenedAt        *time.Time `json:"opened_at"`
-	Description     *string    `json:"description"`
-	OpeningHours    *string    `json:"opening_hours"`
-	Latitude        float64    `json:"latitude"`
-	Longitude       float64    `json:"longitude"`
-	GoogleMapURL    *string    `json:"google_map_url"`
-	PlaceID         string     `json:"place_id"`
-}
-
-func (dto createStoreDTO) toInput() input.CreateStoreInput {
+	Name            string              `json:"name"`
+	NameKana        *string             `json:"name_kana"`
+	Address         string              `json:"address"`
+	OpenedAt        *time.Time          `json:"opened_at"`
+	Description     *string             `json:"description"`
+	OpeningHours    *string             `json:"opening_hours"`
+	OpeningSchedule *openingScheduleDTO `json:"opening_schedule"`
+	Latitude        float64             `json:"latitude"`
+	Longitude       float64             `json:"longitude"`
+	GoogleMapURL    *string             `json:"google_map_url"`
+	PlaceID         string              `json:"place_id"`
+	Tags            []string            `json:"tags"`
+}
+
+func (dto createStoreDTO) toInput() (input.CreateStoreInput, error) {
+	schedule, err := dto.OpeningSchedule.toEntity()
+	if err != nil {
+		return input.CreateStoreInput{}, err
+	}
 	return input.CreateStoreInput{
 		Name:            dto.Name,
+		NameKana:        dto.NameKana,
 		Address:         dto.Address,
-		ThumbnailFileID: dto.ThumbnailFileID,
 		OpenedAt:        dto.OpenedAt,
 		Description:     dto.Description,
 		OpeningHours:    dto.OpeningHours,
+		OpeningSchedule: schedule,
 		Latitude:        dto.Latitude,
 		Longitude:       dto.Longitude,
 		GoogleMapURL:    dto.GoogleMapURL,
 		PlaceID:         dto.PlaceID,
-	}
+		Tags:            dto.Tags,
+	}, nil
 }
 
 type updateStoreDTO struct {
-	Name            *string    `json:"name"`
-	Address         *string    `json:"address"`
-	ThumbnailFileID *string    `json:"thumbnail_file_id"`
-	OpenedAt        *time.Time `json:"opened_at"`
-	Description     *string    `json:"description"`
-	OpeningHours    *string    `json:"opening_hours"`
-	Latitude        *float64   `json:"latitude"`
-	Longitude       *float64   `json:"longitude"`
-	GoogleMapURL    *string    `json:"google_map_url"`
-	PlaceID         *string    `json:"place_id"`
-}
-
-func (dto updateStoreDTO) toInput() input.UpdateStoreInput {
+	Name            *string             `json:"name"`
+	NameKana        *string             `json:"name_kana"`
+	Address         *string             `json:"address"`
+	OpenedAt        *time.Time          `json:"opened_at"`
+	Description     *string             `json:"description"`
+	OpeningHours    *string             `json:"opening_hours"`
+	OpeningSchedule *openingScheduleDTO `json:"opening_schedule"`
+	Latitude        *float64            `json:"latitude"`
+	Longitude       *float64            `json:"longitude"`
+	GoogleMapURL    *string             `json:"google_map_url"`
+	PlaceID         *string             `json:"place_id"`
+	Tags            []string            `json:"tags"`
+}
+
+func (dto updateStoreDTO) toInput() (input.UpdateStoreInput, error) {
+	schedule, err := dto.OpeningSchedule.toEntity()
+	if err != nil {
+		return input.UpdateStoreInput{}, err
+	}
 	return input.UpdateStoreInput{
 		Name:            dto.Name,
+		NameKana:        dto.NameKana,
 		Address:         dto.Address,
-		ThumbnailFileID: dto.ThumbnailFileID,
 		OpenedAt:        dto.OpenedAt,
 		Description:     dto.Description,
 		OpeningHours:    dto.OpeningHours,
+		OpeningSchedule: schedule,
 		Latitude:        dto.Latitude,
 		Longitude:       dto.Longitude,
 		GoogleMapURL:    dto.GoogleMapURL,
 		PlaceID:         dto.PlaceID,
-	}
+		Tags:            dto.Tags,
+	}, nil
 }
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type StoreApprovalHandler struct {
+	approvalUseCase input.StoreApprovalUseCase
+	storage         output.StorageProvider
+	bucket          string
+}
+
+func NewStoreApprovalHandler(approvalUseCase input.StoreApprovalUseCase, storage output.StorageProvider, bucket string) *StoreApprovalHandler {
+	return &StoreApprovalHandler{
+		approvalUseCase: approvalUseCase,
+		storage:         storage,
+		bucket:          bucket,
+	}
+}
+
+type storeApprovalDTO struct {
+	Reason *string `json:"reason"`
+}
+
+// SubmitStore sends a draft or rejected store to the admins for approval.
+func (h *StoreApprovalHandler) SubmitStore(c echo.Context) error {
+	return h.transition(c, h.approvalUseCase.SubmitStore)
+}
+
+// GetReviewHistory returns the approval history of a store, oldest first.
+func (h *StoreApprovalHandler) GetReviewHistory(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	events, err := h.approvalUseCase.GetApprovalHistory(c.Request().Context(), user, storeID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewStoreApprovalEventResponses(events))
+}
+
+func (h *StoreApprovalHandler) ApproveStore(c echo.Context) error {
+	return h.transition(c, h.approvalUseCase.ApproveStore)
+}
+
+// RejectStore rejects a store awaiting approval. The body must carry a non-empty reason.
+func (h *StoreApprovalHandler) RejectStore(c echo.Context) error {
+	return h.transition(c, h.approvalUseCase.RejectStore)
+}
+
+type storeApprovalFunc func(ctx context.Context, actor entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error)
+
+func (h *StoreApprovalHandler) transition(c echo.Context, transition storeApprovalFunc) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	var dto storeApprovalDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	store, err := transition(c.Request().Context(), user, storeID, input.StoreApprovalInput{Reason: dto.Reason})
+	if err != nil {
+		return err
+	}
+	resp := []presenter.StoreResponse{presenter.NewStoreResponse(*store)}
+	attachSignedURLsToStoreResponses(c.Request().Context(), h.storage, h.bucket, resp)
+	return c.JSON(http.StatusOK, resp[0])
+}
@@ -0,0 +1,170 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+)
+
+const testApprovalStoreID = "550e8400-e29b-41d4-a716-446655440000"
+
+func newApprovalHandler(mockUC *testutil.MockStoreApprovalUseCase) *handlers.StoreApprovalHandler {
+	return handlers.NewStoreApprovalHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+}
+
+// --- SubmitStore Tests ---
+
+func TestStoreApprovalHandler_SubmitStore_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/stores/"+testApprovalStoreID+"/submit")
+	tc.SetPath("/stores/:id/submit", []string{"id"}, []string{testApprovalStoreID})
+	owner := entity.User{UserID: "owner-1"}
+	tc.SetUser(owner, "owner")
+
+	mockUC := &testutil.MockStoreApprovalUseCase{Store: &entity.Store{
+		StoreID:        testApprovalStoreID,
+		ApprovalStatus: constants.StoreApprovalSubmitted,
+	}}
+	err := newApprovalHandler(mockUC).SubmitStore(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.CalledWith.Action != "submit" {
+		t.Errorf("expected submit, got %s", mockUC.CalledWith.Action)
+	}
+	if mockUC.CalledWith.Actor.UserID != owner.UserID {
+		t.Errorf("expected actor %s, got %s", owner.UserID, mockUC.CalledWith.Actor.UserID)
+	}
+
+	var response struct {
+		ApprovalStatus string `json:"approval_status"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if response.ApprovalStatus != constants.StoreApprovalSubmitted {
+		t.Errorf("expected approval_status submitted, got %q", response.ApprovalStatus)
+	}
+}
+
+func TestStoreApprovalHandler_SubmitStore_Unauthorized(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/stores/"+testApprovalStoreID+"/submit")
+	tc.SetPath("/stores/:id/submit", []string{"id"}, []string{testApprovalStoreID})
+
+	err := newApprovalHandler(&testutil.MockStoreApprovalUseCase{}).SubmitStore(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrUnauthorized, "expected unauthorized error")
+}
+
+func TestStoreApprovalHandler_SubmitStore_InvalidTransition(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/stores/"+testApprovalStoreID+"/submit")
+	tc.SetPath("/stores/:id/submit", []string{"id"}, []string{testApprovalStoreID})
+	tc.SetUser(entity.User{UserID: "owner-1"}, "owner")
+
+	mockUC := &testutil.MockStoreApprovalUseCase{Err: usecase.ErrStoreApprovalTransition}
+	err := newApprovalHandler(mockUC).SubmitStore(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrStoreApprovalTransition, "expected conflict error")
+}
+
+// --- GetReviewHistory Tests ---
+
+func TestStoreApprovalHandler_GetReviewHistory_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/"+testApprovalStoreID+"/review-history")
+	tc.SetPath("/stores/:id/review-history", []string{"id"}, []string{testApprovalStoreID})
+	tc.SetUser(entity.User{UserID: "owner-1"}, "owner")
+
+	mockUC := &testutil.MockStoreApprovalUseCase{Events: []entity.StoreApprovalEvent{
+		{EventID: 1, StoreID: testApprovalStoreID, FromStatus: constants.StoreApprovalDraft, ToStatus: constants.StoreApprovalSubmitted},
+		{EventID: 2, StoreID: testApprovalStoreID, FromStatus: constants.StoreApprovalSubmitted, ToStatus: constants.StoreApprovalRejected, Reason: testutil.StringPtr("photos are missing")},
+	}}
+	err := newApprovalHandler(mockUC).GetReviewHistory(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.HistoryCalledWith != testApprovalStoreID {
+		t.Errorf("expected history of %s, got %s", testApprovalStoreID, mockUC.HistoryCalledWith)
+	}
+
+	var response []struct {
+		ToStatus string  `json:"to_status"`
+		Reason   *string `json:"reason"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if len(response) != 2 || response[1].Reason == nil || *response[1].Reason != "photos are missing" {
+		t.Errorf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+}
+
+func TestStoreApprovalHandler_GetReviewHistory_InvalidID(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/bad/review-history")
+	tc.SetPath("/stores/:id/review-history", []string{"id"}, []string{"bad"})
+	tc.SetUser(entity.User{UserID: "owner-1"}, "owner")
+
+	err := newApprovalHandler(&testutil.MockStoreApprovalUseCase{}).GetReviewHistory(tc.Context)
+
+	testutil.AssertError(t, err, "expected error for invalid store id")
+}
+
+// --- ApproveStore / RejectStore Tests ---
+
+func TestStoreApprovalHandler_ApproveStore_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/stores/"+testApprovalStoreID+"/approve")
+	tc.SetPath("/admin/stores/:id/approve", []string{"id"}, []string{testApprovalStoreID})
+	admin := entity.User{UserID: "admin-1"}
+	tc.SetUser(admin, "admin")
+
+	mockUC := &testutil.MockStoreApprovalUseCase{}
+	err := newApprovalHandler(mockUC).ApproveStore(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.CalledWith.Action != "approve" {
+		t.Errorf("expected approve, got %s", mockUC.CalledWith.Action)
+	}
+	if mockUC.CalledWith.Actor.UserID != admin.UserID {
+		t.Errorf("expected admin %s, got %s", admin.UserID, mockUC.CalledWith.Actor.UserID)
+	}
+}
+
+func TestStoreApprovalHandler_ApproveStore_InvalidUUID(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/stores/invalid-uuid/approve")
+	tc.SetPath("/admin/stores/:id/approve", []string{"id"}, []string{"invalid-uuid"})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+
+	err := newApprovalHandler(&testutil.MockStoreApprovalUseCase{}).ApproveStore(tc.Context)
+
+	testutil.AssertError(t, err, "invalid UUID")
+}
+
+func TestStoreApprovalHandler_RejectStore_PassesReason(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/stores/"+testApprovalStoreID+"/reject", `{"reason":"photos are missing"}`)
+	tc.SetPath("/admin/stores/:id/reject", []string{"id"}, []string{testApprovalStoreID})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+
+	mockUC := &testutil.MockStoreApprovalUseCase{}
+	err := newApprovalHandler(mockUC).RejectStore(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.CalledWith.Action != "reject" {
+		t.Errorf("expected reject, got %s", mockUC.CalledWith.Action)
+	}
+	if mockUC.CalledWith.Input.Reason == nil || *mockUC.CalledWith.Input.Reason != "photos are missing" {
+		t.Errorf("expected reason to be passed, got %v", mockUC.CalledWith.Input.Reason)
+	}
+}
+
+func TestStoreApprovalHandler_RejectStore_ReasonRequired(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/stores/"+testApprovalStoreID+"/reject")
+	tc.SetPath("/admin/stores/:id/reject", []string{"id"}, []string{testApprovalStoreID})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+
+	mockUC := &testutil.MockStoreApprovalUseCase{Err: usecase.ErrRejectionReasonRequired}
+	err := newApprovalHandler(mockUC).RejectStore(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrRejectionReasonRequired, "expected invalid input error")
+}
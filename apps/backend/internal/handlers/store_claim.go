@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type StoreClaimHandler struct {
+	claimUseCase input.StoreClaimUseCase
+	storage      output.StorageProvider
+	bucket       string
+}
+
+func NewStoreClaimHandler(claimUseCase input.StoreClaimUseCase, storage output.StorageProvider, bucket string) *StoreClaimHandler {
+	return &StoreClaimHandler{
+		claimUseCase: claimUseCase,
+		storage:      storage,
+		bucket:       bucket,
+	}
+}
+
+type submitClaimDTO struct {
+	Note    string   `json:"note"`
+	FileIDs []string `json:"file_ids"`
+}
+
+type reviewClaimDTO struct {
+	Note *string `json:"note"`
+}
+
+// SubmitClaim lets an owner request management rights for an existing store.
+func (h *StoreClaimHandler) SubmitClaim(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	var dto submitClaimDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	claim, err := h.claimUseCase.SubmitClaim(c.Request().Context(), user, storeID, input.SubmitStoreClaimInput{
+		Note:    dto.Note,
+		FileIDs: dto.FileIDs,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, presenter.NewStoreClaimResponse(*claim))
+}
+
+// ListClaims returns claims for admins, optionally filtered by ?status=.
+func (h *StoreClaimHandler) ListClaims(c echo.Context) error {
+	claims, err := h.claimUseCase.ListClaims(c.Request().Context(), c.QueryParam("status"))
+	if err != nil {
+		return err
+	}
+	resp := presenter.NewStoreClaimResponses(claims)
+	h.attachSignedURLs(c, resp)
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *StoreClaimHandler) GetClaim(c echo.Context) error {
+	claimID, err := parseUUIDParam(c, "id", ErrMsgInvalidClaimID)
+	if err != nil {
+		return err
+	}
+	claim, err := h.claimUseCase.GetClaim(c.Request().Context(), claimID)
+	if err != nil {
+		return err
+	}
+	resp := []presenter.StoreClaimResponse{presenter.NewStoreClaimResponse(*claim)}
+	h.attachSignedURLs(c, resp)
+	return c.JSON(http.StatusOK, resp[0])
+}
+
+func (h *StoreClaimHandler) ApproveClaim(c echo.Context) error {
+	return h.reviewClaim(c, h.claimUseCase.ApproveClaim)
+}
+
+func (h *StoreClaimHandler) DenyClaim(c echo.Context) error {
+	return h.reviewClaim(c, h.claimUseCase.DenyClaim)
+}
+
+type reviewClaimFunc func(ctx context.Context, reviewer entity.User, claimID string, in input.ReviewStoreClaimInput) (*entity.StoreClaim, error)
+
+func (h *StoreClaimHandler) reviewClaim(c echo.Context, review reviewClaimFunc) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	claimID, err := parseUUIDParam(c, "id", ErrMsgInvalidClaimID)
+	if err != nil {
+		return err
+	}
+	var dto reviewClaimDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	claim, err := review(c.Request().Context(), user, claimID, input.ReviewStoreClaimInput{Note: dto.Note})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewStoreClaimResponse(*claim))
+}
+
+// attachSignedURLs signs evidence file URLs so admins can inspect them.
+func (h *StoreClaimHandler) attachSignedURLs(c echo.Context, claims []presenter.StoreClaimResponse) {
+	for i := range claims {
+		attachSignedURLsToFileResponses(c.Request().Context(), h.storage, h.bucket, claims[i].Files)
+	}
+}
@@ -0,0 +1,168 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+)
+
+const (
+	testClaimStoreID = "550e8400-e29b-41d4-a716-446655440000"
+	testClaimID      = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+)
+
+func newTestClaim() *entity.StoreClaim {
+	return &entity.StoreClaim{
+		ClaimID: testClaimID,
+		StoreID: testClaimStoreID,
+		UserID:  "owner-1",
+		Note:    "I run this shop",
+		Status:  constants.ClaimStatusPending,
+		Files:   []entity.File{{FileID: "file-1", ObjectKey: "claims/doc.pdf"}},
+	}
+}
+
+// --- SubmitClaim Tests ---
+
+func TestStoreClaimHandler_SubmitClaim_Success(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+testClaimStoreID+"/claims",
+		`{"note":"I run this shop","file_ids":["file-1"]}`)
+	tc.SetPath("/stores/:id/claims", []string{"id"}, []string{testClaimStoreID})
+	owner := entity.User{UserID: "owner-1"}
+	tc.SetUser(owner, "owner")
+
+	mockUC := &testutil.MockStoreClaimUseCase{Claim: newTestClaim()}
+	h := handlers.NewStoreClaimHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.SubmitClaim(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusCreated)
+	if mockUC.SubmitCalledWith.Actor.UserID != owner.UserID {
+		t.Errorf("expected actor %s, got %s", owner.UserID, mockUC.SubmitCalledWith.Actor.UserID)
+	}
+	if mockUC.SubmitCalledWith.StoreID != testClaimStoreID {
+		t.Errorf("expected store id %s, got %s", testClaimStoreID, mockUC.SubmitCalledWith.StoreID)
+	}
+	if len(mockUC.SubmitCalledWith.Input.FileIDs) != 1 {
+		t.Errorf("expected 1 file id, got %v", mockUC.SubmitCalledWith.Input.FileIDs)
+	}
+}
+
+func TestStoreClaimHandler_SubmitClaim_Unauthorized(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+testClaimStoreID+"/claims", `{"note":"n"}`)
+	tc.SetPath("/stores/:id/claims", []string{"id"}, []string{testClaimStoreID})
+
+	h := handlers.NewStoreClaimHandler(&testutil.MockStoreClaimUseCase{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.SubmitClaim(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrUnauthorized, "expected unauthorized error")
+}
+
+func TestStoreClaimHandler_SubmitClaim_UseCaseError(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/stores/"+testClaimStoreID+"/claims",
+		`{"note":"I run this shop","file_ids":["file-1"]}`)
+	tc.SetPath("/stores/:id/claims", []string{"id"}, []string{testClaimStoreID})
+	tc.SetUser(entity.User{UserID: "owner-1"}, "owner")
+
+	mockUC := &testutil.MockStoreClaimUseCase{SubmitErr: usecase.ErrClaimAlreadyPending}
+	h := handlers.NewStoreClaimHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.SubmitClaim(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrClaimAlreadyPending, "expected conflict error")
+}
+
+// --- ListClaims / GetClaim Tests ---
+
+func TestStoreClaimHandler_ListClaims_SignsEvidenceURLs(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/claims?status=pending")
+
+	mockUC := &testutil.MockStoreClaimUseCase{Claims: []entity.StoreClaim{*newTestClaim()}}
+	storage := &testutil.MockStorageProvider{
+		SignedURLsByKey: map[string]string{"claims/doc.pdf": "https://example.com/signed/doc.pdf"},
+	}
+	h := handlers.NewStoreClaimHandler(mockUC, storage, "test-bucket")
+
+	err := h.ListClaims(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.ListCalledWith != constants.ClaimStatusPending {
+		t.Errorf("expected status filter pending, got %q", mockUC.ListCalledWith)
+	}
+
+	var response []struct {
+		Files []struct {
+			URL *string `json:"url"`
+		} `json:"files"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if len(response) != 1 || len(response[0].Files) != 1 {
+		t.Fatalf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+	if response[0].Files[0].URL == nil || *response[0].Files[0].URL != "https://example.com/signed/doc.pdf" {
+		t.Errorf("expected signed evidence URL, got %v", response[0].Files[0].URL)
+	}
+}
+
+func TestStoreClaimHandler_GetClaim_InvalidID(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/claims/bad")
+	tc.SetPath("/admin/claims/:id", []string{"id"}, []string{"bad"})
+
+	h := handlers.NewStoreClaimHandler(&testutil.MockStoreClaimUseCase{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.GetClaim(tc.Context)
+
+	testutil.AssertError(t, err, "expected error for invalid claim id")
+}
+
+// --- ApproveClaim / DenyClaim Tests ---
+
+func TestStoreClaimHandler_ApproveClaim_Success(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/claims/"+testClaimID+"/approve", `{"note":"verified"}`)
+	tc.SetPath("/admin/claims/:id/approve", []string{"id"}, []string{testClaimID})
+	admin := entity.User{UserID: "admin-1"}
+	tc.SetUser(admin, "admin")
+
+	claim := newTestClaim()
+	claim.Status = constants.ClaimStatusApproved
+	mockUC := &testutil.MockStoreClaimUseCase{Claim: claim}
+	h := handlers.NewStoreClaimHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.ApproveClaim(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.ReviewCalledWith.Action != "approve" {
+		t.Errorf("expected approve, got %s", mockUC.ReviewCalledWith.Action)
+	}
+	if mockUC.ReviewCalledWith.Reviewer.UserID != admin.UserID {
+		t.Errorf("expected reviewer %s, got %s", admin.UserID, mockUC.ReviewCalledWith.Reviewer.UserID)
+	}
+	if mockUC.ReviewCalledWith.Input.Note == nil || *mockUC.ReviewCalledWith.Input.Note != "verified" {
+		t.Errorf("expected note to be passed, got %v", mockUC.ReviewCalledWith.Input.Note)
+	}
+}
+
+func TestStoreClaimHandler_DenyClaim_EmptyBody(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/claims/"+testClaimID+"/deny")
+	tc.SetPath("/admin/claims/:id/deny", []string{"id"}, []string{testClaimID})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+
+	mockUC := &testutil.MockStoreClaimUseCase{Claim: newTestClaim()}
+	h := handlers.NewStoreClaimHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.DenyClaim(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.ReviewCalledWith.Action != "deny" {
+		t.Errorf("expected deny, got %s", mockUC.ReviewCalledWith.Action)
+	}
+}
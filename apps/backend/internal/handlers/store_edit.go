@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+type StoreEditHandler struct {
+	editUseCase input.StoreEditUseCase
+}
+
+func NewStoreEditHandler(editUseCase input.StoreEditUseCase) *StoreEditHandler {
+	return &StoreEditHandler{editUseCase: editUseCase}
+}
+
+type reviewStoreEditDTO struct {
+	Note *string `json:"note"`
+}
+
+// ListEdits returns store edits awaiting or past review, optionally filtered by ?status=.
+func (h *StoreEditHandler) ListEdits(c echo.Context) error {
+	edits, err := h.editUseCase.ListStoreEdits(c.Request().Context(), c.QueryParam("status"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewStoreEditResponses(edits))
+}
+
+// GetEdit returns a store edit with its field-level diff against the current store.
+func (h *StoreEditHandler) GetEdit(c echo.Context) error {
+	editID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreEditID)
+	if err != nil {
+		return err
+	}
+	edit, err := h.editUseCase.GetStoreEdit(c.Request().Context(), editID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewStoreEditResponse(*edit))
+}
+
+// ApproveEdit applies a pending store edit to the store.
+func (h *StoreEditHandler) ApproveEdit(c echo.Context) error {
+	return h.reviewEdit(c, h.editUseCase.ApproveStoreEdit)
+}
+
+func (h *StoreEditHandler) RejectEdit(c echo.Context) error {
+	return h.reviewEdit(c, h.editUseCase.RejectStoreEdit)
+}
+
+type reviewStoreEditFunc func(ctx context.Context, reviewer entity.User, editID string, in input.ReviewStoreEditInput) (*entity.StoreEdit, error)
+
+func (h *StoreEditHandler) reviewEdit(c echo.Context, review reviewStoreEditFunc) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	editID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreEditID)
+	if err != nil {
+		return err
+	}
+	var dto reviewStoreEditDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	edit, err := review(c.Request().Context(), user, editID, input.ReviewStoreEditInput{Note: dto.Note})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewStoreEditResponse(*edit))
+}
@@ -0,0 +1,128 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+)
+
+const testStoreEditID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+// --- ListEdits / GetEdit Tests ---
+
+func TestStoreEditHandler_ListEdits_PassesStatus(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/store-edits?status=pending")
+	mockUC := &testutil.MockStoreEditUseCase{Edits: []entity.StoreEdit{{
+		EditID:  testStoreEditID,
+		StoreID: "store-1",
+		Name:    testutil.StringPtr("New Name"),
+		Status:  constants.StoreEditStatusPending,
+		Changes: []entity.StoreFieldChange{{Field: "name", Current: "Old Name", Proposed: "New Name"}},
+	}}}
+
+	err := handlers.NewStoreEditHandler(mockUC).ListEdits(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.ListStatus != constants.StoreEditStatusPending {
+		t.Errorf("expected status filter pending, got %q", mockUC.ListStatus)
+	}
+
+	var response []struct {
+		EditID  string `json:"edit_id"`
+		Changes []struct {
+			Field    string `json:"field"`
+			Current  string `json:"current"`
+			Proposed string `json:"proposed"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if len(response) != 1 || len(response[0].Changes) != 1 {
+		t.Fatalf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+	change := response[0].Changes[0]
+	if change.Field != "name" || change.Current != "Old Name" || change.Proposed != "New Name" {
+		t.Errorf("unexpected change: %+v", change)
+	}
+}
+
+func TestStoreEditHandler_ListEdits_InvalidStatus(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/store-edits?status=unknown")
+	mockUC := &testutil.MockStoreEditUseCase{Err: usecase.ErrInvalidStoreEditStatus}
+
+	err := handlers.NewStoreEditHandler(mockUC).ListEdits(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrInvalidStoreEditStatus, "expected invalid status error")
+}
+
+func TestStoreEditHandler_GetEdit_InvalidID(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/store-edits/bad")
+	tc.SetPath("/admin/store-edits/:id", []string{"id"}, []string{"bad"})
+
+	err := handlers.NewStoreEditHandler(&testutil.MockStoreEditUseCase{}).GetEdit(tc.Context)
+
+	testutil.AssertError(t, err, "expected error for invalid edit id")
+}
+
+func TestStoreEditHandler_GetEdit_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/admin/store-edits/"+testStoreEditID)
+	tc.SetPath("/admin/store-edits/:id", []string{"id"}, []string{testStoreEditID})
+	mockUC := &testutil.MockStoreEditUseCase{}
+
+	err := handlers.NewStoreEditHandler(mockUC).GetEdit(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.CalledWith.EditID != testStoreEditID {
+		t.Errorf("expected edit %s, got %s", testStoreEditID, mockUC.CalledWith.EditID)
+	}
+}
+
+// --- ApproveEdit / RejectEdit Tests ---
+
+func TestStoreEditHandler_ApproveEdit_Success(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/admin/store-edits/"+testStoreEditID+"/approve", `{"note":"verified"}`)
+	tc.SetPath("/admin/store-edits/:id/approve", []string{"id"}, []string{testStoreEditID})
+	admin := entity.User{UserID: "admin-1"}
+	tc.SetUser(admin, "admin")
+	mockUC := &testutil.MockStoreEditUseCase{}
+
+	err := handlers.NewStoreEditHandler(mockUC).ApproveEdit(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.CalledWith.Action != "approve" || mockUC.CalledWith.Reviewer.UserID != admin.UserID {
+		t.Errorf("unexpected call: %+v", mockUC.CalledWith)
+	}
+	if mockUC.CalledWith.Input.Note == nil || *mockUC.CalledWith.Input.Note != "verified" {
+		t.Errorf("expected note to be passed, got %v", mockUC.CalledWith.Input.Note)
+	}
+}
+
+func TestStoreEditHandler_RejectEdit_Unauthorized(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/store-edits/"+testStoreEditID+"/reject")
+	tc.SetPath("/admin/store-edits/:id/reject", []string{"id"}, []string{testStoreEditID})
+
+	err := handlers.NewStoreEditHandler(&testutil.MockStoreEditUseCase{}).RejectEdit(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrUnauthorized, "expected unauthorized error")
+}
+
+func TestStoreEditHandler_RejectEdit_NotPending(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodPost, "/admin/store-edits/"+testStoreEditID+"/reject")
+	tc.SetPath("/admin/store-edits/:id/reject", []string{"id"}, []string{testStoreEditID})
+	tc.SetUser(entity.User{UserID: "admin-1"}, "admin")
+	mockUC := &testutil.MockStoreEditUseCase{Err: usecase.ErrStoreEditNotPending}
+
+	err := handlers.NewStoreEditHandler(mockUC).RejectEdit(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrStoreEditNotPending, "expected conflict error")
+	if mockUC.CalledWith.Action != "reject" {
+		t.Errorf("expected reject, got %s", mockUC.CalledWith.Action)
+	}
+}
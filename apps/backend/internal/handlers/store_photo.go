@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type StorePhotoHandler struct {
+	storePhotoUseCase input.StorePhotoUseCase
+	storage           output.StorageProvider
+	bucket            string
+}
+
+func NewStorePhotoHandler(storePhotoUseCase input.StorePhotoUseCase, storage output.StorageProvider, bucket string) *StorePhotoHandler {
+	return &StorePhotoHandler{
+		storePhotoUseCase: storePhotoUseCase,
+		storage:           storage,
+		bucket:            bucket,
+	}
+}
+
+// respondWithPhotos はギャラリーを写真の署名付き URL 付きで返す
+func (h *StorePhotoHandler) respondWithPhotos(c echo.Context, photos []entity.StorePhoto) error {
+	resp := presenter.NewStorePhotoResponses(photos)
+	attachSignedURLsToStorePhotoResponses(c.Request().Context(), h.storage, h.bucket, resp)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetStorePhotos は店舗のギャラリーを表示順に返す
+func (h *StorePhotoHandler) GetStorePhotos(c echo.Context) error {
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	photos, err := h.storePhotoUseCase.ListStorePhotos(c.Request().Context(), getOptionalUser(c), storeID)
+	if err != nil {
+		return err
+	}
+	return h.respondWithPhotos(c, photos)
+}
+
+type updateStorePhotoDTO struct {
+	Caption *string `json:"caption"`
+	AltText *string `json:"alt_text"`
+}
+
+// UpdateStorePhoto は写真のキャプションと代替テキストを更新する
+func (h *StorePhotoHandler) UpdateStorePhoto(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, fileID, err := parseStorePhotoParams(c)
+	if err != nil {
+		return err
+	}
+	var dto updateStorePhotoDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	photo, err := h.storePhotoUseCase.UpdateStorePhoto(c.Request().Context(), user, storeID, fileID, input.UpdateStorePhotoInput{
+		Caption: dto.Caption,
+		AltText: dto.AltText,
+	})
+	if err != nil {
+		return err
+	}
+	responses := []presenter.StorePhotoResponse{presenter.NewStorePhotoResponse(*photo)}
+	attachSignedURLsToStorePhotoResponses(c.Request().Context(), h.storage, h.bucket, responses)
+	return c.JSON(http.StatusOK, responses[0])
+}
+
+type reorderStorePhotosDTO struct {
+	FileIDs []string `json:"file_ids"`
+}
+
+// ReorderStorePhotos はギャラリーを指定順に並べ替え、並べ替え後のギャラリーを返す
+func (h *StorePhotoHandler) ReorderStorePhotos(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	var dto reorderStorePhotosDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	photos, err := h.storePhotoUseCase.ReorderStorePhotos(c.Request().Context(), user, storeID, dto.FileIDs)
+	if err != nil {
+		return err
+	}
+	return h.respondWithPhotos(c, photos)
+}
+
+type setStoreCoverDTO struct {
+	FileID string `json:"file_id"`
+}
+
+// SetStoreCover はギャラリーの写真を店舗のカバー画像にし、店舗を返す。承認待ちになった場合は pending_edit に含める
+func (h *StorePhotoHandler) SetStoreCover(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	var dto setStoreCoverDTO
+	if err = bindJSON(c, &dto); err != nil {
+		return err
+	}
+	if _, err = uuid.Parse(dto.FileID); err != nil {
+		return presentation.NewBadRequest(ErrMsgInvalidFileID)
+	}
+	store, err := h.storePhotoUseCase.SetStoreCover(c.Request().Context(), user, storeID, dto.FileID)
+	if err != nil {
+		return err
+	}
+	responses := []presenter.StoreResponse{presenter.NewStoreResponse(*store)}
+	attachSignedURLsToStoreResponses(c.Request().Context(), h.storage, h.bucket, responses)
+	return c.JSON(http.StatusOK, responses[0])
+}
+
+// DeleteStorePhoto はギャラリーの写真を論理削除する
+func (h *StorePhotoHandler) DeleteStorePhoto(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, fileID, err := parseStorePhotoParams(c)
+	if err != nil {
+		return err
+	}
+	if err := h.storePhotoUseCase.DeleteStorePhoto(c.Request().Context(), user, storeID, fileID); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func parseStorePhotoParams(c echo.Context) (string, string, error) {
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return "", "", err
+	}
+	fileID, err := parseUUIDParam(c, "file_id", ErrMsgInvalidFileID)
+	if err != nil {
+		return "", "", err
+	}
+	return storeID, fileID, nil
+}
@@ -0,0 +1,154 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+)
+
+const (
+	testGalleryStoreID = "550e8400-e29b-41d4-a716-446655440000"
+	testGalleryFileID  = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+)
+
+func TestStorePhotoHandler_GetStorePhotos_SignsURLs(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/stores/"+testGalleryStoreID+"/photos")
+	tc.SetPath("/stores/:id/photos", []string{"id"}, []string{testGalleryStoreID})
+	file := testutil.NewTestFile(testutil.WithFileID(testGalleryFileID))
+	file.ObjectKey = "stores/cover.jpg"
+	mockUC := &testutil.MockStorePhotoUseCase{Photos: []entity.StorePhoto{{
+		StoreID: testGalleryStoreID,
+		File:    file,
+		Caption: testutil.StringPtr("テラス席"),
+		IsCover: true,
+	}}}
+	storage := &testutil.MockStorageProvider{SignedURLsByKey: map[string]string{
+		"stores/cover.jpg": "https://example.com/signed/cover.jpg",
+	}}
+
+	err := handlers.NewStorePhotoHandler(mockUC, storage, "test-bucket").GetStorePhotos(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	var response []struct {
+		FileID  string  `json:"file_id"`
+		Caption *string `json:"caption"`
+		IsCover bool    `json:"is_cover"`
+		File    struct {
+			URL *string `json:"url"`
+		} `json:"file"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if len(response) != 1 || response[0].FileID != testGalleryFileID || !response[0].IsCover {
+		t.Fatalf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+	if response[0].File.URL == nil || *response[0].File.URL != "https://example.com/signed/cover.jpg" {
+		t.Errorf("expected signed photo URL, got %s", tc.Recorder.Body.String())
+	}
+}
+
+func TestStorePhotoHandler_UpdateStorePhoto_InvalidFileID(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+testGalleryStoreID+"/photos/bad", `{"caption":"x"}`)
+	tc.SetPath("/stores/:id/photos/:file_id", []string{"id", "file_id"}, []string{testGalleryStoreID, "bad"})
+	tc.SetUser(testutil.NewTestUser(), "owner")
+	mockUC := &testutil.MockStorePhotoUseCase{}
+
+	err := handlers.NewStorePhotoHandler(mockUC, nil, "").UpdateStorePhoto(tc.Context)
+
+	testutil.AssertError(t, err, "expected error for invalid file id")
+	if mockUC.CalledWith.FileID != "" {
+		t.Error("expected use case not to be called")
+	}
+}
+
+func TestStorePhotoHandler_UpdateStorePhoto_PassesInput(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+testGalleryStoreID+"/photos/"+testGalleryFileID,
+		`{"caption":"カウンター席","alt_text":"木目のカウンター"}`)
+	tc.SetPath("/stores/:id/photos/:file_id", []string{"id", "file_id"}, []string{testGalleryStoreID, testGalleryFileID})
+	tc.SetUser(testutil.NewTestUser(), "owner")
+	mockUC := &testutil.MockStorePhotoUseCase{Photo: &entity.StorePhoto{StoreID: testGalleryStoreID, File: entity.File{FileID: testGalleryFileID}}}
+
+	err := handlers.NewStorePhotoHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket").UpdateStorePhoto(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	in := mockUC.CalledWith.Input
+	if in.Caption == nil || *in.Caption != "カウンター席" || in.AltText == nil || *in.AltText != "木目のカウンター" {
+		t.Errorf("unexpected input: %+v", in)
+	}
+}
+
+func TestStorePhotoHandler_ReorderStorePhotos_PassesOrder(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+testGalleryStoreID+"/photos/order",
+		`{"file_ids":["file-2","file-1"]}`)
+	tc.SetPath("/stores/:id/photos/order", []string{"id"}, []string{testGalleryStoreID})
+	tc.SetUser(testutil.NewTestUser(), "owner")
+	mockUC := &testutil.MockStorePhotoUseCase{}
+
+	err := handlers.NewStorePhotoHandler(mockUC, nil, "").ReorderStorePhotos(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if got := mockUC.CalledWith.FileIDs; len(got) != 2 || got[0] != "file-2" {
+		t.Errorf("unexpected order %v", got)
+	}
+}
+
+func TestStorePhotoHandler_ReorderStorePhotos_UseCaseError(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+testGalleryStoreID+"/photos/order", `{"file_ids":[]}`)
+	tc.SetPath("/stores/:id/photos/order", []string{"id"}, []string{testGalleryStoreID})
+	tc.SetUser(testutil.NewTestUser(), "owner")
+	mockUC := &testutil.MockStorePhotoUseCase{Err: usecase.ErrInvalidStorePhotoOrder}
+
+	err := handlers.NewStorePhotoHandler(mockUC, nil, "").ReorderStorePhotos(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrInvalidStorePhotoOrder, "expected invalid order error")
+}
+
+func TestStorePhotoHandler_SetStoreCover_InvalidFileID(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+testGalleryStoreID+"/cover", `{"file_id":"bad"}`)
+	tc.SetPath("/stores/:id/cover", []string{"id"}, []string{testGalleryStoreID})
+	tc.SetUser(testutil.NewTestUser(), "owner")
+	mockUC := &testutil.MockStorePhotoUseCase{}
+
+	err := handlers.NewStorePhotoHandler(mockUC, nil, "").SetStoreCover(tc.Context)
+
+	testutil.AssertError(t, err, "expected error for invalid file id")
+	if mockUC.CalledWith.FileID != "" {
+		t.Error("expected use case not to be called")
+	}
+}
+
+func TestStorePhotoHandler_SetStoreCover_ReturnsStore(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPut, "/stores/"+testGalleryStoreID+"/cover",
+		`{"file_id":"`+testGalleryFileID+`"}`)
+	tc.SetPath("/stores/:id/cover", []string{"id"}, []string{testGalleryStoreID})
+	tc.SetUser(testutil.NewTestUser(), "owner")
+	store := testutil.NewTestStore(testutil.WithStoreID(testGalleryStoreID))
+	mockUC := &testutil.MockStorePhotoUseCase{Store: &store}
+
+	err := handlers.NewStorePhotoHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket").SetStoreCover(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.CalledWith.StoreID != testGalleryStoreID || mockUC.CalledWith.FileID != testGalleryFileID {
+		t.Errorf("unexpected call: %+v", mockUC.CalledWith)
+	}
+}
+
+func TestStorePhotoHandler_DeleteStorePhoto_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/stores/"+testGalleryStoreID+"/photos/"+testGalleryFileID)
+	tc.SetPath("/stores/:id/photos/:file_id", []string{"id", "file_id"}, []string{testGalleryStoreID, testGalleryFileID})
+	tc.SetUser(testutil.NewTestUser(), "owner")
+	mockUC := &testutil.MockStorePhotoUseCase{}
+
+	err := handlers.NewStorePhotoHandler(mockUC, nil, "").DeleteStorePhoto(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusNoContent)
+	if mockUC.CalledWith.FileID != testGalleryFileID {
+		t.Errorf("expected %s to be deleted, got %q", testGalleryFileID, mockUC.CalledWith.FileID)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -15,6 +16,7 @@ import (
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
 	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/requestcontext"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
 )
 
@@ -80,7 +82,7 @@ func TestStoreHandler_GetStores_UseCaseError(t *testing.T) {
 	c := e.NewContext(req, rec)
 
 	mockUC := &testutil.MockStoreUseCase{
-		GetAllErr: usecase.ErrStoreNotFound,
+		ListErr: usecase.ErrInvalidInput,
 	}
 	h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
 
@@ -91,6 +93,177 @@ func TestStoreHandler_GetStores_UseCaseError(t *testing.T) {
 	}
 }
 
+func TestStoreHandler_GetStores_QueryParams(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet,
+		"/stores?limit=5&cursor=abc&category=cafe&budget=$$&tag=wifi&min_rating=3.5&opened_after=2024-01-01&approved=true&open_now=true&sort=rating", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := &testutil.MockStoreUseCase{
+		Stores:     []entity.Store{{StoreID: "store-1", Name: "Store 1"}},
+		NextCursor: "next-token",
+	}
+	h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.GetStores(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	q := mockUC.ListStoresCalledWith
+	if q.Limit != 5 || q.Cursor != "abc" || q.Sort != "rating" {
+		t.Errorf("unexpected query: %+v", q)
+	}
+	if q.Category == nil || *q.Category != "cafe" {
+		t.Errorf("expected category cafe, got %v", q.Category)
+	}
+	if q.Budget == nil || *q.Budget != "$$" {
+		t.Errorf("expected budget $$, got %v", q.Budget)
+	}
+	if q.Tag == nil || *q.Tag != "wifi" {
+		t.Errorf("expected tag wifi, got %v", q.Tag)
+	}
+	if q.MinRating == nil || *q.MinRating != 3.5 {
+		t.Errorf("expected min_rating 3.5, got %v", q.MinRating)
+	}
+	if q.OpenedAfter == nil || q.OpenedAfter.Format("2006-01-02") != "2024-01-01" {
+		t.Errorf("expected opened_after 2024-01-01, got %v", q.OpenedAfter)
+	}
+	if q.IsApproved == nil || !*q.IsApproved {
+		t.Errorf("expected approved true, got %v", q.IsApproved)
+	}
+	if !q.OpenNow {
+		t.Error("expected open_now to be set")
+	}
+	if got := rec.Header().Get("X-Next-Cursor"); got != "next-token" {
+		t.Errorf("expected X-Next-Cursor next-token, got %q", got)
+	}
+}
+
+func TestStoreHandler_GetStores_NoNextCursorHeader(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/stores", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := &testutil.MockStoreUseCase{Stores: []entity.Store{}}
+	h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.GetStores(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := rec.Header()["X-Next-Cursor"]; ok {
+		t.Error("expected no X-Next-Cursor header on last page")
+	}
+}
+
+func TestStoreHandler_GetStores_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "invalid limit", query: "limit=abc"},
+		{name: "invalid min_rating", query: "min_rating=high"},
+		{name: "invalid opened_after", query: "opened_after=yesterday"},
+		{name: "invalid approved", query: "approved=maybe"},
+		{name: "invalid open_now", query: "open_now=soon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/stores?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockUC := &testutil.MockStoreUseCase{}
+			h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+			if err := h.GetStores(c); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if mockUC.ListStoresCalled {
+				t.Error("use case should not be called for invalid query")
+			}
+		})
+	}
+}
+
+// --- GetNearbyStores Tests ---
+
+func TestStoreHandler_GetNearbyStores_Success(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/stores/nearby?lat=34.69&lng=135.19&radius_m=500", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	meters := 240.0
+	mockUC := &testutil.MockStoreUseCase{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Store 1", DistanceMeters: &meters, DistanceMinutes: 3}},
+	}
+	h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.GetNearbyStores(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	q := mockUC.NearbyCalledWith
+	if q.Latitude == nil || *q.Latitude != 34.69 || q.Longitude == nil || *q.Longitude != 135.19 {
+		t.Errorf("unexpected origin: %+v", q)
+	}
+	if q.RadiusMeters != 500 || q.StationID != nil {
+		t.Errorf("unexpected query: %+v", q)
+	}
+
+	var response []presenter.StoreResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(response) != 1 || response[0].DistanceMeters == nil || *response[0].DistanceMeters != meters {
+		t.Errorf("expected distance_meters in response, got %+v", response)
+	}
+	if response[0].DistanceMinutes != 3 {
+		t.Errorf("expected distance_minutes 3, got %d", response[0].DistanceMinutes)
+	}
+}
+
+func TestStoreHandler_GetNearbyStores_ByStation(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/stores/nearby?station_id=12", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := &testutil.MockStoreUseCase{}
+	h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.GetNearbyStores(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mockUC.NearbyCalledWith.StationID == nil || *mockUC.NearbyCalledWith.StationID != 12 {
+		t.Errorf("expected station_id 12, got %v", mockUC.NearbyCalledWith.StationID)
+	}
+}
+
+func TestStoreHandler_GetNearbyStores_InvalidQuery(t *testing.T) {
+	for _, query := range []string{"lat=north&lng=135", "station_id=abc", "lat=34&lng=135&radius_m=far"} {
+		t.Run(query, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/stores/nearby?"+query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockUC := &testutil.MockStoreUseCase{}
+			h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+			if err := h.GetNearbyStores(c); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if mockUC.NearbyCalled {
+				t.Error("use case should not be called for invalid query")
+			}
+		})
+	}
+}
+
 // --- GetStoreByID Tests ---
 
 func TestStoreHandler_GetStoreByID_Success(t *testing.T) {
@@ -158,15 +331,65 @@ func TestStoreHandler_GetStoreByID_NotFound(t *testing.T) {
 	}
 }
 
+// --- GetOwnedStores Tests ---
+
+func TestStoreHandler_GetOwnedStores_Success(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/owner/stores", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	owner := testutil.NewTestUser(testutil.WithUserRole("owner"))
+	requestcontext.SetToContext(c, owner, "owner")
+
+	mockUC := &testutil.MockStoreUseCase{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Store 1"}},
+	}
+	h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.GetOwnedStores(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if mockUC.ListOwnedStoresCalledWith != owner.UserID {
+		t.Errorf("expected ListOwnedStores called with %q, got %q", owner.UserID, mockUC.ListOwnedStoresCalledWith)
+	}
+
+	var response []map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(response) != 1 {
+		t.Errorf("expected 1 store in response, got %d", len(response))
+	}
+}
+
+func TestStoreHandler_GetOwnedStores_Unauthorized(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/owner/stores", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	h := handlers.NewStoreHandler(&testutil.MockStoreUseCase{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.GetOwnedStores(c)
+	if !errors.Is(err, usecase.ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
 // --- CreateStore Tests ---
 
 func TestStoreHandler_CreateStore_Success(t *testing.T) {
 	e := echo.New()
-	body := `{"name":"New Store","address":"New Address","latitude":35.6812,"longitude":139.7671,"place_id":"place-123","thumbnail_file_id":"file-1"}`
+	body := `{"name":"New Store","address":"New Address","latitude":35.6812,"longitude":139.7671,"place_id":"place-123"}`
 	req := httptest.NewRequest(http.MethodPost, "/stores", strings.NewReader(body))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	owner := testutil.NewTestUser(testutil.WithUserRole("owner"))
+	requestcontext.SetToContext(c, owner, "owner")
 
 	mockUC := &testutil.MockStoreUseCase{
 		CreatedStore: &entity.Store{
@@ -186,6 +409,92 @@ func TestStoreHandler_CreateStore_Success(t *testing.T) {
 	}
 }
 
+func TestStoreHandler_CreateStore_OpeningSchedule(t *testing.T) {
+	e := echo.New()
+	body := `{"name":"New Store","address":"New Address","latitude":35.6812,"longitude":139.7671,"place_id":"place-123",
+		"opening_schedule":{
+		"weekly":{"mon":[{"open":"11:30","close":"14:00"},{"open":"18:00","close":"02:00"}],"sun":[]},
+		"special_hours":[{"date":"2026-12-31","intervals":[]}],
+		"closures":[{"from":"2027-01-01","to":"2027-01-03","note":"年始休業"}]}}`
+	req := httptest.NewRequest(http.MethodPost, "/stores", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	requestcontext.SetToContext(c, testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
+
+	mockUC := &testutil.MockStoreUseCase{CreatedStore: &entity.Store{StoreID: "new-store-id"}}
+	h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.CreateStore(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	schedule := mockUC.CreateStoreCalledWith.OpeningSchedule
+	if schedule == nil {
+		t.Fatal("expected opening schedule to be passed")
+	}
+	wantMonday := []entity.OpeningInterval{{Open: 690, Close: 840}, {Open: 1080, Close: 120}}
+	if len(schedule.Weekly[time.Monday]) != 2 || schedule.Weekly[time.Monday][0] != wantMonday[0] || schedule.Weekly[time.Monday][1] != wantMonday[1] {
+		t.Errorf("expected monday intervals %v, got %v", wantMonday, schedule.Weekly[time.Monday])
+	}
+	if len(schedule.Special) != 1 || !schedule.Special[0].Date.Equal(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)) || len(schedule.Special[0].Intervals) != 0 {
+		t.Errorf("unexpected special hours: %+v", schedule.Special)
+	}
+	if len(schedule.Closures) != 1 || schedule.Closures[0].Note == nil || *schedule.Closures[0].Note != "年始休業" {
+		t.Errorf("unexpected closures: %+v", schedule.Closures)
+	}
+}
+
+func TestStoreHandler_CreateStore_InvalidOpeningSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule string
+	}{
+		{"invalid time", `{"weekly":{"mon":[{"open":"11時","close":"22:00"}]}}`},
+		{"invalid minute", `{"weekly":{"mon":[{"open":"11:75","close":"22:00"}]}}`},
+		{"invalid date", `{"special_hours":[{"date":"12/31","intervals":[]}]}`},
+		{"invalid closure", `{"closures":[{"from":"2027-01-01","to":""}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			body := `{"name":"New Store","opening_schedule":` + tt.schedule + `}`
+			req := httptest.NewRequest(http.MethodPost, "/stores", strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			requestcontext.SetToContext(c, testutil.NewTestUser(testutil.WithUserRole("owner")), "owner")
+
+			mockUC := &testutil.MockStoreUseCase{}
+			h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+			testutil.AssertError(t, h.CreateStore(c), "expected error for invalid opening_schedule")
+			if mockUC.CreateStoreCalled {
+				t.Error("use case should not be called for an invalid opening_schedule")
+			}
+		})
+	}
+}
+
+func TestStoreHandler_CreateStore_Unauthorized(t *testing.T) {
+	e := echo.New()
+	body := `{"name":"New Store"}`
+	req := httptest.NewRequest(http.MethodPost, "/stores", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := &testutil.MockStoreUseCase{}
+	h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.CreateStore(c)
+	if !errors.Is(err, usecase.ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+	if mockUC.CreateStoreCalled {
+		t.Error("expected CreateStore not to be called")
+	}
+}
+
 func TestStoreHandler_CreateStore_InvalidJSON(t *testing.T) {
 	e := echo.New()
 	body := `{invalid json}`
@@ -237,6 +546,8 @@ func TestStoreHandler_UpdateStore_Success(t *testing.T) {
 	c.SetPath("/stores/:id")
 	c.SetParamNames("id")
 	c.SetParamValues(storeID)
+	owner := testutil.NewTestUser(testutil.WithUserRole("owner"))
+	requestcontext.SetToContext(c, owner, "owner")
 
 	mockUC := &testutil.MockStoreUseCase{
 		Store: &entity.Store{StoreID: storeID, Name: "Updated Store"},
@@ -252,6 +563,53 @@ func TestStoreHandler_UpdateStore_Success(t *testing.T) {
 	}
 }
 
+func TestStoreHandler_UpdateStore_PassesTags(t *testing.T) {
+	e := echo.New()
+	storeID := uuid.New().String()
+	tests := []struct {
+		name     string
+		body     string
+		wantNil  bool
+		wantTags []string
+	}{
+		{"tags omitted", testUpdateStoreBody, true, nil},
+		{"tags cleared", `{"tags":[]}`, false, []string{}},
+		{"tags replaced", `{"tags":["wifi","quiet"]}`, false, []string{"wifi", "quiet"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/stores/"+storeID, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/stores/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(storeID)
+			owner := testutil.NewTestUser(testutil.WithUserRole("owner"))
+			requestcontext.SetToContext(c, owner, "owner")
+
+			mockUC := &testutil.MockStoreUseCase{Store: &entity.Store{StoreID: storeID}}
+			h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+			if err := h.UpdateStore(c); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := mockUC.UpdateStoreCalledWith.Input.Tags
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("expected nil tags = %v, got %v", tt.wantNil, got)
+			}
+			if len(got) != len(tt.wantTags) {
+				t.Fatalf("expected tags %v, got %v", tt.wantTags, got)
+			}
+			for i := range got {
+				if got[i] != tt.wantTags[i] {
+					t.Errorf("expected tag %q at %d, got %q", tt.wantTags[i], i, got[i])
+				}
+			}
+		})
+	}
+}
+
 func TestStoreHandler_UpdateStore_InvalidUUID(t *testing.T) {
 	e := echo.New()
 	body := testUpdateStoreBody
@@ -330,6 +688,7 @@ func TestStoreHandler_DeleteStore_Success(t *testing.T) {
 	c.SetPath("/stores/:id")
 	c.SetParamNames("id")
 	c.SetParamValues(storeID)
+	requestcontext.SetToContext(c, entity.User{UserID: "admin-1"}, "admin")
 
 	mockUC := &testutil.MockStoreUseCase{}
 	h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
@@ -351,6 +710,7 @@ func TestStoreHandler_DeleteStore_InvalidUUID(t *testing.T) {
 	c.SetPath("/stores/:id")
 	c.SetParamNames("id")
 	c.SetParamValues("invalid-uuid")
+	requestcontext.SetToContext(c, entity.User{UserID: "admin-1"}, "admin")
 
 	mockUC := &testutil.MockStoreUseCase{}
 	h := handlers.NewStoreHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
@@ -371,6 +731,7 @@ func TestStoreHandler_DeleteStore_NotFound(t *testing.T) {
 	c.SetPath("/stores/:id")
 	c.SetParamNames("id")
 	c.SetParamValues(storeID)
+	requestcontext.SetToContext(c, entity.User{UserID: "admin-1"}, "admin")
 
 	mockUC := &testutil.MockStoreUseCase{
 		DeleteErr: usecase.ErrStoreNotFound,
@@ -729,6 +1090,7 @@ func TestGetStoreByID_StorageSigningTracksRequestedKeys(t *testing.T) {
 	c.SetPath("/stores/:id")
 	c.SetParamNames("id")
 	c.SetParamValues(storeID)
+	requestcontext.SetToContext(c, entity.User{UserID: "admin-1"}, "admin")
 
 	mockUC := &testutil.MockStoreUseCase{
 		Store: &entity.Store{
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type TagHandler struct {
+	tagUseCase input.TagUseCase
+	storage    output.StorageProvider
+	bucket     string
+}
+
+func NewTagHandler(tagUseCase input.TagUseCase, storage output.StorageProvider, bucket string) *TagHandler {
+	return &TagHandler{
+		tagUseCase: tagUseCase,
+		storage:    storage,
+		bucket:     bucket,
+	}
+}
+
+// ListTags returns the tags used by approved stores together with their store counts.
+func (h *TagHandler) ListTags(c echo.Context) error {
+	counts, err := h.tagUseCase.ListTags(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewTagCountResponses(counts))
+}
+
+// ListStoresByTag returns one page of approved stores with the given tag.
+// It accepts the same query parameters as GetStores and sends the next cursor in the X-Next-Cursor header.
+func (h *TagHandler) ListStoresByTag(c echo.Context) error {
+	query, err := parseListStoresQuery(c)
+	if err != nil {
+		return err
+	}
+	page, err := h.tagUseCase.ListStoresByTag(c.Request().Context(), tagParam(c), query)
+	if err != nil {
+		return err
+	}
+	if page.NextCursor != "" {
+		c.Response().Header().Set(infrahttp.HeaderNextCursor, page.NextCursor)
+	}
+	resp := presenter.NewStoreResponses(page.Stores)
+	attachSignedURLsToStoreResponses(c.Request().Context(), h.storage, h.bucket, resp)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// tagParam returns the decoded :tag path parameter, falling back to the raw value when it is not valid escaping.
+func tagParam(c echo.Context) string {
+	raw := c.Param("tag")
+	if decoded, err := url.PathUnescape(raw); err == nil {
+		return decoded
+	}
+	return raw
+}
@@ -0,0 +1,135 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+// --- ListTags Tests ---
+
+func TestTagHandler_ListTags_Success(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/tags", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := &testutil.MockTagUseCase{
+		Counts: []entity.TagCount{{Tag: "wifi", StoreCount: 2}, {Tag: "quiet", StoreCount: 1}},
+	}
+	h := handlers.NewTagHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.ListTags(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	var response []presenter.TagCountResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(response) != 2 || response[0].Tag != "wifi" || response[0].StoreCount != 2 {
+		t.Errorf("unexpected response: %+v", response)
+	}
+}
+
+func TestTagHandler_ListTags_UseCaseError(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/tags", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	listErr := errors.New("database error")
+	h := handlers.NewTagHandler(&testutil.MockTagUseCase{ListTagsErr: listErr}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.ListTags(c); !errors.Is(err, listErr) {
+		t.Errorf("expected use case error, got %v", err)
+	}
+}
+
+// --- ListStoresByTag Tests ---
+
+func TestTagHandler_ListStoresByTag_Success(t *testing.T) {
+	e := echo.New()
+	escaped := url.PathEscape("テラス席")
+	req := httptest.NewRequest(http.MethodGet, "/tags/"+escaped+"/stores?limit=5&cursor=abc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tags/:tag/stores")
+	c.SetParamNames("tag")
+	c.SetParamValues(escaped)
+
+	mockUC := &testutil.MockTagUseCase{
+		Page: &input.StorePage{
+			Stores:     []entity.Store{{StoreID: "store-1", Name: "Store 1", Tags: []string{"テラス席"}}},
+			NextCursor: "next",
+		},
+	}
+	h := handlers.NewTagHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	if err := h.ListStoresByTag(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if got := rec.Header().Get(infrahttp.HeaderNextCursor); got != "next" {
+		t.Errorf("expected next cursor header %q, got %q", "next", got)
+	}
+	called := mockUC.ListStoresByTagCalledWith
+	if called.Tag != "テラス席" {
+		t.Errorf("expected decoded tag %q, got %q", "テラス席", called.Tag)
+	}
+	if called.Query.Limit != 5 || called.Query.Cursor != "abc" {
+		t.Errorf("unexpected query: %+v", called.Query)
+	}
+	var response []presenter.StoreResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(response) != 1 || response[0].StoreID != "store-1" {
+		t.Errorf("unexpected response: %+v", response)
+	}
+}
+
+func TestTagHandler_ListStoresByTag_InvalidQuery(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/tags/wifi/stores?limit=abc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tags/:tag/stores")
+	c.SetParamNames("tag")
+	c.SetParamValues("wifi")
+
+	h := handlers.NewTagHandler(&testutil.MockTagUseCase{}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	testutil.AssertError(t, h.ListStoresByTag(c), "invalid limit")
+}
+
+func TestTagHandler_ListStoresByTag_UseCaseError(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/tags/%20/stores", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tags/:tag/stores")
+	c.SetParamNames("tag")
+	c.SetParamValues("%20")
+
+	h := handlers.NewTagHandler(&testutil.MockTagUseCase{ListStoresErr: usecase.ErrInvalidTag}, &testutil.MockStorageProvider{}, "test-bucket")
+
+	testutil.AssertErrorIs(t, h.ListStoresByTag(c), usecase.ErrInvalidTag, "invalid tag")
+}
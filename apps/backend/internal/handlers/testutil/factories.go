@@ -79,7 +79,7 @@ func NewTestStore(overrides ...func(*entity.Store)) entity.Store {
 		PlaceID:         "test-place-id",
 		Latitude:        35.6812,
 		Longitude:       139.7671,
-		IsApproved:      true,
+		Visibility:      constants.VisibilityPublished,
 		Category:        "Cafe",
 		Budget:          "$$",
 		AverageRating:   4.0,
@@ -114,10 +114,10 @@ func WithStoreAddress(address string) func(*entity.Store) {
 	}
 }
 
-// WithStoreApproved sets the store approval status.
-func WithStoreApproved(approved bool) func(*entity.Store) {
+// WithStoreVisibility sets the store visibility.
+func WithStoreVisibility(visibility string) func(*entity.Store) {
 	return func(s *entity.Store) {
-		s.IsApproved = approved
+		s.Visibility = visibility
 	}
 }
 
@@ -153,6 +153,7 @@ func NewTestReview(overrides ...func(*entity.Review)) entity.Review {
 		Rating:     4,
 		LikesCount: 0,
 		LikedByMe:  false,
+		Visibility: constants.VisibilityPublished,
 		CreatedAt:  TestTime,
 	}
 	for _, override := range overrides {
@@ -295,7 +296,7 @@ func NewTestReport(overrides ...func(*entity.Report)) entity.Report {
 		ReportID:   1,
 		UserID:     "test-user-id",
 		TargetType: constants.TargetTypeReview,
-		TargetID:   1,
+		TargetID:   "00000000-0000-0000-0000-000000000001",
 		Reason:     "spam",
 		Status:     constants.ReportStatusPending,
 		CreatedAt:  TestTime,
@@ -329,7 +330,7 @@ func WithReportTargetType(targetType string) func(*entity.Report) {
 }
 
 // WithReportTargetID sets the report target ID.
-func WithReportTargetID(targetID int64) func(*entity.Report) {
+func WithReportTargetID(targetID string) func(*entity.Report) {
 	return func(r *entity.Report) {
 		r.TargetID = targetID
 	}
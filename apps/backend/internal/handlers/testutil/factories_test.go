@@ -3,6 +3,7 @@ package testutil
 import (
 	"testing"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 )
 
@@ -61,7 +62,7 @@ func TestNewTestStore_WithOverrides(t *testing.T) {
 		WithStoreID(customStoreID),
 		WithStoreName("Custom Store"),
 		WithStoreAddress("Custom Address"),
-		WithStoreApproved(false),
+		WithStoreVisibility(constants.VisibilityHidden),
 		WithStoreCategory("Restaurant"),
 		WithStoreLocation(40.7128, -74.0060),
 		WithStoreTags([]string{"tag1", "tag2"}),
@@ -75,8 +76,8 @@ func TestNewTestStore_WithOverrides(t *testing.T) {
 	if store.Address != "Custom Address" {
 		t.Errorf("Address = %q, want %q", store.Address, "Custom Address")
 	}
-	if store.IsApproved {
-		t.Error("IsApproved should be false")
+	if store.Visibility != constants.VisibilityHidden {
+		t.Errorf("Visibility = %q, want %q", store.Visibility, constants.VisibilityHidden)
 	}
 	if store.Category != "Restaurant" {
 		t.Errorf("Category = %q, want %q", store.Category, "Restaurant")
@@ -206,7 +207,7 @@ func TestNewTestReport_WithOverrides(t *testing.T) {
 		WithReportID(100),
 		WithReportUserID(customUserID),
 		WithReportTargetType("store"),
-		WithReportTargetID(200),
+		WithReportTargetID("target-200"),
 		WithReportReason("inappropriate"),
 		WithReportStatus("resolved"),
 	)
@@ -219,8 +220,8 @@ func TestNewTestReport_WithOverrides(t *testing.T) {
 	if report.TargetType != "store" {
 		t.Errorf("TargetType = %q, want %q", report.TargetType, "store")
 	}
-	if report.TargetID != 200 {
-		t.Errorf("TargetID = %d, want %d", report.TargetID, 200)
+	if report.TargetID != "target-200" {
+		t.Errorf("TargetID = %q, want %q", report.TargetID, "target-200")
 	}
 	if report.Reason != "inappropriate" {
 		t.Errorf("Reason = %q, want %q", report.Reason, "inappropriate")
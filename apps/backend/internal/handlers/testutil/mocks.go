@@ -1,10 +1,14 @@
 package testutil
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/security"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
@@ -21,34 +25,80 @@ type MockStoreRepository struct {
 	// Return values
 	Stores         []entity.Store
 	Store          *entity.Store
+	Page           *output.StorePage
 	FindAllErr     error
+	ListErr        error
+	FindNearbyErr  error
 	FindByIDErr    error
+	NotVisible     bool
 	FindPendingErr error
+	FindByOwnerErr error
 	CreateErr      error
 	UpdateErr      error
+	ApprovalErr    error
+	VisibilityErr  error
 	DeleteErr      error
 
 	// Call tracking
 	FindAllCalled      bool
+	FindAllViewer      output.Viewer
+	ListCalled         bool
+	ListCalledWith     output.StoreListQuery
+	FindNearbyCalled   bool
+	FindNearbyWith     output.StoreNearbyQuery
 	FindByIDCalled     bool
 	FindByIDCalledWith string
+	VisibleViewer      output.Viewer
 	FindPendingCalled  bool
+	FindByOwnerWith    string
 	CreateCalled       bool
 	CreateCalledWith   *entity.Store
 	UpdateCalled       bool
 	UpdateCalledWith   *entity.Store
-	DeleteCalled       bool
-	DeleteCalledWith   string
+	ApprovalWith       struct {
+		Store *entity.Store
+		From  string
+	}
+	VisibilityWith struct {
+		StoreID        string
+		Visibility     string
+		ApprovalStatus string
+	}
+	DeleteCalled     bool
+	DeleteCalledWith string
+	ScheduleUpdates  map[string]*entity.OpeningSchedule
 }
 
-func (m *MockStoreRepository) FindAll(ctx context.Context) ([]entity.Store, error) {
+func (m *MockStoreRepository) FindAll(ctx context.Context, viewer output.Viewer) ([]entity.Store, error) {
 	m.FindAllCalled = true
+	m.FindAllViewer = viewer
 	if m.FindAllErr != nil {
 		return nil, m.FindAllErr
 	}
 	return m.Stores, nil
 }
 
+func (m *MockStoreRepository) List(ctx context.Context, query output.StoreListQuery) (*output.StorePage, error) {
+	m.ListCalled = true
+	m.ListCalledWith = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	if m.Page != nil {
+		return m.Page, nil
+	}
+	return &output.StorePage{Stores: m.Stores}, nil
+}
+
+func (m *MockStoreRepository) FindNearby(ctx context.Context, query output.StoreNearbyQuery) ([]entity.Store, error) {
+	m.FindNearbyCalled = true
+	m.FindNearbyWith = query
+	if m.FindNearbyErr != nil {
+		return nil, m.FindNearbyErr
+	}
+	return m.Stores, nil
+}
+
 func (m *MockStoreRepository) FindByID(ctx context.Context, id string) (*entity.Store, error) {
 	m.FindByIDCalled = true
 	m.FindByIDCalledWith = id
@@ -67,6 +117,42 @@ func (m *MockStoreRepository) FindByID(ctx context.Context, id string) (*entity.
 	return nil, nil
 }
 
+// FindVisibleByID behaves like FindByID unless NotVisible is set, in which case the store is reported as not found.
+func (m *MockStoreRepository) FindVisibleByID(ctx context.Context, id string, viewer output.Viewer) (*entity.Store, error) {
+	m.VisibleViewer = viewer
+	if m.NotVisible {
+		return nil, apperr.New(apperr.CodeNotFound, errors.New("store not found"))
+	}
+	return m.FindByID(ctx, id)
+}
+
+// IsVisible reports false when NotVisible is set or FindByIDErr is a not-found error.
+func (m *MockStoreRepository) IsVisible(ctx context.Context, id string, viewer output.Viewer) (bool, error) {
+	m.VisibleViewer = viewer
+	if m.NotVisible || apperr.IsCode(m.FindByIDErr, apperr.CodeNotFound) {
+		return false, nil
+	}
+	if m.FindByIDErr != nil {
+		return false, m.FindByIDErr
+	}
+	return true, nil
+}
+
+func (m *MockStoreRepository) FindByIDs(ctx context.Context, ids []string) ([]entity.Store, error) {
+	if m.FindByIDErr != nil {
+		return nil, m.FindByIDErr
+	}
+	var result []entity.Store
+	for _, id := range ids {
+		for i := range m.Stores {
+			if m.Stores[i].StoreID == id {
+				result = append(result, m.Stores[i])
+			}
+		}
+	}
+	return result, nil
+}
+
 func (m *MockStoreRepository) FindPending(ctx context.Context) ([]entity.Store, error) {
 	m.FindPendingCalled = true
 	if m.FindPendingErr != nil {
@@ -75,6 +161,18 @@ func (m *MockStoreRepository) FindPending(ctx context.Context) ([]entity.Store,
 	return m.Stores, nil
 }
 
+func (m *MockStoreRepository) FindByOwner(ctx context.Context, userID string) ([]entity.Store, error) {
+	m.FindByOwnerWith = userID
+	if m.FindByOwnerErr != nil {
+		return nil, m.FindByOwnerErr
+	}
+	return m.Stores, nil
+}
+
+func (m *MockStoreRepository) CreateInTx(ctx context.Context, tx interface{}, store *entity.Store) error {
+	return m.Create(ctx, store)
+}
+
 func (m *MockStoreRepository) Create(ctx context.Context, store *entity.Store) error {
 	m.CreateCalled = true
 	m.CreateCalledWith = store
@@ -103,22 +201,63 @@ func (m *MockStoreRepository) Update(ctx context.Context, store *entity.Store) e
 	return nil
 }
 
+func (m *MockStoreRepository) UpdateInTx(ctx context.Context, tx interface{}, store *entity.Store) error {
+	return m.Update(ctx, store)
+}
+
+func (m *MockStoreRepository) UpdateApprovalInTx(ctx context.Context, tx interface{}, store *entity.Store, from string) error {
+	m.ApprovalWith.Store = store
+	m.ApprovalWith.From = from
+	return m.ApprovalErr
+}
+
+func (m *MockStoreRepository) UpdateVisibilityInTx(ctx context.Context, tx interface{}, storeID string, visibility string, approvalStatus string) error {
+	m.VisibilityWith.StoreID = storeID
+	m.VisibilityWith.Visibility = visibility
+	m.VisibilityWith.ApprovalStatus = approvalStatus
+	return m.VisibilityErr
+}
+
 func (m *MockStoreRepository) Delete(ctx context.Context, id string) error {
 	m.DeleteCalled = true
 	m.DeleteCalledWith = id
 	return m.DeleteErr
 }
 
+func (m *MockStoreRepository) FindWithUnparsedOpeningHours(ctx context.Context) ([]entity.Store, error) {
+	if m.FindAllErr != nil {
+		return nil, m.FindAllErr
+	}
+	return m.Stores, nil
+}
+
+func (m *MockStoreRepository) UpdateOpeningSchedule(ctx context.Context, storeID string, schedule *entity.OpeningSchedule) error {
+	if m.ScheduleUpdates == nil {
+		m.ScheduleUpdates = make(map[string]*entity.OpeningSchedule)
+	}
+	m.ScheduleUpdates[storeID] = schedule
+	return m.UpdateErr
+}
+
 // Reset clears all call tracking state
 func (m *MockStoreRepository) Reset() {
 	m.FindAllCalled = false
+	m.FindAllViewer = output.Viewer{}
+	m.ListCalled = false
+	m.ListCalledWith = output.StoreListQuery{}
+	m.FindNearbyCalled = false
+	m.FindNearbyWith = output.StoreNearbyQuery{}
 	m.FindByIDCalled = false
 	m.FindByIDCalledWith = ""
+	m.VisibleViewer = output.Viewer{}
 	m.FindPendingCalled = false
+	m.FindByOwnerWith = ""
 	m.CreateCalled = false
 	m.CreateCalledWith = nil
 	m.UpdateCalled = false
 	m.UpdateCalledWith = nil
+	m.ApprovalWith.Store = nil
+	m.ApprovalWith.From = ""
 	m.DeleteCalled = false
 	m.DeleteCalledWith = ""
 }
@@ -135,6 +274,14 @@ type MockUserRepository struct {
 	UpdateInTxErr     error
 	UpdateRoleErr     error
 	UpdateRoleInTxErr error
+	ListResult        *output.UserPage
+	ListErr           error
+	UpdateSuspendErr  error
+	AnonymizeErr      error
+	// PurgePending は FindPurgePending が返すユーザー。MarkPurged で削除済みにしたユーザーは返さない
+	PurgePending        []entity.User
+	FindPurgePendingErr error
+	MarkPurgedErr       error
 
 	// Call tracking
 	FindByIDCalled        bool
@@ -161,6 +308,11 @@ type MockUserRepository struct {
 		UserID string
 		Role   string
 	}
+	ListCalledWith          output.UserListQuery
+	UpdateSuspendCalled     bool
+	UpdateSuspendCalledWith entity.User
+	AnonymizeCalledWith     *entity.User
+	MarkPurgedCalledWith    []string
 }
 
 func (m *MockUserRepository) FindByID(ctx context.Context, userID string) (entity.User, error) {
@@ -224,6 +376,65 @@ func (m *MockUserRepository) UpdateRoleInTx(ctx context.Context, tx interface{},
 	return m.UpdateRoleInTxErr
 }
 
+func (m *MockUserRepository) List(ctx context.Context, query output.UserListQuery) (*output.UserPage, error) {
+	m.ListCalledWith = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	if m.ListResult != nil {
+		return m.ListResult, nil
+	}
+	return &output.UserPage{}, nil
+}
+
+func (m *MockUserRepository) UpdateSuspensionInTx(ctx context.Context, tx interface{}, user entity.User) error {
+	m.UpdateSuspendCalled = true
+	m.UpdateSuspendCalledWith = user
+	if m.UpdateSuspendErr != nil {
+		return m.UpdateSuspendErr
+	}
+	m.FindByIDResult = user
+	return nil
+}
+
+func (m *MockUserRepository) AnonymizeInTx(ctx context.Context, tx interface{}, user entity.User) error {
+	m.AnonymizeCalledWith = &user
+	if m.AnonymizeErr != nil {
+		return m.AnonymizeErr
+	}
+	m.FindByIDResult = user
+	return nil
+}
+
+func (m *MockUserRepository) FindPurgePending(ctx context.Context, limit int) ([]entity.User, error) {
+	if m.FindPurgePendingErr != nil {
+		return nil, m.FindPurgePendingErr
+	}
+	var users []entity.User
+	for _, user := range m.PurgePending {
+		if user.IsPurgePending() && len(users) < limit {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (m *MockUserRepository) MarkPurged(ctx context.Context, userID string, purgedAt time.Time) error {
+	m.MarkPurgedCalledWith = append(m.MarkPurgedCalledWith, userID)
+	if m.MarkPurgedErr != nil {
+		return m.MarkPurgedErr
+	}
+	for i := range m.PurgePending {
+		if m.PurgePending[i].UserID == userID {
+			m.PurgePending[i].PurgedAt = &purgedAt
+		}
+	}
+	if m.FindByIDResult.UserID == userID {
+		m.FindByIDResult.PurgedAt = &purgedAt
+	}
+	return nil
+}
+
 // Reset clears all call tracking state
 func (m *MockUserRepository) Reset() {
 	m.FindByIDCalled = false
@@ -253,36 +464,61 @@ type MockReviewRepository struct {
 	FindByStoreIDErr    error
 	FindByIDResult      *entity.Review
 	FindByIDErr         error
+	NotVisible          bool
 	FindByUserIDResult  []entity.Review
 	FindByUserIDErr     error
+	FindByIDsResult     []entity.Review
+	FindByIDsErr        error
 	CreateInTxErr       error
+	UpdateInTxErr       error
+	DeleteInTxErr       error
+	UpdateVisibilityErr error
 	AddLikeErr          error
 	RemoveLikeErr       error
+	LikesResult         []entity.ReviewLike
+	FindLikesErr        error
+	DeleteLikesErr      error
+	StatsResult         *entity.ReviewerStats
+	FindStatsErr        error
+	FeedResult          *output.ReviewPage
+	FindFeedErr         error
 
 	// Call tracking
 	FindByStoreIDCalled     bool
 	FindByStoreIDCalledWith struct {
-		StoreID  string
-		Sort     string
-		ViewerID string
+		StoreID string
+		Sort    string
+		Viewer  output.Viewer
 	}
 	FindByIDCalled         bool
 	FindByIDCalledWith     string
+	VisibleViewer          output.Viewer
+	FeedCalledWith         output.ReviewFeedQuery
 	FindByUserIDCalled     bool
 	FindByUserIDCalledWith string
 	CreateInTxCalled       bool
 	CreateInTxCalledWith   output.CreateReview
-	AddLikeCalled          bool
-	AddLikeCalledWith      struct{ ReviewID, UserID string }
-	RemoveLikeCalled       bool
-	RemoveLikeCalledWith   struct{ ReviewID, UserID string }
-}
-
-func (m *MockReviewRepository) FindByStoreID(ctx context.Context, storeID string, sort string, viewerID string) ([]entity.Review, error) {
+	UpdateInTxCalled       bool
+	UpdateInTxCalledWith   struct {
+		ReviewID string
+		Review   output.UpdateReview
+	}
+	DeleteInTxCalled      bool
+	DeleteInTxCalledWith  string
+	UpdateVisibilityWith  struct{ ReviewID, Visibility string }
+	AddLikeCalled         bool
+	AddLikeCalledWith     struct{ ReviewID, UserID string }
+	RemoveLikeCalled      bool
+	RemoveLikeCalledWith  struct{ ReviewID, UserID string }
+	DeletedInTx           []string
+	DeleteLikesCalledWith string
+}
+
+func (m *MockReviewRepository) FindByStoreID(ctx context.Context, storeID string, sort string, viewer output.Viewer) ([]entity.Review, error) {
 	m.FindByStoreIDCalled = true
 	m.FindByStoreIDCalledWith.StoreID = storeID
 	m.FindByStoreIDCalledWith.Sort = sort
-	m.FindByStoreIDCalledWith.ViewerID = viewerID
+	m.FindByStoreIDCalledWith.Viewer = viewer
 	if m.FindByStoreIDErr != nil {
 		return nil, m.FindByStoreIDErr
 	}
@@ -298,7 +534,33 @@ func (m *MockReviewRepository) FindByID(ctx context.Context, reviewID string) (*
 	return m.FindByIDResult, nil
 }
 
-func (m *MockReviewRepository) FindByUserID(ctx context.Context, userID string) ([]entity.Review, error) {
+func (m *MockReviewRepository) IsVisible(ctx context.Context, reviewID string, viewer output.Viewer) (bool, error) {
+	m.VisibleViewer = viewer
+	if m.NotVisible || apperr.IsCode(m.FindByIDErr, apperr.CodeNotFound) {
+		return false, nil
+	}
+	if m.FindByIDErr != nil {
+		return false, m.FindByIDErr
+	}
+	return true, nil
+}
+
+func (m *MockReviewRepository) FindByIDs(ctx context.Context, reviewIDs []string) ([]entity.Review, error) {
+	if m.FindByIDsErr != nil {
+		return nil, m.FindByIDsErr
+	}
+	var result []entity.Review
+	for _, id := range reviewIDs {
+		for _, review := range m.FindByIDsResult {
+			if review.ReviewID == id {
+				result = append(result, review)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *MockReviewRepository) FindByUserID(ctx context.Context, userID string, viewer output.Viewer) ([]entity.Review, error) {
 	m.FindByUserIDCalled = true
 	m.FindByUserIDCalledWith = userID
 	if m.FindByUserIDErr != nil {
@@ -307,12 +569,56 @@ func (m *MockReviewRepository) FindByUserID(ctx context.Context, userID string)
 	return m.FindByUserIDResult, nil
 }
 
+func (m *MockReviewRepository) FindReviewerStats(ctx context.Context, userID string) (*entity.ReviewerStats, error) {
+	if m.FindStatsErr != nil {
+		return nil, m.FindStatsErr
+	}
+	if m.StatsResult != nil {
+		return m.StatsResult, nil
+	}
+	return &entity.ReviewerStats{}, nil
+}
+
+func (m *MockReviewRepository) FindFeed(ctx context.Context, query output.ReviewFeedQuery) (*output.ReviewPage, error) {
+	m.FeedCalledWith = query
+	if m.FindFeedErr != nil {
+		return nil, m.FindFeedErr
+	}
+	if m.FeedResult != nil {
+		return m.FeedResult, nil
+	}
+	return &output.ReviewPage{}, nil
+}
+
 func (m *MockReviewRepository) CreateInTx(ctx context.Context, tx interface{}, review output.CreateReview) error {
 	m.CreateInTxCalled = true
 	m.CreateInTxCalledWith = review
 	return m.CreateInTxErr
 }
 
+func (m *MockReviewRepository) UpdateInTx(ctx context.Context, tx interface{}, reviewID string, review output.UpdateReview) error {
+	m.UpdateInTxCalled = true
+	m.UpdateInTxCalledWith.ReviewID = reviewID
+	m.UpdateInTxCalledWith.Review = review
+	return m.UpdateInTxErr
+}
+
+func (m *MockReviewRepository) DeleteInTx(ctx context.Context, tx interface{}, reviewID string) error {
+	m.DeleteInTxCalled = true
+	m.DeleteInTxCalledWith = reviewID
+	if m.DeleteInTxErr != nil {
+		return m.DeleteInTxErr
+	}
+	m.DeletedInTx = append(m.DeletedInTx, reviewID)
+	return nil
+}
+
+func (m *MockReviewRepository) UpdateVisibilityInTx(ctx context.Context, tx interface{}, reviewID string, visibility string) error {
+	m.UpdateVisibilityWith.ReviewID = reviewID
+	m.UpdateVisibilityWith.Visibility = visibility
+	return m.UpdateVisibilityErr
+}
+
 func (m *MockReviewRepository) AddLike(ctx context.Context, reviewID string, userID string) error {
 	m.AddLikeCalled = true
 	m.AddLikeCalledWith.ReviewID = reviewID
@@ -327,6 +633,18 @@ func (m *MockReviewRepository) RemoveLike(ctx context.Context, reviewID string,
 	return m.RemoveLikeErr
 }
 
+func (m *MockReviewRepository) FindLikesByUserID(ctx context.Context, userID string) ([]entity.ReviewLike, error) {
+	if m.FindLikesErr != nil {
+		return nil, m.FindLikesErr
+	}
+	return m.LikesResult, nil
+}
+
+func (m *MockReviewRepository) DeleteLikesByUserIDInTx(ctx context.Context, tx interface{}, userID string) error {
+	m.DeleteLikesCalledWith = userID
+	return m.DeleteLikesErr
+}
+
 // MockFavoriteRepository implements output.FavoriteRepository for testing.
 type MockFavoriteRepository struct {
 	// Return values
@@ -336,6 +654,7 @@ type MockFavoriteRepository struct {
 	FindByUserAndStoreErr    error
 	CreateErr                error
 	DeleteErr                error
+	DeleteByUserErr          error
 
 	// Call tracking
 	FindByUserIDCalled           bool
@@ -346,9 +665,10 @@ type MockFavoriteRepository struct {
 	CreateCalledWith             *entity.Favorite
 	DeleteCalled                 bool
 	DeleteCalledWith             struct{ UserID, StoreID string }
+	DeleteByUserCalledWith       string
 }
 
-func (m *MockFavoriteRepository) FindByUserID(ctx context.Context, userID string) ([]entity.Favorite, error) {
+func (m *MockFavoriteRepository) FindByUserID(ctx context.Context, userID string, viewer output.Viewer) ([]entity.Favorite, error) {
 	m.FindByUserIDCalled = true
 	m.FindByUserIDCalledWith = userID
 	if m.FindByUserIDErr != nil {
@@ -380,6 +700,163 @@ func (m *MockFavoriteRepository) Delete(ctx context.Context, userID string, stor
 	return m.DeleteErr
 }
 
+func (m *MockFavoriteRepository) DeleteByUserIDInTx(ctx context.Context, tx interface{}, userID string) error {
+	m.DeleteByUserCalledWith = userID
+	return m.DeleteByUserErr
+}
+
+// MockFollowRepository implements output.FollowRepository for testing.
+type MockFollowRepository struct {
+	// Return values
+	CreateErr       error
+	DeleteErr       error
+	FollowersResult *output.FollowPage
+	FollowingResult *output.FollowPage
+	ListErr         error
+	DeleteByUserErr error
+
+	// Call tracking
+	CreateCalledWith struct{ FollowerID, FolloweeID string }
+	DeleteCalledWith struct{ FollowerID, FolloweeID string }
+	ListCalledWith   struct {
+		UserID string
+		Query  output.FollowListQuery
+	}
+	DeleteByUserCalledWith string
+}
+
+func (m *MockFollowRepository) Create(ctx context.Context, followerID string, followeeID string) error {
+	m.CreateCalledWith.FollowerID = followerID
+	m.CreateCalledWith.FolloweeID = followeeID
+	return m.CreateErr
+}
+
+func (m *MockFollowRepository) Delete(ctx context.Context, followerID string, followeeID string) error {
+	m.DeleteCalledWith.FollowerID = followerID
+	m.DeleteCalledWith.FolloweeID = followeeID
+	return m.DeleteErr
+}
+
+func (m *MockFollowRepository) ListFollowers(ctx context.Context, userID string, query output.FollowListQuery) (*output.FollowPage, error) {
+	return m.list(userID, query, m.FollowersResult)
+}
+
+func (m *MockFollowRepository) ListFollowing(ctx context.Context, userID string, query output.FollowListQuery) (*output.FollowPage, error) {
+	return m.list(userID, query, m.FollowingResult)
+}
+
+func (m *MockFollowRepository) list(userID string, query output.FollowListQuery, result *output.FollowPage) (*output.FollowPage, error) {
+	m.ListCalledWith.UserID = userID
+	m.ListCalledWith.Query = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	if result != nil {
+		return result, nil
+	}
+	return &output.FollowPage{}, nil
+}
+
+func (m *MockFollowRepository) DeleteByUserIDInTx(ctx context.Context, tx interface{}, userID string) error {
+	m.DeleteByUserCalledWith = userID
+	return m.DeleteByUserErr
+}
+
+// MockVisitRepository implements output.VisitRepository for testing.
+type MockVisitRepository struct {
+	// Return values
+	SaveErr            error
+	MarkVisitedErr     error
+	FindByIDResult     *entity.Visit
+	FindByIDErr        error
+	DeleteErr          error
+	ListResult         *output.VisitPage
+	ListErr            error
+	CountsResult       *entity.VisitCounts
+	CountErr           error
+	FindByUserIDResult []entity.Visit
+	FindByUserIDErr    error
+	DeleteByUserErr    error
+
+	// Call tracking
+	SaveCalledWith        *entity.Visit
+	MarkVisitedCalledWith struct {
+		UserID    string
+		StoreID   string
+		VisitedOn time.Time
+	}
+	DeleteCalledWith int64
+	ListCalledWith   struct {
+		UserID string
+		Query  output.VisitListQuery
+	}
+	CountCalledWith        string
+	DeleteByUserCalledWith string
+}
+
+func (m *MockVisitRepository) Save(ctx context.Context, visit *entity.Visit) error {
+	m.SaveCalledWith = visit
+	if m.SaveErr != nil {
+		return m.SaveErr
+	}
+	visit.VisitID = 1
+	return nil
+}
+
+func (m *MockVisitRepository) MarkVisitedInTx(ctx context.Context, tx interface{}, userID string, storeID string, visitedOn time.Time) error {
+	m.MarkVisitedCalledWith.UserID = userID
+	m.MarkVisitedCalledWith.StoreID = storeID
+	m.MarkVisitedCalledWith.VisitedOn = visitedOn
+	return m.MarkVisitedErr
+}
+
+func (m *MockVisitRepository) FindByID(ctx context.Context, visitID int64) (*entity.Visit, error) {
+	if m.FindByIDErr != nil {
+		return nil, m.FindByIDErr
+	}
+	return m.FindByIDResult, nil
+}
+
+func (m *MockVisitRepository) Delete(ctx context.Context, visitID int64) error {
+	m.DeleteCalledWith = visitID
+	return m.DeleteErr
+}
+
+func (m *MockVisitRepository) ListByUser(ctx context.Context, userID string, query output.VisitListQuery) (*output.VisitPage, error) {
+	m.ListCalledWith.UserID = userID
+	m.ListCalledWith.Query = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	if m.ListResult != nil {
+		return m.ListResult, nil
+	}
+	return &output.VisitPage{}, nil
+}
+
+func (m *MockVisitRepository) CountByUser(ctx context.Context, userID string, viewer output.Viewer) (*entity.VisitCounts, error) {
+	m.CountCalledWith = userID
+	if m.CountErr != nil {
+		return nil, m.CountErr
+	}
+	if m.CountsResult != nil {
+		return m.CountsResult, nil
+	}
+	return &entity.VisitCounts{}, nil
+}
+
+func (m *MockVisitRepository) FindByUserID(ctx context.Context, userID string) ([]entity.Visit, error) {
+	if m.FindByUserIDErr != nil {
+		return nil, m.FindByUserIDErr
+	}
+	return m.FindByUserIDResult, nil
+}
+
+func (m *MockVisitRepository) DeleteByUserIDInTx(ctx context.Context, tx interface{}, userID string) error {
+	m.DeleteByUserCalledWith = userID
+	return m.DeleteByUserErr
+}
+
 // MockMenuRepository implements output.MenuRepository for testing.
 type MockMenuRepository struct {
 	// Return values
@@ -387,7 +864,12 @@ type MockMenuRepository struct {
 	FindByStoreIDErr        error
 	FindByStoreAndIDsResult []entity.Menu
 	FindByStoreAndIDsErr    error
+	FindByIDResult          *entity.Menu
+	FindByIDErr             error
 	CreateErr               error
+	UpdateErr               error
+	ReorderErr              error
+	DeleteErr               error
 
 	// Call tracking
 	FindByStoreIDCalled         bool
@@ -397,8 +879,18 @@ type MockMenuRepository struct {
 		StoreID string
 		MenuIDs []string
 	}
-	CreateCalled     bool
-	CreateCalledWith *entity.Menu
+	FindByIDCalledWith string
+	CreateCalled       bool
+	CreateCalledWith   *entity.Menu
+	UpdateCalled       bool
+	UpdateCalledWith   *entity.Menu
+	ReorderCalled      bool
+	ReorderCalledWith  struct {
+		StoreID string
+		MenuIDs []string
+	}
+	DeleteCalled     bool
+	DeleteCalledWith string
 }
 
 func (m *MockMenuRepository) FindByStoreID(ctx context.Context, storeID string) ([]entity.Menu, error) {
@@ -420,19 +912,144 @@ func (m *MockMenuRepository) FindByStoreAndIDs(ctx context.Context, storeID stri
 	return m.FindByStoreAndIDsResult, nil
 }
 
-func (m *MockMenuRepository) Create(ctx context.Context, menu *entity.Menu) error {
+func (m *MockMenuRepository) FindByID(ctx context.Context, menuID string) (*entity.Menu, error) {
+	m.FindByIDCalledWith = menuID
+	if m.FindByIDErr != nil {
+		return nil, m.FindByIDErr
+	}
+	if m.FindByIDResult == nil {
+		return nil, nil
+	}
+	// 呼び出し側での変更が他のテストケースに影響しないようコピーを返す
+	menu := *m.FindByIDResult
+	return &menu, nil
+}
+
+func (m *MockMenuRepository) CreateInTx(ctx context.Context, tx interface{}, menu *entity.Menu) error {
 	m.CreateCalled = true
 	m.CreateCalledWith = menu
 	return m.CreateErr
 }
 
+func (m *MockMenuRepository) UpdateInTx(ctx context.Context, tx interface{}, menu *entity.Menu) error {
+	m.UpdateCalled = true
+	m.UpdateCalledWith = menu
+	return m.UpdateErr
+}
+
+func (m *MockMenuRepository) ReorderInTx(ctx context.Context, tx interface{}, storeID string, menuIDs []string) error {
+	m.ReorderCalled = true
+	m.ReorderCalledWith.StoreID = storeID
+	m.ReorderCalledWith.MenuIDs = menuIDs
+	return m.ReorderErr
+}
+
+func (m *MockMenuRepository) Delete(ctx context.Context, menuID string) error {
+	m.DeleteCalled = true
+	m.DeleteCalledWith = menuID
+	return m.DeleteErr
+}
+
+func (m *MockMenuRepository) DeleteInTx(ctx context.Context, tx interface{}, menuID string) error {
+	return m.Delete(ctx, menuID)
+}
+
+// MockStorePhotoRepository implements output.StorePhotoRepository for testing.
+type MockStorePhotoRepository struct {
+	// Return values
+	Photos      []entity.StorePhoto
+	FindErr     error
+	CountResult int
+	CountErr    error
+	AddErr      error
+	UpdateErr   error
+	ReorderErr  error
+	SetCoverErr error
+	DeleteErr   error
+
+	// Call tracking
+	AddedFileIDs      []string
+	UpdateCalledWith  *entity.StorePhoto
+	ReorderCalledWith []string
+	CoverCalledWith   string
+	DeleteCalledWith  string
+}
+
+func (m *MockStorePhotoRepository) FindByStoreID(ctx context.Context, storeID string) ([]entity.StorePhoto, error) {
+	if m.FindErr != nil {
+		return nil, m.FindErr
+	}
+	return m.Photos, nil
+}
+
+// FindByID は Photos から file_id が一致する写真のコピーを返します。見つからない場合は NotFound のエラーを返します
+func (m *MockStorePhotoRepository) FindByID(ctx context.Context, storeID string, fileID string) (*entity.StorePhoto, error) {
+	if m.FindErr != nil {
+		return nil, m.FindErr
+	}
+	for _, photo := range m.Photos {
+		if photo.File.FileID == fileID {
+			found := photo
+			return &found, nil
+		}
+	}
+	return nil, apperr.New(apperr.CodeNotFound, errors.New("store photo not found"))
+}
+
+func (m *MockStorePhotoRepository) CountByStoreID(ctx context.Context, storeID string) (int, error) {
+	return m.CountResult, m.CountErr
+}
+
+func (m *MockStorePhotoRepository) Add(ctx context.Context, storeID string, fileID string) error {
+	m.AddedFileIDs = append(m.AddedFileIDs, fileID)
+	return m.AddErr
+}
+
+func (m *MockStorePhotoRepository) UpdateDetails(ctx context.Context, photo *entity.StorePhoto) error {
+	m.UpdateCalledWith = photo
+	return m.UpdateErr
+}
+
+func (m *MockStorePhotoRepository) ReorderInTx(ctx context.Context, tx interface{}, storeID string, fileIDs []string) error {
+	m.ReorderCalledWith = fileIDs
+	return m.ReorderErr
+}
+
+func (m *MockStorePhotoRepository) SetCoverInTx(ctx context.Context, tx interface{}, storeID string, fileID string) error {
+	m.CoverCalledWith = fileID
+	return m.SetCoverErr
+}
+
+func (m *MockStorePhotoRepository) DeleteInTx(ctx context.Context, tx interface{}, storeID string, fileID string) error {
+	m.DeleteCalledWith = fileID
+	return m.DeleteErr
+}
+
 // MockFileRepository implements output.FileRepository for testing.
 type MockFileRepository struct {
 	// Return values
 	FindByStoreAndIDsResult []entity.File
 	FindByStoreAndIDsErr    error
+	FindByCreatorResult     []entity.File
+	FindByCreatorErr        error
 	CreateErr               error
 	LinkToStoreErr          error
+	UploadedResult          []entity.File
+	FindUploadedErr         error
+	UnlinkByCreatorErr      error
+	FindByIDResult          *entity.File
+	FindByIDErr             error
+	MarkUploadedErr         error
+	// Sweepable は FindSweepable が返すファイル。DeleteByIDsInTx で削除されたファイルは返さない
+	Sweepable        []entity.File
+	FindSweepableErr error
+	DeleteByIDsErr   error
+	// Unprocessed は FindUnprocessedReviewImages が返すファイル。MarkProcessed で加工済みにしたファイルと
+	// 失敗が上限に達したファイルは返さない
+	Unprocessed                []entity.File
+	FindUnprocessedErr         error
+	MarkProcessedErr           error
+	RecordProcessingFailureErr error
 
 	// Call tracking
 	FindByStoreAndIDsCalled     bool
@@ -440,6 +1057,10 @@ type MockFileRepository struct {
 		StoreID string
 		FileIDs []string
 	}
+	FindByCreatorCalledWith struct {
+		UserID  string
+		FileIDs []string
+	}
 	CreateCalled          bool
 	CreateCalledWith      *entity.File
 	LinkToStoreCalled     bool
@@ -447,6 +1068,14 @@ type MockFileRepository struct {
 		StoreID string
 		FileID  string
 	}
+	UnlinkByCreatorCalledWith string
+	MarkUploadedCalledWith    []string
+	MarkUploadedInfo          output.ObjectInfo
+	DeletedByIDs              []string
+	// MarkProcessedCalledWith は MarkProcessed に渡された結果をファイル ID ごとに記録する
+	MarkProcessedCalledWith map[string]output.ProcessedFile
+	// ProcessingFailures は RecordProcessingFailure に渡されたファイル ID
+	ProcessingFailures []string
 }
 
 func (m *MockFileRepository) FindByStoreAndIDs(ctx context.Context, storeID string, fileIDs []string) ([]entity.File, error) {
@@ -459,6 +1088,15 @@ func (m *MockFileRepository) FindByStoreAndIDs(ctx context.Context, storeID stri
 	return m.FindByStoreAndIDsResult, nil
 }
 
+func (m *MockFileRepository) FindByCreatorAndIDs(ctx context.Context, userID string, fileIDs []string) ([]entity.File, error) {
+	m.FindByCreatorCalledWith.UserID = userID
+	m.FindByCreatorCalledWith.FileIDs = fileIDs
+	if m.FindByCreatorErr != nil {
+		return nil, m.FindByCreatorErr
+	}
+	return m.FindByCreatorResult, nil
+}
+
 func (m *MockFileRepository) Create(ctx context.Context, file *entity.File) error {
 	m.CreateCalled = true
 	m.CreateCalledWith = file
@@ -478,57 +1116,610 @@ func (m *MockFileRepository) LinkToStore(ctx context.Context, storeID string, fi
 	return m.LinkToStoreErr
 }
 
-// MockReportRepository implements output.ReportRepository for testing.
-type MockReportRepository struct {
-	// Return values
-	FindAllResult   []entity.Report
-	FindAllErr      error
-	FindByIDResult  *entity.Report
-	FindByIDErr     error
-	CreateErr       error
-	UpdateStatusErr error
-
-	// Call tracking
-	FindAllCalled          bool
-	FindByIDCalled         bool
-	FindByIDCalledWith     int64
-	CreateCalled           bool
-	CreateCalledWith       *entity.Report
-	UpdateStatusCalled     bool
-	UpdateStatusCalledWith struct {
-		ReportID int64
-		Status   string
+func (m *MockFileRepository) FindByCreator(ctx context.Context, userID string) ([]entity.File, error) {
+	if m.FindUploadedErr != nil {
+		return nil, m.FindUploadedErr
 	}
+	return m.UploadedResult, nil
 }
 
-func (m *MockReportRepository) FindAll(ctx context.Context) ([]entity.Report, error) {
-	m.FindAllCalled = true
-	if m.FindAllErr != nil {
-		return nil, m.FindAllErr
-	}
-	return m.FindAllResult, nil
+func (m *MockFileRepository) UnlinkByCreatorInTx(ctx context.Context, tx interface{}, userID string) error {
+	m.UnlinkByCreatorCalledWith = userID
+	return m.UnlinkByCreatorErr
 }
 
-func (m *MockReportRepository) FindByID(ctx context.Context, reportID int64) (*entity.Report, error) {
-	m.FindByIDCalled = true
-	m.FindByIDCalledWith = reportID
+func (m *MockFileRepository) FindByID(ctx context.Context, fileID string) (*entity.File, error) {
 	if m.FindByIDErr != nil {
 		return nil, m.FindByIDErr
 	}
+	if m.FindByIDResult == nil {
+		return nil, apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+	}
 	return m.FindByIDResult, nil
 }
 
-func (m *MockReportRepository) Create(ctx context.Context, report *entity.Report) error {
-	m.CreateCalled = true
-	m.CreateCalledWith = report
-	return m.CreateErr
+func (m *MockFileRepository) MarkUploaded(ctx context.Context, fileID string, info output.ObjectInfo, uploadedAt time.Time) error {
+	if m.MarkUploadedErr != nil {
+		return m.MarkUploadedErr
+	}
+	m.MarkUploadedCalledWith = append(m.MarkUploadedCalledWith, fileID)
+	m.MarkUploadedInfo = info
+	for i := range m.Sweepable {
+		if m.Sweepable[i].FileID == fileID {
+			m.Sweepable[i].UploadedAt = &uploadedAt
+		}
+	}
+	return nil
 }
 
-func (m *MockReportRepository) UpdateStatus(ctx context.Context, reportID int64, status string) error {
-	m.UpdateStatusCalled = true
-	m.UpdateStatusCalledWith.ReportID = reportID
-	m.UpdateStatusCalledWith.Status = status
-	return m.UpdateStatusErr
+func (m *MockFileRepository) FindSweepable(ctx context.Context, createdBefore time.Time, limit int) ([]entity.File, error) {
+	if m.FindSweepableErr != nil {
+		return nil, m.FindSweepableErr
+	}
+	var files []entity.File
+	for _, file := range m.Sweepable {
+		if !file.IsDeleted && len(files) < limit {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+func (m *MockFileRepository) DeleteByIDsInTx(ctx context.Context, tx interface{}, fileIDs []string) error {
+	if m.DeleteByIDsErr != nil {
+		return m.DeleteByIDsErr
+	}
+	m.DeletedByIDs = append(m.DeletedByIDs, fileIDs...)
+	for i := range m.Sweepable {
+		for _, id := range fileIDs {
+			if m.Sweepable[i].FileID == id {
+				m.Sweepable[i].IsDeleted = true
+			}
+		}
+	}
+	return nil
+}
+
+func (m *MockFileRepository) FindUnprocessedReviewImages(ctx context.Context, maxAttempts, limit int) ([]entity.File, error) {
+	if m.FindUnprocessedErr != nil {
+		return nil, m.FindUnprocessedErr
+	}
+	var files []entity.File
+	for _, file := range m.Unprocessed {
+		if file.ProcessedAt == nil && file.ProcessingAttempts < maxAttempts && len(files) < limit {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+func (m *MockFileRepository) MarkProcessed(ctx context.Context, fileID string, result output.ProcessedFile, processedAt time.Time) error {
+	if m.MarkProcessedErr != nil {
+		return m.MarkProcessedErr
+	}
+	if m.MarkProcessedCalledWith == nil {
+		m.MarkProcessedCalledWith = make(map[string]output.ProcessedFile)
+	}
+	m.MarkProcessedCalledWith[fileID] = result
+	for i := range m.Unprocessed {
+		if m.Unprocessed[i].FileID == fileID {
+			m.Unprocessed[i].ProcessedAt = &processedAt
+			m.Unprocessed[i].Variants = result.Variants
+		}
+	}
+	return nil
+}
+
+func (m *MockFileRepository) RecordProcessingFailure(ctx context.Context, fileID string) error {
+	if m.RecordProcessingFailureErr != nil {
+		return m.RecordProcessingFailureErr
+	}
+	m.ProcessingFailures = append(m.ProcessingFailures, fileID)
+	for i := range m.Unprocessed {
+		if m.Unprocessed[i].FileID == fileID {
+			m.Unprocessed[i].ProcessingAttempts++
+		}
+	}
+	return nil
+}
+
+// MockReportRepository implements output.ReportRepository for testing.
+type MockReportRepository struct {
+	// Return values
+	ListResult     *output.ReportPage
+	ListErr        error
+	FindByIDResult *entity.Report
+	FindByIDErr    error
+	Pending        bool
+	HasPendingErr  error
+	CreateErr      error
+	ResolveCount   int
+	ResolveErr     error
+	ByUserResult   []entity.Report
+	FindByUserErr  error
+
+	// Call tracking
+	ListCalled         bool
+	ListCalledWith     output.ReportListQuery
+	FindByIDCalled     bool
+	FindByIDCalledWith int64
+	CreateCalled       bool
+	CreateCalledWith   *entity.Report
+	ResolveCalled      bool
+	ResolveCalledWith  *entity.Report
+}
+
+func (m *MockReportRepository) List(ctx context.Context, query output.ReportListQuery) (*output.ReportPage, error) {
+	m.ListCalled = true
+	m.ListCalledWith = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	if m.ListResult != nil {
+		return m.ListResult, nil
+	}
+	return &output.ReportPage{}, nil
+}
+
+func (m *MockReportRepository) FindByID(ctx context.Context, reportID int64) (*entity.Report, error) {
+	m.FindByIDCalled = true
+	m.FindByIDCalledWith = reportID
+	if m.FindByIDErr != nil {
+		return nil, m.FindByIDErr
+	}
+	if m.FindByIDResult == nil {
+		return nil, nil
+	}
+	// 呼び出し側での変更が他のテストケースに影響しないようコピーを返す
+	report := *m.FindByIDResult
+	return &report, nil
+}
+
+func (m *MockReportRepository) FindByUserID(ctx context.Context, userID string) ([]entity.Report, error) {
+	if m.FindByUserErr != nil {
+		return nil, m.FindByUserErr
+	}
+	return m.ByUserResult, nil
+}
+
+func (m *MockReportRepository) HasPending(ctx context.Context, userID, targetType, targetID string) (bool, error) {
+	if m.HasPendingErr != nil {
+		return false, m.HasPendingErr
+	}
+	return m.Pending, nil
+}
+
+func (m *MockReportRepository) Create(ctx context.Context, report *entity.Report) error {
+	m.CreateCalled = true
+	m.CreateCalledWith = report
+	return m.CreateErr
+}
+
+func (m *MockReportRepository) ResolveInTx(ctx context.Context, tx interface{}, report *entity.Report) (int, error) {
+	m.ResolveCalled = true
+	m.ResolveCalledWith = report
+	if m.ResolveErr != nil {
+		return 0, m.ResolveErr
+	}
+	if m.ResolveCount == 0 {
+		return 1, nil
+	}
+	return m.ResolveCount, nil
+}
+
+// MockStoreRatingRepository implements output.StoreRatingRepository for testing.
+type MockStoreRatingRepository struct {
+	// Return values
+	Summary         *entity.RatingSummary
+	FindErr         error
+	RecomputeErr    error
+	RecomputedAll   int64
+	RecomputeAllErr error
+
+	// Call tracking
+	RecomputeCalledWith []string
+}
+
+func (m *MockStoreRatingRepository) FindByStoreID(ctx context.Context, storeID string) (*entity.RatingSummary, error) {
+	if m.FindErr != nil {
+		return nil, m.FindErr
+	}
+	return m.Summary, nil
+}
+
+func (m *MockStoreRatingRepository) RecomputeInTx(ctx context.Context, tx interface{}, storeID string) error {
+	m.RecomputeCalledWith = append(m.RecomputeCalledWith, storeID)
+	return m.RecomputeErr
+}
+
+func (m *MockStoreRatingRepository) RecomputeAll(ctx context.Context) (int64, error) {
+	if m.RecomputeAllErr != nil {
+		return 0, m.RecomputeAllErr
+	}
+	return m.RecomputedAll, nil
+}
+
+// MockStoreOwnerRepository implements output.StoreOwnerRepository for testing.
+type MockStoreOwnerRepository struct {
+	// Return values
+	Owners     map[string][]string // storeID -> userIDs
+	IsOwnerErr error
+	AddErr     error
+
+	// Call tracking
+	AddCalled     bool
+	AddCalledWith struct {
+		StoreID string
+		UserID  string
+	}
+}
+
+func (m *MockStoreOwnerRepository) IsOwner(ctx context.Context, storeID, userID string) (bool, error) {
+	if m.IsOwnerErr != nil {
+		return false, m.IsOwnerErr
+	}
+	for _, id := range m.Owners[storeID] {
+		if id == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockStoreOwnerRepository) AddInTx(ctx context.Context, tx interface{}, storeID, userID string) error {
+	m.AddCalled = true
+	m.AddCalledWith.StoreID = storeID
+	m.AddCalledWith.UserID = userID
+	if m.AddErr != nil {
+		return m.AddErr
+	}
+	if m.Owners == nil {
+		m.Owners = map[string][]string{}
+	}
+	m.Owners[storeID] = append(m.Owners[storeID], userID)
+	return nil
+}
+
+// MockStoreTagRepository implements output.StoreTagRepository for testing.
+type MockStoreTagRepository struct {
+	// Return values
+	Counts     []entity.TagCount
+	ReplaceErr error
+	CountErr   error
+
+	// Call tracking
+	ReplaceCalled     bool
+	ReplaceCalledWith struct {
+		StoreID string
+		Tags    []string
+	}
+}
+
+func (m *MockStoreTagRepository) ReplaceInTx(ctx context.Context, tx interface{}, storeID string, tags []string) error {
+	m.ReplaceCalled = true
+	m.ReplaceCalledWith.StoreID = storeID
+	m.ReplaceCalledWith.Tags = tags
+	return m.ReplaceErr
+}
+
+func (m *MockStoreTagRepository) CountByTag(ctx context.Context) ([]entity.TagCount, error) {
+	if m.CountErr != nil {
+		return nil, m.CountErr
+	}
+	return m.Counts, nil
+}
+
+// MockSearchRepository implements output.SearchRepository for testing.
+type MockSearchRepository struct {
+	// Return values
+	Page      *output.SearchPage
+	SearchErr error
+
+	// Call tracking
+	SearchCalledWith output.SearchQuery
+}
+
+func (m *MockSearchRepository) Search(ctx context.Context, query output.SearchQuery) (*output.SearchPage, error) {
+	m.SearchCalledWith = query
+	if m.SearchErr != nil {
+		return nil, m.SearchErr
+	}
+	if m.Page != nil {
+		return m.Page, nil
+	}
+	return &output.SearchPage{}, nil
+}
+
+// MockStoreClaimRepository implements output.StoreClaimRepository for testing.
+type MockStoreClaimRepository struct {
+	// Return values
+	Claims        []entity.StoreClaim
+	Claim         *entity.StoreClaim
+	Pending       bool
+	ListErr       error
+	FindByIDErr   error
+	HasPendingErr error
+	CreateErr     error
+	UpdateErr     error
+
+	// Call tracking
+	ListCalledWith   *string
+	CreateCalledWith struct {
+		Claim   *entity.StoreClaim
+		FileIDs []string
+	}
+	UpdateCalled     bool
+	UpdateCalledWith *entity.StoreClaim
+}
+
+func (m *MockStoreClaimRepository) List(ctx context.Context, status *string) ([]entity.StoreClaim, error) {
+	m.ListCalledWith = status
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	return m.Claims, nil
+}
+
+func (m *MockStoreClaimRepository) FindByID(ctx context.Context, claimID string) (*entity.StoreClaim, error) {
+	if m.FindByIDErr != nil {
+		return nil, m.FindByIDErr
+	}
+	return m.Claim, nil
+}
+
+func (m *MockStoreClaimRepository) HasPending(ctx context.Context, storeID, userID string) (bool, error) {
+	if m.HasPendingErr != nil {
+		return false, m.HasPendingErr
+	}
+	return m.Pending, nil
+}
+
+func (m *MockStoreClaimRepository) CreateInTx(ctx context.Context, tx interface{}, claim *entity.StoreClaim, fileIDs []string) error {
+	m.CreateCalledWith.Claim = claim
+	m.CreateCalledWith.FileIDs = fileIDs
+	if m.CreateErr != nil {
+		return m.CreateErr
+	}
+	if claim.ClaimID == "" {
+		claim.ClaimID = "generated-claim-id"
+	}
+	m.Claim = claim
+	return nil
+}
+
+func (m *MockStoreClaimRepository) UpdateReviewInTx(ctx context.Context, tx interface{}, claim *entity.StoreClaim) error {
+	m.UpdateCalled = true
+	m.UpdateCalledWith = claim
+	return m.UpdateErr
+}
+
+// MockStoreApprovalEventRepository implements output.StoreApprovalEventRepository for testing.
+type MockStoreApprovalEventRepository struct {
+	// Return values
+	Events    []entity.StoreApprovalEvent
+	CreateErr error
+	FindErr   error
+
+	// Call tracking
+	Created      []entity.StoreApprovalEvent
+	FindCalledID string
+}
+
+func (m *MockStoreApprovalEventRepository) CreateInTx(ctx context.Context, tx interface{}, event *entity.StoreApprovalEvent) error {
+	if m.CreateErr != nil {
+		return m.CreateErr
+	}
+	event.EventID = int64(len(m.Created) + 1)
+	m.Created = append(m.Created, *event)
+	return nil
+}
+
+func (m *MockStoreApprovalEventRepository) FindByStoreID(ctx context.Context, storeID string) ([]entity.StoreApprovalEvent, error) {
+	m.FindCalledID = storeID
+	if m.FindErr != nil {
+		return nil, m.FindErr
+	}
+	return m.Events, nil
+}
+
+// MockUserModerationEventRepository implements output.UserModerationEventRepository for testing.
+type MockUserModerationEventRepository struct {
+	// Return values
+	Events    []entity.UserModerationEvent
+	CreateErr error
+	FindErr   error
+
+	// Call tracking
+	Created      []entity.UserModerationEvent
+	FindCalledID string
+}
+
+func (m *MockUserModerationEventRepository) CreateInTx(ctx context.Context, tx interface{}, event *entity.UserModerationEvent) error {
+	if m.CreateErr != nil {
+		return m.CreateErr
+	}
+	event.EventID = int64(len(m.Created) + 1)
+	m.Created = append(m.Created, *event)
+	return nil
+}
+
+func (m *MockUserModerationEventRepository) FindByUserID(ctx context.Context, userID string) ([]entity.UserModerationEvent, error) {
+	m.FindCalledID = userID
+	if m.FindErr != nil {
+		return nil, m.FindErr
+	}
+	return m.Events, nil
+}
+
+// MockAuditLogRepository implements output.AuditLogRepository for testing.
+type MockAuditLogRepository struct {
+	// Return values
+	ListResult         *output.AuditLogPage
+	CreateErr          error
+	ListErr            error
+	CreatePartitionErr error
+
+	// Call tracking
+	Created           []entity.AuditLog
+	ListCalledWith    output.AuditLogQuery
+	PartitionsCreated []time.Time
+}
+
+func (m *MockAuditLogRepository) Create(ctx context.Context, log *entity.AuditLog) error {
+	if m.CreateErr != nil {
+		return m.CreateErr
+	}
+	log.AuditID = int64(len(m.Created) + 1)
+	m.Created = append(m.Created, *log)
+	return nil
+}
+
+func (m *MockAuditLogRepository) List(ctx context.Context, query output.AuditLogQuery) (*output.AuditLogPage, error) {
+	m.ListCalledWith = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	if m.ListResult != nil {
+		return m.ListResult, nil
+	}
+	return &output.AuditLogPage{}, nil
+}
+
+func (m *MockAuditLogRepository) CreatePartition(ctx context.Context, month time.Time) error {
+	if m.CreatePartitionErr != nil {
+		return m.CreatePartitionErr
+	}
+	m.PartitionsCreated = append(m.PartitionsCreated, month)
+	return nil
+}
+
+// MockStoreEditRepository implements output.StoreEditRepository for testing.
+type MockStoreEditRepository struct {
+	// Return values
+	Edits      []entity.StoreEdit
+	Edit       *entity.StoreEdit
+	Pending    *entity.StoreEdit
+	ListErr    error
+	FindErr    error
+	CreateErr  error
+	UpdateErr  error
+	ReviewErr  error
+	PendingErr error
+
+	// Call tracking
+	ListStatus *string
+	Created    *entity.StoreEdit
+	Updated    *entity.StoreEdit
+	Reviewed   *entity.StoreEdit
+}
+
+func (m *MockStoreEditRepository) List(ctx context.Context, status *string) ([]entity.StoreEdit, error) {
+	m.ListStatus = status
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	return m.Edits, nil
+}
+
+func (m *MockStoreEditRepository) FindByID(ctx context.Context, editID string) (*entity.StoreEdit, error) {
+	if m.FindErr != nil {
+		return nil, m.FindErr
+	}
+	if m.Edit == nil {
+		return nil, apperr.New(apperr.CodeNotFound, errors.New("store edit not found"))
+	}
+	return m.Edit, nil
+}
+
+// FindPendingByStoreID returns Pending, or a NotFound error when it is nil.
+func (m *MockStoreEditRepository) FindPendingByStoreID(ctx context.Context, storeID string) (*entity.StoreEdit, error) {
+	if m.PendingErr != nil {
+		return nil, m.PendingErr
+	}
+	if m.Pending == nil {
+		return nil, apperr.New(apperr.CodeNotFound, errors.New("store edit not found"))
+	}
+	return m.Pending, nil
+}
+
+func (m *MockStoreEditRepository) CreateInTx(ctx context.Context, tx interface{}, edit *entity.StoreEdit) error {
+	if m.CreateErr != nil {
+		return m.CreateErr
+	}
+	edit.EditID = "edit-1"
+	m.Created = edit
+	return nil
+}
+
+func (m *MockStoreEditRepository) UpdateChangesInTx(ctx context.Context, tx interface{}, edit *entity.StoreEdit) error {
+	m.Updated = edit
+	return m.UpdateErr
+}
+
+func (m *MockStoreEditRepository) UpdateReviewInTx(ctx context.Context, tx interface{}, edit *entity.StoreEdit) error {
+	m.Reviewed = edit
+	return m.ReviewErr
+}
+
+// MockStationRepository implements output.StationRepository for testing.
+type MockStationRepository struct {
+	// Return values
+	Stations       []entity.Station
+	Station        *entity.Station
+	FindAllErr     error
+	FindByIDErr    error
+	SearchErr      error
+	FindNearestErr error
+
+	// Call tracking
+	FindAllCalled         bool
+	FindByIDCalled        bool
+	FindByIDCalledWith    int64
+	SearchCalled          bool
+	SearchCalledWith      output.StationSearchQuery
+	FindNearestCalled     bool
+	FindNearestCalledWith struct {
+		Lat   float64
+		Lng   float64
+		Limit int
+	}
+}
+
+func (m *MockStationRepository) FindAll(ctx context.Context) ([]entity.Station, error) {
+	m.FindAllCalled = true
+	if m.FindAllErr != nil {
+		return nil, m.FindAllErr
+	}
+	return m.Stations, nil
+}
+
+func (m *MockStationRepository) FindByID(ctx context.Context, id int64) (*entity.Station, error) {
+	m.FindByIDCalled = true
+	m.FindByIDCalledWith = id
+	if m.FindByIDErr != nil {
+		return nil, m.FindByIDErr
+	}
+	return m.Station, nil
+}
+
+func (m *MockStationRepository) Search(ctx context.Context, query output.StationSearchQuery) ([]entity.Station, error) {
+	m.SearchCalled = true
+	m.SearchCalledWith = query
+	if m.SearchErr != nil {
+		return nil, m.SearchErr
+	}
+	return m.Stations, nil
+}
+
+func (m *MockStationRepository) FindNearest(ctx context.Context, lat, lng float64, limit int) ([]entity.Station, error) {
+	m.FindNearestCalled = true
+	m.FindNearestCalledWith.Lat = lat
+	m.FindNearestCalledWith.Lng = lng
+	m.FindNearestCalledWith.Limit = limit
+	if m.FindNearestErr != nil {
+		return nil, m.FindNearestErr
+	}
+	return m.Stations, nil
 }
 
 // MockAuthProvider implements output.AuthProvider for testing.
@@ -567,6 +1758,8 @@ func (m *MockAuthProvider) Login(ctx context.Context, in output.AuthLoginInput)
 // MockTransaction implements output.Transaction for testing.
 type MockTransaction struct {
 	StartErr error
+	// CommitErr は fn が成功したあとのコミットの失敗を再現する
+	CommitErr error
 
 	// Call tracking
 	StartTransactionCalled bool
@@ -577,7 +1770,10 @@ func (m *MockTransaction) StartTransaction(fn func(interface{}) error) error {
 	if m.StartErr != nil {
 		return m.StartErr
 	}
-	return fn(nil)
+	if err := fn(nil); err != nil {
+		return err
+	}
+	return m.CommitErr
 }
 
 // ============================================================================
@@ -589,7 +1785,10 @@ type MockStoreUseCase struct {
 	// Return values
 	Stores       []entity.Store
 	Store        *entity.Store
+	NextCursor   string
 	GetAllErr    error
+	ListErr      error
+	NearbyErr    error
 	GetByIDErr   error
 	CreateErr    error
 	UpdateErr    error
@@ -597,13 +1796,20 @@ type MockStoreUseCase struct {
 	CreatedStore *entity.Store
 
 	// Call tracking
-	GetAllStoresCalled     bool
-	GetStoreByIDCalled     bool
-	GetStoreByIDCalledWith string
-	CreateStoreCalled      bool
-	CreateStoreCalledWith  input.CreateStoreInput
-	UpdateStoreCalled      bool
-	UpdateStoreCalledWith  struct {
+	GetAllStoresCalled        bool
+	ListStoresCalled          bool
+	ListStoresCalledWith      input.ListStoresQuery
+	NearbyCalled              bool
+	NearbyCalledWith          input.NearbyStoresQuery
+	GetStoreByIDCalled        bool
+	GetStoreByIDCalledWith    string
+	ListOwnedStoresCalledWith string
+	CreateStoreCalled         bool
+	CreateStoreCalledWith     input.CreateStoreInput
+	CreateStoreActor          entity.User
+	UpdateStoreCalled         bool
+	UpdateStoreCalledWith     struct {
+		Actor entity.User
 		ID    string
 		Input input.UpdateStoreInput
 	}
@@ -611,7 +1817,7 @@ type MockStoreUseCase struct {
 	DeleteStoreCalledWith string
 }
 
-func (m *MockStoreUseCase) GetAllStores(ctx context.Context) ([]entity.Store, error) {
+func (m *MockStoreUseCase) GetAllStores(ctx context.Context, viewer entity.User) ([]entity.Store, error) {
 	m.GetAllStoresCalled = true
 	if m.GetAllErr != nil {
 		return nil, m.GetAllErr
@@ -619,7 +1825,25 @@ func (m *MockStoreUseCase) GetAllStores(ctx context.Context) ([]entity.Store, er
 	return m.Stores, nil
 }
 
-func (m *MockStoreUseCase) GetStoreByID(ctx context.Context, id string) (*entity.Store, error) {
+func (m *MockStoreUseCase) ListStores(ctx context.Context, viewer entity.User, query input.ListStoresQuery) (*input.StorePage, error) {
+	m.ListStoresCalled = true
+	m.ListStoresCalledWith = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	return &input.StorePage{Stores: m.Stores, NextCursor: m.NextCursor}, nil
+}
+
+func (m *MockStoreUseCase) FindNearbyStores(ctx context.Context, viewer entity.User, query input.NearbyStoresQuery) ([]entity.Store, error) {
+	m.NearbyCalled = true
+	m.NearbyCalledWith = query
+	if m.NearbyErr != nil {
+		return nil, m.NearbyErr
+	}
+	return m.Stores, nil
+}
+
+func (m *MockStoreUseCase) GetStoreByID(ctx context.Context, viewer entity.User, id string) (*entity.Store, error) {
 	m.GetStoreByIDCalled = true
 	m.GetStoreByIDCalledWith = id
 	if m.GetByIDErr != nil {
@@ -628,8 +1852,17 @@ func (m *MockStoreUseCase) GetStoreByID(ctx context.Context, id string) (*entity
 	return m.Store, nil
 }
 
-func (m *MockStoreUseCase) CreateStore(ctx context.Context, in input.CreateStoreInput) (*entity.Store, error) {
+func (m *MockStoreUseCase) ListOwnedStores(ctx context.Context, userID string) ([]entity.Store, error) {
+	m.ListOwnedStoresCalledWith = userID
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	return m.Stores, nil
+}
+
+func (m *MockStoreUseCase) CreateStore(ctx context.Context, actor entity.User, in input.CreateStoreInput) (*entity.Store, error) {
 	m.CreateStoreCalled = true
+	m.CreateStoreActor = actor
 	m.CreateStoreCalledWith = in
 	if m.CreateErr != nil {
 		return nil, m.CreateErr
@@ -644,8 +1877,9 @@ func (m *MockStoreUseCase) CreateStore(ctx context.Context, in input.CreateStore
 	}, nil
 }
 
-func (m *MockStoreUseCase) UpdateStore(ctx context.Context, id string, in input.UpdateStoreInput) (*entity.Store, error) {
+func (m *MockStoreUseCase) UpdateStore(ctx context.Context, actor entity.User, id string, in input.UpdateStoreInput) (*entity.Store, error) {
 	m.UpdateStoreCalled = true
+	m.UpdateStoreCalledWith.Actor = actor
 	m.UpdateStoreCalledWith.ID = id
 	m.UpdateStoreCalledWith.Input = in
 	if m.UpdateErr != nil {
@@ -654,7 +1888,7 @@ func (m *MockStoreUseCase) UpdateStore(ctx context.Context, id string, in input.
 	return m.Store, nil
 }
 
-func (m *MockStoreUseCase) DeleteStore(ctx context.Context, id string) error {
+func (m *MockStoreUseCase) DeleteStore(ctx context.Context, actor entity.User, id string) error {
 	m.DeleteStoreCalled = true
 	m.DeleteStoreCalledWith = id
 	return m.DeleteErr
@@ -677,6 +1911,8 @@ type MockUserUseCase struct {
 	UpdateUserRoleErr    error
 	GetUserReviewsResult []entity.Review
 	GetUserReviewsErr    error
+	PublicProfileResult  *input.PublicUserProfile
+	PublicProfileErr     error
 
 	// Call tracking - indicates if each method was called
 	FindByIDCalled       bool
@@ -695,6 +1931,7 @@ type MockUserUseCase struct {
 	}
 	GetUserReviewsCalled     bool
 	GetUserReviewsCalledWith string
+	PublicProfileCalledWith  string
 }
 
 // Reset clears all call tracking state for reuse between test cases
@@ -748,7 +1985,7 @@ func (m *MockUserUseCase) UpdateUserRole(ctx context.Context, userID string, rol
 	return m.UpdateUserRoleErr
 }
 
-func (m *MockUserUseCase) GetUserReviews(ctx context.Context, userID string) ([]entity.Review, error) {
+func (m *MockUserUseCase) GetUserReviews(ctx context.Context, viewer entity.User, userID string) ([]entity.Review, error) {
 	m.GetUserReviewsCalled = true
 	m.GetUserReviewsCalledWith = userID
 	if m.GetUserReviewsErr != nil {
@@ -757,6 +1994,17 @@ func (m *MockUserUseCase) GetUserReviews(ctx context.Context, userID string) ([]
 	return m.GetUserReviewsResult, nil
 }
 
+func (m *MockUserUseCase) GetPublicProfile(ctx context.Context, userID string) (*input.PublicUserProfile, error) {
+	m.PublicProfileCalledWith = userID
+	if m.PublicProfileErr != nil {
+		return nil, m.PublicProfileErr
+	}
+	if m.PublicProfileResult != nil {
+		return m.PublicProfileResult, nil
+	}
+	return &input.PublicUserProfile{User: entity.User{UserID: userID}}, nil
+}
+
 // MockTokenVerifier implements security.TokenVerifier for testing
 type MockTokenVerifier struct {
 	Claims *security.TokenClaims
@@ -811,18 +2059,21 @@ func (m *MockAuthUseCase) Login(ctx context.Context, in input.AuthLoginInput) (*
 type MockReportUseCase struct {
 	CreateResult    *entity.Report
 	CreateErr       error
-	GetAllResult    []entity.Report
-	GetAllErr       error
+	ListResult      *input.ReportPage
+	ListErr         error
+	HandleResult    *entity.Report
 	HandleReportErr error
 
 	// Call tracking
 	CreateCalled     bool
 	CreateCalledWith input.CreateReportInput
-	GetAllCalled     bool
+	ListCalled       bool
+	ListCalledWith   input.ListReportsQuery
 	HandleCalled     bool
 	HandleCalledWith struct {
+		Admin    entity.User
 		ReportID int64
-		Action   input.HandleReportAction
+		Input    input.HandleReportInput
 	}
 }
 
@@ -835,34 +2086,43 @@ func (m *MockReportUseCase) CreateReport(ctx context.Context, in input.CreateRep
 	return m.CreateResult, nil
 }
 
-func (m *MockReportUseCase) GetAllReports(ctx context.Context) ([]entity.Report, error) {
-	m.GetAllCalled = true
-	if m.GetAllErr != nil {
-		return nil, m.GetAllErr
+func (m *MockReportUseCase) ListReports(ctx context.Context, query input.ListReportsQuery) (*input.ReportPage, error) {
+	m.ListCalled = true
+	m.ListCalledWith = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	if m.ListResult != nil {
+		return m.ListResult, nil
 	}
-	return m.GetAllResult, nil
+	return &input.ReportPage{}, nil
 }
 
-func (m *MockReportUseCase) HandleReport(ctx context.Context, reportID int64, action input.HandleReportAction) error {
+func (m *MockReportUseCase) HandleReport(ctx context.Context, admin entity.User, reportID int64, in input.HandleReportInput) (*entity.Report, error) {
 	m.HandleCalled = true
+	m.HandleCalledWith.Admin = admin
 	m.HandleCalledWith.ReportID = reportID
-	m.HandleCalledWith.Action = action
-	return m.HandleReportErr
+	m.HandleCalledWith.Input = in
+	if m.HandleReportErr != nil {
+		return nil, m.HandleReportErr
+	}
+	if m.HandleResult != nil {
+		return m.HandleResult, nil
+	}
+	return &entity.Report{ReportID: reportID}, nil
 }
 
 // MockAdminUseCase implements input.AdminUseCase for testing
 type MockAdminUseCase struct {
 	GetPendingResult []entity.Store
 	GetPendingErr    error
-	ApproveErr       error
-	RejectErr        error
+	VisibilityErr    error
+	Store            *entity.Store
+	Review           *entity.Review
 
 	// Call tracking
-	GetPendingCalled  bool
-	ApproveCalled     bool
-	ApproveCalledWith string
-	RejectCalled      bool
-	RejectCalledWith  string
+	GetPendingCalled bool
+	VisibilityWith   struct{ ID, Visibility string }
 }
 
 func (m *MockAdminUseCase) GetPendingStores(ctx context.Context) ([]entity.Store, error) {
@@ -873,16 +2133,142 @@ func (m *MockAdminUseCase) GetPendingStores(ctx context.Context) ([]entity.Store
 	return m.GetPendingResult, nil
 }
 
-func (m *MockAdminUseCase) ApproveStore(ctx context.Context, storeID string) error {
-	m.ApproveCalled = true
-	m.ApproveCalledWith = storeID
-	return m.ApproveErr
+func (m *MockAdminUseCase) SetStoreVisibility(ctx context.Context, admin entity.User, storeID string, visibility string) (*entity.Store, error) {
+	m.VisibilityWith.ID = storeID
+	m.VisibilityWith.Visibility = visibility
+	if m.VisibilityErr != nil {
+		return nil, m.VisibilityErr
+	}
+	if m.Store != nil {
+		return m.Store, nil
+	}
+	return &entity.Store{StoreID: storeID, Visibility: visibility}, nil
+}
+
+func (m *MockAdminUseCase) SetReviewVisibility(ctx context.Context, admin entity.User, reviewID string, visibility string) (*entity.Review, error) {
+	m.VisibilityWith.ID = reviewID
+	m.VisibilityWith.Visibility = visibility
+	if m.VisibilityErr != nil {
+		return nil, m.VisibilityErr
+	}
+	if m.Review != nil {
+		return m.Review, nil
+	}
+	return &entity.Review{ReviewID: reviewID, Visibility: visibility}, nil
+}
+
+// MockAdminUserUseCase implements input.AdminUserUseCase for testing
+type MockAdminUserUseCase struct {
+	Page   *input.UserPage
+	User   *entity.User
+	Events []entity.UserModerationEvent
+	Err    error
+
+	// Call tracking
+	ListCalledWith input.ListUsersQuery
+	CalledWith     struct {
+		Action string
+		Admin  entity.User
+		UserID string
+	}
+	SuspendInput      input.SuspendUserInput
+	UnsuspendInput    input.UnsuspendUserInput
+	RoleInput         input.ChangeUserRoleInput
+	HistoryCalledWith string
+}
+
+func (m *MockAdminUserUseCase) ListUsers(ctx context.Context, query input.ListUsersQuery) (*input.UserPage, error) {
+	m.ListCalledWith = query
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	if m.Page != nil {
+		return m.Page, nil
+	}
+	return &input.UserPage{}, nil
+}
+
+func (m *MockAdminUserUseCase) SuspendUser(ctx context.Context, admin entity.User, userID string, in input.SuspendUserInput) (*entity.User, error) {
+	m.SuspendInput = in
+	return m.moderate("suspend", admin, userID)
+}
+
+func (m *MockAdminUserUseCase) UnsuspendUser(ctx context.Context, admin entity.User, userID string, in input.UnsuspendUserInput) (*entity.User, error) {
+	m.UnsuspendInput = in
+	return m.moderate("unsuspend", admin, userID)
+}
+
+func (m *MockAdminUserUseCase) ChangeUserRole(ctx context.Context, admin entity.User, userID string, in input.ChangeUserRoleInput) (*entity.User, error) {
+	m.RoleInput = in
+	return m.moderate("change_role", admin, userID)
+}
+
+func (m *MockAdminUserUseCase) GetModerationHistory(ctx context.Context, userID string) ([]entity.UserModerationEvent, error) {
+	m.HistoryCalledWith = userID
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	return m.Events, nil
+}
+
+func (m *MockAdminUserUseCase) moderate(action string, admin entity.User, userID string) (*entity.User, error) {
+	m.CalledWith.Action = action
+	m.CalledWith.Admin = admin
+	m.CalledWith.UserID = userID
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	if m.User != nil {
+		return m.User, nil
+	}
+	return &entity.User{UserID: userID}, nil
+}
+
+// MockAuditLogUseCase implements input.AuditLogUseCase for testing
+type MockAuditLogUseCase struct {
+	Page *input.AuditLogPage
+	Err  error
+
+	// Call tracking
+	CalledWith input.ListAuditLogsQuery
 }
 
-func (m *MockAdminUseCase) RejectStore(ctx context.Context, storeID string) error {
-	m.RejectCalled = true
-	m.RejectCalledWith = storeID
-	return m.RejectErr
+func (m *MockAuditLogUseCase) ListAuditLogs(ctx context.Context, query input.ListAuditLogsQuery) (*input.AuditLogPage, error) {
+	m.CalledWith = query
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	if m.Page != nil {
+		return m.Page, nil
+	}
+	return &input.AuditLogPage{}, nil
+}
+
+// MockAccountUseCase implements input.AccountUseCase for testing
+type MockAccountUseCase struct {
+	Export    *input.AccountExport
+	DeleteErr error
+	ExportErr error
+
+	// Call tracking
+	DeleteCalledWith entity.User
+	ExportCalledWith entity.User
+}
+
+func (m *MockAccountUseCase) DeleteAccount(ctx context.Context, user entity.User) error {
+	m.DeleteCalledWith = user
+	return m.DeleteErr
+}
+
+func (m *MockAccountUseCase) ExportAccount(ctx context.Context, user entity.User) (*input.AccountExport, error) {
+	m.ExportCalledWith = user
+	if m.ExportErr != nil {
+		return nil, m.ExportErr
+	}
+	if m.Export != nil {
+		return m.Export, nil
+	}
+	return &input.AccountExport{User: user}, nil
 }
 
 // MockReviewUseCase implements input.ReviewUseCase for testing
@@ -890,8 +2276,12 @@ type MockReviewUseCase struct {
 	GetByStoreIDResult []entity.Review
 	GetByStoreIDErr    error
 	CreateErr          error
+	UpdateErr          error
+	DeleteErr          error
 	LikeErr            error
 	UnlikeErr          error
+	RatingSummary      *entity.RatingSummary
+	RatingSummaryErr   error
 
 	// Call tracking
 	GetByStoreIDCalled     bool
@@ -906,17 +2296,28 @@ type MockReviewUseCase struct {
 		UserID  string
 		Input   input.CreateReview
 	}
-	LikeCalled       bool
-	LikeCalledWith   struct{ ReviewID, UserID string }
-	UnlikeCalled     bool
-	UnlikeCalledWith struct{ ReviewID, UserID string }
+	UpdateCalledWith struct {
+		Actor    entity.User
+		ReviewID string
+		Input    input.UpdateReview
+	}
+	DeleteCalledWith struct {
+		Actor    entity.User
+		ReviewID string
+	}
+	LikeCalled              bool
+	LikeCalledWith          struct{ ReviewID, UserID string }
+	UnlikeCalled            bool
+	UnlikeCalledWith        struct{ ReviewID, UserID string }
+	RatingSummaryCalledWith string
+	RatingSummaryViewer     entity.User
 }
 
-func (m *MockReviewUseCase) GetReviewsByStoreID(ctx context.Context, storeID string, sort string, viewerID string) ([]entity.Review, error) {
+func (m *MockReviewUseCase) GetReviewsByStoreID(ctx context.Context, storeID string, sort string, viewer entity.User) ([]entity.Review, error) {
 	m.GetByStoreIDCalled = true
 	m.GetByStoreIDCalledWith.StoreID = storeID
 	m.GetByStoreIDCalledWith.Sort = sort
-	m.GetByStoreIDCalledWith.ViewerID = viewerID
+	m.GetByStoreIDCalledWith.ViewerID = viewer.UserID
 	if m.GetByStoreIDErr != nil {
 		return nil, m.GetByStoreIDErr
 	}
@@ -931,6 +2332,28 @@ func (m *MockReviewUseCase) Create(ctx context.Context, storeID string, userID s
 	return m.CreateErr
 }
 
+func (m *MockReviewUseCase) Update(ctx context.Context, actor entity.User, reviewID string, in input.UpdateReview) error {
+	m.UpdateCalledWith.Actor = actor
+	m.UpdateCalledWith.ReviewID = reviewID
+	m.UpdateCalledWith.Input = in
+	return m.UpdateErr
+}
+
+func (m *MockReviewUseCase) Delete(ctx context.Context, actor entity.User, reviewID string) error {
+	m.DeleteCalledWith.Actor = actor
+	m.DeleteCalledWith.ReviewID = reviewID
+	return m.DeleteErr
+}
+
+func (m *MockReviewUseCase) GetRatingSummary(ctx context.Context, storeID string, viewer entity.User) (*entity.RatingSummary, error) {
+	m.RatingSummaryCalledWith = storeID
+	m.RatingSummaryViewer = viewer
+	if m.RatingSummaryErr != nil {
+		return nil, m.RatingSummaryErr
+	}
+	return m.RatingSummary, nil
+}
+
 func (m *MockReviewUseCase) LikeReview(ctx context.Context, reviewID string, userID string) error {
 	m.LikeCalled = true
 	m.LikeCalledWith.ReviewID = reviewID
@@ -988,24 +2411,179 @@ func (m *MockFavoriteUseCase) RemoveFavorite(ctx context.Context, userID string,
 	return m.RemoveErr
 }
 
+// MockFollowUseCase implements input.FollowUseCase for testing
+type MockFollowUseCase struct {
+	FollowErr   error
+	UnfollowErr error
+	ListResult  *input.FollowPage
+	ListErr     error
+	FeedResult  *input.ReviewPage
+	FeedErr     error
+
+	// Call tracking
+	FollowCalledWith   struct{ FollowerID, FolloweeID string }
+	UnfollowCalledWith struct{ FollowerID, FolloweeID string }
+	ListCalledWith     struct {
+		UserID string
+		Query  input.ListFollowsQuery
+	}
+	FeedCalledWith struct {
+		UserID string
+		Query  input.FeedQuery
+	}
+}
+
+func (m *MockFollowUseCase) Follow(ctx context.Context, follower entity.User, followeeID string) error {
+	m.FollowCalledWith.FollowerID = follower.UserID
+	m.FollowCalledWith.FolloweeID = followeeID
+	return m.FollowErr
+}
+
+func (m *MockFollowUseCase) Unfollow(ctx context.Context, follower entity.User, followeeID string) error {
+	m.UnfollowCalledWith.FollowerID = follower.UserID
+	m.UnfollowCalledWith.FolloweeID = followeeID
+	return m.UnfollowErr
+}
+
+func (m *MockFollowUseCase) ListFollowers(ctx context.Context, userID string, query input.ListFollowsQuery) (*input.FollowPage, error) {
+	return m.list(userID, query)
+}
+
+func (m *MockFollowUseCase) ListFollowing(ctx context.Context, userID string, query input.ListFollowsQuery) (*input.FollowPage, error) {
+	return m.list(userID, query)
+}
+
+func (m *MockFollowUseCase) list(userID string, query input.ListFollowsQuery) (*input.FollowPage, error) {
+	m.ListCalledWith.UserID = userID
+	m.ListCalledWith.Query = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	if m.ListResult != nil {
+		return m.ListResult, nil
+	}
+	return &input.FollowPage{}, nil
+}
+
+func (m *MockFollowUseCase) GetFeed(ctx context.Context, user entity.User, query input.FeedQuery) (*input.ReviewPage, error) {
+	m.FeedCalledWith.UserID = user.UserID
+	m.FeedCalledWith.Query = query
+	if m.FeedErr != nil {
+		return nil, m.FeedErr
+	}
+	if m.FeedResult != nil {
+		return m.FeedResult, nil
+	}
+	return &input.ReviewPage{}, nil
+}
+
+// MockVisitUseCase implements input.VisitUseCase for testing
+type MockVisitUseCase struct {
+	MarkResult   *entity.Visit
+	MarkErr      error
+	DeleteErr    error
+	ListResult   *input.VisitPage
+	ListErr      error
+	CountsResult *entity.VisitCounts
+	CountsErr    error
+
+	// Call tracking
+	MarkCalledWith struct {
+		UserID  string
+		StoreID string
+		Input   input.MarkVisitInput
+	}
+	DeleteCalledWith struct {
+		UserID  string
+		VisitID int64
+	}
+	ListCalledWith struct {
+		UserID string
+		Query  input.ListVisitsQuery
+	}
+}
+
+func (m *MockVisitUseCase) MarkVisited(ctx context.Context, user entity.User, storeID string, in input.MarkVisitInput) (*entity.Visit, error) {
+	m.MarkCalledWith.UserID = user.UserID
+	m.MarkCalledWith.StoreID = storeID
+	m.MarkCalledWith.Input = in
+	if m.MarkErr != nil {
+		return nil, m.MarkErr
+	}
+	if m.MarkResult != nil {
+		return m.MarkResult, nil
+	}
+	return &entity.Visit{UserID: user.UserID, StoreID: storeID}, nil
+}
+
+func (m *MockVisitUseCase) DeleteVisit(ctx context.Context, user entity.User, visitID int64) error {
+	m.DeleteCalledWith.UserID = user.UserID
+	m.DeleteCalledWith.VisitID = visitID
+	return m.DeleteErr
+}
+
+func (m *MockVisitUseCase) ListMyVisits(ctx context.Context, user entity.User, query input.ListVisitsQuery) (*input.VisitPage, error) {
+	m.ListCalledWith.UserID = user.UserID
+	m.ListCalledWith.Query = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	if m.ListResult != nil {
+		return m.ListResult, nil
+	}
+	return &input.VisitPage{}, nil
+}
+
+func (m *MockVisitUseCase) GetMyVisitCounts(ctx context.Context, user entity.User) (*entity.VisitCounts, error) {
+	if m.CountsErr != nil {
+		return nil, m.CountsErr
+	}
+	if m.CountsResult != nil {
+		return m.CountsResult, nil
+	}
+	return &entity.VisitCounts{}, nil
+}
+
 // MockMenuUseCase implements input.MenuUseCase for testing
 type MockMenuUseCase struct {
 	GetByStoreIDResult []entity.Menu
 	GetByStoreIDErr    error
 	CreateResult       *entity.Menu
 	CreateErr          error
+	UpdateResult       *entity.Menu
+	UpdateErr          error
+	DeleteErr          error
+	ReorderResult      []entity.Menu
+	ReorderErr         error
 
 	// Call tracking
 	GetByStoreIDCalled     bool
 	GetByStoreIDCalledWith string
 	CreateCalled           bool
 	CreateCalledWith       struct {
+		Actor   entity.User
 		StoreID string
 		Input   input.CreateMenuInput
 	}
+	UpdateCalledWith struct {
+		Actor   entity.User
+		StoreID string
+		MenuID  string
+		Input   input.UpdateMenuInput
+	}
+	DeleteCalledWith struct {
+		Actor   entity.User
+		StoreID string
+		MenuID  string
+	}
+	ReorderCalledWith struct {
+		Actor   entity.User
+		StoreID string
+		MenuIDs []string
+	}
 }
 
-func (m *MockMenuUseCase) GetMenusByStoreID(ctx context.Context, storeID string) ([]entity.Menu, error) {
+func (m *MockMenuUseCase) GetMenusByStoreID(ctx context.Context, viewer entity.User, storeID string) ([]entity.Menu, error) {
 	m.GetByStoreIDCalled = true
 	m.GetByStoreIDCalledWith = storeID
 	if m.GetByStoreIDErr != nil {
@@ -1014,8 +2592,9 @@ func (m *MockMenuUseCase) GetMenusByStoreID(ctx context.Context, storeID string)
 	return m.GetByStoreIDResult, nil
 }
 
-func (m *MockMenuUseCase) CreateMenu(ctx context.Context, storeID string, in input.CreateMenuInput) (*entity.Menu, error) {
+func (m *MockMenuUseCase) CreateMenu(ctx context.Context, actor entity.User, storeID string, in input.CreateMenuInput) (*entity.Menu, error) {
 	m.CreateCalled = true
+	m.CreateCalledWith.Actor = actor
 	m.CreateCalledWith.StoreID = storeID
 	m.CreateCalledWith.Input = in
 	if m.CreateErr != nil {
@@ -1024,6 +2603,209 @@ func (m *MockMenuUseCase) CreateMenu(ctx context.Context, storeID string, in inp
 	return m.CreateResult, nil
 }
 
+func (m *MockMenuUseCase) UpdateMenu(
+	ctx context.Context,
+	actor entity.User,
+	storeID string,
+	menuID string,
+	in input.UpdateMenuInput,
+) (*entity.Menu, error) {
+	m.UpdateCalledWith.Actor = actor
+	m.UpdateCalledWith.StoreID = storeID
+	m.UpdateCalledWith.MenuID = menuID
+	m.UpdateCalledWith.Input = in
+	if m.UpdateErr != nil {
+		return nil, m.UpdateErr
+	}
+	return m.UpdateResult, nil
+}
+
+func (m *MockMenuUseCase) DeleteMenu(ctx context.Context, actor entity.User, storeID string, menuID string) error {
+	m.DeleteCalledWith.Actor = actor
+	m.DeleteCalledWith.StoreID = storeID
+	m.DeleteCalledWith.MenuID = menuID
+	return m.DeleteErr
+}
+
+func (m *MockMenuUseCase) ReorderMenus(ctx context.Context, actor entity.User, storeID string, menuIDs []string) ([]entity.Menu, error) {
+	m.ReorderCalledWith.Actor = actor
+	m.ReorderCalledWith.StoreID = storeID
+	m.ReorderCalledWith.MenuIDs = menuIDs
+	if m.ReorderErr != nil {
+		return nil, m.ReorderErr
+	}
+	return m.ReorderResult, nil
+}
+
+// MockStorePhotoUseCase implements input.StorePhotoUseCase for testing
+type MockStorePhotoUseCase struct {
+	Photos []entity.StorePhoto
+	Photo  *entity.StorePhoto
+	Store  *entity.Store
+	Err    error
+
+	// Call tracking
+	CalledWith struct {
+		Actor   entity.User
+		StoreID string
+		FileID  string
+		FileIDs []string
+		Input   input.UpdateStorePhotoInput
+	}
+}
+
+func (m *MockStorePhotoUseCase) ListStorePhotos(ctx context.Context, viewer entity.User, storeID string) ([]entity.StorePhoto, error) {
+	m.CalledWith.Actor = viewer
+	m.CalledWith.StoreID = storeID
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	return m.Photos, nil
+}
+
+func (m *MockStorePhotoUseCase) UpdateStorePhoto(
+	ctx context.Context,
+	actor entity.User,
+	storeID string,
+	fileID string,
+	in input.UpdateStorePhotoInput,
+) (*entity.StorePhoto, error) {
+	m.CalledWith.Actor = actor
+	m.CalledWith.StoreID = storeID
+	m.CalledWith.FileID = fileID
+	m.CalledWith.Input = in
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	return m.Photo, nil
+}
+
+func (m *MockStorePhotoUseCase) ReorderStorePhotos(ctx context.Context, actor entity.User, storeID string, fileIDs []string) ([]entity.StorePhoto, error) {
+	m.CalledWith.Actor = actor
+	m.CalledWith.StoreID = storeID
+	m.CalledWith.FileIDs = fileIDs
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	return m.Photos, nil
+}
+
+func (m *MockStorePhotoUseCase) SetStoreCover(ctx context.Context, actor entity.User, storeID string, fileID string) (*entity.Store, error) {
+	m.CalledWith.Actor = actor
+	m.CalledWith.StoreID = storeID
+	m.CalledWith.FileID = fileID
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	return m.Store, nil
+}
+
+func (m *MockStorePhotoUseCase) DeleteStorePhoto(ctx context.Context, actor entity.User, storeID string, fileID string) error {
+	m.CalledWith.Actor = actor
+	m.CalledWith.StoreID = storeID
+	m.CalledWith.FileID = fileID
+	return m.Err
+}
+
+// MockTagUseCase implements input.TagUseCase for testing.
+type MockTagUseCase struct {
+	Counts        []entity.TagCount
+	Page          *input.StorePage
+	ListTagsErr   error
+	ListStoresErr error
+
+	// Call tracking
+	ListStoresByTagCalledWith struct {
+		Tag   string
+		Query input.ListStoresQuery
+	}
+}
+
+func (m *MockTagUseCase) ListTags(ctx context.Context) ([]entity.TagCount, error) {
+	if m.ListTagsErr != nil {
+		return nil, m.ListTagsErr
+	}
+	return m.Counts, nil
+}
+
+func (m *MockTagUseCase) ListStoresByTag(ctx context.Context, tag string, query input.ListStoresQuery) (*input.StorePage, error) {
+	m.ListStoresByTagCalledWith.Tag = tag
+	m.ListStoresByTagCalledWith.Query = query
+	if m.ListStoresErr != nil {
+		return nil, m.ListStoresErr
+	}
+	if m.Page != nil {
+		return m.Page, nil
+	}
+	return &input.StorePage{}, nil
+}
+
+// MockSearchUseCase implements input.SearchUseCase for testing.
+type MockSearchUseCase struct {
+	Page      *input.SearchPage
+	SearchErr error
+
+	// Call tracking
+	SearchCalledWith input.SearchQuery
+}
+
+func (m *MockSearchUseCase) Search(ctx context.Context, query input.SearchQuery) (*input.SearchPage, error) {
+	m.SearchCalledWith = query
+	if m.SearchErr != nil {
+		return nil, m.SearchErr
+	}
+	if m.Page != nil {
+		return m.Page, nil
+	}
+	return &input.SearchPage{}, nil
+}
+
+// MockStationUseCase implements input.StationUseCase for testing.
+type MockStationUseCase struct {
+	Stations   []entity.Station
+	Groups     []entity.StationGroup
+	ListErr    error
+	GroupErr   error
+	NearestErr error
+
+	// Call tracking
+	ListStationsCalledWith        input.StationQuery
+	GroupStationsCalledWith       input.StationQuery
+	FindNearestStationsCalled     bool
+	FindNearestStationsCalledWith struct {
+		Lat   float64
+		Lng   float64
+		Limit int
+	}
+}
+
+func (m *MockStationUseCase) ListStations(ctx context.Context, query input.StationQuery) ([]entity.Station, error) {
+	m.ListStationsCalledWith = query
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	return m.Stations, nil
+}
+
+func (m *MockStationUseCase) GroupStationsByKind(ctx context.Context, query input.StationQuery) ([]entity.StationGroup, error) {
+	m.GroupStationsCalledWith = query
+	if m.GroupErr != nil {
+		return nil, m.GroupErr
+	}
+	return m.Groups, nil
+}
+
+func (m *MockStationUseCase) FindNearestStations(ctx context.Context, lat, lng float64, limit int) ([]entity.Station, error) {
+	m.FindNearestStationsCalled = true
+	m.FindNearestStationsCalledWith.Lat = lat
+	m.FindNearestStationsCalledWith.Lng = lng
+	m.FindNearestStationsCalledWith.Limit = limit
+	if m.NearestErr != nil {
+		return nil, m.NearestErr
+	}
+	return m.Stations, nil
+}
+
 // MockMediaUseCase implements input.MediaUseCase for testing
 type MockMediaUseCase struct {
 	CreateResult []input.SignedUploadFile
@@ -1036,6 +2818,24 @@ type MockMediaUseCase struct {
 		UserID  string
 		Files   []input.UploadFileInput
 	}
+	CreateClaimUploadsCalled     bool
+	CreateClaimUploadsCalledWith struct {
+		StoreID string
+		UserID  string
+		Files   []input.UploadFileInput
+	}
+	CreateStorePhotoUploadsCalledWith struct {
+		Actor   entity.User
+		StoreID string
+		Files   []input.UploadFileInput
+	}
+
+	CompleteResult     *entity.File
+	CompleteErr        error
+	CompleteCalledWith struct {
+		Actor  entity.User
+		FileID string
+	}
 }
 
 func (m *MockMediaUseCase) CreateReviewUploads(ctx context.Context, storeID string, userID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error) {
@@ -1049,6 +2849,157 @@ func (m *MockMediaUseCase) CreateReviewUploads(ctx context.Context, storeID stri
 	return m.CreateResult, nil
 }
 
+func (m *MockMediaUseCase) CreateClaimUploads(ctx context.Context, storeID string, userID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error) {
+	m.CreateClaimUploadsCalled = true
+	m.CreateClaimUploadsCalledWith.StoreID = storeID
+	m.CreateClaimUploadsCalledWith.UserID = userID
+	m.CreateClaimUploadsCalledWith.Files = files
+	if m.CreateErr != nil {
+		return nil, m.CreateErr
+	}
+	return m.CreateResult, nil
+}
+
+func (m *MockMediaUseCase) CreateStorePhotoUploads(ctx context.Context, actor entity.User, storeID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error) {
+	m.CreateStorePhotoUploadsCalledWith.Actor = actor
+	m.CreateStorePhotoUploadsCalledWith.StoreID = storeID
+	m.CreateStorePhotoUploadsCalledWith.Files = files
+	if m.CreateErr != nil {
+		return nil, m.CreateErr
+	}
+	return m.CreateResult, nil
+}
+
+func (m *MockMediaUseCase) CompleteUpload(ctx context.Context, actor entity.User, fileID string) (*entity.File, error) {
+	m.CompleteCalledWith.Actor = actor
+	m.CompleteCalledWith.FileID = fileID
+	if m.CompleteErr != nil {
+		return nil, m.CompleteErr
+	}
+	return m.CompleteResult, nil
+}
+
+// MockStoreClaimUseCase implements input.StoreClaimUseCase for testing.
+type MockStoreClaimUseCase struct {
+	// Return values
+	Claims    []entity.StoreClaim
+	Claim     *entity.StoreClaim
+	SubmitErr error
+	ListErr   error
+	GetErr    error
+	ReviewErr error
+
+	// Call tracking
+	SubmitCalledWith struct {
+		Actor   entity.User
+		StoreID string
+		Input   input.SubmitStoreClaimInput
+	}
+	ListCalledWith   string
+	ReviewCalledWith struct {
+		Action   string
+		Reviewer entity.User
+		ClaimID  string
+		Input    input.ReviewStoreClaimInput
+	}
+}
+
+func (m *MockStoreClaimUseCase) SubmitClaim(ctx context.Context, actor entity.User, storeID string, in input.SubmitStoreClaimInput) (*entity.StoreClaim, error) {
+	m.SubmitCalledWith.Actor = actor
+	m.SubmitCalledWith.StoreID = storeID
+	m.SubmitCalledWith.Input = in
+	if m.SubmitErr != nil {
+		return nil, m.SubmitErr
+	}
+	return m.Claim, nil
+}
+
+func (m *MockStoreClaimUseCase) ListClaims(ctx context.Context, status string) ([]entity.StoreClaim, error) {
+	m.ListCalledWith = status
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	return m.Claims, nil
+}
+
+func (m *MockStoreClaimUseCase) GetClaim(ctx context.Context, claimID string) (*entity.StoreClaim, error) {
+	if m.GetErr != nil {
+		return nil, m.GetErr
+	}
+	return m.Claim, nil
+}
+
+func (m *MockStoreClaimUseCase) ApproveClaim(ctx context.Context, reviewer entity.User, claimID string, in input.ReviewStoreClaimInput) (*entity.StoreClaim, error) {
+	return m.review("approve", reviewer, claimID, in)
+}
+
+func (m *MockStoreClaimUseCase) DenyClaim(ctx context.Context, reviewer entity.User, claimID string, in input.ReviewStoreClaimInput) (*entity.StoreClaim, error) {
+	return m.review("deny", reviewer, claimID, in)
+}
+
+func (m *MockStoreClaimUseCase) review(action string, reviewer entity.User, claimID string, in input.ReviewStoreClaimInput) (*entity.StoreClaim, error) {
+	m.ReviewCalledWith.Action = action
+	m.ReviewCalledWith.Reviewer = reviewer
+	m.ReviewCalledWith.ClaimID = claimID
+	m.ReviewCalledWith.Input = in
+	if m.ReviewErr != nil {
+		return nil, m.ReviewErr
+	}
+	return m.Claim, nil
+}
+
+// MockStoreApprovalUseCase implements input.StoreApprovalUseCase for testing.
+type MockStoreApprovalUseCase struct {
+	// Return values
+	Store      *entity.Store
+	Events     []entity.StoreApprovalEvent
+	Err        error
+	HistoryErr error
+
+	// Call tracking
+	CalledWith struct {
+		Action  string
+		Actor   entity.User
+		StoreID string
+		Input   input.StoreApprovalInput
+	}
+	HistoryCalledWith string
+}
+
+func (m *MockStoreApprovalUseCase) SubmitStore(ctx context.Context, actor entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	return m.transition("submit", actor, storeID, in)
+}
+
+func (m *MockStoreApprovalUseCase) GetApprovalHistory(ctx context.Context, actor entity.User, storeID string) ([]entity.StoreApprovalEvent, error) {
+	m.HistoryCalledWith = storeID
+	if m.HistoryErr != nil {
+		return nil, m.HistoryErr
+	}
+	return m.Events, nil
+}
+
+func (m *MockStoreApprovalUseCase) ApproveStore(ctx context.Context, admin entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	return m.transition("approve", admin, storeID, in)
+}
+
+func (m *MockStoreApprovalUseCase) RejectStore(ctx context.Context, admin entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	return m.transition("reject", admin, storeID, in)
+}
+
+func (m *MockStoreApprovalUseCase) transition(action string, actor entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	m.CalledWith.Action = action
+	m.CalledWith.Actor = actor
+	m.CalledWith.StoreID = storeID
+	m.CalledWith.Input = in
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	if m.Store != nil {
+		return m.Store, nil
+	}
+	return &entity.Store{StoreID: storeID}, nil
+}
+
 // MockStorageProvider implements output.StorageProvider for testing.
 // It provides configurable return values with sensible defaults when not configured.
 type MockStorageProvider struct {
@@ -1067,6 +3018,20 @@ type MockStorageProvider struct {
 	ReturnEmptyURL bool
 	// RequestedKeys tracks which keys were requested (for verification in tests)
 	RequestedKeys []string
+
+	// ObjectsByKey maps object keys to the objects StatObject finds. Other keys are not found.
+	ObjectsByKey map[string]output.ObjectInfo
+	StatErr      error
+	DeleteErr    error
+	// DeletedKeys tracks which keys were passed to DeleteObjects
+	DeletedKeys []string
+
+	// ObjectContents maps object keys to the contents GetObject returns. Other keys are not found.
+	ObjectContents map[string][]byte
+	GetErr         error
+	PutErr         error
+	// PutObjects tracks the contents passed to PutObject by key
+	PutObjects map[string][]byte
 }
 
 // CreateSignedUpload returns a configured result or a sensible default.
@@ -1137,3 +3102,106 @@ func (m *MockStorageProvider) CreateSignedDownload(ctx context.Context, bucket,
 		ExpiresIn: expiresIn,
 	}, nil
 }
+
+// StatObject returns the object configured in ObjectsByKey, or output.ErrObjectNotFound.
+func (m *MockStorageProvider) StatObject(ctx context.Context, bucket, objectPath string) (*output.ObjectInfo, error) {
+	if m.StatErr != nil {
+		return nil, m.StatErr
+	}
+	info, ok := m.ObjectsByKey[objectPath]
+	if !ok {
+		return nil, output.ErrObjectNotFound
+	}
+	return &info, nil
+}
+
+// DeleteObjects records the deleted keys.
+func (m *MockStorageProvider) DeleteObjects(ctx context.Context, bucket string, objectPaths []string) error {
+	if m.DeleteErr != nil {
+		return m.DeleteErr
+	}
+	m.DeletedKeys = append(m.DeletedKeys, objectPaths...)
+	return nil
+}
+
+// GetObject returns the configured contents of the object.
+func (m *MockStorageProvider) GetObject(ctx context.Context, bucket, objectPath string) (io.ReadCloser, error) {
+	if m.GetErr != nil {
+		return nil, m.GetErr
+	}
+	content, ok := m.ObjectContents[objectPath]
+	if !ok {
+		return nil, output.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+// PutObject records the stored contents.
+func (m *MockStorageProvider) PutObject(ctx context.Context, bucket, objectPath, contentType string, content []byte) error {
+	if m.PutErr != nil {
+		return m.PutErr
+	}
+	if m.PutObjects == nil {
+		m.PutObjects = make(map[string][]byte)
+	}
+	m.PutObjects[objectPath] = content
+	return nil
+}
+
+// MockStoreEditUseCase implements input.StoreEditUseCase for testing.
+type MockStoreEditUseCase struct {
+	// Return values
+	Edits []entity.StoreEdit
+	Edit  *entity.StoreEdit
+	Err   error
+
+	// Call tracking
+	ListStatus string
+	CalledWith struct {
+		Action   string
+		Reviewer entity.User
+		EditID   string
+		Input    input.ReviewStoreEditInput
+	}
+}
+
+func (m *MockStoreEditUseCase) ListStoreEdits(ctx context.Context, status string) ([]entity.StoreEdit, error) {
+	m.ListStatus = status
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	return m.Edits, nil
+}
+
+func (m *MockStoreEditUseCase) GetStoreEdit(ctx context.Context, editID string) (*entity.StoreEdit, error) {
+	m.CalledWith.EditID = editID
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	if m.Edit != nil {
+		return m.Edit, nil
+	}
+	return &entity.StoreEdit{EditID: editID}, nil
+}
+
+func (m *MockStoreEditUseCase) ApproveStoreEdit(ctx context.Context, reviewer entity.User, editID string, in input.ReviewStoreEditInput) (*entity.StoreEdit, error) {
+	return m.review("approve", reviewer, editID, in)
+}
+
+func (m *MockStoreEditUseCase) RejectStoreEdit(ctx context.Context, reviewer entity.User, editID string, in input.ReviewStoreEditInput) (*entity.StoreEdit, error) {
+	return m.review("reject", reviewer, editID, in)
+}
+
+func (m *MockStoreEditUseCase) review(action string, reviewer entity.User, editID string, in input.ReviewStoreEditInput) (*entity.StoreEdit, error) {
+	m.CalledWith.Action = action
+	m.CalledWith.Reviewer = reviewer
+	m.CalledWith.EditID = editID
+	m.CalledWith.Input = in
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	if m.Edit != nil {
+		return m.Edit, nil
+	}
+	return &entity.StoreEdit{EditID: editID}, nil
+}
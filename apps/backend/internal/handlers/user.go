@@ -64,7 +64,7 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 }
 
 func (h *UserHandler) GetUserReviews(c echo.Context) error {
-	reviews, err := h.userUseCase.GetUserReviews(c.Request().Context(), c.Param("id"))
+	reviews, err := h.userUseCase.GetUserReviews(c.Request().Context(), getOptionalUser(c), c.Param("id"))
 	if err != nil {
 		return err
 	}
@@ -73,6 +73,23 @@ func (h *UserHandler) GetUserReviews(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+// GetPublicProfile は誰でも閲覧できるユーザーのプロフィールを返します
+func (h *UserHandler) GetPublicProfile(c echo.Context) error {
+	ctx := c.Request().Context()
+	profile, err := h.userUseCase.GetPublicProfile(ctx, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	resp := presenter.NewPublicUserProfileResponse(*profile)
+	if profile.IconFile != nil {
+		if url := signedFileURL(ctx, h.storage, h.bucket, *profile.IconFile); url != nil {
+			resp.IconURL = url
+		}
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
 type updateUserDTO struct {
 	Name       *string    `json:"name"`
 	Phone      *string    `json:"phone"`
@@ -9,6 +9,7 @@ import (
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 )
 
 // --- GetMe Tests ---
@@ -207,3 +208,74 @@ func TestUserHandler_GetUserReviews_UseCaseError(t *testing.T) {
 
 	testutil.AssertError(t, err, "usecase error")
 }
+
+// --- GetPublicProfile Tests ---
+
+func TestUserHandler_GetPublicProfile_OmitsPrivateFields(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/users/user-1")
+	tc.SetPath("/users/:id", []string{"id"}, []string{"user-1"})
+
+	phone := "090-1234-5678"
+	gender := "female"
+	iconFileID := "file-1"
+	mockUC := &testutil.MockUserUseCase{PublicProfileResult: &input.PublicUserProfile{
+		User: entity.User{
+			UserID:     "user-1",
+			Name:       "Hanako",
+			Email:      "hanako@example.com",
+			Phone:      &phone,
+			Gender:     &gender,
+			IconFileID: &iconFileID,
+		},
+		IconFile: &entity.File{FileID: iconFileID, ObjectKey: "icons/file-1.png"},
+		Stats: entity.ReviewerStats{
+			ReviewCount:   4,
+			LikesReceived: 10,
+			StoresVisited: 3,
+			TopCategories: []entity.CategoryCount{{Category: "ラーメン", ReviewCount: 2}},
+		},
+	}}
+	storage := &testutil.MockStorageProvider{SignedURLsByKey: map[string]string{
+		"icons/file-1.png": "https://example.com/signed/icon.png",
+	}}
+	h := handlers.NewUserHandler(mockUC, storage, "test-bucket")
+
+	err := h.GetPublicProfile(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	if mockUC.PublicProfileCalledWith != "user-1" {
+		t.Errorf("expected user-1, got %q", mockUC.PublicProfileCalledWith)
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	for _, key := range []string{"email", "phone", "gender", "birthday", "role", "icon_file_id"} {
+		if _, ok := response[key]; ok {
+			t.Errorf("expected %s to be omitted from public profile", key)
+		}
+	}
+	if response["icon_url"] != "https://example.com/signed/icon.png" {
+		t.Errorf("expected signed icon url, got %v", response["icon_url"])
+	}
+	if response["review_count"] != float64(4) || response["likes_received"] != float64(10) || response["stores_visited"] != float64(3) {
+		t.Errorf("unexpected stats: %v", response)
+	}
+	categories, ok := response["top_categories"].([]interface{})
+	if !ok || len(categories) != 1 {
+		t.Errorf("unexpected top categories: %v", response["top_categories"])
+	}
+}
+
+func TestUserHandler_GetPublicProfile_NotFound(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/users/missing")
+	tc.SetPath("/users/:id", []string{"id"}, []string{"missing"})
+
+	mockUC := &testutil.MockUserUseCase{PublicProfileErr: usecase.ErrUserNotFound}
+	h := handlers.NewUserHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket")
+
+	err := h.GetPublicProfile(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrUserNotFound, "expected user not found error")
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation"
+	"github.com/TeamH04/team-production/apps/backend/internal/presentation/presenter"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type VisitHandler struct {
+	visitUseCase input.VisitUseCase
+	storage      output.StorageProvider
+	bucket       string
+}
+
+func NewVisitHandler(visitUseCase input.VisitUseCase, storage output.StorageProvider, bucket string) *VisitHandler {
+	return &VisitHandler{
+		visitUseCase: visitUseCase,
+		storage:      storage,
+		bucket:       bucket,
+	}
+}
+
+type markVisitDTO struct {
+	// VisitedOn は YYYY-MM-DD 形式の訪問日。省略時は今日
+	VisitedOn *string `json:"visited_on"`
+	Note      *string `json:"note"`
+}
+
+func (dto markVisitDTO) toInput() (input.MarkVisitInput, error) {
+	in := input.MarkVisitInput{Note: dto.Note}
+	if dto.VisitedOn != nil && strings.TrimSpace(*dto.VisitedOn) != "" {
+		visitedOn, err := time.Parse(time.DateOnly, strings.TrimSpace(*dto.VisitedOn))
+		if err != nil {
+			return input.MarkVisitInput{}, presentation.NewBadRequest("invalid visited_on")
+		}
+		in.VisitedOn = &visitedOn
+	}
+	return in, nil
+}
+
+// MarkVisited はログイン中のユーザーが店舗に行ったことを記録します
+func (h *VisitHandler) MarkVisited(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	storeID, err := parseUUIDParam(c, "id", ErrMsgInvalidStoreID)
+	if err != nil {
+		return err
+	}
+	var dto markVisitDTO
+	if err := bindJSON(c, &dto); err != nil {
+		return err
+	}
+	in, err := dto.toInput()
+	if err != nil {
+		return err
+	}
+
+	visit, err := h.visitUseCase.MarkVisited(c.Request().Context(), user, storeID, in)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, presenter.NewVisitResponse(*visit))
+}
+
+// DeleteVisit は自分の訪問記録を削除します
+func (h *VisitHandler) DeleteVisit(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	visitID, err := parseInt64Param(c, "visit_id", ErrMsgInvalidVisitID)
+	if err != nil {
+		return err
+	}
+
+	if err := h.visitUseCase.DeleteVisit(c.Request().Context(), user, visitID); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListMyVisits returns one page of the user's visits with the store, most recent visit first.
+// The cursor for the next page is sent in the X-Next-Cursor header.
+func (h *VisitHandler) ListMyVisits(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+	limit, err := parseIntQuery(c, "limit", "invalid limit")
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	page, err := h.visitUseCase.ListMyVisits(ctx, user, input.ListVisitsQuery{
+		StoreID: optionalStringQuery(c, "store_id"),
+		Limit:   limit,
+		Cursor:  c.QueryParam("cursor"),
+	})
+	if err != nil {
+		return err
+	}
+
+	resp := presenter.NewVisitResponses(page.Visits)
+	attachSignedURLsToVisitResponses(ctx, h.storage, h.bucket, resp)
+	if page.NextCursor != "" {
+		c.Response().Header().Set(infrahttp.HeaderNextCursor, page.NextCursor)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetMyVisitCounts は自分の訪問記録の件数と、行ったことのある店舗の数を返します
+func (h *VisitHandler) GetMyVisitCounts(c echo.Context) error {
+	user, err := getRequiredUser(c)
+	if err != nil {
+		return err
+	}
+
+	counts, err := h.visitUseCase.GetMyVisitCounts(c.Request().Context(), user)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, presenter.NewVisitCountsResponse(*counts))
+}
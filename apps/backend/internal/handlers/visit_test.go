@@ -0,0 +1,166 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+const visitTestStoreID = "11111111-1111-1111-1111-111111111111"
+
+func TestVisitHandler_MarkVisited_Success(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/api/stores/"+visitTestStoreID+"/visits",
+		`{"visited_on":"2026-03-01","note":"ランチ"}`).
+		SetPath("/api/stores/:id/visits", []string{"id"}, []string{visitTestStoreID}).
+		SetUser(entity.User{UserID: "user-1"}, "user")
+	note := "ランチ"
+	mockUC := &testutil.MockVisitUseCase{MarkResult: &entity.Visit{
+		VisitID:   3,
+		UserID:    "user-1",
+		StoreID:   visitTestStoreID,
+		VisitedOn: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		Note:      &note,
+	}}
+
+	err := handlers.NewVisitHandler(mockUC, nil, "").MarkVisited(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusCreated)
+	called := mockUC.MarkCalledWith
+	if called.UserID != "user-1" || called.StoreID != visitTestStoreID || called.Input.VisitedOn == nil ||
+		!called.Input.VisitedOn.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected mark call: %+v", called)
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if response["visited_on"] != "2026-03-01" || response["note"] != "ランチ" {
+		t.Errorf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+}
+
+func TestVisitHandler_MarkVisited_InvalidDate(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/api/stores/"+visitTestStoreID+"/visits",
+		`{"visited_on":"03/01/2026"}`).
+		SetPath("/api/stores/:id/visits", []string{"id"}, []string{visitTestStoreID}).
+		SetUser(entity.User{UserID: "user-1"}, "user")
+	mockUC := &testutil.MockVisitUseCase{}
+
+	err := handlers.NewVisitHandler(mockUC, nil, "").MarkVisited(tc.Context)
+
+	testutil.AssertError(t, err, "expected invalid visited_on error")
+	if mockUC.MarkCalledWith.UserID != "" {
+		t.Error("expected use case not to be called")
+	}
+}
+
+func TestVisitHandler_MarkVisited_Unauthorized(t *testing.T) {
+	tc := testutil.NewTestContextWithJSON(http.MethodPost, "/api/stores/"+visitTestStoreID+"/visits", `{}`).
+		SetPath("/api/stores/:id/visits", []string{"id"}, []string{visitTestStoreID})
+
+	err := handlers.NewVisitHandler(&testutil.MockVisitUseCase{}, nil, "").MarkVisited(tc.Context)
+
+	testutil.AssertError(t, err, "expected error without user")
+}
+
+func TestVisitHandler_DeleteVisit_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/api/users/me/visits/7").
+		SetPath("/api/users/me/visits/:visit_id", []string{"visit_id"}, []string{"7"}).
+		SetUser(entity.User{UserID: "user-1"}, "user")
+	mockUC := &testutil.MockVisitUseCase{}
+
+	err := handlers.NewVisitHandler(mockUC, nil, "").DeleteVisit(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusNoContent)
+	if mockUC.DeleteCalledWith.UserID != "user-1" || mockUC.DeleteCalledWith.VisitID != 7 {
+		t.Errorf("unexpected delete call: %+v", mockUC.DeleteCalledWith)
+	}
+}
+
+func TestVisitHandler_DeleteVisit_InvalidID(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/api/users/me/visits/abc").
+		SetPath("/api/users/me/visits/:visit_id", []string{"visit_id"}, []string{"abc"}).
+		SetUser(entity.User{UserID: "user-1"}, "user")
+
+	err := handlers.NewVisitHandler(&testutil.MockVisitUseCase{}, nil, "").DeleteVisit(tc.Context)
+
+	testutil.AssertError(t, err, "expected invalid visit id error")
+}
+
+func TestVisitHandler_DeleteVisit_NotFound(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodDelete, "/api/users/me/visits/7").
+		SetPath("/api/users/me/visits/:visit_id", []string{"visit_id"}, []string{"7"}).
+		SetUser(entity.User{UserID: "user-1"}, "user")
+	mockUC := &testutil.MockVisitUseCase{DeleteErr: usecase.ErrVisitNotFound}
+
+	err := handlers.NewVisitHandler(mockUC, nil, "").DeleteVisit(tc.Context)
+
+	testutil.AssertErrorIs(t, err, usecase.ErrVisitNotFound, "expected visit not found error")
+}
+
+func TestVisitHandler_ListMyVisits_SetsNextCursor(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/api/users/me/visits?limit=1&cursor=abc&store_id=store-1").
+		SetUser(entity.User{UserID: "user-1"}, "user")
+	store := testutil.NewTestStore(testutil.WithStoreID("store-1"))
+	mockUC := &testutil.MockVisitUseCase{ListResult: &input.VisitPage{
+		Visits: []entity.Visit{{
+			VisitID:   3,
+			UserID:    "user-1",
+			StoreID:   "store-1",
+			VisitedOn: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			Store:     &store,
+		}},
+		NextCursor: "next",
+	}}
+
+	err := handlers.NewVisitHandler(mockUC, &testutil.MockStorageProvider{}, "test-bucket").ListMyVisits(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	query := mockUC.ListCalledWith.Query
+	if mockUC.ListCalledWith.UserID != "user-1" || query.Limit != 1 || query.Cursor != "abc" ||
+		query.StoreID == nil || *query.StoreID != "store-1" {
+		t.Errorf("unexpected list call: %+v", mockUC.ListCalledWith)
+	}
+	if got := tc.Recorder.Header().Get(infrahttp.HeaderNextCursor); got != "next" {
+		t.Errorf("expected next cursor header, got %q", got)
+	}
+
+	var response []struct {
+		VisitID int64 `json:"visit_id"`
+		Store   *struct {
+			StoreID string `json:"store_id"`
+		} `json:"store"`
+	}
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if len(response) != 1 || response[0].VisitID != 3 || response[0].Store == nil || response[0].Store.StoreID != "store-1" {
+		t.Errorf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+}
+
+func TestVisitHandler_GetMyVisitCounts_Success(t *testing.T) {
+	tc := testutil.NewTestContextNoBody(http.MethodGet, "/api/users/me/visits/counts").
+		SetUser(entity.User{UserID: "user-1"}, "user")
+	mockUC := &testutil.MockVisitUseCase{CountsResult: &entity.VisitCounts{VisitCount: 5, StoreCount: 3}}
+
+	err := handlers.NewVisitHandler(mockUC, nil, "").GetMyVisitCounts(tc.Context)
+
+	testutil.AssertSuccess(t, err, tc.Recorder, http.StatusOK)
+	var response map[string]int
+	if err := json.Unmarshal(tc.Recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response body: %v", err)
+	}
+	if response["visit_count"] != 5 || response["store_count"] != 3 {
+		t.Errorf("unexpected response: %s", tc.Recorder.Body.String())
+	}
+}
@@ -10,6 +10,7 @@ const (
 	HeaderContentType   = "Content-Type"
 	HeaderAuthorization = "Authorization"
 	HeaderAPIKey        = "apikey" // Supabase specific header
+	HeaderNextCursor    = "X-Next-Cursor"
 )
 
 // MIME types
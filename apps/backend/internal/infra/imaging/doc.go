@@ -0,0 +1,2 @@
+// Package imaging は画像の向きの補正・メタデータの除去・縮小と形式の変換を、cgo を使わない Go だけで行います。
+package imaging
@@ -0,0 +1,129 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// EXIF の Orientation の値。1 がそのままの向きで、2〜8 は表示時に反転・回転が必要
+const (
+	orientationNormal = 1
+	orientationMax    = 8
+	tagOrientation    = 0x0112
+	exifHeader        = "Exif\x00\x00"
+)
+
+// exifOrientation は画像に埋め込まれた EXIF の Orientation を返します。EXIF がない・読めない場合は 1 を返します
+func exifOrientation(format string, content []byte) int {
+	var tiff []byte
+	switch format {
+	case "jpeg":
+		tiff = jpegEXIF(content)
+	case "png":
+		tiff = pngEXIF(content)
+	case "webp":
+		tiff = webpEXIF(content)
+	}
+	return tiffOrientation(tiff)
+}
+
+// jpegEXIF は JPEG の APP1 セグメントから EXIF（TIFF 形式）の部分を取り出します
+func jpegEXIF(content []byte) []byte {
+	const (
+		markerAPP1 = 0xE1
+		markerSOS  = 0xDA
+	)
+	for i := 2; i+4 <= len(content); {
+		if content[i] != 0xFF {
+			return nil
+		}
+		marker := content[i+1]
+		if marker == 0xFF {
+			// 詰め物の 0xFF は読み飛ばす
+			i++
+			continue
+		}
+		if marker == markerSOS {
+			return nil
+		}
+		length := int(binary.BigEndian.Uint16(content[i+2:]))
+		if length < 2 || i+2+length > len(content) {
+			return nil
+		}
+		payload := content[i+4 : i+2+length]
+		if marker == markerAPP1 && bytes.HasPrefix(payload, []byte(exifHeader)) {
+			return payload[len(exifHeader):]
+		}
+		i += 2 + length
+	}
+	return nil
+}
+
+// pngEXIF は PNG の eXIf チャンクを取り出します
+func pngEXIF(content []byte) []byte {
+	const signatureLength = 8
+	for i := signatureLength; i+8 <= len(content); {
+		length := int(binary.BigEndian.Uint32(content[i:]))
+		chunkType := string(content[i+4 : i+8])
+		if length < 0 || i+12+length > len(content) {
+			return nil
+		}
+		if chunkType == "eXIf" {
+			return content[i+8 : i+8+length]
+		}
+		if chunkType == "IEND" {
+			return nil
+		}
+		i += 12 + length
+	}
+	return nil
+}
+
+// webpEXIF は WebP の EXIF チャンクを取り出します
+func webpEXIF(content []byte) []byte {
+	for _, chunk := range webpChunks(content) {
+		if chunk.fourCC == "EXIF" {
+			return bytes.TrimPrefix(chunk.data, []byte(exifHeader))
+		}
+	}
+	return nil
+}
+
+// tiffOrientation は TIFF 形式の EXIF の IFD0 から Orientation を読み取ります
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return orientationNormal
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return orientationNormal
+	}
+	if order.Uint16(tiff[2:]) != 42 {
+		return orientationNormal
+	}
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return orientationNormal
+	}
+	entries := int(order.Uint16(tiff[ifd:]))
+	for n := 0; n < entries; n++ {
+		entry := ifd + 2 + n*12
+		if entry+12 > len(tiff) {
+			return orientationNormal
+		}
+		if order.Uint16(tiff[entry:]) != tagOrientation {
+			continue
+		}
+		value := int(order.Uint16(tiff[entry+8:]))
+		if value < orientationNormal || value > orientationMax {
+			return orientationNormal
+		}
+		return value
+	}
+	return orientationNormal
+}
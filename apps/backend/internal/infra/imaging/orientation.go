@@ -0,0 +1,54 @@
+package imaging
+
+import (
+	"image"
+
+	"golang.org/x/image/draw"
+)
+
+// applyOrientation は EXIF の Orientation（2〜8）に従って画像を反転・回転し、そのままの向きで表示できる画像を返します
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= orientationNormal || orientation > orientationMax {
+		return img
+	}
+	bounds := img.Bounds()
+	src := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+
+	w, h := bounds.Dx(), bounds.Dy()
+	dw, dh := w, h
+	// 5〜8 は90度回転を含むため縦横が入れ替わる
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for dy := 0; dy < dh; dy++ {
+		for dx := 0; dx < dw; dx++ {
+			sx, sy := sourcePoint(orientation, dx, dy, w, h)
+			copy(dst.Pix[dst.PixOffset(dx, dy):dst.PixOffset(dx, dy)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+	return dst
+}
+
+// sourcePoint は補正後の画像の (dx, dy) に対応する、幅 w・高さ h の元の画像の座標を返します
+func sourcePoint(orientation, dx, dy, w, h int) (int, int) {
+	switch orientation {
+	case 2: // 左右反転
+		return w - 1 - dx, dy
+	case 3: // 180度回転
+		return w - 1 - dx, h - 1 - dy
+	case 4: // 上下反転
+		return dx, h - 1 - dy
+	case 5: // 左上と右下を結ぶ線で反転
+		return dy, dx
+	case 6: // 時計回りに90度回転
+		return dy, h - 1 - dx
+	case 7: // 右上と左下を結ぶ線で反転
+		return w - 1 - dy, h - 1 - dx
+	case 8: // 反時計回りに90度回転
+		return w - 1 - dy, dx
+	default:
+		return dx, dy
+	}
+}
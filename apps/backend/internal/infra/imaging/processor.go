@@ -0,0 +1,173 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // GIF は1コマ目から派生ファイルを作る
+	"image/jpeg"
+	"image/png"
+	"slices"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // WebP のデコーダを登録する
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// Processor は output.ImageProcessor の実装です。
+// 派生ファイルは JPEG と WebP で書き出します。WebP は純粋な Go のエンコーダを使うため可逆圧縮になります
+type Processor struct{}
+
+// NewProcessor は Processor を生成します
+func NewProcessor() *Processor {
+	return &Processor{}
+}
+
+var _ output.ImageProcessor = (*Processor)(nil)
+
+// Process は画像を EXIF の Orientation に従って回転し、メタデータを除いた元の大きさの画像と、
+// widths の幅に縮小した JPEG・WebP の派生ファイルを返します。派生ファイルは幅の小さい順に並びます
+func (p *Processor) Process(content []byte, widths []int) (*output.ProcessedImage, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", output.ErrUnsupportedImage, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > constants.MaxImageProcessingPixels {
+		return nil, fmt.Errorf("%w: %dx%d pixels", output.ErrUnsupportedImage, config.Width, config.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", output.ErrUnsupportedImage, err)
+	}
+
+	orientation := exifOrientation(format, content)
+	img = applyOrientation(img, orientation)
+
+	original, err := encodeOriginal(format, content, img, orientation)
+	if err != nil {
+		return nil, err
+	}
+	variants, err := encodeVariants(img, widths)
+	if err != nil {
+		return nil, err
+	}
+	return &output.ProcessedImage{Original: original, Variants: variants}, nil
+}
+
+// encodeOriginal はメタデータを除いた元の大きさの画像を、アップロードされた形式で書き出します。
+// WebP は向きの補正が不要ならチャンクを取り除くだけにして、可逆圧縮で大きくなるのを避ける。GIF は EXIF を持たないため書き換えない
+func encodeOriginal(format string, content []byte, img image.Image, orientation int) (*output.EncodedImage, error) {
+	bounds := img.Bounds()
+	encoded := &output.EncodedImage{Width: bounds.Dx(), Height: bounds.Dy(), Format: format, ContentType: "image/" + format}
+	var buf bytes.Buffer
+	switch format {
+	case "jpeg":
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: constants.ImageOriginalJPEGQuality}); err != nil {
+			return nil, err
+		}
+	case "png":
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, err
+		}
+	case "webp":
+		if orientation == orientationNormal {
+			if stripped, ok := stripWebPMetadata(content); ok {
+				encoded.Content = stripped
+				return encoded, nil
+			}
+		}
+		if err := nativewebp.Encode(&buf, img, nil); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, nil
+	}
+	encoded.Content = buf.Bytes()
+	return encoded, nil
+}
+
+// encodeVariants は widths の幅に縮小した画像を JPEG と WebP で書き出します。
+// 元の画像より広い幅は元の幅にまとめ、大きい方から順に縮小して前の結果を次の縮小に使う
+func encodeVariants(img image.Image, widths []int) ([]output.EncodedImage, error) {
+	bounds := img.Bounds()
+	targets := make([]int, 0, len(widths))
+	for _, w := range widths {
+		if w <= 0 {
+			continue
+		}
+		targets = append(targets, min(w, bounds.Dx()))
+	}
+	slices.Sort(targets)
+	targets = slices.Compact(targets)
+
+	variants := make([]output.EncodedImage, 0, len(targets)*2)
+	source := img
+	for i := len(targets) - 1; i >= 0; i-- {
+		scaled := resize(source, targets[i])
+		source = scaled
+
+		jpegImage, err := encodeJPEG(scaled)
+		if err != nil {
+			return nil, err
+		}
+		webpImage, err := encodeWebP(scaled)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, webpImage, jpegImage)
+	}
+	slices.Reverse(variants)
+	return variants, nil
+}
+
+// resize は縦横比を保って幅 width に縮小します
+func resize(img image.Image, width int) *image.NRGBA {
+	bounds := img.Bounds()
+	height := max(1, (bounds.Dy()*width+bounds.Dx()/2)/bounds.Dx())
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	if width == bounds.Dx() && height == bounds.Dy() {
+		draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+		return dst
+	}
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+// encodeJPEG は透過部分を白で塗りつぶして JPEG で書き出します
+func encodeJPEG(img *image.NRGBA) (output.EncodedImage, error) {
+	bounds := img.Bounds()
+	flat := image.NewRGBA(bounds)
+	draw.Draw(flat, bounds, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, bounds, img, bounds.Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, flat, &jpeg.Options{Quality: constants.ImageJPEGQuality}); err != nil {
+		return output.EncodedImage{}, err
+	}
+	return output.EncodedImage{
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+		Format:      constants.ImageFormatJPEG,
+		ContentType: "image/jpeg",
+		Content:     buf.Bytes(),
+	}, nil
+}
+
+func encodeWebP(img *image.NRGBA) (output.EncodedImage, error) {
+	var buf bytes.Buffer
+	if err := nativewebp.Encode(&buf, img, nil); err != nil {
+		return output.EncodedImage{}, err
+	}
+	bounds := img.Bounds()
+	return output.EncodedImage{
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+		Format:      constants.ImageFormatWebP,
+		ContentType: "image/webp",
+		Content:     buf.Bytes(),
+	}, nil
+}
@@ -0,0 +1,169 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// exifWithOrientation は Orientation と GPS 情報（の代わりの目印）だけを持つ TIFF 形式の EXIF を返します
+func exifWithOrientation(orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, tagOrientation)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = binary.BigEndian.AppendUint16(tiff, 0)
+	tiff = binary.BigEndian.AppendUint32(tiff, 0)
+	return append(tiff, "GPS-35.6812,139.7671"...)
+}
+
+// quadrantImage は左上の 1/4 だけが赤い画像を返します
+func quadrantImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.NRGBA{R: 0, G: 0, B: 255, A: 255}
+			if x < w/2 && y < h/2 {
+				c = color.NRGBA{R: 255, G: 0, B: 0, A: 255}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func jpegWithEXIF(t *testing.T, img image.Image, orientation uint16) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}))
+	raw := buf.Bytes()
+
+	payload := append([]byte(exifHeader), exifWithOrientation(orientation)...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, raw[:2]...)
+	out = append(out, segment...)
+	return append(out, raw[2:]...)
+}
+
+func isRed(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return r>>8 > 200 && g>>8 < 80 && b>>8 < 80
+}
+
+func TestProcess_JPEG_RotatesAndStripsEXIF(t *testing.T) {
+	content := jpegWithEXIF(t, quadrantImage(80, 40), 6)
+	require.Equal(t, 6, exifOrientation("jpeg", content))
+
+	result, err := NewProcessor().Process(content, []int{constants.ImageVariantSmallWidth, constants.ImageVariantMediumWidth})
+	require.NoError(t, err)
+
+	require.NotNil(t, result.Original)
+	assert.Equal(t, 40, result.Original.Width)
+	assert.Equal(t, 80, result.Original.Height)
+	assert.Equal(t, "image/jpeg", result.Original.ContentType)
+	assert.NotContains(t, string(result.Original.Content), "Exif")
+	assert.NotContains(t, string(result.Original.Content), "GPS")
+
+	// 時計回りに90度回転すると、左上の赤い部分は右上に来る
+	rotated, err := jpeg.Decode(bytes.NewReader(result.Original.Content))
+	require.NoError(t, err)
+	assert.True(t, isRed(rotated.At(30, 10)), "expected top right to be red")
+	assert.False(t, isRed(rotated.At(10, 10)), "expected top left not to be red")
+
+	// 元の幅（40px）より広い幅は1つにまとめる
+	require.Len(t, result.Variants, 2)
+	formats := []string{result.Variants[0].Format, result.Variants[1].Format}
+	assert.ElementsMatch(t, []string{constants.ImageFormatJPEG, constants.ImageFormatWebP}, formats)
+	for _, v := range result.Variants {
+		assert.Equal(t, 40, v.Width)
+		assert.Equal(t, 80, v.Height)
+		decoded, format, err := image.Decode(bytes.NewReader(v.Content))
+		require.NoError(t, err)
+		assert.Equal(t, v.Format, format)
+		assert.Equal(t, 40, decoded.Bounds().Dx())
+	}
+}
+
+func TestProcess_ScalesVariantsSmallestFirst(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, quadrantImage(1000, 500)))
+
+	result, err := NewProcessor().Process(buf.Bytes(), []int{
+		constants.ImageVariantSmallWidth, constants.ImageVariantMediumWidth, constants.ImageVariantLargeWidth,
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, result.Original)
+	assert.Equal(t, "image/png", result.Original.ContentType)
+	// 元の幅（1000px）より広い 1200px は元の幅で作る
+	var widths, heights []int
+	for _, v := range result.Variants {
+		widths = append(widths, v.Width)
+		heights = append(heights, v.Height)
+	}
+	assert.Equal(t, []int{200, 200, 600, 600, 1000, 1000}, widths)
+	assert.Equal(t, []int{100, 100, 300, 300, 500, 500}, heights)
+}
+
+func TestProcess_WebP_StripsMetadataWithoutReencoding(t *testing.T) {
+	var encoded bytes.Buffer
+	require.NoError(t, nativewebp.Encode(&encoded, quadrantImage(20, 10), nil))
+	chunks := webpChunks(encoded.Bytes())
+	require.NotEmpty(t, chunks)
+
+	// VP8X で EXIF を持つことを示し、EXIF チャンクを付けた WebP を作る
+	vp8x := []byte{vp8xFlagEXIF, 0, 0, 0}
+	vp8x = append(vp8x, 19, 0, 0, 9, 0, 0)
+	var body bytes.Buffer
+	body.WriteString("WEBP")
+	for _, chunk := range append([]webpChunk{{fourCC: "VP8X", data: vp8x}}, append(chunks, webpChunk{fourCC: "EXIF", data: exifWithOrientation(1)})...) {
+		body.WriteString(chunk.fourCC)
+		body.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(chunk.data))))
+		body.Write(chunk.data)
+		if len(chunk.data)%2 == 1 {
+			body.WriteByte(0)
+		}
+	}
+	content := append([]byte("RIFF"), binary.LittleEndian.AppendUint32(nil, uint32(body.Len()))...)
+	content = append(content, body.Bytes()...)
+
+	result, err := NewProcessor().Process(content, []int{constants.ImageVariantSmallWidth})
+	require.NoError(t, err)
+
+	require.NotNil(t, result.Original)
+	assert.NotContains(t, string(result.Original.Content), "GPS")
+	stripped := webpChunks(result.Original.Content)
+	require.Len(t, stripped, len(chunks)+1)
+	assert.Equal(t, "VP8X", stripped[0].fourCC)
+	assert.Zero(t, stripped[0].data[0]&vp8xFlagEXIF)
+	_, _, err = image.Decode(bytes.NewReader(result.Original.Content))
+	require.NoError(t, err)
+}
+
+func TestProcess_UnsupportedContent(t *testing.T) {
+	_, err := NewProcessor().Process([]byte("%PDF-1.7"), []int{constants.ImageVariantSmallWidth})
+	require.ErrorIs(t, err, output.ErrUnsupportedImage)
+}
+
+func TestTiffOrientation_Invalid(t *testing.T) {
+	assert.Equal(t, orientationNormal, tiffOrientation(nil))
+	assert.Equal(t, orientationNormal, tiffOrientation([]byte("XX\x00\x2a\x00\x00\x00\x08")))
+	assert.Equal(t, orientationNormal, tiffOrientation(exifWithOrientation(9)))
+	assert.Equal(t, 8, tiffOrientation(exifWithOrientation(8)))
+}
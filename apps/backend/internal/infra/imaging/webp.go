@@ -0,0 +1,66 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// VP8X チャンクのフラグのうち、メタデータの有無を示すもの
+const (
+	vp8xFlagEXIF = 0x08
+	vp8xFlagXMP  = 0x04
+)
+
+type webpChunk struct {
+	fourCC string
+	data   []byte
+}
+
+// webpChunks は RIFF 形式の WebP をチャンクに分けます。形式が壊れている場合は読めたところまでを返します
+func webpChunks(content []byte) []webpChunk {
+	if len(content) < 12 || string(content[:4]) != "RIFF" || string(content[8:12]) != "WEBP" {
+		return nil
+	}
+	var chunks []webpChunk
+	for i := 12; i+8 <= len(content); {
+		size := int(binary.LittleEndian.Uint32(content[i+4:]))
+		if size < 0 || i+8+size > len(content) {
+			return chunks
+		}
+		chunks = append(chunks, webpChunk{fourCC: string(content[i : i+4]), data: content[i+8 : i+8+size]})
+		// チャンクは偶数バイトに揃えられている
+		i += 8 + size + size%2
+	}
+	return chunks
+}
+
+// stripWebPMetadata は WebP から EXIF と XMP のチャンクを取り除きます。画像のデータは再圧縮しません
+func stripWebPMetadata(content []byte) ([]byte, bool) {
+	chunks := webpChunks(content)
+	if len(chunks) == 0 {
+		return nil, false
+	}
+	var body bytes.Buffer
+	body.WriteString("WEBP")
+	for _, chunk := range chunks {
+		if chunk.fourCC == "EXIF" || chunk.fourCC == "XMP " {
+			continue
+		}
+		data := chunk.data
+		if chunk.fourCC == "VP8X" && len(data) > 0 {
+			data = append([]byte(nil), data...)
+			data[0] &^= vp8xFlagEXIF | vp8xFlagXMP
+		}
+		body.WriteString(chunk.fourCC)
+		body.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(data))))
+		body.Write(data)
+		if len(data)%2 == 1 {
+			body.WriteByte(0)
+		}
+	}
+
+	out := make([]byte, 0, 8+body.Len())
+	out = append(out, "RIFF"...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(body.Len()))
+	return append(out, body.Bytes()...), true
+}
@@ -0,0 +1,3 @@
+// Package localstorage はオブジェクトをローカルのファイルシステムに保存し、HMAC で署名した URL を通して
+// このサーバー自身がアップロードとダウンロードを受け付けるストレージを提供します。開発やテストで使います。
+package localstorage
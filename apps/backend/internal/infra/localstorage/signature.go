@@ -0,0 +1,90 @@
+package localstorage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// 署名付き URL のクエリパラメータ
+const (
+	paramExpires     = "expires"
+	paramContentType = "content_type"
+	paramUpsert      = "upsert"
+	paramSignature   = "signature"
+)
+
+// signedRequest は署名付き URL が許可する操作。アップロードでは Content-Type と上書きの可否も署名に含める
+type signedRequest struct {
+	method      string
+	bucket      string
+	objectPath  string
+	expiresAt   time.Time
+	contentType string
+	upsert      bool
+}
+
+// query は署名を含む URL のクエリを返します
+func (s *Storage) query(req signedRequest) url.Values {
+	q := url.Values{}
+	q.Set(paramExpires, strconv.FormatInt(req.expiresAt.Unix(), 10))
+	if req.method != methodGet {
+		q.Set(paramContentType, req.contentType)
+		q.Set(paramUpsert, strconv.FormatBool(req.upsert))
+	}
+	q.Set(paramSignature, s.sign(req))
+	return q
+}
+
+// verify は URL のクエリの署名と有効期限を確かめ、署名された操作を返します
+func (s *Storage) verify(method, bucket, objectPath string, q url.Values) (signedRequest, error) {
+	expires, err := strconv.ParseInt(q.Get(paramExpires), 10, 64)
+	if err != nil {
+		return signedRequest{}, output.ErrInvalidSignedURL
+	}
+	req := signedRequest{
+		method:     method,
+		bucket:     bucket,
+		objectPath: objectPath,
+		expiresAt:  time.Unix(expires, 0),
+	}
+	if method != methodGet {
+		req.contentType = q.Get(paramContentType)
+		if req.upsert, err = strconv.ParseBool(q.Get(paramUpsert)); err != nil {
+			return signedRequest{}, output.ErrInvalidSignedURL
+		}
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(q.Get(paramSignature))
+	if err != nil || !hmac.Equal(signature, s.mac(req)) {
+		return signedRequest{}, output.ErrInvalidSignedURL
+	}
+	if !s.now().Before(req.expiresAt) {
+		return signedRequest{}, output.ErrInvalidSignedURL
+	}
+	return req, nil
+}
+
+func (s *Storage) sign(req signedRequest) string {
+	return base64.RawURLEncoding.EncodeToString(s.mac(req))
+}
+
+func (s *Storage) mac(req signedRequest) []byte {
+	payload := strings.Join([]string{
+		req.method,
+		req.bucket,
+		req.objectPath,
+		strconv.FormatInt(req.expiresAt.Unix(), 10),
+		req.contentType,
+		strconv.FormatBool(req.upsert),
+	}, "\n")
+	h := hmac.New(sha256.New, s.signingKey)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
@@ -0,0 +1,325 @@
+package localstorage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+const (
+	// pathPrefix は署名付き URL のパス。router の StorageObjectPath（/storage/:bucket/*）で受け付ける
+	pathPrefix = "/storage"
+
+	methodGet = http.MethodGet
+	methodPut = http.MethodPut
+
+	// metadataDir はオブジェクトの Content-Type を保存するディレクトリ。"." で始まるバケット名は使えないため衝突しない
+	metadataDir = ".metadata"
+	// defaultContentType は Content-Type を指定せずに保存したオブジェクトの Content-Type
+	defaultContentType = "application/octet-stream"
+)
+
+var errNotConfigured = errors.New("local storage not configured")
+
+// Storage はオブジェクトを dir/<bucket>/<objectPath> に保存する StorageProvider
+type Storage struct {
+	dir        string
+	baseURL    string
+	signingKey []byte
+	now        func() time.Time
+}
+
+var (
+	_ output.StorageProvider   = (*Storage)(nil)
+	_ output.SignedObjectStore = (*Storage)(nil)
+)
+
+// NewStorage は dir にオブジェクトを保存する Storage を生成します。
+// 署名付き URL は baseURL（このサーバーの URL。例: http://localhost:8080）を起点に作ります
+func NewStorage(dir, baseURL string, signingKey []byte) *Storage {
+	return &Storage{
+		dir:        strings.TrimSpace(dir),
+		baseURL:    strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+		signingKey: signingKey,
+		now:        time.Now,
+	}
+}
+
+func (s *Storage) CreateSignedUpload(
+	ctx context.Context,
+	bucket, objectPath, contentType string,
+	expiresIn time.Duration,
+	upsert bool,
+) (*output.SignedUpload, error) {
+	req, err := s.newSignedRequest(methodPut, bucket, objectPath, expiresIn)
+	if err != nil {
+		return nil, err
+	}
+	req.contentType = normalizeContentType(contentType)
+	req.upsert = upsert
+
+	headers := map[string]string{}
+	if req.contentType != "" {
+		headers[infrahttp.HeaderContentType] = req.contentType
+	}
+	return &output.SignedUpload{
+		Bucket:      req.bucket,
+		Path:        req.objectPath,
+		URL:         s.signedURL(req),
+		Headers:     headers,
+		ExpiresIn:   expiresIn,
+		ContentType: req.contentType,
+		Upsert:      upsert,
+	}, nil
+}
+
+func (s *Storage) CreateSignedDownload(ctx context.Context, bucket, objectPath string, expiresIn time.Duration) (*output.SignedDownload, error) {
+	req, err := s.newSignedRequest(methodGet, bucket, objectPath, expiresIn)
+	if err != nil {
+		return nil, err
+	}
+	return &output.SignedDownload{
+		Bucket:    req.bucket,
+		Path:      req.objectPath,
+		URL:       s.signedURL(req),
+		ExpiresIn: expiresIn,
+	}, nil
+}
+
+func (s *Storage) StatObject(ctx context.Context, bucket, objectPath string) (*output.ObjectInfo, error) {
+	file, err := s.objectFile(bucket, objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return s.stat(bucket, objectPath, file)
+}
+
+func (s *Storage) DeleteObjects(ctx context.Context, bucket string, objectPaths []string) error {
+	for _, objectPath := range objectPaths {
+		file, err := s.objectFile(bucket, objectPath)
+		if err != nil {
+			return err
+		}
+		for _, path := range []string{file, s.metadataFile(bucket, objectPath)} {
+			if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Storage) GetObject(ctx context.Context, bucket, objectPath string) (io.ReadCloser, error) {
+	file, err := s.objectFile(bucket, objectPath)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, output.ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *Storage) PutObject(ctx context.Context, bucket, objectPath, contentType string, content []byte) error {
+	file, err := s.objectFile(bucket, objectPath)
+	if err != nil {
+		return err
+	}
+	return s.write(bucket, objectPath, file, normalizeContentType(contentType), bytes.NewReader(content), true)
+}
+
+// ReceiveSignedUpload は署名付きアップロード URL を確かめて内容を保存します。
+// 署名された Content-Type と異なる場合と、上書きを許可していない URL で既存のオブジェクトに送った場合は保存しません
+func (s *Storage) ReceiveSignedUpload(
+	ctx context.Context,
+	bucket, objectPath string,
+	query url.Values,
+	contentType string,
+	content io.Reader,
+) error {
+	file, err := s.objectFile(bucket, objectPath)
+	if err != nil {
+		return output.ErrInvalidSignedURL
+	}
+	req, err := s.verify(methodPut, bucket, objectPath, query)
+	if err != nil {
+		return err
+	}
+	contentType = normalizeContentType(contentType)
+	if req.contentType != "" && contentType != req.contentType {
+		return output.ErrContentTypeMismatch
+	}
+	return s.write(bucket, objectPath, file, contentType, content, req.upsert)
+}
+
+// OpenSignedDownload は署名付きダウンロード URL を確かめてオブジェクトを開きます
+func (s *Storage) OpenSignedDownload(ctx context.Context, bucket, objectPath string, query url.Values) (io.ReadCloser, *output.ObjectInfo, error) {
+	file, err := s.objectFile(bucket, objectPath)
+	if err != nil {
+		return nil, nil, output.ErrInvalidSignedURL
+	}
+	if _, err := s.verify(methodGet, bucket, objectPath, query); err != nil {
+		return nil, nil, err
+	}
+	info, err := s.stat(bucket, objectPath, file)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := s.GetObject(ctx, bucket, objectPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return body, info, nil
+}
+
+func (s *Storage) newSignedRequest(method, bucket, objectPath string, expiresIn time.Duration) (signedRequest, error) {
+	if s.dir == "" || s.baseURL == "" || len(s.signingKey) == 0 {
+		return signedRequest{}, errNotConfigured
+	}
+	objectPath = strings.TrimSpace(objectPath)
+	if _, err := s.objectFile(bucket, objectPath); err != nil {
+		return signedRequest{}, err
+	}
+	if expiresIn <= 0 {
+		return signedRequest{}, errors.New("expiresIn must be positive")
+	}
+	return signedRequest{
+		method:     method,
+		bucket:     bucket,
+		objectPath: objectPath,
+		// 秒未満を切り上げ、expiresIn より早く失効しないようにする
+		expiresAt: s.now().Add(expiresIn + time.Second - 1).Truncate(time.Second),
+	}, nil
+}
+
+func (s *Storage) signedURL(req signedRequest) string {
+	return fmt.Sprintf("%s%s/%s/%s?%s", s.baseURL, pathPrefix, url.PathEscape(req.bucket), escapePath(req.objectPath), s.query(req).Encode())
+}
+
+// objectFile はオブジェクトを保存するファイルのパスを返します。ディレクトリの外を指すパスは受け付けない
+func (s *Storage) objectFile(bucket, objectPath string) (string, error) {
+	if s.dir == "" {
+		return "", errNotConfigured
+	}
+	if !validSegment(bucket) || strings.HasPrefix(bucket, ".") || strings.Contains(bucket, "/") {
+		return "", fmt.Errorf("invalid bucket %q", bucket)
+	}
+	if objectPath == "" {
+		return "", errors.New("objectPath is required")
+	}
+	for _, segment := range strings.Split(objectPath, "/") {
+		if !validSegment(segment) {
+			return "", fmt.Errorf("invalid objectPath %q", objectPath)
+		}
+	}
+	return filepath.Join(s.dir, bucket, filepath.FromSlash(objectPath)), nil
+}
+
+func (s *Storage) metadataFile(bucket, objectPath string) string {
+	return filepath.Join(s.dir, metadataDir, bucket, filepath.FromSlash(objectPath))
+}
+
+func (s *Storage) stat(bucket, objectPath, file string) (*output.ObjectInfo, error) {
+	fi, err := os.Stat(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, output.ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if fi.IsDir() {
+		return nil, output.ErrObjectNotFound
+	}
+
+	contentType := defaultContentType
+	if raw, err := os.ReadFile(s.metadataFile(bucket, objectPath)); err == nil && len(raw) > 0 {
+		contentType = string(raw)
+	}
+	return &output.ObjectInfo{Size: fi.Size(), ContentType: contentType}, nil
+}
+
+// write は内容を一時ファイルに書き出してから置き換えるため、読み込み中のオブジェクトが途中の内容になることはない。
+// upsert でない場合はリンクの作成で既存のオブジェクトを検出する
+func (s *Storage) write(bucket, objectPath, file, contentType string, content io.Reader, upsert bool) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0o750); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(file), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		_ = tmp.Close() // 書き込みのエラーを返すため、Close のエラーは無視する
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if upsert {
+		err = os.Rename(tmp.Name(), file)
+	} else {
+		err = os.Link(tmp.Name(), file)
+	}
+	if errors.Is(err, fs.ErrExist) {
+		return output.ErrObjectExists
+	}
+	if err != nil {
+		return err
+	}
+
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+	metadata := s.metadataFile(bucket, objectPath)
+	if err := os.MkdirAll(filepath.Dir(metadata), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(metadata, []byte(contentType), 0o600)
+}
+
+// validSegment はパスの区切りの間の1要素として使えるかを返します
+func validSegment(segment string) bool {
+	if segment == "" || segment == "." || segment == ".." {
+		return false
+	}
+	return !strings.ContainsFunc(segment, func(r rune) bool {
+		return r == '\\' || r < 0x20 || r == 0x7f
+	})
+}
+
+// escapePath はパスの区切りを残して各要素をエスケープします
+func escapePath(objectPath string) string {
+	parts := strings.Split(objectPath, "/")
+	for i := range parts {
+		parts[i] = url.PathEscape(parts[i])
+	}
+	return strings.Join(parts, "/")
+}
+
+func normalizeContentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+	return mediaType
+}
@@ -0,0 +1,247 @@
+package localstorage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+const (
+	testBucket  = "test-bucket"
+	testBaseURL = "http://localhost:8080"
+)
+
+var testNow = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func newTestStorage(t *testing.T) *Storage {
+	t.Helper()
+	s := NewStorage(t.TempDir(), testBaseURL, []byte("test-signing-key"))
+	s.now = func() time.Time { return testNow }
+	return s
+}
+
+// parseSignedURL は署名付き URL をバケット・オブジェクトのパス・クエリに分けます（ハンドラーが受け取る形）
+func parseSignedURL(t *testing.T, rawURL string) (string, string, url.Values) {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	rest, ok := strings.CutPrefix(u.Path, pathPrefix+"/")
+	require.True(t, ok, "unexpected path %q", u.Path)
+	bucket, objectPath, ok := strings.Cut(rest, "/")
+	require.True(t, ok, "unexpected path %q", u.Path)
+	return bucket, objectPath, u.Query()
+}
+
+func upload(t *testing.T, s *Storage, objectPath, contentType string, upsert bool) (string, string, url.Values) {
+	t.Helper()
+	signed, err := s.CreateSignedUpload(context.Background(), testBucket, objectPath, contentType, time.Minute, upsert)
+	require.NoError(t, err)
+	return parseSignedURL(t, signed.URL)
+}
+
+func TestCreateSignedUpload(t *testing.T) {
+	s := newTestStorage(t)
+
+	signed, err := s.CreateSignedUpload(context.Background(), testBucket, "reviews/a b.png", "image/png", time.Minute, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, testBucket, signed.Bucket)
+	assert.Equal(t, "reviews/a b.png", signed.Path)
+	assert.True(t, strings.HasPrefix(signed.URL, testBaseURL+"/storage/test-bucket/reviews/a%20b.png?"), signed.URL)
+	assert.Equal(t, map[string]string{"Content-Type": "image/png"}, signed.Headers)
+	assert.Equal(t, "image/png", signed.ContentType)
+	assert.False(t, signed.Upsert)
+	assert.Equal(t, time.Minute, signed.ExpiresIn)
+
+	_, _, query := parseSignedURL(t, signed.URL)
+	assert.Equal(t, "1767323105", query.Get(paramExpires))
+}
+
+func TestSignedUploadAndDownload(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+
+	bucket, objectPath, query := upload(t, s, "reviews/photo.png", "image/png", false)
+	err := s.ReceiveSignedUpload(ctx, bucket, objectPath, query, "image/png; charset=binary", strings.NewReader("png-bytes"))
+	require.NoError(t, err)
+
+	info, err := s.StatObject(ctx, testBucket, "reviews/photo.png")
+	require.NoError(t, err)
+	assert.Equal(t, &output.ObjectInfo{Size: 9, ContentType: "image/png"}, info)
+
+	download, err := s.CreateSignedDownload(ctx, testBucket, "reviews/photo.png", time.Minute)
+	require.NoError(t, err)
+	bucket, objectPath, query = parseSignedURL(t, download.URL)
+
+	body, info, err := s.OpenSignedDownload(ctx, bucket, objectPath, query)
+	require.NoError(t, err)
+	defer body.Close()
+	content, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "png-bytes", string(content))
+	assert.Equal(t, "image/png", info.ContentType)
+}
+
+func TestReceiveSignedUpload_Rejects(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("expired", func(t *testing.T) {
+		s := newTestStorage(t)
+		bucket, objectPath, query := upload(t, s, "a.png", "image/png", false)
+		s.now = func() time.Time { return testNow.Add(time.Minute) }
+
+		err := s.ReceiveSignedUpload(ctx, bucket, objectPath, query, "image/png", strings.NewReader("x"))
+		assert.ErrorIs(t, err, output.ErrInvalidSignedURL)
+	})
+
+	t.Run("tampered signature", func(t *testing.T) {
+		s := newTestStorage(t)
+		bucket, objectPath, query := upload(t, s, "a.png", "image/png", false)
+		query.Set(paramUpsert, "true")
+
+		err := s.ReceiveSignedUpload(ctx, bucket, objectPath, query, "image/png", strings.NewReader("x"))
+		assert.ErrorIs(t, err, output.ErrInvalidSignedURL)
+	})
+
+	t.Run("other object", func(t *testing.T) {
+		s := newTestStorage(t)
+		bucket, _, query := upload(t, s, "a.png", "image/png", false)
+
+		err := s.ReceiveSignedUpload(ctx, bucket, "b.png", query, "image/png", strings.NewReader("x"))
+		assert.ErrorIs(t, err, output.ErrInvalidSignedURL)
+	})
+
+	t.Run("download URL", func(t *testing.T) {
+		s := newTestStorage(t)
+		download, err := s.CreateSignedDownload(ctx, testBucket, "a.png", time.Minute)
+		require.NoError(t, err)
+		bucket, objectPath, query := parseSignedURL(t, download.URL)
+
+		err = s.ReceiveSignedUpload(ctx, bucket, objectPath, query, "image/png", strings.NewReader("x"))
+		assert.ErrorIs(t, err, output.ErrInvalidSignedURL)
+	})
+
+	t.Run("content type mismatch", func(t *testing.T) {
+		s := newTestStorage(t)
+		bucket, objectPath, query := upload(t, s, "a.png", "image/png", false)
+
+		err := s.ReceiveSignedUpload(ctx, bucket, objectPath, query, "text/html", strings.NewReader("x"))
+		assert.ErrorIs(t, err, output.ErrContentTypeMismatch)
+		_, err = s.StatObject(ctx, testBucket, "a.png")
+		assert.ErrorIs(t, err, output.ErrObjectNotFound)
+	})
+
+	t.Run("path traversal", func(t *testing.T) {
+		s := newTestStorage(t)
+		_, _, query := upload(t, s, "a.png", "image/png", false)
+
+		err := s.ReceiveSignedUpload(ctx, testBucket, "../a.png", query, "image/png", strings.NewReader("x"))
+		assert.ErrorIs(t, err, output.ErrInvalidSignedURL)
+	})
+}
+
+func TestReceiveSignedUpload_Upsert(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+	require.NoError(t, s.PutObject(ctx, testBucket, "a.png", "image/png", []byte("old")))
+
+	bucket, objectPath, query := upload(t, s, "a.png", "image/png", false)
+	err := s.ReceiveSignedUpload(ctx, bucket, objectPath, query, "image/png", strings.NewReader("new"))
+	assert.ErrorIs(t, err, output.ErrObjectExists)
+	assertContent(t, s, "a.png", "old")
+
+	bucket, objectPath, query = upload(t, s, "a.png", "image/png", true)
+	require.NoError(t, s.ReceiveSignedUpload(ctx, bucket, objectPath, query, "image/png", strings.NewReader("new")))
+	assertContent(t, s, "a.png", "new")
+}
+
+func TestOpenSignedDownload_Rejects(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+
+	download, err := s.CreateSignedDownload(ctx, testBucket, "missing.png", time.Minute)
+	require.NoError(t, err)
+	bucket, objectPath, query := parseSignedURL(t, download.URL)
+
+	_, _, err = s.OpenSignedDownload(ctx, bucket, objectPath, query)
+	assert.ErrorIs(t, err, output.ErrObjectNotFound)
+
+	query.Set(paramSignature, "invalid")
+	_, _, err = s.OpenSignedDownload(ctx, bucket, objectPath, query)
+	assert.ErrorIs(t, err, output.ErrInvalidSignedURL)
+}
+
+func TestObjectOperations(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.PutObject(ctx, testBucket, "reviews/a_small.webp", "image/webp", []byte("webp")))
+	assertContent(t, s, "reviews/a_small.webp", "webp")
+
+	info, err := s.StatObject(ctx, testBucket, "reviews/a_small.webp")
+	require.NoError(t, err)
+	assert.Equal(t, &output.ObjectInfo{Size: 4, ContentType: "image/webp"}, info)
+
+	require.NoError(t, s.DeleteObjects(ctx, testBucket, []string{"reviews/a_small.webp", "reviews/missing.webp"}))
+	_, err = s.StatObject(ctx, testBucket, "reviews/a_small.webp")
+	assert.ErrorIs(t, err, output.ErrObjectNotFound)
+	_, err = s.GetObject(ctx, testBucket, "reviews/a_small.webp")
+	assert.ErrorIs(t, err, output.ErrObjectNotFound)
+}
+
+func TestObjectFile_RejectsInvalidPaths(t *testing.T) {
+	s := newTestStorage(t)
+
+	for _, tt := range []struct{ bucket, objectPath string }{
+		{testBucket, ""},
+		{testBucket, "../secret"},
+		{testBucket, "a/../../secret"},
+		{testBucket, "a//b"},
+		{testBucket, `a\b`},
+		{"", "a.png"},
+		{"..", "a.png"},
+		{metadataDir, "a.png"},
+	} {
+		_, err := s.objectFile(tt.bucket, tt.objectPath)
+		assert.Error(t, err, "bucket=%q objectPath=%q", tt.bucket, tt.objectPath)
+	}
+
+	file, err := s.objectFile(testBucket, "reviews/a.png")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(s.dir, testBucket, "reviews", "a.png"), file)
+}
+
+func TestNewStorage_NotConfigured(t *testing.T) {
+	s := NewStorage(t.TempDir(), testBaseURL, nil)
+
+	_, err := s.CreateSignedUpload(context.Background(), testBucket, "a.png", "image/png", time.Minute, false)
+	assert.Error(t, err)
+}
+
+func assertContent(t *testing.T, s *Storage, objectPath, want string) {
+	t.Helper()
+	body, err := s.GetObject(context.Background(), testBucket, objectPath)
+	require.NoError(t, err)
+	defer body.Close()
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, body)
+	require.NoError(t, err)
+	assert.Equal(t, want, buf.String())
+
+	entries, err := os.ReadDir(filepath.Dir(filepath.Join(s.dir, testBucket, objectPath)))
+	require.NoError(t, err)
+	for _, entry := range entries {
+		assert.False(t, strings.HasPrefix(entry.Name(), ".upload-"), "temporary file %q left behind", entry.Name())
+	}
+}
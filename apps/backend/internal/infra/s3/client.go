@@ -0,0 +1,284 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/config"
+	infrahttp "github.com/TeamH04/team-production/apps/backend/internal/infra/http"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+const (
+	headerIfNoneMatch = "If-None-Match"
+	// requestExpiry はサーバーから S3 へ送るリクエストの署名の有効期限
+	requestExpiry = 5 * time.Minute
+	// maxErrorBodyBytes はエラーの内容として読み込むレスポンスの大きさの上限
+	maxErrorBodyBytes = 64 << 10
+)
+
+// Config は S3 互換ストレージへの接続設定
+type Config struct {
+	// Endpoint は API の URL（例: https://s3.ap-northeast-1.amazonaws.com、http://localhost:9000）
+	Endpoint        string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle はバケットをホスト名ではなくパスで指定するか。MinIO などで使う
+	UsePathStyle bool
+}
+
+// Client は S3 互換ストレージの StorageProvider
+type Client struct {
+	endpoint        string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	usePathStyle    bool
+	httpClient      *http.Client
+	now             func() time.Time
+}
+
+var _ output.StorageProvider = (*Client)(nil)
+
+func NewClient(cfg Config) *Client {
+	return &Client{
+		endpoint:        strings.TrimRight(strings.TrimSpace(cfg.Endpoint), "/"),
+		region:          strings.TrimSpace(cfg.Region),
+		accessKeyID:     strings.TrimSpace(cfg.AccessKeyID),
+		secretAccessKey: strings.TrimSpace(cfg.SecretAccessKey),
+		usePathStyle:    cfg.UsePathStyle,
+		httpClient:      &http.Client{Timeout: config.HTTPClientTimeout},
+		now:             time.Now,
+	}
+}
+
+// objectLocation は署名に使う、オブジェクトの URL の各部分。path はエスケープ済み
+type objectLocation struct {
+	scheme string
+	host   string
+	path   string
+}
+
+func (c *Client) objectLocation(bucket, objectPath string) (objectLocation, error) {
+	if c.endpoint == "" || c.region == "" || c.accessKeyID == "" || c.secretAccessKey == "" {
+		return objectLocation{}, errors.New("s3 storage not configured")
+	}
+	bucket = strings.TrimSpace(bucket)
+	if bucket == "" {
+		return objectLocation{}, errors.New("bucket is required")
+	}
+	objectPath = strings.TrimPrefix(strings.TrimSpace(objectPath), "/")
+	if objectPath == "" {
+		return objectLocation{}, errors.New("objectPath is required")
+	}
+	endpoint, err := url.Parse(c.endpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return objectLocation{}, fmt.Errorf("invalid s3 endpoint %q", c.endpoint)
+	}
+
+	basePath := strings.TrimRight(endpoint.EscapedPath(), "/")
+	if c.usePathStyle {
+		return objectLocation{
+			scheme: endpoint.Scheme,
+			host:   endpoint.Host,
+			path:   basePath + "/" + uriEncode(bucket, true) + "/" + uriEncode(objectPath, false),
+		}, nil
+	}
+	return objectLocation{
+		scheme: endpoint.Scheme,
+		host:   bucket + "." + endpoint.Host,
+		path:   basePath + "/" + uriEncode(objectPath, false),
+	}, nil
+}
+
+// CreateSignedUpload は PUT で使う署名付き URL を返します。正規化した Content-Type を署名に含め、
+// upsert でない場合は If-None-Match: * も含めて既存のオブジェクトを上書きできないようにする
+func (c *Client) CreateSignedUpload(
+	ctx context.Context,
+	bucket, objectPath, contentType string,
+	expiresIn time.Duration,
+	upsert bool,
+) (*output.SignedUpload, error) {
+	loc, err := c.objectLocation(bucket, objectPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateExpiry(expiresIn); err != nil {
+		return nil, err
+	}
+
+	ct := normalizeContentType(contentType)
+	headers := map[string]string{}
+	if ct != "" {
+		headers[infrahttp.HeaderContentType] = ct
+	}
+	if !upsert {
+		headers[headerIfNoneMatch] = "*"
+	}
+
+	return &output.SignedUpload{
+		Bucket:      bucket,
+		Path:        strings.TrimSpace(objectPath),
+		URL:         c.presign(http.MethodPut, loc, headers, expiresIn),
+		Headers:     headers,
+		ExpiresIn:   expiresIn,
+		ContentType: ct,
+		Upsert:      upsert,
+	}, nil
+}
+
+func (c *Client) CreateSignedDownload(ctx context.Context, bucket, objectPath string, expiresIn time.Duration) (*output.SignedDownload, error) {
+	loc, err := c.objectLocation(bucket, objectPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateExpiry(expiresIn); err != nil {
+		return nil, err
+	}
+	return &output.SignedDownload{
+		Bucket:    bucket,
+		Path:      strings.TrimSpace(objectPath),
+		URL:       c.presign(http.MethodGet, loc, nil, expiresIn),
+		ExpiresIn: expiresIn,
+	}, nil
+}
+
+// StatObject は HEAD でオブジェクトのサイズと Content-Type を取得します
+func (c *Client) StatObject(ctx context.Context, bucket, objectPath string) (*output.ObjectInfo, error) {
+	resp, err := c.do(ctx, http.MethodHead, bucket, objectPath, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, output.ErrObjectNotFound
+	}
+	if infrahttp.IsHTTPError(resp.StatusCode) {
+		return nil, decodeError(http.MethodHead, resp)
+	}
+	return &output.ObjectInfo{Size: resp.ContentLength, ContentType: resp.Header.Get(infrahttp.HeaderContentType)}, nil
+}
+
+// DeleteObjects はオブジェクトを1件ずつ削除します。存在しないオブジェクトの削除も S3 は成功として扱う
+func (c *Client) DeleteObjects(ctx context.Context, bucket string, objectPaths []string) error {
+	for _, objectPath := range objectPaths {
+		if err := c.deleteObject(ctx, bucket, objectPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) deleteObject(ctx context.Context, bucket, objectPath string) error {
+	resp, err := c.do(ctx, http.MethodDelete, bucket, objectPath, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || !infrahttp.IsHTTPError(resp.StatusCode) {
+		return nil
+	}
+	return decodeError(http.MethodDelete, resp)
+}
+
+func (c *Client) GetObject(ctx context.Context, bucket, objectPath string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, http.MethodGet, bucket, objectPath, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !infrahttp.IsHTTPError(resp.StatusCode) {
+		return resp.Body, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, output.ErrObjectNotFound
+	}
+	return nil, decodeError(http.MethodGet, resp)
+}
+
+func (c *Client) PutObject(ctx context.Context, bucket, objectPath, contentType string, content []byte) error {
+	headers := map[string]string{}
+	if ct := normalizeContentType(contentType); ct != "" {
+		headers[infrahttp.HeaderContentType] = ct
+	}
+	resp, err := c.do(ctx, http.MethodPut, bucket, objectPath, headers, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if infrahttp.IsHTTPError(resp.StatusCode) {
+		return decodeError(http.MethodPut, resp)
+	}
+	return nil
+}
+
+// do は署名付き URL でリクエストを送ります。headers は署名に含め、リクエストにも付ける
+func (c *Client) do(
+	ctx context.Context,
+	method, bucket, objectPath string,
+	headers map[string]string,
+	body io.Reader,
+) (*http.Response, error) {
+	loc, err := c.objectLocation(bucket, objectPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.presign(method, loc, headers, requestExpiry), body)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	return c.httpClient.Do(req)
+}
+
+func validateExpiry(expiresIn time.Duration) error {
+	if expiresIn <= 0 {
+		return errors.New("expiresIn must be positive")
+	}
+	if expiresIn > maxPresignExpiry {
+		return fmt.Errorf("expiresIn must be at most %s", maxPresignExpiry)
+	}
+	return nil
+}
+
+// normalizeContentType は Content-Type をパラメーターを除いた小文字のメディアタイプにします。
+// 署名した値とクライアントが送る値を localstorage と同じ規則で揃えるため
+func normalizeContentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+	return mediaType
+}
+
+// decodeError は S3 のエラーレスポンス（XML）からエラーを作ります
+func decodeError(method string, resp *http.Response) error {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	if err != nil {
+		return fmt.Errorf("s3 %s failed: status %d: %w", method, resp.StatusCode, err)
+	}
+	var result struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if err := xml.Unmarshal(body, &result); err == nil && result.Code != "" {
+		return fmt.Errorf("s3 %s failed: status %d: %s: %s", method, resp.StatusCode, result.Code, result.Message)
+	}
+	return fmt.Errorf("s3 %s failed: status %d", method, resp.StatusCode)
+}
@@ -0,0 +1,3 @@
+// Package s3 は Amazon S3 互換のオブジェクトストレージ（MinIO など）との連携機能を提供します。
+// リクエストはすべて AWS Signature Version 4 で署名した URL で送ります。
+package s3
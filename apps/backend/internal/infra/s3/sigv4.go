@@ -0,0 +1,120 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signingService   = "s3"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	amzDateFormat    = "20060102T150405Z"
+	// maxPresignExpiry は署名付き URL の有効期限の上限（S3 の仕様）
+	maxPresignExpiry = 7 * 24 * time.Hour
+)
+
+// presign は method で target にアクセスする署名付き URL を返します。
+// headers は署名に含めるヘッダーで、URL を使うリクエストは同じ値を送る必要がある
+func (c *Client) presign(method string, target objectLocation, headers map[string]string, expiresIn time.Duration) string {
+	now := c.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	scope := strings.Join([]string{now.Format("20060102"), c.region, signingService, "aws4_request"}, "/")
+
+	canonicalHeaders := map[string]string{"host": target.host}
+	for name, value := range headers {
+		canonicalHeaders[strings.ToLower(name)] = strings.TrimSpace(value)
+	}
+	names := make([]string, 0, len(canonicalHeaders))
+	for name := range canonicalHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	signedHeaders := strings.Join(names, ";")
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", signingAlgorithm)
+	query.Set("X-Amz-Credential", c.accessKeyID+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(expiresIn/time.Second), 10))
+	query.Set("X-Amz-SignedHeaders", signedHeaders)
+	canonicalQuery := canonicalQueryString(query)
+
+	var headerLines strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&headerLines, "%s:%s\n", name, canonicalHeaders[name])
+	}
+	canonicalRequest := strings.Join([]string{
+		method,
+		target.path,
+		canonicalQuery,
+		headerLines.String(),
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{signingAlgorithm, amzDate, scope, hashHex(canonicalRequest)}, "\n")
+	signature := hex.EncodeToString(hmacSHA256(c.signingKey(now), stringToSign))
+
+	return fmt.Sprintf("%s://%s%s?%s&X-Amz-Signature=%s", target.scheme, target.host, target.path, canonicalQuery, signature)
+}
+
+func (c *Client) signingKey(now time.Time) []byte {
+	key := hmacSHA256([]byte("AWS4"+c.secretAccessKey), now.Format("20060102"))
+	key = hmacSHA256(key, c.region)
+	key = hmacSHA256(key, signingService)
+	return hmacSHA256(key, "aws4_request")
+}
+
+// canonicalQueryString はキーの順に並べ、SigV4 の規則（RFC 3986、空白は %20）でエスケープしたクエリを返します
+func canonicalQueryString(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, value := range query[key] {
+			pairs = append(pairs, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode は英数字と "-._~" 以外をエスケープします。encodeSlash が false の場合は "/" を残す
+func uriEncode(value string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+		switch {
+		case 'A' <= ch && ch <= 'Z', 'a' <= ch && ch <= 'z', '0' <= ch && ch <= '9',
+			ch == '-', ch == '.', ch == '_', ch == '~':
+			b.WriteByte(ch)
+		case ch == '/' && !encodeSlash:
+			b.WriteByte(ch)
+		default:
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func hashHex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
@@ -15,6 +15,7 @@ import (
 	"net/http"
 	"net/url"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -28,6 +29,9 @@ import (
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
 
+// headerUpsert は Storage のアップロードで既存のオブジェクトを上書きするかを指定するヘッダー
+const headerUpsert = "x-upsert"
+
 // Client provides access to Supabase Auth / Storage APIs.
 type Client struct {
 	baseURL    string
@@ -286,6 +290,44 @@ func (c *Client) UpdateUser(ctx context.Context, userID string, input output.Aut
 	return nil
 }
 
+// DeleteUser deletes a Supabase Auth user via Admin API.
+// A user that no longer exists is treated as deleted so that retries succeed.
+func (c *Client) DeleteUser(ctx context.Context, userID string) error {
+	if c.baseURL == "" || c.serviceKey == "" {
+		return errors.New("supabase admin api not configured")
+	}
+	if strings.TrimSpace(userID) == "" {
+		return errors.New("userID is required")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.authEndpoint("/admin/users/"+userID), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(infrahttp.HeaderAPIKey, c.serviceKey)
+	req.Header.Set(infrahttp.HeaderAuthorization, security.BearerPrefix+c.serviceKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if infrahttp.IsHTTPError(resp.StatusCode) {
+		return decodeSupabaseErrorFromBody(resp.StatusCode, respBody)
+	}
+
+	return nil
+}
+
 // Signup creates a new user via Supabase Admin API.
 func (c *Client) Signup(ctx context.Context, input output.AuthSignupInput) (*output.AuthUser, error) {
 	if c.baseURL == "" || c.serviceKey == "" {
@@ -700,10 +742,18 @@ func (c *Client) CreateSignedUpload(
 		return nil, fmt.Errorf("supabase signed upload missing token (bucket=%s path=%s)", bucket, objectPath)
 	}
 
+	// アップロード先は Storage の署名付きアップロード URL。upsert はトークンにも含まれるが、クライアントライブラリと同じくヘッダーでも送る
+	headers := map[string]string{headerUpsert: strconv.FormatBool(upsert)}
+	if ct != "" {
+		headers[infrahttp.HeaderContentType] = ct
+	}
+
 	return &output.SignedUpload{
 		Bucket:      bucket,
 		Path:        objectPath,
 		Token:       result.Token,
+		URL:         c.storageEndpoint(fmt.Sprintf("%s?token=%s", target, url.QueryEscape(result.Token))),
+		Headers:     headers,
 		ExpiresIn:   expiresIn,
 		ContentType: ct,
 		Upsert:      upsert,
@@ -819,3 +869,167 @@ func (c *Client) CreateSignedDownload(ctx context.Context, bucket, objectPath st
 		ExpiresIn: expiresIn,
 	}, nil
 }
+
+// StatObject は HEAD リクエストでオブジェクトのサイズと Content-Type を取得します。
+// Supabase Storage は存在しないオブジェクトに 400 を返すことがあるため、404 と同様に ErrObjectNotFound として扱う。
+func (c *Client) StatObject(ctx context.Context, bucket, objectPath string) (*output.ObjectInfo, error) {
+	key, err := c.storageKeyOrError()
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(bucket) == "" {
+		return nil, errors.New("bucket is required")
+	}
+	objectPath = strings.TrimSpace(objectPath)
+	if objectPath == "" {
+		return nil, errors.New("objectPath is required")
+	}
+
+	target := fmt.Sprintf("/object/%s/%s", bucket, escapePathPreserveSlash(objectPath))
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.storageEndpoint(target), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(infrahttp.HeaderAPIKey, key)
+	req.Header.Set(infrahttp.HeaderAuthorization, security.BearerPrefix+key)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest:
+		return nil, output.ErrObjectNotFound
+	case infrahttp.IsHTTPError(resp.StatusCode):
+		return nil, fmt.Errorf("supabase responded with status %d", resp.StatusCode)
+	}
+
+	return &output.ObjectInfo{
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get(infrahttp.HeaderContentType),
+	}, nil
+}
+
+// DeleteObjects はバケットからオブジェクトをまとめて削除します。存在しないオブジェクトは無視されます
+func (c *Client) DeleteObjects(ctx context.Context, bucket string, objectPaths []string) error {
+	key, err := c.storageKeyOrError()
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(bucket) == "" {
+		return errors.New("bucket is required")
+	}
+	if len(objectPaths) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(map[string]any{"prefixes": objectPaths})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.storageEndpoint("/object/"+bucket), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(infrahttp.HeaderContentType, infrahttp.MimeTypeJSON)
+	req.Header.Set(infrahttp.HeaderAPIKey, key)
+	req.Header.Set(infrahttp.HeaderAuthorization, security.BearerPrefix+key)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if infrahttp.IsHTTPError(resp.StatusCode) {
+		return decodeSupabaseErrorFromBody(resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+// GetObject はサービスキーでオブジェクトをダウンロードします。存在しない場合は StatObject と同様に ErrObjectNotFound を返す
+func (c *Client) GetObject(ctx context.Context, bucket, objectPath string) (io.ReadCloser, error) {
+	key, err := c.storageKeyOrError()
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(bucket) == "" {
+		return nil, errors.New("bucket is required")
+	}
+	objectPath = strings.TrimSpace(objectPath)
+	if objectPath == "" {
+		return nil, errors.New("objectPath is required")
+	}
+
+	target := fmt.Sprintf("/object/%s/%s", bucket, escapePathPreserveSlash(objectPath))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.storageEndpoint(target), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(infrahttp.HeaderAPIKey, key)
+	req.Header.Set(infrahttp.HeaderAuthorization, security.BearerPrefix+key)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !infrahttp.IsHTTPError(resp.StatusCode) {
+		return resp.Body, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
+		return nil, output.ErrObjectNotFound
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return nil, decodeSupabaseErrorFromBody(resp.StatusCode, respBody)
+}
+
+// PutObject はサービスキーでオブジェクトをアップロードします。同じパスのオブジェクトは上書きする
+func (c *Client) PutObject(ctx context.Context, bucket, objectPath, contentType string, content []byte) error {
+	key, err := c.storageKeyOrError()
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(bucket) == "" {
+		return errors.New("bucket is required")
+	}
+	objectPath = strings.TrimSpace(objectPath)
+	if objectPath == "" {
+		return errors.New("objectPath is required")
+	}
+
+	target := fmt.Sprintf("/object/%s/%s", bucket, escapePathPreserveSlash(objectPath))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.storageEndpoint(target), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(infrahttp.HeaderContentType, contentType)
+	req.Header.Set(infrahttp.HeaderAPIKey, key)
+	req.Header.Set(infrahttp.HeaderAuthorization, security.BearerPrefix+key)
+	req.Header.Set(headerUpsert, "true")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if infrahttp.IsHTTPError(resp.StatusCode) {
+		return decodeSupabaseErrorFromBody(resp.StatusCode, respBody)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -528,6 +529,65 @@ func TestClient_UpdateUser(t *testing.T) {
 	})
 }
 
+func TestClient_DeleteUser(t *testing.T) {
+	t.Run("successful delete", func(t *testing.T) {
+		called := false
+		_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Contains(t, r.URL.Path, "/auth/v1/admin/users/user-123")
+			assert.NotEmpty(t, r.Header.Get("apikey"))
+			assert.NotEmpty(t, r.Header.Get("Authorization"))
+
+			w.Header().Set("Content-Type", "application/json")
+			writeJSON(w, map[string]interface{}{})
+		})
+
+		err := client.DeleteUser(context.Background(), "user-123")
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("already deleted user", func(t *testing.T) {
+		_, client := setupTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]string{"message": "User not found"})
+		})
+
+		err := client.DeleteUser(context.Background(), "user-123")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing configuration", func(t *testing.T) {
+		client := NewClient("", "anon", "")
+		err := client.DeleteUser(context.Background(), "user-123")
+		requireErrorContains(t, err, "not configured")
+	})
+
+	t.Run("missing user ID", func(t *testing.T) {
+		_, client := setupTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		err := client.DeleteUser(context.Background(), " ")
+		requireErrorContains(t, err, "userID is required")
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		_, client := setupTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+			writeJSON(w, map[string]string{"message": "database error"})
+		})
+
+		err := client.DeleteUser(context.Background(), "user-123")
+
+		requireErrorContains(t, err, "database error")
+	})
+}
+
 // TestClient_CreateSignedUpload tests the CreateSignedUpload method.
 func TestClient_CreateSignedUpload(t *testing.T) {
 	t.Run("successful signed upload", func(t *testing.T) {
@@ -546,6 +606,8 @@ func TestClient_CreateSignedUpload(t *testing.T) {
 
 		require.NoError(t, err)
 		assertSignedUpload(t, result, "test-bucket", "images/test.png", "signed-token-123", "image/png", time.Hour, false)
+		assert.Contains(t, result.URL, "/storage/v1/object/upload/sign/test-bucket/images/test.png?token=signed-token-123")
+		assert.Equal(t, map[string]string{"Content-Type": "image/png", "x-upsert": "false"}, result.Headers)
 	})
 
 	t.Run("signed upload with upsert", func(t *testing.T) {
@@ -730,6 +792,169 @@ func TestClient_CreateSignedDownload_NotConfigured(t *testing.T) {
 	requireErrorContains(t, err, "not configured")
 }
 
+// TestClient_StatObject tests the StatObject method.
+func TestClient_StatObject(t *testing.T) {
+	t.Run("existing object", func(t *testing.T) {
+		_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodHead, r.Method)
+			assert.Equal(t, "/storage/v1/object/test-bucket/reviews/photo%201.jpg", r.URL.EscapedPath())
+			assert.Equal(t, "Bearer service-key", r.Header.Get("Authorization"))
+
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Header().Set("Content-Length", "2048")
+			w.WriteHeader(http.StatusOK)
+		})
+
+		info, err := client.StatObject(context.Background(), "test-bucket", "reviews/photo 1.jpg")
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2048), info.Size)
+		assert.Equal(t, "image/jpeg", info.ContentType)
+	})
+
+	for _, status := range []int{http.StatusNotFound, http.StatusBadRequest} {
+		t.Run(fmt.Sprintf("missing object (%d)", status), func(t *testing.T) {
+			_, client := setupTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(status)
+			})
+
+			_, err := client.StatObject(context.Background(), "bucket", "missing.jpg")
+
+			assert.ErrorIs(t, err, output.ErrObjectNotFound)
+		})
+	}
+
+	t.Run("server error", func(t *testing.T) {
+		_, client := setupTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		_, err := client.StatObject(context.Background(), "bucket", "file.jpg")
+
+		requireErrorContains(t, err, "status 500")
+		assert.NotErrorIs(t, err, output.ErrObjectNotFound)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		_, err := NewClient("", "", "").StatObject(context.Background(), "bucket", "file.jpg")
+		requireErrorContains(t, err, "not configured")
+	})
+}
+
+// TestClient_DeleteObjects tests the DeleteObjects method.
+func TestClient_DeleteObjects(t *testing.T) {
+	t.Run("deletes objects in one request", func(t *testing.T) {
+		var body struct {
+			Prefixes []string `json:"prefixes"`
+		}
+		_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, "/storage/v1/object/test-bucket", r.URL.Path)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			w.Header().Set("Content-Type", "application/json")
+			writeJSON(w, []map[string]string{})
+		})
+
+		err := client.DeleteObjects(context.Background(), "test-bucket", []string{"reviews/a.jpg", "stores/b.png"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"reviews/a.jpg", "stores/b.png"}, body.Prefixes)
+	})
+
+	t.Run("no objects", func(t *testing.T) {
+		client := NewClient("http://127.0.0.1:1", "anon-key", "service-key")
+		require.NoError(t, client.DeleteObjects(context.Background(), "bucket", nil))
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		_, client := setupTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			writeJSON(w, map[string]string{"message": "permission denied"})
+		})
+
+		err := client.DeleteObjects(context.Background(), "bucket", []string{"a.jpg"})
+
+		requireErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestClient_GetObject(t *testing.T) {
+	t.Run("existing object", func(t *testing.T) {
+		_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/storage/v1/object/test-bucket/reviews/photo%201.jpg", r.URL.EscapedPath())
+			assert.Equal(t, "Bearer service-key", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte("image-bytes"))
+		})
+
+		body, err := client.GetObject(context.Background(), "test-bucket", "reviews/photo 1.jpg")
+		require.NoError(t, err)
+		defer body.Close()
+
+		content, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, "image-bytes", string(content))
+	})
+
+	t.Run("missing object", func(t *testing.T) {
+		_, client := setupTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]string{"error": "not_found", "message": "Object not found"})
+		})
+
+		_, err := client.GetObject(context.Background(), "bucket", "missing.jpg")
+
+		assert.ErrorIs(t, err, output.ErrObjectNotFound)
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		_, client := setupTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			writeJSON(w, map[string]string{"message": "permission denied"})
+		})
+
+		_, err := client.GetObject(context.Background(), "bucket", "file.jpg")
+
+		requireErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestClient_PutObject(t *testing.T) {
+	t.Run("uploads with upsert", func(t *testing.T) {
+		var received []byte
+		_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/storage/v1/object/test-bucket/reviews/photo_200w.webp", r.URL.EscapedPath())
+			assert.Equal(t, "image/webp", r.Header.Get("Content-Type"))
+			assert.Equal(t, "true", r.Header.Get("x-upsert"))
+			received, _ = io.ReadAll(r.Body)
+			writeJSON(w, map[string]string{"Key": "test-bucket/reviews/photo_200w.webp"})
+		})
+
+		err := client.PutObject(context.Background(), "test-bucket", "reviews/photo_200w.webp", "image/webp", []byte("webp-bytes"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "webp-bytes", string(received))
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		_, client := setupTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			writeJSON(w, map[string]string{"message": "Payload too large"})
+		})
+
+		err := client.PutObject(context.Background(), "bucket", "file.jpg", "image/jpeg", []byte("x"))
+
+		requireErrorContains(t, err, "Payload too large")
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		err := NewClient("", "", "").PutObject(context.Background(), "bucket", "file.jpg", "image/jpeg", nil)
+		requireErrorContains(t, err, "not configured")
+	})
+}
+
 // generateTestKey generates a P-256 ECDSA key pair for testing.
 func generateTestKey() (*ecdsa.PrivateKey, string, string, error) {
 	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
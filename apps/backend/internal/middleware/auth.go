@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -54,15 +55,18 @@ func bearerTokenFromAuthHeader(value string) (string, error) {
 	return token, nil
 }
 
+// findOrEnsureUser はトークンのユーザーを取得し、未登録なら作成します。
+// 利用停止中のユーザーは既存の行を返さず作り直しもせず、ErrUserSuspended を返します。
+// 退会済みのユーザーのトークンは ErrUnauthorized になります
 func (m *AuthMiddleware) findOrEnsureUser(ctx context.Context, claims *security.TokenClaims) (entity.User, error) {
 	user, err := m.userUC.FindByID(ctx, claims.UserID)
 	if err == nil {
-		return user, nil
+		return rejectInactive(user)
 	}
 	if !errors.Is(err, usecase.ErrUserNotFound) {
 		return entity.User{}, err
 	}
-	return m.userUC.EnsureUser(ctx, input.EnsureUserInput{
+	user, err = m.userUC.EnsureUser(ctx, input.EnsureUserInput{
 		UserID:   claims.UserID,
 		Email:    claims.Email,
 		Role:     claims.Role,
@@ -71,6 +75,21 @@ func (m *AuthMiddleware) findOrEnsureUser(ctx context.Context, claims *security.
 		IconURL:  claims.IconURL,
 		Gender:   claims.Gender,
 	})
+	if err != nil {
+		return entity.User{}, err
+	}
+	// 同時に作成された既存の行が返ることがあるため、作成後も確認する
+	return rejectInactive(user)
+}
+
+func rejectInactive(user entity.User) (entity.User, error) {
+	if user.IsDeleted() {
+		return entity.User{}, usecase.ErrUnauthorized
+	}
+	if user.IsSuspended(time.Now()) {
+		return entity.User{}, usecase.ErrUserSuspended
+	}
+	return user, nil
 }
 
 func (m *AuthMiddleware) attachUserIfPossible(c echo.Context, verifier security.TokenVerifier) {
@@ -86,10 +105,10 @@ func (m *AuthMiddleware) attachUserIfPossible(c echo.Context, verifier security.
 		return
 	}
 	user, err := m.userUC.FindByID(c.Request().Context(), claims.UserID)
-	if err != nil {
+	if err != nil || user.IsDeleted() || user.IsSuspended(time.Now()) {
 		return
 	}
-	requestcontext.SetToContext(c, user, claims.Role)
+	requestcontext.SetToContext(c, user, user.Role)
 }
 
 // JWTAuth はJWT認証を行うミドルウェア
@@ -115,7 +134,7 @@ func (m *AuthMiddleware) JWTAuth(verifier security.TokenVerifier) echo.Middlewar
 				return err
 			}
 
-			requestcontext.SetToContext(c, user, claims.Role)
+			requestcontext.SetToContext(c, user, user.Role)
 
 			return next(c)
 		}
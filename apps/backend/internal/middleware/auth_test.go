@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -172,6 +173,146 @@ func TestJWTAuth_UserNotFound_EnsureUserFails(t *testing.T) {
 	}
 }
 
+func TestJWTAuth_SuspendedUserRejected(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	suspendedAt := time.Now().Add(-time.Hour)
+	mockUC := &testutil.MockUserUseCase{
+		FindByIDResult: entity.User{UserID: "user-1", SuspendedAt: &suspendedAt},
+	}
+	mockVerifier := &testutil.MockTokenVerifier{
+		Claims: &security.TokenClaims{UserID: "user-1", Role: "user", Email: "test@example.com"},
+	}
+
+	mw := middleware.NewAuthMiddleware(mockUC)
+	handler := mw.JWTAuth(mockVerifier)(func(c echo.Context) error {
+		t.Fatal("handler must not be called for a suspended user")
+		return nil
+	})
+
+	err := handler(c)
+
+	if !errors.Is(err, usecase.ErrUserSuspended) {
+		t.Fatalf("expected ErrUserSuspended, got %v", err)
+	}
+	if mockUC.EnsureUserCalled {
+		t.Error("expected suspended user not to be recreated")
+	}
+}
+
+func TestJWTAuth_DeletedUserRejected(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	deletedAt := time.Now().Add(-time.Minute)
+	mockUC := &testutil.MockUserUseCase{
+		FindByIDResult: entity.User{UserID: "user-1", DeletedAt: &deletedAt},
+	}
+	mockVerifier := &testutil.MockTokenVerifier{
+		Claims: &security.TokenClaims{UserID: "user-1", Role: "user", Email: "test@example.com"},
+	}
+
+	mw := middleware.NewAuthMiddleware(mockUC)
+	handler := mw.JWTAuth(mockVerifier)(func(c echo.Context) error {
+		t.Fatal("handler must not be called for a deleted user")
+		return nil
+	})
+
+	err := handler(c)
+
+	if !errors.Is(err, usecase.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+	if mockUC.EnsureUserCalled {
+		t.Error("expected deleted user not to be recreated")
+	}
+}
+
+func TestJWTAuth_ExpiredSuspensionAllowed(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	suspendedAt := time.Now().Add(-48 * time.Hour)
+	until := time.Now().Add(-time.Hour)
+	mockUC := &testutil.MockUserUseCase{
+		FindByIDResult: entity.User{UserID: "user-1", SuspendedAt: &suspendedAt, SuspendedUntil: &until},
+	}
+	mockVerifier := &testutil.MockTokenVerifier{
+		Claims: &security.TokenClaims{UserID: "user-1", Role: "user"},
+	}
+
+	mw := middleware.NewAuthMiddleware(mockUC)
+	handler := mw.JWTAuth(mockVerifier)(func(c echo.Context) error {
+		return c.String(http.StatusOK, "success")
+	})
+
+	if err := handler(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestJWTAuth_EnsuredUserSuspended(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// EnsureUser may return a row created concurrently, which can already be suspended
+	suspendedAt := time.Now()
+	mockUC := &testutil.MockUserUseCase{
+		FindByIDErr:      usecase.ErrUserNotFound,
+		EnsureUserResult: entity.User{UserID: "user-1", SuspendedAt: &suspendedAt},
+	}
+	mockVerifier := &testutil.MockTokenVerifier{
+		Claims: &security.TokenClaims{UserID: "user-1", Role: "user", Email: "test@example.com"},
+	}
+
+	mw := middleware.NewAuthMiddleware(mockUC)
+	handler := mw.JWTAuth(mockVerifier)(func(c echo.Context) error {
+		return c.String(http.StatusOK, "success")
+	})
+
+	if err := handler(c); !errors.Is(err, usecase.ErrUserSuspended) {
+		t.Fatalf("expected ErrUserSuspended, got %v", err)
+	}
+}
+
+func TestJWTAuth_UsesRoleFromDatabase(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// トークンの発行後に admin から user に降格された
+	mockUC := &testutil.MockUserUseCase{
+		FindByIDResult: entity.User{UserID: "user-1", Role: "user"},
+	}
+	mockVerifier := &testutil.MockTokenVerifier{
+		Claims: &security.TokenClaims{UserID: "user-1", Role: "admin"},
+	}
+
+	mw := middleware.NewAuthMiddleware(mockUC)
+	handler := mw.JWTAuth(mockVerifier)(mw.RequireRole("admin")(func(c echo.Context) error {
+		return c.String(http.StatusOK, "success")
+	}))
+
+	if err := handler(c); err == nil {
+		t.Fatal("expected demoted admin to be rejected, got nil")
+	}
+}
+
 // --- RequireRole Tests ---
 
 func TestRequireRole_HasRole(t *testing.T) {
@@ -279,6 +420,67 @@ func TestOptionalAuth_WithValidToken(t *testing.T) {
 	}
 }
 
+func TestOptionalAuth_UsesRoleFromDatabase(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := &testutil.MockUserUseCase{
+		FindByIDResult: entity.User{UserID: "user-1", Role: "user"},
+	}
+	mockVerifier := &testutil.MockTokenVerifier{
+		Claims: &security.TokenClaims{UserID: "user-1", Role: "admin"},
+	}
+
+	mw := middleware.NewAuthMiddleware(mockUC)
+	var role string
+	handler := mw.OptionalAuth(mockVerifier)(func(c echo.Context) error {
+		r, err := requestcontext.GetUserRoleFromContext(c.Request().Context())
+		if err != nil {
+			return err
+		}
+		role = r
+		return c.String(http.StatusOK, "success")
+	})
+
+	if err := handler(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if role != "user" {
+		t.Errorf("expected role from the database, got %q", role)
+	}
+}
+
+func TestOptionalAuth_SuspendedUserNotAttached(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	suspendedAt := time.Now()
+	mockUC := &testutil.MockUserUseCase{
+		FindByIDResult: entity.User{UserID: "user-1", SuspendedAt: &suspendedAt},
+	}
+	mockVerifier := &testutil.MockTokenVerifier{
+		Claims: &security.TokenClaims{UserID: "user-1", Role: "user"},
+	}
+
+	mw := middleware.NewAuthMiddleware(mockUC)
+	handler := mw.OptionalAuth(mockVerifier)(func(c echo.Context) error {
+		if _, err := requestcontext.GetUserFromContext(c.Request().Context()); err == nil {
+			t.Error("expected suspended user not to be attached")
+		}
+		return c.String(http.StatusOK, "success")
+	})
+
+	if err := handler(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestOptionalAuth_WithoutToken(t *testing.T) {
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -463,7 +665,11 @@ func (m *mockUserUseCaseWithTracking) UpdateUserRole(ctx context.Context, userID
 	return nil
 }
 
-func (m *mockUserUseCaseWithTracking) GetUserReviews(ctx context.Context, userID string) ([]entity.Review, error) {
+func (m *mockUserUseCaseWithTracking) GetUserReviews(ctx context.Context, viewer entity.User, userID string) ([]entity.Review, error) {
+	return nil, nil
+}
+
+func (m *mockUserUseCaseWithTracking) GetPublicProfile(ctx context.Context, userID string) (*input.PublicUserProfile, error) {
 	return nil, nil
 }
 
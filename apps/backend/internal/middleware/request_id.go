@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	echomw "github.com/labstack/echo/v4/middleware"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+)
+
+// RequestID はリクエストごとに X-Request-Id を発行してレスポンスヘッダーに返します。
+// クライアントが X-Request-Id を送った場合はその値を使い、監査ログに記録できるようコンテキストにも設定します
+func RequestID() echo.MiddlewareFunc {
+	return echomw.RequestIDWithConfig(echomw.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, requestID string) {
+			ctx := usecase.WithRequestID(c.Request().Context(), requestID)
+			c.SetRequest(c.Request().WithContext(ctx))
+		},
+	})
+}
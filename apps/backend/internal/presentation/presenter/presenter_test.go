@@ -122,7 +122,7 @@ func createFullStore() entity.Store {
 		Latitude:        35.6762,
 		Longitude:       139.6503,
 		GoogleMapURL:    ptrString("https://maps.google.com/test"),
-		IsApproved:      true,
+		Visibility:      "published",
 		Category:        "ramen",
 		Budget:          "medium",
 		AverageRating:   4.5,
@@ -201,7 +201,7 @@ func createFullReport() entity.Report {
 		ReportID:   1,
 		UserID:     "user-001",
 		TargetType: "review",
-		TargetID:   100,
+		TargetID:   "00000000-0000-0000-0000-000000000100",
 		Reason:     "spam content",
 		Status:     "pending",
 		CreatedAt:  testTime(),
@@ -327,6 +327,25 @@ func TestNewFileResponse(t *testing.T) {
 	}
 }
 
+func TestNewFileResponse_Variants(t *testing.T) {
+	file := createMinimalFile()
+	require.NotNil(t, NewFileResponse(file).Variants, "variants should be an empty array, not null")
+
+	file.Variants = []entity.FileVariant{
+		{Width: 200, Height: 150, Format: "webp", ContentType: "image/webp", ObjectKey: "uploads/doc.pdf_200w.webp", FileSize: 80},
+	}
+	got := NewFileResponse(file)
+	require.Len(t, got.Variants, 1)
+	require.Equal(t, FileVariantResponse{
+		Width:       200,
+		Height:      150,
+		Format:      "webp",
+		ContentType: "image/webp",
+		FileSize:    80,
+		ObjectKey:   "uploads/doc.pdf_200w.webp",
+	}, got.Variants[0])
+}
+
 func TestNewFileResponses(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -428,6 +447,35 @@ func TestNewMenuResponse(t *testing.T) {
 	}
 }
 
+func TestNewMenuResponse_ManagementFields(t *testing.T) {
+	deletedAt := testTime()
+	menu := entity.Menu{
+		MenuID:      "menu-004",
+		StoreID:     "store-001",
+		Name:        "Matcha Latte",
+		Section:     ptrString("drink"),
+		IsAvailable: false,
+		SortOrder:   3,
+		ImageFileID: ptrString("file-001"),
+		ImageFile:   &entity.File{FileID: "file-001", ObjectKey: "menus/latte.jpg"},
+		DietaryTags: []string{"vegetarian", "contains_milk"},
+		DeletedAt:   &deletedAt,
+	}
+
+	got := NewMenuResponse(menu)
+
+	assertOptionalString(t, "Section", got.Section, ptrString("drink"))
+	require.False(t, got.IsAvailable)
+	require.Equal(t, 3, got.SortOrder)
+	require.NotNil(t, got.ImageFile)
+	require.Equal(t, "menus/latte.jpg", got.ImageFile.ObjectKey)
+	require.Equal(t, []string{"vegetarian", "contains_milk"}, got.DietaryTags)
+	require.True(t, got.IsDeleted)
+
+	// タグ未設定でも null ではなく空配列を返す
+	require.Equal(t, []string{}, NewMenuResponse(createMinimalMenu()).DietaryTags)
+}
+
 func TestNewMenuResponses(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -524,6 +572,21 @@ func TestNewReviewResponse(t *testing.T) {
 	}
 }
 
+func TestNewReviewResponse_EditedMarker(t *testing.T) {
+	review := createMinimalReview()
+	review.UpdatedAt = review.CreatedAt
+
+	got := NewReviewResponse(review)
+	require.False(t, got.IsEdited)
+	require.Nil(t, got.UpdatedAt)
+
+	review.UpdatedAt = review.CreatedAt.Add(time.Hour)
+	got = NewReviewResponse(review)
+	require.True(t, got.IsEdited)
+	require.NotNil(t, got.UpdatedAt)
+	require.Equal(t, review.UpdatedAt, *got.UpdatedAt)
+}
+
 func TestNewReviewResponses(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -693,7 +756,8 @@ func assertStoreRequiredFields(t *testing.T, got StoreResponse, want entity.Stor
 	require.Equal(t, want.PlaceID, got.PlaceID, "PlaceID")
 	require.Equal(t, want.Latitude, got.Latitude, "Latitude")
 	require.Equal(t, want.Longitude, got.Longitude, "Longitude")
-	require.Equal(t, want.IsApproved, got.IsApproved, "IsApproved")
+	require.Equal(t, want.IsPublished(), got.IsApproved, "IsApproved")
+	require.Equal(t, want.Visibility, got.Visibility, "Visibility")
 	require.Equal(t, want.Category, got.Category, "Category")
 	require.Equal(t, want.Budget, got.Budget, "Budget")
 	require.Equal(t, want.AverageRating, got.AverageRating, "AverageRating")
@@ -770,6 +834,72 @@ func TestNewStoreResponse(t *testing.T) {
 	}
 }
 
+func TestNewStoreResponse_RatingAggregates(t *testing.T) {
+	taste := 4.25
+	store := createMinimalStore()
+	store.AverageRating = 4.2
+	store.ReviewCount = 5
+	store.RatingHistogram = entity.RatingHistogram{0, 1, 0, 1, 3}
+	store.RatingAverages = entity.RatingAverages{Taste: &taste}
+
+	got := NewStoreResponse(store)
+
+	require.Equal(t, 5, got.ReviewCount)
+	require.Equal(t, map[string]int{"1": 0, "2": 1, "3": 0, "4": 1, "5": 3}, got.RatingHistogram)
+	require.Equal(t, &taste, got.RatingAverages.Taste)
+	require.Nil(t, got.RatingAverages.Service)
+}
+
+func TestNewStoreResponse_OpeningSchedule(t *testing.T) {
+	note := "年始休業"
+	schedule := entity.OpeningSchedule{
+		Special:  []entity.SpecialHours{{Date: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)}},
+		Closures: []entity.StoreClosure{{From: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2027, 1, 3, 0, 0, 0, 0, time.UTC), Note: &note}},
+	}
+	schedule.Weekly[time.Monday] = []entity.OpeningInterval{{Open: 690, Close: 840}, {Open: 1080, Close: 1560}}
+	store := createMinimalStore()
+	store.OpeningSchedule = &schedule
+
+	got := NewStoreResponse(store)
+
+	require.NotNil(t, got.OpeningSchedule)
+	require.Equal(t, []OpeningIntervalResponse{{Open: "11:30", Close: "14:00"}, {Open: "18:00", Close: "26:00"}}, got.OpeningSchedule.Weekly.Mon)
+	require.Empty(t, got.OpeningSchedule.Weekly.Tue)
+	require.Equal(t, []SpecialHoursResponse{{Date: "2026-12-31", Intervals: []OpeningIntervalResponse{}}}, got.OpeningSchedule.SpecialHours)
+	require.Equal(t, []StoreClosureResponse{{From: "2027-01-01", To: "2027-01-03", Note: &note}}, got.OpeningSchedule.Closures)
+	require.NotNil(t, got.OpenNow)
+
+	require.Nil(t, NewStoreResponse(createMinimalStore()).OpenNow)
+}
+
+func TestSetOpeningStatus_UsesServiceTimeZone(t *testing.T) {
+	closesAt := time.Date(2026, 10, 14, 13, 0, 0, 0, time.UTC)
+	var resp StoreResponse
+
+	setOpeningStatus(&resp, entity.OpeningStatus{OpenNow: true, ClosesAt: &closesAt})
+
+	require.True(t, *resp.OpenNow)
+	require.Equal(t, "2026-10-14T22:00:00+09:00", resp.ClosesAt.Format(time.RFC3339))
+	require.Nil(t, resp.NextOpenAt)
+}
+
+func TestNewRatingSummaryResponse(t *testing.T) {
+	summary := entity.RatingSummary{
+		StoreID:       "store-001",
+		AverageRating: 3.5,
+		ReviewCount:   2,
+		Histogram:     entity.RatingHistogram{0, 0, 1, 1, 0},
+	}
+
+	got := NewRatingSummaryResponse(summary)
+
+	require.Equal(t, "store-001", got.StoreID)
+	require.InDelta(t, 3.5, got.AverageRating, 0.001)
+	require.Equal(t, 2, got.ReviewCount)
+	require.Equal(t, map[string]int{"1": 0, "2": 0, "3": 1, "4": 1, "5": 0}, got.Histogram)
+	require.Nil(t, got.Averages.Taste)
+}
+
 func TestNewStoreResponses(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -830,7 +960,7 @@ func TestNewReportResponse(t *testing.T) {
 		{
 			name: "report with different status",
 			report: entity.Report{
-				ReportID: 2, UserID: "user-002", TargetType: "store", TargetID: 200,
+				ReportID: 2, UserID: "user-002", TargetType: "store", TargetID: "00000000-0000-0000-0000-000000000200",
 				Reason: "inappropriate content", Status: "resolved",
 				CreatedAt: testTime(), UpdatedAt: testTimeUpdated(),
 			},
@@ -869,7 +999,7 @@ func TestNewReportResponses(t *testing.T) {
 					ReportID:   2,
 					UserID:     "user-002",
 					TargetType: "store",
-					TargetID:   200,
+					TargetID:   "00000000-0000-0000-0000-000000000200",
 					Reason:     "spam",
 					Status:     "rejected",
 					CreatedAt:  testTime(),
@@ -1247,3 +1377,43 @@ func TestReviewResponseWithOnlyFiles(t *testing.T) {
 		t.Errorf("expected 1 file ID, got %d", len(got.FileIDs))
 	}
 }
+
+func TestNewTagCountResponses(t *testing.T) {
+	got := NewTagCountResponses([]entity.TagCount{{Tag: "wifi", StoreCount: 3}, {Tag: "quiet", StoreCount: 1}})
+
+	require.Equal(t, []TagCountResponse{
+		{Tag: "wifi", StoreCount: 3},
+		{Tag: "quiet", StoreCount: 1},
+	}, got)
+	require.Empty(t, NewTagCountResponses(nil))
+}
+
+func TestNewSearchResultResponse(t *testing.T) {
+	store := entity.Store{StoreID: "store-1", Name: "Cafe Blue"}
+	review := entity.Review{ReviewID: "review-1", StoreID: "store-1", Content: ptrString("cafe latte")}
+
+	got := NewSearchResultResponse(entity.SearchResult{
+		Type:   "review",
+		Score:  0.5,
+		Store:  &store,
+		Review: &review,
+		Highlights: []entity.SearchHighlight{
+			{Field: "content", Segments: []entity.SearchSegment{{Text: "cafe", Match: true}, {Text: " latte"}}},
+		},
+	})
+
+	require.Equal(t, "review", got.Type)
+	require.InDelta(t, 0.5, got.Score, 1e-9)
+	require.NotNil(t, got.Store)
+	require.Equal(t, "store-1", got.Store.StoreID)
+	require.NotNil(t, got.Review)
+	require.Equal(t, "review-1", got.Review.ReviewID)
+	require.Equal(t, []SearchHighlightResponse{
+		{Field: "content", Segments: []SearchSegmentResponse{{Text: "cafe", Match: true}, {Text: " latte"}}},
+	}, got.Highlights)
+
+	storeOnly := NewSearchResultResponse(entity.SearchResult{Type: "store", Store: &store})
+	require.Nil(t, storeOnly.Review)
+	require.NotNil(t, storeOnly.Highlights)
+	require.Empty(t, storeOnly.Highlights)
+}
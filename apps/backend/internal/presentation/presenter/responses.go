@@ -1,9 +1,12 @@
 package presenter
 
 import (
+	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/hours"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 )
 
@@ -20,38 +23,107 @@ func toResponses[E any, R any](entities []E, convert func(E) R) []R {
 }
 
 type StoreResponse struct {
-	StoreID         string           `json:"store_id"`
-	ThumbnailFileID *string          `json:"thumbnail_file_id,omitempty"`
-	ThumbnailFile   *FileResponse    `json:"thumbnail_file,omitempty"`
-	Name            string           `json:"name"`
-	OpenedAt        *time.Time       `json:"opened_at,omitempty"`
-	Description     *string          `json:"description,omitempty"`
-	Address         string           `json:"address"`
-	PlaceID         string           `json:"place_id"`
-	OpeningHours    *string          `json:"opening_hours,omitempty"`
-	Latitude        float64          `json:"latitude"`
-	Longitude       float64          `json:"longitude"`
-	GoogleMapURL    *string          `json:"google_map_url,omitempty"`
-	IsApproved      bool             `json:"is_approved"`
-	Category        string           `json:"category"`
-	Budget          string           `json:"budget"`
-	AverageRating   float64          `json:"average_rating"`
-	DistanceMinutes int              `json:"distance_minutes"`
-	Tags            []string         `json:"tags"`
-	ImageUrls       []string         `json:"image_urls"`
-	CreatedAt       time.Time        `json:"created_at"`
-	UpdatedAt       time.Time        `json:"updated_at"`
-	Menus           []MenuResponse   `json:"menus,omitempty"`
-	Reviews         []ReviewResponse `json:"reviews,omitempty"`
+	StoreID string `json:"store_id"`
+	// ThumbnailFileID・ThumbnailFile はカバー画像。ギャラリーの写真から PUT /stores/:id/cover で選ぶ
+	ThumbnailFileID *string       `json:"thumbnail_file_id,omitempty"`
+	ThumbnailFile   *FileResponse `json:"thumbnail_file,omitempty"`
+	Name            string        `json:"name"`
+	NameKana        *string       `json:"name_kana,omitempty"`
+	OpenedAt        *time.Time    `json:"opened_at,omitempty"`
+	Description     *string       `json:"description,omitempty"`
+	Address         string        `json:"address"`
+	PlaceID         string        `json:"place_id"`
+	OpeningHours    *string       `json:"opening_hours,omitempty"`
+	// OpeningSchedule の営業時間から、レスポンスを作成した時点の営業状況を OpenNow・ClosesAt・NextOpenAt に設定する
+	OpeningSchedule *OpeningScheduleResponse `json:"opening_schedule,omitempty"`
+	OpenNow         *bool                    `json:"open_now,omitempty"`
+	ClosesAt        *time.Time               `json:"closes_at,omitempty"`
+	NextOpenAt      *time.Time               `json:"next_open_at,omitempty"`
+	Latitude        float64                  `json:"latitude"`
+	Longitude       float64                  `json:"longitude"`
+	GoogleMapURL    *string                  `json:"google_map_url,omitempty"`
+	IsApproved      bool                     `json:"is_approved"`
+	Visibility      string                   `json:"visibility"`
+	ApprovalStatus  string                   `json:"approval_status"`
+	Category        string                   `json:"category"`
+	Budget          string                   `json:"budget"`
+	AverageRating   float64                  `json:"average_rating"`
+	ReviewCount     int                      `json:"review_count"`
+	RatingHistogram map[string]int           `json:"rating_histogram"`
+	RatingAverages  RatingAverages           `json:"rating_averages"`
+	DistanceMinutes int                      `json:"distance_minutes"`
+	DistanceMeters  *float64                 `json:"distance_meters,omitempty"`
+	VisitedByMe     *bool                    `json:"visited_by_me,omitempty"`
+	Tags            []string                 `json:"tags"`
+	ImageUrls       []string                 `json:"image_urls"`
+	Photos          []StorePhotoResponse     `json:"photos"`
+	CreatedAt       time.Time                `json:"created_at"`
+	UpdatedAt       time.Time                `json:"updated_at"`
+	Menus           []MenuResponse           `json:"menus,omitempty"`
+	Reviews         []ReviewResponse         `json:"reviews,omitempty"`
+	PendingEdit     *StoreEditResponse       `json:"pending_edit,omitempty"`
+}
+
+// StorePhotoResponse は店舗のギャラリーの写真。写真は sort_order の順に並ぶ
+type StorePhotoResponse struct {
+	FileID    string       `json:"file_id"`
+	File      FileResponse `json:"file"`
+	SortOrder int          `json:"sort_order"`
+	Caption   *string      `json:"caption,omitempty"`
+	AltText   *string      `json:"alt_text,omitempty"`
+	IsCover   bool         `json:"is_cover"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// OpeningScheduleResponse は構造化された営業時間。時刻は Asia/Tokyo の HH:MM で、翌日にまたがる閉店時刻は 26:00 のように表す
+type OpeningScheduleResponse struct {
+	Weekly       WeeklyHoursResponse    `json:"weekly"`
+	SpecialHours []SpecialHoursResponse `json:"special_hours"`
+	Closures     []StoreClosureResponse `json:"closures"`
+}
+
+type WeeklyHoursResponse struct {
+	Mon []OpeningIntervalResponse `json:"mon"`
+	Tue []OpeningIntervalResponse `json:"tue"`
+	Wed []OpeningIntervalResponse `json:"wed"`
+	Thu []OpeningIntervalResponse `json:"thu"`
+	Fri []OpeningIntervalResponse `json:"fri"`
+	Sat []OpeningIntervalResponse `json:"sat"`
+	Sun []OpeningIntervalResponse `json:"sun"`
+}
+
+type OpeningIntervalResponse struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+type SpecialHoursResponse struct {
+	Date      string                    `json:"date"`
+	Intervals []OpeningIntervalResponse `json:"intervals"`
+}
+
+type StoreClosureResponse struct {
+	From string  `json:"from"`
+	To   string  `json:"to"`
+	Note *string `json:"note,omitempty"`
 }
 
 type MenuResponse struct {
-	MenuID      string    `json:"menu_id"`
-	StoreID     string    `json:"store_id"`
-	Name        string    `json:"name"`
-	Price       *int      `json:"price,omitempty"`
-	Description *string   `json:"description,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
+	MenuID      string        `json:"menu_id"`
+	StoreID     string        `json:"store_id"`
+	Name        string        `json:"name"`
+	Price       *int          `json:"price,omitempty"`
+	Description *string       `json:"description,omitempty"`
+	Section     *string       `json:"section"`
+	IsAvailable bool          `json:"is_available"`
+	SortOrder   int           `json:"sort_order"`
+	ImageFileID *string       `json:"image_file_id,omitempty"`
+	ImageFile   *FileResponse `json:"image_file,omitempty"`
+	DietaryTags []string      `json:"dietary_tags"`
+	// IsDeleted はレビューが参照しているメニューが既に削除されている場合に true になります
+	IsDeleted bool      `json:"is_deleted"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type RatingDetailsResponse struct {
@@ -62,6 +134,46 @@ type RatingDetailsResponse struct {
 	Cleanliness *int `json:"cleanliness,omitempty"`
 }
 
+// RatingAverages は詳細評価の項目別平均です。評価が1件もない項目は null になります
+type RatingAverages struct {
+	Taste       *float64 `json:"taste"`
+	Atmosphere  *float64 `json:"atmosphere"`
+	Service     *float64 `json:"service"`
+	Speed       *float64 `json:"speed"`
+	Cleanliness *float64 `json:"cleanliness"`
+}
+
+type RatingSummaryResponse struct {
+	StoreID       string         `json:"store_id"`
+	AverageRating float64        `json:"average_rating"`
+	ReviewCount   int            `json:"review_count"`
+	Histogram     map[string]int `json:"histogram"`
+	Averages      RatingAverages `json:"averages"`
+}
+
+type TagCountResponse struct {
+	Tag        string `json:"tag"`
+	StoreCount int    `json:"store_count"`
+}
+
+type SearchResultResponse struct {
+	Type       string                    `json:"type"`
+	Score      float64                   `json:"score"`
+	Store      *StoreResponse            `json:"store,omitempty"`
+	Review     *ReviewResponse           `json:"review,omitempty"`
+	Highlights []SearchHighlightResponse `json:"highlights"`
+}
+
+type SearchHighlightResponse struct {
+	Field    string                  `json:"field"`
+	Segments []SearchSegmentResponse `json:"segments"`
+}
+
+type SearchSegmentResponse struct {
+	Text  string `json:"text"`
+	Match bool   `json:"match"`
+}
+
 type ReviewResponse struct {
 	ReviewID      string                 `json:"review_id"`
 	StoreID       string                 `json:"store_id"`
@@ -75,7 +187,10 @@ type ReviewResponse struct {
 	Files         []FileResponse         `json:"files,omitempty"`
 	LikesCount    int                    `json:"likes_count"`
 	LikedByMe     bool                   `json:"liked_by_me"`
+	IsEdited      bool                   `json:"is_edited"`
+	Visibility    string                 `json:"visibility"`
 	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     *time.Time             `json:"updated_at,omitempty"`
 }
 
 type UserResponse struct {
@@ -91,6 +206,36 @@ type UserResponse struct {
 	Role       string     `json:"role"`
 	CreatedAt  time.Time  `json:"created_at"`
 	UpdatedAt  time.Time  `json:"updated_at"`
+
+	SuspendedAt      *time.Time `json:"suspended_at,omitempty"`
+	SuspendedUntil   *time.Time `json:"suspended_until,omitempty"`
+	SuspensionReason *string    `json:"suspension_reason,omitempty"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
+}
+
+// PublicUserProfileResponse is the profile shown to anyone. It must not contain private fields such as email or phone.
+type PublicUserProfileResponse struct {
+	UserID        string                  `json:"user_id"`
+	Name          string                  `json:"name"`
+	IconURL       *string                 `json:"icon_url,omitempty"`
+	JoinedAt      time.Time               `json:"joined_at"`
+	ReviewCount   int                     `json:"review_count"`
+	LikesReceived int                     `json:"likes_received"`
+	StoresVisited int                     `json:"stores_visited"`
+	TopCategories []CategoryCountResponse `json:"top_categories"`
+}
+
+type CategoryCountResponse struct {
+	Category    string `json:"category"`
+	ReviewCount int    `json:"review_count"`
+}
+
+// FollowUserResponse is a user in a follower or following list. Like PublicUserProfileResponse it has no private fields.
+type FollowUserResponse struct {
+	UserID     string    `json:"user_id"`
+	Name       string    `json:"name"`
+	IconURL    *string   `json:"icon_url,omitempty"`
+	FollowedAt time.Time `json:"followed_at"`
 }
 
 type FavoriteResponse struct {
@@ -101,14 +246,93 @@ type FavoriteResponse struct {
 }
 
 type ReportResponse struct {
-	ReportID   int64     `json:"report_id"`
-	UserID     string    `json:"user_id"`
-	TargetType string    `json:"target_type"`
-	TargetID   int64     `json:"target_id"`
-	Reason     string    `json:"reason"`
-	Status     string    `json:"status"`
+	ReportID       int64      `json:"report_id"`
+	UserID         string     `json:"user_id"`
+	TargetType     string     `json:"target_type"`
+	TargetID       string     `json:"target_id"`
+	Reason         string     `json:"reason"`
+	Status         string     `json:"status"`
+	Enforcement    *string    `json:"enforcement,omitempty"`
+	ResolutionNote *string    `json:"resolution_note,omitempty"`
+	ResolvedBy     *string    `json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+	ReportCount    int        `json:"report_count,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+type StoreClaimResponse struct {
+	ClaimID    string         `json:"claim_id"`
+	StoreID    string         `json:"store_id"`
+	UserID     string         `json:"user_id"`
+	Note       string         `json:"note"`
+	Status     string         `json:"status"`
+	ReviewedBy *string        `json:"reviewed_by,omitempty"`
+	ReviewNote *string        `json:"review_note,omitempty"`
+	ReviewedAt *time.Time     `json:"reviewed_at,omitempty"`
+	Files      []FileResponse `json:"files"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+type StoreApprovalEventResponse struct {
+	EventID    int64     `json:"event_id"`
+	StoreID    string    `json:"store_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    *string   `json:"actor_id,omitempty"`
+	Reason     *string   `json:"reason,omitempty"`
 	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type UserModerationEventResponse struct {
+	EventID        int64      `json:"event_id"`
+	UserID         string     `json:"user_id"`
+	AdminID        *string    `json:"admin_id,omitempty"`
+	Action         string     `json:"action"`
+	Reason         *string    `json:"reason,omitempty"`
+	FromRole       *string    `json:"from_role,omitempty"`
+	ToRole         *string    `json:"to_role,omitempty"`
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type AuditLogResponse struct {
+	AuditID    int64           `json:"audit_id"`
+	ActorID    *string         `json:"actor_id,omitempty"`
+	ActorRole  string          `json:"actor_role"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	RequestID  *string         `json:"request_id,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type StoreEditResponse struct {
+	EditID          string                     `json:"edit_id"`
+	StoreID         string                     `json:"store_id"`
+	UserID          string                     `json:"user_id"`
+	Name            *string                    `json:"name,omitempty"`
+	Address         *string                    `json:"address,omitempty"`
+	Latitude        *float64                   `json:"latitude,omitempty"`
+	Longitude       *float64                   `json:"longitude,omitempty"`
+	PlaceID         *string                    `json:"place_id,omitempty"`
+	ThumbnailFileID *string                    `json:"thumbnail_file_id,omitempty"`
+	Status          string                     `json:"status"`
+	ReviewedBy      *string                    `json:"reviewed_by,omitempty"`
+	ReviewNote      *string                    `json:"review_note,omitempty"`
+	ReviewedAt      *time.Time                 `json:"reviewed_at,omitempty"`
+	Changes         []StoreFieldChangeResponse `json:"changes"`
+	CreatedAt       time.Time                  `json:"created_at"`
+	UpdatedAt       time.Time                  `json:"updated_at"`
+}
+
+type StoreFieldChangeResponse struct {
+	Field    string `json:"field"`
+	Current  any    `json:"current"`
+	Proposed any    `json:"proposed"`
 }
 
 type MediaResponse struct {
@@ -131,6 +355,54 @@ type FileResponse struct {
 	IsDeleted   bool      `json:"is_deleted"`
 	CreatedAt   time.Time `json:"created_at"`
 	CreatedBy   *string   `json:"created_by,omitempty"`
+	// UploadedAt はアップロード完了を確認した日時。未確認のファイルでは省略する
+	UploadedAt *time.Time `json:"uploaded_at,omitempty"`
+	// Variants は画像を縮小・変換した派生ファイル。画像の加工前や画像でないファイルでは空配列
+	Variants []FileVariantResponse `json:"variants"`
+}
+
+// FileVariantResponse は画像の派生ファイル。クライアントは表示する大きさと対応する形式で選ぶ
+type FileVariantResponse struct {
+	Width       int     `json:"width"`
+	Height      int     `json:"height"`
+	Format      string  `json:"format"`
+	ContentType string  `json:"content_type"`
+	FileSize    int64   `json:"file_size"`
+	ObjectKey   string  `json:"object_key"`
+	URL         *string `json:"url,omitempty"`
+}
+
+type ReviewLikeResponse struct {
+	ReviewID  string    `json:"review_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AccountExportResponse is the JSON archive returned by the personal data export.
+type AccountExportResponse struct {
+	User       UserResponse         `json:"user"`
+	Reviews    []ReviewResponse     `json:"reviews"`
+	Favorites  []FavoriteResponse   `json:"favorites"`
+	Likes      []ReviewLikeResponse `json:"likes"`
+	Reports    []ReportResponse     `json:"reports"`
+	Files      []FileResponse       `json:"files"`
+	Visits     []VisitResponse      `json:"visits"`
+	ExportedAt time.Time            `json:"exported_at"`
+}
+
+// VisitResponse is a visit to a store. VisitedOn is a date formatted as YYYY-MM-DD.
+type VisitResponse struct {
+	VisitID   int64          `json:"visit_id"`
+	StoreID   string         `json:"store_id"`
+	VisitedOn string         `json:"visited_on"`
+	Note      *string        `json:"note,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	Store     *StoreResponse `json:"store,omitempty"`
+}
+
+type VisitCountsResponse struct {
+	VisitCount int `json:"visit_count"`
+	StoreCount int `json:"store_count"`
 }
 
 type AuthSessionResponse struct {
@@ -147,11 +419,82 @@ type AuthUserResponse struct {
 	Role  string `json:"role"`
 }
 
+func NewRatingSummaryResponse(summary entity.RatingSummary) RatingSummaryResponse {
+	return RatingSummaryResponse{
+		StoreID:       summary.StoreID,
+		AverageRating: summary.AverageRating,
+		ReviewCount:   summary.ReviewCount,
+		Histogram:     newRatingHistogram(summary.Histogram),
+		Averages:      newRatingAverages(summary.Averages),
+	}
+}
+
+func NewTagCountResponse(count entity.TagCount) TagCountResponse {
+	return TagCountResponse{
+		Tag:        count.Tag,
+		StoreCount: count.StoreCount,
+	}
+}
+
+func NewTagCountResponses(counts []entity.TagCount) []TagCountResponse {
+	return toResponses(counts, NewTagCountResponse)
+}
+
+// newRatingHistogram は星の数（"1"〜"5"）をキーにした件数マップを返します
+func newRatingHistogram(h entity.RatingHistogram) map[string]int {
+	result := make(map[string]int, len(h))
+	for i, count := range h {
+		result[strconv.Itoa(i+1)] = count
+	}
+	return result
+}
+
+func newRatingAverages(a entity.RatingAverages) RatingAverages {
+	return RatingAverages{
+		Taste:       a.Taste,
+		Atmosphere:  a.Atmosphere,
+		Service:     a.Service,
+		Speed:       a.Speed,
+		Cleanliness: a.Cleanliness,
+	}
+}
+
+func NewSearchResultResponse(result entity.SearchResult) SearchResultResponse {
+	resp := SearchResultResponse{
+		Type:       result.Type,
+		Score:      result.Score,
+		Highlights: toResponses(result.Highlights, newSearchHighlightResponse),
+	}
+	if result.Store != nil {
+		store := NewStoreResponse(*result.Store)
+		resp.Store = &store
+	}
+	if result.Review != nil {
+		review := NewReviewResponse(*result.Review)
+		resp.Review = &review
+	}
+	return resp
+}
+
+func NewSearchResultResponses(results []entity.SearchResult) []SearchResultResponse {
+	return toResponses(results, NewSearchResultResponse)
+}
+
+func newSearchHighlightResponse(h entity.SearchHighlight) SearchHighlightResponse {
+	return SearchHighlightResponse{
+		Field: h.Field,
+		Segments: toResponses(h.Segments, func(s entity.SearchSegment) SearchSegmentResponse {
+			return SearchSegmentResponse{Text: s.Text, Match: s.Match}
+		}),
+	}
+}
+
 func NewStoreResponse(store entity.Store) StoreResponse {
 	resp := StoreResponse{
 		StoreID:         store.StoreID,
 		ThumbnailFileID: store.ThumbnailFileID,
 		Name:            store.Name,
+		NameKana:        store.NameKana,
 		OpenedAt:        store.OpenedAt,
 		Description:     store.Description,
 		Address:         store.Address,
@@ -160,13 +503,21 @@ func NewStoreResponse(store entity.Store) StoreResponse {
 		Latitude:        store.Latitude,
 		Longitude:       store.Longitude,
 		GoogleMapURL:    store.GoogleMapURL,
-		IsApproved:      store.IsApproved,
+		IsApproved:      store.IsPublished(),
+		Visibility:      store.Visibility,
+		ApprovalStatus:  store.ApprovalStatus,
 		Category:        store.Category,
 		Budget:          store.Budget,
 		AverageRating:   store.AverageRating,
+		ReviewCount:     store.ReviewCount,
+		RatingHistogram: newRatingHistogram(store.RatingHistogram),
+		RatingAverages:  newRatingAverages(store.RatingAverages),
 		DistanceMinutes: store.DistanceMinutes,
+		DistanceMeters:  store.DistanceMeters,
+		VisitedByMe:     store.VisitedByMe,
 		Tags:            store.Tags,
 		ImageUrls:       extractImageUrls(store.Files),
+		Photos:          NewStorePhotoResponses(store.Photos),
 		CreatedAt:       store.CreatedAt,
 		UpdatedAt:       store.UpdatedAt,
 	}
@@ -186,9 +537,68 @@ func NewStoreResponse(store entity.Store) StoreResponse {
 	if len(store.Reviews) > 0 {
 		resp.Reviews = NewReviewResponses(store.Reviews)
 	}
+	if store.PendingEdit != nil {
+		edit := NewStoreEditResponse(*store.PendingEdit)
+		resp.PendingEdit = &edit
+	}
+	if store.OpeningSchedule != nil {
+		schedule := NewOpeningScheduleResponse(*store.OpeningSchedule)
+		resp.OpeningSchedule = &schedule
+		setOpeningStatus(&resp, hours.Status(*store.OpeningSchedule, time.Now()))
+	}
 	return resp
 }
 
+// setOpeningStatus は営業状況を、日時を Asia/Tokyo で表してレスポンスに設定します
+func setOpeningStatus(resp *StoreResponse, status entity.OpeningStatus) {
+	openNow := status.OpenNow
+	resp.OpenNow = &openNow
+	if status.ClosesAt != nil {
+		closesAt := status.ClosesAt.In(hours.Location)
+		resp.ClosesAt = &closesAt
+	}
+	if status.NextOpenAt != nil {
+		nextOpenAt := status.NextOpenAt.In(hours.Location)
+		resp.NextOpenAt = &nextOpenAt
+	}
+}
+
+func NewOpeningScheduleResponse(schedule entity.OpeningSchedule) OpeningScheduleResponse {
+	intervals := func(d time.Weekday) []OpeningIntervalResponse {
+		return newOpeningIntervalResponses(schedule.Weekly[d])
+	}
+	return OpeningScheduleResponse{
+		Weekly: WeeklyHoursResponse{
+			Mon: intervals(time.Monday),
+			Tue: intervals(time.Tuesday),
+			Wed: intervals(time.Wednesday),
+			Thu: intervals(time.Thursday),
+			Fri: intervals(time.Friday),
+			Sat: intervals(time.Saturday),
+			Sun: intervals(time.Sunday),
+		},
+		SpecialHours: toResponses(schedule.Special, func(s entity.SpecialHours) SpecialHoursResponse {
+			return SpecialHoursResponse{
+				Date:      s.Date.Format(time.DateOnly),
+				Intervals: newOpeningIntervalResponses(s.Intervals),
+			}
+		}),
+		Closures: toResponses(schedule.Closures, func(c entity.StoreClosure) StoreClosureResponse {
+			return StoreClosureResponse{
+				From: c.From.Format(time.DateOnly),
+				To:   c.To.Format(time.DateOnly),
+				Note: c.Note,
+			}
+		}),
+	}
+}
+
+func newOpeningIntervalResponses(intervals []entity.OpeningInterval) []OpeningIntervalResponse {
+	return toResponses(intervals, func(i entity.OpeningInterval) OpeningIntervalResponse {
+		return OpeningIntervalResponse{Open: hours.FormatMinutes(i.Open), Close: hours.FormatMinutes(i.Close)}
+	})
+}
+
 func extractImageUrls(files []entity.File) []string {
 	if len(files) == 0 {
 		return []string{}
@@ -200,19 +610,50 @@ func extractImageUrls(files []entity.File) []string {
 	return urls
 }
 
+func NewStorePhotoResponse(photo entity.StorePhoto) StorePhotoResponse {
+	return StorePhotoResponse{
+		FileID:    photo.File.FileID,
+		File:      NewFileResponse(photo.File),
+		SortOrder: photo.SortOrder,
+		Caption:   photo.Caption,
+		AltText:   photo.AltText,
+		IsCover:   photo.IsCover,
+		CreatedAt: photo.CreatedAt,
+	}
+}
+
+func NewStorePhotoResponses(photos []entity.StorePhoto) []StorePhotoResponse {
+	return toResponses(photos, NewStorePhotoResponse)
+}
+
 func NewStoreResponses(stores []entity.Store) []StoreResponse {
 	return toResponses(stores, NewStoreResponse)
 }
 
 func NewMenuResponse(menu entity.Menu) MenuResponse {
-	return MenuResponse{
+	resp := MenuResponse{
 		MenuID:      menu.MenuID,
 		StoreID:     menu.StoreID,
 		Name:        menu.Name,
 		Price:       menu.Price,
 		Description: menu.Description,
+		Section:     menu.Section,
+		IsAvailable: menu.IsAvailable,
+		SortOrder:   menu.SortOrder,
+		ImageFileID: menu.ImageFileID,
+		DietaryTags: menu.DietaryTags,
+		IsDeleted:   menu.IsDeleted(),
 		CreatedAt:   menu.CreatedAt,
+		UpdatedAt:   menu.UpdatedAt,
 	}
+	if resp.DietaryTags == nil {
+		resp.DietaryTags = []string{}
+	}
+	if menu.ImageFile != nil {
+		file := NewFileResponse(*menu.ImageFile)
+		resp.ImageFile = &file
+	}
+	return resp
 }
 
 func NewMenuResponses(menus []entity.Menu) []MenuResponse {
@@ -228,8 +669,14 @@ func NewReviewResponse(review entity.Review) ReviewResponse {
 		Content:    review.Content,
 		LikesCount: review.LikesCount,
 		LikedByMe:  review.LikedByMe,
+		IsEdited:   review.IsEdited(),
+		Visibility: review.Visibility,
 		CreatedAt:  review.CreatedAt,
 	}
+	if resp.IsEdited {
+		updatedAt := review.UpdatedAt
+		resp.UpdatedAt = &updatedAt
+	}
 	if review.RatingDetails != nil {
 		resp.RatingDetails = &RatingDetailsResponse{
 			Taste:       review.RatingDetails.Taste,
@@ -268,6 +715,51 @@ func NewUserResponse(user entity.User) UserResponse {
 		Role:       user.Role,
 		CreatedAt:  user.CreatedAt,
 		UpdatedAt:  user.UpdatedAt,
+
+		SuspendedAt:      user.SuspendedAt,
+		SuspendedUntil:   user.SuspendedUntil,
+		SuspensionReason: user.SuspensionReason,
+		DeletedAt:        user.DeletedAt,
+	}
+}
+
+func NewUserResponses(users []entity.User) []UserResponse {
+	return toResponses(users, NewUserResponse)
+}
+
+// NewPublicUserProfileResponse builds the public profile. IconURL is the provider's icon; the handler replaces it
+// with a signed URL when an uploaded icon exists.
+func NewPublicUserProfileResponse(profile input.PublicUserProfile) PublicUserProfileResponse {
+	return PublicUserProfileResponse{
+		UserID:        profile.User.UserID,
+		Name:          profile.User.Name,
+		IconURL:       profile.User.IconURL,
+		JoinedAt:      profile.User.CreatedAt,
+		ReviewCount:   profile.Stats.ReviewCount,
+		LikesReceived: profile.Stats.LikesReceived,
+		StoresVisited: profile.Stats.StoresVisited,
+		TopCategories: toResponses(profile.Stats.TopCategories, NewCategoryCountResponse),
+	}
+}
+
+func NewFollowUserResponse(follow entity.Follow) FollowUserResponse {
+	resp := FollowUserResponse{FollowedAt: follow.CreatedAt}
+	if follow.User != nil {
+		resp.UserID = follow.User.UserID
+		resp.Name = follow.User.Name
+		resp.IconURL = follow.User.IconURL
+	}
+	return resp
+}
+
+func NewFollowUserResponses(follows []entity.Follow) []FollowUserResponse {
+	return toResponses(follows, NewFollowUserResponse)
+}
+
+func NewCategoryCountResponse(count entity.CategoryCount) CategoryCountResponse {
+	return CategoryCountResponse{
+		Category:    count.Category,
+		ReviewCount: count.ReviewCount,
 	}
 }
 
@@ -294,6 +786,19 @@ func NewFileResponse(file entity.File) FileResponse {
 		IsDeleted:   file.IsDeleted,
 		CreatedAt:   file.CreatedAt,
 		CreatedBy:   file.CreatedBy,
+		UploadedAt:  file.UploadedAt,
+		Variants:    toResponses(file.Variants, newFileVariantResponse),
+	}
+}
+
+func newFileVariantResponse(variant entity.FileVariant) FileVariantResponse {
+	return FileVariantResponse{
+		Width:       variant.Width,
+		Height:      variant.Height,
+		Format:      variant.Format,
+		ContentType: variant.ContentType,
+		FileSize:    variant.FileSize,
+		ObjectKey:   variant.ObjectKey,
 	}
 }
 
@@ -340,17 +845,179 @@ func NewAuthUserResponse(user input.AuthUser) AuthUserResponse {
 
 func NewReportResponse(report entity.Report) ReportResponse {
 	return ReportResponse{
-		ReportID:   report.ReportID,
-		UserID:     report.UserID,
-		TargetType: report.TargetType,
-		TargetID:   report.TargetID,
-		Reason:     report.Reason,
-		Status:     report.Status,
-		CreatedAt:  report.CreatedAt,
-		UpdatedAt:  report.UpdatedAt,
+		ReportID:       report.ReportID,
+		UserID:         report.UserID,
+		TargetType:     report.TargetType,
+		TargetID:       report.TargetID,
+		Reason:         report.Reason,
+		Status:         report.Status,
+		Enforcement:    report.Enforcement,
+		ResolutionNote: report.ResolutionNote,
+		ResolvedBy:     report.ResolvedBy,
+		ResolvedAt:     report.ResolvedAt,
+		ReportCount:    report.ReportCount,
+		CreatedAt:      report.CreatedAt,
+		UpdatedAt:      report.UpdatedAt,
 	}
 }
 
 func NewReportResponses(reports []entity.Report) []ReportResponse {
 	return toResponses(reports, NewReportResponse)
 }
+
+func NewStoreClaimResponse(claim entity.StoreClaim) StoreClaimResponse {
+	return StoreClaimResponse{
+		ClaimID:    claim.ClaimID,
+		StoreID:    claim.StoreID,
+		UserID:     claim.UserID,
+		Note:       claim.Note,
+		Status:     claim.Status,
+		ReviewedBy: claim.ReviewedBy,
+		ReviewNote: claim.ReviewNote,
+		ReviewedAt: claim.ReviewedAt,
+		Files:      NewFileResponses(claim.Files),
+		CreatedAt:  claim.CreatedAt,
+		UpdatedAt:  claim.UpdatedAt,
+	}
+}
+
+func NewStoreClaimResponses(claims []entity.StoreClaim) []StoreClaimResponse {
+	return toResponses(claims, NewStoreClaimResponse)
+}
+
+func NewStoreApprovalEventResponse(event entity.StoreApprovalEvent) StoreApprovalEventResponse {
+	return StoreApprovalEventResponse{
+		EventID:    event.EventID,
+		StoreID:    event.StoreID,
+		FromStatus: event.FromStatus,
+		ToStatus:   event.ToStatus,
+		ActorID:    event.ActorID,
+		Reason:     event.Reason,
+		CreatedAt:  event.CreatedAt,
+	}
+}
+
+func NewStoreApprovalEventResponses(events []entity.StoreApprovalEvent) []StoreApprovalEventResponse {
+	return toResponses(events, NewStoreApprovalEventResponse)
+}
+
+func NewStoreEditResponse(edit entity.StoreEdit) StoreEditResponse {
+	resp := StoreEditResponse{
+		EditID:          edit.EditID,
+		StoreID:         edit.StoreID,
+		UserID:          edit.UserID,
+		Name:            edit.Name,
+		Address:         edit.Address,
+		Latitude:        edit.Latitude,
+		Longitude:       edit.Longitude,
+		PlaceID:         edit.PlaceID,
+		ThumbnailFileID: edit.ThumbnailFileID,
+		Status:          edit.Status,
+		ReviewedBy:      edit.ReviewedBy,
+		ReviewNote:      edit.ReviewNote,
+		ReviewedAt:      edit.ReviewedAt,
+		Changes:         make([]StoreFieldChangeResponse, len(edit.Changes)),
+		CreatedAt:       edit.CreatedAt,
+		UpdatedAt:       edit.UpdatedAt,
+	}
+	for i, change := range edit.Changes {
+		resp.Changes[i] = StoreFieldChangeResponse{
+			Field:    change.Field,
+			Current:  change.Current,
+			Proposed: change.Proposed,
+		}
+	}
+	return resp
+}
+
+func NewStoreEditResponses(edits []entity.StoreEdit) []StoreEditResponse {
+	return toResponses(edits, NewStoreEditResponse)
+}
+
+func NewUserModerationEventResponse(event entity.UserModerationEvent) UserModerationEventResponse {
+	return UserModerationEventResponse{
+		EventID:        event.EventID,
+		UserID:         event.UserID,
+		AdminID:        event.AdminID,
+		Action:         event.Action,
+		Reason:         event.Reason,
+		FromRole:       event.FromRole,
+		ToRole:         event.ToRole,
+		SuspendedUntil: event.SuspendedUntil,
+		CreatedAt:      event.CreatedAt,
+	}
+}
+
+func NewUserModerationEventResponses(events []entity.UserModerationEvent) []UserModerationEventResponse {
+	return toResponses(events, NewUserModerationEventResponse)
+}
+
+func NewAuditLogResponse(log entity.AuditLog) AuditLogResponse {
+	return AuditLogResponse{
+		AuditID:    log.AuditID,
+		ActorID:    log.ActorID,
+		ActorRole:  log.ActorRole,
+		Action:     log.Action,
+		TargetType: log.TargetType,
+		TargetID:   log.TargetID,
+		Before:     log.Before,
+		After:      log.After,
+		RequestID:  log.RequestID,
+		CreatedAt:  log.CreatedAt,
+	}
+}
+
+func NewAuditLogResponses(logs []entity.AuditLog) []AuditLogResponse {
+	return toResponses(logs, NewAuditLogResponse)
+}
+
+func NewReviewLikeResponse(like entity.ReviewLike) ReviewLikeResponse {
+	return ReviewLikeResponse{
+		ReviewID:  like.ReviewID,
+		CreatedAt: like.CreatedAt,
+	}
+}
+
+func NewReviewLikeResponses(likes []entity.ReviewLike) []ReviewLikeResponse {
+	return toResponses(likes, NewReviewLikeResponse)
+}
+
+func NewAccountExportResponse(export *input.AccountExport) AccountExportResponse {
+	return AccountExportResponse{
+		User:       NewUserResponse(export.User),
+		Reviews:    NewReviewResponses(export.Reviews),
+		Favorites:  NewFavoriteResponses(export.Favorites),
+		Likes:      NewReviewLikeResponses(export.Likes),
+		Reports:    NewReportResponses(export.Reports),
+		Files:      NewFileResponses(export.Files),
+		Visits:     NewVisitResponses(export.Visits),
+		ExportedAt: export.ExportedAt,
+	}
+}
+
+func NewVisitResponse(visit entity.Visit) VisitResponse {
+	resp := VisitResponse{
+		VisitID:   visit.VisitID,
+		StoreID:   visit.StoreID,
+		VisitedOn: visit.VisitedOn.Format(time.DateOnly),
+		Note:      visit.Note,
+		CreatedAt: visit.CreatedAt,
+		UpdatedAt: visit.UpdatedAt,
+	}
+	if visit.Store != nil {
+		store := NewStoreResponse(*visit.Store)
+		resp.Store = &store
+	}
+	return resp
+}
+
+func NewVisitResponses(visits []entity.Visit) []VisitResponse {
+	return toResponses(visits, NewVisitResponse)
+}
+
+func NewVisitCountsResponse(counts entity.VisitCounts) VisitCountsResponse {
+	return VisitCountsResponse{
+		VisitCount: counts.VisitCount,
+		StoreCount: counts.StoreCount,
+	}
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+)
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository は AuditLogRepository の実装を生成します
+func NewAuditLogRepository(db *gorm.DB) output.AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+// auditLogCursor is the keyset position of the last log on a page.
+// created_at is included so that the query can skip partitions.
+type auditLogCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        int64     `json:"id"`
+}
+
+func (cursor auditLogCursor) valid() bool {
+	return cursor.ID > 0
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, log *entity.AuditLog) error {
+	record := model.AuditLog{
+		ActorID:    log.ActorID,
+		ActorRole:  log.ActorRole,
+		Action:     log.Action,
+		TargetType: log.TargetType,
+		TargetID:   log.TargetID,
+		Before:     log.Before,
+		After:      log.After,
+		RequestID:  log.RequestID,
+		CreatedAt:  log.CreatedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&record).Error; err != nil {
+		return mapDBError(err)
+	}
+	log.AuditID = record.AuditID
+	log.CreatedAt = record.CreatedAt
+	return nil
+}
+
+// List は条件に一致する監査ログを新しい順に返します
+func (r *auditLogRepository) List(ctx context.Context, query output.AuditLogQuery) (*output.AuditLogPage, error) {
+	if query.Limit <= 0 {
+		query.Limit = constants.DefaultAuditLogListLimit
+	}
+
+	db := r.db.WithContext(ctx).Model(&model.AuditLog{})
+	if query.ActorID != nil {
+		db = db.Where("actor_id = ?", *query.ActorID)
+	}
+	if query.Action != nil {
+		db = db.Where("action = ?", *query.Action)
+	}
+	if query.TargetType != nil {
+		db = db.Where("target_type = ?", *query.TargetType)
+	}
+	if query.TargetID != nil {
+		db = db.Where("target_id = ?", *query.TargetID)
+	}
+	if query.From != nil {
+		db = db.Where("created_at >= ?", *query.From)
+	}
+	if query.To != nil {
+		db = db.Where("created_at < ?", *query.To)
+	}
+	if query.Cursor != "" {
+		cursor, err := decodeCursor[auditLogCursor](query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where(
+			"created_at < ? OR (created_at = ? AND audit_id < ?)",
+			cursor.CreatedAt, cursor.CreatedAt, cursor.ID,
+		)
+	}
+
+	var logs []model.AuditLog
+	if err := db.Order("created_at DESC, audit_id DESC").Limit(query.Limit + 1).Find(&logs).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	page := &output.AuditLogPage{}
+	if len(logs) > query.Limit {
+		logs = logs[:query.Limit]
+		last := logs[len(logs)-1]
+		page.NextCursor = encodeCursor(auditLogCursor{CreatedAt: last.CreatedAt, ID: last.AuditID})
+	}
+	page.Logs = model.ToEntities[entity.AuditLog, model.AuditLog](logs)
+	return page, nil
+}
+
+// CreatePartition は month を含む月のパーティションを作成します。作成済みなら何もしません
+func (r *auditLogRepository) CreatePartition(ctx context.Context, month time.Time) error {
+	return mapDBError(r.db.WithContext(ctx).
+		Exec("SELECT public.create_audit_log_partition(?::DATE)", month.UTC().Format(time.DateOnly)).Error)
+}
@@ -0,0 +1,132 @@
+package repository_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func setupAuditLogTest(t *testing.T) output.AuditLogRepository {
+	t.Helper()
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	return repository.NewAuditLogRepository(db)
+}
+
+func TestAuditLogRepository_CreateAndList(t *testing.T) {
+	repo := setupAuditLogTest(t)
+	ctx := context.Background()
+
+	actorID := newTestUserID(t)
+	requestID := "req-1"
+	log := &entity.AuditLog{
+		ActorID:    &actorID,
+		ActorRole:  "admin",
+		Action:     constants.AuditActionStoreApprove,
+		TargetType: constants.AuditTargetStore,
+		TargetID:   "store-1",
+		Before:     json.RawMessage(`{"approval_status":"pending"}`),
+		After:      json.RawMessage(`{"approval_status":"approved"}`),
+		RequestID:  &requestID,
+	}
+	require.NoError(t, repo.Create(ctx, log))
+	require.NotZero(t, log.AuditID)
+	require.False(t, log.CreatedAt.IsZero())
+
+	page, err := repo.List(ctx, output.AuditLogQuery{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Logs, 1)
+	require.Empty(t, page.NextCursor)
+
+	found := page.Logs[0]
+	require.Equal(t, actorID, *found.ActorID)
+	require.Equal(t, constants.AuditActionStoreApprove, found.Action)
+	require.JSONEq(t, `{"approval_status":"pending"}`, string(found.Before))
+	require.JSONEq(t, `{"approval_status":"approved"}`, string(found.After))
+	require.Equal(t, requestID, *found.RequestID)
+}
+
+func TestAuditLogRepository_List_Filters(t *testing.T) {
+	repo := setupAuditLogTest(t)
+	ctx := context.Background()
+
+	adminID := newTestUserID(t)
+	ownerID := newTestUserID(t)
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	logs := []*entity.AuditLog{
+		{ActorID: &adminID, ActorRole: "admin", Action: constants.AuditActionStoreApprove, TargetType: constants.AuditTargetStore, TargetID: "store-1", CreatedAt: base},
+		{ActorID: &ownerID, ActorRole: "owner", Action: constants.AuditActionStoreUpdate, TargetType: constants.AuditTargetStore, TargetID: "store-1", CreatedAt: base.AddDate(0, 0, 10)},
+		{ActorID: &adminID, ActorRole: "admin", Action: constants.AuditActionUserSuspend, TargetType: constants.AuditTargetUser, TargetID: ownerID, CreatedAt: base.AddDate(0, 1, 0)},
+	}
+	for _, log := range logs {
+		require.NoError(t, repo.Create(ctx, log))
+	}
+
+	page, err := repo.List(ctx, output.AuditLogQuery{ActorID: &adminID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Logs, 2)
+	require.Equal(t, constants.AuditActionUserSuspend, page.Logs[0].Action)
+
+	targetType, targetID := constants.AuditTargetStore, "store-1"
+	page, err = repo.List(ctx, output.AuditLogQuery{TargetType: &targetType, TargetID: &targetID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Logs, 2)
+
+	action := constants.AuditActionStoreUpdate
+	page, err = repo.List(ctx, output.AuditLogQuery{Action: &action, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Logs, 1)
+	require.Equal(t, ownerID, *page.Logs[0].ActorID)
+
+	// from is inclusive and to is exclusive
+	from, to := base, base.AddDate(0, 1, 0)
+	page, err = repo.List(ctx, output.AuditLogQuery{From: &from, To: &to, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Logs, 2)
+	require.Equal(t, constants.AuditActionStoreUpdate, page.Logs[0].Action)
+}
+
+func TestAuditLogRepository_List_Cursor(t *testing.T) {
+	repo := setupAuditLogTest(t)
+	ctx := context.Background()
+
+	// 同じ時刻のログも ID で順序付けされる
+	createdAt := time.Now().Add(-time.Hour).UTC()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, repo.Create(ctx, &entity.AuditLog{
+			ActorRole:  "admin",
+			Action:     constants.AuditActionStoreVisibility,
+			TargetType: constants.AuditTargetStore,
+			TargetID:   newTestStoreID(t),
+			CreatedAt:  createdAt.Add(time.Duration(i/2) * time.Minute),
+		}))
+	}
+
+	seen := map[int64]bool{}
+	cursor := ""
+	for {
+		page, err := repo.List(ctx, output.AuditLogQuery{Limit: 2, Cursor: cursor})
+		require.NoError(t, err)
+		for _, log := range page.Logs {
+			require.False(t, seen[log.AuditID], "audit log %d returned twice", log.AuditID)
+			seen[log.AuditID] = true
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	require.Len(t, seen, 5)
+
+	_, err := repo.List(ctx, output.AuditLogQuery{Limit: 2, Cursor: "not-a-cursor"})
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+)
+
+// errInvalidCursor is returned when a listing cursor cannot be decoded.
+var errInvalidCursor = apperr.New(apperr.CodeInvalidInput, errors.New("invalid cursor"))
+
+// keysetCursor is implemented by the keyset position types of the listings.
+// valid reports whether a decoded cursor identifies a row.
+type keysetCursor interface {
+	valid() bool
+}
+
+// encodeCursor encodes a keyset position as an opaque URL-safe string.
+func encodeCursor[T keysetCursor](cursor T) string {
+	raw, _ := json.Marshal(cursor) //nolint:errcheck // cursors only contain strings, numbers and times
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor decodes a string made by encodeCursor and returns errInvalidCursor when it is malformed.
+func decodeCursor[T keysetCursor](value string) (T, error) {
+	var zero T
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return zero, errInvalidCursor
+	}
+	var cursor T
+	if err := json.Unmarshal(raw, &cursor); err != nil || !cursor.valid() {
+		return zero, errInvalidCursor
+	}
+	return cursor, nil
+}
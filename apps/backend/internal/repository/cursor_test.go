@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	value := encodeCursor(storeCursor{Sort: "rating", Value: "4.5", ID: "store-1"})
+
+	cursor, err := decodeStoreCursor(value, "rating")
+	require.NoError(t, err)
+	require.Equal(t, storeCursor{Sort: "rating", Value: "4.5", ID: "store-1"}, cursor)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "not base64", value: "!!!"},
+		{name: "not json", value: base64.RawURLEncoding.EncodeToString([]byte("cursor"))},
+		{name: "no id", value: encodeCursor(reportCursor{})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeCursor[reportCursor](tt.value)
+			require.ErrorIs(t, err, errInvalidCursor)
+			require.Equal(t, apperr.CodeInvalidInput, apperr.CodeOf(err))
+		})
+	}
+}
+
+func TestDecodeStoreCursor_RejectsOtherSort(t *testing.T) {
+	value := encodeCursor(storeCursor{Sort: "new", Value: "2024-01-01T00:00:00Z", ID: "store-1"})
+
+	_, err := decodeStoreCursor(value, "rating")
+	require.ErrorIs(t, err, errInvalidCursor)
+}
@@ -18,12 +18,16 @@ func NewFavoriteRepository(db *gorm.DB) output.FavoriteRepository {
 	return &favoriteRepository{db: db}
 }
 
-func (r *favoriteRepository) FindByUserID(ctx context.Context, userID string) ([]entity.Favorite, error) {
+func (r *favoriteRepository) FindByUserID(ctx context.Context, userID string, viewer output.Viewer) ([]entity.Favorite, error) {
 	var favorites []model.Favorite
 	if err := r.db.WithContext(ctx).
 		Preload("Store").
-		Where("user_id = ?", userID).
-		Order("created_at desc").
+		Where("favorites.user_id = ?", userID).
+		Where("EXISTS (?)", visibleStores(
+			r.db.Table("stores").Select("1").Where("stores.store_id = favorites.store_id"),
+			"stores", viewer,
+		)).
+		Order("favorites.created_at desc").
 		Find(&favorites).Error; err != nil {
 		return nil, mapDBError(err)
 	}
@@ -59,3 +63,13 @@ func (r *favoriteRepository) Delete(ctx context.Context, userID string, storeID
 		Where("user_id = ? AND store_id = ?", userID, storeID).
 		Delete(&model.Favorite{}).Error)
 }
+
+func (r *favoriteRepository) DeleteByUserIDInTx(ctx context.Context, tx interface{}, userID string) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	return mapDBError(gormTx.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.Favorite{}).Error)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
@@ -104,24 +105,26 @@ func TestFavoriteRepository_FindByUserID_Success(t *testing.T) {
 
 	// Create two stores with unique IDs
 	store1 := &entity.Store{
-		StoreID:   newTestStoreID(t),
-		Name:      "Store 1",
-		Address:   "Address 1",
-		PlaceID:   "place-" + uuid.New().String()[:8],
-		Latitude:  35.6812,
-		Longitude: 139.7671,
-		Category:  "カフェ・喫茶",
-		Budget:    "$$",
+		StoreID:    newTestStoreID(t),
+		Name:       "Store 1",
+		Address:    "Address 1",
+		PlaceID:    "place-" + uuid.New().String()[:8],
+		Latitude:   35.6812,
+		Longitude:  139.7671,
+		Category:   "カフェ・喫茶",
+		Budget:     "$$",
+		Visibility: constants.VisibilityPublished,
 	}
 	store2 := &entity.Store{
-		StoreID:   newTestStoreID(t),
-		Name:      "Store 2",
-		Address:   "Address 2",
-		PlaceID:   "place-" + uuid.New().String()[:8],
-		Latitude:  35.6813,
-		Longitude: 139.7672,
-		Category:  "カフェ・喫茶",
-		Budget:    "$$",
+		StoreID:    newTestStoreID(t),
+		Name:       "Store 2",
+		Address:    "Address 2",
+		PlaceID:    "place-" + uuid.New().String()[:8],
+		Latitude:   35.6813,
+		Longitude:  139.7672,
+		Category:   "カフェ・喫茶",
+		Budget:     "$$",
+		Visibility: constants.VisibilityPublished,
 	}
 	require.NoError(t, storeRepo.Create(context.Background(), store1))
 	require.NoError(t, storeRepo.Create(context.Background(), store2))
@@ -133,7 +136,7 @@ func TestFavoriteRepository_FindByUserID_Success(t *testing.T) {
 	require.NoError(t, favRepo.Create(context.Background(), favorite2))
 
 	// Find by user ID
-	favorites, err := favRepo.FindByUserID(context.Background(), user.UserID)
+	favorites, err := favRepo.FindByUserID(context.Background(), user.UserID, output.Viewer{})
 	require.NoError(t, err)
 	require.Len(t, favorites, 2)
 }
@@ -142,7 +145,7 @@ func TestFavoriteRepository_FindByUserID_Empty(t *testing.T) {
 	favRepo, _, _ := setupFavoriteTest(t)
 
 	nonexistentUserID := newTestUserID(t)
-	favorites, err := favRepo.FindByUserID(context.Background(), nonexistentUserID)
+	favorites, err := favRepo.FindByUserID(context.Background(), nonexistentUserID, output.Viewer{})
 	require.NoError(t, err)
 	require.Empty(t, favorites)
 }
@@ -192,3 +195,34 @@ func TestFavoriteRepository_Delete_Success(t *testing.T) {
 	_, err = favRepo.FindByUserAndStore(context.Background(), user.UserID, store.StoreID)
 	require.True(t, apperr.IsCode(err, apperr.CodeNotFound), "expected CodeNotFound after deletion, got %v", err)
 }
+
+func TestFavoriteRepository_DeleteByUserIDInTx(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	favRepo := repository.NewFavoriteRepository(db)
+	userRepo := repository.NewUserRepository(db)
+	storeRepo := repository.NewStoreRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	user, store := createTestUserAndStore(t, userRepo, storeRepo)
+	other := &entity.User{UserID: newTestUserID(t), Email: "other@example.com", Role: "user"}
+	require.NoError(t, userRepo.Create(ctx, other))
+	require.NoError(t, favRepo.Create(ctx, &entity.Favorite{UserID: user.UserID, StoreID: store.StoreID}))
+	require.NoError(t, favRepo.Create(ctx, &entity.Favorite{UserID: other.UserID, StoreID: store.StoreID}))
+
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return favRepo.DeleteByUserIDInTx(ctx, txDB, user.UserID)
+	}))
+
+	viewer := output.Viewer{IsAdmin: true}
+	favorites, err := favRepo.FindByUserID(ctx, user.UserID, viewer)
+	require.NoError(t, err)
+	require.Empty(t, favorites)
+	favorites, err = favRepo.FindByUserID(ctx, other.UserID, viewer)
+	require.NoError(t, err)
+	require.Len(t, favorites, 1)
+
+	err = favRepo.DeleteByUserIDInTx(ctx, nil, user.UserID)
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
@@ -26,7 +27,22 @@ func (r *fileRepository) FindByStoreAndIDs(ctx context.Context, storeID string,
 	var files []model.File
 	if err := r.db.WithContext(ctx).
 		Joins("JOIN store_files sf ON sf.file_id = files.file_id").
-		Where("sf.store_id = ? AND files.file_id IN ?", storeID, fileIDs).
+		Where("sf.store_id = ? AND files.file_id IN ? AND files.is_deleted = ?", storeID, fileIDs, false).
+		Find(&files).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return model.ToEntities[entity.File, model.File](files), nil
+}
+
+func (r *fileRepository) FindByCreatorAndIDs(ctx context.Context, userID string, fileIDs []string) ([]entity.File, error) {
+	if len(fileIDs) == 0 {
+		return nil, nil
+	}
+
+	var files []model.File
+	if err := r.db.WithContext(ctx).
+		Where("created_by = ? AND file_id IN ? AND is_deleted = ?", userID, fileIDs, false).
 		Find(&files).Error; err != nil {
 		return nil, mapDBError(err)
 	}
@@ -44,6 +60,7 @@ func (r *fileRepository) Create(ctx context.Context, file *entity.File) error {
 		ContentType: file.ContentType,
 		IsDeleted:   file.IsDeleted,
 		CreatedBy:   file.CreatedBy,
+		UploadedAt:  file.UploadedAt,
 	}
 	if err := r.db.WithContext(ctx).Create(&record).Error; err != nil {
 		return mapDBError(err)
@@ -57,3 +74,188 @@ func (r *fileRepository) LinkToStore(ctx context.Context, storeID string, fileID
 	record := model.StoreFile{StoreID: storeID, FileID: fileID}
 	return mapDBError(r.db.WithContext(ctx).Create(&record).Error)
 }
+
+// FindByCreator はユーザーがアップロードした削除されていないファイルを新しい順に返します
+func (r *fileRepository) FindByCreator(ctx context.Context, userID string) ([]entity.File, error) {
+	var files []model.File
+	if err := r.db.WithContext(ctx).
+		Where("created_by = ? AND is_deleted = ?", userID, false).
+		Order("created_at DESC, file_id ASC").
+		Find(&files).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return model.ToEntities[entity.File, model.File](files), nil
+}
+
+// UnlinkByCreatorInTx はユーザーがアップロードしたファイルを店舗・レビューから外します。
+// ファイル自体は Storage から削除したあとで DeleteByIDsInTx で論理削除する
+func (r *fileRepository) UnlinkByCreatorInTx(ctx context.Context, tx interface{}, userID string) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	db := gormTx.WithContext(ctx)
+
+	uploaded := db.Model(&model.File{}).Select("file_id").Where("created_by = ?", userID)
+	return unlinkFiles(db, uploaded)
+}
+
+func (r *fileRepository) FindByID(ctx context.Context, fileID string) (*entity.File, error) {
+	var file model.File
+	if err := r.db.WithContext(ctx).Where("file_id = ?", fileID).First(&file).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	entityFile := file.Entity()
+	return &entityFile, nil
+}
+
+// MarkUploaded は Storage で確認したサイズと Content-Type を記録し、アップロード済みにします
+func (r *fileRepository) MarkUploaded(ctx context.Context, fileID string, info output.ObjectInfo, uploadedAt time.Time) error {
+	updates := map[string]any{
+		"file_size":   info.Size,
+		"uploaded_at": uploadedAt,
+	}
+	if info.ContentType != "" {
+		updates["content_type"] = info.ContentType
+	}
+	result := r.db.WithContext(ctx).Model(&model.File{}).
+		Where("file_id = ? AND is_deleted = ?", fileID, false).
+		Updates(updates)
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// fileReferencedCondition は files の行が店舗・レビュー・オーナー申請・メニュー・ユーザーのいずれかから参照されている条件
+const fileReferencedCondition = `EXISTS (SELECT 1 FROM store_files ref WHERE ref.file_id = files.file_id)
+	OR EXISTS (SELECT 1 FROM review_files ref WHERE ref.file_id = files.file_id)
+	OR EXISTS (SELECT 1 FROM store_claim_files ref WHERE ref.file_id = files.file_id)
+	OR EXISTS (SELECT 1 FROM stores ref WHERE ref.thumbnail_file_id = files.file_id)
+	OR EXISTS (SELECT 1 FROM store_edits ref WHERE ref.thumbnail_file_id = files.file_id)
+	OR EXISTS (SELECT 1 FROM menus ref WHERE ref.image_file_id = files.file_id)
+	OR EXISTS (SELECT 1 FROM users ref WHERE ref.icon_file_id = files.file_id)`
+
+// FindSweepable は createdBefore より前に作られた削除されていないファイルのうち、
+// アップロードが確認されていないもの、またはどこからも参照されていないものを古い順に返します
+func (r *fileRepository) FindSweepable(ctx context.Context, createdBefore time.Time, limit int) ([]entity.File, error) {
+	var files []model.File
+	if err := r.db.WithContext(ctx).
+		Where("files.is_deleted = ? AND files.created_at < ?", false, createdBefore).
+		Where("files.uploaded_at IS NULL OR NOT (" + fileReferencedCondition + ")").
+		Order("files.created_at ASC, files.file_id ASC").
+		Limit(limit).
+		Find(&files).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return model.ToEntities[entity.File, model.File](files), nil
+}
+
+// DeleteByIDsInTx はファイルを論理削除し、店舗・レビューなどからの参照をすべて外します
+func (r *fileRepository) DeleteByIDsInTx(ctx context.Context, tx interface{}, fileIDs []string) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	if len(fileIDs) == 0 {
+		return nil
+	}
+	db := gormTx.WithContext(ctx)
+
+	if err := unlinkFiles(db, fileIDs); err != nil {
+		return err
+	}
+	for _, column := range []struct {
+		record any
+		name   string
+	}{
+		{&model.StoreEdit{}, "thumbnail_file_id"},
+		{&model.User{}, "icon_file_id"},
+	} {
+		if err := db.Model(column.record).Where(column.name+" IN ?", fileIDs).
+			Update(column.name, nil).Error; err != nil {
+			return mapDBError(err)
+		}
+	}
+	if err := db.Where("file_id IN ?", fileIDs).Delete(&model.StoreClaimFile{}).Error; err != nil {
+		return mapDBError(err)
+	}
+	return mapDBError(db.Model(&model.File{}).
+		Where("file_id IN ?", fileIDs).
+		Update("is_deleted", true).Error)
+}
+
+// unlinkFiles は fileIDs（ID のスライスまたはサブクエリ）のファイルを店舗・レビューから外し、
+// 店舗のサムネイルやメニュー画像に使われていた場合は参照を外します
+func unlinkFiles(db *gorm.DB, fileIDs any) error {
+	for _, relation := range []any{&model.StoreFile{}, &model.ReviewFile{}} {
+		if err := db.Where("file_id IN (?)", fileIDs).Delete(relation).Error; err != nil {
+			return mapDBError(err)
+		}
+	}
+	if err := db.Model(&model.Store{}).Where("thumbnail_file_id IN (?)", fileIDs).
+		Update("thumbnail_file_id", nil).Error; err != nil {
+		return mapDBError(err)
+	}
+	return mapDBError(db.Model(&model.Menu{}).Where("image_file_id IN (?)", fileIDs).
+		Update("image_file_id", nil).Error)
+}
+
+// FindUnprocessedReviewImages はレビューに添付されたアップロード済みのファイルのうち、画像の加工が済んでおらず
+// 失敗が maxAttempts 回未満のものを古い順に返します
+func (r *fileRepository) FindUnprocessedReviewImages(ctx context.Context, maxAttempts, limit int) ([]entity.File, error) {
+	var files []model.File
+	if err := r.db.WithContext(ctx).
+		Where("files.is_deleted = ? AND files.uploaded_at IS NOT NULL AND files.processed_at IS NULL", false).
+		Where("files.processing_attempts < ?", maxAttempts).
+		Where("EXISTS (SELECT 1 FROM review_files ref WHERE ref.file_id = files.file_id)").
+		Order("files.created_at ASC, files.file_id ASC").
+		Limit(limit).
+		Find(&files).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return model.ToEntities[entity.File, model.File](files), nil
+}
+
+// MarkProcessed は画像の加工結果を記録し、加工済みにします。元のファイルを書き換えた場合はサイズと Content-Type も更新する
+func (r *fileRepository) MarkProcessed(ctx context.Context, fileID string, result output.ProcessedFile, processedAt time.Time) error {
+	updates := map[string]any{
+		"variants":     model.EncodeFileVariants(result.Variants),
+		"processed_at": processedAt,
+	}
+	if result.Original != nil {
+		updates["file_size"] = result.Original.Size
+		updates["content_type"] = result.Original.ContentType
+	}
+	res := r.db.WithContext(ctx).Model(&model.File{}).
+		Where("file_id = ? AND is_deleted = ?", fileID, false).
+		Updates(updates)
+	if res.Error != nil {
+		return mapDBError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// RecordProcessingFailure は画像の加工に失敗した回数を1増やします
+func (r *fileRepository) RecordProcessingFailure(ctx context.Context, fileID string) error {
+	res := r.db.WithContext(ctx).Model(&model.File{}).
+		Where("file_id = ? AND is_deleted = ?", fileID, false).
+		Update("processing_attempts", gorm.Expr("processing_attempts + 1"))
+	if res.Error != nil {
+		return mapDBError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
+	return nil
+}
@@ -170,6 +170,51 @@ func TestFileRepository_FindByStoreAndIDs_Success(t *testing.T) {
 	require.Len(t, files, 2)
 }
 
+// TestFileRepository_FindByStoreAndIDs_ExcludesDeleted tests that soft-deleted files cannot be attached again
+func TestFileRepository_FindByStoreAndIDs_ExcludesDeleted(t *testing.T) {
+	fileRepo, storeRepo, userRepo := setupFileTest(t)
+	ctx := context.Background()
+
+	user := newTestFileUser(t)
+	require.NoError(t, userRepo.Create(ctx, user))
+	store := newTestFileStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	active := newTestFileEntity(t, &user.UserID)
+	deleted := newTestFileEntity(t, &user.UserID, func(f *entity.File) { f.IsDeleted = true })
+	for _, f := range []*entity.File{active, deleted} {
+		require.NoError(t, fileRepo.Create(ctx, f))
+		require.NoError(t, fileRepo.LinkToStore(ctx, store.StoreID, f.FileID))
+	}
+
+	files, err := fileRepo.FindByStoreAndIDs(ctx, store.StoreID, []string{active.FileID, deleted.FileID})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, active.FileID, files[0].FileID)
+}
+
+// TestFileRepository_FindByCreatorAndIDs_OnlyOwnFiles tests that files uploaded by other users are excluded
+func TestFileRepository_FindByCreatorAndIDs_OnlyOwnFiles(t *testing.T) {
+	fileRepo, _, userRepo := setupFileTest(t)
+
+	owner := newTestFileUser(t)
+	other := newTestFileUser(t)
+	require.NoError(t, userRepo.Create(context.Background(), owner))
+	require.NoError(t, userRepo.Create(context.Background(), other))
+
+	own := newTestFileEntity(t, &owner.UserID)
+	foreign := newTestFileEntity(t, &other.UserID)
+	deleted := newTestFileEntity(t, &owner.UserID, func(f *entity.File) { f.IsDeleted = true })
+	require.NoError(t, fileRepo.Create(context.Background(), own))
+	require.NoError(t, fileRepo.Create(context.Background(), foreign))
+	require.NoError(t, fileRepo.Create(context.Background(), deleted))
+
+	files, err := fileRepo.FindByCreatorAndIDs(context.Background(), owner.UserID, []string{own.FileID, foreign.FileID, deleted.FileID})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, own.FileID, files[0].FileID)
+}
+
 // TestFileRepository_FindByStoreAndIDs_EmptyIDs tests finding files with empty ID list
 func TestFileRepository_FindByStoreAndIDs_EmptyIDs(t *testing.T) {
 	fileRepo, storeRepo, _ := setupFileTest(t)
@@ -291,3 +336,176 @@ func TestFileRepository_Create_DifferentFileKinds(t *testing.T) {
 	err = fileRepo.Create(context.Background(), thumbnailFile)
 	require.NoError(t, err)
 }
+
+// TestFileRepository_MarkUploaded_RecordsObjectInfo tests recording the uploaded object
+func TestFileRepository_MarkUploaded_RecordsObjectInfo(t *testing.T) {
+	fileRepo, _, _ := setupFileTest(t)
+	ctx := context.Background()
+
+	file := newTestFileEntity(t, nil)
+	require.NoError(t, fileRepo.Create(ctx, file))
+
+	uploadedAt := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, fileRepo.MarkUploaded(ctx, file.FileID, output.ObjectInfo{Size: 2048, ContentType: "image/png"}, uploadedAt))
+
+	found, err := fileRepo.FindByID(ctx, file.FileID)
+	require.NoError(t, err)
+	require.NotNil(t, found.UploadedAt)
+	require.True(t, found.UploadedAt.Equal(uploadedAt))
+	require.Equal(t, int64(2048), *found.FileSize)
+	require.Equal(t, "image/png", *found.ContentType)
+}
+
+// TestFileRepository_MarkUploaded_DeletedFile tests that deleted files cannot be confirmed
+func TestFileRepository_MarkUploaded_DeletedFile(t *testing.T) {
+	fileRepo, _, _ := setupFileTest(t)
+	ctx := context.Background()
+
+	file := newTestFileEntity(t, nil, func(f *entity.File) { f.IsDeleted = true })
+	require.NoError(t, fileRepo.Create(ctx, file))
+
+	err := fileRepo.MarkUploaded(ctx, file.FileID, output.ObjectInfo{Size: 1}, time.Now())
+	require.ErrorIs(t, err, entity.ErrNotFound)
+}
+
+// TestFileRepository_FindSweepable_UnconfirmedOrUnreferenced tests which files are picked up by the sweeper
+func TestFileRepository_FindSweepable_UnconfirmedOrUnreferenced(t *testing.T) {
+	fileRepo, storeRepo, _ := setupFileTest(t)
+	ctx := context.Background()
+
+	store := newTestFileStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	uploadedAt := time.Now()
+	unconfirmed := newTestFileEntity(t, nil)
+	unreferenced := newTestFileEntity(t, nil, func(f *entity.File) { f.UploadedAt = &uploadedAt })
+	referenced := newTestFileEntity(t, nil, func(f *entity.File) { f.UploadedAt = &uploadedAt })
+	deleted := newTestFileEntity(t, nil, func(f *entity.File) { f.IsDeleted = true })
+	for _, f := range []*entity.File{unconfirmed, unreferenced, referenced, deleted} {
+		require.NoError(t, fileRepo.Create(ctx, f))
+	}
+	require.NoError(t, fileRepo.LinkToStore(ctx, store.StoreID, referenced.FileID))
+
+	files, err := fileRepo.FindSweepable(ctx, time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(files))
+	for _, f := range files {
+		ids = append(ids, f.FileID)
+	}
+	require.ElementsMatch(t, []string{unconfirmed.FileID, unreferenced.FileID}, ids)
+
+	recent, err := fileRepo.FindSweepable(ctx, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	require.Empty(t, recent)
+}
+
+// TestFileRepository_DeleteByIDsInTx_UnlinksAndSoftDeletes tests deleting files swept from storage
+func TestFileRepository_DeleteByIDsInTx_UnlinksAndSoftDeletes(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	fileRepo := repository.NewFileRepository(db)
+	ctx := context.Background()
+
+	store := newTestFileStore(t)
+	require.NoError(t, repository.NewStoreRepository(db).Create(ctx, store))
+	file := newTestFileEntity(t, nil)
+	require.NoError(t, fileRepo.Create(ctx, file))
+	require.NoError(t, fileRepo.LinkToStore(ctx, store.StoreID, file.FileID))
+
+	require.NoError(t, fileRepo.DeleteByIDsInTx(ctx, db, []string{file.FileID}))
+
+	found, err := fileRepo.FindByID(ctx, file.FileID)
+	require.NoError(t, err)
+	require.True(t, found.IsDeleted)
+	var links int64
+	require.NoError(t, db.Table("store_files").Where("file_id = ?", file.FileID).Count(&links).Error)
+	require.Zero(t, links)
+}
+
+// TestFileRepository_FindUnprocessedReviewImages_OnlyUploadedReviewFiles tests which files are picked up for image processing
+func TestFileRepository_FindUnprocessedReviewImages_OnlyUploadedReviewFiles(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	fileRepo := repository.NewFileRepository(db)
+	ctx := context.Background()
+
+	uploadedAt := time.Now()
+	processedAt := time.Now()
+	pending := newTestFileEntity(t, nil, func(f *entity.File) { f.UploadedAt = &uploadedAt })
+	unconfirmed := newTestFileEntity(t, nil)
+	processed := newTestFileEntity(t, nil, func(f *entity.File) { f.UploadedAt = &uploadedAt })
+	unreferenced := newTestFileEntity(t, nil, func(f *entity.File) { f.UploadedAt = &uploadedAt })
+	for _, f := range []*entity.File{pending, unconfirmed, processed, unreferenced} {
+		require.NoError(t, fileRepo.Create(ctx, f))
+	}
+	for _, f := range []*entity.File{pending, unconfirmed, processed} {
+		require.NoError(t, db.Table("review_files").Create(map[string]any{
+			"review_id": uuid.New().String(), "file_id": f.FileID, "created_at": time.Now(),
+		}).Error)
+	}
+	require.NoError(t, fileRepo.MarkProcessed(ctx, processed.FileID, output.ProcessedFile{}, processedAt))
+
+	files, err := fileRepo.FindUnprocessedReviewImages(ctx, 3, 10)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, pending.FileID, files[0].FileID)
+}
+
+// TestFileRepository_RecordProcessingFailure_StopsPickingFile tests that files failing too many times are not picked up again
+func TestFileRepository_RecordProcessingFailure_StopsPickingFile(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	fileRepo := repository.NewFileRepository(db)
+	ctx := context.Background()
+
+	uploadedAt := time.Now()
+	file := newTestFileEntity(t, nil, func(f *entity.File) { f.UploadedAt = &uploadedAt })
+	require.NoError(t, fileRepo.Create(ctx, file))
+	require.NoError(t, db.Table("review_files").Create(map[string]any{
+		"review_id": uuid.New().String(), "file_id": file.FileID, "created_at": time.Now(),
+	}).Error)
+
+	require.NoError(t, fileRepo.RecordProcessingFailure(ctx, file.FileID))
+	files, err := fileRepo.FindUnprocessedReviewImages(ctx, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, 1, files[0].ProcessingAttempts)
+
+	require.NoError(t, fileRepo.RecordProcessingFailure(ctx, file.FileID))
+	files, err = fileRepo.FindUnprocessedReviewImages(ctx, 2, 10)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	err = fileRepo.RecordProcessingFailure(ctx, uuid.New().String())
+	require.ErrorIs(t, err, entity.ErrNotFound)
+}
+
+// TestFileRepository_MarkProcessed_RecordsVariants tests recording the result of image processing
+func TestFileRepository_MarkProcessed_RecordsVariants(t *testing.T) {
+	fileRepo, _, _ := setupFileTest(t)
+	ctx := context.Background()
+
+	file := newTestFileEntity(t, nil)
+	require.NoError(t, fileRepo.Create(ctx, file))
+
+	variants := []entity.FileVariant{
+		{Width: 200, Height: 150, Format: "jpeg", ContentType: "image/jpeg", ObjectKey: file.ObjectKey + "_200w.jpeg", FileSize: 100},
+		{Width: 200, Height: 150, Format: "webp", ContentType: "image/webp", ObjectKey: file.ObjectKey + "_200w.webp", FileSize: 80},
+	}
+	err := fileRepo.MarkProcessed(ctx, file.FileID, output.ProcessedFile{
+		Original: &output.ObjectInfo{Size: 500, ContentType: "image/jpeg"},
+		Variants: variants,
+	}, time.Now())
+	require.NoError(t, err)
+
+	found, err := fileRepo.FindByID(ctx, file.FileID)
+	require.NoError(t, err)
+	require.NotNil(t, found.ProcessedAt)
+	require.Equal(t, variants, found.Variants)
+	require.NotNil(t, found.FileSize)
+	require.Equal(t, int64(500), *found.FileSize)
+	require.Equal(t, []string{file.ObjectKey, variants[0].ObjectKey, variants[1].ObjectKey}, found.ObjectKeys())
+
+	err = fileRepo.MarkProcessed(ctx, uuid.New().String(), output.ProcessedFile{}, time.Now())
+	require.ErrorIs(t, err, entity.ErrNotFound)
+}
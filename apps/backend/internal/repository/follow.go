@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type followRepository struct {
+	db *gorm.DB
+}
+
+// NewFollowRepository は FollowRepository の実装を生成します
+func NewFollowRepository(db *gorm.DB) output.FollowRepository {
+	return &followRepository{db: db}
+}
+
+// followCursor is the keyset position of the last follow on a page.
+// ID is the user shown in the listing.
+type followCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"id"`
+}
+
+func (cursor followCursor) valid() bool {
+	return cursor.ID != ""
+}
+
+func (r *followRepository) Create(ctx context.Context, followerID string, followeeID string) error {
+	record := model.UserFollow{FollowerID: followerID, FolloweeID: followeeID, CreatedAt: time.Now()}
+	return mapDBError(r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&record).Error)
+}
+
+func (r *followRepository) Delete(ctx context.Context, followerID string, followeeID string) error {
+	return mapDBError(r.db.WithContext(ctx).
+		Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
+		Delete(&model.UserFollow{}).Error)
+}
+
+// ListFollowers はユーザーをフォローしているユーザーを、フォローされた新しい順に返します
+func (r *followRepository) ListFollowers(ctx context.Context, userID string, query output.FollowListQuery) (*output.FollowPage, error) {
+	return r.list(ctx, "followee_id", "follower_id", "Follower", userID, query)
+}
+
+// ListFollowing はユーザーがフォローしているユーザーを、フォローした新しい順に返します
+func (r *followRepository) ListFollowing(ctx context.Context, userID string, query output.FollowListQuery) (*output.FollowPage, error) {
+	return r.list(ctx, "follower_id", "followee_id", "Followee", userID, query)
+}
+
+// list は userColumn が userID のフォロー関係を、otherColumn 側のユーザーを読み込んで返します
+func (r *followRepository) list(
+	ctx context.Context,
+	userColumn, otherColumn, association string,
+	userID string,
+	query output.FollowListQuery,
+) (*output.FollowPage, error) {
+	if query.Limit <= 0 {
+		query.Limit = constants.DefaultFollowListLimit
+	}
+
+	db := r.db.WithContext(ctx).
+		Preload(association).
+		Where(fmt.Sprintf("user_follows.%s = ?", userColumn), userID).
+		Where(fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM users fu WHERE fu.user_id = user_follows.%s AND fu.deleted_at IS NULL)", otherColumn,
+		))
+	if query.Cursor != "" {
+		cursor, err := decodeCursor[followCursor](query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where(
+			fmt.Sprintf("user_follows.created_at < ? OR (user_follows.created_at = ? AND user_follows.%s < ?)", otherColumn),
+			cursor.CreatedAt, cursor.CreatedAt, cursor.ID,
+		)
+	}
+
+	var follows []model.UserFollow
+	if err := db.
+		Order(fmt.Sprintf("user_follows.created_at DESC, user_follows.%s DESC", otherColumn)).
+		Limit(query.Limit + 1).
+		Find(&follows).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	page := &output.FollowPage{}
+	if len(follows) > query.Limit {
+		follows = follows[:query.Limit]
+		last := follows[len(follows)-1]
+		otherID := last.FollowerID
+		if otherColumn == "followee_id" {
+			otherID = last.FolloweeID
+		}
+		page.NextCursor = encodeCursor(followCursor{CreatedAt: last.CreatedAt, ID: otherID})
+	}
+	page.Follows = model.ToEntities[entity.Follow, model.UserFollow](follows)
+	return page, nil
+}
+
+func (r *followRepository) DeleteByUserIDInTx(ctx context.Context, tx interface{}, userID string) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	return mapDBError(gormTx.WithContext(ctx).
+		Where("follower_id = ? OR followee_id = ?", userID, userID).
+		Delete(&model.UserFollow{}).Error)
+}
@@ -0,0 +1,127 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// setupFollowTest creates a follow repository and the given number of users
+func setupFollowTest(t *testing.T, userCount int) (*gorm.DB, output.FollowRepository, []*entity.User) {
+	t.Helper()
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	userRepo := repository.NewUserRepository(db)
+	users := make([]*entity.User, userCount)
+	for i := range users {
+		users[i] = newTestReviewUser(t)
+		require.NoError(t, userRepo.Create(context.Background(), users[i]))
+	}
+	return db, repository.NewFollowRepository(db), users
+}
+
+func followedUserIDs(follows []entity.Follow) []string {
+	ids := make([]string, 0, len(follows))
+	for _, follow := range follows {
+		ids = append(ids, follow.User.UserID)
+	}
+	return ids
+}
+
+func TestFollowRepository_CreateIsIdempotent(t *testing.T) {
+	db, followRepo, users := setupFollowTest(t, 2)
+	ctx := context.Background()
+
+	require.NoError(t, followRepo.Create(ctx, users[0].UserID, users[1].UserID))
+	require.NoError(t, followRepo.Create(ctx, users[0].UserID, users[1].UserID))
+
+	var count int64
+	require.NoError(t, db.Table("user_follows").Count(&count).Error)
+	require.EqualValues(t, 1, count)
+
+	require.NoError(t, followRepo.Delete(ctx, users[0].UserID, users[1].UserID))
+	require.NoError(t, followRepo.Delete(ctx, users[0].UserID, users[1].UserID))
+	require.NoError(t, db.Table("user_follows").Count(&count).Error)
+	require.Zero(t, count)
+}
+
+func TestFollowRepository_ListFollowersAndFollowing(t *testing.T) {
+	db, followRepo, users := setupFollowTest(t, 4)
+	ctx := context.Background()
+	target := users[0]
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, follower := range users[1:] {
+		require.NoError(t, followRepo.Create(ctx, follower.UserID, target.UserID))
+		require.NoError(t, db.Exec(
+			"UPDATE user_follows SET created_at = ? WHERE follower_id = ?", base.Add(time.Duration(i)*time.Hour), follower.UserID,
+		).Error)
+	}
+	require.NoError(t, followRepo.Create(ctx, target.UserID, users[1].UserID))
+
+	page, err := followRepo.ListFollowers(ctx, target.UserID, output.FollowListQuery{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{users[3].UserID, users[2].UserID}, followedUserIDs(page.Follows))
+	require.NotEmpty(t, page.NextCursor)
+
+	page, err = followRepo.ListFollowers(ctx, target.UserID, output.FollowListQuery{Limit: 2, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Equal(t, []string{users[1].UserID}, followedUserIDs(page.Follows))
+	require.Empty(t, page.NextCursor)
+
+	page, err = followRepo.ListFollowing(ctx, target.UserID, output.FollowListQuery{Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []string{users[1].UserID}, followedUserIDs(page.Follows))
+}
+
+func TestFollowRepository_ListSkipsDeletedUsers(t *testing.T) {
+	db, followRepo, users := setupFollowTest(t, 3)
+	ctx := context.Background()
+
+	require.NoError(t, followRepo.Create(ctx, users[1].UserID, users[0].UserID))
+	require.NoError(t, followRepo.Create(ctx, users[2].UserID, users[0].UserID))
+	require.NoError(t, db.Exec("UPDATE users SET deleted_at = ? WHERE user_id = ?", time.Now(), users[2].UserID).Error)
+
+	page, err := followRepo.ListFollowers(ctx, users[0].UserID, output.FollowListQuery{Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []string{users[1].UserID}, followedUserIDs(page.Follows))
+}
+
+func TestFollowRepository_ListInvalidCursor(t *testing.T) {
+	_, followRepo, users := setupFollowTest(t, 1)
+
+	_, err := followRepo.ListFollowing(context.Background(), users[0].UserID, output.FollowListQuery{Cursor: "not-a-cursor"})
+	require.Equal(t, apperr.CodeInvalidInput, apperr.CodeOf(err))
+}
+
+func TestFollowRepository_DeleteByUserIDInTx(t *testing.T) {
+	db, followRepo, users := setupFollowTest(t, 3)
+	ctx := context.Background()
+
+	require.NoError(t, followRepo.Create(ctx, users[0].UserID, users[1].UserID))
+	require.NoError(t, followRepo.Create(ctx, users[2].UserID, users[0].UserID))
+	require.NoError(t, followRepo.Create(ctx, users[1].UserID, users[2].UserID))
+
+	txManager := repository.NewGormTransaction(db)
+	require.NoError(t, txManager.StartTransaction(func(tx interface{}) error {
+		return followRepo.DeleteByUserIDInTx(ctx, tx, users[0].UserID)
+	}))
+
+	var count int64
+	require.NoError(t, db.Table("user_follows").Count(&count).Error)
+	require.EqualValues(t, 1, count)
+
+	require.ErrorIs(t, followRepo.DeleteByUserIDInTx(ctx, nil, users[0].UserID), output.ErrInvalidTransaction)
+}
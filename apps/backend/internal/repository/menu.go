@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
@@ -18,32 +19,173 @@ func NewMenuRepository(db *gorm.DB) output.MenuRepository {
 	return &menuRepository{db: db}
 }
 
+// withMenuPreload adds the photo and dietary tags of a menu.
+func withMenuPreload(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("ImageFile").
+		Preload("DietaryTags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("tag asc")
+		})
+}
+
+// activeMenus narrows a menu query to menus that have not been deleted, in display order.
+func activeMenus(db *gorm.DB) *gorm.DB {
+	return db.Where("deleted_at IS NULL").Order("sort_order asc, created_at desc")
+}
+
 func (r *menuRepository) FindByStoreID(ctx context.Context, storeID string) ([]entity.Menu, error) {
 	var menus []model.Menu
-	if err := r.db.WithContext(ctx).
+	if err := activeMenus(withMenuPreload(r.db.WithContext(ctx))).
 		Where("store_id = ?", storeID).
-		Order("created_at desc").
 		Find(&menus).Error; err != nil {
 		return nil, mapDBError(err)
 	}
 	return model.ToEntities[entity.Menu, model.Menu](menus), nil
 }
 
-func (r *menuRepository) Create(ctx context.Context, menu *entity.Menu) error {
+// FindByID は削除されていないメニューを返します
+func (r *menuRepository) FindByID(ctx context.Context, menuID string) (*entity.Menu, error) {
+	var menu model.Menu
+	if err := withMenuPreload(r.db.WithContext(ctx)).
+		Where("menu_id = ? AND deleted_at IS NULL", menuID).
+		First(&menu).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	e := menu.Entity()
+	return &e, nil
+}
+
+// CreateInTx はメニューを登録します。新しいメニューは一覧の先頭に表示されます
+func (r *menuRepository) CreateInTx(ctx context.Context, tx interface{}, menu *entity.Menu) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	db := txAsserted.WithContext(ctx)
+
+	var sortOrder int
+	if err := db.Model(&model.Menu{}).
+		Select("COALESCE(MIN(sort_order), 0) - 1").
+		Where("store_id = ? AND deleted_at IS NULL", menu.StoreID).
+		Scan(&sortOrder).Error; err != nil {
+		return mapDBError(err)
+	}
+
 	record := model.Menu{
 		StoreID:     menu.StoreID,
 		Name:        menu.Name,
 		Description: menu.Description,
 		Price:       menu.Price,
+		Section:     menu.Section,
+		IsAvailable: menu.IsAvailable,
+		SortOrder:   sortOrder,
+		ImageFileID: menu.ImageFileID,
 	}
-	if err := r.db.WithContext(ctx).Create(&record).Error; err != nil {
+	if err := db.Create(&record).Error; err != nil {
 		return mapDBError(err)
 	}
+	if err := linkMenuDietaryTags(db, record.MenuID, menu.DietaryTags); err != nil {
+		return err
+	}
 	menu.MenuID = record.MenuID
+	menu.SortOrder = record.SortOrder
 	menu.CreatedAt = record.CreatedAt
+	menu.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+// UpdateInTx はメニューの編集可能な項目とタグを置き換えます
+func (r *menuRepository) UpdateInTx(ctx context.Context, tx interface{}, menu *entity.Menu) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	db := txAsserted.WithContext(ctx)
+
+	now := time.Now()
+	result := db.Model(&model.Menu{}).
+		Where("menu_id = ? AND deleted_at IS NULL", menu.MenuID).
+		Updates(map[string]any{
+			"name":          menu.Name,
+			"price":         menu.Price,
+			"description":   menu.Description,
+			"section":       menu.Section,
+			"is_available":  menu.IsAvailable,
+			"image_file_id": menu.ImageFileID,
+			"updated_at":    now,
+		})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
+
+	if err := db.Where("menu_id = ?", menu.MenuID).Delete(&model.MenuDietaryTag{}).Error; err != nil {
+		return mapDBError(err)
+	}
+	if err := linkMenuDietaryTags(db, menu.MenuID, menu.DietaryTags); err != nil {
+		return err
+	}
+	menu.UpdatedAt = now
+	return nil
+}
+
+// ReorderInTx は menuIDs の並び順どおりに sort_order を振り直します
+func (r *menuRepository) ReorderInTx(ctx context.Context, tx interface{}, storeID string, menuIDs []string) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	db := txAsserted.WithContext(ctx)
+
+	for i, menuID := range menuIDs {
+		result := db.Model(&model.Menu{}).
+			Where("menu_id = ? AND store_id = ? AND deleted_at IS NULL", menuID, storeID).
+			UpdateColumn("sort_order", i)
+		if result.Error != nil {
+			return mapDBError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return mapDBError(gorm.ErrRecordNotFound)
+		}
+	}
 	return nil
 }
 
+// Delete はメニューを論理削除します。review_menus の参照は残るため、既存レビューには引き続き表示されます
+func (r *menuRepository) Delete(ctx context.Context, menuID string) error {
+	return softDeleteMenu(r.db.WithContext(ctx), menuID)
+}
+
+// DeleteInTx は Delete と同じ論理削除をトランザクション内で行います
+func (r *menuRepository) DeleteInTx(ctx context.Context, tx interface{}, menuID string) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	return softDeleteMenu(txAsserted.WithContext(ctx), menuID)
+}
+
+func softDeleteMenu(db *gorm.DB, menuID string) error {
+	now := time.Now()
+	result := db.
+		Model(&model.Menu{}).
+		Where("menu_id = ? AND deleted_at IS NULL", menuID).
+		UpdateColumns(map[string]any{
+			"deleted_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// FindByStoreAndIDs は削除されていないメニューのみを返すため、削除済みメニューを新しいレビューに紐付けることはできません
 func (r *menuRepository) FindByStoreAndIDs(ctx context.Context, storeID string, menuIDs []string) ([]entity.Menu, error) {
 	if len(menuIDs) == 0 {
 		return nil, nil
@@ -51,10 +193,24 @@ func (r *menuRepository) FindByStoreAndIDs(ctx context.Context, storeID string,
 
 	var menus []model.Menu
 	if err := r.db.WithContext(ctx).
-		Where("store_id = ? AND menu_id IN ?", storeID, menuIDs).
+		Where("store_id = ? AND menu_id IN ? AND deleted_at IS NULL", storeID, menuIDs).
 		Find(&menus).Error; err != nil {
 		return nil, mapDBError(err)
 	}
 
 	return model.ToEntities[entity.Menu, model.Menu](menus), nil
 }
+
+func linkMenuDietaryTags(db *gorm.DB, menuID string, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	rows := make([]model.MenuDietaryTag, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, model.MenuDietaryTag{
+			MenuID: menuID,
+			Tag:    tag,
+		})
+	}
+	return mapDBError(db.Create(&rows).Error)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
@@ -34,16 +35,17 @@ func setupMenuTest(t *testing.T) (*gorm.DB, output.MenuRepository, output.StoreR
 func newTestMenuStore(t *testing.T) *entity.Store {
 	t.Helper()
 	return &entity.Store{
-		StoreID:   "store-" + uuid.New().String()[:8],
-		Name:      "Test Store for Menu",
-		Address:   "Test Address",
-		Latitude:  35.6812,
-		Longitude: 139.7671,
-		PlaceID:   "place-" + uuid.New().String()[:8],
-		Category:  "カフェ・喫茶",
-		Budget:    "$$",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		StoreID:    "store-" + uuid.New().String()[:8],
+		Name:       "Test Store for Menu",
+		Address:    "Test Address",
+		Latitude:   35.6812,
+		Longitude:  139.7671,
+		PlaceID:    "place-" + uuid.New().String()[:8],
+		Category:   "カフェ・喫茶",
+		Budget:     "$$",
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Visibility: constants.VisibilityPublished,
 	}
 }
 
@@ -76,7 +78,7 @@ func TestMenuRepository_Create_Success(t *testing.T) {
 	// Create menu - note: SQLite doesn't auto-generate UUIDs like PostgreSQL,
 	// so MenuID won't be populated. This test verifies Create doesn't error.
 	menu := newTestMenuEntity(t, store.StoreID)
-	err := menuRepo.Create(context.Background(), menu)
+	err := menuRepo.CreateInTx(context.Background(), db, menu)
 	require.NoError(t, err)
 
 	// Verify the menu was created by checking DB directly
@@ -98,7 +100,7 @@ func TestMenuRepository_Create_WithNilPrice(t *testing.T) {
 	menu := newTestMenuEntity(t, store.StoreID, func(m *entity.Menu) {
 		m.Price = nil
 	})
-	err := menuRepo.Create(context.Background(), menu)
+	err := menuRepo.CreateInTx(context.Background(), db, menu)
 	require.NoError(t, err)
 
 	// Verify the menu was created by checking DB directly
@@ -110,7 +112,7 @@ func TestMenuRepository_Create_WithNilPrice(t *testing.T) {
 
 // TestMenuRepository_FindByStoreID_Success tests finding menus by store ID
 func TestMenuRepository_FindByStoreID_Success(t *testing.T) {
-	_, menuRepo, storeRepo := setupMenuTest(t)
+	db, menuRepo, storeRepo := setupMenuTest(t)
 
 	// Create store
 	store := newTestMenuStore(t)
@@ -123,8 +125,8 @@ func TestMenuRepository_FindByStoreID_Success(t *testing.T) {
 	menu2 := newTestMenuEntity(t, store.StoreID, func(m *entity.Menu) {
 		m.Name = "Menu 2"
 	})
-	require.NoError(t, menuRepo.Create(context.Background(), menu1))
-	require.NoError(t, menuRepo.Create(context.Background(), menu2))
+	require.NoError(t, menuRepo.CreateInTx(context.Background(), db, menu1))
+	require.NoError(t, menuRepo.CreateInTx(context.Background(), db, menu2))
 
 	// Find by store ID
 	menus, err := menuRepo.FindByStoreID(context.Background(), store.StoreID)
@@ -148,7 +150,7 @@ func TestMenuRepository_FindByStoreID_Empty(t *testing.T) {
 
 // TestMenuRepository_FindByStoreID_OrderByCreatedAtDesc tests menus are ordered by created_at desc
 func TestMenuRepository_FindByStoreID_OrderByCreatedAtDesc(t *testing.T) {
-	_, menuRepo, storeRepo := setupMenuTest(t)
+	db, menuRepo, storeRepo := setupMenuTest(t)
 
 	// Create store
 	store := newTestMenuStore(t)
@@ -158,12 +160,12 @@ func TestMenuRepository_FindByStoreID_OrderByCreatedAtDesc(t *testing.T) {
 	menu1 := newTestMenuEntity(t, store.StoreID, func(m *entity.Menu) {
 		m.Name = "First Menu"
 	})
-	require.NoError(t, menuRepo.Create(context.Background(), menu1))
+	require.NoError(t, menuRepo.CreateInTx(context.Background(), db, menu1))
 
 	menu2 := newTestMenuEntity(t, store.StoreID, func(m *entity.Menu) {
 		m.Name = "Second Menu"
 	})
-	require.NoError(t, menuRepo.Create(context.Background(), menu2))
+	require.NoError(t, menuRepo.CreateInTx(context.Background(), db, menu2))
 
 	// Find by store ID - should be ordered by created_at desc (newest first)
 	menus, err := menuRepo.FindByStoreID(context.Background(), store.StoreID)
@@ -256,3 +258,155 @@ func TestMenuRepository_FindByStoreAndIDs_PartialMatch(t *testing.T) {
 	require.Len(t, menus, 1)
 	require.Equal(t, menuID, menus[0].MenuID)
 }
+
+// insertMenuDirectly inserts a menu with a known ID and display position
+func insertMenuDirectly(t *testing.T, db *gorm.DB, storeID, name string, sortOrder int) string {
+	t.Helper()
+	menuID := "menu-" + uuid.New().String()[:8]
+	require.NoError(t, db.Exec(
+		"INSERT INTO menus (menu_id, store_id, name, sort_order, is_available, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		menuID, storeID, name, sortOrder, true, time.Now(), time.Now()).Error)
+	return menuID
+}
+
+// TestMenuRepository_FindByStoreID_OrderBySortOrder tests menus follow sort_order and exclude deleted ones
+func TestMenuRepository_FindByStoreID_OrderBySortOrder(t *testing.T) {
+	db, menuRepo, storeRepo := setupMenuTest(t)
+
+	store := newTestMenuStore(t)
+	require.NoError(t, storeRepo.Create(context.Background(), store))
+
+	second := insertMenuDirectly(t, db, store.StoreID, "Second", 1)
+	first := insertMenuDirectly(t, db, store.StoreID, "First", 0)
+	deleted := insertMenuDirectly(t, db, store.StoreID, "Deleted", 2)
+	require.NoError(t, menuRepo.Delete(context.Background(), deleted))
+
+	menus, err := menuRepo.FindByStoreID(context.Background(), store.StoreID)
+	require.NoError(t, err)
+	require.Len(t, menus, 2)
+	require.Equal(t, first, menus[0].MenuID)
+	require.Equal(t, second, menus[1].MenuID)
+}
+
+// TestMenuRepository_CreateInTx_PrependsAndStoresTags tests a new menu is listed first with its tags
+func TestMenuRepository_CreateInTx_PrependsAndStoresTags(t *testing.T) {
+	db, menuRepo, storeRepo := setupMenuTest(t)
+
+	store := newTestMenuStore(t)
+	require.NoError(t, storeRepo.Create(context.Background(), store))
+	insertMenuDirectly(t, db, store.StoreID, "Existing", 0)
+
+	section := "drink"
+	menu := newTestMenuEntity(t, store.StoreID, func(m *entity.Menu) {
+		m.Section = &section
+		m.IsAvailable = false
+		m.DietaryTags = []string{"vegan", "contains_egg"}
+	})
+	require.NoError(t, menuRepo.CreateInTx(context.Background(), db, menu))
+	require.Equal(t, -1, menu.SortOrder)
+
+	var stored struct {
+		MenuID      string
+		Section     *string
+		IsAvailable bool
+	}
+	require.NoError(t, db.Table("menus").Where("name = ?", menu.Name).Take(&stored).Error)
+	require.NotNil(t, stored.Section)
+	require.Equal(t, section, *stored.Section)
+	require.False(t, stored.IsAvailable, "is_available=false must not fall back to the column default")
+
+	var tagCount int64
+	require.NoError(t, db.Table("menu_dietary_tags").Count(&tagCount).Error)
+	require.Equal(t, int64(2), tagCount)
+}
+
+// TestMenuRepository_UpdateInTx_ReplacesFieldsAndTags tests updating a menu
+func TestMenuRepository_UpdateInTx_ReplacesFieldsAndTags(t *testing.T) {
+	db, menuRepo, storeRepo := setupMenuTest(t)
+
+	store := newTestMenuStore(t)
+	require.NoError(t, storeRepo.Create(context.Background(), store))
+	menuID := insertMenuDirectly(t, db, store.StoreID, "Toast", 0)
+	require.NoError(t, db.Exec("INSERT INTO menu_dietary_tags (menu_id, tag) VALUES (?, ?)", menuID, "contains_wheat").Error)
+
+	menu, err := menuRepo.FindByID(context.Background(), menuID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"contains_wheat"}, menu.DietaryTags)
+
+	menu.Name = "French Toast"
+	menu.IsAvailable = false
+	menu.DietaryTags = []string{"contains_egg", "contains_milk"}
+	require.NoError(t, menuRepo.UpdateInTx(context.Background(), db, menu))
+
+	found, err := menuRepo.FindByID(context.Background(), menuID)
+	require.NoError(t, err)
+	require.Equal(t, "French Toast", found.Name)
+	require.False(t, found.IsAvailable)
+	require.Equal(t, []string{"contains_egg", "contains_milk"}, found.DietaryTags)
+}
+
+// TestMenuRepository_ReorderInTx tests rewriting the display order
+func TestMenuRepository_ReorderInTx(t *testing.T) {
+	db, menuRepo, storeRepo := setupMenuTest(t)
+
+	store := newTestMenuStore(t)
+	require.NoError(t, storeRepo.Create(context.Background(), store))
+	a := insertMenuDirectly(t, db, store.StoreID, "A", 0)
+	b := insertMenuDirectly(t, db, store.StoreID, "B", 1)
+	c := insertMenuDirectly(t, db, store.StoreID, "C", 2)
+
+	require.NoError(t, menuRepo.ReorderInTx(context.Background(), db, store.StoreID, []string{c, a, b}))
+
+	menus, err := menuRepo.FindByStoreID(context.Background(), store.StoreID)
+	require.NoError(t, err)
+	require.Len(t, menus, 3)
+	require.Equal(t, []string{c, a, b}, []string{menus[0].MenuID, menus[1].MenuID, menus[2].MenuID})
+}
+
+// TestMenuRepository_ReorderInTx_OtherStoreMenu tests a menu of another store cannot be reordered
+func TestMenuRepository_ReorderInTx_OtherStoreMenu(t *testing.T) {
+	db, menuRepo, storeRepo := setupMenuTest(t)
+
+	store1 := newTestMenuStore(t)
+	store2 := newTestMenuStore(t)
+	require.NoError(t, storeRepo.Create(context.Background(), store1))
+	require.NoError(t, storeRepo.Create(context.Background(), store2))
+	other := insertMenuDirectly(t, db, store2.StoreID, "Other", 0)
+
+	err := menuRepo.ReorderInTx(context.Background(), db, store1.StoreID, []string{other})
+	require.Error(t, err)
+}
+
+// TestMenuRepository_Delete_KeepsReviewMenus tests a deleted menu stays visible on reviews that reference it
+func TestMenuRepository_Delete_KeepsReviewMenus(t *testing.T) {
+	db, menuRepo, storeRepo := setupMenuTest(t)
+	userRepo := repository.NewUserRepository(db)
+	reviewRepo := repository.NewReviewRepository(db)
+
+	store := newTestMenuStore(t)
+	require.NoError(t, storeRepo.Create(context.Background(), store))
+	user := newTestReviewUser(t)
+	require.NoError(t, userRepo.Create(context.Background(), user))
+
+	menuID := insertMenuDirectly(t, db, store.StoreID, "Seasonal Cake", 0)
+	reviewID := createReviewWithFiles(t, db, store.StoreID, user.UserID, []string{menuID}, nil)
+
+	require.NoError(t, menuRepo.Delete(context.Background(), menuID))
+
+	_, err := menuRepo.FindByID(context.Background(), menuID)
+	require.Error(t, err)
+	menus, err := menuRepo.FindByStoreAndIDs(context.Background(), store.StoreID, []string{menuID})
+	require.NoError(t, err)
+	require.Empty(t, menus, "deleted menus must not be attachable to new reviews")
+
+	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "new", output.Viewer{})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Equal(t, reviewID, reviews[0].ReviewID)
+	require.Len(t, reviews[0].Menus, 1)
+	require.Equal(t, "Seasonal Cake", reviews[0].Menus[0].Name)
+	require.True(t, reviews[0].Menus[0].IsDeleted())
+
+	// 二重削除は not found
+	require.Error(t, menuRepo.Delete(context.Background(), menuID))
+}
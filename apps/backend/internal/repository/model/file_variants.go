@@ -0,0 +1,60 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// files.variants に保存する JSON。派生ファイルごとに1要素
+type fileVariantJSON struct {
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Format      string `json:"format"`
+	ContentType string `json:"content_type"`
+	ObjectKey   string `json:"object_key"`
+	FileSize    int64  `json:"file_size"`
+}
+
+// EncodeFileVariants は派生ファイルを files.variants の JSON に変換します。派生ファイルがない場合は nil（NULL）を返します
+func EncodeFileVariants(variants []entity.FileVariant) []byte {
+	if len(variants) == 0 {
+		return nil
+	}
+	records := make([]fileVariantJSON, len(variants))
+	for i, v := range variants {
+		records[i] = fileVariantJSON{
+			Width:       v.Width,
+			Height:      v.Height,
+			Format:      v.Format,
+			ContentType: v.ContentType,
+			ObjectKey:   v.ObjectKey,
+			FileSize:    v.FileSize,
+		}
+	}
+	raw, _ := json.Marshal(records) //nolint:errcheck // fileVariantJSON only contains strings and integers
+	return raw
+}
+
+// DecodeFileVariants は files.variants の JSON を派生ファイルに変換します。空または読めない値は nil を返します
+func DecodeFileVariants(raw []byte) []entity.FileVariant {
+	if len(raw) == 0 {
+		return nil
+	}
+	var records []fileVariantJSON
+	if err := json.Unmarshal(raw, &records); err != nil {
+		return nil
+	}
+	variants := make([]entity.FileVariant, len(records))
+	for i, r := range records {
+		variants[i] = entity.FileVariant{
+			Width:       r.Width,
+			Height:      r.Height,
+			Format:      r.Format,
+			ContentType: r.ContentType,
+			ObjectKey:   r.ObjectKey,
+			FileSize:    r.FileSize,
+		}
+	}
+	return variants
+}
@@ -37,7 +37,7 @@ func assertStoreFields(t *testing.T, expected, result entity.Store) {
 	assert.Equal(t, expected.PlaceID, result.PlaceID, "PlaceID mismatch")
 	assert.Equal(t, expected.Latitude, result.Latitude, "Latitude mismatch")
 	assert.Equal(t, expected.Longitude, result.Longitude, "Longitude mismatch")
-	assert.Equal(t, expected.IsApproved, result.IsApproved, "IsApproved mismatch")
+	assert.Equal(t, expected.Visibility, result.Visibility, "Visibility mismatch")
 	assert.Equal(t, expected.Category, result.Category, "Category mismatch")
 	assert.Equal(t, expected.Budget, result.Budget, "Budget mismatch")
 	assert.Equal(t, expected.AverageRating, result.AverageRating, "AverageRating mismatch")
@@ -176,7 +176,7 @@ func TestStore_Entity(t *testing.T) {
 				Longitude:       139.6503,
 				GoogleMapURL:    strPtr("https://maps.google.com/test"),
 				PlaceID:         "place-123",
-				IsApproved:      true,
+				Visibility:      "published",
 				Category:        "カフェ・喫茶",
 				Budget:          "$$",
 				AverageRating:   4.5,
@@ -223,7 +223,7 @@ func TestStore_Entity(t *testing.T) {
 				Latitude:        35.6762,
 				Longitude:       139.6503,
 				GoogleMapURL:    strPtr("https://maps.google.com/test"),
-				IsApproved:      true,
+				Visibility:      "published",
 				Category:        "カフェ・喫茶",
 				Budget:          "$$",
 				AverageRating:   4.5,
@@ -865,7 +865,7 @@ func TestReport_Entity(t *testing.T) {
 				ReportID:   1,
 				UserID:     "user-123",
 				TargetType: "review",
-				TargetID:   100,
+				TargetID:   "00000000-0000-0000-0000-000000000100",
 				Reason:     "spam",
 				Status:     "pending",
 				CreatedAt:  now,
@@ -875,7 +875,7 @@ func TestReport_Entity(t *testing.T) {
 				ReportID:   1,
 				UserID:     "user-123",
 				TargetType: "review",
-				TargetID:   100,
+				TargetID:   "00000000-0000-0000-0000-000000000100",
 				Reason:     "spam",
 				Status:     "pending",
 				CreatedAt:  now,
@@ -888,7 +888,7 @@ func TestReport_Entity(t *testing.T) {
 				ReportID:   2,
 				UserID:     "user-456",
 				TargetType: "store",
-				TargetID:   200,
+				TargetID:   "00000000-0000-0000-0000-000000000200",
 				Reason:     "inappropriate content",
 				Status:     "resolved",
 				CreatedAt:  now.Add(-24 * time.Hour),
@@ -898,7 +898,7 @@ func TestReport_Entity(t *testing.T) {
 				ReportID:   2,
 				UserID:     "user-456",
 				TargetType: "store",
-				TargetID:   200,
+				TargetID:   "00000000-0000-0000-0000-000000000200",
 				Reason:     "inappropriate content",
 				Status:     "resolved",
 				CreatedAt:  now.Add(-24 * time.Hour),
@@ -911,7 +911,7 @@ func TestReport_Entity(t *testing.T) {
 				ReportID:   3,
 				UserID:     "user-789",
 				TargetType: "review",
-				TargetID:   300,
+				TargetID:   "00000000-0000-0000-0000-000000000300",
 				Reason:     "false report",
 				Status:     "rejected",
 				CreatedAt:  now,
@@ -921,7 +921,7 @@ func TestReport_Entity(t *testing.T) {
 				ReportID:   3,
 				UserID:     "user-789",
 				TargetType: "review",
-				TargetID:   300,
+				TargetID:   "00000000-0000-0000-0000-000000000300",
 				Reason:     "false report",
 				Status:     "rejected",
 				CreatedAt:  now,
@@ -30,21 +30,29 @@ func (s Store) Entity() entity.Store {
 			return &file
 		}(),
 		Name:            s.Name,
+		NameKana:        s.NameKana,
 		OpenedAt:        s.OpenedAt,
 		Description:     s.Description,
 		Address:         s.Address,
 		PlaceID:         s.PlaceID,
 		OpeningHours:    s.OpeningHours,
+		OpeningSchedule: DecodeOpeningSchedule(s.OpeningSchedule),
 		Latitude:        s.Latitude,
 		Longitude:       s.Longitude,
 		GoogleMapURL:    s.GoogleMapURL,
-		IsApproved:      s.IsApproved,
+		Visibility:      s.Visibility,
+		ApprovalStatus:  s.ApprovalStatus,
 		Category:        s.Category,
 		Budget:          s.Budget,
 		AverageRating:   s.AverageRating,
+		ReviewCount:     s.ReviewCount,
+		RatingHistogram: s.ratingHistogram(),
+		RatingAverages:  s.ratingAverages(),
 		DistanceMinutes: s.DistanceMinutes,
+		VisitedByMe:     s.VisitedByMe,
 		Tags:            extractTags(s.Tags),
 		Files:           ToEntities[entity.File, File](s.Files),
+		Photos:          StorePhotos(s.Photos, s.ThumbnailFileID),
 		CreatedAt:       s.CreatedAt,
 		UpdatedAt:       s.UpdatedAt,
 		Menus:           ToEntities[entity.Menu, Menu](s.Menus),
@@ -52,6 +60,31 @@ func (s Store) Entity() entity.Store {
 	}
 }
 
+func (s Store) ratingHistogram() entity.RatingHistogram {
+	return entity.RatingHistogram{s.RatingCount1, s.RatingCount2, s.RatingCount3, s.RatingCount4, s.RatingCount5}
+}
+
+func (s Store) ratingAverages() entity.RatingAverages {
+	return entity.RatingAverages{
+		Taste:       s.AvgTaste,
+		Atmosphere:  s.AvgAtmosphere,
+		Service:     s.AvgService,
+		Speed:       s.AvgSpeed,
+		Cleanliness: s.AvgCleanliness,
+	}
+}
+
+// RatingSummary は店舗の集計済み評価を RatingSummary として返します
+func (s Store) RatingSummary() entity.RatingSummary {
+	return entity.RatingSummary{
+		StoreID:       s.StoreID,
+		AverageRating: s.AverageRating,
+		ReviewCount:   s.ReviewCount,
+		Histogram:     s.ratingHistogram(),
+		Averages:      s.ratingAverages(),
+	}
+}
+
 func extractTags(tags []StoreTag) []string {
 	result := make([]string, len(tags))
 	for i, t := range tags {
@@ -61,14 +94,34 @@ func extractTags(tags []StoreTag) []string {
 }
 
 func (m Menu) Entity() entity.Menu {
-	return entity.Menu{
+	menu := entity.Menu{
 		MenuID:      m.MenuID,
 		StoreID:     m.StoreID,
 		Name:        m.Name,
 		Price:       m.Price,
 		Description: m.Description,
+		Section:     m.Section,
+		IsAvailable: m.IsAvailable,
+		SortOrder:   m.SortOrder,
+		ImageFileID: m.ImageFileID,
+		DietaryTags: extractDietaryTags(m.DietaryTags),
 		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		DeletedAt:   m.DeletedAt,
+	}
+	if m.ImageFile != nil {
+		file := m.ImageFile.Entity()
+		menu.ImageFile = &file
 	}
+	return menu
+}
+
+func extractDietaryTags(tags []MenuDietaryTag) []string {
+	result := make([]string, len(tags))
+	for i, t := range tags {
+		result[i] = t.Tag
+	}
+	return result
 }
 
 func (r Review) Entity() entity.Review {
@@ -89,7 +142,9 @@ func (r Review) Entity() entity.Review {
 		Rating:        r.Rating,
 		RatingDetails: ratingDetails,
 		Content:       r.Content,
+		Visibility:    r.Visibility,
 		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
 		Menus:         ToEntities[entity.Menu, Menu](r.Menus),
 		Files:         ToEntities[entity.File, File](r.Files),
 	}
@@ -105,18 +160,23 @@ func (u User) Entity() entity.User {
 		userRole = role.User
 	}
 	return entity.User{
-		UserID:     u.UserID,
-		Name:       u.Name,
-		Email:      u.Email,
-		Phone:      u.Phone,
-		IconURL:    u.IconURL,
-		IconFileID: u.IconFileID,
-		Provider:   provider,
-		Gender:     u.Gender,
-		Birthday:   u.Birthday,
-		Role:       userRole,
-		CreatedAt:  u.CreatedAt,
-		UpdatedAt:  u.UpdatedAt,
+		UserID:           u.UserID,
+		Name:             u.Name,
+		Email:            u.Email,
+		Phone:            u.Phone,
+		IconURL:          u.IconURL,
+		IconFileID:       u.IconFileID,
+		Provider:         provider,
+		Gender:           u.Gender,
+		Birthday:         u.Birthday,
+		Role:             userRole,
+		CreatedAt:        u.CreatedAt,
+		SuspendedAt:      u.SuspendedAt,
+		SuspendedUntil:   u.SuspendedUntil,
+		SuspensionReason: u.SuspensionReason,
+		DeletedAt:        u.DeletedAt,
+		PurgedAt:         u.PurgedAt,
+		UpdatedAt:        u.UpdatedAt,
 	}
 }
 
@@ -135,29 +195,183 @@ func (f Favorite) Entity() entity.Favorite {
 	}
 }
 
+// Entity は店舗の写真を返します。カバー画像かは店舗を参照しないと分からないため StorePhotos を使います
+func (sf StoreFile) Entity() entity.StorePhoto {
+	photo := entity.StorePhoto{
+		StoreID:   sf.StoreID,
+		SortOrder: sf.SortOrder,
+		Caption:   sf.Caption,
+		AltText:   sf.AltText,
+		CreatedAt: sf.CreatedAt,
+	}
+	if sf.File != nil {
+		photo.File = sf.File.Entity()
+	} else {
+		photo.File.FileID = sf.FileID
+	}
+	return photo
+}
+
+// StorePhotos は店舗の写真を返し、coverFileID の写真をカバー画像とします
+func StorePhotos(files []StoreFile, coverFileID *string) []entity.StorePhoto {
+	photos := ToEntities[entity.StorePhoto, StoreFile](files)
+	for i := range photos {
+		photos[i].IsCover = coverFileID != nil && photos[i].File.FileID == *coverFileID
+	}
+	return photos
+}
+
 func (f File) Entity() entity.File {
 	return entity.File{
-		FileID:      f.FileID,
-		FileKind:    f.FileKind,
-		FileName:    f.FileName,
-		FileSize:    f.FileSize,
-		ObjectKey:   f.ObjectKey,
-		ContentType: f.ContentType,
-		IsDeleted:   f.IsDeleted,
-		CreatedAt:   f.CreatedAt,
-		CreatedBy:   f.CreatedBy,
+		FileID:             f.FileID,
+		FileKind:           f.FileKind,
+		FileName:           f.FileName,
+		FileSize:           f.FileSize,
+		ObjectKey:          f.ObjectKey,
+		ContentType:        f.ContentType,
+		IsDeleted:          f.IsDeleted,
+		CreatedAt:          f.CreatedAt,
+		CreatedBy:          f.CreatedBy,
+		UploadedAt:         f.UploadedAt,
+		ProcessedAt:        f.ProcessedAt,
+		Variants:           DecodeFileVariants(f.Variants),
+		ProcessingAttempts: f.ProcessingAttempts,
+	}
+}
+
+func (l ReviewLike) Entity() entity.ReviewLike {
+	return entity.ReviewLike{
+		ReviewID:  l.ReviewID,
+		UserID:    l.UserID,
+		CreatedAt: l.CreatedAt,
 	}
 }
 
+// Entity は一覧の相手として、読み込まれている方（Follower または Followee）のユーザーを User に設定します
+func (f UserFollow) Entity() entity.Follow {
+	follow := entity.Follow{
+		FollowerID: f.FollowerID,
+		FolloweeID: f.FolloweeID,
+		CreatedAt:  f.CreatedAt,
+	}
+	other := f.Follower
+	if other == nil {
+		other = f.Followee
+	}
+	if other != nil {
+		user := other.Entity()
+		follow.User = &user
+	}
+	return follow
+}
+
+func (v StoreVisit) Entity() entity.Visit {
+	visit := entity.Visit{
+		VisitID:   v.VisitID,
+		UserID:    v.UserID,
+		StoreID:   v.StoreID,
+		VisitedOn: v.VisitedOn,
+		Note:      v.Note,
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+	}
+	if v.Store != nil {
+		store := v.Store.Entity()
+		visit.Store = &store
+	}
+	return visit
+}
+
 func (r Report) Entity() entity.Report {
 	return entity.Report{
-		ReportID:   r.ReportID,
-		UserID:     r.UserID,
-		TargetType: r.TargetType,
-		TargetID:   r.TargetID,
-		Reason:     r.Reason,
-		Status:     r.Status,
-		CreatedAt:  r.CreatedAt,
-		UpdatedAt:  r.UpdatedAt,
+		ReportID:       r.ReportID,
+		UserID:         r.UserID,
+		TargetType:     r.TargetType,
+		TargetID:       r.TargetID,
+		Reason:         r.Reason,
+		Status:         r.Status,
+		Enforcement:    r.Enforcement,
+		ResolutionNote: r.ResolutionNote,
+		ResolvedBy:     r.ResolvedBy,
+		ResolvedAt:     r.ResolvedAt,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
+
+func (e StoreApprovalEvent) Entity() entity.StoreApprovalEvent {
+	return entity.StoreApprovalEvent{
+		EventID:    e.EventID,
+		StoreID:    e.StoreID,
+		FromStatus: e.FromStatus,
+		ToStatus:   e.ToStatus,
+		ActorID:    e.ActorID,
+		Reason:     e.Reason,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func (e UserModerationEvent) Entity() entity.UserModerationEvent {
+	return entity.UserModerationEvent{
+		EventID:        e.EventID,
+		UserID:         e.UserID,
+		AdminID:        e.AdminID,
+		Action:         e.Action,
+		Reason:         e.Reason,
+		FromRole:       e.FromRole,
+		ToRole:         e.ToRole,
+		SuspendedUntil: e.SuspendedUntil,
+		CreatedAt:      e.CreatedAt,
+	}
+}
+
+func (e AuditLog) Entity() entity.AuditLog {
+	return entity.AuditLog{
+		AuditID:    e.AuditID,
+		ActorID:    e.ActorID,
+		ActorRole:  e.ActorRole,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		Before:     e.Before,
+		After:      e.After,
+		RequestID:  e.RequestID,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func (e StoreEdit) Entity() entity.StoreEdit {
+	return entity.StoreEdit{
+		EditID:          e.EditID,
+		StoreID:         e.StoreID,
+		UserID:          e.UserID,
+		Name:            e.Name,
+		Address:         e.Address,
+		Latitude:        e.Latitude,
+		Longitude:       e.Longitude,
+		PlaceID:         e.PlaceID,
+		ThumbnailFileID: e.ThumbnailFileID,
+		Status:          e.Status,
+		ReviewedBy:      e.ReviewedBy,
+		ReviewNote:      e.ReviewNote,
+		ReviewedAt:      e.ReviewedAt,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
+
+func (c StoreClaim) Entity() entity.StoreClaim {
+	return entity.StoreClaim{
+		ClaimID:    c.ClaimID,
+		StoreID:    c.StoreID,
+		UserID:     c.UserID,
+		Note:       c.Note,
+		Status:     c.Status,
+		ReviewedBy: c.ReviewedBy,
+		ReviewNote: c.ReviewNote,
+		ReviewedAt: c.ReviewedAt,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+		Files:      ToEntities[entity.File, File](c.Files),
 	}
 }
@@ -6,37 +6,70 @@ type Store struct {
 	StoreID         string     `gorm:"column:store_id;primaryKey;type:uuid;default:gen_random_uuid()"`
 	ThumbnailFileID *string    `gorm:"column:thumbnail_file_id;type:uuid"`
 	Name            string     `gorm:"column:name"`
+	NameKana        *string    `gorm:"column:name_kana"`
 	OpenedAt        *time.Time `gorm:"column:opened_at"`
 	Description     *string    `gorm:"column:description"`
 	Address         string     `gorm:"column:address"`
 	OpeningHours    *string    `gorm:"column:opening_hours"`
+	OpeningSchedule []byte     `gorm:"column:opening_schedule;type:jsonb"`
 	Latitude        float64    `gorm:"column:latitude"`
 	Longitude       float64    `gorm:"column:longitude"`
 	GoogleMapURL    *string    `gorm:"column:google_map_url"`
 	PlaceID         string     `gorm:"column:place_id"`
-	IsApproved      bool       `gorm:"column:is_approved;default:false"`
+	Visibility      string     `gorm:"column:visibility;default:pending"`
+	ApprovalStatus  string     `gorm:"column:approval_status;default:draft"`
 	Category        string     `gorm:"column:category;default:'カフェ・喫茶'"`
 	Budget          string     `gorm:"column:budget;default:'$$'"`
 	AverageRating   float64    `gorm:"column:average_rating;default:0.0"`
+	ReviewCount     int        `gorm:"column:review_count;default:0"`
+	RatingCount1    int        `gorm:"column:rating_count_1;default:0"`
+	RatingCount2    int        `gorm:"column:rating_count_2;default:0"`
+	RatingCount3    int        `gorm:"column:rating_count_3;default:0"`
+	RatingCount4    int        `gorm:"column:rating_count_4;default:0"`
+	RatingCount5    int        `gorm:"column:rating_count_5;default:0"`
+	AvgTaste        *float64   `gorm:"column:avg_taste"`
+	AvgAtmosphere   *float64   `gorm:"column:avg_atmosphere"`
+	AvgService      *float64   `gorm:"column:avg_service"`
+	AvgSpeed        *float64   `gorm:"column:avg_speed"`
+	AvgCleanliness  *float64   `gorm:"column:avg_cleanliness"`
 	DistanceMinutes int        `gorm:"column:distance_minutes;default:5"`
 	CreatedAt       time.Time  `gorm:"column:created_at"`
 	UpdatedAt       time.Time  `gorm:"column:updated_at"`
-	Menus           []Menu     `gorm:"foreignKey:StoreID;references:StoreID"`
-	Reviews         []Review   `gorm:"foreignKey:StoreID;references:StoreID"`
-	ThumbnailFile   *File      `gorm:"foreignKey:ThumbnailFileID;references:FileID"`
-	Tags            []StoreTag `gorm:"foreignKey:StoreID;references:StoreID"`
-	Files           []File     `gorm:"many2many:store_files;joinForeignKey:StoreID;joinReferences:FileID"`
+	// VisitedByMe は閲覧者が店舗に行ったことがあるか。閲覧者がいるクエリでだけ SELECT される
+	VisitedByMe   *bool       `gorm:"column:visited_by_me;->;-:migration"`
+	Menus         []Menu      `gorm:"foreignKey:StoreID;references:StoreID"`
+	Reviews       []Review    `gorm:"foreignKey:StoreID;references:StoreID"`
+	ThumbnailFile *File       `gorm:"foreignKey:ThumbnailFileID;references:FileID"`
+	Tags          []StoreTag  `gorm:"foreignKey:StoreID;references:StoreID"`
+	Files         []File      `gorm:"many2many:store_files;joinForeignKey:StoreID;joinReferences:FileID"`
+	Photos        []StoreFile `gorm:"foreignKey:StoreID;references:StoreID"`
 }
 
 type Menu struct {
-	MenuID      string    `gorm:"column:menu_id;primaryKey;type:uuid;default:gen_random_uuid()"`
-	StoreID     string    `gorm:"column:store_id;type:uuid"`
-	Name        string    `gorm:"column:name"`
-	Price       *int      `gorm:"column:price"`
-	Description *string   `gorm:"column:description"`
-	CreatedAt   time.Time `gorm:"column:created_at"`
+	MenuID      string           `gorm:"column:menu_id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	StoreID     string           `gorm:"column:store_id;type:uuid"`
+	Name        string           `gorm:"column:name"`
+	Price       *int             `gorm:"column:price"`
+	Description *string          `gorm:"column:description"`
+	Section     *string          `gorm:"column:section"`
+	IsAvailable bool             `gorm:"column:is_available"`
+	SortOrder   int              `gorm:"column:sort_order"`
+	ImageFileID *string          `gorm:"column:image_file_id;type:uuid"`
+	CreatedAt   time.Time        `gorm:"column:created_at"`
+	UpdatedAt   time.Time        `gorm:"column:updated_at"`
+	DeletedAt   *time.Time       `gorm:"column:deleted_at"`
+	ImageFile   *File            `gorm:"foreignKey:ImageFileID;references:FileID"`
+	DietaryTags []MenuDietaryTag `gorm:"foreignKey:MenuID;references:MenuID"`
 }
 
+type MenuDietaryTag struct {
+	MenuID    string    `gorm:"column:menu_id;primaryKey;type:uuid"`
+	Tag       string    `gorm:"column:tag;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (MenuDietaryTag) TableName() string { return "menu_dietary_tags" }
+
 type Review struct {
 	ReviewID          string    `gorm:"column:review_id;primaryKey;type:uuid;default:gen_random_uuid()"`
 	StoreID           string    `gorm:"column:store_id;type:uuid"`
@@ -48,27 +81,37 @@ type Review struct {
 	RatingSpeed       *int      `gorm:"column:rating_speed"`
 	RatingCleanliness *int      `gorm:"column:rating_cleanliness"`
 	Content           *string   `gorm:"column:content"`
+	Visibility        string    `gorm:"column:visibility;default:published"`
 	CreatedAt         time.Time `gorm:"column:created_at"`
+	UpdatedAt         time.Time `gorm:"column:updated_at"`
 	Menus             []Menu    `gorm:"many2many:review_menus;joinForeignKey:ReviewID;joinReferences:MenuID"`
 	Files             []File    `gorm:"many2many:review_files;joinForeignKey:ReviewID;joinReferences:FileID"`
 }
 
 type File struct {
-	FileID      string    `gorm:"column:file_id;primaryKey;type:uuid;default:gen_random_uuid()"`
-	FileKind    string    `gorm:"column:file_kind"`
-	FileName    string    `gorm:"column:file_name"`
-	FileSize    *int64    `gorm:"column:file_size"`
-	ObjectKey   string    `gorm:"column:object_key"`
-	ContentType *string   `gorm:"column:content_type"`
-	IsDeleted   bool      `gorm:"column:is_deleted"`
-	CreatedAt   time.Time `gorm:"column:created_at"`
-	CreatedBy   *string   `gorm:"column:created_by;type:uuid"`
+	FileID             string     `gorm:"column:file_id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	FileKind           string     `gorm:"column:file_kind"`
+	FileName           string     `gorm:"column:file_name"`
+	FileSize           *int64     `gorm:"column:file_size"`
+	ObjectKey          string     `gorm:"column:object_key"`
+	ContentType        *string    `gorm:"column:content_type"`
+	IsDeleted          bool       `gorm:"column:is_deleted"`
+	CreatedAt          time.Time  `gorm:"column:created_at"`
+	CreatedBy          *string    `gorm:"column:created_by;type:uuid"`
+	UploadedAt         *time.Time `gorm:"column:uploaded_at"`
+	ProcessedAt        *time.Time `gorm:"column:processed_at"`
+	Variants           []byte     `gorm:"column:variants;type:jsonb"`
+	ProcessingAttempts int        `gorm:"column:processing_attempts"`
 }
 
 type StoreFile struct {
 	StoreID   string    `gorm:"column:store_id;primaryKey;type:uuid"`
 	FileID    string    `gorm:"column:file_id;primaryKey;type:uuid"`
+	SortOrder int       `gorm:"column:sort_order"`
+	Caption   *string   `gorm:"column:caption"`
+	AltText   *string   `gorm:"column:alt_text"`
 	CreatedAt time.Time `gorm:"column:created_at"`
+	File      *File     `gorm:"foreignKey:FileID;references:FileID"`
 }
 
 func (StoreFile) TableName() string { return "store_files" }
@@ -81,6 +124,112 @@ type StoreTag struct {
 
 func (StoreTag) TableName() string { return "store_tags" }
 
+// StoreOpeningHour は「営業中」の絞り込み用に opening_schedule を展開した行。
+// Weekday と ServiceDate のどちらか一方を持ち、OpenMinute が nil の ServiceDate の行はその日の休業を表す
+type StoreOpeningHour struct {
+	OpeningHourID int64      `gorm:"column:opening_hour_id;primaryKey;autoIncrement"`
+	StoreID       string     `gorm:"column:store_id;type:uuid"`
+	Weekday       *int       `gorm:"column:weekday"`
+	ServiceDate   *time.Time `gorm:"column:service_date;type:date"`
+	OpenMinute    *int       `gorm:"column:open_minute"`
+	CloseMinute   *int       `gorm:"column:close_minute"`
+}
+
+func (StoreOpeningHour) TableName() string { return "store_opening_hours" }
+
+type StoreOwner struct {
+	StoreID   string    `gorm:"column:store_id;primaryKey;type:uuid"`
+	UserID    string    `gorm:"column:user_id;primaryKey;type:uuid"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (StoreOwner) TableName() string { return "store_owners" }
+
+type StoreClaim struct {
+	ClaimID    string     `gorm:"column:claim_id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	StoreID    string     `gorm:"column:store_id;type:uuid"`
+	UserID     string     `gorm:"column:user_id;type:uuid"`
+	Note       string     `gorm:"column:note"`
+	Status     string     `gorm:"column:status;default:pending"`
+	ReviewedBy *string    `gorm:"column:reviewed_by;type:uuid"`
+	ReviewNote *string    `gorm:"column:review_note"`
+	ReviewedAt *time.Time `gorm:"column:reviewed_at"`
+	CreatedAt  time.Time  `gorm:"column:created_at"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at"`
+	Files      []File     `gorm:"many2many:store_claim_files;joinForeignKey:ClaimID;joinReferences:FileID"`
+}
+
+func (StoreClaim) TableName() string { return "store_claims" }
+
+type StoreEdit struct {
+	EditID          string     `gorm:"column:edit_id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	StoreID         string     `gorm:"column:store_id;type:uuid"`
+	UserID          string     `gorm:"column:user_id;type:uuid"`
+	Name            *string    `gorm:"column:name"`
+	Address         *string    `gorm:"column:address"`
+	Latitude        *float64   `gorm:"column:latitude"`
+	Longitude       *float64   `gorm:"column:longitude"`
+	PlaceID         *string    `gorm:"column:place_id"`
+	ThumbnailFileID *string    `gorm:"column:thumbnail_file_id;type:uuid"`
+	Status          string     `gorm:"column:status;default:pending"`
+	ReviewedBy      *string    `gorm:"column:reviewed_by;type:uuid"`
+	ReviewNote      *string    `gorm:"column:review_note"`
+	ReviewedAt      *time.Time `gorm:"column:reviewed_at"`
+	CreatedAt       time.Time  `gorm:"column:created_at"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at"`
+}
+
+func (StoreEdit) TableName() string { return "store_edits" }
+
+type StoreApprovalEvent struct {
+	EventID    int64     `gorm:"column:event_id;primaryKey;autoIncrement"`
+	StoreID    string    `gorm:"column:store_id;type:uuid"`
+	FromStatus string    `gorm:"column:from_status"`
+	ToStatus   string    `gorm:"column:to_status"`
+	ActorID    *string   `gorm:"column:actor_id;type:uuid"`
+	Reason     *string   `gorm:"column:reason"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (StoreApprovalEvent) TableName() string { return "store_approval_events" }
+
+type UserModerationEvent struct {
+	EventID        int64      `gorm:"column:event_id;primaryKey;autoIncrement"`
+	UserID         string     `gorm:"column:user_id;type:uuid"`
+	AdminID        *string    `gorm:"column:admin_id;type:uuid"`
+	Action         string     `gorm:"column:action"`
+	Reason         *string    `gorm:"column:reason"`
+	FromRole       *string    `gorm:"column:from_role"`
+	ToRole         *string    `gorm:"column:to_role"`
+	SuspendedUntil *time.Time `gorm:"column:suspended_until"`
+	CreatedAt      time.Time  `gorm:"column:created_at"`
+}
+
+func (UserModerationEvent) TableName() string { return "user_moderation_events" }
+
+type AuditLog struct {
+	AuditID    int64     `gorm:"column:audit_id;primaryKey;autoIncrement"`
+	ActorID    *string   `gorm:"column:actor_id;type:uuid"`
+	ActorRole  string    `gorm:"column:actor_role"`
+	Action     string    `gorm:"column:action"`
+	TargetType string    `gorm:"column:target_type"`
+	TargetID   string    `gorm:"column:target_id"`
+	Before     []byte    `gorm:"column:before;type:jsonb"`
+	After      []byte    `gorm:"column:after;type:jsonb"`
+	RequestID  *string   `gorm:"column:request_id"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (AuditLog) TableName() string { return "audit_logs" }
+
+type StoreClaimFile struct {
+	ClaimID   string    `gorm:"column:claim_id;primaryKey;type:uuid"`
+	FileID    string    `gorm:"column:file_id;primaryKey;type:uuid"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (StoreClaimFile) TableName() string { return "store_claim_files" }
+
 type ReviewMenu struct {
 	ReviewID  string    `gorm:"column:review_id;primaryKey;type:uuid"`
 	MenuID    string    `gorm:"column:menu_id;primaryKey;type:uuid"`
@@ -110,6 +259,12 @@ type User struct {
 	Role       string     `gorm:"column:role;default:user"`
 	CreatedAt  time.Time  `gorm:"column:created_at"`
 	UpdatedAt  time.Time  `gorm:"column:updated_at"`
+
+	SuspendedAt      *time.Time `gorm:"column:suspended_at"`
+	SuspendedUntil   *time.Time `gorm:"column:suspended_until"`
+	SuspensionReason *string    `gorm:"column:suspension_reason"`
+	DeletedAt        *time.Time `gorm:"column:deleted_at"`
+	PurgedAt         *time.Time `gorm:"column:purged_at"`
 }
 
 type Favorite struct {
@@ -120,14 +275,18 @@ type Favorite struct {
 }
 
 type Report struct {
-	ReportID   int64     `gorm:"column:report_id;primaryKey;autoIncrement"`
-	UserID     string    `gorm:"column:user_id;type:uuid"`
-	TargetType string    `gorm:"column:target_type"`
-	TargetID   int64     `gorm:"column:target_id"`
-	Reason     string    `gorm:"column:reason"`
-	Status     string    `gorm:"column:status;default:pending"`
-	CreatedAt  time.Time `gorm:"column:created_at"`
-	UpdatedAt  time.Time `gorm:"column:updated_at"`
+	ReportID       int64      `gorm:"column:report_id;primaryKey;autoIncrement"`
+	UserID         string     `gorm:"column:user_id;type:uuid"`
+	TargetType     string     `gorm:"column:target_type"`
+	TargetID       string     `gorm:"column:target_id;type:uuid"`
+	Reason         string     `gorm:"column:reason"`
+	Status         string     `gorm:"column:status;default:pending"`
+	Enforcement    *string    `gorm:"column:enforcement"`
+	ResolutionNote *string    `gorm:"column:resolution_note"`
+	ResolvedBy     *string    `gorm:"column:resolved_by;type:uuid"`
+	ResolvedAt     *time.Time `gorm:"column:resolved_at"`
+	CreatedAt      time.Time  `gorm:"column:created_at"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at"`
 }
 
 type ReviewLike struct {
@@ -137,3 +296,26 @@ type ReviewLike struct {
 }
 
 func (ReviewLike) TableName() string { return "review_likes" }
+
+type UserFollow struct {
+	FollowerID string    `gorm:"column:follower_id;primaryKey;type:uuid"`
+	FolloweeID string    `gorm:"column:followee_id;primaryKey;type:uuid"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+	Follower   *User     `gorm:"foreignKey:FollowerID;references:UserID"`
+	Followee   *User     `gorm:"foreignKey:FolloweeID;references:UserID"`
+}
+
+func (UserFollow) TableName() string { return "user_follows" }
+
+type StoreVisit struct {
+	VisitID   int64     `gorm:"column:visit_id;primaryKey;autoIncrement"`
+	UserID    string    `gorm:"column:user_id;type:uuid"`
+	StoreID   string    `gorm:"column:store_id;type:uuid"`
+	VisitedOn time.Time `gorm:"column:visited_on;type:date"`
+	Note      *string   `gorm:"column:note"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+	Store     *Store    `gorm:"foreignKey:StoreID;references:StoreID"`
+}
+
+func (StoreVisit) TableName() string { return "store_visits" }
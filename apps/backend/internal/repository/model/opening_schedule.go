@@ -0,0 +1,111 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// stores.opening_schedule に保存する JSON。日付は YYYY-MM-DD、時刻は 0:00 からの分数で持つ
+type openingScheduleJSON struct {
+	// Weekly は time.Weekday（0 が日曜）の順
+	Weekly   [7][]openingIntervalJSON `json:"weekly"`
+	Special  []specialHoursJSON       `json:"special,omitempty"`
+	Closures []storeClosureJSON       `json:"closures,omitempty"`
+}
+
+type openingIntervalJSON struct {
+	Open  int `json:"open"`
+	Close int `json:"close"`
+}
+
+type specialHoursJSON struct {
+	Date      string                `json:"date"`
+	Intervals []openingIntervalJSON `json:"intervals"`
+}
+
+type storeClosureJSON struct {
+	From string  `json:"from"`
+	To   string  `json:"to"`
+	Note *string `json:"note,omitempty"`
+}
+
+// EncodeOpeningSchedule は営業時間を stores.opening_schedule の JSON に変換します。nil の場合は nil（NULL）を返します
+func EncodeOpeningSchedule(schedule *entity.OpeningSchedule) []byte {
+	if schedule == nil {
+		return nil
+	}
+	var record openingScheduleJSON
+	for d, intervals := range schedule.Weekly {
+		record.Weekly[d] = encodeIntervals(intervals)
+	}
+	for _, special := range schedule.Special {
+		record.Special = append(record.Special, specialHoursJSON{
+			Date:      special.Date.Format(time.DateOnly),
+			Intervals: encodeIntervals(special.Intervals),
+		})
+	}
+	for _, closure := range schedule.Closures {
+		record.Closures = append(record.Closures, storeClosureJSON{
+			From: closure.From.Format(time.DateOnly),
+			To:   closure.To.Format(time.DateOnly),
+			Note: closure.Note,
+		})
+	}
+	raw, _ := json.Marshal(record) //nolint:errcheck // openingScheduleJSON only contains strings and integers
+	return raw
+}
+
+// DecodeOpeningSchedule は stores.opening_schedule の JSON を営業時間に変換します。空または読めない値は nil を返します
+func DecodeOpeningSchedule(raw []byte) *entity.OpeningSchedule {
+	if len(raw) == 0 {
+		return nil
+	}
+	var record openingScheduleJSON
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return nil
+	}
+	var schedule entity.OpeningSchedule
+	for d, intervals := range record.Weekly {
+		schedule.Weekly[d] = decodeIntervals(intervals)
+	}
+	for _, special := range record.Special {
+		date, err := time.Parse(time.DateOnly, special.Date)
+		if err != nil {
+			return nil
+		}
+		schedule.Special = append(schedule.Special, entity.SpecialHours{Date: date, Intervals: decodeIntervals(special.Intervals)})
+	}
+	for _, closure := range record.Closures {
+		from, err := time.Parse(time.DateOnly, closure.From)
+		if err != nil {
+			return nil
+		}
+		to, err := time.Parse(time.DateOnly, closure.To)
+		if err != nil {
+			return nil
+		}
+		schedule.Closures = append(schedule.Closures, entity.StoreClosure{From: from, To: to, Note: closure.Note})
+	}
+	return &schedule
+}
+
+func encodeIntervals(intervals []entity.OpeningInterval) []openingIntervalJSON {
+	result := make([]openingIntervalJSON, len(intervals))
+	for i, interval := range intervals {
+		result[i] = openingIntervalJSON{Open: interval.Open, Close: interval.Close}
+	}
+	return result
+}
+
+func decodeIntervals(intervals []openingIntervalJSON) []entity.OpeningInterval {
+	if len(intervals) == 0 {
+		return nil
+	}
+	result := make([]entity.OpeningInterval, len(intervals))
+	for i, interval := range intervals {
+		result[i] = entity.OpeningInterval{Open: interval.Open, Close: interval.Close}
+	}
+	return result
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
@@ -18,14 +19,66 @@ func NewReportRepository(db *gorm.DB) output.ReportRepository {
 	return &reportRepository{db: db}
 }
 
-func (r *reportRepository) FindAll(ctx context.Context) ([]entity.Report, error) {
-	var reports []model.Report
-	if err := r.db.WithContext(ctx).
-		Order("created_at desc").
-		Find(&reports).Error; err != nil {
+// reportCursor is the keyset position of the last report on a page.
+type reportCursor struct {
+	ID int64 `json:"id"`
+}
+
+func (cursor reportCursor) valid() bool {
+	return cursor.ID > 0
+}
+
+// reportListRow is a report with the number of reports collapsed into it.
+type reportListRow struct {
+	model.Report
+	ReportCount int `gorm:"column:report_count"`
+}
+
+// List は同じ対象・同じステータスの通報を最新の1件にまとめ、新しい順に返します
+func (r *reportRepository) List(ctx context.Context, query output.ReportListQuery) (*output.ReportPage, error) {
+	if query.Limit <= 0 {
+		query.Limit = constants.DefaultReportListLimit
+	}
+
+	groups := r.db.WithContext(ctx).
+		Model(&model.Report{}).
+		Select("MAX(report_id) AS report_id, COUNT(*) AS report_count").
+		Group("target_type, target_id, status")
+	if query.Status != nil {
+		groups = groups.Where("status = ?", *query.Status)
+	}
+	if query.TargetType != nil {
+		groups = groups.Where("target_type = ?", *query.TargetType)
+	}
+
+	db := r.db.WithContext(ctx).
+		Table("reports").
+		Select("reports.*, g.report_count").
+		Joins("JOIN (?) AS g ON g.report_id = reports.report_id", groups)
+	if query.Cursor != "" {
+		cursor, err := decodeCursor[reportCursor](query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where("reports.report_id < ?", cursor.ID)
+	}
+
+	var rows []reportListRow
+	if err := db.Order("reports.report_id DESC").Limit(query.Limit + 1).Scan(&rows).Error; err != nil {
 		return nil, mapDBError(err)
 	}
-	return model.ToEntities[entity.Report, model.Report](reports), nil
+
+	page := &output.ReportPage{}
+	if len(rows) > query.Limit {
+		rows = rows[:query.Limit]
+		page.NextCursor = encodeCursor(reportCursor{ID: rows[len(rows)-1].ReportID})
+	}
+	page.Reports = make([]entity.Report, len(rows))
+	for i, row := range rows {
+		page.Reports[i] = row.Entity()
+		page.Reports[i].ReportCount = row.ReportCount
+	}
+	return page, nil
 }
 
 func (r *reportRepository) FindByID(ctx context.Context, reportID int64) (*entity.Report, error) {
@@ -38,6 +91,31 @@ func (r *reportRepository) FindByID(ctx context.Context, reportID int64) (*entit
 	return &entityReport, nil
 }
 
+// FindByUserID はユーザーが送信した通報を新しい順に返します
+func (r *reportRepository) FindByUserID(ctx context.Context, userID string) ([]entity.Report, error) {
+	var reports []model.Report
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, report_id DESC").
+		Find(&reports).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return model.ToEntities[entity.Report, model.Report](reports), nil
+}
+
+func (r *reportRepository) HasPending(ctx context.Context, userID, targetType, targetID string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&model.Report{}).
+		Where("user_id = ? AND target_type = ? AND target_id = ? AND status = ?",
+			userID, targetType, targetID, constants.ReportStatusPending).
+		Count(&count).Error; err != nil {
+		return false, mapDBError(err)
+	}
+	return count > 0, nil
+}
+
 func (r *reportRepository) Create(ctx context.Context, report *entity.Report) error {
 	record := model.Report{
 		ReportID:   report.ReportID,
@@ -50,11 +128,36 @@ func (r *reportRepository) Create(ctx context.Context, report *entity.Report) er
 	if err := r.db.WithContext(ctx).Create(&record).Error; err != nil {
 		return mapDBError(err)
 	}
+	report.ReportID = record.ReportID
+	report.CreatedAt = record.CreatedAt
+	report.UpdatedAt = record.UpdatedAt
 	return nil
 }
 
-func (r *reportRepository) UpdateStatus(ctx context.Context, reportID int64, status string) error {
-	return mapDBError(r.db.WithContext(ctx).Model(&model.Report{}).
-		Where("report_id = ?", reportID).
-		Update("status", status).Error)
+func (r *reportRepository) ResolveInTx(ctx context.Context, tx interface{}, report *entity.Report) (int, error) {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return 0, output.ErrInvalidTransaction
+	}
+
+	// 対応待ちの行だけを更新し、同時に対応された場合の二重処理を防ぐ
+	result := gormTx.WithContext(ctx).
+		Model(&model.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?",
+			report.TargetType, report.TargetID, constants.ReportStatusPending).
+		Updates(map[string]interface{}{
+			"status":          report.Status,
+			"enforcement":     report.Enforcement,
+			"resolution_note": report.ResolutionNote,
+			"resolved_by":     report.ResolvedBy,
+			"resolved_at":     report.ResolvedAt,
+			"updated_at":      report.UpdatedAt,
+		})
+	if result.Error != nil {
+		return 0, mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return 0, output.ErrReportAlreadyHandled
+	}
+	return int(result.RowsAffected), nil
 }
@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -43,6 +44,34 @@ func newTestReportUser(t *testing.T) *entity.User {
 	}
 }
 
+// reportTargetID returns a deterministic target UUID for n
+func reportTargetID(n int) string {
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", n)
+}
+
+// listAllReports lists every report without filters
+func listAllReports(t *testing.T, repo output.ReportRepository) []entity.Report {
+	t.Helper()
+	page, err := repo.List(context.Background(), output.ReportListQuery{Limit: 100})
+	require.NoError(t, err)
+	return page.Reports
+}
+
+// insertReportDirectly inserts a report and returns its ID
+func insertReportDirectly(t *testing.T, db *gorm.DB, userID, targetType, targetID, status string) int64 {
+	t.Helper()
+	record := struct {
+		ReportID   int64  `gorm:"column:report_id;primaryKey;autoIncrement"`
+		UserID     string `gorm:"column:user_id"`
+		TargetType string `gorm:"column:target_type"`
+		TargetID   string `gorm:"column:target_id"`
+		Reason     string `gorm:"column:reason"`
+		Status     string `gorm:"column:status"`
+	}{UserID: userID, TargetType: targetType, TargetID: targetID, Reason: "spam", Status: status}
+	require.NoError(t, db.Table("reports").Create(&record).Error)
+	return record.ReportID
+}
+
 // TestReportRepository_Create_Success tests creating a report using direct SQL
 // This test uses direct SQL insert to verify database operations work correctly
 func TestReportRepository_Create_Success(t *testing.T) {
@@ -55,7 +84,7 @@ func TestReportRepository_Create_Success(t *testing.T) {
 	// Insert report directly into the database
 	require.NoError(t, db.Exec(
 		"INSERT INTO reports (user_id, target_type, target_id, reason, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
-		user.UserID, "review", 1, "inappropriate content", "pending", time.Now(), time.Now(),
+		user.UserID, "review", reportTargetID(1), "inappropriate content", "pending", time.Now(), time.Now(),
 	).Error)
 
 	// Verify the record was inserted
@@ -76,7 +105,7 @@ func TestReportRepository_Create_WithStoreTargetType(t *testing.T) {
 	// Insert report for store directly
 	require.NoError(t, db.Exec(
 		"INSERT INTO reports (user_id, target_type, target_id, reason, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
-		user.UserID, "store", 123, "spam", "pending", time.Now(), time.Now(),
+		user.UserID, "store", reportTargetID(123), "spam", "pending", time.Now(), time.Now(),
 	).Error)
 
 	// Verify the record was inserted
@@ -97,7 +126,7 @@ func TestReportRepository_FindByID_Success(t *testing.T) {
 	// Insert report directly into the database
 	require.NoError(t, db.Exec(
 		"INSERT INTO reports (user_id, target_type, target_id, reason, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
-		user.UserID, "review", 1, "inappropriate content", "pending", time.Now(), time.Now(),
+		user.UserID, "review", reportTargetID(1), "inappropriate content", "pending", time.Now(), time.Now(),
 	).Error)
 
 	// Get the created report ID from DB
@@ -126,8 +155,8 @@ func TestReportRepository_FindByID_NotFound(t *testing.T) {
 	require.True(t, apperr.IsCode(err, apperr.CodeNotFound), "expected CodeNotFound error, got %v", err)
 }
 
-// TestReportRepository_FindAll_Success tests finding all reports
-func TestReportRepository_FindAll_Success(t *testing.T) {
+// TestReportRepository_List_Success tests listing reports on different targets
+func TestReportRepository_List_Success(t *testing.T) {
 	db, reportRepo, userRepo := setupReportTest(t)
 
 	// Create user
@@ -137,31 +166,29 @@ func TestReportRepository_FindAll_Success(t *testing.T) {
 	// Insert multiple reports directly
 	require.NoError(t, db.Exec(
 		"INSERT INTO reports (user_id, target_type, target_id, reason, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
-		user.UserID, "review", 1, "spam", "pending", time.Now(), time.Now(),
+		user.UserID, "review", reportTargetID(1), "spam", "pending", time.Now(), time.Now(),
 	).Error)
 	require.NoError(t, db.Exec(
 		"INSERT INTO reports (user_id, target_type, target_id, reason, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
-		user.UserID, "review", 2, "harassment", "pending", time.Now(), time.Now(),
+		user.UserID, "review", reportTargetID(2), "harassment", "pending", time.Now(), time.Now(),
 	).Error)
 
-	// Find all
-	reports, err := reportRepo.FindAll(context.Background())
-	require.NoError(t, err)
+	// List
+	reports := listAllReports(t, reportRepo)
 	require.Len(t, reports, 2)
 }
 
-// TestReportRepository_FindAll_Empty tests finding all reports when none exist
-func TestReportRepository_FindAll_Empty(t *testing.T) {
+// TestReportRepository_List_Empty tests listing reports when none exist
+func TestReportRepository_List_Empty(t *testing.T) {
 	_, reportRepo, _ := setupReportTest(t)
 
-	// Find all - should return empty
-	reports, err := reportRepo.FindAll(context.Background())
-	require.NoError(t, err)
+	// List - should return empty
+	reports := listAllReports(t, reportRepo)
 	require.Empty(t, reports)
 }
 
-// TestReportRepository_FindAll_OrderByCreatedAtDesc tests reports are ordered by created_at desc
-func TestReportRepository_FindAll_OrderByCreatedAtDesc(t *testing.T) {
+// TestReportRepository_List_OrderByCreatedAtDesc tests reports are listed newest first
+func TestReportRepository_List_OrderByCreatedAtDesc(t *testing.T) {
 	db, reportRepo, userRepo := setupReportTest(t)
 
 	// Create user
@@ -174,76 +201,22 @@ func TestReportRepository_FindAll_OrderByCreatedAtDesc(t *testing.T) {
 
 	require.NoError(t, db.Exec(
 		"INSERT INTO reports (user_id, target_type, target_id, reason, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
-		user.UserID, "review", 1, "first report", "pending", time1, time1,
+		user.UserID, "review", reportTargetID(1), "first report", "pending", time1, time1,
 	).Error)
 
 	require.NoError(t, db.Exec(
 		"INSERT INTO reports (user_id, target_type, target_id, reason, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
-		user.UserID, "review", 2, "second report", "pending", time2, time2,
+		user.UserID, "review", reportTargetID(2), "second report", "pending", time2, time2,
 	).Error)
 
-	// Find all - should be ordered by created_at desc (newest first)
-	reports, err := reportRepo.FindAll(context.Background())
-	require.NoError(t, err)
+	// List - newest first
+	reports := listAllReports(t, reportRepo)
 	require.Len(t, reports, 2)
 	// Second report (created later) should come first
 	require.Equal(t, "second report", reports[0].Reason)
 	require.Equal(t, "first report", reports[1].Reason)
 }
 
-// TestReportRepository_UpdateStatus tests updating a report status
-func TestReportRepository_UpdateStatus(t *testing.T) {
-	tests := []struct {
-		name      string
-		newStatus string
-	}{
-		{"to resolved", "resolved"},
-		{"to rejected", "rejected"},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			db, reportRepo, userRepo := setupReportTest(t)
-
-			// Create user
-			user := newTestReportUser(t)
-			require.NoError(t, userRepo.Create(context.Background(), user))
-
-			// Insert report directly
-			require.NoError(t, db.Exec(
-				"INSERT INTO reports (user_id, target_type, target_id, reason, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
-				user.UserID, "review", 1, "inappropriate content", "pending", time.Now(), time.Now(),
-			).Error)
-
-			// Get the created report ID from DB
-			var createdReport struct {
-				ReportID int64 `gorm:"column:report_id"`
-			}
-			err := db.Table("reports").Where("user_id = ?", user.UserID).First(&createdReport).Error
-			require.NoError(t, err)
-
-			// Update status
-			err = reportRepo.UpdateStatus(context.Background(), createdReport.ReportID, tt.newStatus)
-			require.NoError(t, err)
-
-			// Verify the update
-			found, err := reportRepo.FindByID(context.Background(), createdReport.ReportID)
-			require.NoError(t, err)
-			require.Equal(t, tt.newStatus, found.Status)
-		})
-	}
-}
-
-// TestReportRepository_UpdateStatus_NonexistentReport tests updating status for non-existent report
-func TestReportRepository_UpdateStatus_NonexistentReport(t *testing.T) {
-	_, reportRepo, _ := setupReportTest(t)
-
-	// Try to update non-existent report - GORM doesn't error on no rows affected
-	nonexistentID := int64(99999)
-	err := reportRepo.UpdateStatus(context.Background(), nonexistentID, "resolved")
-	require.NoError(t, err) // GORM returns nil even if no rows updated
-}
-
 // TestReportRepository_Create_ViaRepository tests creating a report using the repository method
 func TestReportRepository_Create_ViaRepository(t *testing.T) {
 	_, reportRepo, userRepo := setupReportTest(t)
@@ -256,7 +229,7 @@ func TestReportRepository_Create_ViaRepository(t *testing.T) {
 	report := &entity.Report{
 		UserID:     user.UserID,
 		TargetType: "review",
-		TargetID:   1,
+		TargetID:   reportTargetID(1),
 		Reason:     "inappropriate content",
 		Status:     "pending",
 	}
@@ -264,13 +237,12 @@ func TestReportRepository_Create_ViaRepository(t *testing.T) {
 	err := reportRepo.Create(context.Background(), report)
 	require.NoError(t, err)
 
-	// Verify via FindAll
-	reports, err := reportRepo.FindAll(context.Background())
-	require.NoError(t, err)
+	// Verify via List
+	reports := listAllReports(t, reportRepo)
 	require.Len(t, reports, 1)
 	require.Equal(t, user.UserID, reports[0].UserID)
 	require.Equal(t, "review", reports[0].TargetType)
-	require.Equal(t, int64(1), reports[0].TargetID)
+	require.Equal(t, reportTargetID(1), reports[0].TargetID)
 	require.Equal(t, "inappropriate content", reports[0].Reason)
 	require.Equal(t, "pending", reports[0].Status)
 }
@@ -280,19 +252,19 @@ func TestReportRepository_Create_WithDifferentTargetTypes(t *testing.T) {
 	tests := []struct {
 		name       string
 		targetType string
-		targetID   int64
+		targetID   string
 		reason     string
 	}{
 		{
 			name:       "review target",
 			targetType: "review",
-			targetID:   100,
+			targetID:   reportTargetID(100),
 			reason:     "spam content",
 		},
 		{
 			name:       "store target",
 			targetType: "store",
-			targetID:   200,
+			targetID:   reportTargetID(200),
 			reason:     "fake store",
 		},
 	}
@@ -317,9 +289,8 @@ func TestReportRepository_Create_WithDifferentTargetTypes(t *testing.T) {
 			err := reportRepo.Create(context.Background(), report)
 			require.NoError(t, err)
 
-			// Verify via FindAll
-			reports, err := reportRepo.FindAll(context.Background())
-			require.NoError(t, err)
+			// Verify via List
+			reports := listAllReports(t, reportRepo)
 			require.Len(t, reports, 1)
 			require.Equal(t, tt.targetType, reports[0].TargetType)
 			require.Equal(t, tt.targetID, reports[0].TargetID)
@@ -340,7 +311,7 @@ func TestReportRepository_Create_MultipleReportsBySameUser(t *testing.T) {
 	report1 := &entity.Report{
 		UserID:     user.UserID,
 		TargetType: "review",
-		TargetID:   1,
+		TargetID:   reportTargetID(1),
 		Reason:     "spam",
 		Status:     "pending",
 	}
@@ -350,15 +321,14 @@ func TestReportRepository_Create_MultipleReportsBySameUser(t *testing.T) {
 	report2 := &entity.Report{
 		UserID:     user.UserID,
 		TargetType: "store",
-		TargetID:   2,
+		TargetID:   reportTargetID(2),
 		Reason:     "misleading info",
 		Status:     "pending",
 	}
 	require.NoError(t, reportRepo.Create(context.Background(), report2))
 
 	// Verify both reports exist
-	reports, err := reportRepo.FindAll(context.Background())
-	require.NoError(t, err)
+	reports := listAllReports(t, reportRepo)
 	require.Len(t, reports, 2)
 }
 
@@ -374,7 +344,7 @@ func TestReportRepository_Create_WithEmptyReason(t *testing.T) {
 	report := &entity.Report{
 		UserID:     user.UserID,
 		TargetType: "review",
-		TargetID:   1,
+		TargetID:   reportTargetID(1),
 		Reason:     "",
 		Status:     "pending",
 	}
@@ -383,8 +353,7 @@ func TestReportRepository_Create_WithEmptyReason(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify report was created
-	reports, err := reportRepo.FindAll(context.Background())
-	require.NoError(t, err)
+	reports := listAllReports(t, reportRepo)
 	require.Len(t, reports, 1)
 	require.Equal(t, "", reports[0].Reason)
 }
@@ -412,7 +381,7 @@ func TestReportRepository_Create_WithDifferentStatuses(t *testing.T) {
 			report := &entity.Report{
 				UserID:     user.UserID,
 				TargetType: "review",
-				TargetID:   1,
+				TargetID:   reportTargetID(1),
 				Reason:     "test reason",
 				Status:     tt.status,
 			}
@@ -421,10 +390,216 @@ func TestReportRepository_Create_WithDifferentStatuses(t *testing.T) {
 			require.NoError(t, err)
 
 			// Verify report was created with correct status
-			reports, err := reportRepo.FindAll(context.Background())
-			require.NoError(t, err)
+			reports := listAllReports(t, reportRepo)
 			require.Len(t, reports, 1)
 			require.Equal(t, tt.status, reports[0].Status)
 		})
 	}
 }
+
+// createReportUsers creates n users for report tests
+func createReportUsers(t *testing.T, userRepo output.UserRepository, n int) []*entity.User {
+	t.Helper()
+	users := make([]*entity.User, n)
+	for i := range users {
+		users[i] = newTestReportUser(t)
+		require.NoError(t, userRepo.Create(context.Background(), users[i]))
+	}
+	return users
+}
+
+// TestReportRepository_List_CollapsesReportsPerTarget tests reports on the same target are listed once with a count
+func TestReportRepository_List_CollapsesReportsPerTarget(t *testing.T) {
+	db, reportRepo, userRepo := setupReportTest(t)
+	users := createReportUsers(t, userRepo, 3)
+
+	insertReportDirectly(t, db, users[0].UserID, "review", reportTargetID(1), "pending")
+	insertReportDirectly(t, db, users[1].UserID, "review", reportTargetID(1), "pending")
+	latestID := insertReportDirectly(t, db, users[2].UserID, "review", reportTargetID(1), "pending")
+	otherID := insertReportDirectly(t, db, users[0].UserID, "store", reportTargetID(2), "pending")
+
+	reports := listAllReports(t, reportRepo)
+	require.Len(t, reports, 2)
+
+	require.Equal(t, otherID, reports[0].ReportID)
+	require.Equal(t, 1, reports[0].ReportCount)
+
+	require.Equal(t, latestID, reports[1].ReportID)
+	require.Equal(t, users[2].UserID, reports[1].UserID)
+	require.Equal(t, 3, reports[1].ReportCount)
+}
+
+// TestReportRepository_List_FiltersByStatusAndTargetType tests listing filters
+func TestReportRepository_List_FiltersByStatusAndTargetType(t *testing.T) {
+	db, reportRepo, userRepo := setupReportTest(t)
+	users := createReportUsers(t, userRepo, 1)
+
+	insertReportDirectly(t, db, users[0].UserID, "review", reportTargetID(1), "pending")
+	resolvedID := insertReportDirectly(t, db, users[0].UserID, "review", reportTargetID(2), "resolved")
+	storeID := insertReportDirectly(t, db, users[0].UserID, "store", reportTargetID(3), "pending")
+
+	status := "resolved"
+	page, err := reportRepo.List(context.Background(), output.ReportListQuery{Status: &status, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Reports, 1)
+	require.Equal(t, resolvedID, page.Reports[0].ReportID)
+
+	targetType := "store"
+	page, err = reportRepo.List(context.Background(), output.ReportListQuery{TargetType: &targetType, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Reports, 1)
+	require.Equal(t, storeID, page.Reports[0].ReportID)
+}
+
+// TestReportRepository_List_Pagination tests cursor based paging
+func TestReportRepository_List_Pagination(t *testing.T) {
+	db, reportRepo, userRepo := setupReportTest(t)
+	users := createReportUsers(t, userRepo, 1)
+
+	var ids []int64
+	for i := 1; i <= 3; i++ {
+		ids = append(ids, insertReportDirectly(t, db, users[0].UserID, "review", reportTargetID(i), "pending"))
+	}
+
+	first, err := reportRepo.List(context.Background(), output.ReportListQuery{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, first.Reports, 2)
+	require.Equal(t, ids[2], first.Reports[0].ReportID)
+	require.Equal(t, ids[1], first.Reports[1].ReportID)
+	require.NotEmpty(t, first.NextCursor)
+
+	second, err := reportRepo.List(context.Background(), output.ReportListQuery{Limit: 2, Cursor: first.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, second.Reports, 1)
+	require.Equal(t, ids[0], second.Reports[0].ReportID)
+	require.Empty(t, second.NextCursor)
+}
+
+// TestReportRepository_List_InvalidCursor tests a malformed cursor is rejected
+func TestReportRepository_List_InvalidCursor(t *testing.T) {
+	_, reportRepo, _ := setupReportTest(t)
+
+	_, err := reportRepo.List(context.Background(), output.ReportListQuery{Limit: 10, Cursor: "not-a-cursor"})
+	require.Error(t, err)
+	require.True(t, apperr.IsCode(err, apperr.CodeInvalidInput))
+}
+
+// TestReportRepository_HasPending tests detecting a pending report by the same user
+func TestReportRepository_HasPending(t *testing.T) {
+	db, reportRepo, userRepo := setupReportTest(t)
+	users := createReportUsers(t, userRepo, 2)
+
+	insertReportDirectly(t, db, users[0].UserID, "review", reportTargetID(1), "pending")
+	insertReportDirectly(t, db, users[0].UserID, "review", reportTargetID(2), "resolved")
+
+	tests := []struct {
+		name     string
+		userID   string
+		targetID string
+		want     bool
+	}{
+		{name: "pending report exists", userID: users[0].UserID, targetID: reportTargetID(1), want: true},
+		{name: "only resolved report exists", userID: users[0].UserID, targetID: reportTargetID(2), want: false},
+		{name: "other user", userID: users[1].UserID, targetID: reportTargetID(1), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := reportRepo.HasPending(context.Background(), tt.userID, "review", tt.targetID)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestReportRepository_ResolveInTx_ResolvesAllPendingForTarget tests every pending report on the target is resolved
+func TestReportRepository_ResolveInTx_ResolvesAllPendingForTarget(t *testing.T) {
+	db, reportRepo, userRepo := setupReportTest(t)
+	users := createReportUsers(t, userRepo, 3)
+
+	firstID := insertReportDirectly(t, db, users[0].UserID, "review", reportTargetID(1), "pending")
+	insertReportDirectly(t, db, users[1].UserID, "review", reportTargetID(1), "pending")
+	rejectedID := insertReportDirectly(t, db, users[1].UserID, "review", reportTargetID(1), "rejected")
+	otherID := insertReportDirectly(t, db, users[0].UserID, "review", reportTargetID(2), "pending")
+
+	report, err := reportRepo.FindByID(context.Background(), firstID)
+	require.NoError(t, err)
+
+	now := time.Now()
+	enforcement := "delete_review"
+	note := "spam confirmed"
+	report.Status = "resolved"
+	report.Enforcement = &enforcement
+	report.ResolutionNote = &note
+	report.ResolvedBy = &users[2].UserID
+	report.ResolvedAt = &now
+	report.UpdatedAt = now
+
+	var count int
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var txErr error
+		count, txErr = reportRepo.ResolveInTx(context.Background(), tx, report)
+		return txErr
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	resolved, err := reportRepo.FindByID(context.Background(), firstID)
+	require.NoError(t, err)
+	require.Equal(t, "resolved", resolved.Status)
+	require.NotNil(t, resolved.Enforcement)
+	require.Equal(t, enforcement, *resolved.Enforcement)
+	require.NotNil(t, resolved.ResolutionNote)
+	require.Equal(t, note, *resolved.ResolutionNote)
+	require.NotNil(t, resolved.ResolvedBy)
+	require.Equal(t, users[2].UserID, *resolved.ResolvedBy)
+	require.NotNil(t, resolved.ResolvedAt)
+
+	rejected, err := reportRepo.FindByID(context.Background(), rejectedID)
+	require.NoError(t, err)
+	require.Equal(t, "rejected", rejected.Status)
+
+	other, err := reportRepo.FindByID(context.Background(), otherID)
+	require.NoError(t, err)
+	require.Equal(t, "pending", other.Status)
+}
+
+// TestReportRepository_ResolveInTx_AlreadyHandled tests resolving a target without pending reports
+func TestReportRepository_ResolveInTx_AlreadyHandled(t *testing.T) {
+	db, reportRepo, userRepo := setupReportTest(t)
+	users := createReportUsers(t, userRepo, 1)
+
+	id := insertReportDirectly(t, db, users[0].UserID, "review", reportTargetID(1), "resolved")
+	report, err := reportRepo.FindByID(context.Background(), id)
+	require.NoError(t, err)
+
+	_, err = reportRepo.ResolveInTx(context.Background(), db, report)
+	require.ErrorIs(t, err, output.ErrReportAlreadyHandled)
+}
+
+// TestReportRepository_ResolveInTx_InvalidTransaction tests a non-gorm transaction is rejected
+func TestReportRepository_ResolveInTx_InvalidTransaction(t *testing.T) {
+	_, reportRepo, _ := setupReportTest(t)
+
+	_, err := reportRepo.ResolveInTx(context.Background(), nil, &entity.Report{})
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestReportRepository_FindByUserID(t *testing.T) {
+	db, reportRepo, userRepo := setupReportTest(t)
+	ctx := context.Background()
+
+	users := createReportUsers(t, userRepo, 2)
+	first := insertReportDirectly(t, db, users[0].UserID, "review", reportTargetID(1), "pending")
+	second := insertReportDirectly(t, db, users[0].UserID, "store", reportTargetID(2), "resolved")
+	insertReportDirectly(t, db, users[1].UserID, "review", reportTargetID(3), "pending")
+
+	reports, err := reportRepo.FindByUserID(ctx, users[0].UserID)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	require.ElementsMatch(t, []int64{first, second}, []int64{reports[0].ReportID, reports[1].ReportID})
+
+	reports, err = reportRepo.FindByUserID(ctx, "missing")
+	require.NoError(t, err)
+	require.Empty(t, reports)
+}
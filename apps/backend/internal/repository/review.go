@@ -32,14 +32,16 @@ type reviewRow struct {
 	RatingSpeed       *int      `gorm:"column:rating_speed"`
 	RatingCleanliness *int      `gorm:"column:rating_cleanliness"`
 	Content           *string   `gorm:"column:content"`
+	Visibility        string    `gorm:"column:visibility"`
 	CreatedAt         time.Time `gorm:"column:created_at"`
+	UpdatedAt         time.Time `gorm:"column:updated_at"`
 	LikesCount        int64     `gorm:"column:likes_count"`
 	LikedByMe         bool      `gorm:"column:liked_by_me"`
 }
 
-func (r *reviewRepository) FindByStoreID(ctx context.Context, storeID string, sort string, viewerID string) ([]entity.Review, error) {
+func (r *reviewRepository) FindByStoreID(ctx context.Context, storeID string, sort string, viewer output.Viewer) ([]entity.Review, error) {
 	var rows []reviewRow
-	query := r.baseReviewQuery(ctx, viewerID).
+	query := visibleReviews(r.baseReviewQuery(ctx, viewer.UserID), "r", viewer).
 		Where("r.store_id = ?", storeID).
 		Group("r.review_id")
 
@@ -56,9 +58,9 @@ func (r *reviewRepository) FindByStoreID(ctx context.Context, storeID string, so
 	return r.attachReviewRelations(ctx, rows)
 }
 
-func (r *reviewRepository) FindByUserID(ctx context.Context, userID string) ([]entity.Review, error) {
+func (r *reviewRepository) FindByUserID(ctx context.Context, userID string, viewer output.Viewer) ([]entity.Review, error) {
 	var rows []reviewRow
-	query := r.baseReviewQuery(ctx, "").
+	query := visibleReviews(r.baseReviewQuery(ctx, ""), "r", viewer).
 		Where("r.user_id = ?", userID).
 		Group("r.review_id").
 		Order("r.created_at desc")
@@ -70,6 +72,132 @@ func (r *reviewRepository) FindByUserID(ctx context.Context, userID string) ([]e
 	return r.attachReviewRelations(ctx, rows)
 }
 
+// reviewCursor is the keyset position of the last review on a page.
+type reviewCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"id"`
+}
+
+func (cursor reviewCursor) valid() bool {
+	return cursor.ID != ""
+}
+
+// FindFeed はフォロー中のユーザーのレビューと、お気に入りの店舗に投稿されたレビューを新しい順に返します。
+// 閲覧者自身のレビューは含めず、liked_by_me は閲覧者について計算します
+func (r *reviewRepository) FindFeed(ctx context.Context, query output.ReviewFeedQuery) (*output.ReviewPage, error) {
+	if query.Limit <= 0 {
+		query.Limit = constants.DefaultFeedLimit
+	}
+
+	db := visibleReviews(r.baseReviewQuery(ctx, query.UserID), "r", output.Viewer{}).
+		Where("r.user_id <> ?", query.UserID).
+		Where(
+			"r.user_id IN (SELECT uf.followee_id FROM user_follows uf WHERE uf.follower_id = ?) OR "+
+				"r.store_id IN (SELECT f.store_id FROM favorites f WHERE f.user_id = ?)",
+			query.UserID, query.UserID,
+		)
+	if query.Cursor != "" {
+		cursor, err := decodeCursor[reviewCursor](query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where(
+			"r.created_at < ? OR (r.created_at = ? AND r.review_id < ?)",
+			cursor.CreatedAt, cursor.CreatedAt, cursor.ID,
+		)
+	}
+
+	var rows []reviewRow
+	if err := db.Group("r.review_id").
+		Order("r.created_at DESC, r.review_id DESC").
+		Limit(query.Limit + 1).
+		Scan(&rows).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	page := &output.ReviewPage{}
+	if len(rows) > query.Limit {
+		rows = rows[:query.Limit]
+		last := rows[len(rows)-1]
+		page.NextCursor = encodeCursor(reviewCursor{CreatedAt: last.CreatedAt, ID: last.ReviewID})
+	}
+	reviews, err := r.attachReviewRelations(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+	page.Reviews = reviews
+	return page, nil
+}
+
+// FindReviewerStats はユーザーが書いた公開中のレビューの件数・受け取ったいいね数・よくレビューするカテゴリと、訪問記録がある公開中の店舗の数を集計します
+func (r *reviewRepository) FindReviewerStats(ctx context.Context, userID string) (*entity.ReviewerStats, error) {
+	public := output.Viewer{}
+	db := r.db.WithContext(ctx)
+
+	var reviewCount int64
+	if err := visibleReviews(db.Table("reviews r"), "r", public).
+		Where("r.user_id = ?", userID).
+		Count(&reviewCount).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	var storesVisited int64
+	if err := visibleStores(db.Table("store_visits sv").Joins("JOIN stores s ON s.store_id = sv.store_id"), "s", public).
+		Where("sv.user_id = ?", userID).
+		Distinct("sv.store_id").
+		Count(&storesVisited).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	var likes int64
+	if err := visibleReviews(db.Table("review_likes rl").Joins("JOIN reviews r ON r.review_id = rl.review_id"), "r", public).
+		Where("r.user_id = ?", userID).
+		Count(&likes).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	var categories []struct {
+		Category    string `gorm:"column:category"`
+		ReviewCount int    `gorm:"column:review_count"`
+	}
+	if err := visibleReviews(db.Table("reviews r").Joins("JOIN stores s ON s.store_id = r.store_id"), "r", public).
+		Select("s.category AS category, COUNT(*) AS review_count").
+		Where("r.user_id = ? AND s.category <> ''", userID).
+		Group("s.category").
+		Order("review_count DESC, s.category ASC").
+		Limit(constants.PublicProfileTopCategoryLimit).
+		Scan(&categories).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	stats := &entity.ReviewerStats{
+		ReviewCount:   int(reviewCount),
+		LikesReceived: int(likes),
+		StoresVisited: int(storesVisited),
+		TopCategories: make([]entity.CategoryCount, len(categories)),
+	}
+	for i, c := range categories {
+		stats.TopCategories[i] = entity.CategoryCount{Category: c.Category, ReviewCount: c.ReviewCount}
+	}
+	return stats, nil
+}
+
+func (r *reviewRepository) FindByIDs(ctx context.Context, reviewIDs []string) ([]entity.Review, error) {
+	if len(reviewIDs) == 0 {
+		return []entity.Review{}, nil
+	}
+	var rows []reviewRow
+	query := r.baseReviewQuery(ctx, "").
+		Where("r.review_id IN ?", reviewIDs).
+		Group("r.review_id")
+
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return r.attachReviewRelations(ctx, rows)
+}
+
 func (r *reviewRepository) FindByID(ctx context.Context, reviewID string) (*entity.Review, error) {
 	var review model.Review
 	if err := r.db.WithContext(ctx).First(&review, "review_id = ?", reviewID).Error; err != nil {
@@ -79,6 +207,16 @@ func (r *reviewRepository) FindByID(ctx context.Context, reviewID string) (*enti
 	return &entityReview, nil
 }
 
+func (r *reviewRepository) IsVisible(ctx context.Context, reviewID string, viewer output.Viewer) (bool, error) {
+	var count int64
+	if err := visibleReviews(r.db.WithContext(ctx).Table("reviews r"), "r", viewer).
+		Where("r.review_id = ?", reviewID).
+		Count(&count).Error; err != nil {
+		return false, mapDBError(err)
+	}
+	return count > 0, nil
+}
+
 func (r *reviewRepository) CreateInTx(ctx context.Context, tx interface{}, review output.CreateReview) error {
 	txAsserted, ok := tx.(*gorm.DB)
 	if !ok {
@@ -99,39 +237,179 @@ func (r *reviewRepository) CreateInTx(ctx context.Context, tx interface{}, revie
 		record.RatingCleanliness = review.RatingDetails.Cleanliness
 	}
 
-	if err := txAsserted.WithContext(ctx).Create(&record).Error; err != nil {
+	db := txAsserted.WithContext(ctx)
+	if err := db.Create(&record).Error; err != nil {
 		return mapDBError(err)
 	}
+	if err := linkReviewMenus(db, record.ReviewID, review.MenuIDs); err != nil {
+		return err
+	}
+	return linkReviewFiles(db, record.ReviewID, review.FileIDs)
+}
 
-	if len(review.MenuIDs) > 0 {
-		rows := make([]model.ReviewMenu, 0, len(review.MenuIDs))
-		for _, menuID := range review.MenuIDs {
-			rows = append(rows, model.ReviewMenu{
-				ReviewID: record.ReviewID,
-				MenuID:   menuID,
-			})
-		}
-		if err := txAsserted.WithContext(ctx).Create(&rows).Error; err != nil {
-			return mapDBError(err)
-		}
+func (r *reviewRepository) UpdateInTx(ctx context.Context, tx interface{}, reviewID string, review output.UpdateReview) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
 	}
+	db := txAsserted.WithContext(ctx)
 
-	if len(review.FileIDs) > 0 {
-		rows := make([]model.ReviewFile, 0, len(review.FileIDs))
-		for _, fileID := range review.FileIDs {
-			rows = append(rows, model.ReviewFile{
-				ReviewID: record.ReviewID,
-				FileID:   fileID,
-			})
-		}
-		if err := txAsserted.WithContext(ctx).Create(&rows).Error; err != nil {
+	details := review.RatingDetails
+	if details == nil {
+		details = &output.RatingDetails{}
+	}
+	result := db.Model(&model.Review{}).
+		Where("review_id = ?", reviewID).
+		Updates(map[string]any{
+			"rating":             review.Rating,
+			"rating_taste":       details.Taste,
+			"rating_atmosphere":  details.Atmosphere,
+			"rating_service":     details.Service,
+			"rating_speed":       details.Speed,
+			"rating_cleanliness": details.Cleanliness,
+			"content":            review.Content,
+			"updated_at":         time.Now(),
+		})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
+
+	if err := db.Where("review_id = ?", reviewID).Delete(&model.ReviewMenu{}).Error; err != nil {
+		return mapDBError(err)
+	}
+	if err := linkReviewMenus(db, reviewID, review.MenuIDs); err != nil {
+		return err
+	}
+
+	currentFileIDs, err := reviewFileIDs(db, reviewID)
+	if err != nil {
+		return err
+	}
+	if err := db.Where("review_id = ?", reviewID).Delete(&model.ReviewFile{}).Error; err != nil {
+		return mapDBError(err)
+	}
+	if err := linkReviewFiles(db, reviewID, review.FileIDs); err != nil {
+		return err
+	}
+	return markFilesDeleted(db, excludeStrings(currentFileIDs, review.FileIDs))
+}
+
+func (r *reviewRepository) DeleteInTx(ctx context.Context, tx interface{}, reviewID string) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	db := txAsserted.WithContext(ctx)
+
+	fileIDs, err := reviewFileIDs(db, reviewID)
+	if err != nil {
+		return err
+	}
+	if err := markFilesDeleted(db, fileIDs); err != nil {
+		return err
+	}
+
+	// 関連テーブルは ON DELETE CASCADE だが、FK を持たない環境でも残らないよう明示的に削除する
+	for _, relation := range []any{&model.ReviewMenu{}, &model.ReviewFile{}, &model.ReviewLike{}} {
+		if err := db.Where("review_id = ?", reviewID).Delete(relation).Error; err != nil {
 			return mapDBError(err)
 		}
 	}
 
+	result := db.Where("review_id = ?", reviewID).Delete(&model.Review{})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
 	return nil
 }
 
+func (r *reviewRepository) UpdateVisibilityInTx(ctx context.Context, tx interface{}, reviewID string, visibility string) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	result := txAsserted.WithContext(ctx).
+		Model(&model.Review{}).
+		Where("review_id = ?", reviewID).
+		Update("visibility", visibility)
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+func linkReviewMenus(db *gorm.DB, reviewID string, menuIDs []string) error {
+	if len(menuIDs) == 0 {
+		return nil
+	}
+	rows := make([]model.ReviewMenu, 0, len(menuIDs))
+	for _, menuID := range menuIDs {
+		rows = append(rows, model.ReviewMenu{
+			ReviewID: reviewID,
+			MenuID:   menuID,
+		})
+	}
+	return mapDBError(db.Create(&rows).Error)
+}
+
+func linkReviewFiles(db *gorm.DB, reviewID string, fileIDs []string) error {
+	if len(fileIDs) == 0 {
+		return nil
+	}
+	rows := make([]model.ReviewFile, 0, len(fileIDs))
+	for _, fileID := range fileIDs {
+		rows = append(rows, model.ReviewFile{
+			ReviewID: reviewID,
+			FileID:   fileID,
+		})
+	}
+	return mapDBError(db.Create(&rows).Error)
+}
+
+func reviewFileIDs(db *gorm.DB, reviewID string) ([]string, error) {
+	var fileIDs []string
+	if err := db.Model(&model.ReviewFile{}).
+		Where("review_id = ?", reviewID).
+		Pluck("file_id", &fileIDs).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return fileIDs, nil
+}
+
+// markFilesDeleted は指定したファイルを論理削除します
+func markFilesDeleted(db *gorm.DB, fileIDs []string) error {
+	if len(fileIDs) == 0 {
+		return nil
+	}
+	return mapDBError(db.Model(&model.File{}).
+		Where("file_id IN ?", fileIDs).
+		Update("is_deleted", true).Error)
+}
+
+// excludeStrings は values のうち excluded に含まれない要素を返します
+func excludeStrings(values, excluded []string) []string {
+	skip := make(map[string]struct{}, len(excluded))
+	for _, v := range excluded {
+		skip[v] = struct{}{}
+	}
+	var result []string
+	for _, v := range values {
+		if _, ok := skip[v]; !ok {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
 func (r *reviewRepository) AddLike(ctx context.Context, reviewID string, userID string) error {
 	record := model.ReviewLike{ReviewID: reviewID, UserID: userID}
 	return mapDBError(r.db.WithContext(ctx).
@@ -145,8 +423,30 @@ func (r *reviewRepository) RemoveLike(ctx context.Context, reviewID string, user
 		Delete(&model.ReviewLike{}).Error)
 }
 
+// FindLikesByUserID はユーザーがつけたいいねを新しい順に返します
+func (r *reviewRepository) FindLikesByUserID(ctx context.Context, userID string) ([]entity.ReviewLike, error) {
+	var likes []model.ReviewLike
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, review_id ASC").
+		Find(&likes).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToEntities[entity.ReviewLike, model.ReviewLike](likes), nil
+}
+
+func (r *reviewRepository) DeleteLikesByUserIDInTx(ctx context.Context, tx interface{}, userID string) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	return mapDBError(txAsserted.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.ReviewLike{}).Error)
+}
+
 func (r *reviewRepository) baseReviewQuery(ctx context.Context, viewerID string) *gorm.DB {
-	baseFields := "r.review_id, r.store_id, r.user_id, r.rating, r.rating_taste, r.rating_atmosphere, r.rating_service, r.rating_speed, r.rating_cleanliness, r.content, r.created_at, COUNT(rl.review_id) AS likes_count"
+	baseFields := "r.review_id, r.store_id, r.user_id, r.rating, r.rating_taste, r.rating_atmosphere, r.rating_service, r.rating_speed, r.rating_cleanliness, r.content, r.visibility, r.created_at, r.updated_at, COUNT(rl.review_id) AS likes_count"
 
 	query := r.db.WithContext(ctx).
 		Table("reviews r").
@@ -205,7 +505,9 @@ func (r *reviewRepository) attachReviewRelations(ctx context.Context, rows []rev
 			Rating:        row.Rating,
 			RatingDetails: ratingDetails,
 			Content:       row.Content,
+			Visibility:    row.Visibility,
 			CreatedAt:     row.CreatedAt,
+			UpdatedAt:     row.UpdatedAt,
 			LikesCount:    int(row.LikesCount),
 			LikedByMe:     row.LikedByMe,
 		}
@@ -234,7 +536,8 @@ func fetchReviewMenus(ctx context.Context, db *gorm.DB, reviewIDs []string) (map
 	var rows []reviewMenuRow
 	if err := db.WithContext(ctx).
 		Table("review_menus rm").
-		Select(`rm.review_id, m.menu_id, m.store_id, m.name, m.price, m.description, m.created_at`).
+		Select(`rm.review_id, m.menu_id, m.store_id, m.name, m.price, m.description, m.section,
+			m.is_available, m.sort_order, m.image_file_id, m.created_at, m.updated_at, m.deleted_at`).
 		Joins("JOIN menus m ON m.menu_id = rm.menu_id").
 		Where("rm.review_id IN ?", reviewIDs).
 		Order("rm.review_id asc, m.menu_id asc").
@@ -263,7 +566,8 @@ func fetchReviewFiles(ctx context.Context, db *gorm.DB, reviewIDs []string) (map
 	var rows []reviewFileRow
 	if err := db.WithContext(ctx).
 		Table("review_files rf").
-		Select(`rf.review_id, f.file_id, f.file_kind, f.file_name, f.file_size, f.object_key, f.content_type, f.is_deleted, f.created_at, f.created_by`).
+		Select(`rf.review_id, f.file_id, f.file_kind, f.file_name, f.file_size, f.object_key, f.content_type, f.is_deleted,
+			f.created_at, f.created_by, f.uploaded_at, f.processed_at, f.variants`).
 		Joins("JOIN files f ON f.file_id = rf.file_id").
 		Where("rf.review_id IN ?", reviewIDs).
 		Order("rf.review_id asc, f.file_id asc").
@@ -10,6 +10,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
@@ -49,16 +50,17 @@ func newTestReviewUser(t *testing.T) *entity.User {
 func newTestReviewStore(t *testing.T) *entity.Store {
 	t.Helper()
 	return &entity.Store{
-		StoreID:   "store-" + uuid.New().String()[:8],
-		Name:      "Test Store",
-		Address:   "Test Address",
-		Latitude:  35.6812,
-		Longitude: 139.7671,
-		PlaceID:   "place-" + uuid.New().String()[:8],
-		Category:  "カフェ・喫茶",
-		Budget:    "$$",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		StoreID:    "store-" + uuid.New().String()[:8],
+		Name:       "Test Store",
+		Address:    "Test Address",
+		Latitude:   35.6812,
+		Longitude:  139.7671,
+		PlaceID:    "place-" + uuid.New().String()[:8],
+		Category:   "カフェ・喫茶",
+		Budget:     "$$",
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Visibility: constants.VisibilityPublished,
 	}
 }
 
@@ -133,6 +135,32 @@ func TestReviewRepository_FindByID_NotFound(t *testing.T) {
 	require.True(t, apperr.IsCode(err, apperr.CodeNotFound), "expected CodeNotFound error, got %v", err)
 }
 
+// TestReviewRepository_FindByIDs tests loading several reviews at once
+func TestReviewRepository_FindByIDs(t *testing.T) {
+	db, reviewRepo, userRepo, storeRepo, _ := setupReviewTest(t)
+	ctx := context.Background()
+
+	user := newTestReviewUser(t)
+	require.NoError(t, userRepo.Create(ctx, user))
+	store := newTestReviewStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	reviewID1 := "review-" + uuid.New().String()[:8]
+	reviewID2 := "review-" + uuid.New().String()[:8]
+	insertReviewDirectly(t, db, reviewID1, store.StoreID, user.UserID, 5, "Great place!")
+	insertReviewDirectly(t, db, reviewID2, store.StoreID, user.UserID, 3, "Okay")
+
+	reviews, err := reviewRepo.FindByIDs(ctx, []string{reviewID2, "missing"})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Equal(t, reviewID2, reviews[0].ReviewID)
+	require.Equal(t, 3, reviews[0].Rating)
+
+	empty, err := reviewRepo.FindByIDs(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, empty)
+}
+
 // TestReviewRepository_FindByStoreID_Success tests finding reviews by store ID
 func TestReviewRepository_FindByStoreID_Success(t *testing.T) {
 	db, reviewRepo, userRepo, storeRepo, _ := setupReviewTest(t)
@@ -153,7 +181,7 @@ func TestReviewRepository_FindByStoreID_Success(t *testing.T) {
 	insertReviewDirectly(t, db, reviewID2, store.StoreID, user2.UserID, 5, "Great service!")
 
 	// Find by store ID with default sort (created_at desc)
-	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", "")
+	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", output.Viewer{})
 	require.NoError(t, err)
 	require.Len(t, reviews, 2)
 }
@@ -165,7 +193,7 @@ func TestReviewRepository_FindByStoreID_Empty(t *testing.T) {
 	store := newTestReviewStore(t)
 	require.NoError(t, storeRepo.Create(context.Background(), store))
 
-	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", "")
+	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", output.Viewer{})
 	require.NoError(t, err)
 	require.Empty(t, reviews)
 }
@@ -195,7 +223,7 @@ func TestReviewRepository_FindByStoreID_SortByLiked(t *testing.T) {
 	insertReviewLikeDirectly(t, db, review2ID, user3.UserID)
 
 	// Find by store ID sorted by likes
-	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "liked", "")
+	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "liked", output.Viewer{})
 	require.NoError(t, err)
 	require.Len(t, reviews, 2)
 	// The more popular review should come first
@@ -224,7 +252,7 @@ func TestReviewRepository_FindByStoreID_WithViewerID(t *testing.T) {
 	insertReviewLikeDirectly(t, db, reviewID, viewer.UserID)
 
 	// Find by store ID with viewer ID
-	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", viewer.UserID)
+	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", output.Viewer{UserID: viewer.UserID})
 	require.NoError(t, err)
 	require.Len(t, reviews, 1)
 	require.True(t, reviews[0].LikedByMe)
@@ -251,7 +279,7 @@ func TestReviewRepository_FindByUserID_Success(t *testing.T) {
 	insertReviewDirectly(t, db, reviewID2, store2.StoreID, user.UserID, 5, "Nice restaurant!")
 
 	// Find by user ID
-	reviews, err := reviewRepo.FindByUserID(context.Background(), user.UserID)
+	reviews, err := reviewRepo.FindByUserID(context.Background(), user.UserID, output.Viewer{})
 	require.NoError(t, err)
 	require.Len(t, reviews, 2)
 }
@@ -263,7 +291,7 @@ func TestReviewRepository_FindByUserID_Empty(t *testing.T) {
 	user := newTestReviewUser(t)
 	require.NoError(t, userRepo.Create(context.Background(), user))
 
-	reviews, err := reviewRepo.FindByUserID(context.Background(), user.UserID)
+	reviews, err := reviewRepo.FindByUserID(context.Background(), user.UserID, output.Viewer{})
 	require.NoError(t, err)
 	require.Empty(t, reviews)
 }
@@ -294,7 +322,7 @@ func TestReviewRepository_CreateInTx_Success(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify review was created
-	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", "")
+	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", output.Viewer{})
 	require.NoError(t, err)
 	require.Len(t, reviews, 1)
 	require.Equal(t, 5, reviews[0].Rating)
@@ -336,7 +364,7 @@ func TestReviewRepository_CreateInTx_WithMenus(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify review was created with menus
-	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", "")
+	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", output.Viewer{})
 	require.NoError(t, err)
 	require.Len(t, reviews, 1)
 	require.Len(t, reviews[0].Menus, 2)
@@ -375,7 +403,7 @@ func TestReviewRepository_CreateInTx_WithFiles(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify review was created with files
-	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", "")
+	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", output.Viewer{})
 	require.NoError(t, err)
 	require.Len(t, reviews, 1)
 	require.Len(t, reviews[0].Files, 2)
@@ -427,7 +455,7 @@ func TestReviewRepository_AddLike_Success(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify like was added
-	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", "")
+	reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", output.Viewer{})
 	require.NoError(t, err)
 	require.Len(t, reviews, 1)
 	require.Equal(t, 1, reviews[0].LikesCount)
@@ -486,7 +514,7 @@ func TestReviewRepository_LikeOperations(t *testing.T) {
 			}
 
 			// Verify likes count
-			reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", "")
+			reviews, err := reviewRepo.FindByStoreID(context.Background(), store.StoreID, "", output.Viewer{})
 			require.NoError(t, err)
 			require.Len(t, reviews, 1)
 			require.Equal(t, tt.expectedLikes, reviews[0].LikesCount)
@@ -515,3 +543,348 @@ func TestReviewRepository_RemoveLike_NonexistentLike(t *testing.T) {
 	err := reviewRepo.RemoveLike(context.Background(), reviewID, user2.UserID)
 	require.NoError(t, err)
 }
+
+// createReviewWithFiles inserts a review linked to the given menus and files and returns its ID.
+// IDs are set explicitly because sqlite has no gen_random_uuid default.
+func createReviewWithFiles(t *testing.T, db *gorm.DB, storeID, userID string, menuIDs, fileIDs []string) string {
+	t.Helper()
+	reviewID := "review-" + uuid.New().String()[:8]
+	insertReviewDirectly(t, db, reviewID, storeID, userID, 3, "Original content")
+	for _, menuID := range menuIDs {
+		require.NoError(t, db.Exec("INSERT INTO review_menus (review_id, menu_id) VALUES (?, ?)", reviewID, menuID).Error)
+	}
+	for _, fileID := range fileIDs {
+		require.NoError(t, db.Exec("INSERT INTO review_files (review_id, file_id) VALUES (?, ?)", reviewID, fileID).Error)
+	}
+	return reviewID
+}
+
+func isFileDeleted(t *testing.T, db *gorm.DB, fileID string) bool {
+	t.Helper()
+	var deleted bool
+	require.NoError(t, db.Raw("SELECT is_deleted FROM files WHERE file_id = ?", fileID).Scan(&deleted).Error)
+	return deleted
+}
+
+// TestReviewRepository_UpdateInTx_RelinksMenusAndFiles tests rewriting a review and its relations
+func TestReviewRepository_UpdateInTx_RelinksMenusAndFiles(t *testing.T) {
+	db, reviewRepo, userRepo, storeRepo, fileRepo := setupReviewTest(t)
+	ctx := context.Background()
+
+	user := newTestReviewUser(t)
+	require.NoError(t, userRepo.Create(ctx, user))
+	store := newTestReviewStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	menuID1 := "menu-" + uuid.New().String()[:8]
+	menuID2 := "menu-" + uuid.New().String()[:8]
+	for _, id := range []string{menuID1, menuID2} {
+		require.NoError(t, db.Exec("INSERT INTO menus (menu_id, store_id, name, created_at) VALUES (?, ?, ?, ?)",
+			id, store.StoreID, "Menu", time.Now()).Error)
+	}
+	kept := newTestFile(t, user.UserID)
+	removed := newTestFile(t, user.UserID)
+	added := newTestFile(t, user.UserID)
+	for _, f := range []*entity.File{kept, removed, added} {
+		require.NoError(t, fileRepo.Create(ctx, f))
+	}
+
+	reviewID := createReviewWithFiles(t, db, store.StoreID, user.UserID,
+		[]string{menuID1}, []string{kept.FileID, removed.FileID})
+
+	content := "Updated content"
+	err := repository.NewGormTransaction(db).StartTransaction(func(txDB interface{}) error {
+		return reviewRepo.UpdateInTx(ctx, txDB, reviewID, output.UpdateReview{
+			Rating:        5,
+			RatingDetails: &output.RatingDetails{Taste: intPtr(4)},
+			Content:       &content,
+			MenuIDs:       []string{menuID2},
+			FileIDs:       []string{kept.FileID, added.FileID},
+		})
+	})
+	require.NoError(t, err)
+
+	reviews, err := reviewRepo.FindByStoreID(ctx, store.StoreID, "", output.Viewer{})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	got := reviews[0]
+	require.Equal(t, 5, got.Rating)
+	require.Equal(t, content, *got.Content)
+	require.NotNil(t, got.RatingDetails)
+	require.Equal(t, 4, *got.RatingDetails.Taste)
+	require.True(t, got.IsEdited())
+	require.Len(t, got.Menus, 1)
+	require.Equal(t, menuID2, got.Menus[0].MenuID)
+	require.ElementsMatch(t, []string{kept.FileID, added.FileID}, []string{got.Files[0].FileID, got.Files[1].FileID})
+
+	require.False(t, isFileDeleted(t, db, kept.FileID))
+	require.True(t, isFileDeleted(t, db, removed.FileID))
+	require.False(t, isFileDeleted(t, db, added.FileID))
+}
+
+// TestReviewRepository_UpdateInTx_NotFound tests updating a missing review
+func TestReviewRepository_UpdateInTx_NotFound(t *testing.T) {
+	db, reviewRepo, _, _, _ := setupReviewTest(t)
+
+	err := repository.NewGormTransaction(db).StartTransaction(func(txDB interface{}) error {
+		return reviewRepo.UpdateInTx(context.Background(), txDB, "missing-review", output.UpdateReview{Rating: 4})
+	})
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+}
+
+// TestReviewRepository_DeleteInTx_MarksFilesDeleted tests deleting a review with attachments
+func TestReviewRepository_DeleteInTx_MarksFilesDeleted(t *testing.T) {
+	db, reviewRepo, userRepo, storeRepo, fileRepo := setupReviewTest(t)
+	ctx := context.Background()
+
+	user := newTestReviewUser(t)
+	require.NoError(t, userRepo.Create(ctx, user))
+	store := newTestReviewStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+	file := newTestFile(t, user.UserID)
+	require.NoError(t, fileRepo.Create(ctx, file))
+
+	reviewID := createReviewWithFiles(t, db, store.StoreID, user.UserID, nil, []string{file.FileID})
+	require.NoError(t, reviewRepo.AddLike(ctx, reviewID, user.UserID))
+
+	err := repository.NewGormTransaction(db).StartTransaction(func(txDB interface{}) error {
+		return reviewRepo.DeleteInTx(ctx, txDB, reviewID)
+	})
+	require.NoError(t, err)
+
+	_, err = reviewRepo.FindByID(ctx, reviewID)
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+	require.True(t, isFileDeleted(t, db, file.FileID))
+
+	var remaining int64
+	require.NoError(t, db.Table("review_files").Where("review_id = ?", reviewID).Count(&remaining).Error)
+	require.Zero(t, remaining)
+	require.NoError(t, db.Table("review_likes").Where("review_id = ?", reviewID).Count(&remaining).Error)
+	require.Zero(t, remaining)
+}
+
+// TestReviewRepository_DeleteInTx_NotFound tests deleting a missing review
+func TestReviewRepository_DeleteInTx_NotFound(t *testing.T) {
+	db, reviewRepo, _, _, _ := setupReviewTest(t)
+
+	err := repository.NewGormTransaction(db).StartTransaction(func(txDB interface{}) error {
+		return reviewRepo.DeleteInTx(context.Background(), txDB, "missing-review")
+	})
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+}
+
+// TestReviewRepository_UpdateAndDeleteInTx_InvalidTransaction tests passing a non-gorm transaction
+func TestReviewRepository_UpdateAndDeleteInTx_InvalidTransaction(t *testing.T) {
+	_, reviewRepo, _, _, _ := setupReviewTest(t)
+
+	require.Equal(t, output.ErrInvalidTransaction,
+		reviewRepo.UpdateInTx(context.Background(), "invalid", "review-1", output.UpdateReview{Rating: 3}))
+	require.Equal(t, output.ErrInvalidTransaction,
+		reviewRepo.DeleteInTx(context.Background(), "invalid", "review-1"))
+}
+
+// TestReviewRepository_LikesByUserID tests listing and deleting the likes given by a user
+func TestReviewRepository_LikesByUserID(t *testing.T) {
+	db, reviewRepo, userRepo, storeRepo, _ := setupReviewTest(t)
+	ctx := context.Background()
+
+	author := newTestReviewUser(t)
+	liker := newTestReviewUser(t)
+	require.NoError(t, userRepo.Create(ctx, author))
+	require.NoError(t, userRepo.Create(ctx, liker))
+	store := newTestReviewStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	reviewID := "review-" + uuid.New().String()[:8]
+	insertReviewDirectly(t, db, reviewID, store.StoreID, author.UserID, 5, "Great place!")
+	insertReviewLikeDirectly(t, db, reviewID, liker.UserID)
+	insertReviewLikeDirectly(t, db, reviewID, author.UserID)
+
+	likes, err := reviewRepo.FindLikesByUserID(ctx, liker.UserID)
+	require.NoError(t, err)
+	require.Len(t, likes, 1)
+	require.Equal(t, reviewID, likes[0].ReviewID)
+
+	tx := repository.NewGormTransaction(db)
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return reviewRepo.DeleteLikesByUserIDInTx(ctx, txDB, liker.UserID)
+	}))
+
+	likes, err = reviewRepo.FindLikesByUserID(ctx, liker.UserID)
+	require.NoError(t, err)
+	require.Empty(t, likes)
+	likes, err = reviewRepo.FindLikesByUserID(ctx, author.UserID)
+	require.NoError(t, err)
+	require.Len(t, likes, 1)
+
+	err = reviewRepo.DeleteLikesByUserIDInTx(ctx, nil, liker.UserID)
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+// TestFileRepository_UnlinkByCreatorInTx tests unlinking the files uploaded by a user without deleting them
+func TestFileRepository_UnlinkByCreatorInTx(t *testing.T) {
+	db, _, userRepo, storeRepo, fileRepo := setupReviewTest(t)
+	ctx := context.Background()
+
+	user := newTestReviewUser(t)
+	other := newTestReviewUser(t)
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, userRepo.Create(ctx, other))
+	store := newTestReviewStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	own := newTestFile(t, user.UserID)
+	kept := newTestFile(t, other.UserID)
+	require.NoError(t, fileRepo.Create(ctx, own))
+	require.NoError(t, fileRepo.Create(ctx, kept))
+	require.NoError(t, fileRepo.LinkToStore(ctx, store.StoreID, own.FileID))
+	require.NoError(t, db.Exec("UPDATE stores SET thumbnail_file_id = ? WHERE store_id = ?", own.FileID, store.StoreID).Error)
+	reviewID := createReviewWithFiles(t, db, store.StoreID, other.UserID, nil, []string{own.FileID, kept.FileID})
+
+	uploaded, err := fileRepo.FindByCreator(ctx, user.UserID)
+	require.NoError(t, err)
+	require.Len(t, uploaded, 1)
+
+	tx := repository.NewGormTransaction(db)
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return fileRepo.UnlinkByCreatorInTx(ctx, txDB, user.UserID)
+	}))
+
+	require.False(t, isFileDeleted(t, db, own.FileID))
+	require.False(t, isFileDeleted(t, db, kept.FileID))
+
+	var reviewFiles int64
+	require.NoError(t, db.Raw("SELECT COUNT(*) FROM review_files WHERE review_id = ?", reviewID).Scan(&reviewFiles).Error)
+	require.Equal(t, int64(1), reviewFiles)
+	var storeFiles int64
+	require.NoError(t, db.Raw("SELECT COUNT(*) FROM store_files WHERE store_id = ?", store.StoreID).Scan(&storeFiles).Error)
+	require.Zero(t, storeFiles)
+	var thumbnail *string
+	require.NoError(t, db.Raw("SELECT thumbnail_file_id FROM stores WHERE store_id = ?", store.StoreID).Scan(&thumbnail).Error)
+	require.Nil(t, thumbnail)
+
+	// オブジェクトを Storage から削除するまでは論理削除しない
+	uploaded, err = fileRepo.FindByCreator(ctx, user.UserID)
+	require.NoError(t, err)
+	require.Len(t, uploaded, 1)
+
+	err = fileRepo.UnlinkByCreatorInTx(ctx, nil, user.UserID)
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+// TestReviewRepository_FindReviewerStats tests aggregating the public reviews of a user
+func TestReviewRepository_FindReviewerStats(t *testing.T) {
+	db, reviewRepo, userRepo, storeRepo, _ := setupReviewTest(t)
+	ctx := context.Background()
+
+	author := newTestReviewUser(t)
+	liker1 := newTestReviewUser(t)
+	liker2 := newTestReviewUser(t)
+	for _, user := range []*entity.User{author, liker1, liker2} {
+		require.NoError(t, userRepo.Create(ctx, user))
+	}
+	cafe := newTestReviewStore(t)
+	ramen := newTestReviewStore(t)
+	ramen.Category = "ラーメン"
+	washoku := newTestReviewStore(t)
+	washoku.Category = "和食"
+	for _, store := range []*entity.Store{cafe, ramen, washoku} {
+		require.NoError(t, storeRepo.Create(ctx, store))
+	}
+
+	first := "review-" + uuid.New().String()[:8]
+	second := "review-" + uuid.New().String()[:8]
+	third := "review-" + uuid.New().String()[:8]
+	hidden := "review-" + uuid.New().String()[:8]
+	insertReviewDirectly(t, db, first, cafe.StoreID, author.UserID, 5, "First")
+	insertReviewDirectly(t, db, second, cafe.StoreID, author.UserID, 4, "Second")
+	insertReviewDirectly(t, db, third, ramen.StoreID, author.UserID, 3, "Third")
+	insertReviewDirectly(t, db, hidden, washoku.StoreID, author.UserID, 1, "Hidden")
+	require.NoError(t, db.Exec("UPDATE reviews SET visibility = ? WHERE review_id = ?", constants.VisibilityHidden, hidden).Error)
+	insertReviewLikeDirectly(t, db, first, liker1.UserID)
+	insertReviewLikeDirectly(t, db, first, liker2.UserID)
+	insertReviewLikeDirectly(t, db, hidden, liker1.UserID)
+
+	// 訪問記録は店舗ごとに数え、非公開の店舗は含めない
+	closed := newTestReviewStore(t)
+	require.NoError(t, storeRepo.Create(ctx, closed))
+	require.NoError(t, db.Exec("UPDATE stores SET visibility = ? WHERE store_id = ?", constants.VisibilityHidden, closed.StoreID).Error)
+	for i, storeID := range []string{cafe.StoreID, cafe.StoreID, washoku.StoreID, closed.StoreID} {
+		require.NoError(t, db.Exec(
+			"INSERT INTO store_visits (user_id, store_id, visited_on, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+			author.UserID, storeID, time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC), time.Now(), time.Now(),
+		).Error)
+	}
+
+	stats, err := reviewRepo.FindReviewerStats(ctx, author.UserID)
+	require.NoError(t, err)
+	require.Equal(t, 3, stats.ReviewCount)
+	require.Equal(t, 2, stats.StoresVisited)
+	require.Equal(t, 2, stats.LikesReceived)
+	require.Equal(t, []entity.CategoryCount{
+		{Category: "カフェ・喫茶", ReviewCount: 2},
+		{Category: "ラーメン", ReviewCount: 1},
+	}, stats.TopCategories)
+
+	stats, err = reviewRepo.FindReviewerStats(ctx, liker1.UserID)
+	require.NoError(t, err)
+	require.Zero(t, stats.ReviewCount)
+	require.Zero(t, stats.StoresVisited)
+	require.Empty(t, stats.TopCategories)
+}
+
+func TestReviewRepository_FindFeed(t *testing.T) {
+	db, reviewRepo, userRepo, storeRepo, _ := setupReviewTest(t)
+	ctx := context.Background()
+	followRepo := repository.NewFollowRepository(db)
+
+	viewer := newTestReviewUser(t)
+	followed := newTestReviewUser(t)
+	stranger := newTestReviewUser(t)
+	for _, user := range []*entity.User{viewer, followed, stranger} {
+		require.NoError(t, userRepo.Create(ctx, user))
+	}
+	favorite := newTestReviewStore(t)
+	other := newTestReviewStore(t)
+	for _, store := range []*entity.Store{favorite, other} {
+		require.NoError(t, storeRepo.Create(ctx, store))
+	}
+	require.NoError(t, followRepo.Create(ctx, viewer.UserID, followed.UserID))
+	require.NoError(t, db.Exec(
+		"INSERT INTO favorites (user_id, store_id, created_at) VALUES (?, ?, ?)", viewer.UserID, favorite.StoreID, time.Now(),
+	).Error)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	insertAt := func(storeID, userID string, offset time.Duration) string {
+		reviewID := "review-" + uuid.New().String()[:8]
+		insertReviewDirectly(t, db, reviewID, storeID, userID, 4, "Review")
+		require.NoError(t, db.Exec("UPDATE reviews SET created_at = ? WHERE review_id = ?", base.Add(offset), reviewID).Error)
+		return reviewID
+	}
+	byFollowed := insertAt(other.StoreID, followed.UserID, 1*time.Hour)
+	onFavorite := insertAt(favorite.StoreID, stranger.UserID, 2*time.Hour)
+	both := insertAt(favorite.StoreID, followed.UserID, 3*time.Hour)
+	insertAt(other.StoreID, stranger.UserID, 4*time.Hour)
+	insertAt(favorite.StoreID, viewer.UserID, 5*time.Hour)
+	hidden := insertAt(other.StoreID, followed.UserID, 6*time.Hour)
+	require.NoError(t, db.Exec("UPDATE reviews SET visibility = ? WHERE review_id = ?", constants.VisibilityHidden, hidden).Error)
+	insertReviewLikeDirectly(t, db, onFavorite, viewer.UserID)
+
+	page, err := reviewRepo.FindFeed(ctx, output.ReviewFeedQuery{UserID: viewer.UserID, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Reviews, 2)
+	require.Equal(t, both, page.Reviews[0].ReviewID)
+	require.Equal(t, onFavorite, page.Reviews[1].ReviewID)
+	require.False(t, page.Reviews[0].LikedByMe)
+	require.True(t, page.Reviews[1].LikedByMe)
+	require.NotEmpty(t, page.NextCursor)
+
+	page, err = reviewRepo.FindFeed(ctx, output.ReviewFeedQuery{UserID: viewer.UserID, Limit: 2, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Reviews, 1)
+	require.Equal(t, byFollowed, page.Reviews[0].ReviewID)
+	require.Empty(t, page.NextCursor)
+
+	_, err = reviewRepo.FindFeed(ctx, output.ReviewFeedQuery{UserID: viewer.UserID, Cursor: "%%%"})
+	require.Equal(t, apperr.CodeInvalidInput, apperr.CodeOf(err))
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+)
+
+type searchRepository struct {
+	db *gorm.DB
+}
+
+// NewSearchRepository は pg_trgm を使った SearchRepository の実装を生成します。
+// 照合には migrations で定義した search_normalize 関数と trigram インデックスを使います
+func NewSearchRepository(db *gorm.DB) output.SearchRepository {
+	return &searchRepository{db: db}
+}
+
+// searchCursor is the keyset position of the last hit on a page.
+type searchCursor struct {
+	Score float64 `json:"sc"`
+	Type  string  `json:"t"`
+	ID    string  `json:"id"`
+}
+
+func (cursor searchCursor) valid() bool {
+	return cursor.ID != "" && cursor.Type != ""
+}
+
+// フィールドごとの重み。店舗名での一致を最も高く、説明文での一致を最も低く評価する
+const (
+	searchWeightStoreName        = 1.0
+	searchWeightStoreNameKana    = 0.9
+	searchWeightStoreTag         = 0.8
+	searchWeightReviewContent    = 0.7
+	searchWeightStoreMenu        = 0.6
+	searchWeightStoreAddress     = 0.5
+	searchWeightStoreDescription = 0.4
+)
+
+// searchFieldScore は部分一致なら 1、それ以外は検索語との word_similarity を返す SQL 式を組み立てます
+func searchFieldScore(expr string) string {
+	return fmt.Sprintf(`CASE WHEN %[1]s LIKE @pattern ESCAPE '\' THEN 1 ELSE word_similarity(@query, %[1]s) END`, expr)
+}
+
+// searchFieldMatch は部分一致または類似度（<%）で一致する条件を組み立てます。どちらも trigram インデックスを使えます
+func searchFieldMatch(expr string) string {
+	return fmt.Sprintf(`(%[1]s LIKE @pattern ESCAPE '\' OR @query <%% %[1]s)`, expr)
+}
+
+// searchStoresSQL は公開中の店舗を店舗名・読み・タグ・メニュー名・住所・説明文から探します。
+// 候補をインデックスで絞り込んでから、各フィールドの重み付きスコアの最大値を店舗のスコアにします
+var searchStoresSQL = fmt.Sprintf(`SELECT '%s' AS type, s.store_id::text AS id, GREATEST(
+		%s * %g,
+		%s * %g,
+		COALESCE((SELECT MAX(%s) FROM store_tags st WHERE st.store_id = s.store_id), 0) * %g,
+		COALESCE((SELECT MAX(%s) FROM menus m WHERE m.store_id = s.store_id AND m.deleted_at IS NULL), 0) * %g,
+		%s * %g,
+		%s * %g
+	)::float8 AS score
+	FROM stores s
+	WHERE s.visibility = '%s' AND s.store_id IN (
+		SELECT store_id FROM stores WHERE %s OR %s OR %s OR %s
+		UNION
+		SELECT store_id FROM store_tags WHERE %s
+		UNION
+		SELECT store_id FROM menus WHERE deleted_at IS NULL AND %s
+	)`,
+	constants.SearchTypeStore,
+	searchFieldScore("search_normalize(s.name)"), searchWeightStoreName,
+	searchFieldScore("search_normalize(s.name_kana)"), searchWeightStoreNameKana,
+	searchFieldScore("search_normalize(st.tag)"), searchWeightStoreTag,
+	searchFieldScore("search_normalize(m.name)"), searchWeightStoreMenu,
+	searchFieldScore("search_normalize(s.address)"), searchWeightStoreAddress,
+	searchFieldScore("search_normalize(s.description)"), searchWeightStoreDescription,
+	constants.VisibilityPublished,
+	searchFieldMatch("search_normalize(name)"),
+	searchFieldMatch("search_normalize(name_kana)"),
+	searchFieldMatch("search_normalize(address)"),
+	searchFieldMatch("search_normalize(description)"),
+	searchFieldMatch("search_normalize(tag)"),
+	searchFieldMatch("search_normalize(name)"),
+)
+
+// searchReviewsSQL は公開中の店舗にある公開中のレビューの本文から探します
+var searchReviewsSQL = fmt.Sprintf(`SELECT '%s' AS type, r.review_id::text AS id, (%s * %g)::float8 AS score
+	FROM reviews r
+	JOIN stores s ON s.store_id = r.store_id
+	WHERE s.visibility = '%[4]s' AND r.visibility = '%[4]s' AND %[5]s`,
+	constants.SearchTypeReview,
+	searchFieldScore("search_normalize(r.content)"), searchWeightReviewContent,
+	constants.VisibilityPublished,
+	searchFieldMatch("search_normalize(r.content)"),
+)
+
+type searchHitRow struct {
+	Type  string  `gorm:"column:type"`
+	ID    string  `gorm:"column:id"`
+	Score float64 `gorm:"column:score"`
+}
+
+// Search returns hits ordered by score, then by type and id so that the keyset cursor is stable.
+func (r *searchRepository) Search(ctx context.Context, query output.SearchQuery) (*output.SearchPage, error) {
+	if query.Limit <= 0 {
+		query.Limit = constants.DefaultSearchLimit
+	}
+
+	var parts []string
+	if query.Type == "" || query.Type == constants.SearchTypeStore {
+		parts = append(parts, searchStoresSQL)
+	}
+	if query.Type == "" || query.Type == constants.SearchTypeReview {
+		parts = append(parts, searchReviewsSQL)
+	}
+	if len(parts) == 0 {
+		return &output.SearchPage{Hits: []entity.SearchHit{}}, nil
+	}
+
+	args := map[string]interface{}{
+		"query":   query.Query,
+		"pattern": searchLikePattern(query.Query),
+		"limit":   query.Limit + 1,
+	}
+	sql := "SELECT type, id, score FROM (" + strings.Join(parts, " UNION ALL ") + ") AS hits"
+	if query.Cursor != "" {
+		cursor, err := decodeCursor[searchCursor](query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		sql += " WHERE score < @score OR (score = @score AND (type > @type OR (type = @type AND id > @id)))"
+		args["score"] = cursor.Score
+		args["type"] = cursor.Type
+		args["id"] = cursor.ID
+	}
+	sql += " ORDER BY score DESC, type ASC, id ASC LIMIT @limit"
+
+	var rows []searchHitRow
+	if err := r.db.WithContext(ctx).Raw(sql, args).Scan(&rows).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	page := &output.SearchPage{}
+	if len(rows) > query.Limit {
+		rows = rows[:query.Limit]
+		last := rows[len(rows)-1]
+		page.NextCursor = encodeCursor(searchCursor{Score: last.Score, Type: last.Type, ID: last.ID})
+	}
+	page.Hits = make([]entity.SearchHit, len(rows))
+	for i, row := range rows {
+		page.Hits[i] = entity.SearchHit{Type: row.Type, ID: row.ID, Score: row.Score}
+	}
+	return page, nil
+}
+
+// searchLikePattern は空白区切りの語がこの順に現れるテキストに一致する LIKE パターンを返します
+func searchLikePattern(query string) string {
+	terms := strings.Fields(query)
+	for i, term := range terms {
+		terms[i] = escapeLike(term)
+	}
+	return "%" + strings.Join(terms, "%") + "%"
+}
@@ -0,0 +1,107 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func TestSearchRepository_Search_InvalidCursor(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	repo := repository.NewSearchRepository(db)
+
+	_, err := repo.Search(context.Background(), output.SearchQuery{Query: "cafe", Limit: 10, Cursor: "not-a-cursor"})
+	require.True(t, apperr.IsCode(err, apperr.CodeInvalidInput), "expected CodeInvalidInput error, got %v", err)
+}
+
+// setupSearchTest applies the search migration (pg_trgm and search_normalize) on top of the test schema.
+func setupSearchTest(t *testing.T) (*gorm.DB, output.SearchRepository) {
+	t.Helper()
+	if os.Getenv("TEST_DB_TYPE") != "postgres" {
+		t.Skip("Search requires pg_trgm; set TEST_DB_TYPE=postgres to run")
+	}
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	migration, err := os.ReadFile("../../migrations/000016_add_search.up.sql")
+	require.NoError(t, err)
+	require.NoError(t, db.Exec(string(migration)).Error)
+	return db, repository.NewSearchRepository(db)
+}
+
+func TestSearchRepository_Search_RanksAndPaginates(t *testing.T) {
+	db, repo := setupSearchTest(t)
+	ctx := context.Background()
+	storeRepo := repository.NewStoreRepository(db)
+
+	kana := "かふぇぶるー"
+	byName := newTestStore(t, func(s *entity.Store) { s.Name = "Cafe Blue"; s.Visibility = constants.VisibilityPublished })
+	byKana := newTestStore(t, func(s *entity.Store) {
+		s.Name = "珈琲青"
+		s.NameKana = &kana
+		s.Visibility = constants.VisibilityPublished
+	})
+	description := "駅前のカフェ"
+	byDescription := newTestStore(t, func(s *entity.Store) {
+		s.Name = "Blue"
+		s.Description = &description
+		s.Visibility = constants.VisibilityPublished
+	})
+	unapproved := newTestStore(t, func(s *entity.Store) { s.Name = "Cafe Hidden" })
+	for _, s := range []*entity.Store{byName, byKana, byDescription, unapproved} {
+		require.NoError(t, storeRepo.Create(ctx, s))
+	}
+
+	page, err := repo.Search(ctx, output.SearchQuery{Query: "cafe", Type: constants.SearchTypeStore, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Hits, 1)
+	require.Equal(t, byName.StoreID, page.Hits[0].ID)
+
+	page, err = repo.Search(ctx, output.SearchQuery{Query: "かふぇ", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Hits, 1)
+	require.Equal(t, byKana.StoreID, page.Hits[0].ID)
+	require.NotEmpty(t, page.NextCursor)
+
+	next, err := repo.Search(ctx, output.SearchQuery{Query: "かふぇ", Limit: 1, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, next.Hits, 1)
+	require.Equal(t, byDescription.StoreID, next.Hits[0].ID)
+	require.Less(t, next.Hits[0].Score, page.Hits[0].Score)
+	require.Empty(t, next.NextCursor)
+}
+
+func TestSearchRepository_Search_Reviews(t *testing.T) {
+	db, repo := setupSearchTest(t)
+	ctx := context.Background()
+	storeRepo := repository.NewStoreRepository(db)
+	userRepo := repository.NewUserRepository(db)
+
+	user := newTestReviewUser(t)
+	require.NoError(t, userRepo.Create(ctx, user))
+	store := newTestStore(t, func(s *entity.Store) { s.Visibility = constants.VisibilityPublished })
+	require.NoError(t, storeRepo.Create(ctx, store))
+	reviewID := uuid.New().String()
+	insertReviewDirectly(t, db, reviewID, store.StoreID, user.UserID, 5, "ここのカフェラテが好き")
+
+	page, err := repo.Search(ctx, output.SearchQuery{Query: "かふぇらて", Type: constants.SearchTypeReview, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Hits, 1)
+	require.Equal(t, constants.SearchTypeReview, page.Hits[0].Type)
+	require.Equal(t, reviewID, page.Hits[0].ID)
+}
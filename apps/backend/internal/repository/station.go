@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"strings"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type stationRepository struct {
@@ -19,8 +21,70 @@ func NewStationRepository(db *gorm.DB) output.StationRepository {
 
 func (r *stationRepository) FindAll(ctx context.Context) ([]entity.Station, error) {
 	var stations []model.Station
-	if err := r.db.WithContext(ctx).Find(&stations).Error; err != nil {
+	if err := r.db.WithContext(ctx).Order("id").Find(&stations).Error; err != nil {
 		return nil, mapDBError(err)
 	}
 	return model.ToStationEntities(stations), nil
 }
+
+func (r *stationRepository) FindByID(ctx context.Context, id int64) (*entity.Station, error) {
+	var station model.Station
+	if err := r.db.WithContext(ctx).First(&station, "id = ?", id).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	e := station.Entity()
+	return &e, nil
+}
+
+func (r *stationRepository) Search(ctx context.Context, query output.StationSearchQuery) ([]entity.Station, error) {
+	db := r.db.WithContext(ctx)
+	if query.NamePrefix != "" || query.KanaPrefix != "" {
+		db = db.Where(
+			`name LIKE ? ESCAPE '\' OR kana LIKE ? ESCAPE '\'`,
+			escapeLike(query.NamePrefix)+"%",
+			escapeLike(query.KanaPrefix)+"%",
+		)
+	}
+	if query.Kind != "" {
+		db = db.Where("kind = ?", query.Kind)
+	}
+
+	var stations []model.Station
+	if err := db.Order("id").Find(&stations).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToStationEntities(stations), nil
+}
+
+// stationDistanceRow is the scan target of the nearest-station query.
+type stationDistanceRow struct {
+	model.Station  `gorm:"embedded"`
+	DistanceMeters float64 `gorm:"column:distance_meters"`
+}
+
+// FindNearest orders by the KNN operator (<->) so that stations_geog_gist_idx is used.
+func (r *stationRepository) FindNearest(ctx context.Context, lat, lng float64, limit int) ([]entity.Station, error) {
+	var rows []stationDistanceRow
+	if err := r.db.WithContext(ctx).
+		Model(&model.Station{}).
+		Select("stations.*, ST_Distance(geog, "+geogPoint+") AS distance_meters", lng, lat).
+		Where("geog IS NOT NULL").
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: "geog <-> " + geogPoint, Vars: []interface{}{lng, lat}}}).
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	stations := make([]entity.Station, len(rows))
+	for i, row := range rows {
+		stations[i] = row.Station.Entity()
+		distance := row.DistanceMeters
+		stations[i].DistanceMeters = &distance
+	}
+	return stations, nil
+}
+
+// escapeLike escapes LIKE wildcards so user input is matched literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
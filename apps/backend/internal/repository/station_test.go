@@ -0,0 +1,110 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// setupStationTest creates a station repository seeded with a few Kobe stations
+func setupStationTest(t *testing.T) (output.StationRepository, *gorm.DB) {
+	t.Helper()
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	lat := func(v float64) *float64 { return &v }
+	stations := []model.Station{
+		{Name: "三ノ宮駅(ＪＲ)", Kana: "さんのみや", Kind: "三宮・元町", Lat: lat(34.694839), Lng: lat(135.194942)},
+		{Name: "新神戸駅", Kana: "しんこうべ", Kind: "三宮・元町", Lat: lat(34.706722), Lng: lat(135.196222)},
+		{Name: "須磨駅", Kana: "すま", Kind: "須磨", Lat: lat(34.643250), Lng: lat(135.113833)},
+		{Name: "100%駅", Kana: "ひゃくぱーせんと", Kind: "その他"},
+	}
+	require.NoError(t, db.Create(&stations).Error)
+
+	return repository.NewStationRepository(db), db
+}
+
+func TestStationRepository_FindAll_OrderedByID(t *testing.T) {
+	repo, _ := setupStationTest(t)
+
+	stations, err := repo.FindAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, stations, 4)
+	for i := 1; i < len(stations); i++ {
+		require.Less(t, stations[i-1].ID, stations[i].ID)
+	}
+}
+
+func TestStationRepository_FindByID(t *testing.T) {
+	repo, _ := setupStationTest(t)
+
+	all, err := repo.FindAll(context.Background())
+	require.NoError(t, err)
+
+	station, err := repo.FindByID(context.Background(), all[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, "新神戸駅", station.Name)
+
+	_, err = repo.FindByID(context.Background(), 99999)
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound), "expected CodeNotFound error, got %v", err)
+}
+
+func TestStationRepository_Search(t *testing.T) {
+	repo, _ := setupStationTest(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		query output.StationSearchQuery
+		want  []string
+	}{
+		{name: "kana prefix", query: output.StationSearchQuery{NamePrefix: "し", KanaPrefix: "し"}, want: []string{"新神戸駅"}},
+		{name: "name prefix", query: output.StationSearchQuery{NamePrefix: "須磨", KanaPrefix: "須磨"}, want: []string{"須磨駅"}},
+		{name: "prefix only, not substring", query: output.StationSearchQuery{NamePrefix: "こうべ", KanaPrefix: "こうべ"}, want: []string{}},
+		{name: "kind", query: output.StationSearchQuery{Kind: "三宮・元町"}, want: []string{"三ノ宮駅(ＪＲ)", "新神戸駅"}},
+		{name: "prefix and kind", query: output.StationSearchQuery{NamePrefix: "さ", KanaPrefix: "さ", Kind: "須磨"}, want: []string{}},
+		{name: "wildcards are literal", query: output.StationSearchQuery{NamePrefix: "%", KanaPrefix: "%"}, want: []string{}},
+		{name: "escaped percent", query: output.StationSearchQuery{NamePrefix: "100%", KanaPrefix: "100%"}, want: []string{"100%駅"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stations, err := repo.Search(ctx, tt.query)
+			require.NoError(t, err)
+			names := make([]string, len(stations))
+			for i, s := range stations {
+				names[i] = s.Name
+			}
+			require.ElementsMatch(t, tt.want, names)
+		})
+	}
+}
+
+func TestStationRepository_FindNearest(t *testing.T) {
+	if os.Getenv("TEST_DB_TYPE") != "postgres" {
+		t.Skip("FindNearest requires PostGIS; set TEST_DB_TYPE=postgres to run")
+	}
+	repo, db := setupStationTest(t)
+	require.NoError(t, db.Exec(`ALTER TABLE stations ADD COLUMN IF NOT EXISTS geog geography(Point, 4326)
+		GENERATED ALWAYS AS (ST_SetSRID(ST_MakePoint(lng, lat), 4326)::geography) STORED`).Error)
+
+	// 新神戸駅のすぐ南
+	stations, err := repo.FindNearest(context.Background(), 34.7050, 135.1960, 2)
+	require.NoError(t, err)
+	require.Len(t, stations, 2)
+	require.Equal(t, "新神戸駅", stations[0].Name)
+	require.Equal(t, "三ノ宮駅(ＪＲ)", stations[1].Name)
+	require.NotNil(t, stations[0].DistanceMeters)
+	require.Less(t, *stations[0].DistanceMeters, *stations[1].DistanceMeters)
+}
@@ -2,8 +2,12 @@ package repository
 
 import (
 	"context"
+	"strconv"
+	"time"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/hours"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 	"gorm.io/gorm"
@@ -19,19 +23,39 @@ func NewStoreRepository(db *gorm.DB) output.StoreRepository {
 }
 
 // withFullPreload adds all standard preloads for store queries.
-func (r *storeRepository) withFullPreload(db *gorm.DB) *gorm.DB {
+// Only the reviews the viewer can see are loaded.
+func (r *storeRepository) withFullPreload(db *gorm.DB, viewer output.Viewer) *gorm.DB {
 	return db.
 		Preload("ThumbnailFile").
-		Preload("Menus").
+		Preload("Menus", activeMenus).
+		Preload("Menus.ImageFile").
+		Preload("Menus.DietaryTags").
+		Preload("Reviews", func(db *gorm.DB) *gorm.DB {
+			return visibleReviews(db, "reviews", viewer)
+		}).
 		Preload("Reviews.Menus").
 		Preload("Reviews.Files").
 		Preload("Tags").
-		Preload("Files")
+		Preload("Files", "is_deleted = ?", false).
+		Preload("Photos", galleryPhotos).
+		Preload("Photos.File")
 }
 
-func (r *storeRepository) FindAll(ctx context.Context) ([]entity.Store, error) {
+// withVisitedByMe selects whether the viewer has visited each store, in the same way liked_by_me is selected
+// for reviews. Anonymous viewers get no column and VisitedByMe stays nil.
+func withVisitedByMe(db *gorm.DB, viewer output.Viewer) *gorm.DB {
+	if viewer.UserID == "" {
+		return db
+	}
+	return db.Select(
+		"stores.*, EXISTS(SELECT 1 FROM store_visits sv WHERE sv.store_id = stores.store_id AND sv.user_id = ?) AS visited_by_me",
+		viewer.UserID,
+	)
+}
+
+func (r *storeRepository) FindAll(ctx context.Context, viewer output.Viewer) ([]entity.Store, error) {
 	var stores []model.Store
-	if err := r.withFullPreload(r.db.WithContext(ctx)).
+	if err := withVisitedByMe(visibleStores(r.withFullPreload(r.db.WithContext(ctx), viewer), "stores", viewer), viewer).
 		Order("created_at desc").
 		Find(&stores).Error; err != nil {
 		return nil, mapDBError(err)
@@ -40,11 +64,270 @@ func (r *storeRepository) FindAll(ctx context.Context) ([]entity.Store, error) {
 	return model.ToEntities[entity.Store, model.Store](stores), nil
 }
 
+// storeCursor is the keyset position of the last store on a page.
+// Value holds the sort key formatted as a string; it is empty for a NULL opened_at.
+type storeCursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+func (cursor storeCursor) valid() bool {
+	return cursor.ID != ""
+}
+
+// decodeStoreCursor decodes a listing cursor and rejects cursors issued for another sort.
+func decodeStoreCursor(value string, sort string) (storeCursor, error) {
+	cursor, err := decodeCursor[storeCursor](value)
+	if err != nil {
+		return storeCursor{}, err
+	}
+	if cursor.Sort != sort {
+		return storeCursor{}, errInvalidCursor
+	}
+	return cursor, nil
+}
+
+// storeListOrder describes how a listing sort is ordered and how it seeks past a cursor.
+type storeListOrder struct {
+	orderBy string
+	key     func(s model.Store) string
+	seek    func(db *gorm.DB, cursor storeCursor) (*gorm.DB, error)
+}
+
+var storeListOrders = map[string]storeListOrder{
+	constants.StoreSortByNew: {
+		orderBy: "stores.created_at DESC, stores.store_id DESC",
+		key: func(s model.Store) string {
+			return s.CreatedAt.Format(time.RFC3339Nano)
+		},
+		seek: func(db *gorm.DB, cursor storeCursor) (*gorm.DB, error) {
+			createdAt, err := time.Parse(time.RFC3339Nano, cursor.Value)
+			if err != nil {
+				return nil, errInvalidCursor
+			}
+			return db.Where(
+				"stores.created_at < ? OR (stores.created_at = ? AND stores.store_id < ?)",
+				createdAt, createdAt, cursor.ID,
+			), nil
+		},
+	},
+	constants.StoreSortByRating: {
+		orderBy: "stores.average_rating DESC, stores.store_id DESC",
+		key: func(s model.Store) string {
+			return strconv.FormatFloat(s.AverageRating, 'g', -1, 64)
+		},
+		seek: func(db *gorm.DB, cursor storeCursor) (*gorm.DB, error) {
+			rating, err := strconv.ParseFloat(cursor.Value, 64)
+			if err != nil {
+				return nil, errInvalidCursor
+			}
+			return db.Where(
+				"stores.average_rating < ? OR (stores.average_rating = ? AND stores.store_id < ?)",
+				rating, rating, cursor.ID,
+			), nil
+		},
+	},
+	constants.StoreSortByOpened: {
+		orderBy: "stores.opened_at DESC NULLS LAST, stores.store_id DESC",
+		key: func(s model.Store) string {
+			if s.OpenedAt == nil {
+				return ""
+			}
+			return s.OpenedAt.Format(time.RFC3339Nano)
+		},
+		seek: func(db *gorm.DB, cursor storeCursor) (*gorm.DB, error) {
+			if cursor.Value == "" {
+				return db.Where("stores.opened_at IS NULL AND stores.store_id < ?", cursor.ID), nil
+			}
+			openedAt, err := time.Parse(time.RFC3339Nano, cursor.Value)
+			if err != nil {
+				return nil, errInvalidCursor
+			}
+			return db.Where(
+				"stores.opened_at < ? OR stores.opened_at IS NULL OR (stores.opened_at = ? AND stores.store_id < ?)",
+				openedAt, openedAt, cursor.ID,
+			), nil
+		},
+	},
+}
+
+// List returns a page of stores without the menu/review preloads used by FindByID.
+func (r *storeRepository) List(ctx context.Context, query output.StoreListQuery) (*output.StorePage, error) {
+	order, ok := storeListOrders[query.Sort]
+	if !ok {
+		order = storeListOrders[constants.StoreSortByNew]
+		query.Sort = constants.StoreSortByNew
+	}
+	if query.Limit <= 0 {
+		query.Limit = constants.DefaultStoreListLimit
+	}
+
+	db := applyStoreListFilters(r.db.WithContext(ctx).Model(&model.Store{}), query)
+	db = visibleStores(db, "stores", query.Viewer)
+	if query.Cursor != "" {
+		cursor, err := decodeStoreCursor(query.Cursor, query.Sort)
+		if err != nil {
+			return nil, err
+		}
+		if db, err = order.seek(db, cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	var stores []model.Store
+	if err := withVisitedByMe(db, query.Viewer).
+		Preload("ThumbnailFile").
+		Preload("Tags").
+		Order(order.orderBy).
+		Limit(query.Limit + 1).
+		Find(&stores).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	page := &output.StorePage{}
+	if len(stores) > query.Limit {
+		stores = stores[:query.Limit]
+		last := stores[len(stores)-1]
+		page.NextCursor = encodeCursor(storeCursor{
+			Sort:  query.Sort,
+			Value: order.key(last),
+			ID:    last.StoreID,
+		})
+	}
+	page.Stores = model.ToEntities[entity.Store, model.Store](stores)
+	return page, nil
+}
+
+func applyStoreListFilters(db *gorm.DB, query output.StoreListQuery) *gorm.DB {
+	if query.Category != nil {
+		db = db.Where("stores.category = ?", *query.Category)
+	}
+	if query.Budget != nil {
+		db = db.Where("stores.budget = ?", *query.Budget)
+	}
+	if query.Tag != nil {
+		db = db.Where("EXISTS (SELECT 1 FROM store_tags st WHERE st.store_id = stores.store_id AND st.tag = ?)", *query.Tag)
+	}
+	if query.MinRating != nil {
+		db = db.Where("stores.average_rating >= ?", *query.MinRating)
+	}
+	if query.OpenedAfter != nil {
+		db = db.Where("stores.opened_at >= ?", *query.OpenedAfter)
+	}
+	if query.OpenAt != nil {
+		db = applyOpenAt(db, *query.OpenAt)
+	}
+	if query.IsApproved != nil {
+		if *query.IsApproved {
+			db = db.Where("stores.visibility = ?", constants.VisibilityPublished)
+		} else {
+			db = db.Where("stores.visibility <> ?", constants.VisibilityPublished)
+		}
+	}
+	return db
+}
+
+// openOnDateSQL matches stores open at minute ? of the service date ? (weekday ?).
+// Rows for the date (special hours and closures) replace the weekly rows of that day.
+const openOnDateSQL = `(EXISTS (SELECT 1 FROM store_opening_hours oh
+		WHERE oh.store_id = stores.store_id AND oh.service_date = ? AND oh.open_minute <= ? AND oh.close_minute > ?)
+	OR (NOT EXISTS (SELECT 1 FROM store_opening_hours oh
+		WHERE oh.store_id = stores.store_id AND oh.service_date = ?)
+	AND EXISTS (SELECT 1 FROM store_opening_hours oh
+		WHERE oh.store_id = stores.store_id AND oh.weekday = ? AND oh.open_minute <= ? AND oh.close_minute > ?)))`
+
+// applyOpenAt keeps the stores open at the given time. Intervals of the previous day that run
+// past midnight are matched by shifting the minute by a day.
+func applyOpenAt(db *gorm.DB, at time.Time) *gorm.DB {
+	today, minute := hours.Clock(at)
+	yesterday := today.AddDate(0, 0, -1)
+	overnight := minute + entity.MinutesPerDay
+	return db.Where("("+openOnDateSQL+" OR "+openOnDateSQL+")",
+		today, minute, minute, today, int(today.Weekday()), minute, minute,
+		yesterday, overnight, overnight, yesterday, int(yesterday.Weekday()), overnight, overnight,
+	)
+}
+
+// geogPoint builds a geography point from (lng, lat) placeholders.
+const geogPoint = "ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography"
+
+// storeDistanceRow is the scan target of the nearby query.
+type storeDistanceRow struct {
+	StoreID        string  `gorm:"column:store_id"`
+	DistanceMeters float64 `gorm:"column:distance_meters"`
+}
+
+// FindNearby uses the stores_geog_gist_idx index through ST_DWithin and then loads
+// the matched stores with the same slim projection as List.
+func (r *storeRepository) FindNearby(ctx context.Context, query output.StoreNearbyQuery) ([]entity.Store, error) {
+	db := visibleStores(r.db.WithContext(ctx).Model(&model.Store{}), "stores", query.Viewer)
+	if query.OpenAt != nil {
+		db = applyOpenAt(db, *query.OpenAt)
+	}
+	var rows []storeDistanceRow
+	if err := db.
+		Select("stores.store_id, ST_Distance(stores.geog, "+geogPoint+") AS distance_meters", query.Longitude, query.Latitude).
+		Where("ST_DWithin(stores.geog, "+geogPoint+", ?)", query.Longitude, query.Latitude, query.RadiusMeters).
+		Order("distance_meters ASC, stores.store_id ASC").
+		Limit(query.Limit).
+		Scan(&rows).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	if len(rows) == 0 {
+		return []entity.Store{}, nil
+	}
+
+	ids := make([]string, len(rows))
+	for i, row := range rows {
+		ids[i] = row.StoreID
+	}
+	var stores []model.Store
+	if err := withVisitedByMe(r.db.WithContext(ctx), query.Viewer).
+		Preload("ThumbnailFile").
+		Preload("Tags").
+		Where("stores.store_id IN ?", ids).
+		Find(&stores).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	byID := make(map[string]model.Store, len(stores))
+	for _, s := range stores {
+		byID[s.StoreID] = s
+	}
+
+	result := make([]entity.Store, 0, len(rows))
+	for _, row := range rows {
+		s, ok := byID[row.StoreID]
+		if !ok {
+			continue
+		}
+		store := s.Entity()
+		distance := row.DistanceMeters
+		store.DistanceMeters = &distance
+		result = append(result, store)
+	}
+	return result, nil
+}
+
+// FindByOwner returns the stores managed by the user, newest first.
+func (r *storeRepository) FindByOwner(ctx context.Context, userID string) ([]entity.Store, error) {
+	var stores []model.Store
+	if err := r.db.WithContext(ctx).
+		Preload("ThumbnailFile").
+		Preload("Tags").
+		Where("EXISTS (SELECT 1 FROM store_owners so WHERE so.store_id = stores.store_id AND so.user_id = ?)", userID).
+		Order("stores.created_at desc").
+		Find(&stores).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToEntities[entity.Store, model.Store](stores), nil
+}
+
 func (r *storeRepository) FindPending(ctx context.Context) ([]entity.Store, error) {
 	var stores []model.Store
 	if err := r.db.WithContext(ctx).
 		Preload("ThumbnailFile").
-		Where("is_approved = ?", false).
+		Where("approval_status IN ?", []string{constants.StoreApprovalSubmitted, constants.StoreApprovalResubmitted}).
 		Order("created_at asc").
 		Find(&stores).Error; err != nil {
 		return nil, mapDBError(err)
@@ -53,36 +336,94 @@ func (r *storeRepository) FindPending(ctx context.Context) ([]entity.Store, erro
 	return model.ToEntities[entity.Store, model.Store](stores), nil
 }
 
+// FindByID loads the store with every review, for callers that manage the store.
 func (r *storeRepository) FindByID(ctx context.Context, id string) (*entity.Store, error) {
+	return r.findByID(r.db.WithContext(ctx), id, output.Viewer{IsAdmin: true})
+}
+
+// FindVisibleByID reports a store the viewer cannot see as not found so that its existence is not revealed.
+func (r *storeRepository) FindVisibleByID(ctx context.Context, id string, viewer output.Viewer) (*entity.Store, error) {
+	return r.findByID(visibleStores(r.db.WithContext(ctx), "stores", viewer), id, viewer)
+}
+
+func (r *storeRepository) findByID(db *gorm.DB, id string, viewer output.Viewer) (*entity.Store, error) {
 	var store model.Store
-	if err := r.withFullPreload(r.db.WithContext(ctx)).
-		First(&store, "store_id = ?", id).Error; err != nil {
+	if err := withVisitedByMe(r.withFullPreload(db, viewer), viewer).
+		First(&store, "stores.store_id = ?", id).Error; err != nil {
 		return nil, mapDBError(err)
 	}
 	domainStore := store.Entity()
 	return &domainStore, nil
 }
 
+func (r *storeRepository) IsVisible(ctx context.Context, id string, viewer output.Viewer) (bool, error) {
+	var count int64
+	if err := visibleStores(r.db.WithContext(ctx).Model(&model.Store{}), "stores", viewer).
+		Where("stores.store_id = ?", id).
+		Count(&count).Error; err != nil {
+		return false, mapDBError(err)
+	}
+	return count > 0, nil
+}
+
+// FindByIDs loads the stores for search results: the list projection plus active menus, without reviews.
+func (r *storeRepository) FindByIDs(ctx context.Context, ids []string) ([]entity.Store, error) {
+	if len(ids) == 0 {
+		return []entity.Store{}, nil
+	}
+	var stores []model.Store
+	if err := r.db.WithContext(ctx).
+		Preload("ThumbnailFile").
+		Preload("Tags").
+		Preload("Menus", activeMenus).
+		Where("store_id IN ?", ids).
+		Find(&stores).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToEntities[entity.Store, model.Store](stores), nil
+}
+
 func (r *storeRepository) Create(ctx context.Context, store *entity.Store) error {
+	return createStore(r.db.WithContext(ctx), store)
+}
+
+func (r *storeRepository) CreateInTx(ctx context.Context, tx interface{}, store *entity.Store) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	return createStore(gormTx.WithContext(ctx), store)
+}
+
+func createStore(db *gorm.DB, store *entity.Store) error {
 	record := model.Store{
 		StoreID:         store.StoreID,
 		ThumbnailFileID: store.ThumbnailFileID,
 		Name:            store.Name,
+		NameKana:        store.NameKana,
 		OpenedAt:        store.OpenedAt,
 		Description:     store.Description,
 		Address:         store.Address,
 		OpeningHours:    store.OpeningHours,
+		OpeningSchedule: model.EncodeOpeningSchedule(store.OpeningSchedule),
 		Latitude:        store.Latitude,
 		Longitude:       store.Longitude,
 		GoogleMapURL:    store.GoogleMapURL,
 		PlaceID:         store.PlaceID,
-		IsApproved:      store.IsApproved,
+		Visibility:      store.Visibility,
+		ApprovalStatus:  store.ApprovalStatus,
 		Category:        store.Category,
 		Budget:          store.Budget,
 		AverageRating:   store.AverageRating,
 		DistanceMinutes: store.DistanceMinutes,
 	}
-	if err := r.db.WithContext(ctx).Create(&record).Error; err != nil {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&record).Error; err != nil {
+			return err
+		}
+		return replaceOpeningHours(tx, record.StoreID, store.OpeningSchedule)
+	})
+	if err != nil {
 		return mapDBError(err)
 	}
 	store.StoreID = record.StoreID
@@ -92,25 +433,170 @@ func (r *storeRepository) Create(ctx context.Context, store *entity.Store) error
 }
 
 func (r *storeRepository) Update(ctx context.Context, store *entity.Store) error {
+	return updateStore(r.db.WithContext(ctx), store)
+}
+
+func (r *storeRepository) UpdateInTx(ctx context.Context, tx interface{}, store *entity.Store) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	return updateStore(gormTx.WithContext(ctx), store)
+}
+
+// updateStore は店舗の編集可能な項目を更新します。
+// visibility と approval_status は現在の状態を条件に更新する UpdateVisibilityInTx / UpdateApprovalInTx だけで変更します
+func updateStore(db *gorm.DB, store *entity.Store) error {
 	updates := map[string]any{
 		"thumbnail_file_id": store.ThumbnailFileID,
 		"name":              store.Name,
+		"name_kana":         store.NameKana,
 		"opened_at":         store.OpenedAt,
 		"description":       store.Description,
 		"address":           store.Address,
 		"opening_hours":     store.OpeningHours,
+		"opening_schedule":  model.EncodeOpeningSchedule(store.OpeningSchedule),
 		"latitude":          store.Latitude,
 		"longitude":         store.Longitude,
 		"google_map_url":    store.GoogleMapURL,
 		"place_id":          store.PlaceID,
-		"is_approved":       store.IsApproved,
 		"category":          store.Category,
 		"budget":            store.Budget,
-		"average_rating":    store.AverageRating,
 		"distance_minutes":  store.DistanceMinutes,
 		"updated_at":        store.UpdatedAt,
 	}
-	return mapDBError(r.db.WithContext(ctx).Model(&model.Store{StoreID: store.StoreID}).Updates(updates).Error)
+	return mapDBError(db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Store{StoreID: store.StoreID}).Updates(updates).Error; err != nil {
+			return err
+		}
+		return replaceOpeningHours(tx, store.StoreID, store.OpeningSchedule)
+	}))
+}
+
+// FindWithUnparsedOpeningHours returns the stores that have free-text opening hours but no structured schedule.
+func (r *storeRepository) FindWithUnparsedOpeningHours(ctx context.Context) ([]entity.Store, error) {
+	var stores []model.Store
+	if err := r.db.WithContext(ctx).
+		Where("opening_hours IS NOT NULL AND opening_hours <> '' AND opening_schedule IS NULL").
+		Order("store_id").
+		Find(&stores).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToEntities[entity.Store, model.Store](stores), nil
+}
+
+// UpdateOpeningSchedule stores only the structured schedule of the store, leaving updated_at untouched.
+func (r *storeRepository) UpdateOpeningSchedule(ctx context.Context, storeID string, schedule *entity.OpeningSchedule) error {
+	return mapDBError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Store{}).
+			Where("store_id = ?", storeID).
+			UpdateColumn("opening_schedule", model.EncodeOpeningSchedule(schedule)).Error; err != nil {
+			return err
+		}
+		return replaceOpeningHours(tx, storeID, schedule)
+	}))
+}
+
+// replaceOpeningHours rebuilds the store_opening_hours rows that the open_now filter searches.
+// Special hours and closures are expanded into one row per date; a closed date has a row without minutes.
+func replaceOpeningHours(db *gorm.DB, storeID string, schedule *entity.OpeningSchedule) error {
+	if err := db.Where("store_id = ?", storeID).Delete(&model.StoreOpeningHour{}).Error; err != nil {
+		return err
+	}
+	if schedule == nil {
+		return nil
+	}
+
+	var rows []model.StoreOpeningHour
+	for d, intervals := range schedule.Weekly {
+		weekday := d
+		for _, interval := range intervals {
+			rows = append(rows, openingHourRow(storeID, &weekday, nil, interval))
+		}
+	}
+	dates := make(map[time.Time]bool)
+	for _, special := range schedule.Special {
+		date := special.Date
+		dates[date] = true
+		if len(special.Intervals) == 0 {
+			rows = append(rows, model.StoreOpeningHour{StoreID: storeID, ServiceDate: &date})
+		}
+		for _, interval := range special.Intervals {
+			rows = append(rows, openingHourRow(storeID, nil, &date, interval))
+		}
+	}
+	for _, closure := range schedule.Closures {
+		for date := closure.From; !date.After(closure.To); date = date.AddDate(0, 0, 1) {
+			if dates[date] {
+				continue
+			}
+			dates[date] = true
+			closedOn := date
+			rows = append(rows, model.StoreOpeningHour{StoreID: storeID, ServiceDate: &closedOn})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return db.CreateInBatches(rows, 500).Error
+}
+
+func openingHourRow(storeID string, weekday *int, date *time.Time, interval entity.OpeningInterval) model.StoreOpeningHour {
+	open, closeAt := interval.Open, interval.Close
+	return model.StoreOpeningHour{
+		StoreID:     storeID,
+		Weekday:     weekday,
+		ServiceDate: date,
+		OpenMinute:  &open,
+		CloseMinute: &closeAt,
+	}
+}
+
+func (r *storeRepository) UpdateApprovalInTx(ctx context.Context, tx interface{}, store *entity.Store, from string) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+
+	// 遷移元の状態のままの行だけを更新し、同時に審査された場合の二重遷移を防ぐ
+	result := gormTx.WithContext(ctx).
+		Model(&model.Store{}).
+		Where("store_id = ? AND approval_status = ?", store.StoreID, from).
+		Updates(map[string]any{
+			"approval_status": store.ApprovalStatus,
+			"visibility":      store.Visibility,
+			"updated_at":      store.UpdatedAt,
+		})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return output.ErrStoreApprovalChanged
+	}
+	return nil
+}
+
+func (r *storeRepository) UpdateVisibilityInTx(ctx context.Context, tx interface{}, storeID string, visibility string, approvalStatus string) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+
+	// 審査状態が読み込んだときのままの行だけを更新し、同時に審査された店舗を公開しないようにする
+	result := gormTx.WithContext(ctx).
+		Model(&model.Store{}).
+		Where("store_id = ? AND approval_status = ?", storeID, approvalStatus).
+		Updates(map[string]any{
+			"visibility": visibility,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return output.ErrStoreApprovalChanged
+	}
+	return nil
 }
 
 func (r *storeRepository) Delete(ctx context.Context, id string) error {
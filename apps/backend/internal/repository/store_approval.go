@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+)
+
+type storeApprovalEventRepository struct {
+	db *gorm.DB
+}
+
+// NewStoreApprovalEventRepository は StoreApprovalEventRepository の実装を生成します
+func NewStoreApprovalEventRepository(db *gorm.DB) output.StoreApprovalEventRepository {
+	return &storeApprovalEventRepository{db: db}
+}
+
+func (r *storeApprovalEventRepository) CreateInTx(ctx context.Context, tx interface{}, event *entity.StoreApprovalEvent) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+
+	record := model.StoreApprovalEvent{
+		StoreID:    event.StoreID,
+		FromStatus: event.FromStatus,
+		ToStatus:   event.ToStatus,
+		ActorID:    event.ActorID,
+		Reason:     event.Reason,
+	}
+	if err := gormTx.WithContext(ctx).Create(&record).Error; err != nil {
+		return mapDBError(err)
+	}
+
+	event.EventID = record.EventID
+	event.CreatedAt = record.CreatedAt
+	return nil
+}
+
+func (r *storeApprovalEventRepository) FindByStoreID(ctx context.Context, storeID string) ([]entity.StoreApprovalEvent, error) {
+	var events []model.StoreApprovalEvent
+	if err := r.db.WithContext(ctx).
+		Where("store_id = ?", storeID).
+		Order("created_at asc, event_id asc").
+		Find(&events).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToEntities[entity.StoreApprovalEvent, model.StoreApprovalEvent](events), nil
+}
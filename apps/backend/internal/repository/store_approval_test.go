@@ -0,0 +1,175 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func TestStoreRepository_UpdateApprovalInTx(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	storeRepo := repository.NewStoreRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	store := newTestStore(t, func(s *entity.Store) {
+		s.Visibility = constants.VisibilityPending
+		s.ApprovalStatus = constants.StoreApprovalSubmitted
+	})
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	store.ApprovalStatus = constants.StoreApprovalApproved
+	store.Visibility = constants.VisibilityPublished
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return storeRepo.UpdateApprovalInTx(ctx, txDB, store, constants.StoreApprovalSubmitted)
+	}))
+
+	found, err := storeRepo.FindByID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, constants.StoreApprovalApproved, found.ApprovalStatus)
+	require.Equal(t, constants.VisibilityPublished, found.Visibility)
+
+	// A second transition from the stale status must not overwrite the new one
+	store.ApprovalStatus = constants.StoreApprovalRejected
+	err = tx.StartTransaction(func(txDB interface{}) error {
+		return storeRepo.UpdateApprovalInTx(ctx, txDB, store, constants.StoreApprovalSubmitted)
+	})
+	require.ErrorIs(t, err, output.ErrStoreApprovalChanged)
+
+	err = storeRepo.UpdateApprovalInTx(ctx, nil, store, constants.StoreApprovalSubmitted)
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestStoreRepository_UpdateVisibilityInTx(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	storeRepo := repository.NewStoreRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	store := newTestStore(t, func(s *entity.Store) {
+		s.Name = "Original"
+		s.Visibility = constants.VisibilityPublished
+		s.ApprovalStatus = constants.StoreApprovalApproved
+	})
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	// An owner edit saved after the store was loaded must not be overwritten
+	store.Name = "Edited by owner"
+	require.NoError(t, storeRepo.Update(ctx, store))
+
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return storeRepo.UpdateVisibilityInTx(ctx, txDB, store.StoreID, constants.VisibilityHidden, constants.StoreApprovalApproved)
+	}))
+
+	found, err := storeRepo.FindByID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, constants.VisibilityHidden, found.Visibility)
+	require.Equal(t, "Edited by owner", found.Name)
+
+	// The visibility is not changed when the approval status moved on
+	err = tx.StartTransaction(func(txDB interface{}) error {
+		return storeRepo.UpdateVisibilityInTx(ctx, txDB, store.StoreID, constants.VisibilityPublished, constants.StoreApprovalDraft)
+	})
+	require.ErrorIs(t, err, output.ErrStoreApprovalChanged)
+
+	err = storeRepo.UpdateVisibilityInTx(ctx, nil, store.StoreID, constants.VisibilityHidden, constants.StoreApprovalApproved)
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestStoreRepository_Update_KeepsApprovalStatus(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	store := newTestStore(t, func(s *entity.Store) { s.ApprovalStatus = constants.StoreApprovalSubmitted })
+	require.NoError(t, repo.Create(ctx, store))
+
+	store.Name = "Renamed"
+	store.ApprovalStatus = constants.StoreApprovalApproved
+	require.NoError(t, repo.Update(ctx, store))
+
+	found, err := repo.FindByID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, "Renamed", found.Name)
+	require.Equal(t, constants.StoreApprovalSubmitted, found.ApprovalStatus)
+}
+
+func TestStoreApprovalEventRepository_CreateAndFind(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	storeRepo := repository.NewStoreRepository(db)
+	eventRepo := repository.NewStoreApprovalEventRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	other := newTestStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+	require.NoError(t, storeRepo.Create(ctx, other))
+
+	actorID := "admin-1"
+	reason := "photos are missing"
+	events := []*entity.StoreApprovalEvent{
+		{StoreID: store.StoreID, FromStatus: constants.StoreApprovalDraft, ToStatus: constants.StoreApprovalSubmitted, ActorID: &actorID},
+		{StoreID: store.StoreID, FromStatus: constants.StoreApprovalSubmitted, ToStatus: constants.StoreApprovalRejected, ActorID: &actorID, Reason: &reason},
+		{StoreID: other.StoreID, FromStatus: constants.StoreApprovalDraft, ToStatus: constants.StoreApprovalSubmitted},
+	}
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		for _, event := range events {
+			if err := eventRepo.CreateInTx(ctx, txDB, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NotZero(t, events[0].EventID)
+	require.False(t, events[0].CreatedAt.IsZero())
+
+	found, err := eventRepo.FindByStoreID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	require.Equal(t, constants.StoreApprovalSubmitted, found[0].ToStatus)
+	require.Equal(t, constants.StoreApprovalRejected, found[1].ToStatus)
+	require.NotNil(t, found[1].Reason)
+	require.Equal(t, reason, *found[1].Reason)
+	require.Equal(t, actorID, *found[1].ActorID)
+
+	err = eventRepo.CreateInTx(ctx, nil, &entity.StoreApprovalEvent{StoreID: store.StoreID})
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestStoreRepository_Update_KeepsVisibility(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	storeRepo := repository.NewStoreRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	store := newTestStore(t, func(s *entity.Store) {
+		s.Visibility = constants.VisibilityPublished
+		s.ApprovalStatus = constants.StoreApprovalApproved
+	})
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	// An admin hides the store after the owner loaded it
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return storeRepo.UpdateVisibilityInTx(ctx, txDB, store.StoreID, constants.VisibilityHidden, constants.StoreApprovalApproved)
+	}))
+
+	// Saving the owner's edit with the stale visibility must not re-publish it
+	store.Name = "Edited by owner"
+	require.NoError(t, storeRepo.Update(ctx, store))
+
+	found, err := storeRepo.FindByID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, "Edited by owner", found.Name)
+	require.Equal(t, constants.VisibilityHidden, found.Visibility)
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+)
+
+type storeClaimRepository struct {
+	db *gorm.DB
+}
+
+// NewStoreClaimRepository は StoreClaimRepository の実装を生成します
+func NewStoreClaimRepository(db *gorm.DB) output.StoreClaimRepository {
+	return &storeClaimRepository{db: db}
+}
+
+func (r *storeClaimRepository) List(ctx context.Context, status *string) ([]entity.StoreClaim, error) {
+	query := r.db.WithContext(ctx).Preload("Files")
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	var claims []model.StoreClaim
+	if err := query.Order("created_at desc").Find(&claims).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToEntities[entity.StoreClaim, model.StoreClaim](claims), nil
+}
+
+func (r *storeClaimRepository) FindByID(ctx context.Context, claimID string) (*entity.StoreClaim, error) {
+	var claim model.StoreClaim
+	if err := r.db.WithContext(ctx).
+		Preload("Files").
+		Where("claim_id = ?", claimID).
+		First(&claim).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	entityClaim := claim.Entity()
+	return &entityClaim, nil
+}
+
+func (r *storeClaimRepository) HasPending(ctx context.Context, storeID, userID string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&model.StoreClaim{}).
+		Where("store_id = ? AND user_id = ? AND status = ?", storeID, userID, constants.ClaimStatusPending).
+		Count(&count).Error; err != nil {
+		return false, mapDBError(err)
+	}
+	return count > 0, nil
+}
+
+func (r *storeClaimRepository) CreateInTx(ctx context.Context, tx interface{}, claim *entity.StoreClaim, fileIDs []string) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+
+	record := model.StoreClaim{
+		ClaimID: claim.ClaimID,
+		StoreID: claim.StoreID,
+		UserID:  claim.UserID,
+		Note:    claim.Note,
+		Status:  claim.Status,
+	}
+	if err := gormTx.WithContext(ctx).Create(&record).Error; err != nil {
+		return mapDBError(err)
+	}
+
+	if len(fileIDs) > 0 {
+		rows := make([]model.StoreClaimFile, 0, len(fileIDs))
+		for _, fileID := range fileIDs {
+			rows = append(rows, model.StoreClaimFile{ClaimID: record.ClaimID, FileID: fileID})
+		}
+		if err := gormTx.WithContext(ctx).Create(&rows).Error; err != nil {
+			return mapDBError(err)
+		}
+	}
+
+	claim.ClaimID = record.ClaimID
+	claim.CreatedAt = record.CreatedAt
+	claim.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *storeClaimRepository) UpdateReviewInTx(ctx context.Context, tx interface{}, claim *entity.StoreClaim) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+
+	// 審査待ちの行だけを更新し、同時に審査された場合の二重承認を防ぐ
+	result := gormTx.WithContext(ctx).
+		Model(&model.StoreClaim{}).
+		Where("claim_id = ? AND status = ?", claim.ClaimID, constants.ClaimStatusPending).
+		Updates(map[string]interface{}{
+			"status":      claim.Status,
+			"reviewed_by": claim.ReviewedBy,
+			"review_note": claim.ReviewNote,
+			"reviewed_at": claim.ReviewedAt,
+			"updated_at":  claim.UpdatedAt,
+		})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return output.ErrClaimAlreadyReviewed
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type storeClaimTestDeps struct {
+	claimRepo output.StoreClaimRepository
+	storeRepo output.StoreRepository
+	fileRepo  output.FileRepository
+	tx        output.Transaction
+}
+
+// setupStoreClaimTest creates common test dependencies for store claim tests
+func setupStoreClaimTest(t *testing.T) storeClaimTestDeps {
+	t.Helper()
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	return storeClaimTestDeps{
+		claimRepo: repository.NewStoreClaimRepository(db),
+		storeRepo: repository.NewStoreRepository(db),
+		fileRepo:  repository.NewFileRepository(db),
+		tx:        repository.NewGormTransaction(db),
+	}
+}
+
+// createTestClaim stores a pending claim with one evidence file
+func createTestClaim(t *testing.T, deps storeClaimTestDeps, storeID, userID string) *entity.StoreClaim {
+	t.Helper()
+	ctx := context.Background()
+
+	file := newTestFileEntity(t, &userID, func(f *entity.File) { f.FileKind = constants.FileKindClaimEvidence })
+	require.NoError(t, deps.fileRepo.Create(ctx, file))
+
+	claim := &entity.StoreClaim{
+		ClaimID: uuid.New().String(),
+		StoreID: storeID,
+		UserID:  userID,
+		Note:    "I run this shop",
+		Status:  constants.ClaimStatusPending,
+	}
+	require.NoError(t, deps.tx.StartTransaction(func(tx interface{}) error {
+		return deps.claimRepo.CreateInTx(ctx, tx, claim, []string{file.FileID})
+	}))
+	return claim
+}
+
+func TestStoreClaimRepository_CreateInTx_And_FindByID(t *testing.T) {
+	deps := setupStoreClaimTest(t)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	require.NoError(t, deps.storeRepo.Create(ctx, store))
+	claim := createTestClaim(t, deps, store.StoreID, "user-1")
+
+	found, err := deps.claimRepo.FindByID(ctx, claim.ClaimID)
+	require.NoError(t, err)
+	require.Equal(t, store.StoreID, found.StoreID)
+	require.Equal(t, constants.ClaimStatusPending, found.Status)
+	require.Len(t, found.Files, 1)
+	require.Equal(t, constants.FileKindClaimEvidence, found.Files[0].FileKind)
+}
+
+func TestStoreClaimRepository_FindByID_NotFound(t *testing.T) {
+	deps := setupStoreClaimTest(t)
+
+	_, err := deps.claimRepo.FindByID(context.Background(), "missing")
+	require.Error(t, err)
+}
+
+func TestStoreClaimRepository_HasPending(t *testing.T) {
+	deps := setupStoreClaimTest(t)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	require.NoError(t, deps.storeRepo.Create(ctx, store))
+	createTestClaim(t, deps, store.StoreID, "user-1")
+
+	pending, err := deps.claimRepo.HasPending(ctx, store.StoreID, "user-1")
+	require.NoError(t, err)
+	require.True(t, pending)
+
+	pending, err = deps.claimRepo.HasPending(ctx, store.StoreID, "user-2")
+	require.NoError(t, err)
+	require.False(t, pending)
+}
+
+func TestStoreClaimRepository_List_FilterByStatus(t *testing.T) {
+	deps := setupStoreClaimTest(t)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	require.NoError(t, deps.storeRepo.Create(ctx, store))
+	pendingClaim := createTestClaim(t, deps, store.StoreID, "user-1")
+	deniedClaim := createTestClaim(t, deps, store.StoreID, "user-2")
+
+	now := time.Now()
+	deniedClaim.Status = constants.ClaimStatusDenied
+	deniedClaim.ReviewedAt = &now
+	deniedClaim.UpdatedAt = now
+	require.NoError(t, deps.tx.StartTransaction(func(tx interface{}) error {
+		return deps.claimRepo.UpdateReviewInTx(ctx, tx, deniedClaim)
+	}))
+
+	all, err := deps.claimRepo.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	status := constants.ClaimStatusPending
+	pending, err := deps.claimRepo.List(ctx, &status)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, pendingClaim.ClaimID, pending[0].ClaimID)
+}
+
+func TestStoreClaimRepository_UpdateReviewInTx_AlreadyReviewed(t *testing.T) {
+	deps := setupStoreClaimTest(t)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	require.NoError(t, deps.storeRepo.Create(ctx, store))
+	claim := createTestClaim(t, deps, store.StoreID, "user-1")
+
+	reviewer := "admin-1"
+	now := time.Now()
+	claim.Status = constants.ClaimStatusApproved
+	claim.ReviewedBy = &reviewer
+	claim.ReviewedAt = &now
+	claim.UpdatedAt = now
+	require.NoError(t, deps.tx.StartTransaction(func(tx interface{}) error {
+		return deps.claimRepo.UpdateReviewInTx(ctx, tx, claim)
+	}))
+
+	found, err := deps.claimRepo.FindByID(ctx, claim.ClaimID)
+	require.NoError(t, err)
+	require.Equal(t, constants.ClaimStatusApproved, found.Status)
+	require.NotNil(t, found.ReviewedBy)
+	require.Equal(t, reviewer, *found.ReviewedBy)
+
+	claim.Status = constants.ClaimStatusDenied
+	err = deps.tx.StartTransaction(func(tx interface{}) error {
+		return deps.claimRepo.UpdateReviewInTx(ctx, tx, claim)
+	})
+	require.ErrorIs(t, err, output.ErrClaimAlreadyReviewed)
+}
+
+func TestStoreClaimRepository_CreateInTx_InvalidTransaction(t *testing.T) {
+	deps := setupStoreClaimTest(t)
+
+	err := deps.claimRepo.CreateInTx(context.Background(), "not-a-tx", &entity.StoreClaim{}, nil)
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
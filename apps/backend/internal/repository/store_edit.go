@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+)
+
+type storeEditRepository struct {
+	db *gorm.DB
+}
+
+// NewStoreEditRepository は StoreEditRepository の実装を生成します
+func NewStoreEditRepository(db *gorm.DB) output.StoreEditRepository {
+	return &storeEditRepository{db: db}
+}
+
+func (r *storeEditRepository) List(ctx context.Context, status *string) ([]entity.StoreEdit, error) {
+	query := r.db.WithContext(ctx)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	var edits []model.StoreEdit
+	if err := query.Order("created_at desc").Find(&edits).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToEntities[entity.StoreEdit, model.StoreEdit](edits), nil
+}
+
+func (r *storeEditRepository) FindByID(ctx context.Context, editID string) (*entity.StoreEdit, error) {
+	var edit model.StoreEdit
+	if err := r.db.WithContext(ctx).Where("edit_id = ?", editID).First(&edit).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	entityEdit := edit.Entity()
+	return &entityEdit, nil
+}
+
+func (r *storeEditRepository) FindPendingByStoreID(ctx context.Context, storeID string) (*entity.StoreEdit, error) {
+	var edit model.StoreEdit
+	if err := r.db.WithContext(ctx).
+		Where("store_id = ? AND status = ?", storeID, constants.StoreEditStatusPending).
+		First(&edit).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	entityEdit := edit.Entity()
+	return &entityEdit, nil
+}
+
+func (r *storeEditRepository) CreateInTx(ctx context.Context, tx interface{}, edit *entity.StoreEdit) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+
+	record := model.StoreEdit{
+		EditID:          edit.EditID,
+		StoreID:         edit.StoreID,
+		UserID:          edit.UserID,
+		Name:            edit.Name,
+		Address:         edit.Address,
+		Latitude:        edit.Latitude,
+		Longitude:       edit.Longitude,
+		PlaceID:         edit.PlaceID,
+		ThumbnailFileID: edit.ThumbnailFileID,
+		Status:          edit.Status,
+	}
+	if err := gormTx.WithContext(ctx).Create(&record).Error; err != nil {
+		return mapDBError(err)
+	}
+
+	edit.EditID = record.EditID
+	edit.CreatedAt = record.CreatedAt
+	edit.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *storeEditRepository) UpdateChangesInTx(ctx context.Context, tx interface{}, edit *entity.StoreEdit) error {
+	return r.updatePending(ctx, tx, edit.EditID, map[string]interface{}{
+		"user_id":           edit.UserID,
+		"name":              edit.Name,
+		"address":           edit.Address,
+		"latitude":          edit.Latitude,
+		"longitude":         edit.Longitude,
+		"place_id":          edit.PlaceID,
+		"thumbnail_file_id": edit.ThumbnailFileID,
+		"updated_at":        edit.UpdatedAt,
+	})
+}
+
+func (r *storeEditRepository) UpdateReviewInTx(ctx context.Context, tx interface{}, edit *entity.StoreEdit) error {
+	return r.updatePending(ctx, tx, edit.EditID, map[string]interface{}{
+		"status":      edit.Status,
+		"reviewed_by": edit.ReviewedBy,
+		"review_note": edit.ReviewNote,
+		"reviewed_at": edit.ReviewedAt,
+		"updated_at":  edit.UpdatedAt,
+	})
+}
+
+// updatePending は承認待ちの行だけを更新し、同時に審査された変更を上書きしないようにする
+func (r *storeEditRepository) updatePending(ctx context.Context, tx interface{}, editID string, updates map[string]interface{}) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+
+	result := gormTx.WithContext(ctx).
+		Model(&model.StoreEdit{}).
+		Where("edit_id = ? AND status = ?", editID, constants.StoreEditStatusPending).
+		Updates(updates)
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return output.ErrStoreEditAlreadyReviewed
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func TestStoreEditRepository_Lifecycle(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	storeRepo := repository.NewStoreRepository(db)
+	editRepo := repository.NewStoreEditRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	_, err := editRepo.FindPendingByStoreID(ctx, store.StoreID)
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+
+	name := "New Name"
+	edit := &entity.StoreEdit{
+		EditID:  uuid.New().String(),
+		StoreID: store.StoreID,
+		UserID:  "owner-1",
+		Name:    &name,
+		Status:  constants.StoreEditStatusPending,
+	}
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return editRepo.CreateInTx(ctx, txDB, edit)
+	}))
+
+	pending, err := editRepo.FindPendingByStoreID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, edit.EditID, pending.EditID)
+	require.Equal(t, name, *pending.Name)
+	require.Nil(t, pending.Address)
+
+	address := "New Address"
+	pending.Address = &address
+	pending.UpdatedAt = time.Now()
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return editRepo.UpdateChangesInTx(ctx, txDB, pending)
+	}))
+
+	reviewer := "admin-1"
+	now := time.Now()
+	pending.Status = constants.StoreEditStatusApproved
+	pending.ReviewedBy = &reviewer
+	pending.ReviewedAt = &now
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return editRepo.UpdateReviewInTx(ctx, txDB, pending)
+	}))
+
+	found, err := editRepo.FindByID(ctx, edit.EditID)
+	require.NoError(t, err)
+	require.Equal(t, constants.StoreEditStatusApproved, found.Status)
+	require.Equal(t, address, *found.Address)
+	require.Equal(t, reviewer, *found.ReviewedBy)
+
+	// A reviewed edit can no longer be changed or reviewed again
+	err = tx.StartTransaction(func(txDB interface{}) error {
+		return editRepo.UpdateChangesInTx(ctx, txDB, pending)
+	})
+	require.ErrorIs(t, err, output.ErrStoreEditAlreadyReviewed)
+	err = tx.StartTransaction(func(txDB interface{}) error {
+		return editRepo.UpdateReviewInTx(ctx, txDB, pending)
+	})
+	require.ErrorIs(t, err, output.ErrStoreEditAlreadyReviewed)
+
+	_, err = editRepo.FindPendingByStoreID(ctx, store.StoreID)
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+
+	err = editRepo.CreateInTx(ctx, nil, edit)
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestStoreEditRepository_List(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	storeRepo := repository.NewStoreRepository(db)
+	editRepo := repository.NewStoreEditRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	ids := make([]string, 0, 2)
+	for _, status := range []string{constants.StoreEditStatusRejected, constants.StoreEditStatusPending} {
+		edit := &entity.StoreEdit{
+			EditID:  uuid.New().String(),
+			StoreID: store.StoreID,
+			UserID:  "owner-1",
+			Status:  status,
+		}
+		require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+			return editRepo.CreateInTx(ctx, txDB, edit)
+		}))
+		ids = append(ids, edit.EditID)
+	}
+
+	all, err := editRepo.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	pending := constants.StoreEditStatusPending
+	filtered, err := editRepo.List(ctx, &pending)
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	require.Equal(t, ids[1], filtered[0].EditID)
+}
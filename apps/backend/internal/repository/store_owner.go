@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type storeOwnerRepository struct {
+	db *gorm.DB
+}
+
+// NewStoreOwnerRepository は StoreOwnerRepository の実装を生成します
+func NewStoreOwnerRepository(db *gorm.DB) output.StoreOwnerRepository {
+	return &storeOwnerRepository{db: db}
+}
+
+func (r *storeOwnerRepository) IsOwner(ctx context.Context, storeID, userID string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&model.StoreOwner{}).
+		Where("store_id = ? AND user_id = ?", storeID, userID).
+		Count(&count).Error; err != nil {
+		return false, mapDBError(err)
+	}
+	return count > 0, nil
+}
+
+// AddInTx links the user to the store. Adding an existing owner is a no-op.
+func (r *storeOwnerRepository) AddInTx(ctx context.Context, tx interface{}, storeID, userID string) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	record := model.StoreOwner{StoreID: storeID, UserID: userID}
+	return mapDBError(gormTx.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&record).Error)
+}
@@ -0,0 +1,95 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// setupStoreOwnerTest creates common test dependencies for store owner tests
+func setupStoreOwnerTest(t *testing.T) (output.StoreOwnerRepository, output.StoreRepository, output.Transaction, *gorm.DB) {
+	t.Helper()
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	return repository.NewStoreOwnerRepository(db), repository.NewStoreRepository(db), repository.NewGormTransaction(db), db
+}
+
+func TestStoreOwnerRepository_AddInTx_And_IsOwner(t *testing.T) {
+	ownerRepo, storeRepo, tx, _ := setupStoreOwnerTest(t)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+	ownerID := "user-" + uuid.New().String()[:8]
+	otherID := "user-" + uuid.New().String()[:8]
+
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return ownerRepo.AddInTx(ctx, txDB, store.StoreID, ownerID)
+	}))
+
+	owns, err := ownerRepo.IsOwner(ctx, store.StoreID, ownerID)
+	require.NoError(t, err)
+	require.True(t, owns)
+
+	owns, err = ownerRepo.IsOwner(ctx, store.StoreID, otherID)
+	require.NoError(t, err)
+	require.False(t, owns)
+}
+
+func TestStoreOwnerRepository_AddInTx_Idempotent(t *testing.T) {
+	ownerRepo, storeRepo, tx, db := setupStoreOwnerTest(t)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+	ownerID := "user-" + uuid.New().String()[:8]
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+			return ownerRepo.AddInTx(ctx, txDB, store.StoreID, ownerID)
+		}))
+	}
+
+	var count int64
+	require.NoError(t, db.Table("store_owners").Where("store_id = ?", store.StoreID).Count(&count).Error)
+	require.Equal(t, int64(1), count)
+}
+
+func TestStoreOwnerRepository_AddInTx_InvalidTransaction(t *testing.T) {
+	ownerRepo, _, _, _ := setupStoreOwnerTest(t)
+
+	err := ownerRepo.AddInTx(context.Background(), "not-a-tx", "store-1", "user-1")
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestStoreRepository_FindByOwner(t *testing.T) {
+	ownerRepo, storeRepo, tx, _ := setupStoreOwnerTest(t)
+	ctx := context.Background()
+
+	ownerID := "user-" + uuid.New().String()[:8]
+	owned := newTestStore(t, func(s *entity.Store) { s.Name = "Owned" })
+	other := newTestStore(t, func(s *entity.Store) { s.Name = "Other" })
+
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		if err := storeRepo.CreateInTx(ctx, txDB, owned); err != nil {
+			return err
+		}
+		return ownerRepo.AddInTx(ctx, txDB, owned.StoreID, ownerID)
+	}))
+	require.NoError(t, storeRepo.Create(ctx, other))
+
+	stores, err := storeRepo.FindByOwner(ctx, ownerID)
+	require.NoError(t, err)
+	require.Len(t, stores, 1)
+	require.Equal(t, owned.StoreID, stores[0].StoreID)
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type storePhotoRepository struct {
+	db *gorm.DB
+}
+
+// NewStorePhotoRepository は StorePhotoRepository の実装を生成します
+func NewStorePhotoRepository(db *gorm.DB) output.StorePhotoRepository {
+	return &storePhotoRepository{db: db}
+}
+
+// galleryPhotos は store_files をギャラリーの写真（削除されていない店舗画像）に絞り、表示順に並べます
+func galleryPhotos(db *gorm.DB) *gorm.DB {
+	return db.
+		Select("store_files.*").
+		Joins("JOIN files gf ON gf.file_id = store_files.file_id").
+		Where("gf.file_kind = ? AND gf.is_deleted = ?", constants.FileKindStoreImage, false).
+		Order("store_files.sort_order, store_files.created_at, store_files.file_id")
+}
+
+func (r *storePhotoRepository) FindByStoreID(ctx context.Context, storeID string) ([]entity.StorePhoto, error) {
+	coverFileID, err := r.coverFileID(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []model.StoreFile
+	if err := galleryPhotos(r.db.WithContext(ctx)).
+		Preload("File").
+		Where("store_files.store_id = ?", storeID).
+		Find(&files).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.StorePhotos(files, coverFileID), nil
+}
+
+func (r *storePhotoRepository) FindByID(ctx context.Context, storeID string, fileID string) (*entity.StorePhoto, error) {
+	coverFileID, err := r.coverFileID(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var file model.StoreFile
+	if err := galleryPhotos(r.db.WithContext(ctx)).
+		Preload("File").
+		Where("store_files.store_id = ? AND store_files.file_id = ?", storeID, fileID).
+		First(&file).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	photo := model.StorePhotos([]model.StoreFile{file}, coverFileID)[0]
+	return &photo, nil
+}
+
+func (r *storePhotoRepository) CountByStoreID(ctx context.Context, storeID string) (int, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&model.StoreFile{}).
+		Joins("JOIN files gf ON gf.file_id = store_files.file_id").
+		Where("store_files.store_id = ? AND gf.file_kind = ? AND gf.is_deleted = ?", storeID, constants.FileKindStoreImage, false).
+		Count(&count).Error; err != nil {
+		return 0, mapDBError(err)
+	}
+	return int(count), nil
+}
+
+// coverFileID は店舗のカバー画像のファイル ID を返します
+func (r *storePhotoRepository) coverFileID(ctx context.Context, storeID string) (*string, error) {
+	var store model.Store
+	if err := r.db.WithContext(ctx).
+		Select("store_id", "thumbnail_file_id").
+		Where("store_id = ?", storeID).
+		First(&store).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return store.ThumbnailFileID, nil
+}
+
+// Add はファイルを店舗のギャラリーの末尾に追加します
+func (r *storePhotoRepository) Add(ctx context.Context, storeID string, fileID string) error {
+	return mapDBError(r.db.WithContext(ctx).Exec(
+		`INSERT INTO store_files (store_id, file_id, sort_order, created_at)
+		SELECT ?, ?, COALESCE(MAX(sort_order) + 1, 0), ? FROM store_files WHERE store_id = ?`,
+		storeID, fileID, time.Now(), storeID,
+	).Error)
+}
+
+// UpdateDetails は写真のキャプションと代替テキストを更新します
+func (r *storePhotoRepository) UpdateDetails(ctx context.Context, photo *entity.StorePhoto) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.StoreFile{}).
+		Where("store_id = ? AND file_id = ?", photo.StoreID, photo.File.FileID).
+		Updates(map[string]any{
+			"caption":  photo.Caption,
+			"alt_text": photo.AltText,
+		})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// ReorderInTx は fileIDs の並び順どおりに sort_order を振り直します
+func (r *storePhotoRepository) ReorderInTx(ctx context.Context, tx interface{}, storeID string, fileIDs []string) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	db := txAsserted.WithContext(ctx)
+
+	for i, fileID := range fileIDs {
+		result := db.Model(&model.StoreFile{}).
+			Where("store_id = ? AND file_id = ?", storeID, fileID).
+			UpdateColumn("sort_order", i)
+		if result.Error != nil {
+			return mapDBError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return mapDBError(gorm.ErrRecordNotFound)
+		}
+	}
+	return nil
+}
+
+// SetCoverInTx は写真を店舗のカバー画像にします
+func (r *storePhotoRepository) SetCoverInTx(ctx context.Context, tx interface{}, storeID string, fileID string) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	result := txAsserted.WithContext(ctx).
+		Model(&model.Store{}).
+		Where("store_id = ?", storeID).
+		UpdateColumns(map[string]any{
+			"thumbnail_file_id": fileID,
+			"updated_at":        time.Now(),
+		})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// DeleteInTx は写真のファイルを論理削除し、カバー画像やメニューの写真としての参照を外します。
+// ストレージ上のオブジェクトは削除しません
+func (r *storePhotoRepository) DeleteInTx(ctx context.Context, tx interface{}, storeID string, fileID string) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	db := txAsserted.WithContext(ctx)
+
+	linked := db.Model(&model.StoreFile{}).Select("file_id").Where("store_id = ? AND file_id = ?", storeID, fileID)
+	result := db.Model(&model.File{}).
+		Where("file_id IN (?) AND file_kind = ? AND is_deleted = ?", linked, constants.FileKindStoreImage, false).
+		UpdateColumn("is_deleted", true)
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return mapDBError(gorm.ErrRecordNotFound)
+	}
+
+	if err := db.Model(&model.Store{}).
+		Where("store_id = ? AND thumbnail_file_id = ?", storeID, fileID).
+		UpdateColumn("thumbnail_file_id", nil).Error; err != nil {
+		return mapDBError(err)
+	}
+	return mapDBError(db.Model(&model.Menu{}).
+		Where("store_id = ? AND image_file_id = ?", storeID, fileID).
+		UpdateColumn("image_file_id", nil).Error)
+}
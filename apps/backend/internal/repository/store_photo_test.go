@@ -0,0 +1,132 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// setupStorePhotoTest creates a store for gallery tests
+func setupStorePhotoTest(t *testing.T) (*gorm.DB, output.StorePhotoRepository, *entity.Store) {
+	t.Helper()
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	store := newTestMenuStore(t)
+	require.NoError(t, repository.NewStoreRepository(db).Create(context.Background(), store))
+	return db, repository.NewStorePhotoRepository(db), store
+}
+
+// insertTestStoreFile inserts a file of the given kind and adds it to the end of the store gallery
+func insertTestStoreFile(t *testing.T, db *gorm.DB, repo output.StorePhotoRepository, storeID string, fileKind string) string {
+	t.Helper()
+	fileID := "file-" + uuid.New().String()[:8]
+	require.NoError(t, db.Exec(
+		"INSERT INTO files (file_id, file_kind, file_name, object_key, is_deleted, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		fileID, fileKind, fileID+".jpg", "stores/"+fileID, false, time.Now(),
+	).Error)
+	require.NoError(t, repo.Add(context.Background(), storeID, fileID))
+	return fileID
+}
+
+func galleryFileIDs(photos []entity.StorePhoto) []string {
+	ids := make([]string, len(photos))
+	for i, photo := range photos {
+		ids[i] = photo.File.FileID
+	}
+	return ids
+}
+
+func TestStorePhotoRepository_AddAppendsToGallery(t *testing.T) {
+	db, repo, store := setupStorePhotoTest(t)
+
+	first := insertTestStoreFile(t, db, repo, store.StoreID, constants.FileKindStoreImage)
+	second := insertTestStoreFile(t, db, repo, store.StoreID, constants.FileKindStoreImage)
+	// レビューの写真も store_files に紐付くが、ギャラリーには含めない
+	insertTestStoreFile(t, db, repo, store.StoreID, constants.TargetTypeReview)
+
+	photos, err := repo.FindByStoreID(context.Background(), store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, []string{first, second}, galleryFileIDs(photos))
+	require.Equal(t, 0, photos[0].SortOrder)
+	require.Equal(t, 1, photos[1].SortOrder)
+	require.Equal(t, "stores/"+first, photos[0].File.ObjectKey)
+
+	count, err := repo.CountByStoreID(context.Background(), store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestStorePhotoRepository_UpdateDetailsAndReorder(t *testing.T) {
+	db, repo, store := setupStorePhotoTest(t)
+	first := insertTestStoreFile(t, db, repo, store.StoreID, constants.FileKindStoreImage)
+	second := insertTestStoreFile(t, db, repo, store.StoreID, constants.FileKindStoreImage)
+
+	caption, altText := "カウンター席", "木のカウンターと椅子"
+	require.NoError(t, repo.UpdateDetails(context.Background(), &entity.StorePhoto{
+		StoreID: store.StoreID,
+		File:    entity.File{FileID: second},
+		Caption: &caption,
+		AltText: &altText,
+	}))
+	require.NoError(t, repo.ReorderInTx(context.Background(), db, store.StoreID, []string{second, first}))
+
+	photos, err := repo.FindByStoreID(context.Background(), store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, []string{second, first}, galleryFileIDs(photos))
+	require.NotNil(t, photos[0].Caption)
+	require.Equal(t, caption, *photos[0].Caption)
+	require.Equal(t, altText, *photos[0].AltText)
+}
+
+func TestStorePhotoRepository_ReorderInTx_UnknownFile(t *testing.T) {
+	db, repo, store := setupStorePhotoTest(t)
+
+	err := repo.ReorderInTx(context.Background(), db, store.StoreID, []string{"missing-file"})
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+}
+
+func TestStorePhotoRepository_SetCoverAndDelete(t *testing.T) {
+	db, repo, store := setupStorePhotoTest(t)
+	cover := insertTestStoreFile(t, db, repo, store.StoreID, constants.FileKindStoreImage)
+	other := insertTestStoreFile(t, db, repo, store.StoreID, constants.FileKindStoreImage)
+
+	require.NoError(t, repo.SetCoverInTx(context.Background(), db, store.StoreID, cover))
+	photo, err := repo.FindByID(context.Background(), store.StoreID, cover)
+	require.NoError(t, err)
+	require.True(t, photo.IsCover)
+
+	found, err := repository.NewStoreRepository(db).FindByID(context.Background(), store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, []string{cover, other}, galleryFileIDs(found.Photos))
+	require.True(t, found.Photos[0].IsCover)
+	require.False(t, found.Photos[1].IsCover)
+
+	require.NoError(t, repo.DeleteInTx(context.Background(), db, store.StoreID, cover))
+
+	photos, err := repo.FindByStoreID(context.Background(), store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, []string{other}, galleryFileIDs(photos))
+
+	var thumbnail *string
+	require.NoError(t, db.Table("stores").Select("thumbnail_file_id").Where("store_id = ?", store.StoreID).Scan(&thumbnail).Error)
+	require.Nil(t, thumbnail)
+
+	_, err = repo.FindByID(context.Background(), store.StoreID, cover)
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+	err = repo.DeleteInTx(context.Background(), db, store.StoreID, cover)
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type storeRatingRepository struct {
+	db *gorm.DB
+}
+
+// NewStoreRatingRepository は StoreRatingRepository の実装を生成します
+func NewStoreRatingRepository(db *gorm.DB) output.StoreRatingRepository {
+	return &storeRatingRepository{db: db}
+}
+
+// ratingColumns は集計値の読み出しに必要な stores のカラムです
+var ratingColumns = []string{
+	"store_id", "average_rating", "review_count",
+	"rating_count_1", "rating_count_2", "rating_count_3", "rating_count_4", "rating_count_5",
+	"avg_taste", "avg_atmosphere", "avg_service", "avg_speed", "avg_cleanliness",
+}
+
+func (r *storeRatingRepository) FindByStoreID(ctx context.Context, storeID string) (*entity.RatingSummary, error) {
+	var store model.Store
+	if err := r.db.WithContext(ctx).
+		Select(ratingColumns).
+		First(&store, "store_id = ?", storeID).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	summary := store.RatingSummary()
+	return &summary, nil
+}
+
+// RecomputeInTx は1店舗の集計値を再計算します。RecomputeAll と同じく、レビューが一度も集計されておらず
+// 公開中のレビューもない店舗は更新せず、シードデータなどの average_rating を残します
+func (r *storeRatingRepository) RecomputeInTx(ctx context.Context, tx interface{}, storeID string) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	db := gormTx.WithContext(ctx)
+
+	// 同じ店舗へのレビューが並行して書き込まれても集計が取りこぼされないよう、
+	// 店舗行をロックしてから（= ロック取得後のスナップショットで）再計算する
+	var locked model.Store
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("store_id").
+		First(&locked, "store_id = ?", storeID).Error; err != nil {
+		return mapDBError(err)
+	}
+
+	return mapDBError(db.Model(&model.Store{}).
+		Where("store_id = ?", storeID).
+		Where(reviewedStoreCondition()).
+		UpdateColumns(ratingAggregateUpdates()).Error)
+}
+
+// RecomputeAll は公開中のレビューがある店舗と、集計済みのレビュー件数が残っている店舗を再計算します。
+// レビューが一度も集計されていない店舗はシードデータなどの average_rating を残すため対象外です
+func (r *storeRatingRepository) RecomputeAll(ctx context.Context) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.Store{}).
+		Where(reviewedStoreCondition()).
+		UpdateColumns(ratingAggregateUpdates())
+	if result.Error != nil {
+		return 0, mapDBError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// reviewedStoreCondition は集計済みのレビュー件数が残っているか、公開中のレビューがある店舗の条件を返します
+func reviewedStoreCondition() string {
+	return "(stores.review_count <> 0 OR EXISTS " + reviewAggregate("1", "") + ")"
+}
+
+// ratingAggregateUpdates は stores の集計カラムをレビューから再計算する式を返します。
+// 公開中のレビューがなくなった店舗の average_rating はシードの評価（なければ 0）に戻します。
+// 相関サブクエリのみで構成しているので、1店舗の更新にも全店舗の一括更新にも使えます
+func ratingAggregateUpdates() map[string]any {
+	return map[string]any{
+		"review_count":    gorm.Expr(reviewAggregate("COUNT(*)", "")),
+		"average_rating":  gorm.Expr("COALESCE(" + reviewAggregate("ROUND(AVG(r.rating), 2)", "") + ", stores.seed_average_rating, 0)"),
+		"rating_count_1":  gorm.Expr(reviewAggregate("COUNT(*)", "r.rating = 1")),
+		"rating_count_2":  gorm.Expr(reviewAggregate("COUNT(*)", "r.rating = 2")),
+		"rating_count_3":  gorm.Expr(reviewAggregate("COUNT(*)", "r.rating = 3")),
+		"rating_count_4":  gorm.Expr(reviewAggregate("COUNT(*)", "r.rating = 4")),
+		"rating_count_5":  gorm.Expr(reviewAggregate("COUNT(*)", "r.rating = 5")),
+		"avg_taste":       gorm.Expr(reviewAggregate("ROUND(AVG(r.rating_taste), 2)", "")),
+		"avg_atmosphere":  gorm.Expr(reviewAggregate("ROUND(AVG(r.rating_atmosphere), 2)", "")),
+		"avg_service":     gorm.Expr(reviewAggregate("ROUND(AVG(r.rating_service), 2)", "")),
+		"avg_speed":       gorm.Expr(reviewAggregate("ROUND(AVG(r.rating_speed), 2)", "")),
+		"avg_cleanliness": gorm.Expr(reviewAggregate("ROUND(AVG(r.rating_cleanliness), 2)", "")),
+	}
+}
+
+// reviewAggregate は対象店舗の公開中のレビューに対する集計サブクエリを組み立てます
+func reviewAggregate(aggregate, condition string) string {
+	where := fmt.Sprintf("r.store_id = stores.store_id AND r.visibility = '%s'", constants.VisibilityPublished)
+	if condition != "" {
+		where += " AND " + condition
+	}
+	return fmt.Sprintf("(SELECT %s FROM reviews r WHERE %s)", aggregate, where)
+}
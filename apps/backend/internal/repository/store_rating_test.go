@@ -0,0 +1,211 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func setupStoreRatingTest(t *testing.T) (*gorm.DB, output.StoreRatingRepository, output.StoreRepository) {
+	t.Helper()
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	return db, repository.NewStoreRatingRepository(db), repository.NewStoreRepository(db)
+}
+
+// insertRatedReview inserts a review with an overall rating and an optional taste rating.
+func insertRatedReview(t *testing.T, db *gorm.DB, storeID string, rating int, taste *int) {
+	t.Helper()
+	err := db.Exec(
+		"INSERT INTO reviews (review_id, store_id, user_id, rating, rating_taste, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		uuid.New().String(), storeID, uuid.New().String(), rating, taste, time.Now(),
+	).Error
+	require.NoError(t, err)
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestStoreRatingRepository_RecomputeInTx(t *testing.T) {
+	db, ratingRepo, storeRepo := setupStoreRatingTest(t)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+	other := newTestStore(t)
+	require.NoError(t, storeRepo.Create(ctx, other))
+
+	insertRatedReview(t, db, store.StoreID, 5, intPtr(4))
+	insertRatedReview(t, db, store.StoreID, 4, intPtr(5))
+	insertRatedReview(t, db, store.StoreID, 4, nil)
+	insertRatedReview(t, db, other.StoreID, 1, nil)
+
+	err := repository.NewGormTransaction(db).StartTransaction(func(tx interface{}) error {
+		return ratingRepo.RecomputeInTx(ctx, tx, store.StoreID)
+	})
+	require.NoError(t, err)
+
+	summary, err := ratingRepo.FindByStoreID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, store.StoreID, summary.StoreID)
+	require.Equal(t, 3, summary.ReviewCount)
+	require.InDelta(t, 4.33, summary.AverageRating, 0.001)
+	require.Equal(t, entity.RatingHistogram{0, 0, 0, 2, 1}, summary.Histogram)
+	require.NotNil(t, summary.Averages.Taste)
+	require.InDelta(t, 4.5, *summary.Averages.Taste, 0.001)
+	require.Nil(t, summary.Averages.Service)
+
+	// 対象外の店舗は再計算されない
+	untouched, err := ratingRepo.FindByStoreID(ctx, other.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, 0, untouched.ReviewCount)
+}
+
+func TestStoreRatingRepository_RecomputeInTx_NeverReviewedKeepsSeed(t *testing.T) {
+	db, ratingRepo, storeRepo := setupStoreRatingTest(t)
+	ctx := context.Background()
+
+	store := newTestStore(t, func(s *entity.Store) { s.AverageRating = 3.8 })
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	err := repository.NewGormTransaction(db).StartTransaction(func(tx interface{}) error {
+		return ratingRepo.RecomputeInTx(ctx, tx, store.StoreID)
+	})
+	require.NoError(t, err)
+
+	summary, err := ratingRepo.FindByStoreID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, 0, summary.ReviewCount)
+	require.InDelta(t, 3.8, summary.AverageRating, 0.001)
+	require.Nil(t, summary.Averages.Taste)
+}
+
+func TestStoreRatingRepository_RecomputeInTx_LastReviewDeleted(t *testing.T) {
+	tests := []struct {
+		name string
+		seed *float64
+		want float64
+	}{
+		{name: "falls back to the seed rating", seed: floatPtr(3.8), want: 3.8},
+		{name: "resets without a seed rating", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, ratingRepo, storeRepo := setupStoreRatingTest(t)
+			ctx := context.Background()
+			recompute := func(storeID string) {
+				t.Helper()
+				require.NoError(t, repository.NewGormTransaction(db).StartTransaction(func(tx interface{}) error {
+					return ratingRepo.RecomputeInTx(ctx, tx, storeID)
+				}))
+			}
+
+			store := newTestStore(t, func(s *entity.Store) { s.AverageRating = 3.8 })
+			require.NoError(t, storeRepo.Create(ctx, store))
+			require.NoError(t, db.Exec("UPDATE stores SET seed_average_rating = ? WHERE store_id = ?", tt.seed, store.StoreID).Error)
+
+			insertRatedReview(t, db, store.StoreID, 5, intPtr(4))
+			recompute(store.StoreID)
+			summary, err := ratingRepo.FindByStoreID(ctx, store.StoreID)
+			require.NoError(t, err)
+			require.Equal(t, 1, summary.ReviewCount)
+			require.InDelta(t, 5.0, summary.AverageRating, 0.001)
+
+			require.NoError(t, db.Exec("DELETE FROM reviews WHERE store_id = ?", store.StoreID).Error)
+			recompute(store.StoreID)
+
+			summary, err = ratingRepo.FindByStoreID(ctx, store.StoreID)
+			require.NoError(t, err)
+			require.Equal(t, 0, summary.ReviewCount)
+			require.InDelta(t, tt.want, summary.AverageRating, 0.001)
+			require.Equal(t, entity.RatingHistogram{}, summary.Histogram)
+			require.Nil(t, summary.Averages.Taste)
+		})
+	}
+}
+
+func floatPtr(v float64) *float64 { return &v }
+
+func TestStoreRatingRepository_RecomputeInTx_StoreNotFound(t *testing.T) {
+	db, ratingRepo, _ := setupStoreRatingTest(t)
+
+	err := repository.NewGormTransaction(db).StartTransaction(func(tx interface{}) error {
+		return ratingRepo.RecomputeInTx(context.Background(), tx, "missing-store")
+	})
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+}
+
+func TestStoreRatingRepository_RecomputeInTx_InvalidTransaction(t *testing.T) {
+	_, ratingRepo, _ := setupStoreRatingTest(t)
+
+	err := ratingRepo.RecomputeInTx(context.Background(), "not-a-tx", "store-1")
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestStoreRatingRepository_RecomputeAll(t *testing.T) {
+	db, ratingRepo, storeRepo := setupStoreRatingTest(t)
+	ctx := context.Background()
+
+	first := newTestStore(t)
+	require.NoError(t, storeRepo.Create(ctx, first))
+	second := newTestStore(t)
+	require.NoError(t, storeRepo.Create(ctx, second))
+
+	seeded := newTestStore(t, func(s *entity.Store) { s.AverageRating = 3.8 })
+	require.NoError(t, storeRepo.Create(ctx, seeded))
+	stale := newTestStore(t, func(s *entity.Store) { s.AverageRating = 4.2 })
+	require.NoError(t, storeRepo.Create(ctx, stale))
+	require.NoError(t, db.Exec("UPDATE stores SET review_count = 1, rating_count_4 = 1 WHERE store_id = ?", stale.StoreID).Error)
+
+	insertRatedReview(t, db, first.StoreID, 2, nil)
+	insertRatedReview(t, db, second.StoreID, 5, nil)
+	insertRatedReview(t, db, second.StoreID, 3, nil)
+
+	updated, err := ratingRepo.RecomputeAll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), updated)
+
+	summary, err := ratingRepo.FindByStoreID(ctx, second.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, 2, summary.ReviewCount)
+	require.InDelta(t, 4.0, summary.AverageRating, 0.001)
+	require.Equal(t, entity.RatingHistogram{0, 0, 1, 0, 1}, summary.Histogram)
+
+	// 集計値は店舗の取得結果にも反映される
+	stored, err := storeRepo.FindByID(ctx, first.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, 1, stored.ReviewCount)
+	require.InDelta(t, 2.0, stored.AverageRating, 0.001)
+
+	// レビューが一度も集計されていない店舗はシードの評価を残す
+	summary, err = ratingRepo.FindByStoreID(ctx, seeded.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, 0, summary.ReviewCount)
+	require.InDelta(t, 3.8, summary.AverageRating, 0.001)
+
+	// 集計済みのレビューがなくなった店舗はリセットする
+	summary, err = ratingRepo.FindByStoreID(ctx, stale.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, 0, summary.ReviewCount)
+	require.InDelta(t, 0.0, summary.AverageRating, 0.001)
+	require.Equal(t, entity.RatingHistogram{}, summary.Histogram)
+}
+
+func TestStoreRatingRepository_FindByStoreID_NotFound(t *testing.T) {
+	_, ratingRepo, _ := setupStoreRatingTest(t)
+
+	_, err := ratingRepo.FindByStoreID(context.Background(), "missing-store")
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+)
+
+type storeTagRepository struct {
+	db *gorm.DB
+}
+
+// NewStoreTagRepository は StoreTagRepository の実装を生成します
+func NewStoreTagRepository(db *gorm.DB) output.StoreTagRepository {
+	return &storeTagRepository{db: db}
+}
+
+func (r *storeTagRepository) ReplaceInTx(ctx context.Context, tx interface{}, storeID string, tags []string) error {
+	txAsserted, ok := tx.(*gorm.DB)
+	if !ok {
+		return output.ErrInvalidTransaction
+	}
+	db := txAsserted.WithContext(ctx)
+
+	if err := db.Where("store_id = ?", storeID).Delete(&model.StoreTag{}).Error; err != nil {
+		return mapDBError(err)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	rows := make([]model.StoreTag, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, model.StoreTag{
+			StoreID: storeID,
+			Tag:     tag,
+		})
+	}
+	return mapDBError(db.Create(&rows).Error)
+}
+
+type tagCountRow struct {
+	Tag        string
+	StoreCount int
+}
+
+func (r *storeTagRepository) CountByTag(ctx context.Context) ([]entity.TagCount, error) {
+	var rows []tagCountRow
+	if err := r.db.WithContext(ctx).
+		Table("store_tags st").
+		Select("st.tag, COUNT(*) AS store_count").
+		Joins("JOIN stores s ON s.store_id = st.store_id").
+		Where("s.visibility = ?", constants.VisibilityPublished).
+		Group("st.tag").
+		Order("store_count DESC, st.tag ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	result := make([]entity.TagCount, len(rows))
+	for i, row := range rows {
+		result[i] = entity.TagCount{Tag: row.Tag, StoreCount: row.StoreCount}
+	}
+	return result, nil
+}
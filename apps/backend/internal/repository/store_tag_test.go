@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// setupStoreTagTest creates common test dependencies for store tag tests
+func setupStoreTagTest(t *testing.T) (output.StoreTagRepository, output.StoreRepository, output.Transaction) {
+	t.Helper()
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	return repository.NewStoreTagRepository(db), repository.NewStoreRepository(db), repository.NewGormTransaction(db)
+}
+
+func replaceTags(t *testing.T, tagRepo output.StoreTagRepository, tx output.Transaction, storeID string, tags []string) {
+	t.Helper()
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return tagRepo.ReplaceInTx(context.Background(), txDB, storeID, tags)
+	}))
+}
+
+func TestStoreTagRepository_ReplaceInTx(t *testing.T) {
+	tagRepo, storeRepo, tx := setupStoreTagTest(t)
+	ctx := context.Background()
+
+	store := newTestStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	replaceTags(t, tagRepo, tx, store.StoreID, []string{"wifi", "quiet"})
+	found, err := storeRepo.FindByID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"wifi", "quiet"}, found.Tags)
+
+	replaceTags(t, tagRepo, tx, store.StoreID, []string{"terrace"})
+	found, err = storeRepo.FindByID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"terrace"}, found.Tags)
+
+	replaceTags(t, tagRepo, tx, store.StoreID, []string{})
+	found, err = storeRepo.FindByID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Empty(t, found.Tags)
+}
+
+func TestStoreTagRepository_ReplaceInTx_InvalidTransaction(t *testing.T) {
+	tagRepo, _, _ := setupStoreTagTest(t)
+
+	err := tagRepo.ReplaceInTx(context.Background(), "not-a-tx", "store-1", []string{"wifi"})
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestStoreTagRepository_CountByTag_OnlyApprovedStores(t *testing.T) {
+	tagRepo, storeRepo, tx := setupStoreTagTest(t)
+	ctx := context.Background()
+
+	first := newTestStore(t, func(s *entity.Store) { s.Visibility = constants.VisibilityPublished })
+	second := newTestStore(t, func(s *entity.Store) { s.Visibility = constants.VisibilityPublished })
+	pending := newTestStore(t, func(s *entity.Store) { s.Visibility = constants.VisibilityPending })
+	for _, s := range []*entity.Store{first, second, pending} {
+		require.NoError(t, storeRepo.Create(ctx, s))
+	}
+	replaceTags(t, tagRepo, tx, first.StoreID, []string{"wifi", "quiet"})
+	replaceTags(t, tagRepo, tx, second.StoreID, []string{"wifi", "terrace"})
+	replaceTags(t, tagRepo, tx, pending.StoreID, []string{"wifi", "pending-only"})
+
+	counts, err := tagRepo.CountByTag(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []entity.TagCount{
+		{Tag: "wifi", StoreCount: 2},
+		{Tag: "quiet", StoreCount: 1},
+		{Tag: "terrace", StoreCount: 1},
+	}, counts)
+}
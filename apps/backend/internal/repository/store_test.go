@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"context"
+	"os"
 	"testing"
 	"time"
 
@@ -9,7 +10,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/hours"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
@@ -30,16 +33,18 @@ func setupStoreTest(t *testing.T) output.StoreRepository {
 func newTestStore(t *testing.T, overrides ...func(*entity.Store)) *entity.Store {
 	t.Helper()
 	store := &entity.Store{
-		StoreID:   "store-" + uuid.New().String()[:8],
-		Name:      "Test Store",
-		Address:   "Test Address",
-		Latitude:  35.6812,
-		Longitude: 139.7671,
-		PlaceID:   "place-" + uuid.New().String()[:8],
-		Category:  "カフェ・喫茶",
-		Budget:    "$$",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		StoreID:        "store-" + uuid.New().String()[:8],
+		Name:           "Test Store",
+		Address:        "Test Address",
+		Latitude:       35.6812,
+		Longitude:      139.7671,
+		PlaceID:        "place-" + uuid.New().String()[:8],
+		Category:       "カフェ・喫茶",
+		Budget:         "$$",
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+		Visibility:     constants.VisibilityPublished,
+		ApprovalStatus: constants.StoreApprovalApproved,
 	}
 	for _, fn := range overrides {
 		fn(store)
@@ -75,7 +80,7 @@ func TestStoreRepository_FindAll_Success(t *testing.T) {
 	require.NoError(t, repo.Create(context.Background(), store2))
 
 	// Find all stores
-	stores, err := repo.FindAll(context.Background())
+	stores, err := repo.FindAll(context.Background(), output.Viewer{})
 	require.NoError(t, err)
 	require.Len(t, stores, 2)
 }
@@ -83,7 +88,7 @@ func TestStoreRepository_FindAll_Success(t *testing.T) {
 func TestStoreRepository_FindAll_Empty(t *testing.T) {
 	repo := setupStoreTest(t)
 
-	stores, err := repo.FindAll(context.Background())
+	stores, err := repo.FindAll(context.Background(), output.Viewer{})
 	require.NoError(t, err)
 	require.Empty(t, stores)
 }
@@ -111,26 +116,57 @@ func TestStoreRepository_FindByID_NotFound(t *testing.T) {
 	require.True(t, apperr.IsCode(err, apperr.CodeNotFound), "expected CodeNotFound error, got %v", err)
 }
 
+func TestStoreRepository_FindByIDs(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	store1 := newTestStore(t, func(s *entity.Store) { s.Name = "Store 1" })
+	store2 := newTestStore(t, func(s *entity.Store) { s.Name = "Store 2" })
+	store3 := newTestStore(t, func(s *entity.Store) { s.Name = "Store 3" })
+	for _, s := range []*entity.Store{store1, store2, store3} {
+		require.NoError(t, repo.Create(ctx, s))
+	}
+
+	stores, err := repo.FindByIDs(ctx, []string{store3.StoreID, store1.StoreID, "missing"})
+	require.NoError(t, err)
+	ids := make([]string, len(stores))
+	for i, s := range stores {
+		ids[i] = s.StoreID
+	}
+	require.ElementsMatch(t, []string{store1.StoreID, store3.StoreID}, ids)
+
+	empty, err := repo.FindByIDs(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, empty)
+}
+
 func TestStoreRepository_FindPending_Success(t *testing.T) {
 	repo := setupStoreTest(t)
 
-	// Create approved and pending stores with unique IDs
-	approvedStore := newTestStore(t, func(s *entity.Store) {
-		s.Name = "Approved Store"
-		s.IsApproved = true
-	})
-	pendingStore := newTestStore(t, func(s *entity.Store) {
-		s.Name = "Pending Store"
-		s.IsApproved = false
-	})
-	require.NoError(t, repo.Create(context.Background(), approvedStore))
-	require.NoError(t, repo.Create(context.Background(), pendingStore))
+	// Only stores awaiting approval are listed; drafts have not been submitted yet
+	statuses := []string{
+		constants.StoreApprovalDraft,
+		constants.StoreApprovalSubmitted,
+		constants.StoreApprovalApproved,
+		constants.StoreApprovalRejected,
+		constants.StoreApprovalResubmitted,
+	}
+	ids := make(map[string]string, len(statuses))
+	for _, status := range statuses {
+		store := newTestStore(t, func(s *entity.Store) {
+			s.Name = status
+			s.Visibility = constants.VisibilityPending
+			s.ApprovalStatus = status
+		})
+		require.NoError(t, repo.Create(context.Background(), store))
+		ids[status] = store.StoreID
+	}
 
-	// Find pending stores
 	stores, err := repo.FindPending(context.Background())
 	require.NoError(t, err)
-	require.Len(t, stores, 1)
-	require.Equal(t, pendingStore.StoreID, stores[0].StoreID)
+	require.ElementsMatch(t,
+		[]string{ids[constants.StoreApprovalSubmitted], ids[constants.StoreApprovalResubmitted]},
+		storeIDs(stores))
 }
 
 func TestStoreRepository_Update_Success(t *testing.T) {
@@ -169,3 +205,320 @@ func TestStoreRepository_Delete_Success(t *testing.T) {
 	_, err = repo.FindByID(context.Background(), store.StoreID)
 	require.True(t, apperr.IsCode(err, apperr.CodeNotFound), "expected CodeNotFound after deletion, got %v", err)
 }
+
+func TestStoreRepository_List_PaginatesWithCursor(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		store := newTestStore(t, func(s *entity.Store) {
+			s.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		})
+		require.NoError(t, repo.Create(ctx, store))
+	}
+
+	seen := map[string]bool{}
+	cursor := ""
+	pages := 0
+	for {
+		page, err := repo.List(ctx, output.StoreListQuery{Limit: 2, Cursor: cursor, Sort: "new"})
+		require.NoError(t, err)
+		pages++
+		for i, s := range page.Stores {
+			require.False(t, seen[s.StoreID], "store %s returned twice", s.StoreID)
+			seen[s.StoreID] = true
+			if i > 0 {
+				require.False(t, s.CreatedAt.After(page.Stores[i-1].CreatedAt), "stores must be ordered newest first")
+			}
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	require.Len(t, seen, 5)
+	require.Equal(t, 3, pages)
+}
+
+func TestStoreRepository_List_Filters(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	cafe := newTestStore(t, func(s *entity.Store) {
+		s.Category = "cafe"
+		s.Budget = "$"
+		s.AverageRating = 4.5
+		s.Visibility = constants.VisibilityPublished
+	})
+	bar := newTestStore(t, func(s *entity.Store) {
+		s.Category = "bar"
+		s.Budget = "$$$"
+		s.AverageRating = 3.0
+		s.Visibility = constants.VisibilityPending
+	})
+	require.NoError(t, repo.Create(ctx, cafe))
+	require.NoError(t, repo.Create(ctx, bar))
+
+	// List as an admin so that pending stores are included
+	admin := output.Viewer{IsAdmin: true}
+	category := "cafe"
+	page, err := repo.List(ctx, output.StoreListQuery{Limit: 10, Category: &category, Viewer: admin})
+	require.NoError(t, err)
+	require.Len(t, page.Stores, 1)
+	require.Equal(t, cafe.StoreID, page.Stores[0].StoreID)
+
+	budget := "$$$"
+	page, err = repo.List(ctx, output.StoreListQuery{Limit: 10, Budget: &budget, Viewer: admin})
+	require.NoError(t, err)
+	require.Len(t, page.Stores, 1)
+	require.Equal(t, bar.StoreID, page.Stores[0].StoreID)
+
+	minRating := 4.0
+	page, err = repo.List(ctx, output.StoreListQuery{Limit: 10, MinRating: &minRating, Viewer: admin})
+	require.NoError(t, err)
+	require.Len(t, page.Stores, 1)
+	require.Equal(t, cafe.StoreID, page.Stores[0].StoreID)
+
+	approved := false
+	page, err = repo.List(ctx, output.StoreListQuery{Limit: 10, IsApproved: &approved, Viewer: admin})
+	require.NoError(t, err)
+	require.Len(t, page.Stores, 1)
+	require.Equal(t, bar.StoreID, page.Stores[0].StoreID)
+}
+
+func TestStoreRepository_List_SortByRating(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	for _, rating := range []float64{2.0, 4.5, 3.0} {
+		store := newTestStore(t, func(s *entity.Store) {
+			s.AverageRating = rating
+		})
+		require.NoError(t, repo.Create(ctx, store))
+	}
+
+	page, err := repo.List(ctx, output.StoreListQuery{Limit: 2, Sort: "rating"})
+	require.NoError(t, err)
+	require.Len(t, page.Stores, 2)
+	require.InDelta(t, 4.5, page.Stores[0].AverageRating, 0.001)
+	require.InDelta(t, 3.0, page.Stores[1].AverageRating, 0.001)
+	require.NotEmpty(t, page.NextCursor)
+
+	page, err = repo.List(ctx, output.StoreListQuery{Limit: 2, Sort: "rating", Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Stores, 1)
+	require.InDelta(t, 2.0, page.Stores[0].AverageRating, 0.001)
+	require.Empty(t, page.NextCursor)
+}
+
+func TestStoreRepository_List_InvalidCursor(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	_, err := repo.List(ctx, output.StoreListQuery{Limit: 10, Cursor: "not-a-cursor"})
+	require.True(t, apperr.IsCode(err, apperr.CodeInvalidInput), "expected CodeInvalidInput error, got %v", err)
+}
+
+func TestStoreRepository_List_CursorSortMismatch(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, repo.Create(ctx, newTestStore(t)))
+	}
+
+	page, err := repo.List(ctx, output.StoreListQuery{Limit: 1, Sort: "new"})
+	require.NoError(t, err)
+	require.NotEmpty(t, page.NextCursor)
+
+	_, err = repo.List(ctx, output.StoreListQuery{Limit: 1, Sort: "rating", Cursor: page.NextCursor})
+	require.True(t, apperr.IsCode(err, apperr.CodeInvalidInput), "expected CodeInvalidInput error, got %v", err)
+}
+
+func TestStoreRepository_FindNearby_OrdersByDistance(t *testing.T) {
+	if os.Getenv("TEST_DB_TYPE") != "postgres" {
+		t.Skip("FindNearby requires PostGIS; set TEST_DB_TYPE=postgres to run")
+	}
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	require.NoError(t, db.Exec(`ALTER TABLE stores ADD COLUMN IF NOT EXISTS geog geography(Point, 4326)
+		GENERATED ALWAYS AS (ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography) STORED`).Error)
+	repo := repository.NewStoreRepository(db)
+	ctx := context.Background()
+
+	// 三ノ宮駅(ＪＲ)付近を基準に、約110m・約550m・約5.5km離れた店舗を作成
+	origin := struct{ lat, lng float64 }{34.694839, 135.194942}
+	nearStore := newTestStore(t, func(s *entity.Store) { s.Latitude, s.Longitude = origin.lat+0.001, origin.lng })
+	midStore := newTestStore(t, func(s *entity.Store) { s.Latitude, s.Longitude = origin.lat+0.005, origin.lng })
+	farStore := newTestStore(t, func(s *entity.Store) { s.Latitude, s.Longitude = origin.lat+0.05, origin.lng })
+	for _, s := range []*entity.Store{midStore, farStore, nearStore} {
+		require.NoError(t, repo.Create(ctx, s))
+	}
+
+	stores, err := repo.FindNearby(ctx, output.StoreNearbyQuery{
+		Latitude:     origin.lat,
+		Longitude:    origin.lng,
+		RadiusMeters: 1000,
+		Limit:        10,
+	})
+	require.NoError(t, err)
+	require.Len(t, stores, 2)
+	require.Equal(t, nearStore.StoreID, stores[0].StoreID)
+	require.Equal(t, midStore.StoreID, stores[1].StoreID)
+	require.NotNil(t, stores[0].DistanceMeters)
+	require.InDelta(t, 111, *stores[0].DistanceMeters, 5)
+}
+
+func TestStoreRepository_VisitedByMe(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	repo := repository.NewStoreRepository(db)
+	ctx := context.Background()
+
+	user := newTestReviewUser(t)
+	require.NoError(t, repository.NewUserRepository(db).Create(ctx, user))
+	visited := newTestStore(t)
+	other := newTestStore(t)
+	for _, s := range []*entity.Store{visited, other} {
+		require.NoError(t, repo.Create(ctx, s))
+	}
+	require.NoError(t, repository.NewVisitRepository(db).Save(ctx, &entity.Visit{
+		UserID: user.UserID, StoreID: visited.StoreID, VisitedOn: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}))
+
+	viewer := output.Viewer{UserID: user.UserID}
+	page, err := repo.List(ctx, output.StoreListQuery{Viewer: viewer})
+	require.NoError(t, err)
+	require.Len(t, page.Stores, 2)
+	for _, s := range page.Stores {
+		require.NotNil(t, s.VisitedByMe)
+		require.Equal(t, s.StoreID == visited.StoreID, *s.VisitedByMe)
+	}
+
+	store, err := repo.FindVisibleByID(ctx, visited.StoreID, viewer)
+	require.NoError(t, err)
+	require.NotNil(t, store.VisitedByMe)
+	require.True(t, *store.VisitedByMe)
+
+	// 未ログインの閲覧では判定しない
+	store, err = repo.FindVisibleByID(ctx, visited.StoreID, output.Viewer{})
+	require.NoError(t, err)
+	require.Nil(t, store.VisitedByMe)
+}
+
+func TestStoreRepository_OpeningSchedule_RoundTrip(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	note := "年末年始"
+	schedule := &entity.OpeningSchedule{
+		Special:  []entity.SpecialHours{{Date: time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)}},
+		Closures: []entity.StoreClosure{{From: time.Date(2026, 12, 30, 0, 0, 0, 0, time.UTC), To: time.Date(2027, 1, 3, 0, 0, 0, 0, time.UTC), Note: &note}},
+	}
+	schedule.Weekly[time.Friday] = []entity.OpeningInterval{{Open: 11 * 60, Close: 14 * 60}, {Open: 18 * 60, Close: 26 * 60}}
+	store := newTestStore(t, func(s *entity.Store) { s.OpeningSchedule = schedule })
+	require.NoError(t, repo.Create(ctx, store))
+
+	found, err := repo.FindByID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, schedule, found.OpeningSchedule)
+
+	// 構造化した営業時間を消すと、自由記述だけの店舗に戻る
+	found.OpeningSchedule = nil
+	require.NoError(t, repo.Update(ctx, found))
+	found, err = repo.FindByID(ctx, store.StoreID)
+	require.NoError(t, err)
+	require.Nil(t, found.OpeningSchedule)
+}
+
+func TestStoreRepository_List_OpenAt(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	// 2026-10-14 は水曜日
+	wednesday := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	weekly := func(day time.Weekday, intervals ...entity.OpeningInterval) *entity.OpeningSchedule {
+		var schedule entity.OpeningSchedule
+		schedule.Weekly[day] = intervals
+		return &schedule
+	}
+	lunch := newTestStore(t, func(s *entity.Store) {
+		s.OpeningSchedule = weekly(time.Wednesday, entity.OpeningInterval{Open: 660, Close: 840}, entity.OpeningInterval{Open: 1020, Close: 1320})
+	})
+	overnight := newTestStore(t, func(s *entity.Store) {
+		s.OpeningSchedule = weekly(time.Tuesday, entity.OpeningInterval{Open: 1080, Close: 1560})
+	})
+	closed := newTestStore(t, func(s *entity.Store) {
+		s.OpeningSchedule = weekly(time.Wednesday, entity.OpeningInterval{Open: 660, Close: 1320})
+		s.OpeningSchedule.Closures = []entity.StoreClosure{{From: wednesday.AddDate(0, 0, -1), To: wednesday}}
+	})
+	special := newTestStore(t, func(s *entity.Store) {
+		s.OpeningSchedule = &entity.OpeningSchedule{Special: []entity.SpecialHours{{
+			Date: wednesday, Intervals: []entity.OpeningInterval{{Open: 600, Close: 900}},
+		}}}
+	})
+	unstructured := newTestStore(t, func(s *entity.Store) {
+		hoursText := "11:00-22:00"
+		s.OpeningHours = &hoursText
+	})
+	for _, s := range []*entity.Store{lunch, overnight, closed, special, unstructured} {
+		require.NoError(t, repo.Create(ctx, s))
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want []string
+	}{
+		{"lunch time", hours.At(wednesday, 12*60), []string{lunch.StoreID, special.StoreID}},
+		{"after midnight", hours.At(wednesday, 60), []string{overnight.StoreID}},
+		{"between intervals", hours.At(wednesday, 15*60), nil},
+		{"dinner time", hours.At(wednesday, 21*60), []string{lunch.StoreID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := repo.List(ctx, output.StoreListQuery{OpenAt: &tt.at, Viewer: output.Viewer{IsAdmin: true}})
+			require.NoError(t, err)
+			var got []string
+			for _, s := range page.Stores {
+				got = append(got, s.StoreID)
+			}
+			require.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestStoreRepository_UpdateOpeningSchedule(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	text := "月〜金 11:00-22:00"
+	unparsed := newTestStore(t, func(s *entity.Store) { s.OpeningHours = &text })
+	withoutHours := newTestStore(t)
+	for _, s := range []*entity.Store{unparsed, withoutHours} {
+		require.NoError(t, repo.Create(ctx, s))
+	}
+
+	stores, err := repo.FindWithUnparsedOpeningHours(ctx)
+	require.NoError(t, err)
+	require.Len(t, stores, 1)
+	require.Equal(t, unparsed.StoreID, stores[0].StoreID)
+
+	var schedule entity.OpeningSchedule
+	schedule.Weekly[time.Monday] = []entity.OpeningInterval{{Open: 660, Close: 1320}}
+	require.NoError(t, repo.UpdateOpeningSchedule(ctx, unparsed.StoreID, &schedule))
+
+	stores, err = repo.FindWithUnparsedOpeningHours(ctx)
+	require.NoError(t, err)
+	require.Empty(t, stores)
+	found, err := repo.FindByID(ctx, unparsed.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, &schedule, found.OpeningSchedule)
+	require.Equal(t, text, *found.OpeningHours)
+}
@@ -25,26 +25,76 @@ type testUser struct {
 	Role       string     `gorm:"column:role;default:user"`
 	CreatedAt  time.Time  `gorm:"column:created_at"`
 	UpdatedAt  time.Time  `gorm:"column:updated_at"`
+
+	SuspendedAt      *time.Time `gorm:"column:suspended_at"`
+	SuspendedUntil   *time.Time `gorm:"column:suspended_until"`
+	SuspensionReason *string    `gorm:"column:suspension_reason"`
+	DeletedAt        *time.Time `gorm:"column:deleted_at"`
+	PurgedAt         *time.Time `gorm:"column:purged_at"`
 }
 
 func (testUser) TableName() string { return "users" }
 
+type testUserModerationEvent struct {
+	EventID        int64      `gorm:"column:event_id;primaryKey;autoIncrement"`
+	UserID         string     `gorm:"column:user_id"`
+	AdminID        *string    `gorm:"column:admin_id"`
+	Action         string     `gorm:"column:action"`
+	Reason         *string    `gorm:"column:reason"`
+	FromRole       *string    `gorm:"column:from_role"`
+	ToRole         *string    `gorm:"column:to_role"`
+	SuspendedUntil *time.Time `gorm:"column:suspended_until"`
+	CreatedAt      time.Time  `gorm:"column:created_at"`
+}
+
+func (testUserModerationEvent) TableName() string { return "user_moderation_events" }
+
+type testAuditLog struct {
+	AuditID    int64     `gorm:"column:audit_id;primaryKey;autoIncrement"`
+	ActorID    *string   `gorm:"column:actor_id"`
+	ActorRole  string    `gorm:"column:actor_role"`
+	Action     string    `gorm:"column:action"`
+	TargetType string    `gorm:"column:target_type"`
+	TargetID   string    `gorm:"column:target_id"`
+	Before     []byte    `gorm:"column:before"`
+	After      []byte    `gorm:"column:after"`
+	RequestID  *string   `gorm:"column:request_id"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (testAuditLog) TableName() string { return "audit_logs" }
+
 type testStore struct {
 	StoreID         string     `gorm:"column:store_id;primaryKey"`
 	ThumbnailFileID *string    `gorm:"column:thumbnail_file_id"`
 	Name            string     `gorm:"column:name"`
+	NameKana        *string    `gorm:"column:name_kana"`
 	OpenedAt        *time.Time `gorm:"column:opened_at"`
 	Description     *string    `gorm:"column:description"`
 	Address         string     `gorm:"column:address"`
 	OpeningHours    *string    `gorm:"column:opening_hours"`
+	OpeningSchedule []byte     `gorm:"column:opening_schedule"`
 	Latitude        float64    `gorm:"column:latitude"`
 	Longitude       float64    `gorm:"column:longitude"`
 	GoogleMapURL    *string    `gorm:"column:google_map_url"`
 	PlaceID         string     `gorm:"column:place_id"`
-	IsApproved      bool       `gorm:"column:is_approved;default:false"`
+	Visibility      string     `gorm:"column:visibility;default:pending"`
+	ApprovalStatus  string     `gorm:"column:approval_status;default:draft"`
 	Category        string     `gorm:"column:category;default:'カフェ・喫茶'"`
 	Budget          string     `gorm:"column:budget;default:'$$'"`
 	AverageRating   float64    `gorm:"column:average_rating;default:0.0"`
+	SeedRating      *float64   `gorm:"column:seed_average_rating"`
+	ReviewCount     int        `gorm:"column:review_count;default:0"`
+	RatingCount1    int        `gorm:"column:rating_count_1;default:0"`
+	RatingCount2    int        `gorm:"column:rating_count_2;default:0"`
+	RatingCount3    int        `gorm:"column:rating_count_3;default:0"`
+	RatingCount4    int        `gorm:"column:rating_count_4;default:0"`
+	RatingCount5    int        `gorm:"column:rating_count_5;default:0"`
+	AvgTaste        *float64   `gorm:"column:avg_taste"`
+	AvgAtmosphere   *float64   `gorm:"column:avg_atmosphere"`
+	AvgService      *float64   `gorm:"column:avg_service"`
+	AvgSpeed        *float64   `gorm:"column:avg_speed"`
+	AvgCleanliness  *float64   `gorm:"column:avg_cleanliness"`
 	DistanceMinutes int        `gorm:"column:distance_minutes;default:5"`
 	CreatedAt       time.Time  `gorm:"column:created_at"`
 	UpdatedAt       time.Time  `gorm:"column:updated_at"`
@@ -53,16 +103,30 @@ type testStore struct {
 func (testStore) TableName() string { return "stores" }
 
 type testMenu struct {
-	MenuID      string    `gorm:"column:menu_id;primaryKey"`
-	StoreID     string    `gorm:"column:store_id"`
-	Name        string    `gorm:"column:name"`
-	Price       *int      `gorm:"column:price"`
-	Description *string   `gorm:"column:description"`
-	CreatedAt   time.Time `gorm:"column:created_at"`
+	MenuID      string     `gorm:"column:menu_id;primaryKey"`
+	StoreID     string     `gorm:"column:store_id"`
+	Name        string     `gorm:"column:name"`
+	Price       *int       `gorm:"column:price"`
+	Description *string    `gorm:"column:description"`
+	Section     *string    `gorm:"column:section"`
+	IsAvailable bool       `gorm:"column:is_available;default:true"`
+	SortOrder   int        `gorm:"column:sort_order;default:0"`
+	ImageFileID *string    `gorm:"column:image_file_id"`
+	CreatedAt   time.Time  `gorm:"column:created_at"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at"`
+	DeletedAt   *time.Time `gorm:"column:deleted_at"`
 }
 
 func (testMenu) TableName() string { return "menus" }
 
+type testMenuDietaryTag struct {
+	MenuID    string    `gorm:"column:menu_id;primaryKey"`
+	Tag       string    `gorm:"column:tag;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (testMenuDietaryTag) TableName() string { return "menu_dietary_tags" }
+
 type testReview struct {
 	ReviewID          string    `gorm:"column:review_id;primaryKey"`
 	StoreID           string    `gorm:"column:store_id"`
@@ -74,21 +138,27 @@ type testReview struct {
 	RatingSpeed       *int      `gorm:"column:rating_speed"`
 	RatingCleanliness *int      `gorm:"column:rating_cleanliness"`
 	Content           *string   `gorm:"column:content"`
+	Visibility        string    `gorm:"column:visibility;default:published"`
 	CreatedAt         time.Time `gorm:"column:created_at"`
+	UpdatedAt         time.Time `gorm:"column:updated_at"`
 }
 
 func (testReview) TableName() string { return "reviews" }
 
 type testFile struct {
-	FileID      string    `gorm:"column:file_id;primaryKey"`
-	FileKind    string    `gorm:"column:file_kind"`
-	FileName    string    `gorm:"column:file_name"`
-	FileSize    *int64    `gorm:"column:file_size"`
-	ObjectKey   string    `gorm:"column:object_key"`
-	ContentType *string   `gorm:"column:content_type"`
-	IsDeleted   bool      `gorm:"column:is_deleted"`
-	CreatedAt   time.Time `gorm:"column:created_at"`
-	CreatedBy   *string   `gorm:"column:created_by"`
+	FileID             string     `gorm:"column:file_id;primaryKey"`
+	FileKind           string     `gorm:"column:file_kind"`
+	FileName           string     `gorm:"column:file_name"`
+	FileSize           *int64     `gorm:"column:file_size"`
+	ObjectKey          string     `gorm:"column:object_key"`
+	ContentType        *string    `gorm:"column:content_type"`
+	IsDeleted          bool       `gorm:"column:is_deleted"`
+	CreatedAt          time.Time  `gorm:"column:created_at"`
+	CreatedBy          *string    `gorm:"column:created_by"`
+	UploadedAt         *time.Time `gorm:"column:uploaded_at"`
+	ProcessedAt        *time.Time `gorm:"column:processed_at"`
+	Variants           []byte     `gorm:"column:variants"`
+	ProcessingAttempts int        `gorm:"column:processing_attempts;not null;default:0"`
 }
 
 func (testFile) TableName() string { return "files" }
@@ -102,14 +172,18 @@ type testFavorite struct {
 func (testFavorite) TableName() string { return "favorites" }
 
 type testReport struct {
-	ReportID   int64     `gorm:"column:report_id;primaryKey;autoIncrement"`
-	UserID     string    `gorm:"column:user_id"`
-	TargetType string    `gorm:"column:target_type"`
-	TargetID   int64     `gorm:"column:target_id"`
-	Reason     string    `gorm:"column:reason"`
-	Status     string    `gorm:"column:status;default:pending"`
-	CreatedAt  time.Time `gorm:"column:created_at"`
-	UpdatedAt  time.Time `gorm:"column:updated_at"`
+	ReportID       int64      `gorm:"column:report_id;primaryKey;autoIncrement"`
+	UserID         string     `gorm:"column:user_id"`
+	TargetType     string     `gorm:"column:target_type"`
+	TargetID       string     `gorm:"column:target_id"`
+	Reason         string     `gorm:"column:reason"`
+	Status         string     `gorm:"column:status;default:pending"`
+	Enforcement    *string    `gorm:"column:enforcement"`
+	ResolutionNote *string    `gorm:"column:resolution_note"`
+	ResolvedBy     *string    `gorm:"column:resolved_by"`
+	ResolvedAt     *time.Time `gorm:"column:resolved_at"`
+	CreatedAt      time.Time  `gorm:"column:created_at"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at"`
 }
 
 func (testReport) TableName() string { return "reports" }
@@ -117,6 +191,9 @@ func (testReport) TableName() string { return "reports" }
 type testStoreFile struct {
 	StoreID   string    `gorm:"column:store_id;primaryKey"`
 	FileID    string    `gorm:"column:file_id;primaryKey"`
+	SortOrder int       `gorm:"column:sort_order;default:0"`
+	Caption   *string   `gorm:"column:caption"`
+	AltText   *string   `gorm:"column:alt_text"`
 	CreatedAt time.Time `gorm:"column:created_at"`
 }
 
@@ -130,6 +207,80 @@ type testStoreTag struct {
 
 func (testStoreTag) TableName() string { return "store_tags" }
 
+type testStoreOpeningHour struct {
+	OpeningHourID int64      `gorm:"column:opening_hour_id;primaryKey;autoIncrement"`
+	StoreID       string     `gorm:"column:store_id"`
+	Weekday       *int       `gorm:"column:weekday"`
+	ServiceDate   *time.Time `gorm:"column:service_date"`
+	OpenMinute    *int       `gorm:"column:open_minute"`
+	CloseMinute   *int       `gorm:"column:close_minute"`
+}
+
+func (testStoreOpeningHour) TableName() string { return "store_opening_hours" }
+
+type testStoreOwner struct {
+	StoreID   string    `gorm:"column:store_id;primaryKey"`
+	UserID    string    `gorm:"column:user_id;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (testStoreOwner) TableName() string { return "store_owners" }
+
+type testStoreClaim struct {
+	ClaimID    string     `gorm:"column:claim_id;primaryKey"`
+	StoreID    string     `gorm:"column:store_id"`
+	UserID     string     `gorm:"column:user_id"`
+	Note       string     `gorm:"column:note"`
+	Status     string     `gorm:"column:status;default:pending"`
+	ReviewedBy *string    `gorm:"column:reviewed_by"`
+	ReviewNote *string    `gorm:"column:review_note"`
+	ReviewedAt *time.Time `gorm:"column:reviewed_at"`
+	CreatedAt  time.Time  `gorm:"column:created_at"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at"`
+}
+
+func (testStoreClaim) TableName() string { return "store_claims" }
+
+type testStoreClaimFile struct {
+	ClaimID   string    `gorm:"column:claim_id;primaryKey"`
+	FileID    string    `gorm:"column:file_id;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (testStoreClaimFile) TableName() string { return "store_claim_files" }
+
+type testStoreEdit struct {
+	EditID          string     `gorm:"column:edit_id;primaryKey"`
+	StoreID         string     `gorm:"column:store_id"`
+	UserID          string     `gorm:"column:user_id"`
+	Name            *string    `gorm:"column:name"`
+	Address         *string    `gorm:"column:address"`
+	Latitude        *float64   `gorm:"column:latitude"`
+	Longitude       *float64   `gorm:"column:longitude"`
+	PlaceID         *string    `gorm:"column:place_id"`
+	ThumbnailFileID *string    `gorm:"column:thumbnail_file_id"`
+	Status          string     `gorm:"column:status;default:pending"`
+	ReviewedBy      *string    `gorm:"column:reviewed_by"`
+	ReviewNote      *string    `gorm:"column:review_note"`
+	ReviewedAt      *time.Time `gorm:"column:reviewed_at"`
+	CreatedAt       time.Time  `gorm:"column:created_at"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at"`
+}
+
+func (testStoreEdit) TableName() string { return "store_edits" }
+
+type testStoreApprovalEvent struct {
+	EventID    int64     `gorm:"column:event_id;primaryKey;autoIncrement"`
+	StoreID    string    `gorm:"column:store_id"`
+	FromStatus string    `gorm:"column:from_status"`
+	ToStatus   string    `gorm:"column:to_status"`
+	ActorID    *string   `gorm:"column:actor_id"`
+	Reason     *string   `gorm:"column:reason"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (testStoreApprovalEvent) TableName() string { return "store_approval_events" }
+
 type testReviewMenu struct {
 	ReviewID  string    `gorm:"column:review_id;primaryKey"`
 	MenuID    string    `gorm:"column:menu_id;primaryKey"`
@@ -154,6 +305,37 @@ type testReviewLike struct {
 
 func (testReviewLike) TableName() string { return "review_likes" }
 
+type testUserFollow struct {
+	FollowerID string    `gorm:"column:follower_id;primaryKey"`
+	FolloweeID string    `gorm:"column:followee_id;primaryKey"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (testUserFollow) TableName() string { return "user_follows" }
+
+type testStoreVisit struct {
+	VisitID   int64     `gorm:"column:visit_id;primaryKey;autoIncrement"`
+	UserID    string    `gorm:"column:user_id;uniqueIndex:store_visits_user_store_day_key"`
+	StoreID   string    `gorm:"column:store_id;uniqueIndex:store_visits_user_store_day_key"`
+	VisitedOn time.Time `gorm:"column:visited_on;uniqueIndex:store_visits_user_store_day_key"`
+	Note      *string   `gorm:"column:note"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (testStoreVisit) TableName() string { return "store_visits" }
+
+type testStation struct {
+	ID   int64    `gorm:"column:id;primaryKey;autoIncrement"`
+	Name string   `gorm:"column:name"`
+	Kana string   `gorm:"column:kana"`
+	Kind string   `gorm:"column:kind"`
+	Lat  *float64 `gorm:"column:lat"`
+	Lng  *float64 `gorm:"column:lng"`
+}
+
+func (testStation) TableName() string { return "stations" }
+
 // SetupTestDB creates a test database instance.
 // By default, it uses SQLite in-memory database.
 // Set TEST_DB_TYPE=postgres and TEST_DATABASE_URL to use PostgreSQL.
@@ -188,15 +370,27 @@ func SetupTestDB(t *testing.T) *gorm.DB {
 		&testUser{},
 		&testStore{},
 		&testMenu{},
+		&testMenuDietaryTag{},
 		&testReview{},
 		&testFile{},
 		&testFavorite{},
 		&testReport{},
 		&testStoreFile{},
 		&testStoreTag{},
+		&testStoreOpeningHour{},
+		&testStoreOwner{},
+		&testStoreClaim{},
+		&testStoreClaimFile{},
+		&testStoreApprovalEvent{},
+		&testStoreEdit{},
+		&testUserModerationEvent{},
+		&testAuditLog{},
 		&testReviewMenu{},
 		&testReviewFile{},
 		&testReviewLike{},
+		&testUserFollow{},
+		&testStoreVisit{},
+		&testStation{},
 	)
 	if err != nil {
 		t.Fatalf("failed to migrate test database: %v", err)
@@ -2,7 +2,11 @@ package repository
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
@@ -112,3 +116,130 @@ func (r *userRepository) UpdateRoleInTx(ctx context.Context, tx interface{}, use
 		Where("user_id = ?", userID).
 		Update("role", role).Error)
 }
+
+// AnonymizeInTx は退会したユーザーの個人情報を上書きし、退会日時を記録します
+func (r *userRepository) AnonymizeInTx(ctx context.Context, tx interface{}, user entity.User) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	result := gormTx.WithContext(ctx).Model(&model.User{}).
+		Where("user_id = ?", user.UserID).
+		Updates(map[string]interface{}{
+			"name":         user.Name,
+			"email":        user.Email,
+			"phone":        user.Phone,
+			"icon_url":     user.IconURL,
+			"icon_file_id": user.IconFileID,
+			"gender":       user.Gender,
+			"birthday":     user.Birthday,
+			"deleted_at":   user.DeletedAt,
+			"updated_at":   user.UpdatedAt,
+		})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+	}
+	return nil
+}
+
+// FindPurgePending は退会済みで、認証ユーザーやアップロードしたファイルの削除が終わっていないユーザーを退会の古い順に返します
+func (r *userRepository) FindPurgePending(ctx context.Context, limit int) ([]entity.User, error) {
+	var users []model.User
+	if err := r.db.WithContext(ctx).
+		Where("deleted_at IS NOT NULL AND purged_at IS NULL").
+		Order("deleted_at ASC, user_id ASC").
+		Limit(limit).
+		Find(&users).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToEntities[entity.User, model.User](users), nil
+}
+
+// MarkPurged は退会したユーザーの認証ユーザーとファイルを削除し終えた日時を記録します
+func (r *userRepository) MarkPurged(ctx context.Context, userID string, purgedAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&model.User{}).
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID).
+		Update("purged_at", purgedAt)
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+	}
+	return nil
+}
+
+// userCursor is the keyset position of the last user on a page.
+type userCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"id"`
+}
+
+func (cursor userCursor) valid() bool {
+	return cursor.ID != ""
+}
+
+// List はユーザーを登録の新しい順に返します。Query はメールアドレスか名前の部分一致（大文字小文字を区別しない）
+func (r *userRepository) List(ctx context.Context, query output.UserListQuery) (*output.UserPage, error) {
+	if query.Limit <= 0 {
+		query.Limit = constants.DefaultUserListLimit
+	}
+
+	db := r.db.WithContext(ctx).Model(&model.User{})
+	if q := strings.ToLower(strings.TrimSpace(query.Query)); q != "" {
+		pattern := "%" + escapeLike(q) + "%"
+		db = db.Where(`LOWER(email) LIKE ? ESCAPE '\' OR LOWER(name) LIKE ? ESCAPE '\'`, pattern, pattern)
+	}
+	if query.Role != nil {
+		db = db.Where("role = ?", *query.Role)
+	}
+	if query.Cursor != "" {
+		cursor, err := decodeCursor[userCursor](query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where(
+			"created_at < ? OR (created_at = ? AND user_id < ?)",
+			cursor.CreatedAt, cursor.CreatedAt, cursor.ID,
+		)
+	}
+
+	var users []model.User
+	if err := db.Order("created_at DESC, user_id DESC").Limit(query.Limit + 1).Find(&users).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	page := &output.UserPage{}
+	if len(users) > query.Limit {
+		users = users[:query.Limit]
+		last := users[len(users)-1]
+		page.NextCursor = encodeCursor(userCursor{CreatedAt: last.CreatedAt, ID: last.UserID})
+	}
+	page.Users = model.ToEntities[entity.User, model.User](users)
+	return page, nil
+}
+
+func (r *userRepository) UpdateSuspensionInTx(ctx context.Context, tx interface{}, user entity.User) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	result := gormTx.WithContext(ctx).Model(&model.User{}).
+		Where("user_id = ?", user.UserID).
+		Updates(map[string]interface{}{
+			"suspended_at":      user.SuspendedAt,
+			"suspended_until":   user.SuspendedUntil,
+			"suspension_reason": user.SuspensionReason,
+			"updated_at":        user.UpdatedAt,
+		})
+	if result.Error != nil {
+		return mapDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+	}
+	return nil
+}
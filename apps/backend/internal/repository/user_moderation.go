@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+)
+
+type userModerationEventRepository struct {
+	db *gorm.DB
+}
+
+// NewUserModerationEventRepository は UserModerationEventRepository の実装を生成します
+func NewUserModerationEventRepository(db *gorm.DB) output.UserModerationEventRepository {
+	return &userModerationEventRepository{db: db}
+}
+
+func (r *userModerationEventRepository) CreateInTx(ctx context.Context, tx interface{}, event *entity.UserModerationEvent) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+
+	record := model.UserModerationEvent{
+		UserID:         event.UserID,
+		AdminID:        event.AdminID,
+		Action:         event.Action,
+		Reason:         event.Reason,
+		FromRole:       event.FromRole,
+		ToRole:         event.ToRole,
+		SuspendedUntil: event.SuspendedUntil,
+	}
+	if err := gormTx.WithContext(ctx).Create(&record).Error; err != nil {
+		return mapDBError(err)
+	}
+
+	event.EventID = record.EventID
+	event.CreatedAt = record.CreatedAt
+	return nil
+}
+
+func (r *userModerationEventRepository) FindByUserID(ctx context.Context, userID string) ([]entity.UserModerationEvent, error) {
+	var events []model.UserModerationEvent
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at desc, event_id desc").
+		Find(&events).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToEntities[entity.UserModerationEvent, model.UserModerationEvent](events), nil
+}
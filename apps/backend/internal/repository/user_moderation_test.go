@@ -0,0 +1,177 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func TestUserRepository_List_SearchAndRole(t *testing.T) {
+	repo := setupUserTest(t)
+	ctx := context.Background()
+
+	base := time.Now().Add(-time.Hour)
+	users := []*entity.User{
+		{UserID: "user-alice", Name: "Alice", Email: "alice@example.com", Role: "user"},
+		{UserID: "user-bob", Name: "Bob", Email: "bob@shop.example.com", Role: "owner"},
+		{UserID: "user-carol", Name: "Carol 100%", Email: "carol@example.com", Role: "owner"},
+	}
+	for i, user := range users {
+		user.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		user.UpdatedAt = user.CreatedAt
+		require.NoError(t, repo.Create(ctx, user))
+	}
+
+	page, err := repo.List(ctx, output.UserListQuery{Query: "ALICE", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Users, 1)
+	require.Equal(t, "user-alice", page.Users[0].UserID)
+
+	page, err = repo.List(ctx, output.UserListQuery{Query: "shop", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Users, 1)
+	require.Equal(t, "user-bob", page.Users[0].UserID)
+
+	// LIKE wildcards in the query are matched literally
+	page, err = repo.List(ctx, output.UserListQuery{Query: "100%", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Users, 1)
+	require.Equal(t, "user-carol", page.Users[0].UserID)
+
+	role := "owner"
+	page, err = repo.List(ctx, output.UserListQuery{Role: &role, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Users, 2)
+	require.Equal(t, "user-carol", page.Users[0].UserID)
+	require.Equal(t, "user-bob", page.Users[1].UserID)
+}
+
+func TestUserRepository_List_Cursor(t *testing.T) {
+	repo := setupUserTest(t)
+	ctx := context.Background()
+
+	base := time.Now().Add(-time.Hour)
+	for i := 0; i < 5; i++ {
+		user := newTestUser(t)
+		user.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, repo.Create(ctx, user))
+	}
+
+	var seen []string
+	cursor := ""
+	for {
+		page, err := repo.List(ctx, output.UserListQuery{Limit: 2, Cursor: cursor})
+		require.NoError(t, err)
+		for _, user := range page.Users {
+			seen = append(seen, user.UserID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	require.Len(t, seen, 5)
+
+	_, err := repo.List(ctx, output.UserListQuery{Limit: 2, Cursor: "not-a-cursor"})
+	require.Error(t, err)
+}
+
+func TestUserRepository_UpdateSuspensionInTx(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	repo := repository.NewUserRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	user := newTestUser(t)
+	require.NoError(t, repo.Create(ctx, user))
+
+	now := time.Now()
+	until := now.Add(24 * time.Hour)
+	reason := "spam"
+	user.SuspendedAt = &now
+	user.SuspendedUntil = &until
+	user.SuspensionReason = &reason
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return repo.UpdateSuspensionInTx(ctx, txDB, *user)
+	}))
+
+	found, err := repo.FindByID(ctx, user.UserID)
+	require.NoError(t, err)
+	require.True(t, found.IsSuspended(time.Now()))
+	require.Equal(t, reason, *found.SuspensionReason)
+
+	// Clearing the suspension sets the columns back to NULL
+	user.SuspendedAt = nil
+	user.SuspendedUntil = nil
+	user.SuspensionReason = nil
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return repo.UpdateSuspensionInTx(ctx, txDB, *user)
+	}))
+
+	found, err = repo.FindByID(ctx, user.UserID)
+	require.NoError(t, err)
+	require.Nil(t, found.SuspendedAt)
+	require.Nil(t, found.SuspendedUntil)
+	require.Nil(t, found.SuspensionReason)
+
+	err = tx.StartTransaction(func(txDB interface{}) error {
+		return repo.UpdateSuspensionInTx(ctx, txDB, entity.User{UserID: "missing"})
+	})
+	require.ErrorIs(t, err, entity.ErrNotFound)
+
+	err = repo.UpdateSuspensionInTx(ctx, nil, *user)
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestUserModerationEventRepository_CreateAndFind(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	userRepo := repository.NewUserRepository(db)
+	eventRepo := repository.NewUserModerationEventRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	user := newTestUser(t)
+	admin := newTestUser(t)
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, userRepo.Create(ctx, admin))
+
+	reason := "spam"
+	fromRole, toRole := "user", "owner"
+	events := []*entity.UserModerationEvent{
+		{UserID: user.UserID, AdminID: &admin.UserID, Action: constants.ModerationActionBan, Reason: &reason},
+		{UserID: user.UserID, AdminID: &admin.UserID, Action: constants.ModerationActionChangeRole, FromRole: &fromRole, ToRole: &toRole},
+		{UserID: admin.UserID, Action: constants.ModerationActionUnsuspend},
+	}
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		for _, event := range events {
+			if err := eventRepo.CreateInTx(ctx, txDB, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NotZero(t, events[0].EventID)
+	require.False(t, events[0].CreatedAt.IsZero())
+
+	found, err := eventRepo.FindByUserID(ctx, user.UserID)
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	require.Equal(t, constants.ModerationActionChangeRole, found[0].Action)
+	require.Equal(t, toRole, *found[0].ToRole)
+	require.Equal(t, constants.ModerationActionBan, found[1].Action)
+	require.Equal(t, reason, *found[1].Reason)
+	require.Equal(t, admin.UserID, *found[1].AdminID)
+
+	err = eventRepo.CreateInTx(ctx, nil, &entity.UserModerationEvent{UserID: user.UserID})
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
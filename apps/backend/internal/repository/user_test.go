@@ -152,3 +152,80 @@ func TestUserRepository_FindByID_ReturnsNotFoundError(t *testing.T) {
 	require.True(t, apperr.IsCode(err, apperr.CodeNotFound), "expected CodeNotFound, got %v", err)
 	require.True(t, errors.Is(err, entity.ErrNotFound), "expected underlying error to be ErrNotFound, got %v", err)
 }
+
+func TestUserRepository_AnonymizeInTx(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	repo := repository.NewUserRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	user := newTestUser(t)
+	phone := "090-1234-5678"
+	user.Name = "Taro"
+	user.Phone = &phone
+	require.NoError(t, repo.Create(ctx, user))
+
+	now := time.Now()
+	anonymized := *user
+	anonymized.Name = "退会済みユーザー"
+	anonymized.Email = "deleted-" + user.UserID + "@deleted.invalid"
+	anonymized.Phone = nil
+	anonymized.DeletedAt = &now
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return repo.AnonymizeInTx(ctx, txDB, anonymized)
+	}))
+
+	found, err := repo.FindByID(ctx, user.UserID)
+	require.NoError(t, err)
+	require.True(t, found.IsDeleted())
+	require.Equal(t, anonymized.Name, found.Name)
+	require.Equal(t, anonymized.Email, found.Email)
+	require.Nil(t, found.Phone)
+
+	err = tx.StartTransaction(func(txDB interface{}) error {
+		return repo.AnonymizeInTx(ctx, txDB, entity.User{UserID: "missing"})
+	})
+	require.ErrorIs(t, err, entity.ErrNotFound)
+
+	err = repo.AnonymizeInTx(ctx, nil, anonymized)
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestUserRepository_FindPurgePendingAndMarkPurged(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTestDB(t, db) })
+	repo := repository.NewUserRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	active := newTestUser(t)
+	deleted := newTestUser(t)
+	require.NoError(t, repo.Create(ctx, active))
+	require.NoError(t, repo.Create(ctx, deleted))
+
+	now := time.Now()
+	anonymized := *deleted
+	anonymized.DeletedAt = &now
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return repo.AnonymizeInTx(ctx, txDB, anonymized)
+	}))
+
+	pending, err := repo.FindPurgePending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, deleted.UserID, pending[0].UserID)
+
+	require.NoError(t, repo.MarkPurged(ctx, deleted.UserID, now))
+	found, err := repo.FindByID(ctx, deleted.UserID)
+	require.NoError(t, err)
+	require.False(t, found.IsPurgePending())
+
+	pending, err = repo.FindPurgePending(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// 退会していないユーザーは削除済みにできない
+	err = repo.MarkPurged(ctx, active.UserID, now)
+	require.ErrorIs(t, err, entity.ErrNotFound)
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+)
+
+// visibleStores は viewer が閲覧できる店舗に絞り込みます。
+// table は店舗のテーブル名またはエイリアスで、admin 以外は公開中の店舗と自分が管理する店舗だけになります
+func visibleStores(db *gorm.DB, table string, viewer output.Viewer) *gorm.DB {
+	if viewer.IsAdmin {
+		return db
+	}
+	published := fmt.Sprintf("%s.visibility = ?", table)
+	if viewer.UserID == "" {
+		return db.Where(published, constants.VisibilityPublished)
+	}
+	return db.Where(
+		fmt.Sprintf("(%s OR EXISTS (SELECT 1 FROM store_owners vso WHERE vso.store_id = %s.store_id AND vso.user_id = ?))", published, table),
+		constants.VisibilityPublished, viewer.UserID,
+	)
+}
+
+// visibleReviews は viewer が閲覧できるレビューに絞り込みます。
+// table はレビューのテーブル名またはエイリアスで、admin 以外は公開中の店舗にある公開中のレビューと自分のレビューだけになります
+func visibleReviews(db *gorm.DB, table string, viewer output.Viewer) *gorm.DB {
+	if viewer.IsAdmin {
+		return db
+	}
+	published := fmt.Sprintf(
+		"%[1]s.visibility = ? AND EXISTS (SELECT 1 FROM stores vs WHERE vs.store_id = %[1]s.store_id AND vs.visibility = ?)",
+		table,
+	)
+	if viewer.UserID == "" {
+		return db.Where(published, constants.VisibilityPublished, constants.VisibilityPublished)
+	}
+	return db.Where(
+		fmt.Sprintf("((%s) OR %s.user_id = ?)", published, table),
+		constants.VisibilityPublished, constants.VisibilityPublished, viewer.UserID,
+	)
+}
@@ -0,0 +1,197 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func storeIDs(stores []entity.Store) []string {
+	ids := make([]string, 0, len(stores))
+	for _, s := range stores {
+		ids = append(ids, s.StoreID)
+	}
+	return ids
+}
+
+func reviewIDs(reviews []entity.Review) []string {
+	ids := make([]string, 0, len(reviews))
+	for _, r := range reviews {
+		ids = append(ids, r.ReviewID)
+	}
+	return ids
+}
+
+func TestStoreVisibility_ByViewer(t *testing.T) {
+	db, _, _, storeRepo, _ := setupReviewTest(t)
+	ownerRepo := repository.NewStoreOwnerRepository(db)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	published := newTestStore(t)
+	pending := newTestStore(t, func(s *entity.Store) { s.Visibility = constants.VisibilityPending })
+	hidden := newTestStore(t, func(s *entity.Store) { s.Visibility = constants.VisibilityHidden })
+	for _, s := range []*entity.Store{published, pending, hidden} {
+		require.NoError(t, storeRepo.Create(ctx, s))
+	}
+	ownerID := "user-" + uuid.New().String()[:8]
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return ownerRepo.AddInTx(ctx, txDB, pending.StoreID, ownerID)
+	}))
+
+	tests := []struct {
+		name   string
+		viewer output.Viewer
+		want   []string
+	}{
+		{"anonymous", output.Viewer{}, []string{published.StoreID}},
+		{"other user", output.Viewer{UserID: "user-other"}, []string{published.StoreID}},
+		{"owner", output.Viewer{UserID: ownerID}, []string{published.StoreID, pending.StoreID}},
+		{"admin", output.Viewer{IsAdmin: true}, []string{published.StoreID, pending.StoreID, hidden.StoreID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stores, err := storeRepo.FindAll(ctx, tt.viewer)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.want, storeIDs(stores))
+
+			page, err := storeRepo.List(ctx, output.StoreListQuery{Limit: 10, Viewer: tt.viewer})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.want, storeIDs(page.Stores))
+
+			visible, err := storeRepo.IsVisible(ctx, hidden.StoreID, tt.viewer)
+			require.NoError(t, err)
+			require.Equal(t, tt.viewer.IsAdmin, visible)
+		})
+	}
+}
+
+func TestStoreRepository_FindVisibleByID(t *testing.T) {
+	repo := setupStoreTest(t)
+	ctx := context.Background()
+
+	hidden := newTestStore(t, func(s *entity.Store) { s.Visibility = constants.VisibilityHidden })
+	require.NoError(t, repo.Create(ctx, hidden))
+
+	_, err := repo.FindVisibleByID(ctx, hidden.StoreID, output.Viewer{})
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+
+	found, err := repo.FindVisibleByID(ctx, hidden.StoreID, output.Viewer{IsAdmin: true})
+	require.NoError(t, err)
+	require.Equal(t, constants.VisibilityHidden, found.Visibility)
+
+	// FindByID is used by management paths and ignores visibility
+	found, err = repo.FindByID(ctx, hidden.StoreID)
+	require.NoError(t, err)
+	require.Equal(t, hidden.StoreID, found.StoreID)
+
+	visible, err := repo.IsVisible(ctx, "missing-store", output.Viewer{IsAdmin: true})
+	require.NoError(t, err)
+	require.False(t, visible)
+}
+
+func TestReviewVisibility_ByViewer(t *testing.T) {
+	db, reviewRepo, userRepo, storeRepo, _ := setupReviewTest(t)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	author := newTestReviewUser(t)
+	other := newTestReviewUser(t)
+	require.NoError(t, userRepo.Create(ctx, author))
+	require.NoError(t, userRepo.Create(ctx, other))
+
+	store := newTestReviewStore(t)
+	require.NoError(t, storeRepo.Create(ctx, store))
+
+	visibleID := "review-" + uuid.New().String()[:8]
+	hiddenID := "review-" + uuid.New().String()[:8]
+	insertReviewDirectly(t, db, visibleID, store.StoreID, other.UserID, 4, "Visible")
+	insertReviewDirectly(t, db, hiddenID, store.StoreID, author.UserID, 1, "Hidden")
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return reviewRepo.UpdateVisibilityInTx(ctx, txDB, hiddenID, constants.VisibilityHidden)
+	}))
+
+	reviews, err := reviewRepo.FindByStoreID(ctx, store.StoreID, "", output.Viewer{})
+	require.NoError(t, err)
+	require.Equal(t, []string{visibleID}, reviewIDs(reviews))
+
+	visible, err := reviewRepo.IsVisible(ctx, hiddenID, output.Viewer{UserID: other.UserID})
+	require.NoError(t, err)
+	require.False(t, visible)
+	visible, err = reviewRepo.IsVisible(ctx, hiddenID, output.Viewer{UserID: author.UserID})
+	require.NoError(t, err)
+	require.True(t, visible)
+	visible, err = reviewRepo.IsVisible(ctx, "missing-review", output.Viewer{IsAdmin: true})
+	require.NoError(t, err)
+	require.False(t, visible)
+
+	reviews, err = reviewRepo.FindByStoreID(ctx, store.StoreID, "", output.Viewer{UserID: author.UserID})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{visibleID, hiddenID}, reviewIDs(reviews))
+
+	reviews, err = reviewRepo.FindByUserID(ctx, author.UserID, output.Viewer{})
+	require.NoError(t, err)
+	require.Empty(t, reviews)
+
+	reviews, err = reviewRepo.FindByUserID(ctx, author.UserID, output.Viewer{IsAdmin: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{hiddenID}, reviewIDs(reviews))
+	require.Equal(t, constants.VisibilityHidden, reviews[0].Visibility)
+
+	// Reviews of a store that is no longer published are hidden from the public as well
+	require.NoError(t, tx.StartTransaction(func(txDB interface{}) error {
+		return storeRepo.UpdateVisibilityInTx(ctx, txDB, store.StoreID, constants.VisibilityHidden, constants.StoreApprovalDraft)
+	}))
+
+	reviews, err = reviewRepo.FindByUserID(ctx, other.UserID, output.Viewer{})
+	require.NoError(t, err)
+	require.Empty(t, reviews)
+
+	reviews, err = reviewRepo.FindByUserID(ctx, other.UserID, output.Viewer{UserID: other.UserID})
+	require.NoError(t, err)
+	require.Equal(t, []string{visibleID}, reviewIDs(reviews))
+}
+
+func TestReviewRepository_UpdateVisibilityInTx_NotFound(t *testing.T) {
+	db, reviewRepo, _, _, _ := setupReviewTest(t)
+	tx := repository.NewGormTransaction(db)
+	ctx := context.Background()
+
+	err := tx.StartTransaction(func(txDB interface{}) error {
+		return reviewRepo.UpdateVisibilityInTx(ctx, txDB, "missing-review", constants.VisibilityHidden)
+	})
+	require.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+}
+
+func TestReviewRepository_UpdateVisibilityInTx_InvalidTransaction(t *testing.T) {
+	_, reviewRepo, _, _, _ := setupReviewTest(t)
+
+	err := reviewRepo.UpdateVisibilityInTx(context.Background(), nil, "review-1", constants.VisibilityHidden)
+	require.ErrorIs(t, err, output.ErrInvalidTransaction)
+}
+
+func TestFavoriteRepository_FindByUserID_SkipsInvisibleStores(t *testing.T) {
+	favRepo, userRepo, storeRepo := setupFavoriteTest(t)
+	ctx := context.Background()
+
+	user, _ := createTestUserAndStore(t, userRepo, storeRepo)
+	store := newTestStore(t, func(s *entity.Store) { s.Visibility = constants.VisibilityHidden })
+	require.NoError(t, storeRepo.Create(ctx, store))
+	require.NoError(t, favRepo.Create(ctx, &entity.Favorite{UserID: user.UserID, StoreID: store.StoreID}))
+
+	favorites, err := favRepo.FindByUserID(ctx, user.UserID, output.Viewer{UserID: user.UserID})
+	require.NoError(t, err)
+	require.Empty(t, favorites)
+
+	favorites, err = favRepo.FindByUserID(ctx, user.UserID, output.Viewer{IsAdmin: true})
+	require.NoError(t, err)
+	require.Len(t, favorites, 1)
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/model"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type visitRepository struct {
+	db *gorm.DB
+}
+
+// NewVisitRepository は VisitRepository の実装を生成します
+func NewVisitRepository(db *gorm.DB) output.VisitRepository {
+	return &visitRepository{db: db}
+}
+
+// visitCursor is the keyset position of the last visit on a page.
+type visitCursor struct {
+	VisitedOn time.Time `json:"d"`
+	ID        int64     `json:"id"`
+}
+
+func (cursor visitCursor) valid() bool {
+	return cursor.ID > 0
+}
+
+// visitDayConflict は同じユーザー・店舗・日の訪問記録の一意制約です
+var visitDayConflict = []clause.Column{{Name: "user_id"}, {Name: "store_id"}, {Name: "visited_on"}}
+
+func (r *visitRepository) Save(ctx context.Context, visit *entity.Visit) error {
+	now := time.Now()
+	record := model.StoreVisit{
+		UserID:    visit.UserID,
+		StoreID:   visit.StoreID,
+		VisitedOn: visit.VisitedOn,
+		Note:      visit.Note,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	db := r.db.WithContext(ctx)
+	if err := db.Clauses(clause.OnConflict{
+		Columns: visitDayConflict,
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "note"}, Value: gorm.Expr("COALESCE(excluded.note, store_visits.note)")},
+			{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("excluded.updated_at")},
+		},
+	}).Create(&record).Error; err != nil {
+		return mapDBError(err)
+	}
+
+	// 既存の訪問を更新した場合に備えて、保存後の行を読み直す
+	var saved model.StoreVisit
+	if err := db.
+		Where("user_id = ? AND store_id = ? AND visited_on = ?", visit.UserID, visit.StoreID, visit.VisitedOn).
+		First(&saved).Error; err != nil {
+		return mapDBError(err)
+	}
+	*visit = saved.Entity()
+	return nil
+}
+
+func (r *visitRepository) MarkVisitedInTx(ctx context.Context, tx interface{}, userID string, storeID string, visitedOn time.Time) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	now := time.Now()
+	record := model.StoreVisit{
+		UserID:    userID,
+		StoreID:   storeID,
+		VisitedOn: visitedOn,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	return mapDBError(gormTx.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: visitDayConflict, DoNothing: true}).
+		Create(&record).Error)
+}
+
+func (r *visitRepository) FindByID(ctx context.Context, visitID int64) (*entity.Visit, error) {
+	var visit model.StoreVisit
+	if err := r.db.WithContext(ctx).First(&visit, "visit_id = ?", visitID).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	result := visit.Entity()
+	return &result, nil
+}
+
+func (r *visitRepository) Delete(ctx context.Context, visitID int64) error {
+	return mapDBError(r.db.WithContext(ctx).
+		Where("visit_id = ?", visitID).
+		Delete(&model.StoreVisit{}).Error)
+}
+
+// visibleVisitStores は viewer が閲覧できる店舗への訪問に絞り込みます
+func (r *visitRepository) visibleVisitStores(db *gorm.DB, viewer output.Viewer) *gorm.DB {
+	return db.Where("EXISTS (?)", visibleStores(
+		r.db.Table("stores").Select("1").Where("stores.store_id = store_visits.store_id"),
+		"stores", viewer,
+	))
+}
+
+// ListByUser はユーザーの訪問履歴を訪問日の新しい順に返します
+func (r *visitRepository) ListByUser(ctx context.Context, userID string, query output.VisitListQuery) (*output.VisitPage, error) {
+	if query.Limit <= 0 {
+		query.Limit = constants.DefaultVisitListLimit
+	}
+
+	db := r.visibleVisitStores(r.db.WithContext(ctx), query.Viewer).
+		Preload("Store").
+		Preload("Store.ThumbnailFile").
+		Preload("Store.Tags").
+		Where("store_visits.user_id = ?", userID)
+	if query.StoreID != nil {
+		db = db.Where("store_visits.store_id = ?", *query.StoreID)
+	}
+	if query.Cursor != "" {
+		cursor, err := decodeCursor[visitCursor](query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where(
+			"store_visits.visited_on < ? OR (store_visits.visited_on = ? AND store_visits.visit_id < ?)",
+			cursor.VisitedOn, cursor.VisitedOn, cursor.ID,
+		)
+	}
+
+	var visits []model.StoreVisit
+	if err := db.
+		Order("store_visits.visited_on DESC, store_visits.visit_id DESC").
+		Limit(query.Limit + 1).
+		Find(&visits).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+
+	page := &output.VisitPage{}
+	if len(visits) > query.Limit {
+		visits = visits[:query.Limit]
+		last := visits[len(visits)-1]
+		page.NextCursor = encodeCursor(visitCursor{VisitedOn: last.VisitedOn, ID: last.VisitID})
+	}
+	page.Visits = model.ToEntities[entity.Visit, model.StoreVisit](visits)
+	return page, nil
+}
+
+func (r *visitRepository) CountByUser(ctx context.Context, userID string, viewer output.Viewer) (*entity.VisitCounts, error) {
+	var counts struct {
+		VisitCount int `gorm:"column:visit_count"`
+		StoreCount int `gorm:"column:store_count"`
+	}
+	if err := r.visibleVisitStores(r.db.WithContext(ctx).Model(&model.StoreVisit{}), viewer).
+		Select("COUNT(*) AS visit_count, COUNT(DISTINCT store_visits.store_id) AS store_count").
+		Where("store_visits.user_id = ?", userID).
+		Scan(&counts).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return &entity.VisitCounts{VisitCount: counts.VisitCount, StoreCount: counts.StoreCount}, nil
+}
+
+func (r *visitRepository) FindByUserID(ctx context.Context, userID string) ([]entity.Visit, error) {
+	var visits []model.StoreVisit
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("visited_on DESC, visit_id DESC").
+		Find(&visits).Error; err != nil {
+		return nil, mapDBError(err)
+	}
+	return model.ToEntities[entity.Visit, model.StoreVisit](visits), nil
+}
+
+func (r *visitRepository) DeleteByUserIDInTx(ctx context.Context, tx interface{}, userID string) error {
+	gormTx, ok := tx.(*gorm.DB)
+	if !ok || gormTx == nil {
+		return output.ErrInvalidTransaction
+	}
+	return mapDBError(gormTx.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.StoreVisit{}).Error)
+}
@@ -0,0 +1,152 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository"
+	"github.com/TeamH04/team-production/apps/backend/internal/repository/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// setupVisitTest creates a visit repository with a user and the given number of published stores
+func setupVisitTest(t *testing.T, storeCount int) (*gorm.DB, output.VisitRepository, *entity.User, []*entity.Store) {
+	t.Helper()
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	ctx := context.Background()
+	user := newTestReviewUser(t)
+	require.NoError(t, repository.NewUserRepository(db).Create(ctx, user))
+	storeRepo := repository.NewStoreRepository(db)
+	stores := make([]*entity.Store, storeCount)
+	for i := range stores {
+		stores[i] = newTestReviewStore(t)
+		require.NoError(t, storeRepo.Create(ctx, stores[i]))
+	}
+	return db, repository.NewVisitRepository(db), user, stores
+}
+
+func visitDate(day int) time.Time {
+	return time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestVisitRepository_Save_UpdatesSameDay(t *testing.T) {
+	_, visitRepo, user, stores := setupVisitTest(t, 1)
+	ctx := context.Background()
+
+	note := "ランチで利用"
+	first := &entity.Visit{UserID: user.UserID, StoreID: stores[0].StoreID, VisitedOn: visitDate(1), Note: &note}
+	require.NoError(t, visitRepo.Save(ctx, first))
+	require.NotZero(t, first.VisitID)
+
+	// メモを省略して同じ日に記録し直してもメモは残る
+	again := &entity.Visit{UserID: user.UserID, StoreID: stores[0].StoreID, VisitedOn: visitDate(1)}
+	require.NoError(t, visitRepo.Save(ctx, again))
+	require.Equal(t, first.VisitID, again.VisitID)
+	require.NotNil(t, again.Note)
+	require.Equal(t, note, *again.Note)
+
+	other := &entity.Visit{UserID: user.UserID, StoreID: stores[0].StoreID, VisitedOn: visitDate(2)}
+	require.NoError(t, visitRepo.Save(ctx, other))
+	require.NotEqual(t, first.VisitID, other.VisitID)
+}
+
+func TestVisitRepository_MarkVisitedInTx_KeepsExistingVisit(t *testing.T) {
+	db, visitRepo, user, stores := setupVisitTest(t, 1)
+	ctx := context.Background()
+
+	note := "二回目"
+	require.NoError(t, visitRepo.Save(ctx, &entity.Visit{UserID: user.UserID, StoreID: stores[0].StoreID, VisitedOn: visitDate(1), Note: &note}))
+
+	txManager := repository.NewGormTransaction(db)
+	require.NoError(t, txManager.StartTransaction(func(tx interface{}) error {
+		if err := visitRepo.MarkVisitedInTx(ctx, tx, user.UserID, stores[0].StoreID, visitDate(1)); err != nil {
+			return err
+		}
+		return visitRepo.MarkVisitedInTx(ctx, tx, user.UserID, stores[0].StoreID, visitDate(3))
+	}))
+
+	visits, err := visitRepo.FindByUserID(ctx, user.UserID)
+	require.NoError(t, err)
+	require.Len(t, visits, 2)
+	require.Nil(t, visits[0].Note)
+	require.NotNil(t, visits[1].Note)
+	require.Equal(t, note, *visits[1].Note)
+
+	require.ErrorIs(t, visitRepo.MarkVisitedInTx(ctx, nil, user.UserID, stores[0].StoreID, visitDate(1)), output.ErrInvalidTransaction)
+}
+
+func TestVisitRepository_ListByUser(t *testing.T) {
+	db, visitRepo, user, stores := setupVisitTest(t, 3)
+	ctx := context.Background()
+
+	for i, store := range stores {
+		require.NoError(t, visitRepo.Save(ctx, &entity.Visit{UserID: user.UserID, StoreID: store.StoreID, VisitedOn: visitDate(i + 1)}))
+	}
+	require.NoError(t, visitRepo.Save(ctx, &entity.Visit{UserID: user.UserID, StoreID: stores[0].StoreID, VisitedOn: visitDate(10)}))
+	// 非公開になった店舗への訪問は一覧に含めない
+	require.NoError(t, db.Exec("UPDATE stores SET visibility = ? WHERE store_id = ?", constants.VisibilityHidden, stores[2].StoreID).Error)
+
+	viewer := output.Viewer{UserID: user.UserID}
+	page, err := visitRepo.ListByUser(ctx, user.UserID, output.VisitListQuery{Limit: 2, Viewer: viewer})
+	require.NoError(t, err)
+	require.Len(t, page.Visits, 2)
+	require.Equal(t, visitDate(10), page.Visits[0].VisitedOn.UTC())
+	require.NotNil(t, page.Visits[0].Store)
+	require.Equal(t, stores[0].StoreID, page.Visits[0].Store.StoreID)
+	require.Equal(t, stores[1].StoreID, page.Visits[1].StoreID)
+	require.NotEmpty(t, page.NextCursor)
+
+	page, err = visitRepo.ListByUser(ctx, user.UserID, output.VisitListQuery{Limit: 2, Cursor: page.NextCursor, Viewer: viewer})
+	require.NoError(t, err)
+	require.Len(t, page.Visits, 1)
+	require.Equal(t, visitDate(1), page.Visits[0].VisitedOn.UTC())
+	require.Empty(t, page.NextCursor)
+
+	storeID := stores[0].StoreID
+	page, err = visitRepo.ListByUser(ctx, user.UserID, output.VisitListQuery{StoreID: &storeID, Viewer: viewer})
+	require.NoError(t, err)
+	require.Len(t, page.Visits, 2)
+
+	_, err = visitRepo.ListByUser(ctx, user.UserID, output.VisitListQuery{Cursor: "not-a-cursor", Viewer: viewer})
+	require.Equal(t, apperr.CodeInvalidInput, apperr.CodeOf(err))
+
+	counts, err := visitRepo.CountByUser(ctx, user.UserID, viewer)
+	require.NoError(t, err)
+	require.Equal(t, entity.VisitCounts{VisitCount: 3, StoreCount: 2}, *counts)
+}
+
+func TestVisitRepository_DeleteAndDeleteByUserIDInTx(t *testing.T) {
+	db, visitRepo, user, stores := setupVisitTest(t, 2)
+	ctx := context.Background()
+
+	first := &entity.Visit{UserID: user.UserID, StoreID: stores[0].StoreID, VisitedOn: visitDate(1)}
+	require.NoError(t, visitRepo.Save(ctx, first))
+	require.NoError(t, visitRepo.Save(ctx, &entity.Visit{UserID: user.UserID, StoreID: stores[1].StoreID, VisitedOn: visitDate(1)}))
+
+	found, err := visitRepo.FindByID(ctx, first.VisitID)
+	require.NoError(t, err)
+	require.Equal(t, user.UserID, found.UserID)
+
+	require.NoError(t, visitRepo.Delete(ctx, first.VisitID))
+	_, err = visitRepo.FindByID(ctx, first.VisitID)
+	require.Equal(t, apperr.CodeNotFound, apperr.CodeOf(err))
+
+	txManager := repository.NewGormTransaction(db)
+	require.NoError(t, txManager.StartTransaction(func(tx interface{}) error {
+		return visitRepo.DeleteByUserIDInTx(ctx, tx, user.UserID)
+	}))
+	visits, err := visitRepo.FindByUserID(ctx, user.UserID)
+	require.NoError(t, err)
+	require.Empty(t, visits)
+}
@@ -5,6 +5,9 @@ const (
 	// Health
 	HealthPath = "/health"
 
+	// Local storage signed URLs (served outside /api)
+	StorageObjectPath = "/storage/:bucket/*"
+
 	// Auth
 	AuthSignupPath          = "/signup"
 	AuthLoginPath           = "/login"
@@ -12,36 +15,96 @@ const (
 	AuthRolePath            = "/role"
 	OwnerSignupCompletePath = "/owner/signup/complete"
 
+	// Owner
+	OwnerStoresPath = "/owner/stores"
+
 	// Stores
-	StoresPath       = "/stores"
-	StoreByIDPath    = "/stores/:id"
-	StoreMenusPath   = "/stores/:id/menus"
-	StoreReviewsPath = "/stores/:id/reviews"
+	StoresPath         = "/stores"
+	StoresNearbyPath   = "/stores/nearby"
+	StoreByIDPath      = "/stores/:id"
+	StoreMenusPath     = "/stores/:id/menus"
+	StoreMenuOrderPath = "/stores/:id/menus/order"
+	StoreMenuByIDPath  = "/stores/:id/menus/:menu_id"
+	StoreReviewsPath   = "/stores/:id/reviews"
+	StoreRatingPath    = "/stores/:id/rating-summary"
+	StoreVisitsPath    = "/stores/:id/visits"
+
+	// Store gallery paths
+	StorePhotoUploadsPath = "/stores/:id/media/upload"
+	StorePhotosPath       = "/stores/:id/photos"
+	StorePhotoOrderPath   = "/stores/:id/photos/order"
+	StorePhotoByIDPath    = "/stores/:id/photos/:file_id"
+	StoreCoverPath        = "/stores/:id/cover"
+
+	// Store claims
+	StoreClaimsPath       = "/stores/:id/claims"
+	StoreClaimUploadsPath = "/stores/:id/claims/uploads"
+
+	// Store approval
+	StoreSubmitPath        = "/stores/:id/submit"
+	StoreReviewHistoryPath = "/stores/:id/review-history"
+
+	// Search
+	SearchPath = "/search"
+
+	// Tags
+	TagsPath      = "/tags"
+	TagStoresPath = "/tags/:tag/stores"
 
 	// Stations
-	StationsPath = "/stations"
+	StationsPath        = "/stations"
+	StationsNearestPath = "/stations/nearest"
+	StationGroupsPath   = "/stations/groups"
 
 	// Reviews
+	ReviewByIDPath  = "/reviews/:id"
 	ReviewLikesPath = "/reviews/:id/likes"
 
 	// Users
-	UsersMePath        = "/users/me"
-	UserByIDPath       = "/users/:id"
-	UserReviewsPath    = "/users/:id/reviews"
-	UserFavoritesPath  = "/users/me/favorites"
-	UserFavoriteByPath = "/users/me/favorites/:store_id"
+	UsersMePath         = "/users/me"
+	UserMeExportPath    = "/users/me/export"
+	UserByIDPath        = "/users/:id"
+	UserReviewsPath     = "/users/:id/reviews"
+	UserFavoritesPath   = "/users/me/favorites"
+	UserFavoriteByPath  = "/users/me/favorites/:store_id"
+	UserFollowPath      = "/users/:id/follow"
+	UserFollowersPath   = "/users/:id/followers"
+	UserFollowingPath   = "/users/:id/following"
+	UserVisitsPath      = "/users/me/visits"
+	UserVisitCountsPath = "/users/me/visits/counts"
+	UserVisitByIDPath   = "/users/me/visits/:visit_id"
+
+	// Feed
+	FeedPath = "/feed"
 
 	// Reports
 	ReportsPath = "/reports"
 
 	// Media
-	MediaUploadPath = "/media/upload"
+	MediaUploadPath   = "/media/upload"
+	MediaCompletePath = "/media/:file_id/complete"
 
 	// Admin
-	AdminStoresPendingPath = "/stores/pending"
-	AdminStoreApprovePath  = "/stores/:id/approve"
-	AdminStoreRejectPath   = "/stores/:id/reject"
-	AdminReportsPath       = "/reports"
-	AdminReportActionPath  = "/reports/:id/action"
-	AdminUserByIDPath      = "/users/:id"
+	AdminStoresPendingPath         = "/stores/pending"
+	AdminStoreApprovePath          = "/stores/:id/approve"
+	AdminStoreRejectPath           = "/stores/:id/reject"
+	AdminStoreVisibilityPath       = "/stores/:id/visibility"
+	AdminReviewVisibilityPath      = "/reviews/:id/visibility"
+	AdminReportsPath               = "/reports"
+	AdminReportActionPath          = "/reports/:id/action"
+	AdminUsersPath                 = "/users"
+	AdminUserByIDPath              = "/users/:id"
+	AdminUserSuspendPath           = "/users/:id/suspend"
+	AdminUserUnsuspendPath         = "/users/:id/unsuspend"
+	AdminUserRolePath              = "/users/:id/role"
+	AdminUserModerationHistoryPath = "/users/:id/moderation-history"
+	AdminClaimsPath                = "/claims"
+	AdminClaimByIDPath             = "/claims/:id"
+	AdminClaimApprovePath          = "/claims/:id/approve"
+	AdminClaimDenyPath             = "/claims/:id/deny"
+	AdminStoreEditsPath            = "/store-edits"
+	AdminStoreEditByIDPath         = "/store-edits/:id"
+	AdminStoreEditApprovePath      = "/store-edits/:id/approve"
+	AdminStoreEditRejectPath       = "/store-edits/:id/reject"
+	AdminAuditLogsPath             = "/audit-logs"
 )
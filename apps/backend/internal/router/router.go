@@ -16,18 +16,31 @@ import (
 
 // Dependencies bundles the HTTP handlers and middleware collaborators required by the router.
 type Dependencies struct {
-	UserUC          input.UserUseCase
-	StoreHandler    *handlers.StoreHandler
-	MenuHandler     *handlers.MenuHandler
-	StationHandler  *handlers.StationHandler
-	ReviewHandler   *handlers.ReviewHandler
-	UserHandler     *handlers.UserHandler
-	FavoriteHandler *handlers.FavoriteHandler
-	ReportHandler   *handlers.ReportHandler
-	AuthHandler     *handlers.AuthHandler
-	OwnerHandler    *handlers.OwnerHandler
-	AdminHandler    *handlers.AdminHandler
-	MediaHandler    *handlers.MediaHandler
+	UserUC           input.UserUseCase
+	StoreHandler     *handlers.StoreHandler
+	MenuHandler      *handlers.MenuHandler
+	PhotoHandler     *handlers.StorePhotoHandler
+	StationHandler   *handlers.StationHandler
+	TagHandler       *handlers.TagHandler
+	SearchHandler    *handlers.SearchHandler
+	ReviewHandler    *handlers.ReviewHandler
+	UserHandler      *handlers.UserHandler
+	FavoriteHandler  *handlers.FavoriteHandler
+	ReportHandler    *handlers.ReportHandler
+	AuthHandler      *handlers.AuthHandler
+	OwnerHandler     *handlers.OwnerHandler
+	AdminHandler     *handlers.AdminHandler
+	MediaHandler     *handlers.MediaHandler
+	ClaimHandler     *handlers.StoreClaimHandler
+	ApprovalHandler  *handlers.StoreApprovalHandler
+	EditHandler      *handlers.StoreEditHandler
+	AdminUserHandler *handlers.AdminUserHandler
+	AuditLogHandler  *handlers.AuditLogHandler
+	AccountHandler   *handlers.AccountHandler
+	FollowHandler    *handlers.FollowHandler
+	VisitHandler     *handlers.VisitHandler
+	// StorageHandler はローカルストレージを使う場合だけ設定する
+	StorageHandler *handlers.StorageHandler
 
 	TokenVerifier  security.TokenVerifier
 	AuthMiddleware *mw.AuthMiddleware
@@ -45,13 +58,15 @@ func NewServer(deps *Dependencies) *echo.Echo {
 	}
 
 	// グローバルミドルウェア
+	e.Use(mw.RequestID())
 	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
-		LogStatus:   true,
-		LogURI:      true,
-		LogMethod:   true,
-		LogError:    true,
-		LogLatency:  true,
-		HandleError: true,
+		LogRequestID: true,
+		LogStatus:    true,
+		LogURI:       true,
+		LogMethod:    true,
+		LogError:     true,
+		LogLatency:   true,
+		HandleError:  true,
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
 			// 400以上のステータスコード（エラー）のみログ出力
 			if v.Status >= 400 {
@@ -60,6 +75,7 @@ func NewServer(deps *Dependencies) *echo.Echo {
 					"uri", v.URI,
 					"status", v.Status,
 					"latency", v.Latency,
+					"request_id", v.RequestID,
 				}
 				if v.Error != nil {
 					attrs = append(attrs, "error", v.Error)
@@ -81,12 +97,27 @@ func NewServer(deps *Dependencies) *echo.Echo {
 		return c.JSON(http.StatusOK, echo.Map{"status": "ok"})
 	})
 
+	// ローカルストレージの署名付き URL
+	setupStorageRoutes(e, deps)
+
 	// APIルーティング
 	setupAPIRoutes(e, deps)
 
 	return e
 }
 
+// storageBodyLimit はローカルストレージにアップロードできる大きさ。Supabase Storage の既定の上限に合わせる
+const storageBodyLimit = "50M"
+
+// setupStorageRoutes はローカルストレージの署名付き URL のルーティングを設定します。認証は URL の署名で行う
+func setupStorageRoutes(e *echo.Echo, deps *Dependencies) {
+	if deps.StorageHandler == nil {
+		return
+	}
+	e.PUT(StorageObjectPath, deps.StorageHandler.UploadObject, middleware.BodyLimit(storageBodyLimit))
+	e.GET(StorageObjectPath, deps.StorageHandler.DownloadObject)
+}
+
 // setupAPIRoutes はAPIのルーティングを設定します
 func setupAPIRoutes(e *echo.Echo, deps *Dependencies) {
 	api := e.Group("/api")
@@ -97,6 +128,16 @@ func setupAPIRoutes(e *echo.Echo, deps *Dependencies) {
 	// 店舗関連エンドポイント
 	setupStoreRoutes(api, deps)
 
+	// 店舗オーナー申請エンドポイント
+	setupClaimRoutes(api, deps)
+	setupApprovalRoutes(api, deps)
+
+	// タグ関連エンドポイント
+	setupTagRoutes(api, deps)
+
+	// 検索エンドポイント
+	setupSearchRoutes(api, deps)
+
 	// 駅関連エンドポイント
 	setupStationRoutes(api, deps)
 
@@ -106,6 +147,12 @@ func setupAPIRoutes(e *echo.Echo, deps *Dependencies) {
 	// お気に入り関連エンドポイント
 	setupFavoriteRoutes(api, deps)
 
+	// フォロー・フィード関連エンドポイント
+	setupFollowRoutes(api, deps)
+
+	// 訪問記録関連エンドポイント
+	setupVisitRoutes(api, deps)
+
 	// 通報関連エンドポイント
 	setupReportRoutes(api, deps)
 
@@ -129,34 +176,79 @@ func setupAuthRoutes(api *echo.Group, deps *Dependencies) {
 // setupStoreRoutes は店舗関連のルーティングを設定します
 func setupStoreRoutes(api *echo.Group, deps *Dependencies) {
 	// 店舗エンドポイント（一部公開、一部認証必要）
-	api.GET(StoresPath, deps.StoreHandler.GetStores)
-	api.GET(StoreByIDPath, deps.StoreHandler.GetStoreByID)
+	// 公開中でない店舗は admin と店舗のオーナーにだけ返すため、公開エンドポイントでも認証情報があれば読み取る
+	api.GET(StoresPath, deps.StoreHandler.GetStores, deps.AuthMiddleware.OptionalAuth(deps.TokenVerifier))
+	api.GET(StoresNearbyPath, deps.StoreHandler.GetNearbyStores, deps.AuthMiddleware.OptionalAuth(deps.TokenVerifier))
+	api.GET(StoreByIDPath, deps.StoreHandler.GetStoreByID, deps.AuthMiddleware.OptionalAuth(deps.TokenVerifier))
 	api.POST(StoresPath, deps.StoreHandler.CreateStore, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
 	api.PUT(StoreByIDPath, deps.StoreHandler.UpdateStore, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
 	api.DELETE(StoreByIDPath, deps.StoreHandler.DeleteStore, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.Admin))
+	api.GET(OwnerStoresPath, deps.StoreHandler.GetOwnedStores, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
 
 	// メニューエンドポイント
-	api.GET(StoreMenusPath, deps.MenuHandler.GetMenusByStoreID)
+	api.GET(StoreMenusPath, deps.MenuHandler.GetMenusByStoreID, deps.AuthMiddleware.OptionalAuth(deps.TokenVerifier))
 	api.POST(StoreMenusPath, deps.MenuHandler.CreateMenu, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
+	api.PUT(StoreMenuOrderPath, deps.MenuHandler.ReorderMenus, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
+	api.PUT(StoreMenuByIDPath, deps.MenuHandler.UpdateMenu, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
+	api.DELETE(StoreMenuByIDPath, deps.MenuHandler.DeleteMenu, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
+	// ギャラリーエンドポイント
+	api.GET(StorePhotosPath, deps.PhotoHandler.GetStorePhotos, deps.AuthMiddleware.OptionalAuth(deps.TokenVerifier))
+	api.POST(StorePhotoUploadsPath, deps.MediaHandler.CreateStorePhotoUploads, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
+	api.PUT(StorePhotoOrderPath, deps.PhotoHandler.ReorderStorePhotos, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
+	api.PUT(StorePhotoByIDPath, deps.PhotoHandler.UpdateStorePhoto, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
+	api.DELETE(StorePhotoByIDPath, deps.PhotoHandler.DeleteStorePhoto, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
+	api.PUT(StoreCoverPath, deps.PhotoHandler.SetStoreCover, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
 	// レビューエンドポイント
 	api.GET(StoreReviewsPath, deps.ReviewHandler.GetReviewsByStoreID)
 	api.POST(StoreReviewsPath, deps.ReviewHandler.Create, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.GET(StoreRatingPath, deps.ReviewHandler.GetRatingSummary, deps.AuthMiddleware.OptionalAuth(deps.TokenVerifier))
+
+	api.PUT(ReviewByIDPath, deps.ReviewHandler.Update, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.DELETE(ReviewByIDPath, deps.ReviewHandler.Delete, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
 
 	// レビューいいねエンドポイント
 	api.POST(ReviewLikesPath, deps.ReviewHandler.LikeReview, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
 	api.DELETE(ReviewLikesPath, deps.ReviewHandler.UnlikeReview, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
 }
 
+// setupClaimRoutes は既存店舗のオーナー申請関連のルーティングを設定します
+func setupClaimRoutes(api *echo.Group, deps *Dependencies) {
+	api.POST(StoreClaimsPath, deps.ClaimHandler.SubmitClaim, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.Owner))
+	api.POST(StoreClaimUploadsPath, deps.MediaHandler.CreateClaimUploads, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.Owner))
+}
+
+// setupApprovalRoutes は店舗の審査申請関連のルーティングを設定します
+func setupApprovalRoutes(api *echo.Group, deps *Dependencies) {
+	api.POST(StoreSubmitPath, deps.ApprovalHandler.SubmitStore, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
+	api.GET(StoreReviewHistoryPath, deps.ApprovalHandler.GetReviewHistory, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.OwnerOrAdmin...))
+}
+
+// setupTagRoutes は店舗タグ関連のルーティングを設定します
+func setupTagRoutes(api *echo.Group, deps *Dependencies) {
+	api.GET(TagsPath, deps.TagHandler.ListTags)
+	api.GET(TagStoresPath, deps.TagHandler.ListStoresByTag)
+}
+
+// setupSearchRoutes は店舗・レビュー検索のルーティングを設定します
+func setupSearchRoutes(api *echo.Group, deps *Dependencies) {
+	api.GET(SearchPath, deps.SearchHandler.Search)
+}
+
 // setupStationRoutes は駅関連のルーティングを設定します
 func setupStationRoutes(api *echo.Group, deps *Dependencies) {
 	api.GET(StationsPath, deps.StationHandler.ListStations)
+	api.GET(StationsNearestPath, deps.StationHandler.GetNearestStations)
+	api.GET(StationGroupsPath, deps.StationHandler.ListStationGroups)
 }
 
 // setupUserRoutes はユーザー関連のルーティングを設定します
 func setupUserRoutes(api *echo.Group, deps *Dependencies) {
 	api.GET(UsersMePath, deps.UserHandler.GetMe, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.DELETE(UsersMePath, deps.AccountHandler.DeleteMe, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.GET(UserMeExportPath, deps.AccountHandler.ExportMe, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.GET(UserByIDPath, deps.UserHandler.GetPublicProfile)
 	api.PUT(UserByIDPath, deps.UserHandler.UpdateUser, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
-	api.GET(UserReviewsPath, deps.UserHandler.GetUserReviews)
+	api.GET(UserReviewsPath, deps.UserHandler.GetUserReviews, deps.AuthMiddleware.OptionalAuth(deps.TokenVerifier))
 }
 
 // setupFavoriteRoutes はお気に入り関連のルーティングを設定します
@@ -170,6 +262,23 @@ func setupFavoriteRoutes(api *echo.Group, deps *Dependencies) {
 	api.DELETE(UserFavoriteByPath, deps.FavoriteHandler.RemoveFavorite, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
 }
 
+// setupFollowRoutes はフォロー・フィード関連のルーティングを設定します
+func setupFollowRoutes(api *echo.Group, deps *Dependencies) {
+	api.POST(UserFollowPath, deps.FollowHandler.Follow, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.DELETE(UserFollowPath, deps.FollowHandler.Unfollow, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.GET(UserFollowersPath, deps.FollowHandler.ListFollowers)
+	api.GET(UserFollowingPath, deps.FollowHandler.ListFollowing)
+	api.GET(FeedPath, deps.FollowHandler.GetFeed, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+}
+
+// setupVisitRoutes は訪問記録関連のルーティングを設定します
+func setupVisitRoutes(api *echo.Group, deps *Dependencies) {
+	api.POST(StoreVisitsPath, deps.VisitHandler.MarkVisited, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.GET(UserVisitsPath, deps.VisitHandler.ListMyVisits, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.GET(UserVisitCountsPath, deps.VisitHandler.GetMyVisitCounts, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.DELETE(UserVisitByIDPath, deps.VisitHandler.DeleteVisit, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+}
+
 // setupReportRoutes は通報関連のルーティングを設定します
 func setupReportRoutes(api *echo.Group, deps *Dependencies) {
 	api.POST(ReportsPath, deps.ReportHandler.CreateReport, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
@@ -178,15 +287,32 @@ func setupReportRoutes(api *echo.Group, deps *Dependencies) {
 // setupMediaRoutes はメディア関連のルーティングを設定します
 func setupMediaRoutes(api *echo.Group, deps *Dependencies) {
 	api.POST(MediaUploadPath, deps.MediaHandler.CreateReviewUploads, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
+	api.POST(MediaCompletePath, deps.MediaHandler.CompleteUpload, deps.AuthMiddleware.JWTAuth(deps.TokenVerifier))
 }
 
 // setupAdminRoutes は管理者用のルーティングを設定します
 func setupAdminRoutes(api *echo.Group, deps *Dependencies) {
 	admin := api.Group("/admin", deps.AuthMiddleware.JWTAuth(deps.TokenVerifier), deps.AuthMiddleware.RequireRole(role.Admin))
 	admin.GET(AdminStoresPendingPath, deps.AdminHandler.GetPendingStores)
-	admin.POST(AdminStoreApprovePath, deps.AdminHandler.ApproveStore)
-	admin.POST(AdminStoreRejectPath, deps.AdminHandler.RejectStore)
+	admin.POST(AdminStoreApprovePath, deps.ApprovalHandler.ApproveStore)
+	admin.POST(AdminStoreRejectPath, deps.ApprovalHandler.RejectStore)
+	admin.PUT(AdminStoreVisibilityPath, deps.AdminHandler.SetStoreVisibility)
+	admin.PUT(AdminReviewVisibilityPath, deps.AdminHandler.SetReviewVisibility)
 	admin.GET(AdminReportsPath, deps.AdminHandler.GetReports)
 	admin.POST(AdminReportActionPath, deps.AdminHandler.HandleReport)
+	admin.GET(AdminUsersPath, deps.AdminUserHandler.ListUsers)
 	admin.GET(AdminUserByIDPath, deps.AdminHandler.GetUserByID)
+	admin.POST(AdminUserSuspendPath, deps.AdminUserHandler.SuspendUser)
+	admin.POST(AdminUserUnsuspendPath, deps.AdminUserHandler.UnsuspendUser)
+	admin.PUT(AdminUserRolePath, deps.AdminUserHandler.ChangeUserRole)
+	admin.GET(AdminUserModerationHistoryPath, deps.AdminUserHandler.GetModerationHistory)
+	admin.GET(AdminClaimsPath, deps.ClaimHandler.ListClaims)
+	admin.GET(AdminClaimByIDPath, deps.ClaimHandler.GetClaim)
+	admin.POST(AdminClaimApprovePath, deps.ClaimHandler.ApproveClaim)
+	admin.POST(AdminClaimDenyPath, deps.ClaimHandler.DenyClaim)
+	admin.GET(AdminStoreEditsPath, deps.EditHandler.ListEdits)
+	admin.GET(AdminStoreEditByIDPath, deps.EditHandler.GetEdit)
+	admin.POST(AdminStoreEditApprovePath, deps.EditHandler.ApproveEdit)
+	admin.POST(AdminStoreEditRejectPath, deps.EditHandler.RejectEdit)
+	admin.GET(AdminAuditLogsPath, deps.AuditLogHandler.ListAuditLogs)
 }
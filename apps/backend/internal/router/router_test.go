@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -31,6 +32,10 @@ func (m *mockUserUseCase) FindByID(ctx context.Context, userID string) (entity.U
 	return entity.User{}, nil
 }
 
+func (m *mockUserUseCase) GetPublicProfile(ctx context.Context, userID string) (*input.PublicUserProfile, error) {
+	return &input.PublicUserProfile{}, nil
+}
+
 func (m *mockUserUseCase) EnsureUser(ctx context.Context, in input.EnsureUserInput) (entity.User, error) {
 	return entity.User{}, nil
 }
@@ -43,48 +48,72 @@ func (m *mockUserUseCase) UpdateUserRole(ctx context.Context, userID string, rol
 	return nil
 }
 
-func (m *mockUserUseCase) GetUserReviews(ctx context.Context, userID string) ([]entity.Review, error) {
+func (m *mockUserUseCase) GetUserReviews(ctx context.Context, viewer entity.User, userID string) ([]entity.Review, error) {
 	return nil, nil
 }
 
 // mockStoreUseCase implements input.StoreUseCase for testing
 type mockStoreUseCase struct{}
 
-func (m *mockStoreUseCase) GetAllStores(ctx context.Context) ([]entity.Store, error) {
+func (m *mockStoreUseCase) GetAllStores(ctx context.Context, viewer entity.User) ([]entity.Store, error) {
 	return nil, nil
 }
 
-func (m *mockStoreUseCase) GetStoreByID(ctx context.Context, id string) (*entity.Store, error) {
+func (m *mockStoreUseCase) ListStores(ctx context.Context, viewer entity.User, query input.ListStoresQuery) (*input.StorePage, error) {
+	return &input.StorePage{}, nil
+}
+
+func (m *mockStoreUseCase) FindNearbyStores(ctx context.Context, viewer entity.User, query input.NearbyStoresQuery) ([]entity.Store, error) {
+	return []entity.Store{}, nil
+}
+
+func (m *mockStoreUseCase) GetStoreByID(ctx context.Context, viewer entity.User, id string) (*entity.Store, error) {
 	return nil, nil
 }
 
-func (m *mockStoreUseCase) CreateStore(ctx context.Context, in input.CreateStoreInput) (*entity.Store, error) {
+func (m *mockStoreUseCase) ListOwnedStores(ctx context.Context, userID string) ([]entity.Store, error) {
+	return []entity.Store{}, nil
+}
+
+func (m *mockStoreUseCase) CreateStore(ctx context.Context, actor entity.User, in input.CreateStoreInput) (*entity.Store, error) {
 	return nil, nil
 }
 
-func (m *mockStoreUseCase) UpdateStore(ctx context.Context, id string, in input.UpdateStoreInput) (*entity.Store, error) {
+func (m *mockStoreUseCase) UpdateStore(ctx context.Context, actor entity.User, id string, in input.UpdateStoreInput) (*entity.Store, error) {
 	return nil, nil
 }
 
-func (m *mockStoreUseCase) DeleteStore(ctx context.Context, id string) error {
+func (m *mockStoreUseCase) DeleteStore(ctx context.Context, actor entity.User, id string) error {
 	return nil
 }
 
 // mockMenuUseCase implements input.MenuUseCase for testing
 type mockMenuUseCase struct{}
 
-func (m *mockMenuUseCase) GetMenusByStoreID(ctx context.Context, storeID string) ([]entity.Menu, error) {
+func (m *mockMenuUseCase) GetMenusByStoreID(ctx context.Context, viewer entity.User, storeID string) ([]entity.Menu, error) {
+	return nil, nil
+}
+
+func (m *mockMenuUseCase) CreateMenu(ctx context.Context, actor entity.User, storeID string, in input.CreateMenuInput) (*entity.Menu, error) {
 	return nil, nil
 }
 
-func (m *mockMenuUseCase) CreateMenu(ctx context.Context, storeID string, in input.CreateMenuInput) (*entity.Menu, error) {
+func (m *mockMenuUseCase) UpdateMenu(ctx context.Context, actor entity.User, storeID string, menuID string, in input.UpdateMenuInput) (*entity.Menu, error) {
+	return nil, nil
+}
+
+func (m *mockMenuUseCase) DeleteMenu(ctx context.Context, actor entity.User, storeID string, menuID string) error {
+	return nil
+}
+
+func (m *mockMenuUseCase) ReorderMenus(ctx context.Context, actor entity.User, storeID string, menuIDs []string) ([]entity.Menu, error) {
 	return nil, nil
 }
 
 // mockReviewUseCase implements input.ReviewUseCase for testing
 type mockReviewUseCase struct{}
 
-func (m *mockReviewUseCase) GetReviewsByStoreID(ctx context.Context, storeID string, sort string, viewerID string) ([]entity.Review, error) {
+func (m *mockReviewUseCase) GetReviewsByStoreID(ctx context.Context, storeID string, sort string, viewer entity.User) ([]entity.Review, error) {
 	return nil, nil
 }
 
@@ -92,6 +121,18 @@ func (m *mockReviewUseCase) Create(ctx context.Context, storeID string, userID s
 	return nil
 }
 
+func (m *mockReviewUseCase) Update(ctx context.Context, actor entity.User, reviewID string, in input.UpdateReview) error {
+	return nil
+}
+
+func (m *mockReviewUseCase) Delete(ctx context.Context, actor entity.User, reviewID string) error {
+	return nil
+}
+
+func (m *mockReviewUseCase) GetRatingSummary(ctx context.Context, storeID string, viewer entity.User) (*entity.RatingSummary, error) {
+	return &entity.RatingSummary{StoreID: storeID}, nil
+}
+
 func (m *mockReviewUseCase) LikeReview(ctx context.Context, reviewID string, userID string) error {
 	return nil
 }
@@ -122,12 +163,12 @@ func (m *mockReportUseCase) CreateReport(ctx context.Context, in input.CreateRep
 	return nil, nil
 }
 
-func (m *mockReportUseCase) GetAllReports(ctx context.Context) ([]entity.Report, error) {
-	return nil, nil
+func (m *mockReportUseCase) ListReports(ctx context.Context, query input.ListReportsQuery) (*input.ReportPage, error) {
+	return &input.ReportPage{}, nil
 }
 
-func (m *mockReportUseCase) HandleReport(ctx context.Context, reportID int64, action input.HandleReportAction) error {
-	return nil
+func (m *mockReportUseCase) HandleReport(ctx context.Context, admin entity.User, reportID int64, in input.HandleReportInput) (*entity.Report, error) {
+	return nil, nil
 }
 
 // mockAuthUseCase implements input.AuthUseCase for testing
@@ -155,21 +196,47 @@ func (m *mockAdminUseCase) GetPendingStores(ctx context.Context) ([]entity.Store
 	return nil, nil
 }
 
-func (m *mockAdminUseCase) ApproveStore(ctx context.Context, storeID string) error {
-	return nil
+func (m *mockAdminUseCase) SetStoreVisibility(ctx context.Context, admin entity.User, storeID string, visibility string) (*entity.Store, error) {
+	return nil, nil
 }
 
-func (m *mockAdminUseCase) RejectStore(ctx context.Context, storeID string) error {
-	return nil
+func (m *mockAdminUseCase) SetReviewVisibility(ctx context.Context, admin entity.User, reviewID string, visibility string) (*entity.Review, error) {
+	return nil, nil
 }
 
 // mockStationUseCase implements input.StationUseCase for testing
 type mockStationUseCase struct{}
 
-func (m *mockStationUseCase) ListStations(ctx context.Context) ([]entity.Station, error) {
+func (m *mockStationUseCase) ListStations(ctx context.Context, query input.StationQuery) ([]entity.Station, error) {
 	return nil, nil
 }
 
+func (m *mockStationUseCase) GroupStationsByKind(ctx context.Context, query input.StationQuery) ([]entity.StationGroup, error) {
+	return []entity.StationGroup{}, nil
+}
+
+func (m *mockStationUseCase) FindNearestStations(ctx context.Context, lat, lng float64, limit int) ([]entity.Station, error) {
+	return []entity.Station{}, nil
+}
+
+// mockTagUseCase implements input.TagUseCase for testing
+type mockTagUseCase struct{}
+
+func (m *mockTagUseCase) ListTags(ctx context.Context) ([]entity.TagCount, error) {
+	return []entity.TagCount{}, nil
+}
+
+func (m *mockTagUseCase) ListStoresByTag(ctx context.Context, tag string, query input.ListStoresQuery) (*input.StorePage, error) {
+	return &input.StorePage{}, nil
+}
+
+// mockSearchUseCase implements input.SearchUseCase for testing
+type mockSearchUseCase struct{}
+
+func (m *mockSearchUseCase) Search(ctx context.Context, query input.SearchQuery) (*input.SearchPage, error) {
+	return &input.SearchPage{}, nil
+}
+
 // mockMediaUseCase implements input.MediaUseCase for testing
 type mockMediaUseCase struct{}
 
@@ -177,6 +244,185 @@ func (m *mockMediaUseCase) CreateReviewUploads(ctx context.Context, storeID stri
 	return nil, nil
 }
 
+func (m *mockMediaUseCase) CreateClaimUploads(ctx context.Context, storeID string, userID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error) {
+	return nil, nil
+}
+
+func (m *mockMediaUseCase) CreateStorePhotoUploads(ctx context.Context, actor entity.User, storeID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error) {
+	return nil, nil
+}
+
+func (m *mockMediaUseCase) CompleteUpload(ctx context.Context, actor entity.User, fileID string) (*entity.File, error) {
+	return nil, nil
+}
+
+// mockStorePhotoUseCase implements input.StorePhotoUseCase for testing
+type mockStorePhotoUseCase struct{}
+
+func (m *mockStorePhotoUseCase) ListStorePhotos(ctx context.Context, viewer entity.User, storeID string) ([]entity.StorePhoto, error) {
+	return nil, nil
+}
+
+func (m *mockStorePhotoUseCase) UpdateStorePhoto(ctx context.Context, actor entity.User, storeID string, fileID string, in input.UpdateStorePhotoInput) (*entity.StorePhoto, error) {
+	return &entity.StorePhoto{}, nil
+}
+
+func (m *mockStorePhotoUseCase) ReorderStorePhotos(ctx context.Context, actor entity.User, storeID string, fileIDs []string) ([]entity.StorePhoto, error) {
+	return nil, nil
+}
+
+func (m *mockStorePhotoUseCase) SetStoreCover(ctx context.Context, actor entity.User, storeID string, fileID string) (*entity.Store, error) {
+	return &entity.Store{}, nil
+}
+
+func (m *mockStorePhotoUseCase) DeleteStorePhoto(ctx context.Context, actor entity.User, storeID string, fileID string) error {
+	return nil
+}
+
+// mockStoreClaimUseCase implements input.StoreClaimUseCase for testing
+type mockStoreClaimUseCase struct{}
+
+func (m *mockStoreClaimUseCase) SubmitClaim(ctx context.Context, actor entity.User, storeID string, in input.SubmitStoreClaimInput) (*entity.StoreClaim, error) {
+	return &entity.StoreClaim{}, nil
+}
+
+func (m *mockStoreClaimUseCase) ListClaims(ctx context.Context, status string) ([]entity.StoreClaim, error) {
+	return nil, nil
+}
+
+func (m *mockStoreClaimUseCase) GetClaim(ctx context.Context, claimID string) (*entity.StoreClaim, error) {
+	return &entity.StoreClaim{}, nil
+}
+
+func (m *mockStoreClaimUseCase) ApproveClaim(ctx context.Context, reviewer entity.User, claimID string, in input.ReviewStoreClaimInput) (*entity.StoreClaim, error) {
+	return &entity.StoreClaim{}, nil
+}
+
+func (m *mockStoreClaimUseCase) DenyClaim(ctx context.Context, reviewer entity.User, claimID string, in input.ReviewStoreClaimInput) (*entity.StoreClaim, error) {
+	return &entity.StoreClaim{}, nil
+}
+
+// mockStoreApprovalUseCase implements input.StoreApprovalUseCase for testing
+type mockStoreApprovalUseCase struct{}
+
+func (m *mockStoreApprovalUseCase) SubmitStore(ctx context.Context, actor entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	return &entity.Store{}, nil
+}
+
+func (m *mockStoreApprovalUseCase) GetApprovalHistory(ctx context.Context, actor entity.User, storeID string) ([]entity.StoreApprovalEvent, error) {
+	return nil, nil
+}
+
+func (m *mockStoreApprovalUseCase) ApproveStore(ctx context.Context, admin entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	return &entity.Store{}, nil
+}
+
+func (m *mockStoreApprovalUseCase) RejectStore(ctx context.Context, admin entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	return &entity.Store{}, nil
+}
+
+// mockStoreEditUseCase implements input.StoreEditUseCase for testing
+type mockStoreEditUseCase struct{}
+
+func (m *mockStoreEditUseCase) ListStoreEdits(ctx context.Context, status string) ([]entity.StoreEdit, error) {
+	return nil, nil
+}
+
+func (m *mockStoreEditUseCase) GetStoreEdit(ctx context.Context, editID string) (*entity.StoreEdit, error) {
+	return &entity.StoreEdit{}, nil
+}
+
+func (m *mockStoreEditUseCase) ApproveStoreEdit(ctx context.Context, reviewer entity.User, editID string, in input.ReviewStoreEditInput) (*entity.StoreEdit, error) {
+	return &entity.StoreEdit{}, nil
+}
+
+func (m *mockStoreEditUseCase) RejectStoreEdit(ctx context.Context, reviewer entity.User, editID string, in input.ReviewStoreEditInput) (*entity.StoreEdit, error) {
+	return &entity.StoreEdit{}, nil
+}
+
+// mockAdminUserUseCase implements input.AdminUserUseCase for testing
+type mockAdminUserUseCase struct{}
+
+func (m *mockAdminUserUseCase) ListUsers(ctx context.Context, query input.ListUsersQuery) (*input.UserPage, error) {
+	return &input.UserPage{}, nil
+}
+
+func (m *mockAdminUserUseCase) SuspendUser(ctx context.Context, admin entity.User, userID string, in input.SuspendUserInput) (*entity.User, error) {
+	return &entity.User{}, nil
+}
+
+func (m *mockAdminUserUseCase) UnsuspendUser(ctx context.Context, admin entity.User, userID string, in input.UnsuspendUserInput) (*entity.User, error) {
+	return &entity.User{}, nil
+}
+
+func (m *mockAdminUserUseCase) ChangeUserRole(ctx context.Context, admin entity.User, userID string, in input.ChangeUserRoleInput) (*entity.User, error) {
+	return &entity.User{}, nil
+}
+
+func (m *mockAdminUserUseCase) GetModerationHistory(ctx context.Context, userID string) ([]entity.UserModerationEvent, error) {
+	return nil, nil
+}
+
+// mockAuditLogUseCase implements input.AuditLogUseCase for testing
+type mockAuditLogUseCase struct{}
+
+func (m *mockAuditLogUseCase) ListAuditLogs(ctx context.Context, query input.ListAuditLogsQuery) (*input.AuditLogPage, error) {
+	return &input.AuditLogPage{}, nil
+}
+
+// mockAccountUseCase implements input.AccountUseCase for testing
+type mockAccountUseCase struct{}
+
+func (m *mockAccountUseCase) DeleteAccount(ctx context.Context, user entity.User) error {
+	return nil
+}
+
+func (m *mockAccountUseCase) ExportAccount(ctx context.Context, user entity.User) (*input.AccountExport, error) {
+	return &input.AccountExport{}, nil
+}
+
+// mockFollowUseCase implements input.FollowUseCase for testing
+type mockFollowUseCase struct{}
+
+func (m *mockFollowUseCase) Follow(ctx context.Context, follower entity.User, followeeID string) error {
+	return nil
+}
+
+func (m *mockFollowUseCase) Unfollow(ctx context.Context, follower entity.User, followeeID string) error {
+	return nil
+}
+
+func (m *mockFollowUseCase) ListFollowers(ctx context.Context, userID string, query input.ListFollowsQuery) (*input.FollowPage, error) {
+	return &input.FollowPage{}, nil
+}
+
+func (m *mockFollowUseCase) ListFollowing(ctx context.Context, userID string, query input.ListFollowsQuery) (*input.FollowPage, error) {
+	return &input.FollowPage{}, nil
+}
+
+func (m *mockFollowUseCase) GetFeed(ctx context.Context, user entity.User, query input.FeedQuery) (*input.ReviewPage, error) {
+	return &input.ReviewPage{}, nil
+}
+
+// mockVisitUseCase implements input.VisitUseCase for testing
+type mockVisitUseCase struct{}
+
+func (m *mockVisitUseCase) MarkVisited(ctx context.Context, user entity.User, storeID string, in input.MarkVisitInput) (*entity.Visit, error) {
+	return &entity.Visit{}, nil
+}
+
+func (m *mockVisitUseCase) DeleteVisit(ctx context.Context, user entity.User, visitID int64) error {
+	return nil
+}
+
+func (m *mockVisitUseCase) ListMyVisits(ctx context.Context, user entity.User, query input.ListVisitsQuery) (*input.VisitPage, error) {
+	return &input.VisitPage{}, nil
+}
+
+func (m *mockVisitUseCase) GetMyVisitCounts(ctx context.Context, user entity.User) (*entity.VisitCounts, error) {
+	return &entity.VisitCounts{}, nil
+}
+
 // mockTokenVerifier implements security.TokenVerifier for testing
 type mockTokenVerifier struct {
 	claims *security.TokenClaims
@@ -201,6 +447,22 @@ func (m *mockStorageProvider) CreateSignedDownload(ctx context.Context, bucket,
 	return nil, nil
 }
 
+func (m *mockStorageProvider) StatObject(ctx context.Context, bucket, objectPath string) (*output.ObjectInfo, error) {
+	return nil, output.ErrObjectNotFound
+}
+
+func (m *mockStorageProvider) DeleteObjects(ctx context.Context, bucket string, objectPaths []string) error {
+	return nil
+}
+
+func (m *mockStorageProvider) GetObject(ctx context.Context, bucket, objectPath string) (io.ReadCloser, error) {
+	return nil, output.ErrObjectNotFound
+}
+
+func (m *mockStorageProvider) PutObject(ctx context.Context, bucket, objectPath, contentType string, content []byte) error {
+	return nil
+}
+
 // Helper function to create test dependencies
 func createTestDependencies() *Dependencies {
 	userUC := &mockUserUseCase{}
@@ -213,25 +475,47 @@ func createTestDependencies() *Dependencies {
 	ownerUC := &mockOwnerUseCase{}
 	adminUC := &mockAdminUseCase{}
 	stationUC := &mockStationUseCase{}
+	tagUC := &mockTagUseCase{}
+	searchUC := &mockSearchUseCase{}
 	mediaUC := &mockMediaUseCase{}
+	photoUC := &mockStorePhotoUseCase{}
+	claimUC := &mockStoreClaimUseCase{}
+	approvalUC := &mockStoreApprovalUseCase{}
+	editUC := &mockStoreEditUseCase{}
+	adminUserUC := &mockAdminUserUseCase{}
+	auditLogUC := &mockAuditLogUseCase{}
+	accountUC := &mockAccountUseCase{}
+	followUC := &mockFollowUseCase{}
+	visitUC := &mockVisitUseCase{}
 	tokenVerifier := &mockTokenVerifier{}
 	storage := &mockStorageProvider{}
 	bucket := "test-bucket"
 
 	return &Dependencies{
-		UserUC:          userUC,
-		StoreHandler:    handlers.NewStoreHandler(storeUC, storage, bucket),
-		MenuHandler:     handlers.NewMenuHandler(menuUC),
-		StationHandler:  handlers.NewStationHandler(stationUC),
-		ReviewHandler:   handlers.NewReviewHandler(reviewUC, tokenVerifier, storage, bucket),
-		UserHandler:     handlers.NewUserHandler(userUC, storage, bucket),
-		FavoriteHandler: handlers.NewFavoriteHandler(favoriteUC),
-		ReportHandler:   handlers.NewReportHandler(reportUC),
-		AuthHandler:     handlers.NewAuthHandler(authUC, userUC),
-		OwnerHandler:    handlers.NewOwnerHandler(ownerUC),
-		AdminHandler:    handlers.NewAdminHandler(adminUC, reportUC, userUC),
-		MediaHandler:    handlers.NewMediaHandler(mediaUC),
-		TokenVerifier:   tokenVerifier,
+		UserUC:           userUC,
+		StoreHandler:     handlers.NewStoreHandler(storeUC, storage, bucket),
+		MenuHandler:      handlers.NewMenuHandler(menuUC, storage, bucket),
+		PhotoHandler:     handlers.NewStorePhotoHandler(photoUC, storage, bucket),
+		StationHandler:   handlers.NewStationHandler(stationUC),
+		TagHandler:       handlers.NewTagHandler(tagUC, storage, bucket),
+		SearchHandler:    handlers.NewSearchHandler(searchUC, storage, bucket),
+		ReviewHandler:    handlers.NewReviewHandler(reviewUC, tokenVerifier, storage, bucket),
+		UserHandler:      handlers.NewUserHandler(userUC, storage, bucket),
+		FavoriteHandler:  handlers.NewFavoriteHandler(favoriteUC),
+		ReportHandler:    handlers.NewReportHandler(reportUC),
+		AuthHandler:      handlers.NewAuthHandler(authUC, userUC),
+		OwnerHandler:     handlers.NewOwnerHandler(ownerUC),
+		AdminHandler:     handlers.NewAdminHandler(adminUC, reportUC, userUC),
+		MediaHandler:     handlers.NewMediaHandler(mediaUC),
+		ClaimHandler:     handlers.NewStoreClaimHandler(claimUC, storage, bucket),
+		ApprovalHandler:  handlers.NewStoreApprovalHandler(approvalUC, storage, bucket),
+		EditHandler:      handlers.NewStoreEditHandler(editUC),
+		AdminUserHandler: handlers.NewAdminUserHandler(adminUserUC),
+		AuditLogHandler:  handlers.NewAuditLogHandler(auditLogUC),
+		AccountHandler:   handlers.NewAccountHandler(accountUC, storage, bucket),
+		FollowHandler:    handlers.NewFollowHandler(followUC, storage, bucket),
+		VisitHandler:     handlers.NewVisitHandler(visitUC, storage, bucket),
+		TokenVerifier:    tokenVerifier,
 	}
 }
 
@@ -267,6 +551,26 @@ func TestHealthEndpoint(t *testing.T) {
 	}
 }
 
+// TestRequestID verifies that every response carries a request ID and a client supplied one is kept
+func TestRequestID(t *testing.T) {
+	server := NewServer(createTestDependencies())
+
+	req := httptest.NewRequest(http.MethodGet, HealthPath, nil)
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	if rec.Header().Get(echo.HeaderXRequestID) == "" {
+		t.Errorf("expected %s header to be set", echo.HeaderXRequestID)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, HealthPath, nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-123")
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	if got := rec.Header().Get(echo.HeaderXRequestID); got != "req-123" {
+		t.Errorf("expected request ID req-123, got %q", got)
+	}
+}
+
 // TestRoutes tests that all expected routes are registered
 func TestRoutes(t *testing.T) {
 	deps := createTestDependencies()
@@ -290,26 +594,58 @@ func TestRoutes(t *testing.T) {
 
 		// Store routes
 		{http.MethodGet, "/api" + StoresPath},
+		{http.MethodGet, "/api" + StoresNearbyPath},
 		{http.MethodGet, "/api" + StoreByIDPath},
 		{http.MethodPost, "/api" + StoresPath},
 		{http.MethodPut, "/api" + StoreByIDPath},
 		{http.MethodDelete, "/api" + StoreByIDPath},
+		{http.MethodGet, "/api" + OwnerStoresPath},
 
 		// Menu routes
 		{http.MethodGet, "/api" + StoreMenusPath},
 		{http.MethodPost, "/api" + StoreMenusPath},
+		{http.MethodPut, "/api" + StoreMenuOrderPath},
+		{http.MethodPut, "/api" + StoreMenuByIDPath},
+		{http.MethodDelete, "/api" + StoreMenuByIDPath},
+		{http.MethodGet, "/api" + StorePhotosPath},
+		{http.MethodPost, "/api" + StorePhotoUploadsPath},
+		{http.MethodPut, "/api" + StorePhotoOrderPath},
+		{http.MethodPut, "/api" + StorePhotoByIDPath},
+		{http.MethodDelete, "/api" + StorePhotoByIDPath},
+		{http.MethodPut, "/api" + StoreCoverPath},
+
+		// Store claim routes
+		{http.MethodPost, "/api" + StoreClaimsPath},
+		{http.MethodPost, "/api" + StoreClaimUploadsPath},
+		{http.MethodPost, "/api" + StoreSubmitPath},
+		{http.MethodGet, "/api" + StoreReviewHistoryPath},
+
+		// Search routes
+		{http.MethodGet, "/api" + SearchPath},
+
+		// Tag routes
+		{http.MethodGet, "/api" + TagsPath},
+		{http.MethodGet, "/api" + TagStoresPath},
 
 		// Station routes
 		{http.MethodGet, "/api" + StationsPath},
+		{http.MethodGet, "/api" + StationsNearestPath},
+		{http.MethodGet, "/api" + StationGroupsPath},
 
 		// Review routes
 		{http.MethodGet, "/api" + StoreReviewsPath},
 		{http.MethodPost, "/api" + StoreReviewsPath},
+		{http.MethodGet, "/api" + StoreRatingPath},
+		{http.MethodPut, "/api" + ReviewByIDPath},
+		{http.MethodDelete, "/api" + ReviewByIDPath},
 		{http.MethodPost, "/api" + ReviewLikesPath},
 		{http.MethodDelete, "/api" + ReviewLikesPath},
 
 		// User routes
 		{http.MethodGet, "/api" + UsersMePath},
+		{http.MethodDelete, "/api" + UsersMePath},
+		{http.MethodGet, "/api" + UserMeExportPath},
+		{http.MethodGet, "/api" + UserByIDPath},
 		{http.MethodPut, "/api" + UserByIDPath},
 		{http.MethodGet, "/api" + UserReviewsPath},
 
@@ -318,19 +654,49 @@ func TestRoutes(t *testing.T) {
 		{http.MethodPost, "/api" + UserFavoritesPath},
 		{http.MethodDelete, "/api" + UserFavoriteByPath},
 
+		// Follow routes
+		{http.MethodPost, "/api" + UserFollowPath},
+		{http.MethodDelete, "/api" + UserFollowPath},
+		{http.MethodGet, "/api" + UserFollowersPath},
+		{http.MethodGet, "/api" + UserFollowingPath},
+		{http.MethodGet, "/api" + FeedPath},
+
+		// Visit routes
+		{http.MethodPost, "/api" + StoreVisitsPath},
+		{http.MethodGet, "/api" + UserVisitsPath},
+		{http.MethodGet, "/api" + UserVisitCountsPath},
+		{http.MethodDelete, "/api" + UserVisitByIDPath},
+
 		// Report routes
 		{http.MethodPost, "/api" + ReportsPath},
 
 		// Media routes
 		{http.MethodPost, "/api" + MediaUploadPath},
+		{http.MethodPost, "/api" + MediaCompletePath},
 
 		// Admin routes
 		{http.MethodGet, "/api/admin" + AdminStoresPendingPath},
 		{http.MethodPost, "/api/admin" + AdminStoreApprovePath},
 		{http.MethodPost, "/api/admin" + AdminStoreRejectPath},
+		{http.MethodPut, "/api/admin" + AdminStoreVisibilityPath},
+		{http.MethodPut, "/api/admin" + AdminReviewVisibilityPath},
 		{http.MethodGet, "/api/admin" + AdminReportsPath},
 		{http.MethodPost, "/api/admin" + AdminReportActionPath},
+		{http.MethodGet, "/api/admin" + AdminUsersPath},
 		{http.MethodGet, "/api/admin" + AdminUserByIDPath},
+		{http.MethodPost, "/api/admin" + AdminUserSuspendPath},
+		{http.MethodPost, "/api/admin" + AdminUserUnsuspendPath},
+		{http.MethodPut, "/api/admin" + AdminUserRolePath},
+		{http.MethodGet, "/api/admin" + AdminUserModerationHistoryPath},
+		{http.MethodGet, "/api/admin" + AdminClaimsPath},
+		{http.MethodGet, "/api/admin" + AdminClaimByIDPath},
+		{http.MethodPost, "/api/admin" + AdminClaimApprovePath},
+		{http.MethodPost, "/api/admin" + AdminClaimDenyPath},
+		{http.MethodGet, "/api/admin" + AdminStoreEditsPath},
+		{http.MethodGet, "/api/admin" + AdminStoreEditByIDPath},
+		{http.MethodPost, "/api/admin" + AdminStoreEditApprovePath},
+		{http.MethodPost, "/api/admin" + AdminStoreEditRejectPath},
+		{http.MethodGet, "/api/admin" + AdminAuditLogsPath},
 	}
 
 	for _, expected := range expectedRoutes {
@@ -357,19 +723,26 @@ func TestRouteCount(t *testing.T) {
 	// Count expected routes:
 	// Health: 1
 	// Auth: 5
-	// Store: 5
-	// Menu: 2
-	// Station: 1
-	// Review: 4
-	// User: 3
+	// Store: 6
+	// Owner: 1
+	// Menu: 5
+	// Gallery: 6
+	// Claim: 2
+	// Approval: 2
+	// Search: 1
+	// Tag: 2
+	// Station: 3
+	// Review: 7
+	// User: 6
 	// Favorite: 3
+	// Follow: 5
+	// Visit: 4
 	// Report: 1
-	// Media: 1
-	// Admin: 6
-	// Station: 1
+	// Media: 2
+	// Admin: 22
 	// Echo internal routes for admin group (echo_route_not_found): 2
-	// Total: 34
-	expectedCount := 34
+	// Total: 86
+	expectedCount := 86
 
 	if len(routes) != expectedCount {
 		t.Errorf("expected %d routes, got %d", expectedCount, len(routes))
@@ -424,14 +797,21 @@ func TestStoreRoutes(t *testing.T) {
 		path   string
 	}{
 		{http.MethodGet, "/api/stores"},
+		{http.MethodGet, "/api/stores/nearby"},
 		{http.MethodGet, "/api/stores/:id"},
 		{http.MethodPost, "/api/stores"},
 		{http.MethodPut, "/api/stores/:id"},
 		{http.MethodDelete, "/api/stores/:id"},
+		{http.MethodGet, "/api/owner/stores"},
 		{http.MethodGet, "/api/stores/:id/menus"},
 		{http.MethodPost, "/api/stores/:id/menus"},
 		{http.MethodGet, "/api/stores/:id/reviews"},
 		{http.MethodPost, "/api/stores/:id/reviews"},
+		{http.MethodGet, "/api/stores/:id/rating-summary"},
+		{http.MethodPost, "/api/stores/:id/claims"},
+		{http.MethodPost, "/api/stores/:id/claims/uploads"},
+		{http.MethodPost, "/api/stores/:id/submit"},
+		{http.MethodGet, "/api/stores/:id/review-history"},
 	}
 
 	for _, expected := range storeRoutes {
@@ -460,6 +840,8 @@ func TestStationRoutes(t *testing.T) {
 		path   string
 	}{
 		{http.MethodGet, "/api/stations"},
+		{http.MethodGet, "/api/stations/nearest"},
+		{http.MethodGet, "/api/stations/groups"},
 	}
 
 	for _, expected := range stationRoutes {
@@ -526,6 +908,14 @@ func TestAdminRoutes(t *testing.T) {
 		{http.MethodGet, "/api/admin/reports"},
 		{http.MethodPost, "/api/admin/reports/:id/action"},
 		{http.MethodGet, "/api/admin/users/:id"},
+		{http.MethodGet, "/api/admin/claims"},
+		{http.MethodGet, "/api/admin/claims/:id"},
+		{http.MethodPost, "/api/admin/claims/:id/approve"},
+		{http.MethodPost, "/api/admin/claims/:id/deny"},
+		{http.MethodGet, "/api/admin/store-edits"},
+		{http.MethodGet, "/api/admin/store-edits/:id"},
+		{http.MethodPost, "/api/admin/store-edits/:id/approve"},
+		{http.MethodPost, "/api/admin/store-edits/:id/reject"},
 	}
 
 	for _, expected := range adminRoutes {
@@ -553,6 +943,8 @@ func TestReviewRoutes(t *testing.T) {
 		method string
 		path   string
 	}{
+		{http.MethodPut, "/api/reviews/:id"},
+		{http.MethodDelete, "/api/reviews/:id"},
 		{http.MethodPost, "/api/reviews/:id/likes"},
 		{http.MethodDelete, "/api/reviews/:id/likes"},
 	}
@@ -609,6 +1001,30 @@ func TestMediaRoutes(t *testing.T) {
 	}
 }
 
+func TestStorageRoutes(t *testing.T) {
+	hasStorageRoute := func(server *echo.Echo, method string) bool {
+		for _, route := range server.Routes() {
+			if route.Method == method && route.Path == StorageObjectPath {
+				return true
+			}
+		}
+		return false
+	}
+
+	deps := createTestDependencies()
+	if server := NewServer(deps); hasStorageRoute(server, http.MethodGet) || hasStorageRoute(server, http.MethodPut) {
+		t.Error("storage routes should not be registered without StorageHandler")
+	}
+
+	deps.StorageHandler = handlers.NewStorageHandler(nil)
+	server := NewServer(deps)
+	for _, method := range []string{http.MethodGet, http.MethodPut} {
+		if !hasStorageRoute(server, method) {
+			t.Errorf("Storage route not found: %s %s", method, StorageObjectPath)
+		}
+	}
+}
+
 // TestEndpointConstants tests that endpoint constants are defined correctly
 func TestEndpointConstants(t *testing.T) {
 	testCases := []struct {
@@ -622,25 +1038,71 @@ func TestEndpointConstants(t *testing.T) {
 		{"AuthMePath", AuthMePath, "/me"},
 		{"AuthRolePath", AuthRolePath, "/role"},
 		{"OwnerSignupCompletePath", OwnerSignupCompletePath, "/owner/signup/complete"},
+		{"OwnerStoresPath", OwnerStoresPath, "/owner/stores"},
 		{"StoresPath", StoresPath, "/stores"},
+		{"StoresNearbyPath", StoresNearbyPath, "/stores/nearby"},
 		{"StoreByIDPath", StoreByIDPath, "/stores/:id"},
 		{"StoreMenusPath", StoreMenusPath, "/stores/:id/menus"},
+		{"StoreMenuOrderPath", StoreMenuOrderPath, "/stores/:id/menus/order"},
+		{"StoreMenuByIDPath", StoreMenuByIDPath, "/stores/:id/menus/:menu_id"},
+		{"StorePhotoUploadsPath", StorePhotoUploadsPath, "/stores/:id/media/upload"},
+		{"StorePhotosPath", StorePhotosPath, "/stores/:id/photos"},
+		{"StorePhotoOrderPath", StorePhotoOrderPath, "/stores/:id/photos/order"},
+		{"StorePhotoByIDPath", StorePhotoByIDPath, "/stores/:id/photos/:file_id"},
+		{"StoreCoverPath", StoreCoverPath, "/stores/:id/cover"},
 		{"StoreReviewsPath", StoreReviewsPath, "/stores/:id/reviews"},
+		{"StoreRatingPath", StoreRatingPath, "/stores/:id/rating-summary"},
+		{"StoreClaimsPath", StoreClaimsPath, "/stores/:id/claims"},
+		{"StoreClaimUploadsPath", StoreClaimUploadsPath, "/stores/:id/claims/uploads"},
+		{"StoreSubmitPath", StoreSubmitPath, "/stores/:id/submit"},
+		{"StoreReviewHistoryPath", StoreReviewHistoryPath, "/stores/:id/review-history"},
+		{"SearchPath", SearchPath, "/search"},
+		{"TagsPath", TagsPath, "/tags"},
+		{"TagStoresPath", TagStoresPath, "/tags/:tag/stores"},
 		{"StationsPath", StationsPath, "/stations"},
+		{"StationsNearestPath", StationsNearestPath, "/stations/nearest"},
+		{"StationGroupsPath", StationGroupsPath, "/stations/groups"},
+		{"ReviewByIDPath", ReviewByIDPath, "/reviews/:id"},
 		{"ReviewLikesPath", ReviewLikesPath, "/reviews/:id/likes"},
 		{"UsersMePath", UsersMePath, "/users/me"},
+		{"UserMeExportPath", UserMeExportPath, "/users/me/export"},
 		{"UserByIDPath", UserByIDPath, "/users/:id"},
 		{"UserReviewsPath", UserReviewsPath, "/users/:id/reviews"},
 		{"UserFavoritesPath", UserFavoritesPath, "/users/me/favorites"},
 		{"UserFavoriteByPath", UserFavoriteByPath, "/users/me/favorites/:store_id"},
+		{"UserFollowPath", UserFollowPath, "/users/:id/follow"},
+		{"UserFollowersPath", UserFollowersPath, "/users/:id/followers"},
+		{"UserFollowingPath", UserFollowingPath, "/users/:id/following"},
+		{"FeedPath", FeedPath, "/feed"},
+		{"StoreVisitsPath", StoreVisitsPath, "/stores/:id/visits"},
+		{"UserVisitsPath", UserVisitsPath, "/users/me/visits"},
+		{"UserVisitCountsPath", UserVisitCountsPath, "/users/me/visits/counts"},
+		{"UserVisitByIDPath", UserVisitByIDPath, "/users/me/visits/:visit_id"},
 		{"ReportsPath", ReportsPath, "/reports"},
 		{"MediaUploadPath", MediaUploadPath, "/media/upload"},
+		{"MediaCompletePath", MediaCompletePath, "/media/:file_id/complete"},
 		{"AdminStoresPendingPath", AdminStoresPendingPath, "/stores/pending"},
 		{"AdminStoreApprovePath", AdminStoreApprovePath, "/stores/:id/approve"},
 		{"AdminStoreRejectPath", AdminStoreRejectPath, "/stores/:id/reject"},
+		{"AdminStoreVisibilityPath", AdminStoreVisibilityPath, "/stores/:id/visibility"},
+		{"AdminReviewVisibilityPath", AdminReviewVisibilityPath, "/reviews/:id/visibility"},
 		{"AdminReportsPath", AdminReportsPath, "/reports"},
 		{"AdminReportActionPath", AdminReportActionPath, "/reports/:id/action"},
+		{"AdminUsersPath", AdminUsersPath, "/users"},
 		{"AdminUserByIDPath", AdminUserByIDPath, "/users/:id"},
+		{"AdminUserSuspendPath", AdminUserSuspendPath, "/users/:id/suspend"},
+		{"AdminUserUnsuspendPath", AdminUserUnsuspendPath, "/users/:id/unsuspend"},
+		{"AdminUserRolePath", AdminUserRolePath, "/users/:id/role"},
+		{"AdminUserModerationHistoryPath", AdminUserModerationHistoryPath, "/users/:id/moderation-history"},
+		{"AdminClaimsPath", AdminClaimsPath, "/claims"},
+		{"AdminClaimByIDPath", AdminClaimByIDPath, "/claims/:id"},
+		{"AdminClaimApprovePath", AdminClaimApprovePath, "/claims/:id/approve"},
+		{"AdminClaimDenyPath", AdminClaimDenyPath, "/claims/:id/deny"},
+		{"AdminStoreEditsPath", AdminStoreEditsPath, "/store-edits"},
+		{"AdminStoreEditByIDPath", AdminStoreEditByIDPath, "/store-edits/:id"},
+		{"AdminStoreEditApprovePath", AdminStoreEditApprovePath, "/store-edits/:id/approve"},
+		{"AdminStoreEditRejectPath", AdminStoreEditRejectPath, "/store-edits/:id/reject"},
+		{"AdminAuditLogsPath", AdminAuditLogsPath, "/audit-logs"},
 	}
 
 	for _, tc := range testCases {
@@ -941,9 +1403,9 @@ func TestAdminGroupRouting(t *testing.T) {
 		}
 	}
 
-	// Should have 6 admin routes + 2 internal echo routes (echo_route_not_found)
-	if adminRouteCount != 8 {
-		t.Errorf("expected 8 admin routes (including internal), got %d", adminRouteCount)
+	// Should have 16 admin routes + 2 internal echo routes (echo_route_not_found)
+	if adminRouteCount != 24 {
+		t.Errorf("expected 24 admin routes (including internal), got %d", adminRouteCount)
 	}
 }
 
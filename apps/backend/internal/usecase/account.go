@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type accountUseCase struct {
+	userRepo     output.UserRepository
+	reviewRepo   output.ReviewRepository
+	favoriteRepo output.FavoriteRepository
+	followRepo   output.FollowRepository
+	visitRepo    output.VisitRepository
+	fileRepo     output.FileRepository
+	reportRepo   output.ReportRepository
+	ratingRepo   output.StoreRatingRepository
+	purger       *accountPurgeUseCase
+	transaction  output.Transaction
+	reviewPolicy string
+}
+
+// NewAccountUseCase は AccountUseCase の実装を生成します。
+// reviewPolicy は退会したユーザーのレビューの扱い（constants.ReviewDeletionPolicy*）、
+// bucket はアップロードしたファイルを削除する Storage のバケットです
+func NewAccountUseCase(
+	userRepo output.UserRepository,
+	reviewRepo output.ReviewRepository,
+	favoriteRepo output.FavoriteRepository,
+	followRepo output.FollowRepository,
+	visitRepo output.VisitRepository,
+	fileRepo output.FileRepository,
+	reportRepo output.ReportRepository,
+	ratingRepo output.StoreRatingRepository,
+	authAdmin output.AccountAuthAdmin,
+	storage output.StorageProvider,
+	transaction output.Transaction,
+	bucket string,
+	reviewPolicy string,
+) input.AccountUseCase {
+	return &accountUseCase{
+		userRepo:     userRepo,
+		reviewRepo:   reviewRepo,
+		favoriteRepo: favoriteRepo,
+		followRepo:   followRepo,
+		visitRepo:    visitRepo,
+		fileRepo:     fileRepo,
+		reportRepo:   reportRepo,
+		ratingRepo:   ratingRepo,
+		purger:       newAccountPurger(userRepo, fileRepo, storage, authAdmin, transaction, bucket),
+		transaction:  transaction,
+		reviewPolicy: reviewPolicy,
+	}
+}
+
+// ownDataViewer は本人のデータを公開状態で絞り込まずに取得するための閲覧者です
+func ownDataViewer(userID string) output.Viewer {
+	return output.Viewer{UserID: userID, IsAdmin: true}
+}
+
+// DeleteAccount はユーザーを退会させます。
+// お気に入り・いいね・フォロー・訪問記録を削除してアップロードしたファイルの紐付けを外し、レビューはポリシーに従って匿名化または削除します。
+// ユーザーの行は個人情報を匿名化して残し、コミットしたあとでファイルを Storage から、認証ユーザーを Supabase から削除します
+func (uc *accountUseCase) DeleteAccount(ctx context.Context, user entity.User) error {
+	if user.UserID == "" {
+		return ErrUnauthorized
+	}
+	current, err := mustFindUser(ctx, uc.userRepo, user.UserID)
+	if err != nil {
+		return err
+	}
+	if current.IsDeleted() {
+		return ErrUserNotFound
+	}
+	if uc.transaction == nil {
+		return output.ErrInvalidTransaction
+	}
+
+	var reviews []entity.Review
+	if uc.reviewPolicy == constants.ReviewDeletionPolicyDelete {
+		reviews, err = uc.reviewRepo.FindByUserID(ctx, user.UserID, ownDataViewer(user.UserID))
+		if err != nil {
+			return err
+		}
+	}
+	anonymized := anonymizeUser(current, time.Now())
+
+	err = uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.reviewRepo.DeleteLikesByUserIDInTx(ctx, tx, user.UserID); err != nil {
+			return err
+		}
+		if err := uc.favoriteRepo.DeleteByUserIDInTx(ctx, tx, user.UserID); err != nil {
+			return err
+		}
+		if err := uc.followRepo.DeleteByUserIDInTx(ctx, tx, user.UserID); err != nil {
+			return err
+		}
+		if err := uc.visitRepo.DeleteByUserIDInTx(ctx, tx, user.UserID); err != nil {
+			return err
+		}
+		if err := uc.deleteReviewsInTx(ctx, tx, reviews); err != nil {
+			return err
+		}
+		if err := uc.fileRepo.UnlinkByCreatorInTx(ctx, tx, user.UserID); err != nil {
+			return err
+		}
+		return uc.userRepo.AnonymizeInTx(ctx, tx, anonymized)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Storage と認証ユーザーの削除は取り消せないため、匿名化をコミットしてから行う。
+	// 失敗しても退会は完了しており、ユーザーは削除済み（purged_at）になるまで AccountPurgeUseCase が再試行する
+	if err := uc.purger.purge(ctx, user.UserID); err != nil {
+		slog.ErrorContext(ctx, "failed to purge deleted account", "user_id", user.UserID, "error", err)
+	}
+	return nil
+}
+
+// deleteReviewsInTx はレビューを削除し、対象の店舗の評価を再集計します
+func (uc *accountUseCase) deleteReviewsInTx(ctx context.Context, tx interface{}, reviews []entity.Review) error {
+	storeIDs := make([]string, 0, len(reviews))
+	for _, review := range reviews {
+		if err := uc.reviewRepo.DeleteInTx(ctx, tx, review.ReviewID); err != nil {
+			return err
+		}
+		storeIDs = append(storeIDs, review.StoreID)
+	}
+	for _, storeID := range dedupeStrings(storeIDs) {
+		if err := uc.ratingRepo.RecomputeInTx(ctx, tx, storeID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// anonymizeUser は個人情報を取り除いた退会済みのユーザーを返します。
+// メールアドレスは一意制約があるため、ユーザー ID から配送されないアドレスを作ります
+func anonymizeUser(user entity.User, now time.Time) entity.User {
+	user.Name = constants.DeletedUserName
+	user.Email = fmt.Sprintf("deleted-%s@%s", user.UserID, constants.DeletedUserEmailDomain)
+	user.Phone = nil
+	user.IconURL = nil
+	user.IconFileID = nil
+	user.Gender = nil
+	user.Birthday = nil
+	user.DeletedAt = &now
+	user.UpdatedAt = now
+	return user
+}
+
+// ExportAccount はユーザーのプロフィール・レビュー・お気に入り・いいね・通報・アップロードしたファイル・訪問記録をまとめて返します
+func (uc *accountUseCase) ExportAccount(ctx context.Context, user entity.User) (*input.AccountExport, error) {
+	if user.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	profile, err := mustFindUser(ctx, uc.userRepo, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer := ownDataViewer(user.UserID)
+	reviews, err := uc.reviewRepo.FindByUserID(ctx, user.UserID, viewer)
+	if err != nil {
+		return nil, err
+	}
+	favorites, err := uc.favoriteRepo.FindByUserID(ctx, user.UserID, viewer)
+	if err != nil {
+		return nil, err
+	}
+	likes, err := uc.reviewRepo.FindLikesByUserID(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	reports, err := uc.reportRepo.FindByUserID(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	files, err := uc.fileRepo.FindByCreator(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	visits, err := uc.visitRepo.FindByUserID(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &input.AccountExport{
+		User:       profile,
+		Reviews:    reviews,
+		Favorites:  favorites,
+		Likes:      likes,
+		Reports:    reports,
+		Files:      files,
+		Visits:     visits,
+		ExportedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type accountPurgeUseCase struct {
+	userRepo    output.UserRepository
+	fileRepo    output.FileRepository
+	storage     output.StorageProvider
+	authAdmin   output.AccountAuthAdmin
+	transaction output.Transaction
+	bucket      string
+}
+
+// NewAccountPurgeUseCase は AccountPurgeUseCase の実装を生成します
+func NewAccountPurgeUseCase(
+	userRepo output.UserRepository,
+	fileRepo output.FileRepository,
+	storage output.StorageProvider,
+	authAdmin output.AccountAuthAdmin,
+	transaction output.Transaction,
+	bucket string,
+) input.AccountPurgeUseCase {
+	return newAccountPurger(userRepo, fileRepo, storage, authAdmin, transaction, bucket)
+}
+
+func newAccountPurger(
+	userRepo output.UserRepository,
+	fileRepo output.FileRepository,
+	storage output.StorageProvider,
+	authAdmin output.AccountAuthAdmin,
+	transaction output.Transaction,
+	bucket string,
+) *accountPurgeUseCase {
+	return &accountPurgeUseCase{
+		userRepo:    userRepo,
+		fileRepo:    fileRepo,
+		storage:     storage,
+		authAdmin:   authAdmin,
+		transaction: transaction,
+		bucket:      bucket,
+	}
+}
+
+// PurgeDeletedAccounts は退会時に削除しきれなかった認証ユーザーとアップロードしたファイルの削除を再試行します。
+// 失敗したユーザーは次回に回し、残りのユーザーの削除を続ける
+func (uc *accountPurgeUseCase) PurgeDeletedAccounts(ctx context.Context) (*input.AccountPurgeResult, error) {
+	result := &input.AccountPurgeResult{}
+	users, err := uc.userRepo.FindPurgePending(ctx, constants.AccountPurgeBatchSize)
+	if err != nil {
+		return result, err
+	}
+	for _, user := range users {
+		if err := uc.purge(ctx, user.UserID); err != nil {
+			result.Failed++
+			continue
+		}
+		result.Purged++
+	}
+	return result, nil
+}
+
+// purge は退会したユーザーがアップロードしたファイルを Storage から削除し、認証ユーザーを削除して削除済みにします。
+// どの手順も繰り返し実行できるため、途中で失敗しても再試行で続きから削除できる
+func (uc *accountPurgeUseCase) purge(ctx context.Context, userID string) error {
+	files, err := uc.fileRepo.FindByCreator(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := deleteStoredFiles(ctx, uc.fileRepo, uc.storage, uc.transaction, uc.bucket, files); err != nil {
+		return err
+	}
+	if err := uc.authAdmin.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+	return uc.userRepo.MarkPurged(ctx, userID, time.Now())
+}
@@ -0,0 +1,79 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+)
+
+func purgePendingUser(id string) entity.User {
+	deletedAt := time.Now().Add(-time.Hour)
+	return entity.User{UserID: id, DeletedAt: &deletedAt}
+}
+
+func TestPurgeDeletedAccounts_RemovesFilesAndAuthUsers(t *testing.T) {
+	userRepo := &testutil.MockUserRepository{PurgePending: []entity.User{
+		purgePendingUser("user-1"),
+		purgePendingUser("user-2"),
+	}}
+	fileRepo := &testutil.MockFileRepository{UploadedResult: []entity.File{{FileID: "file-1", ObjectKey: "reviews/file-1.jpg"}}}
+	storage := &testutil.MockStorageProvider{}
+	authAdmin := &mockAccountAuthAdmin{}
+	uc := usecase.NewAccountPurgeUseCase(userRepo, fileRepo, storage, authAdmin, &testutil.MockTransaction{}, "test-bucket")
+
+	result, err := uc.PurgeDeletedAccounts(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Purged != 2 || result.Failed != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if got := userRepo.MarkPurgedCalledWith; len(got) != 2 || got[0] != "user-1" || got[1] != "user-2" {
+		t.Errorf("expected both users to be marked as purged, got %v", got)
+	}
+	if len(storage.DeletedKeys) == 0 || len(fileRepo.DeletedByIDs) == 0 {
+		t.Errorf("expected uploaded files to be removed, got keys %v ids %v", storage.DeletedKeys, fileRepo.DeletedByIDs)
+	}
+}
+
+func TestPurgeDeletedAccounts_AuthDeleteFails_RetriesNextTime(t *testing.T) {
+	userRepo := &testutil.MockUserRepository{PurgePending: []entity.User{purgePendingUser("user-1")}}
+	authAdmin := &mockAccountAuthAdmin{DeleteUserErr: errors.New("supabase down")}
+	uc := usecase.NewAccountPurgeUseCase(userRepo, &testutil.MockFileRepository{}, &testutil.MockStorageProvider{}, authAdmin, &testutil.MockTransaction{}, "test-bucket")
+
+	result, err := uc.PurgeDeletedAccounts(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Purged != 0 || result.Failed != 1 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if !userRepo.PurgePending[0].IsPurgePending() {
+		t.Fatal("expected user to stay pending purge")
+	}
+
+	authAdmin.DeleteUserErr = nil
+	result, err = uc.PurgeDeletedAccounts(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Purged != 1 || userRepo.PurgePending[0].IsPurgePending() {
+		t.Errorf("expected user to be purged on retry, got %+v", result)
+	}
+}
+
+func TestPurgeDeletedAccounts_RepositoryError(t *testing.T) {
+	repoErr := errors.New("db error")
+	userRepo := &testutil.MockUserRepository{FindPurgePendingErr: repoErr}
+	uc := usecase.NewAccountPurgeUseCase(userRepo, &testutil.MockFileRepository{}, &testutil.MockStorageProvider{}, &mockAccountAuthAdmin{}, &testutil.MockTransaction{}, "test-bucket")
+
+	if _, err := uc.PurgeDeletedAccounts(context.Background()); !errors.Is(err, repoErr) {
+		t.Fatalf("expected repository error, got %v", err)
+	}
+}
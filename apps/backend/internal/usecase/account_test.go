@@ -0,0 +1,288 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+type mockAccountAuthAdmin struct {
+	DeleteUserCalledWith string
+	DeleteUserErr        error
+}
+
+func (m *mockAccountAuthAdmin) DeleteUser(ctx context.Context, userID string) error {
+	m.DeleteUserCalledWith = userID
+	return m.DeleteUserErr
+}
+
+type accountTestDeps struct {
+	userRepo     *testutil.MockUserRepository
+	reviewRepo   *testutil.MockReviewRepository
+	favoriteRepo *testutil.MockFavoriteRepository
+	followRepo   *testutil.MockFollowRepository
+	visitRepo    *testutil.MockVisitRepository
+	fileRepo     *testutil.MockFileRepository
+	reportRepo   *testutil.MockReportRepository
+	ratingRepo   *testutil.MockStoreRatingRepository
+	authAdmin    *mockAccountAuthAdmin
+	storage      *testutil.MockStorageProvider
+	transaction  *testutil.MockTransaction
+}
+
+func newAccountTestDeps() *accountTestDeps {
+	phone := "090-1234-5678"
+	return &accountTestDeps{
+		userRepo: &testutil.MockUserRepository{FindByIDResult: entity.User{
+			UserID: "user-1",
+			Name:   "Taro",
+			Email:  "taro@example.com",
+			Phone:  &phone,
+			Role:   "user",
+		}},
+		reviewRepo:   &testutil.MockReviewRepository{},
+		favoriteRepo: &testutil.MockFavoriteRepository{},
+		followRepo:   &testutil.MockFollowRepository{},
+		visitRepo:    &testutil.MockVisitRepository{},
+		fileRepo:     &testutil.MockFileRepository{},
+		reportRepo:   &testutil.MockReportRepository{},
+		ratingRepo:   &testutil.MockStoreRatingRepository{},
+		authAdmin:    &mockAccountAuthAdmin{},
+		storage:      &testutil.MockStorageProvider{},
+		transaction:  &testutil.MockTransaction{},
+	}
+}
+
+func (d *accountTestDeps) useCase(policy string) input.AccountUseCase {
+	return usecase.NewAccountUseCase(
+		d.userRepo, d.reviewRepo, d.favoriteRepo, d.followRepo, d.visitRepo, d.fileRepo, d.reportRepo, d.ratingRepo,
+		d.authAdmin, d.storage, d.transaction, "test-bucket", policy,
+	)
+}
+
+// --- DeleteAccount Tests ---
+
+func TestDeleteAccount_AnonymizePolicy_KeepsReviews(t *testing.T) {
+	deps := newAccountTestDeps()
+	deps.reviewRepo.FindByUserIDResult = []entity.Review{{ReviewID: "review-1", StoreID: "store-1"}}
+
+	err := deps.useCase(constants.ReviewDeletionPolicyAnonymize).DeleteAccount(context.Background(), entity.User{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if deps.reviewRepo.DeleteLikesCalledWith != "user-1" {
+		t.Errorf("expected likes of user-1 to be deleted, got %q", deps.reviewRepo.DeleteLikesCalledWith)
+	}
+	if deps.favoriteRepo.DeleteByUserCalledWith != "user-1" {
+		t.Errorf("expected favorites of user-1 to be deleted, got %q", deps.favoriteRepo.DeleteByUserCalledWith)
+	}
+	if deps.followRepo.DeleteByUserCalledWith != "user-1" {
+		t.Errorf("expected follows of user-1 to be deleted, got %q", deps.followRepo.DeleteByUserCalledWith)
+	}
+	if deps.visitRepo.DeleteByUserCalledWith != "user-1" {
+		t.Errorf("expected visits of user-1 to be deleted, got %q", deps.visitRepo.DeleteByUserCalledWith)
+	}
+	if deps.fileRepo.UnlinkByCreatorCalledWith != "user-1" {
+		t.Errorf("expected files of user-1 to be unlinked, got %q", deps.fileRepo.UnlinkByCreatorCalledWith)
+	}
+	if len(deps.reviewRepo.DeletedInTx) != 0 {
+		t.Errorf("expected reviews to be kept, got deleted %v", deps.reviewRepo.DeletedInTx)
+	}
+	if deps.authAdmin.DeleteUserCalledWith != "user-1" {
+		t.Errorf("expected auth user user-1 to be deleted, got %q", deps.authAdmin.DeleteUserCalledWith)
+	}
+	if got := deps.userRepo.MarkPurgedCalledWith; len(got) != 1 || got[0] != "user-1" {
+		t.Errorf("expected user-1 to be marked as purged, got %v", got)
+	}
+
+	anonymized := deps.userRepo.AnonymizeCalledWith
+	if anonymized == nil {
+		t.Fatal("expected user to be anonymized")
+	}
+	if anonymized.Name != constants.DeletedUserName || anonymized.Phone != nil || anonymized.DeletedAt == nil {
+		t.Errorf("unexpected anonymized user: %+v", anonymized)
+	}
+	if anonymized.Email == "taro@example.com" || !strings.HasSuffix(anonymized.Email, "@"+constants.DeletedUserEmailDomain) {
+		t.Errorf("expected anonymized email, got %q", anonymized.Email)
+	}
+}
+
+func TestDeleteAccount_DeletePolicy_DeletesReviewsAndRecomputesRatings(t *testing.T) {
+	deps := newAccountTestDeps()
+	deps.reviewRepo.FindByUserIDResult = []entity.Review{
+		{ReviewID: "review-1", StoreID: "store-1"},
+		{ReviewID: "review-2", StoreID: "store-1"},
+		{ReviewID: "review-3", StoreID: "store-2"},
+	}
+
+	err := deps.useCase(constants.ReviewDeletionPolicyDelete).DeleteAccount(context.Background(), entity.User{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(deps.reviewRepo.DeletedInTx) != 3 {
+		t.Errorf("expected 3 reviews to be deleted, got %v", deps.reviewRepo.DeletedInTx)
+	}
+	if got := deps.ratingRepo.RecomputeCalledWith; len(got) != 2 || got[0] != "store-1" || got[1] != "store-2" {
+		t.Errorf("expected ratings of store-1 and store-2 to be recomputed, got %v", got)
+	}
+}
+
+func TestDeleteAccount_DeletesUploadedFilesFromStorage(t *testing.T) {
+	deps := newAccountTestDeps()
+	deps.fileRepo.UploadedResult = []entity.File{
+		{FileID: "file-1", ObjectKey: "reviews/file-1.jpg", Variants: []entity.FileVariant{{ObjectKey: "reviews/file-1_small.webp"}}},
+		{FileID: "file-2", ObjectKey: "users/file-2.png"},
+	}
+
+	err := deps.useCase(constants.ReviewDeletionPolicyAnonymize).DeleteAccount(context.Background(), entity.User{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := deps.storage.DeletedKeys; len(got) != 3 {
+		t.Errorf("expected the objects and variants to be removed from storage, got %v", got)
+	}
+	if got := deps.fileRepo.DeletedByIDs; len(got) != 2 || got[0] != "file-1" || got[1] != "file-2" {
+		t.Errorf("expected the files to be deleted, got %v", got)
+	}
+}
+
+func TestDeleteAccount_AuthDeleteFails_LeavesPurgePending(t *testing.T) {
+	deps := newAccountTestDeps()
+	deps.authAdmin.DeleteUserErr = errors.New("supabase down")
+
+	err := deps.useCase(constants.ReviewDeletionPolicyAnonymize).DeleteAccount(context.Background(), entity.User{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("expected the account to be deleted, got %v", err)
+	}
+	if deps.userRepo.AnonymizeCalledWith == nil {
+		t.Fatal("expected user to be anonymized")
+	}
+	if len(deps.userRepo.MarkPurgedCalledWith) != 0 {
+		t.Errorf("expected user to be left for the retry, got marked %v", deps.userRepo.MarkPurgedCalledWith)
+	}
+	if !deps.userRepo.FindByIDResult.IsPurgePending() {
+		t.Error("expected user to be pending purge")
+	}
+}
+
+func TestDeleteAccount_StorageDeleteFails_DoesNotDeleteAuthUser(t *testing.T) {
+	deps := newAccountTestDeps()
+	deps.fileRepo.UploadedResult = []entity.File{{FileID: "file-1", ObjectKey: "reviews/file-1.jpg"}}
+	deps.storage.DeleteErr = errors.New("storage down")
+
+	err := deps.useCase(constants.ReviewDeletionPolicyAnonymize).DeleteAccount(context.Background(), entity.User{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("expected the account to be deleted, got %v", err)
+	}
+	if len(deps.fileRepo.DeletedByIDs) != 0 {
+		t.Errorf("expected files to be kept until removed from storage, got deleted %v", deps.fileRepo.DeletedByIDs)
+	}
+	if deps.authAdmin.DeleteUserCalledWith != "" {
+		t.Errorf("expected auth user to be left for the retry, got deleted %q", deps.authAdmin.DeleteUserCalledWith)
+	}
+}
+
+func TestDeleteAccount_CommitFails_DoesNotDeleteAuthUser(t *testing.T) {
+	deps := newAccountTestDeps()
+	deps.fileRepo.UploadedResult = []entity.File{{FileID: "file-1", ObjectKey: "reviews/file-1.jpg"}}
+	commitErr := errors.New("commit failed")
+	deps.transaction.CommitErr = commitErr
+
+	err := deps.useCase(constants.ReviewDeletionPolicyAnonymize).DeleteAccount(context.Background(), entity.User{UserID: "user-1"})
+	if !errors.Is(err, commitErr) {
+		t.Fatalf("expected commit error, got %v", err)
+	}
+	if deps.authAdmin.DeleteUserCalledWith != "" {
+		t.Errorf("expected auth user to be kept, got deleted %q", deps.authAdmin.DeleteUserCalledWith)
+	}
+	if len(deps.storage.DeletedKeys) != 0 {
+		t.Errorf("expected objects to be kept, got deleted %v", deps.storage.DeletedKeys)
+	}
+}
+
+func TestDeleteAccount_RepositoryFails_DoesNotDeleteAuthUser(t *testing.T) {
+	deps := newAccountTestDeps()
+	repoErr := errors.New("db error")
+	deps.fileRepo.UnlinkByCreatorErr = repoErr
+
+	err := deps.useCase(constants.ReviewDeletionPolicyAnonymize).DeleteAccount(context.Background(), entity.User{UserID: "user-1"})
+	if !errors.Is(err, repoErr) {
+		t.Fatalf("expected repository error, got %v", err)
+	}
+	if deps.authAdmin.DeleteUserCalledWith != "" {
+		t.Errorf("expected auth user to be kept, got deleted %q", deps.authAdmin.DeleteUserCalledWith)
+	}
+}
+
+func TestDeleteAccount_AlreadyDeleted(t *testing.T) {
+	deps := newAccountTestDeps()
+	deletedAt := time.Now()
+	deps.userRepo.FindByIDResult.DeletedAt = &deletedAt
+
+	err := deps.useCase(constants.ReviewDeletionPolicyAnonymize).DeleteAccount(context.Background(), entity.User{UserID: "user-1"})
+	if !errors.Is(err, usecase.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+	if deps.authAdmin.DeleteUserCalledWith != "" {
+		t.Error("expected auth user not to be deleted")
+	}
+}
+
+func TestDeleteAccount_Unauthorized(t *testing.T) {
+	deps := newAccountTestDeps()
+
+	err := deps.useCase(constants.ReviewDeletionPolicyAnonymize).DeleteAccount(context.Background(), entity.User{})
+	if !errors.Is(err, usecase.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+// --- ExportAccount Tests ---
+
+func TestExportAccount_CollectsUserData(t *testing.T) {
+	deps := newAccountTestDeps()
+	deps.reviewRepo.FindByUserIDResult = []entity.Review{{ReviewID: "review-1"}}
+	deps.reviewRepo.LikesResult = []entity.ReviewLike{{ReviewID: "review-2", UserID: "user-1"}}
+	deps.favoriteRepo.FindByUserIDResult = []entity.Favorite{{UserID: "user-1", StoreID: "store-1"}}
+	deps.reportRepo.ByUserResult = []entity.Report{{ReportID: 1}}
+	deps.fileRepo.UploadedResult = []entity.File{{FileID: "file-1", ObjectKey: "reviews/file-1.jpg"}}
+	deps.visitRepo.FindByUserIDResult = []entity.Visit{{VisitID: 1, UserID: "user-1", StoreID: "store-1"}}
+
+	export, err := deps.useCase(constants.ReviewDeletionPolicyAnonymize).ExportAccount(context.Background(), entity.User{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if export.User.Email != "taro@example.com" {
+		t.Errorf("expected profile to be exported, got %+v", export.User)
+	}
+	if len(export.Reviews) != 1 || len(export.Likes) != 1 || len(export.Favorites) != 1 ||
+		len(export.Reports) != 1 || len(export.Files) != 1 || len(export.Visits) != 1 {
+		t.Errorf("unexpected export: %+v", export)
+	}
+	if export.ExportedAt.IsZero() {
+		t.Error("expected ExportedAt to be set")
+	}
+}
+
+func TestExportAccount_RepositoryError(t *testing.T) {
+	deps := newAccountTestDeps()
+	repoErr := errors.New("db error")
+	deps.reportRepo.FindByUserErr = repoErr
+
+	_, err := deps.useCase(constants.ReviewDeletionPolicyAnonymize).ExportAccount(context.Background(), entity.User{UserID: "user-1"})
+	if !errors.Is(err, repoErr) {
+		t.Fatalf("expected repository error, got %v", err)
+	}
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
@@ -10,40 +12,96 @@ import (
 // AdminUseCase は管理者機能に関するビジネスロジックを提供します
 type AdminUseCase interface {
 	GetPendingStores(ctx context.Context) ([]entity.Store, error)
-	ApproveStore(ctx context.Context, storeID string) error
-	RejectStore(ctx context.Context, storeID string) error
+	SetStoreVisibility(ctx context.Context, admin entity.User, storeID string, visibility string) (*entity.Store, error)
+	SetReviewVisibility(ctx context.Context, admin entity.User, reviewID string, visibility string) (*entity.Review, error)
 }
 
 type adminUseCase struct {
-	storeRepo output.StoreRepository
+	storeRepo   output.StoreRepository
+	reviewRepo  output.ReviewRepository
+	ratingRepo  output.StoreRatingRepository
+	transaction output.Transaction
 }
 
 // NewAdminUseCase は AdminUseCase の実装を生成します
-func NewAdminUseCase(storeRepo output.StoreRepository) AdminUseCase {
+func NewAdminUseCase(
+	storeRepo output.StoreRepository,
+	reviewRepo output.ReviewRepository,
+	ratingRepo output.StoreRatingRepository,
+	transaction output.Transaction,
+) AdminUseCase {
 	return &adminUseCase{
-		storeRepo: storeRepo,
+		storeRepo:   storeRepo,
+		reviewRepo:  reviewRepo,
+		ratingRepo:  ratingRepo,
+		transaction: transaction,
 	}
 }
 
+// validVisibilities は店舗・レビューに設定できる公開状態
+var validVisibilities = map[string]bool{
+	constants.VisibilityPublished: true,
+	constants.VisibilityPending:   true,
+	constants.VisibilityHidden:    true,
+}
+
 func (uc *adminUseCase) GetPendingStores(ctx context.Context) ([]entity.Store, error) {
 	return uc.storeRepo.FindPending(ctx)
 }
 
-func (uc *adminUseCase) ApproveStore(ctx context.Context, storeID string) error {
-	return uc.setStoreApproval(ctx, storeID, true)
-}
+// SetStoreVisibility は店舗の公開状態だけを変更します。
+// 公開は審査で承認された店舗に限り、承認前の店舗は審査（StoreApprovalUseCase）を通して公開する
+func (uc *adminUseCase) SetStoreVisibility(ctx context.Context, admin entity.User, storeID string, visibility string) (*entity.Store, error) {
+	if !validVisibilities[visibility] {
+		return nil, ErrInvalidVisibility
+	}
+	store, err := mustFindStore(ctx, uc.storeRepo, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if visibility == constants.VisibilityPublished && store.ApprovalStatus != constants.StoreApprovalApproved {
+		return nil, ErrStoreNotApproved
+	}
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
 
-func (uc *adminUseCase) RejectStore(ctx context.Context, storeID string) error {
-	return uc.setStoreApproval(ctx, storeID, false)
+	err = uc.transaction.StartTransaction(func(tx interface{}) error {
+		return uc.storeRepo.UpdateVisibilityInTx(ctx, tx, storeID, visibility, store.ApprovalStatus)
+	})
+	if errors.Is(err, output.ErrStoreApprovalChanged) {
+		return nil, ErrStoreNotApproved
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	store.Visibility = visibility
+	return store, nil
 }
 
-// setStoreApproval is a helper to set store approval status.
-func (uc *adminUseCase) setStoreApproval(ctx context.Context, storeID string, approved bool) error {
-	store, err := mustFindStore(ctx, uc.storeRepo, storeID)
+// SetReviewVisibility はレビューの公開状態を変更し、公開中のレビューだけで店舗の評価を集計し直します
+func (uc *adminUseCase) SetReviewVisibility(ctx context.Context, admin entity.User, reviewID string, visibility string) (*entity.Review, error) {
+	if !validVisibilities[visibility] {
+		return nil, ErrInvalidVisibility
+	}
+	review, err := mustFindReview(ctx, uc.reviewRepo, reviewID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+
+	if err := uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.reviewRepo.UpdateVisibilityInTx(ctx, tx, reviewID, visibility); err != nil {
+			return err
+		}
+		return uc.ratingRepo.RecomputeInTx(ctx, tx, review.StoreID)
+	}); err != nil {
+		return nil, err
 	}
 
-	store.IsApproved = approved
-	return uc.storeRepo.Update(ctx, store)
+	review.Visibility = visibility
+	return review, nil
 }
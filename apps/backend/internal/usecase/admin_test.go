@@ -6,11 +6,17 @@ import (
 	"testing"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
 
+func newAdminUseCase(repo *testutil.MockStoreRepository) usecase.AdminUseCase {
+	return usecase.NewAdminUseCase(repo, &testutil.MockReviewRepository{}, &testutil.MockStoreRatingRepository{}, &testutil.MockTransaction{})
+}
+
 // --- GetPendingStores Tests ---
 
 func TestGetPendingStores_Success(t *testing.T) {
@@ -20,7 +26,7 @@ func TestGetPendingStores_Success(t *testing.T) {
 			{StoreID: "store-2", Name: "Store B"},
 		},
 	}
-	uc := usecase.NewAdminUseCase(repo)
+	uc := newAdminUseCase(repo)
 
 	stores, err := uc.GetPendingStores(context.Background())
 	if err != nil {
@@ -35,7 +41,7 @@ func TestGetPendingStores_Empty(t *testing.T) {
 	repo := &testutil.MockStoreRepository{
 		Stores: []entity.Store{},
 	}
-	uc := usecase.NewAdminUseCase(repo)
+	uc := newAdminUseCase(repo)
 
 	stores, err := uc.GetPendingStores(context.Background())
 	if err != nil {
@@ -51,7 +57,7 @@ func TestGetPendingStores_RepositoryError(t *testing.T) {
 	repo := &testutil.MockStoreRepository{
 		FindPendingErr: dbErr,
 	}
-	uc := usecase.NewAdminUseCase(repo)
+	uc := newAdminUseCase(repo)
 
 	_, err := uc.GetPendingStores(context.Background())
 
@@ -60,120 +66,143 @@ func TestGetPendingStores_RepositoryError(t *testing.T) {
 	}
 }
 
-// --- ApproveStore Tests ---
+// --- SetStoreVisibility Tests ---
 
-func TestApproveStore_Success(t *testing.T) {
-	store := &entity.Store{StoreID: "store-1", IsApproved: false}
-	repo := &testutil.MockStoreRepository{Store: store}
-	uc := usecase.NewAdminUseCase(repo)
+func TestSetStoreVisibility_Success(t *testing.T) {
+	repo := &testutil.MockStoreRepository{Store: &entity.Store{
+		StoreID:        "store-1",
+		Visibility:     constants.VisibilityPublished,
+		ApprovalStatus: constants.StoreApprovalApproved,
+	}}
+	uc := newAdminUseCase(repo)
 
-	err := uc.ApproveStore(context.Background(), "store-1")
+	store, err := uc.SetStoreVisibility(context.Background(), testAdmin, "store-1", constants.VisibilityHidden)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if !repo.UpdateCalledWith.IsApproved {
-		t.Fatalf("expected store to be approved")
+	if store.Visibility != constants.VisibilityHidden || repo.VisibilityWith.Visibility != constants.VisibilityHidden {
+		t.Errorf("expected store to be hidden, got %+v", repo.VisibilityWith)
 	}
-}
-
-func TestApproveStore_NotFound(t *testing.T) {
-	repo := &testutil.MockStoreRepository{
-		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
+	if repo.VisibilityWith.ApprovalStatus != constants.StoreApprovalApproved {
+		t.Errorf("expected update to be guarded by the approval status, got %+v", repo.VisibilityWith)
 	}
-	uc := usecase.NewAdminUseCase(repo)
-
-	err := uc.ApproveStore(context.Background(), "nonexistent")
-
-	if !errors.Is(err, usecase.ErrStoreNotFound) {
-		t.Errorf("expected ErrStoreNotFound, got %v", err)
+	if repo.UpdateCalled {
+		t.Error("expected only the visibility to be updated")
 	}
 }
 
-func TestApproveStore_FindByIDError(t *testing.T) {
-	dbErr := errors.New("database connection error")
-	repo := &testutil.MockStoreRepository{
-		FindByIDErr: dbErr,
+func TestSetStoreVisibility_PublishUnapprovedStore(t *testing.T) {
+	for _, status := range []string{constants.StoreApprovalDraft, constants.StoreApprovalRejected, constants.StoreApprovalSubmitted} {
+		repo := &testutil.MockStoreRepository{Store: &entity.Store{
+			StoreID:        "store-1",
+			Visibility:     constants.VisibilityPending,
+			ApprovalStatus: status,
+		}}
+		uc := newAdminUseCase(repo)
+
+		_, err := uc.SetStoreVisibility(context.Background(), testAdmin, "store-1", constants.VisibilityPublished)
+		if !errors.Is(err, usecase.ErrStoreNotApproved) {
+			t.Errorf("%s: expected ErrStoreNotApproved, got %v", status, err)
+		}
+		if repo.VisibilityWith.StoreID != "" {
+			t.Errorf("%s: expected store not to be updated", status)
+		}
 	}
-	uc := usecase.NewAdminUseCase(repo)
+}
 
-	err := uc.ApproveStore(context.Background(), "store-1")
+func TestSetStoreVisibility_HideUnapprovedStore(t *testing.T) {
+	repo := &testutil.MockStoreRepository{Store: &entity.Store{
+		StoreID:        "store-1",
+		Visibility:     constants.VisibilityPending,
+		ApprovalStatus: constants.StoreApprovalDraft,
+	}}
+	uc := newAdminUseCase(repo)
 
-	if !errors.Is(err, dbErr) {
-		t.Errorf("expected database error, got %v", err)
+	if _, err := uc.SetStoreVisibility(context.Background(), testAdmin, "store-1", constants.VisibilityHidden); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.VisibilityWith.Visibility != constants.VisibilityHidden {
+		t.Errorf("expected store to be hidden, got %+v", repo.VisibilityWith)
 	}
 }
 
-func TestApproveStore_UpdateError(t *testing.T) {
-	updateErr := errors.New("update failed")
-	store := &entity.Store{StoreID: "store-1", IsApproved: false}
+func TestSetStoreVisibility_ApprovalChangedConcurrently(t *testing.T) {
 	repo := &testutil.MockStoreRepository{
-		Store:     store,
-		UpdateErr: updateErr,
+		Store: &entity.Store{
+			StoreID:        "store-1",
+			Visibility:     constants.VisibilityHidden,
+			ApprovalStatus: constants.StoreApprovalApproved,
+		},
+		VisibilityErr: output.ErrStoreApprovalChanged,
 	}
-	uc := usecase.NewAdminUseCase(repo)
-
-	err := uc.ApproveStore(context.Background(), "store-1")
+	uc := newAdminUseCase(repo)
 
-	if !errors.Is(err, updateErr) {
-		t.Errorf("expected update error, got %v", err)
+	_, err := uc.SetStoreVisibility(context.Background(), testAdmin, "store-1", constants.VisibilityPublished)
+	if !errors.Is(err, usecase.ErrStoreNotApproved) {
+		t.Errorf("expected ErrStoreNotApproved, got %v", err)
 	}
 }
 
-// --- RejectStore Tests ---
+func TestSetStoreVisibility_Invalid(t *testing.T) {
+	repo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+	uc := newAdminUseCase(repo)
 
-func TestRejectStore_Success(t *testing.T) {
-	store := &entity.Store{StoreID: "store-1", IsApproved: true}
-	repo := &testutil.MockStoreRepository{Store: store}
-	uc := usecase.NewAdminUseCase(repo)
+	_, err := uc.SetStoreVisibility(context.Background(), testAdmin, "store-1", "deleted")
 
-	err := uc.RejectStore(context.Background(), "store-1")
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	if !errors.Is(err, usecase.ErrInvalidVisibility) {
+		t.Errorf("expected ErrInvalidVisibility, got %v", err)
 	}
-	if repo.UpdateCalledWith.IsApproved {
-		t.Fatalf("expected store to be rejected (IsApproved=false)")
+	if repo.VisibilityWith.StoreID != "" {
+		t.Error("expected store not to be updated")
 	}
 }
 
-func TestRejectStore_NotFound(t *testing.T) {
+func TestSetStoreVisibility_NotFound(t *testing.T) {
 	repo := &testutil.MockStoreRepository{
 		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
 	}
-	uc := usecase.NewAdminUseCase(repo)
+	uc := newAdminUseCase(repo)
 
-	err := uc.RejectStore(context.Background(), "nonexistent")
+	_, err := uc.SetStoreVisibility(context.Background(), testAdmin, "nonexistent", constants.VisibilityHidden)
 
 	if !errors.Is(err, usecase.ErrStoreNotFound) {
 		t.Errorf("expected ErrStoreNotFound, got %v", err)
 	}
 }
 
-func TestRejectStore_FindByIDError(t *testing.T) {
-	dbErr := errors.New("database connection error")
-	repo := &testutil.MockStoreRepository{
-		FindByIDErr: dbErr,
-	}
-	uc := usecase.NewAdminUseCase(repo)
+// --- SetReviewVisibility Tests ---
 
-	err := uc.RejectStore(context.Background(), "store-1")
+func TestSetReviewVisibility_RecomputesRating(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{
+		FindByIDResult: &entity.Review{ReviewID: "review-1", StoreID: "store-1", Visibility: constants.VisibilityPublished},
+	}
+	ratingRepo := &testutil.MockStoreRatingRepository{}
+	uc := usecase.NewAdminUseCase(&testutil.MockStoreRepository{}, reviewRepo, ratingRepo, &testutil.MockTransaction{})
 
-	if !errors.Is(err, dbErr) {
-		t.Errorf("expected database error, got %v", err)
+	review, err := uc.SetReviewVisibility(context.Background(), testAdmin, "review-1", constants.VisibilityHidden)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if review.Visibility != constants.VisibilityHidden {
+		t.Errorf("expected hidden review, got %q", review.Visibility)
+	}
+	if reviewRepo.UpdateVisibilityWith.ReviewID != "review-1" || reviewRepo.UpdateVisibilityWith.Visibility != constants.VisibilityHidden {
+		t.Errorf("unexpected visibility update: %+v", reviewRepo.UpdateVisibilityWith)
+	}
+	if len(ratingRepo.RecomputeCalledWith) != 1 || ratingRepo.RecomputeCalledWith[0] != "store-1" {
+		t.Errorf("expected rating of store-1 to be recomputed, got %v", ratingRepo.RecomputeCalledWith)
 	}
 }
 
-func TestRejectStore_UpdateError(t *testing.T) {
-	updateErr := errors.New("update failed")
-	store := &entity.Store{StoreID: "store-1", IsApproved: true}
-	repo := &testutil.MockStoreRepository{
-		Store:     store,
-		UpdateErr: updateErr,
+func TestSetReviewVisibility_NotFound(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{
+		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
 	}
-	uc := usecase.NewAdminUseCase(repo)
+	uc := usecase.NewAdminUseCase(&testutil.MockStoreRepository{}, reviewRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockTransaction{})
 
-	err := uc.RejectStore(context.Background(), "store-1")
+	_, err := uc.SetReviewVisibility(context.Background(), testAdmin, "nonexistent", constants.VisibilityHidden)
 
-	if !errors.Is(err, updateErr) {
-		t.Errorf("expected update error, got %v", err)
+	if !errors.Is(err, usecase.ErrReviewNotFound) {
+		t.Errorf("expected ErrReviewNotFound, got %v", err)
 	}
 }
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// AdminUserUseCase は管理者によるユーザー管理（検索・利用停止・ロール変更）のビジネスロジックを提供します
+type AdminUserUseCase interface {
+	ListUsers(ctx context.Context, query input.ListUsersQuery) (*input.UserPage, error)
+	SuspendUser(ctx context.Context, admin entity.User, userID string, input input.SuspendUserInput) (*entity.User, error)
+	UnsuspendUser(ctx context.Context, admin entity.User, userID string, input input.UnsuspendUserInput) (*entity.User, error)
+	ChangeUserRole(ctx context.Context, admin entity.User, userID string, input input.ChangeUserRoleInput) (*entity.User, error)
+	GetModerationHistory(ctx context.Context, userID string) ([]entity.UserModerationEvent, error)
+}
+
+type adminUserUseCase struct {
+	userRepo       output.UserRepository
+	moderationRepo output.UserModerationEventRepository
+	authAdmin      output.OwnerAuthAdmin
+	transaction    output.Transaction
+}
+
+// NewAdminUserUseCase は AdminUserUseCase の実装を生成します
+func NewAdminUserUseCase(
+	userRepo output.UserRepository,
+	moderationRepo output.UserModerationEventRepository,
+	authAdmin output.OwnerAuthAdmin,
+	transaction output.Transaction,
+) AdminUserUseCase {
+	return &adminUserUseCase{
+		userRepo:       userRepo,
+		moderationRepo: moderationRepo,
+		authAdmin:      authAdmin,
+		transaction:    transaction,
+	}
+}
+
+// ListUsers はメールアドレス・名前で検索したユーザーを登録の新しい順に返します
+func (uc *adminUserUseCase) ListUsers(ctx context.Context, query input.ListUsersQuery) (*input.UserPage, error) {
+	listQuery := output.UserListQuery{Query: query.Query, Cursor: query.Cursor}
+	if query.Role != "" {
+		if !IsValidRole(query.Role) {
+			return nil, ErrInvalidRole
+		}
+		listQuery.Role = &query.Role
+	}
+	limit, err := normalizeLimit(query.Limit, constants.DefaultUserListLimit, constants.MaxUserListLimit)
+	if err != nil {
+		return nil, err
+	}
+	listQuery.Limit = limit
+
+	page, err := uc.userRepo.List(ctx, listQuery)
+	if err != nil {
+		return nil, err
+	}
+	return &input.UserPage{Users: page.Users, NextCursor: page.NextCursor}, nil
+}
+
+// SuspendUser はユーザーを利用停止にします。期限を指定しない場合は無期限（ban）として記録します
+func (uc *adminUserUseCase) SuspendUser(ctx context.Context, admin entity.User, userID string, in input.SuspendUserInput) (*entity.User, error) {
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return nil, ErrModerationReasonRequired
+	}
+	now := time.Now()
+	if in.Until != nil && !in.Until.After(now) {
+		return nil, ErrInvalidSuspensionPeriod
+	}
+	user, err := uc.findTarget(ctx, admin, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.SuspendedAt = &now
+	user.SuspendedUntil = in.Until
+	user.SuspensionReason = &reason
+	user.UpdatedAt = now
+
+	action := constants.ModerationActionSuspend
+	if in.Until == nil {
+		action = constants.ModerationActionBan
+	}
+	event := &entity.UserModerationEvent{
+		UserID:         userID,
+		AdminID:        &admin.UserID,
+		Action:         action,
+		Reason:         &reason,
+		SuspendedUntil: in.Until,
+	}
+	if err := uc.saveSuspension(ctx, user, event); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// UnsuspendUser はユーザーの利用停止を解除します
+func (uc *adminUserUseCase) UnsuspendUser(ctx context.Context, admin entity.User, userID string, in input.UnsuspendUserInput) (*entity.User, error) {
+	user, err := uc.findTarget(ctx, admin, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsSuspended(time.Now()) {
+		return nil, ErrUserNotSuspended
+	}
+
+	user.SuspendedAt = nil
+	user.SuspendedUntil = nil
+	user.SuspensionReason = nil
+	user.UpdatedAt = time.Now()
+
+	event := &entity.UserModerationEvent{
+		UserID:  userID,
+		AdminID: &admin.UserID,
+		Action:  constants.ModerationActionUnsuspend,
+		Reason:  trimmedOrNil(in.Reason),
+	}
+	if err := uc.saveSuspension(ctx, user, event); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// ChangeUserRole はユーザーのロールを変更します。
+// JWT のロールは Supabase の app_metadata から発行されるため、先に Supabase を更新し、DB の更新に失敗した場合は元に戻します
+func (uc *adminUserUseCase) ChangeUserRole(ctx context.Context, admin entity.User, userID string, in input.ChangeUserRoleInput) (*entity.User, error) {
+	newRole := strings.ToLower(strings.TrimSpace(in.Role))
+	if !IsValidRole(newRole) {
+		return nil, ErrInvalidRole
+	}
+	user, err := uc.findTarget(ctx, admin, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == newRole {
+		return &user, nil
+	}
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+
+	oldRole := user.Role
+	if err := uc.updateAuthRole(ctx, userID, newRole); err != nil {
+		return nil, err
+	}
+
+	event := &entity.UserModerationEvent{
+		UserID:   userID,
+		AdminID:  &admin.UserID,
+		Action:   constants.ModerationActionChangeRole,
+		Reason:   trimmedOrNil(in.Reason),
+		FromRole: &oldRole,
+		ToRole:   &newRole,
+	}
+	err = uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.userRepo.UpdateRoleInTx(ctx, tx, userID, newRole); err != nil {
+			return err
+		}
+		return uc.moderationRepo.CreateInTx(ctx, tx, event)
+	})
+	if err != nil {
+		if rollbackErr := uc.updateAuthRole(ctx, userID, oldRole); rollbackErr != nil {
+			return nil, errors.Join(err, rollbackErr)
+		}
+		return nil, err
+	}
+
+	user.Role = newRole
+	return &user, nil
+}
+
+// GetModerationHistory はユーザーに対する管理者の操作履歴を新しい順に返します
+func (uc *adminUserUseCase) GetModerationHistory(ctx context.Context, userID string) ([]entity.UserModerationEvent, error) {
+	if err := ensureUserExists(ctx, uc.userRepo, userID); err != nil {
+		return nil, err
+	}
+	return uc.moderationRepo.FindByUserID(ctx, userID)
+}
+
+// findTarget は操作対象のユーザーを取得します。管理者自身は対象にできません
+func (uc *adminUserUseCase) findTarget(ctx context.Context, admin entity.User, userID string) (entity.User, error) {
+	if admin.UserID == "" {
+		return entity.User{}, ErrUnauthorized
+	}
+	if admin.UserID == userID {
+		return entity.User{}, ErrCannotModerateSelf
+	}
+	return mustFindUser(ctx, uc.userRepo, userID)
+}
+
+func (uc *adminUserUseCase) saveSuspension(ctx context.Context, user entity.User, event *entity.UserModerationEvent) error {
+	if uc.transaction == nil {
+		return output.ErrInvalidTransaction
+	}
+	return uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.userRepo.UpdateSuspensionInTx(ctx, tx, user); err != nil {
+			return err
+		}
+		return uc.moderationRepo.CreateInTx(ctx, tx, event)
+	})
+}
+
+func (uc *adminUserUseCase) updateAuthRole(ctx context.Context, userID, userRole string) error {
+	return uc.authAdmin.UpdateUser(ctx, userID, output.AuthUserUpdate{
+		AppMetadata: map[string]any{"role": userRole},
+	})
+}
@@ -0,0 +1,307 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type adminUserTestDeps struct {
+	userRepo  *testutil.MockUserRepository
+	eventRepo *testutil.MockUserModerationEventRepository
+	authAdmin *mockOwnerAuthAdmin
+	tx        *testutil.MockTransaction
+}
+
+func newAdminUserTestDeps() adminUserTestDeps {
+	return adminUserTestDeps{
+		userRepo: &testutil.MockUserRepository{FindByIDResult: entity.User{
+			UserID: "user-1",
+			Email:  "user@example.com",
+			Role:   "user",
+		}},
+		eventRepo: &testutil.MockUserModerationEventRepository{},
+		authAdmin: &mockOwnerAuthAdmin{},
+		tx:        &testutil.MockTransaction{},
+	}
+}
+
+func (d adminUserTestDeps) useCase() usecase.AdminUserUseCase {
+	return usecase.NewAdminUserUseCase(d.userRepo, d.eventRepo, d.authAdmin, d.tx)
+}
+
+// --- ListUsers Tests ---
+
+func TestListUsers_PassesFilters(t *testing.T) {
+	deps := newAdminUserTestDeps()
+	deps.userRepo.ListResult = &output.UserPage{Users: []entity.User{{UserID: "user-1"}}, NextCursor: "next"}
+
+	page, err := deps.useCase().ListUsers(context.Background(), input.ListUsersQuery{Query: "alice", Role: "owner", Cursor: "c"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Users) != 1 || page.NextCursor != "next" {
+		t.Errorf("unexpected page: %+v", page)
+	}
+	got := deps.userRepo.ListCalledWith
+	if got.Query != "alice" || got.Role == nil || *got.Role != "owner" || got.Cursor != "c" {
+		t.Errorf("unexpected query: %+v", got)
+	}
+	if got.Limit != constants.DefaultUserListLimit {
+		t.Errorf("expected default limit %d, got %d", constants.DefaultUserListLimit, got.Limit)
+	}
+}
+
+func TestListUsers_InvalidRole(t *testing.T) {
+	deps := newAdminUserTestDeps()
+
+	_, err := deps.useCase().ListUsers(context.Background(), input.ListUsersQuery{Role: "superuser"})
+	if !errors.Is(err, usecase.ErrInvalidRole) {
+		t.Errorf("expected ErrInvalidRole, got %v", err)
+	}
+}
+
+// --- SuspendUser Tests ---
+
+func TestSuspendUser_WithExpiry(t *testing.T) {
+	deps := newAdminUserTestDeps()
+	until := time.Now().Add(72 * time.Hour)
+
+	user, err := deps.useCase().SuspendUser(context.Background(), testAdmin, "user-1", input.SuspendUserInput{Until: &until, Reason: " spam "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !user.IsSuspended(time.Now()) {
+		t.Errorf("expected user to be suspended")
+	}
+	if user.SuspensionReason == nil || *user.SuspensionReason != "spam" {
+		t.Errorf("expected trimmed reason, got %v", user.SuspensionReason)
+	}
+	if !deps.userRepo.UpdateSuspendCalled {
+		t.Errorf("expected suspension to be saved")
+	}
+	if len(deps.eventRepo.Created) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(deps.eventRepo.Created))
+	}
+	event := deps.eventRepo.Created[0]
+	if event.Action != constants.ModerationActionSuspend {
+		t.Errorf("expected suspend action, got %s", event.Action)
+	}
+	if event.AdminID == nil || *event.AdminID != testAdmin.UserID {
+		t.Errorf("expected admin %s, got %v", testAdmin.UserID, event.AdminID)
+	}
+	if event.SuspendedUntil == nil || !event.SuspendedUntil.Equal(until) {
+		t.Errorf("expected expiry to be recorded, got %v", event.SuspendedUntil)
+	}
+}
+
+func TestSuspendUser_WithoutExpiryIsBan(t *testing.T) {
+	deps := newAdminUserTestDeps()
+
+	user, err := deps.useCase().SuspendUser(context.Background(), testAdmin, "user-1", input.SuspendUserInput{Reason: "fraud"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.SuspendedUntil != nil {
+		t.Errorf("expected no expiry, got %v", user.SuspendedUntil)
+	}
+	if !user.IsSuspended(time.Now().AddDate(10, 0, 0)) {
+		t.Errorf("expected ban to never expire")
+	}
+	if deps.eventRepo.Created[0].Action != constants.ModerationActionBan {
+		t.Errorf("expected ban action, got %s", deps.eventRepo.Created[0].Action)
+	}
+}
+
+func TestSuspendUser_Validation(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name    string
+		userID  string
+		in      input.SuspendUserInput
+		wantErr error
+	}{
+		{"reason required", "user-1", input.SuspendUserInput{Reason: "  "}, usecase.ErrModerationReasonRequired},
+		{"expiry in the past", "user-1", input.SuspendUserInput{Until: &past, Reason: "spam"}, usecase.ErrInvalidSuspensionPeriod},
+		{"self", testAdmin.UserID, input.SuspendUserInput{Reason: "spam"}, usecase.ErrCannotModerateSelf},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := newAdminUserTestDeps()
+
+			_, err := deps.useCase().SuspendUser(context.Background(), testAdmin, tt.userID, tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if deps.userRepo.UpdateSuspendCalled || len(deps.eventRepo.Created) != 0 {
+				t.Errorf("expected nothing to be saved")
+			}
+		})
+	}
+}
+
+func TestSuspendUser_UserNotFound(t *testing.T) {
+	deps := newAdminUserTestDeps()
+	deps.userRepo.FindByIDErr = usecase.ErrUserNotFound
+
+	_, err := deps.useCase().SuspendUser(context.Background(), testAdmin, "missing", input.SuspendUserInput{Reason: "spam"})
+	if !errors.Is(err, usecase.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+// --- UnsuspendUser Tests ---
+
+func TestUnsuspendUser_Success(t *testing.T) {
+	deps := newAdminUserTestDeps()
+	suspendedAt := time.Now().Add(-time.Hour)
+	reason := "spam"
+	deps.userRepo.FindByIDResult.SuspendedAt = &suspendedAt
+	deps.userRepo.FindByIDResult.SuspensionReason = &reason
+
+	user, err := deps.useCase().UnsuspendUser(context.Background(), testAdmin, "user-1", input.UnsuspendUserInput{Reason: testutil.StringPtr("appeal accepted")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.SuspendedAt != nil || user.SuspensionReason != nil {
+		t.Errorf("expected suspension to be cleared, got %+v", user)
+	}
+	event := deps.eventRepo.Created[0]
+	if event.Action != constants.ModerationActionUnsuspend {
+		t.Errorf("expected unsuspend action, got %s", event.Action)
+	}
+	if event.Reason == nil || *event.Reason != "appeal accepted" {
+		t.Errorf("expected reason to be recorded, got %v", event.Reason)
+	}
+}
+
+func TestUnsuspendUser_NotSuspended(t *testing.T) {
+	deps := newAdminUserTestDeps()
+	suspendedAt := time.Now().Add(-48 * time.Hour)
+	expired := time.Now().Add(-time.Hour)
+	deps.userRepo.FindByIDResult.SuspendedAt = &suspendedAt
+	deps.userRepo.FindByIDResult.SuspendedUntil = &expired
+
+	_, err := deps.useCase().UnsuspendUser(context.Background(), testAdmin, "user-1", input.UnsuspendUserInput{})
+	if !errors.Is(err, usecase.ErrUserNotSuspended) {
+		t.Errorf("expected ErrUserNotSuspended, got %v", err)
+	}
+}
+
+// --- ChangeUserRole Tests ---
+
+func TestChangeUserRole_Success(t *testing.T) {
+	deps := newAdminUserTestDeps()
+
+	user, err := deps.useCase().ChangeUserRole(context.Background(), testAdmin, "user-1", input.ChangeUserRoleInput{Role: "Owner", Reason: testutil.StringPtr("verified business")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Role != "owner" {
+		t.Errorf("expected role owner, got %s", user.Role)
+	}
+	if deps.authAdmin.UpdateUserCalledWith.UserID != "user-1" {
+		t.Errorf("expected Supabase user-1 to be updated, got %q", deps.authAdmin.UpdateUserCalledWith.UserID)
+	}
+	if got := deps.authAdmin.UpdateUserCalledWith.Input.AppMetadata["role"]; got != "owner" {
+		t.Errorf("expected app_metadata role owner, got %v", got)
+	}
+	if deps.userRepo.UpdateRoleInTxCalledWith.Role != "owner" {
+		t.Errorf("expected DB role owner, got %s", deps.userRepo.UpdateRoleInTxCalledWith.Role)
+	}
+	event := deps.eventRepo.Created[0]
+	if event.Action != constants.ModerationActionChangeRole {
+		t.Errorf("expected change_role action, got %s", event.Action)
+	}
+	if event.FromRole == nil || *event.FromRole != "user" || event.ToRole == nil || *event.ToRole != "owner" {
+		t.Errorf("expected user -> owner, got %v -> %v", event.FromRole, event.ToRole)
+	}
+}
+
+func TestChangeUserRole_SameRoleIsNoop(t *testing.T) {
+	deps := newAdminUserTestDeps()
+
+	if _, err := deps.useCase().ChangeUserRole(context.Background(), testAdmin, "user-1", input.ChangeUserRoleInput{Role: "user"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.authAdmin.UpdateUserCalled || len(deps.eventRepo.Created) != 0 {
+		t.Errorf("expected no update for an unchanged role")
+	}
+}
+
+func TestChangeUserRole_InvalidRole(t *testing.T) {
+	deps := newAdminUserTestDeps()
+
+	_, err := deps.useCase().ChangeUserRole(context.Background(), testAdmin, "user-1", input.ChangeUserRoleInput{Role: "root"})
+	if !errors.Is(err, usecase.ErrInvalidRole) {
+		t.Errorf("expected ErrInvalidRole, got %v", err)
+	}
+}
+
+func TestChangeUserRole_SupabaseError(t *testing.T) {
+	authErr := errors.New("supabase unavailable")
+	deps := newAdminUserTestDeps()
+	deps.authAdmin.UpdateUserErr = authErr
+
+	_, err := deps.useCase().ChangeUserRole(context.Background(), testAdmin, "user-1", input.ChangeUserRoleInput{Role: "owner"})
+	if !errors.Is(err, authErr) {
+		t.Errorf("expected Supabase error, got %v", err)
+	}
+	if deps.userRepo.UpdateRoleInTxCalled {
+		t.Errorf("expected DB role not to be updated")
+	}
+}
+
+func TestChangeUserRole_RestoresSupabaseRoleOnFailure(t *testing.T) {
+	dbErr := errors.New("insert failed")
+	deps := newAdminUserTestDeps()
+	deps.eventRepo.CreateErr = dbErr
+
+	_, err := deps.useCase().ChangeUserRole(context.Background(), testAdmin, "user-1", input.ChangeUserRoleInput{Role: "admin"})
+	if !errors.Is(err, dbErr) {
+		t.Errorf("expected insert error, got %v", err)
+	}
+	if got := deps.authAdmin.UpdateUserCalledWith.Input.AppMetadata["role"]; got != "user" {
+		t.Errorf("expected Supabase role to be restored to user, got %v", got)
+	}
+}
+
+// --- GetModerationHistory Tests ---
+
+func TestGetModerationHistory_Success(t *testing.T) {
+	deps := newAdminUserTestDeps()
+	deps.eventRepo.Events = []entity.UserModerationEvent{
+		{EventID: 2, UserID: "user-1", Action: constants.ModerationActionUnsuspend},
+		{EventID: 1, UserID: "user-1", Action: constants.ModerationActionSuspend},
+	}
+
+	events, err := deps.useCase().GetModerationHistory(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Errorf("expected 2 events, got %d", len(events))
+	}
+	if deps.eventRepo.FindCalledID != "user-1" {
+		t.Errorf("expected history of user-1, got %q", deps.eventRepo.FindCalledID)
+	}
+}
+
+func TestGetModerationHistory_UserNotFound(t *testing.T) {
+	deps := newAdminUserTestDeps()
+	deps.userRepo.FindByIDErr = usecase.ErrUserNotFound
+
+	_, err := deps.useCase().GetModerationHistory(context.Background(), "missing")
+	if !errors.Is(err, usecase.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
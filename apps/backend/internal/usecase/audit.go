@@ -0,0 +1,623 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type requestIDKey struct{}
+
+// WithRequestID はリクエスト ID をコンテキストに設定します。監査ログに記録されます
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+func requestIDFrom(ctx context.Context) *string {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	if !ok || requestID == "" {
+		return nil
+	}
+	return &requestID
+}
+
+// auditRecorder は更新操作の監査ログを記録します。
+// 監査ログは操作のトランザクションが完了した後に記録するため、記録に失敗しても操作の結果は変えずにログ出力だけ行います
+type auditRecorder struct {
+	repo output.AuditLogRepository
+}
+
+func (r auditRecorder) record(ctx context.Context, actor entity.User, action, targetType, targetID string, before, after any) {
+	log := &entity.AuditLog{
+		ActorRole:  actor.Role,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     auditJSON(before),
+		After:      auditJSON(after),
+		RequestID:  requestIDFrom(ctx),
+	}
+	if actor.UserID != "" {
+		log.ActorID = &actor.UserID
+	}
+	// リクエストがキャンセルされても記録できるようにする
+	if err := r.repo.Create(context.WithoutCancel(ctx), log); err != nil {
+		slog.ErrorContext(ctx, "failed to record audit log",
+			"action", action, "target_type", targetType, "target_id", targetID, "error", err)
+	}
+}
+
+func auditJSON(snapshot any) json.RawMessage {
+	if snapshot == nil {
+		return nil
+	}
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil
+	}
+	return raw
+}
+
+// --- Snapshots ---
+// 監査ログには対象の主要な項目だけを snake_case の JSON で残します。nil を返すとその側は記録しません
+
+func storeAuditSnapshot(store *entity.Store) any {
+	if store == nil {
+		return nil
+	}
+	snapshot := map[string]any{
+		"store_id":          store.StoreID,
+		"name":              store.Name,
+		"address":           store.Address,
+		"place_id":          store.PlaceID,
+		"latitude":          store.Latitude,
+		"longitude":         store.Longitude,
+		"thumbnail_file_id": store.ThumbnailFileID,
+		"description":       store.Description,
+		"opening_hours":     store.OpeningHours,
+		"category":          store.Category,
+		"budget":            store.Budget,
+		"tags":              store.Tags,
+		"visibility":        store.Visibility,
+		"approval_status":   store.ApprovalStatus,
+	}
+	if store.PendingEdit != nil {
+		snapshot["pending_edit_id"] = store.PendingEdit.EditID
+	}
+	return snapshot
+}
+
+func reviewAuditSnapshot(review *entity.Review) any {
+	if review == nil {
+		return nil
+	}
+	return map[string]any{
+		"review_id":  review.ReviewID,
+		"store_id":   review.StoreID,
+		"user_id":    review.UserID,
+		"rating":     review.Rating,
+		"visibility": review.Visibility,
+	}
+}
+
+func menuAuditSnapshot(menu *entity.Menu) any {
+	if menu == nil {
+		return nil
+	}
+	return map[string]any{
+		"menu_id":       menu.MenuID,
+		"store_id":      menu.StoreID,
+		"name":          menu.Name,
+		"price":         menu.Price,
+		"description":   menu.Description,
+		"section":       menu.Section,
+		"is_available":  menu.IsAvailable,
+		"image_file_id": menu.ImageFileID,
+		"dietary_tags":  menu.DietaryTags,
+	}
+}
+
+// menuOrderAuditSnapshot はメニューの並び順を menu_id の配列で残します
+func menuOrderAuditSnapshot(menus []entity.Menu) any {
+	if menus == nil {
+		return nil
+	}
+	menuIDs := make([]string, len(menus))
+	for i, menu := range menus {
+		menuIDs[i] = menu.MenuID
+	}
+	return map[string]any{"menu_ids": menuIDs}
+}
+
+func storePhotoAuditSnapshot(photo *entity.StorePhoto) any {
+	if photo == nil {
+		return nil
+	}
+	return map[string]any{
+		"store_id": photo.StoreID,
+		"file_id":  photo.File.FileID,
+		"caption":  photo.Caption,
+		"alt_text": photo.AltText,
+		"is_cover": photo.IsCover,
+	}
+}
+
+func reportAuditSnapshot(report *entity.Report) any {
+	if report == nil {
+		return nil
+	}
+	return map[string]any{
+		"report_id":       report.ReportID,
+		"target_type":     report.TargetType,
+		"target_id":       report.TargetID,
+		"status":          report.Status,
+		"enforcement":     report.Enforcement,
+		"resolution_note": report.ResolutionNote,
+		"resolved_by":     report.ResolvedBy,
+	}
+}
+
+func claimAuditSnapshot(claim *entity.StoreClaim) any {
+	if claim == nil {
+		return nil
+	}
+	return map[string]any{
+		"claim_id":    claim.ClaimID,
+		"store_id":    claim.StoreID,
+		"user_id":     claim.UserID,
+		"status":      claim.Status,
+		"review_note": claim.ReviewNote,
+		"reviewed_by": claim.ReviewedBy,
+	}
+}
+
+func storeEditAuditSnapshot(edit *entity.StoreEdit) any {
+	if edit == nil {
+		return nil
+	}
+	return map[string]any{
+		"edit_id":           edit.EditID,
+		"store_id":          edit.StoreID,
+		"user_id":           edit.UserID,
+		"name":              edit.Name,
+		"address":           edit.Address,
+		"latitude":          edit.Latitude,
+		"longitude":         edit.Longitude,
+		"place_id":          edit.PlaceID,
+		"thumbnail_file_id": edit.ThumbnailFileID,
+		"status":            edit.Status,
+		"review_note":       edit.ReviewNote,
+		"reviewed_by":       edit.ReviewedBy,
+	}
+}
+
+// userAuditSnapshot はロールと利用停止の状態だけを残します。
+// 監査ログは退会後も残るため、名前・メールアドレス・電話番号などの個人情報は記録しません
+func userAuditSnapshot(user *entity.User) any {
+	if user == nil {
+		return nil
+	}
+	return map[string]any{
+		"user_id":         user.UserID,
+		"role":            user.Role,
+		"suspended_at":    user.SuspendedAt,
+		"suspended_until": user.SuspendedUntil,
+	}
+}
+
+// findForAudit は操作前の状態を取得します。取得に失敗した場合は操作前の状態を記録しません
+func findForAudit[T any](find func() (*T, error)) *T {
+	value, err := find()
+	if err != nil {
+		return nil
+	}
+	return value
+}
+
+// --- StoreUseCase ---
+
+type auditedStoreUseCase struct {
+	StoreUseCase
+	storeRepo output.StoreRepository
+	recorder  auditRecorder
+}
+
+// NewAuditedStoreUseCase は店舗の作成・更新・削除を監査ログに記録する StoreUseCase を返します
+func NewAuditedStoreUseCase(inner StoreUseCase, storeRepo output.StoreRepository, auditRepo output.AuditLogRepository) StoreUseCase {
+	return &auditedStoreUseCase{StoreUseCase: inner, storeRepo: storeRepo, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedStoreUseCase) CreateStore(ctx context.Context, actor entity.User, in input.CreateStoreInput) (*entity.Store, error) {
+	store, err := uc.StoreUseCase.CreateStore(ctx, actor, in)
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, actor, constants.AuditActionStoreCreate, constants.AuditTargetStore, store.StoreID, nil, storeAuditSnapshot(store))
+	return store, nil
+}
+
+func (uc *auditedStoreUseCase) UpdateStore(ctx context.Context, actor entity.User, id string, in input.UpdateStoreInput) (*entity.Store, error) {
+	before := findForAudit(func() (*entity.Store, error) { return uc.storeRepo.FindByID(ctx, id) })
+	store, err := uc.StoreUseCase.UpdateStore(ctx, actor, id, in)
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, actor, constants.AuditActionStoreUpdate, constants.AuditTargetStore, id, storeAuditSnapshot(before), storeAuditSnapshot(store))
+	return store, nil
+}
+
+func (uc *auditedStoreUseCase) DeleteStore(ctx context.Context, actor entity.User, id string) error {
+	before := findForAudit(func() (*entity.Store, error) { return uc.storeRepo.FindByID(ctx, id) })
+	if err := uc.StoreUseCase.DeleteStore(ctx, actor, id); err != nil {
+		return err
+	}
+	uc.recorder.record(ctx, actor, constants.AuditActionStoreDelete, constants.AuditTargetStore, id, storeAuditSnapshot(before), nil)
+	return nil
+}
+
+// --- StoreApprovalUseCase ---
+
+type auditedStoreApprovalUseCase struct {
+	StoreApprovalUseCase
+	storeRepo output.StoreRepository
+	recorder  auditRecorder
+}
+
+// NewAuditedStoreApprovalUseCase は店舗の審査申請・承認・却下を監査ログに記録する StoreApprovalUseCase を返します
+func NewAuditedStoreApprovalUseCase(inner StoreApprovalUseCase, storeRepo output.StoreRepository, auditRepo output.AuditLogRepository) StoreApprovalUseCase {
+	return &auditedStoreApprovalUseCase{StoreApprovalUseCase: inner, storeRepo: storeRepo, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedStoreApprovalUseCase) SubmitStore(ctx context.Context, actor entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	return uc.audit(ctx, actor, constants.AuditActionStoreSubmit, storeID, func() (*entity.Store, error) {
+		return uc.StoreApprovalUseCase.SubmitStore(ctx, actor, storeID, in)
+	})
+}
+
+func (uc *auditedStoreApprovalUseCase) ApproveStore(ctx context.Context, admin entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	return uc.audit(ctx, admin, constants.AuditActionStoreApprove, storeID, func() (*entity.Store, error) {
+		return uc.StoreApprovalUseCase.ApproveStore(ctx, admin, storeID, in)
+	})
+}
+
+func (uc *auditedStoreApprovalUseCase) RejectStore(ctx context.Context, admin entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	return uc.audit(ctx, admin, constants.AuditActionStoreReject, storeID, func() (*entity.Store, error) {
+		return uc.StoreApprovalUseCase.RejectStore(ctx, admin, storeID, in)
+	})
+}
+
+func (uc *auditedStoreApprovalUseCase) audit(ctx context.Context, actor entity.User, action, storeID string, run func() (*entity.Store, error)) (*entity.Store, error) {
+	before := findForAudit(func() (*entity.Store, error) { return uc.storeRepo.FindByID(ctx, storeID) })
+	store, err := run()
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, actor, action, constants.AuditTargetStore, storeID, storeAuditSnapshot(before), storeAuditSnapshot(store))
+	return store, nil
+}
+
+// --- AdminUseCase ---
+
+type auditedAdminUseCase struct {
+	AdminUseCase
+	storeRepo  output.StoreRepository
+	reviewRepo output.ReviewRepository
+	recorder   auditRecorder
+}
+
+// NewAuditedAdminUseCase は店舗・レビューの公開状態の変更を監査ログに記録する AdminUseCase を返します
+func NewAuditedAdminUseCase(inner AdminUseCase, storeRepo output.StoreRepository, reviewRepo output.ReviewRepository, auditRepo output.AuditLogRepository) AdminUseCase {
+	return &auditedAdminUseCase{AdminUseCase: inner, storeRepo: storeRepo, reviewRepo: reviewRepo, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedAdminUseCase) SetStoreVisibility(ctx context.Context, admin entity.User, storeID string, visibility string) (*entity.Store, error) {
+	before := findForAudit(func() (*entity.Store, error) { return uc.storeRepo.FindByID(ctx, storeID) })
+	store, err := uc.AdminUseCase.SetStoreVisibility(ctx, admin, storeID, visibility)
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, admin, constants.AuditActionStoreVisibility, constants.AuditTargetStore, storeID, storeAuditSnapshot(before), storeAuditSnapshot(store))
+	return store, nil
+}
+
+func (uc *auditedAdminUseCase) SetReviewVisibility(ctx context.Context, admin entity.User, reviewID string, visibility string) (*entity.Review, error) {
+	before := findForAudit(func() (*entity.Review, error) { return uc.reviewRepo.FindByID(ctx, reviewID) })
+	review, err := uc.AdminUseCase.SetReviewVisibility(ctx, admin, reviewID, visibility)
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, admin, constants.AuditActionReviewVisibility, constants.AuditTargetReview, reviewID, reviewAuditSnapshot(before), reviewAuditSnapshot(review))
+	return review, nil
+}
+
+// --- ReviewUseCase ---
+
+type auditedReviewUseCase struct {
+	input.ReviewUseCase
+	reviewRepo output.ReviewRepository
+	recorder   auditRecorder
+}
+
+// NewAuditedReviewUseCase はレビューの削除を監査ログに記録する ReviewUseCase を返します
+func NewAuditedReviewUseCase(inner input.ReviewUseCase, reviewRepo output.ReviewRepository, auditRepo output.AuditLogRepository) input.ReviewUseCase {
+	return &auditedReviewUseCase{ReviewUseCase: inner, reviewRepo: reviewRepo, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedReviewUseCase) Delete(ctx context.Context, actor entity.User, reviewID string) error {
+	before := findForAudit(func() (*entity.Review, error) { return uc.reviewRepo.FindByID(ctx, reviewID) })
+	if err := uc.ReviewUseCase.Delete(ctx, actor, reviewID); err != nil {
+		return err
+	}
+	uc.recorder.record(ctx, actor, constants.AuditActionReviewDelete, constants.AuditTargetReview, reviewID, reviewAuditSnapshot(before), nil)
+	return nil
+}
+
+// --- MenuUseCase ---
+
+type auditedMenuUseCase struct {
+	MenuUseCase
+	menuRepo output.MenuRepository
+	recorder auditRecorder
+}
+
+// NewAuditedMenuUseCase はメニューの作成・更新・削除・並び替えを監査ログに記録する MenuUseCase を返します
+func NewAuditedMenuUseCase(inner MenuUseCase, menuRepo output.MenuRepository, auditRepo output.AuditLogRepository) MenuUseCase {
+	return &auditedMenuUseCase{MenuUseCase: inner, menuRepo: menuRepo, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedMenuUseCase) CreateMenu(ctx context.Context, actor entity.User, storeID string, in input.CreateMenuInput) (*entity.Menu, error) {
+	menu, err := uc.MenuUseCase.CreateMenu(ctx, actor, storeID, in)
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, actor, constants.AuditActionMenuCreate, constants.AuditTargetMenu, menu.MenuID, nil, menuAuditSnapshot(menu))
+	return menu, nil
+}
+
+func (uc *auditedMenuUseCase) UpdateMenu(ctx context.Context, actor entity.User, storeID string, menuID string, in input.UpdateMenuInput) (*entity.Menu, error) {
+	before := findForAudit(func() (*entity.Menu, error) { return uc.menuRepo.FindByID(ctx, menuID) })
+	menu, err := uc.MenuUseCase.UpdateMenu(ctx, actor, storeID, menuID, in)
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, actor, constants.AuditActionMenuUpdate, constants.AuditTargetMenu, menuID, menuAuditSnapshot(before), menuAuditSnapshot(menu))
+	return menu, nil
+}
+
+func (uc *auditedMenuUseCase) DeleteMenu(ctx context.Context, actor entity.User, storeID string, menuID string) error {
+	before := findForAudit(func() (*entity.Menu, error) { return uc.menuRepo.FindByID(ctx, menuID) })
+	if err := uc.MenuUseCase.DeleteMenu(ctx, actor, storeID, menuID); err != nil {
+		return err
+	}
+	uc.recorder.record(ctx, actor, constants.AuditActionMenuDelete, constants.AuditTargetMenu, menuID, menuAuditSnapshot(before), nil)
+	return nil
+}
+
+// ReorderMenus は店舗を対象に、並び替え前後のメニューの順序を記録します
+func (uc *auditedMenuUseCase) ReorderMenus(ctx context.Context, actor entity.User, storeID string, menuIDs []string) ([]entity.Menu, error) {
+	var before []entity.Menu
+	if current, err := uc.menuRepo.FindByStoreID(ctx, storeID); err == nil {
+		before = current
+	}
+	menus, err := uc.MenuUseCase.ReorderMenus(ctx, actor, storeID, menuIDs)
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, actor, constants.AuditActionMenuReorder, constants.AuditTargetStore, storeID, menuOrderAuditSnapshot(before), menuOrderAuditSnapshot(menus))
+	return menus, nil
+}
+
+// --- StorePhotoUseCase ---
+
+type auditedStorePhotoUseCase struct {
+	StorePhotoUseCase
+	photoRepo output.StorePhotoRepository
+	recorder  auditRecorder
+}
+
+// NewAuditedStorePhotoUseCase は店舗のギャラリーからの写真の削除を監査ログに記録する StorePhotoUseCase を返します
+func NewAuditedStorePhotoUseCase(inner StorePhotoUseCase, photoRepo output.StorePhotoRepository, auditRepo output.AuditLogRepository) StorePhotoUseCase {
+	return &auditedStorePhotoUseCase{StorePhotoUseCase: inner, photoRepo: photoRepo, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedStorePhotoUseCase) DeleteStorePhoto(ctx context.Context, actor entity.User, storeID string, fileID string) error {
+	before := findForAudit(func() (*entity.StorePhoto, error) { return uc.photoRepo.FindByID(ctx, storeID, fileID) })
+	if err := uc.StorePhotoUseCase.DeleteStorePhoto(ctx, actor, storeID, fileID); err != nil {
+		return err
+	}
+	uc.recorder.record(ctx, actor, constants.AuditActionStorePhotoDelete, constants.AuditTargetStorePhoto, fileID, storePhotoAuditSnapshot(before), nil)
+	return nil
+}
+
+// --- MediaUseCase ---
+
+type auditedMediaUseCase struct {
+	MediaUseCase
+	recorder auditRecorder
+}
+
+// NewAuditedMediaUseCase は店舗のギャラリーへの写真の追加を監査ログに記録する MediaUseCase を返します
+func NewAuditedMediaUseCase(inner MediaUseCase, auditRepo output.AuditLogRepository) MediaUseCase {
+	return &auditedMediaUseCase{MediaUseCase: inner, recorder: auditRecorder{repo: auditRepo}}
+}
+
+// CreateStorePhotoUploads はギャラリーに追加した写真ごとに記録します
+func (uc *auditedMediaUseCase) CreateStorePhotoUploads(ctx context.Context, actor entity.User, storeID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error) {
+	uploads, err := uc.MediaUseCase.CreateStorePhotoUploads(ctx, actor, storeID, files)
+	if err != nil {
+		return nil, err
+	}
+	for _, upload := range uploads {
+		photo := &entity.StorePhoto{StoreID: storeID, File: entity.File{FileID: upload.FileID}}
+		uc.recorder.record(ctx, actor, constants.AuditActionStorePhotoAdd, constants.AuditTargetStorePhoto, upload.FileID, nil, storePhotoAuditSnapshot(photo))
+	}
+	return uploads, nil
+}
+
+// --- ReportUseCase ---
+
+type auditedReportUseCase struct {
+	ReportUseCase
+	reportRepo output.ReportRepository
+	recorder   auditRecorder
+}
+
+// NewAuditedReportUseCase は通報への対応を監査ログに記録する ReportUseCase を返します
+func NewAuditedReportUseCase(inner ReportUseCase, reportRepo output.ReportRepository, auditRepo output.AuditLogRepository) ReportUseCase {
+	return &auditedReportUseCase{ReportUseCase: inner, reportRepo: reportRepo, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedReportUseCase) HandleReport(ctx context.Context, admin entity.User, reportID int64, in input.HandleReportInput) (*entity.Report, error) {
+	before := findForAudit(func() (*entity.Report, error) { return uc.reportRepo.FindByID(ctx, reportID) })
+	report, err := uc.ReportUseCase.HandleReport(ctx, admin, reportID, in)
+	if err != nil {
+		return nil, err
+	}
+	action := constants.AuditActionReportResolve
+	if report.Status == constants.ReportStatusRejected {
+		action = constants.AuditActionReportReject
+	}
+	uc.recorder.record(ctx, admin, action, constants.AuditTargetReport, strconv.FormatInt(reportID, 10), reportAuditSnapshot(before), reportAuditSnapshot(report))
+	return report, nil
+}
+
+// --- StoreClaimUseCase ---
+
+type auditedStoreClaimUseCase struct {
+	StoreClaimUseCase
+	claimRepo output.StoreClaimRepository
+	recorder  auditRecorder
+}
+
+// NewAuditedStoreClaimUseCase はオーナー申請の承認・却下を監査ログに記録する StoreClaimUseCase を返します
+func NewAuditedStoreClaimUseCase(inner StoreClaimUseCase, claimRepo output.StoreClaimRepository, auditRepo output.AuditLogRepository) StoreClaimUseCase {
+	return &auditedStoreClaimUseCase{StoreClaimUseCase: inner, claimRepo: claimRepo, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedStoreClaimUseCase) ApproveClaim(ctx context.Context, reviewer entity.User, claimID string, in input.ReviewStoreClaimInput) (*entity.StoreClaim, error) {
+	return uc.audit(ctx, reviewer, constants.AuditActionClaimApprove, claimID, func() (*entity.StoreClaim, error) {
+		return uc.StoreClaimUseCase.ApproveClaim(ctx, reviewer, claimID, in)
+	})
+}
+
+func (uc *auditedStoreClaimUseCase) DenyClaim(ctx context.Context, reviewer entity.User, claimID string, in input.ReviewStoreClaimInput) (*entity.StoreClaim, error) {
+	return uc.audit(ctx, reviewer, constants.AuditActionClaimDeny, claimID, func() (*entity.StoreClaim, error) {
+		return uc.StoreClaimUseCase.DenyClaim(ctx, reviewer, claimID, in)
+	})
+}
+
+func (uc *auditedStoreClaimUseCase) audit(ctx context.Context, reviewer entity.User, action, claimID string, run func() (*entity.StoreClaim, error)) (*entity.StoreClaim, error) {
+	before := findForAudit(func() (*entity.StoreClaim, error) { return uc.claimRepo.FindByID(ctx, claimID) })
+	claim, err := run()
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, reviewer, action, constants.AuditTargetClaim, claimID, claimAuditSnapshot(before), claimAuditSnapshot(claim))
+	return claim, nil
+}
+
+// --- StoreEditUseCase ---
+
+type auditedStoreEditUseCase struct {
+	StoreEditUseCase
+	editRepo output.StoreEditRepository
+	recorder auditRecorder
+}
+
+// NewAuditedStoreEditUseCase は店舗の変更申請の承認・却下を監査ログに記録する StoreEditUseCase を返します
+func NewAuditedStoreEditUseCase(inner StoreEditUseCase, editRepo output.StoreEditRepository, auditRepo output.AuditLogRepository) StoreEditUseCase {
+	return &auditedStoreEditUseCase{StoreEditUseCase: inner, editRepo: editRepo, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedStoreEditUseCase) ApproveStoreEdit(ctx context.Context, reviewer entity.User, editID string, in input.ReviewStoreEditInput) (*entity.StoreEdit, error) {
+	return uc.audit(ctx, reviewer, constants.AuditActionStoreEditApprove, editID, func() (*entity.StoreEdit, error) {
+		return uc.StoreEditUseCase.ApproveStoreEdit(ctx, reviewer, editID, in)
+	})
+}
+
+func (uc *auditedStoreEditUseCase) RejectStoreEdit(ctx context.Context, reviewer entity.User, editID string, in input.ReviewStoreEditInput) (*entity.StoreEdit, error) {
+	return uc.audit(ctx, reviewer, constants.AuditActionStoreEditReject, editID, func() (*entity.StoreEdit, error) {
+		return uc.StoreEditUseCase.RejectStoreEdit(ctx, reviewer, editID, in)
+	})
+}
+
+func (uc *auditedStoreEditUseCase) audit(ctx context.Context, reviewer entity.User, action, editID string, run func() (*entity.StoreEdit, error)) (*entity.StoreEdit, error) {
+	before := findForAudit(func() (*entity.StoreEdit, error) { return uc.editRepo.FindByID(ctx, editID) })
+	edit, err := run()
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, reviewer, action, constants.AuditTargetStoreEdit, editID, storeEditAuditSnapshot(before), storeEditAuditSnapshot(edit))
+	return edit, nil
+}
+
+// --- AdminUserUseCase ---
+
+type auditedAdminUserUseCase struct {
+	AdminUserUseCase
+	userRepo output.UserRepository
+	recorder auditRecorder
+}
+
+// NewAuditedAdminUserUseCase は利用停止・解除・ロール変更を監査ログに記録する AdminUserUseCase を返します
+func NewAuditedAdminUserUseCase(inner AdminUserUseCase, userRepo output.UserRepository, auditRepo output.AuditLogRepository) AdminUserUseCase {
+	return &auditedAdminUserUseCase{AdminUserUseCase: inner, userRepo: userRepo, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedAdminUserUseCase) SuspendUser(ctx context.Context, admin entity.User, userID string, in input.SuspendUserInput) (*entity.User, error) {
+	return uc.audit(ctx, admin, constants.AuditActionUserSuspend, userID, func() (*entity.User, error) {
+		return uc.AdminUserUseCase.SuspendUser(ctx, admin, userID, in)
+	})
+}
+
+func (uc *auditedAdminUserUseCase) UnsuspendUser(ctx context.Context, admin entity.User, userID string, in input.UnsuspendUserInput) (*entity.User, error) {
+	return uc.audit(ctx, admin, constants.AuditActionUserUnsuspend, userID, func() (*entity.User, error) {
+		return uc.AdminUserUseCase.UnsuspendUser(ctx, admin, userID, in)
+	})
+}
+
+func (uc *auditedAdminUserUseCase) ChangeUserRole(ctx context.Context, admin entity.User, userID string, in input.ChangeUserRoleInput) (*entity.User, error) {
+	return uc.audit(ctx, admin, constants.AuditActionUserChangeRole, userID, func() (*entity.User, error) {
+		return uc.AdminUserUseCase.ChangeUserRole(ctx, admin, userID, in)
+	})
+}
+
+func (uc *auditedAdminUserUseCase) audit(ctx context.Context, admin entity.User, action, userID string, run func() (*entity.User, error)) (*entity.User, error) {
+	before := findForAudit(func() (*entity.User, error) {
+		user, err := uc.userRepo.FindByID(ctx, userID)
+		return &user, err
+	})
+	user, err := run()
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, admin, action, constants.AuditTargetUser, userID, userAuditSnapshot(before), userAuditSnapshot(user))
+	return user, nil
+}
+
+// --- OwnerUseCase ---
+
+type auditedOwnerUseCase struct {
+	input.OwnerUseCase
+	recorder auditRecorder
+}
+
+// NewAuditedOwnerUseCase はオーナー登録の完了を監査ログに記録する OwnerUseCase を返します
+func NewAuditedOwnerUseCase(inner input.OwnerUseCase, auditRepo output.AuditLogRepository) input.OwnerUseCase {
+	return &auditedOwnerUseCase{OwnerUseCase: inner, recorder: auditRecorder{repo: auditRepo}}
+}
+
+func (uc *auditedOwnerUseCase) Complete(ctx context.Context, user entity.User, in input.OwnerSignupCompleteInput) (*entity.User, error) {
+	updated, err := uc.OwnerUseCase.Complete(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.record(ctx, user, constants.AuditActionOwnerSignupComplete, constants.AuditTargetUser, user.UserID, userAuditSnapshot(&user), userAuditSnapshot(updated))
+	return updated, nil
+}
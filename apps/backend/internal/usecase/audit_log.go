@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// AuditLogUseCase は監査ログの閲覧のビジネスロジックを提供します
+type AuditLogUseCase interface {
+	ListAuditLogs(ctx context.Context, query input.ListAuditLogsQuery) (*input.AuditLogPage, error)
+}
+
+type auditLogUseCase struct {
+	auditRepo output.AuditLogRepository
+}
+
+// NewAuditLogUseCase は AuditLogUseCase の実装を生成します
+func NewAuditLogUseCase(auditRepo output.AuditLogRepository) AuditLogUseCase {
+	return &auditLogUseCase{auditRepo: auditRepo}
+}
+
+var validAuditTargetTypes = map[string]bool{
+	constants.AuditTargetStore:      true,
+	constants.AuditTargetReview:     true,
+	constants.AuditTargetReport:     true,
+	constants.AuditTargetClaim:      true,
+	constants.AuditTargetStoreEdit:  true,
+	constants.AuditTargetUser:       true,
+	constants.AuditTargetMenu:       true,
+	constants.AuditTargetStorePhoto: true,
+}
+
+// ListAuditLogs は条件に一致する監査ログを新しい順に返します
+func (uc *auditLogUseCase) ListAuditLogs(ctx context.Context, query input.ListAuditLogsQuery) (*input.AuditLogPage, error) {
+	if query.TargetType != "" && !validAuditTargetTypes[query.TargetType] {
+		return nil, ErrInvalidTargetType
+	}
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, ErrInvalidAuditLogPeriod
+	}
+	limit, err := normalizeLimit(query.Limit, constants.DefaultAuditLogListLimit, constants.MaxAuditLogListLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := uc.auditRepo.List(ctx, output.AuditLogQuery{
+		ActorID:    trimmedOrNil(&query.ActorID),
+		Action:     trimmedOrNil(&query.Action),
+		TargetType: trimmedOrNil(&query.TargetType),
+		TargetID:   trimmedOrNil(&query.TargetID),
+		From:       query.From,
+		To:         query.To,
+		Limit:      limit,
+		Cursor:     query.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &input.AuditLogPage{Logs: page.Logs, NextCursor: page.NextCursor}, nil
+}
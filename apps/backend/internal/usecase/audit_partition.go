@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type auditPartitionUseCase struct {
+	auditRepo output.AuditLogRepository
+}
+
+// NewAuditPartitionUseCase は AuditPartitionUseCase の実装を生成します
+func NewAuditPartitionUseCase(auditRepo output.AuditLogRepository) input.AuditPartitionUseCase {
+	return &auditPartitionUseCase{auditRepo: auditRepo}
+}
+
+// CreateAuditLogPartitions は今月から constants.AuditLogPartitionMonthsAhead か月先までのパーティションを作成します。
+// 月のパーティションがないとその月のログは audit_logs_default に入り、後からパーティションを作成できなくなるため、
+// 書き込みが始まる前に作成しておきます
+func (uc *auditPartitionUseCase) CreateAuditLogPartitions(ctx context.Context) error {
+	now := time.Now().UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i <= constants.AuditLogPartitionMonthsAhead; i++ {
+		if err := uc.auditRepo.CreatePartition(ctx, month.AddDate(0, i, 0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+)
+
+func TestCreateAuditLogPartitions_CreatesMonthsAhead(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	uc := usecase.NewAuditPartitionUseCase(auditRepo)
+
+	before := time.Now().UTC()
+	if err := uc.CreateAuditLogPartitions(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	months := auditRepo.PartitionsCreated
+	if len(months) != constants.AuditLogPartitionMonthsAhead+1 {
+		t.Fatalf("expected %d partitions, got %d", constants.AuditLogPartitionMonthsAhead+1, len(months))
+	}
+	first := months[0]
+	if first.Day() != 1 || first.After(before) || before.Sub(first) > 32*24*time.Hour {
+		t.Errorf("expected the first partition to start this month, got %v", first)
+	}
+	for i, month := range months {
+		if want := first.AddDate(0, i, 0); !month.Equal(want) {
+			t.Errorf("expected partition %d to start at %v, got %v", i, want, month)
+		}
+	}
+}
+
+func TestCreateAuditLogPartitions_RepositoryError(t *testing.T) {
+	dbErr := errors.New("database error")
+	uc := usecase.NewAuditPartitionUseCase(&testutil.MockAuditLogRepository{CreatePartitionErr: dbErr})
+
+	if err := uc.CreateAuditLogPartitions(context.Background()); !errors.Is(err, dbErr) {
+		t.Errorf("expected database error, got %v", err)
+	}
+}
@@ -0,0 +1,370 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func decodeAuditSnapshot(t *testing.T, raw json.RawMessage) map[string]any {
+	t.Helper()
+	if raw == nil {
+		return nil
+	}
+	var snapshot map[string]any
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		t.Fatalf("failed to decode audit snapshot: %v", err)
+	}
+	return snapshot
+}
+
+// --- Audited decorators ---
+
+func TestAuditedStoreUseCase_UpdateStore_RecordsBeforeAndAfter(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1", Name: "Old"}}
+	inner := &testutil.MockStoreUseCase{Store: &entity.Store{StoreID: "store-1", Name: "New"}}
+	uc := usecase.NewAuditedStoreUseCase(inner, storeRepo, auditRepo)
+
+	ctx := usecase.WithRequestID(context.Background(), "req-1")
+	name := "New"
+	if _, err := uc.UpdateStore(ctx, testOwner, "store-1", input.UpdateStoreInput{Name: &name}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(auditRepo.Created) != 1 {
+		t.Fatalf("expected 1 audit log, got %d", len(auditRepo.Created))
+	}
+	log := auditRepo.Created[0]
+	if log.Action != constants.AuditActionStoreUpdate || log.TargetType != constants.AuditTargetStore || log.TargetID != "store-1" {
+		t.Errorf("unexpected audit log: %+v", log)
+	}
+	if log.ActorID == nil || *log.ActorID != testOwner.UserID || log.ActorRole != testOwner.Role {
+		t.Errorf("expected actor %s/%s, got %v/%s", testOwner.UserID, testOwner.Role, log.ActorID, log.ActorRole)
+	}
+	if log.RequestID == nil || *log.RequestID != "req-1" {
+		t.Errorf("expected request id req-1, got %v", log.RequestID)
+	}
+	if before := decodeAuditSnapshot(t, log.Before); before["name"] != "Old" {
+		t.Errorf("expected before name Old, got %v", before["name"])
+	}
+	if after := decodeAuditSnapshot(t, log.After); after["name"] != "New" {
+		t.Errorf("expected after name New, got %v", after["name"])
+	}
+}
+
+func TestAuditedStoreUseCase_DeleteStore_RecordsOnlyBefore(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1", Name: "Cafe"}}
+	uc := usecase.NewAuditedStoreUseCase(&testutil.MockStoreUseCase{}, storeRepo, auditRepo)
+
+	if err := uc.DeleteStore(context.Background(), testAdmin, "store-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(auditRepo.Created) != 1 {
+		t.Fatalf("expected 1 audit log, got %d", len(auditRepo.Created))
+	}
+	log := auditRepo.Created[0]
+	if log.Action != constants.AuditActionStoreDelete || log.Before == nil || log.After != nil {
+		t.Errorf("unexpected audit log: %+v", log)
+	}
+	if log.RequestID != nil {
+		t.Errorf("expected no request id, got %v", *log.RequestID)
+	}
+}
+
+func TestAuditedStoreUseCase_InnerError_DoesNotRecord(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	inner := &testutil.MockStoreUseCase{CreateErr: usecase.ErrForbidden}
+	uc := usecase.NewAuditedStoreUseCase(inner, &testutil.MockStoreRepository{}, auditRepo)
+
+	_, err := uc.CreateStore(context.Background(), testOwner, input.CreateStoreInput{Name: "Cafe"})
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+	if len(auditRepo.Created) != 0 {
+		t.Errorf("expected no audit log, got %d", len(auditRepo.Created))
+	}
+}
+
+func TestAuditedStoreUseCase_AuditFailure_DoesNotFailOperation(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{CreateErr: errors.New("db down")}
+	uc := usecase.NewAuditedStoreUseCase(&testutil.MockStoreUseCase{}, &testutil.MockStoreRepository{}, auditRepo)
+
+	store, err := uc.CreateStore(context.Background(), testOwner, input.CreateStoreInput{Name: "Cafe"})
+	if err != nil {
+		t.Fatalf("expected operation to succeed, got %v", err)
+	}
+	if store == nil || store.Name != "Cafe" {
+		t.Errorf("unexpected store: %+v", store)
+	}
+}
+
+func TestAuditedReviewUseCase_Delete_RecordsDeletedReview(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	reviewRepo := &testutil.MockReviewRepository{FindByIDResult: &entity.Review{ReviewID: "review-1", StoreID: "store-1", UserID: "author-1", Rating: 2}}
+	uc := usecase.NewAuditedReviewUseCase(&testutil.MockReviewUseCase{}, reviewRepo, auditRepo)
+
+	if err := uc.Delete(context.Background(), testAdmin, "review-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(auditRepo.Created) != 1 {
+		t.Fatalf("expected 1 audit log, got %d", len(auditRepo.Created))
+	}
+	log := auditRepo.Created[0]
+	if log.Action != constants.AuditActionReviewDelete || log.TargetType != constants.AuditTargetReview || log.TargetID != "review-1" {
+		t.Errorf("unexpected audit log: %+v", log)
+	}
+	if log.ActorID == nil || *log.ActorID != testAdmin.UserID || log.After != nil {
+		t.Errorf("unexpected audit log: %+v", log)
+	}
+	if before := decodeAuditSnapshot(t, log.Before); before["user_id"] != "author-1" {
+		t.Errorf("expected before user_id author-1, got %v", before["user_id"])
+	}
+}
+
+func TestAuditedReviewUseCase_DeleteError_DoesNotRecord(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	uc := usecase.NewAuditedReviewUseCase(&testutil.MockReviewUseCase{DeleteErr: usecase.ErrForbidden}, &testutil.MockReviewRepository{}, auditRepo)
+
+	if err := uc.Delete(context.Background(), testOwner, "review-1"); !errors.Is(err, usecase.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+	if len(auditRepo.Created) != 0 {
+		t.Errorf("expected no audit log, got %d", len(auditRepo.Created))
+	}
+}
+
+func TestAuditedMenuUseCase_RecordsMenuChanges(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	menuRepo := &testutil.MockMenuRepository{
+		FindByIDResult:      &entity.Menu{MenuID: "menu-1", StoreID: "store-1", Name: "Old"},
+		FindByStoreIDResult: []entity.Menu{{MenuID: "menu-1"}, {MenuID: "menu-2"}},
+	}
+	inner := &testutil.MockMenuUseCase{
+		CreateResult:  &entity.Menu{MenuID: "menu-3", StoreID: "store-1", Name: "Coffee"},
+		UpdateResult:  &entity.Menu{MenuID: "menu-1", StoreID: "store-1", Name: "New"},
+		ReorderResult: []entity.Menu{{MenuID: "menu-2"}, {MenuID: "menu-1"}},
+	}
+	uc := usecase.NewAuditedMenuUseCase(inner, menuRepo, auditRepo)
+	ctx := context.Background()
+
+	if _, err := uc.CreateMenu(ctx, testOwner, "store-1", input.CreateMenuInput{Name: "Coffee"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	name := "New"
+	if _, err := uc.UpdateMenu(ctx, testOwner, "store-1", "menu-1", input.UpdateMenuInput{Name: &name}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.DeleteMenu(ctx, testOwner, "store-1", "menu-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.ReorderMenus(ctx, testOwner, "store-1", []string{"menu-2", "menu-1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct{ action, targetType, targetID string }{
+		{constants.AuditActionMenuCreate, constants.AuditTargetMenu, "menu-3"},
+		{constants.AuditActionMenuUpdate, constants.AuditTargetMenu, "menu-1"},
+		{constants.AuditActionMenuDelete, constants.AuditTargetMenu, "menu-1"},
+		{constants.AuditActionMenuReorder, constants.AuditTargetStore, "store-1"},
+	}
+	if len(auditRepo.Created) != len(want) {
+		t.Fatalf("expected %d audit logs, got %d", len(want), len(auditRepo.Created))
+	}
+	for i, w := range want {
+		log := auditRepo.Created[i]
+		if log.Action != w.action || log.TargetType != w.targetType || log.TargetID != w.targetID {
+			t.Errorf("audit log %d: expected %s %s/%s, got %+v", i, w.action, w.targetType, w.targetID, log)
+		}
+	}
+	if before := decodeAuditSnapshot(t, auditRepo.Created[1].Before); before["name"] != "Old" {
+		t.Errorf("expected before name Old, got %v", before["name"])
+	}
+	reorder := auditRepo.Created[3]
+	if before := decodeAuditSnapshot(t, reorder.Before); before["menu_ids"].([]any)[0] != "menu-1" {
+		t.Errorf("expected order before reordering, got %v", before["menu_ids"])
+	}
+	if after := decodeAuditSnapshot(t, reorder.After); after["menu_ids"].([]any)[0] != "menu-2" {
+		t.Errorf("expected order after reordering, got %v", after["menu_ids"])
+	}
+}
+
+func TestAuditedStorePhotoUseCase_DeleteStorePhoto_RecordsRemovedPhoto(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	photoRepo := &testutil.MockStorePhotoRepository{Photos: []entity.StorePhoto{{StoreID: "store-1", File: entity.File{FileID: "file-1"}}}}
+	uc := usecase.NewAuditedStorePhotoUseCase(&testutil.MockStorePhotoUseCase{}, photoRepo, auditRepo)
+
+	if err := uc.DeleteStorePhoto(context.Background(), testOwner, "store-1", "file-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(auditRepo.Created) != 1 {
+		t.Fatalf("expected 1 audit log, got %d", len(auditRepo.Created))
+	}
+	log := auditRepo.Created[0]
+	if log.Action != constants.AuditActionStorePhotoDelete || log.TargetType != constants.AuditTargetStorePhoto || log.TargetID != "file-1" {
+		t.Errorf("unexpected audit log: %+v", log)
+	}
+	if before := decodeAuditSnapshot(t, log.Before); before["store_id"] != "store-1" || log.After != nil {
+		t.Errorf("unexpected audit log: %+v", log)
+	}
+}
+
+func TestAuditedMediaUseCase_CreateStorePhotoUploads_RecordsEachPhoto(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	inner := &testutil.MockMediaUseCase{CreateResult: []input.SignedUploadFile{{FileID: "file-1"}, {FileID: "file-2"}}}
+	uc := usecase.NewAuditedMediaUseCase(inner, auditRepo)
+
+	files := []input.UploadFileInput{{FileName: "a.jpg", ContentType: "image/jpeg"}, {FileName: "b.jpg", ContentType: "image/jpeg"}}
+	if _, err := uc.CreateStorePhotoUploads(context.Background(), testOwner, "store-1", files); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(auditRepo.Created) != 2 {
+		t.Fatalf("expected 2 audit logs, got %d", len(auditRepo.Created))
+	}
+	for i, fileID := range []string{"file-1", "file-2"} {
+		log := auditRepo.Created[i]
+		if log.Action != constants.AuditActionStorePhotoAdd || log.TargetType != constants.AuditTargetStorePhoto || log.TargetID != fileID {
+			t.Errorf("unexpected audit log: %+v", log)
+		}
+		if after := decodeAuditSnapshot(t, log.After); after["store_id"] != "store-1" || log.Before != nil {
+			t.Errorf("unexpected audit log: %+v", log)
+		}
+	}
+
+	// レビュー画像のアップロードは記録しない
+	if _, err := uc.CreateReviewUploads(context.Background(), "store-1", testOwner.UserID, files); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(auditRepo.Created) != 2 {
+		t.Errorf("expected no audit log for review uploads, got %d", len(auditRepo.Created))
+	}
+}
+
+func TestAuditedReportUseCase_HandleReport_RecordsRejection(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	reportRepo := &testutil.MockReportRepository{FindByIDResult: &entity.Report{ReportID: 7, Status: constants.ReportStatusPending}}
+	inner := &testutil.MockReportUseCase{HandleResult: &entity.Report{ReportID: 7, Status: constants.ReportStatusRejected}}
+	uc := usecase.NewAuditedReportUseCase(inner, reportRepo, auditRepo)
+
+	if _, err := uc.HandleReport(context.Background(), testAdmin, 7, input.HandleReportInput{Action: "reject"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(auditRepo.Created) != 1 {
+		t.Fatalf("expected 1 audit log, got %d", len(auditRepo.Created))
+	}
+	log := auditRepo.Created[0]
+	if log.Action != constants.AuditActionReportReject || log.TargetType != constants.AuditTargetReport || log.TargetID != "7" {
+		t.Errorf("unexpected audit log: %+v", log)
+	}
+	if before := decodeAuditSnapshot(t, log.Before); before["status"] != constants.ReportStatusPending {
+		t.Errorf("expected before status pending, got %v", before["status"])
+	}
+}
+
+func TestAuditedAdminUserUseCase_SuspendUser_RecordsSuspension(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{}
+	email := "user@example.com"
+	userRepo := &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-1", Role: "user", Email: email}}
+	until := time.Now().Add(24 * time.Hour)
+	reason := "spam"
+	inner := &testutil.MockAdminUserUseCase{User: &entity.User{UserID: "user-1", Role: "user", Email: email, SuspendedUntil: &until, SuspensionReason: &reason}}
+	uc := usecase.NewAuditedAdminUserUseCase(inner, userRepo, auditRepo)
+
+	if _, err := uc.SuspendUser(context.Background(), testAdmin, "user-1", input.SuspendUserInput{Until: &until, Reason: reason}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(auditRepo.Created) != 1 {
+		t.Fatalf("expected 1 audit log, got %d", len(auditRepo.Created))
+	}
+	log := auditRepo.Created[0]
+	if log.Action != constants.AuditActionUserSuspend || log.TargetID != "user-1" {
+		t.Errorf("unexpected audit log: %+v", log)
+	}
+	before := decodeAuditSnapshot(t, log.Before)
+	if before["suspended_until"] != nil {
+		t.Errorf("expected no suspension before, got %v", before["suspended_until"])
+	}
+	after := decodeAuditSnapshot(t, log.After)
+	if after["suspended_until"] == nil {
+		t.Error("expected suspension after")
+	}
+	// 退会後も残る監査ログに個人情報を残さない
+	for _, snapshot := range []map[string]any{before, after} {
+		if _, ok := snapshot["email"]; ok {
+			t.Errorf("expected no email in the snapshot, got %v", snapshot)
+		}
+	}
+}
+
+// --- ListAuditLogs Tests ---
+
+func TestListAuditLogs_PassesFilters(t *testing.T) {
+	auditRepo := &testutil.MockAuditLogRepository{ListResult: &output.AuditLogPage{
+		Logs:       []entity.AuditLog{{AuditID: 1}},
+		NextCursor: "next",
+	}}
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	page, err := usecase.NewAuditLogUseCase(auditRepo).ListAuditLogs(context.Background(), input.ListAuditLogsQuery{
+		ActorID:    " admin-1 ",
+		TargetType: constants.AuditTargetStore,
+		From:       &from,
+		To:         &to,
+		Cursor:     "c",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Logs) != 1 || page.NextCursor != "next" {
+		t.Errorf("unexpected page: %+v", page)
+	}
+	got := auditRepo.ListCalledWith
+	if got.ActorID == nil || *got.ActorID != "admin-1" || got.Action != nil || got.TargetID != nil {
+		t.Errorf("unexpected filters: %+v", got)
+	}
+	if got.TargetType == nil || *got.TargetType != constants.AuditTargetStore || got.Cursor != "c" {
+		t.Errorf("unexpected filters: %+v", got)
+	}
+	if got.Limit != constants.DefaultAuditLogListLimit {
+		t.Errorf("expected default limit %d, got %d", constants.DefaultAuditLogListLimit, got.Limit)
+	}
+}
+
+func TestListAuditLogs_InvalidQuery(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		query   input.ListAuditLogsQuery
+		wantErr error
+	}{
+		{"unknown target type", input.ListAuditLogsQuery{TargetType: "favorite"}, usecase.ErrInvalidTargetType},
+		{"from after to", input.ListAuditLogsQuery{From: &from, To: &from}, usecase.ErrInvalidAuditLogPeriod},
+		{"negative limit", input.ListAuditLogsQuery{Limit: -1}, usecase.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditRepo := &testutil.MockAuditLogRepository{}
+			_, err := usecase.NewAuditLogUseCase(auditRepo).ListAuditLogs(context.Background(), tt.query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
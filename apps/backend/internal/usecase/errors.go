@@ -10,6 +10,9 @@ var (
 	// ErrStoreNotFound はストアが見つからない場合のエラー
 	ErrStoreNotFound = apperr.New(apperr.CodeNotFound, errors.New("store not found"))
 
+	// ErrStationNotFound は駅が見つからない場合のエラー
+	ErrStationNotFound = apperr.New(apperr.CodeNotFound, errors.New("station not found"))
+
 	// ErrUserNotFound はユーザーが見つからない場合のエラー
 	ErrUserNotFound = apperr.New(apperr.CodeNotFound, errors.New("user not found"))
 
@@ -37,6 +40,48 @@ var (
 	// ErrReviewNotFound はレビューが見つからない場合のエラー
 	ErrReviewNotFound = apperr.New(apperr.CodeNotFound, errors.New("review not found"))
 
+	// ErrVisitNotFound は訪問記録が見つからない場合のエラー
+	ErrVisitNotFound = apperr.New(apperr.CodeNotFound, errors.New("visit not found"))
+
+	// ErrInvalidVisitDate は訪問日が未来の日付の場合のエラー
+	ErrInvalidVisitDate = apperr.New(apperr.CodeInvalidInput, errors.New("visited_on must not be in the future"))
+
+	// ErrVisitNoteTooLong は訪問メモが長すぎる場合のエラー
+	ErrVisitNoteTooLong = apperr.New(apperr.CodeInvalidInput, errors.New("note is too long"))
+
+	// ErrInvalidTag はタグが空・長すぎる・多すぎる場合のエラー
+	ErrInvalidTag = apperr.New(apperr.CodeInvalidInput, errors.New("invalid tags"))
+
+	// ErrInvalidOpeningHours は営業時間帯の重なり・範囲外の時刻・多すぎる特定日などで営業時間が不正な場合のエラー
+	ErrInvalidOpeningHours = apperr.New(apperr.CodeInvalidInput, errors.New("invalid opening hours"))
+
+	// ErrInvalidSearchQuery は検索語が空、または長すぎる場合のエラー
+	ErrInvalidSearchQuery = apperr.New(apperr.CodeInvalidInput, errors.New("invalid search query"))
+
+	// ErrMenuNotFound はメニューが見つからない場合のエラー
+	ErrMenuNotFound = apperr.New(apperr.CodeNotFound, errors.New("menu not found"))
+
+	// ErrInvalidMenuSection はメニュー区分が不正な場合のエラー
+	ErrInvalidMenuSection = apperr.New(apperr.CodeInvalidInput, errors.New("invalid menu section"))
+
+	// ErrInvalidDietaryTag は食事制限・アレルゲンタグが不正な場合のエラー
+	ErrInvalidDietaryTag = apperr.New(apperr.CodeInvalidInput, errors.New("invalid dietary tag"))
+
+	// ErrInvalidMenuOrder は並び替え対象が店舗のメニューと一致しない場合のエラー
+	ErrInvalidMenuOrder = apperr.New(apperr.CodeInvalidInput, errors.New("menu_ids must list every menu of the store exactly once"))
+
+	// ErrStorePhotoNotFound は店舗のギャラリーに写真が見つからない場合のエラー
+	ErrStorePhotoNotFound = apperr.New(apperr.CodeNotFound, errors.New("store photo not found"))
+
+	// ErrTooManyStorePhotos は店舗のギャラリーの写真が上限を超える場合のエラー
+	ErrTooManyStorePhotos = apperr.New(apperr.CodeInvalidInput, errors.New("too many store photos"))
+
+	// ErrInvalidStorePhotoText はキャプション・代替テキストが長すぎる場合のエラー
+	ErrInvalidStorePhotoText = apperr.New(apperr.CodeInvalidInput, errors.New("caption and alt_text must be at most 200 characters"))
+
+	// ErrInvalidStorePhotoOrder は並び替え対象が店舗のギャラリーの写真と一致しない場合のエラー
+	ErrInvalidStorePhotoOrder = apperr.New(apperr.CodeInvalidInput, errors.New("file_ids must list every photo of the store exactly once"))
+
 	// ErrReportNotFound は通報が見つからない場合のエラー
 	ErrReportNotFound = apperr.New(apperr.CodeNotFound, errors.New("report not found"))
 
@@ -46,12 +91,84 @@ var (
 	// ErrInvalidAction はアクションが不正な場合のエラー
 	ErrInvalidAction = apperr.New(apperr.CodeInvalidInput, errors.New("invalid action"))
 
+	// ErrInvalidEnforcement は通報対象に実施できない措置が指定された場合のエラー
+	ErrInvalidEnforcement = apperr.New(apperr.CodeInvalidInput, errors.New("invalid enforcement"))
+
+	// ErrInvalidReportStatus は通報ステータスが不正な場合のエラー
+	ErrInvalidReportStatus = apperr.New(apperr.CodeInvalidInput, errors.New("invalid report status"))
+
+	// ErrInvalidVisibility は公開状態が不正な場合のエラー
+	ErrInvalidVisibility = apperr.New(apperr.CodeInvalidInput, errors.New("invalid visibility"))
+
+	// ErrReportTargetNotFound は通報対象が見つからない場合のエラー
+	ErrReportTargetNotFound = apperr.New(apperr.CodeNotFound, errors.New("report target not found"))
+
+	// ErrReportAlreadyPending は同じ対象への通報が対応待ちの場合のエラー
+	ErrReportAlreadyPending = apperr.New(apperr.CodeConflict, errors.New("report already pending"))
+
+	// ErrReportNotPending は対応済みの通報を再度対応しようとした場合のエラー
+	ErrReportNotPending = apperr.New(apperr.CodeConflict, errors.New("report is not pending"))
+
+	// ErrClaimNotFound はオーナー申請が見つからない場合のエラー
+	ErrClaimNotFound = apperr.New(apperr.CodeNotFound, errors.New("claim not found"))
+
+	// ErrClaimAlreadyPending は同じ店舗への申請が審査待ちの場合のエラー
+	ErrClaimAlreadyPending = apperr.New(apperr.CodeConflict, errors.New("claim already pending"))
+
+	// ErrClaimNotPending は審査済みの申請を再度審査しようとした場合のエラー
+	ErrClaimNotPending = apperr.New(apperr.CodeConflict, errors.New("claim is not pending"))
+
+	// ErrStoreEditNotFound は店舗の変更申請が見つからない場合のエラー
+	ErrStoreEditNotFound = apperr.New(apperr.CodeNotFound, errors.New("store edit not found"))
+
+	// ErrStoreEditNotPending は審査済みの変更を再度審査しようとした場合のエラー
+	ErrStoreEditNotPending = apperr.New(apperr.CodeConflict, errors.New("store edit is not pending"))
+
+	// ErrInvalidStoreEditStatus は変更申請のステータスが不正な場合のエラー
+	ErrInvalidStoreEditStatus = apperr.New(apperr.CodeInvalidInput, errors.New("invalid store edit status"))
+
+	// ErrStoreApprovalTransition は現在の審査状態から要求された遷移ができない場合のエラー
+	ErrStoreApprovalTransition = apperr.New(apperr.CodeConflict, errors.New("store approval status does not allow this transition"))
+
+	// ErrStoreNotApproved は審査で承認されていない店舗を公開しようとした場合のエラー
+	ErrStoreNotApproved = apperr.New(apperr.CodeConflict, errors.New("store must be approved before it is published"))
+
+	// ErrRejectionReasonRequired は却下理由が指定されていない場合のエラー
+	ErrRejectionReasonRequired = apperr.New(apperr.CodeInvalidInput, errors.New("rejection reason is required"))
+
+	// ErrAlreadyStoreOwner は既に店舗のオーナーである場合のエラー
+	ErrAlreadyStoreOwner = apperr.New(apperr.CodeConflict, errors.New("already store owner"))
+
+	// ErrInvalidClaimStatus は申請ステータスが不正な場合のエラー
+	ErrInvalidClaimStatus = apperr.New(apperr.CodeInvalidInput, errors.New("invalid claim status"))
+
 	// ErrUnauthorized は認証エラー
 	ErrUnauthorized = apperr.New(apperr.CodeUnauthorized, errors.New("unauthorized"))
 
 	// ErrForbidden は権限不足エラー
 	ErrForbidden = apperr.New(apperr.CodeForbidden, errors.New("forbidden"))
 
+	// ErrUserSuspended は利用停止中のユーザーがアクセスした場合のエラー
+	ErrUserSuspended = apperr.New(apperr.CodeForbidden, errors.New("user is suspended"))
+
+	// ErrUserNotSuspended は利用停止されていないユーザーの停止を解除しようとした場合のエラー
+	ErrUserNotSuspended = apperr.New(apperr.CodeConflict, errors.New("user is not suspended"))
+
+	// ErrModerationReasonRequired は利用停止の理由が指定されていない場合のエラー
+	ErrModerationReasonRequired = apperr.New(apperr.CodeInvalidInput, errors.New("reason is required"))
+
+	// ErrInvalidSuspensionPeriod は利用停止の期限が過去の場合のエラー
+	ErrInvalidSuspensionPeriod = apperr.New(apperr.CodeInvalidInput, errors.New("suspension must end in the future"))
+
+	// ErrCannotModerateSelf は管理者が自分自身を利用停止・ロール変更しようとした場合のエラー
+	ErrCannotModerateSelf = apperr.New(apperr.CodeForbidden, errors.New("cannot moderate own account"))
+
+	// ErrCannotFollowSelf は自分自身をフォローしようとした場合のエラー
+	ErrCannotFollowSelf = apperr.New(apperr.CodeInvalidInput, errors.New("cannot follow yourself"))
+
+	// ErrInvalidAuditLogPeriod は監査ログの期間指定で開始が終了より後の場合のエラー
+	ErrInvalidAuditLogPeriod = apperr.New(apperr.CodeInvalidInput, errors.New("from must be before to"))
+
 	// ErrUserAlreadyExists はメールアドレス重複時のエラー
 	ErrUserAlreadyExists = apperr.New(apperr.CodeConflict, errors.New("user already exists"))
 
@@ -61,6 +178,15 @@ var (
 	// ErrInvalidContentType は許可されていないContent-Typeの場合のエラー
 	ErrInvalidContentType = apperr.New(apperr.CodeInvalidInput, errors.New("invalid content type: only image files are allowed"))
 
+	// ErrInvalidEvidenceContentType は証拠書類として許可されていないContent-Typeの場合のエラー
+	ErrInvalidEvidenceContentType = apperr.New(apperr.CodeInvalidInput, errors.New("invalid content type: only image or PDF files are allowed"))
+
 	// ErrInvalidFileIDs は無効なファイルIDが指定された場合のエラー
 	ErrInvalidFileIDs = apperr.New(apperr.CodeInvalidInput, errors.New("invalid file IDs"))
+
+	// ErrFileNotFound はファイルが見つからない、または削除済みの場合のエラー
+	ErrFileNotFound = apperr.New(apperr.CodeNotFound, errors.New("file not found"))
+
+	// ErrUploadNotFound はアップロード完了の通知時に Storage にファイルが存在しない場合のエラー
+	ErrUploadNotFound = apperr.New(apperr.CodeConflict, errors.New("uploaded object not found"))
 )
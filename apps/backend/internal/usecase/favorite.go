@@ -34,12 +34,14 @@ func NewFavoriteUseCase(
 	}
 }
 
+// GetMyFavorites はお気に入りのうち、店舗がユーザーから見えるものを返します
 func (uc *favoriteUseCase) GetMyFavorites(ctx context.Context, userID string) ([]entity.Favorite, error) {
-	if err := ensureUserExists(ctx, uc.userRepo, userID); err != nil {
+	user, err := mustFindUser(ctx, uc.userRepo, userID)
+	if err != nil {
 		return nil, err
 	}
 
-	return uc.favoriteRepo.FindByUserID(ctx, userID)
+	return uc.favoriteRepo.FindByUserID(ctx, userID, viewerOf(user))
 }
 
 func (uc *favoriteUseCase) AddFavorite(ctx context.Context, userID string, storeID string) (*entity.Favorite, error) {
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type fileSweepUseCase struct {
+	fileRepo    output.FileRepository
+	storage     output.StorageProvider
+	transaction output.Transaction
+	bucket      string
+	minAge      time.Duration
+}
+
+// NewFileSweepUseCase は FileSweepUseCase の実装を生成します。minAge より新しいファイルは掃除しません
+func NewFileSweepUseCase(
+	fileRepo output.FileRepository,
+	storage output.StorageProvider,
+	transaction output.Transaction,
+	bucket string,
+	minAge time.Duration,
+) input.FileSweepUseCase {
+	return &fileSweepUseCase{
+		fileRepo:    fileRepo,
+		storage:     storage,
+		transaction: transaction,
+		bucket:      bucket,
+		minAge:      minAge,
+	}
+}
+
+// SweepFiles は作成から minAge 以上たったファイルのうち、アップロードが確認されていないもの、
+// またはどの店舗・レビュー・ユーザーからも参照されていないものを論理削除し、Storage から削除します。
+// アップロード完了の通知がなくても Storage にオブジェクトがあるファイルは確認済みにし、参照されていれば残します
+func (uc *fileSweepUseCase) SweepFiles(ctx context.Context) (*input.FileSweepResult, error) {
+	result := &input.FileSweepResult{}
+	createdBefore := time.Now().Add(-uc.minAge)
+
+	for batch := 0; batch < constants.MaxFileSweepBatches; batch++ {
+		files, err := uc.fileRepo.FindSweepable(ctx, createdBefore, constants.FileSweepBatchSize)
+		if err != nil {
+			return result, err
+		}
+
+		var expired []entity.File
+		skipped := false
+		for _, file := range files {
+			if file.UploadedAt != nil {
+				expired = append(expired, file)
+				continue
+			}
+			confirmed, err := uc.confirmIfStored(ctx, file)
+			switch {
+			case err != nil:
+				result.Skipped++
+				skipped = true
+			case confirmed:
+				result.Confirmed++
+			default:
+				expired = append(expired, file)
+			}
+		}
+
+		if err := uc.deleteFiles(ctx, expired); err != nil {
+			return result, err
+		}
+		result.Deleted += len(expired)
+
+		// 確認できなかったファイルは次のバッチでも先頭に来るため、Storage の障害が疑われる場合は次回に回す
+		if len(files) < constants.FileSweepBatchSize || skipped {
+			break
+		}
+	}
+	return result, nil
+}
+
+// confirmIfStored は未確認のファイルのオブジェクトが Storage にあれば確認済みにして true を返します
+func (uc *fileSweepUseCase) confirmIfStored(ctx context.Context, file entity.File) (bool, error) {
+	info, err := uc.storage.StatObject(ctx, uc.bucket, file.ObjectKey)
+	if errors.Is(err, output.ErrObjectNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := uc.fileRepo.MarkUploaded(ctx, file.FileID, *info, time.Now()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// deleteFiles は Storage からオブジェクトを削除してからファイルを論理削除します
+func (uc *fileSweepUseCase) deleteFiles(ctx context.Context, files []entity.File) error {
+	return deleteStoredFiles(ctx, uc.fileRepo, uc.storage, uc.transaction, uc.bucket, files)
+}
+
+// deleteStoredFiles は Storage からオブジェクトを削除してからファイルを論理削除します。
+// 論理削除に失敗しても次回に同じファイルをもう一度削除できるよう、先に Storage から削除する
+func deleteStoredFiles(
+	ctx context.Context,
+	fileRepo output.FileRepository,
+	storage output.StorageProvider,
+	transaction output.Transaction,
+	bucket string,
+	files []entity.File,
+) error {
+	if len(files) == 0 {
+		return nil
+	}
+	fileIDs := make([]string, len(files))
+	objectKeys := make([]string, 0, len(files))
+	for i, file := range files {
+		fileIDs[i] = file.FileID
+		// 画像の派生ファイルも一緒に削除する
+		objectKeys = append(objectKeys, file.ObjectKeys()...)
+	}
+
+	if err := storage.DeleteObjects(ctx, bucket, objectKeys); err != nil {
+		return err
+	}
+	return transaction.StartTransaction(func(tx interface{}) error {
+		return fileRepo.DeleteByIDsInTx(ctx, tx, fileIDs)
+	})
+}
@@ -0,0 +1,100 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func sweepableFile(id string, uploaded bool) entity.File {
+	file := entity.File{FileID: id, ObjectKey: "reviews/" + id}
+	if uploaded {
+		uploadedAt := time.Now().Add(-48 * time.Hour)
+		file.UploadedAt = &uploadedAt
+	}
+	return file
+}
+
+func TestSweepFiles_DeletesAbandonedAndUnreferencedFiles(t *testing.T) {
+	fileRepo := &testutil.MockFileRepository{Sweepable: []entity.File{
+		sweepableFile("unreferenced", true),
+		sweepableFile("never-uploaded", false),
+		sweepableFile("uploaded-late", false),
+	}}
+	storage := &testutil.MockStorageProvider{ObjectsByKey: map[string]output.ObjectInfo{
+		"reviews/uploaded-late": {Size: 100, ContentType: "image/jpeg"},
+	}}
+	uc := usecase.NewFileSweepUseCase(fileRepo, storage, &testutil.MockTransaction{}, "test-bucket", 24*time.Hour)
+
+	result, err := uc.SweepFiles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Confirmed != 1 || result.Deleted != 2 || result.Skipped != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(fileRepo.MarkUploadedCalledWith) != 1 || fileRepo.MarkUploadedCalledWith[0] != "uploaded-late" {
+		t.Errorf("expected uploaded-late to be confirmed, got %v", fileRepo.MarkUploadedCalledWith)
+	}
+	if got := fileRepo.DeletedByIDs; len(got) != 2 || got[0] != "unreferenced" || got[1] != "never-uploaded" {
+		t.Errorf("unexpected deleted files %v", got)
+	}
+	if got := storage.DeletedKeys; len(got) != 2 || got[0] != "reviews/unreferenced" || got[1] != "reviews/never-uploaded" {
+		t.Errorf("unexpected deleted objects %v", got)
+	}
+}
+
+func TestSweepFiles_ProcessesSeveralBatches(t *testing.T) {
+	var files []entity.File
+	for i := 0; i < constants.FileSweepBatchSize+5; i++ {
+		files = append(files, sweepableFile(fmt.Sprintf("file-%03d", i), true))
+	}
+	fileRepo := &testutil.MockFileRepository{Sweepable: files}
+	uc := usecase.NewFileSweepUseCase(fileRepo, &testutil.MockStorageProvider{}, &testutil.MockTransaction{}, "test-bucket", time.Hour)
+
+	result, err := uc.SweepFiles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Deleted != len(files) {
+		t.Errorf("expected %d files to be deleted, got %d", len(files), result.Deleted)
+	}
+}
+
+func TestSweepFiles_StorageUnavailable_SkipsUnconfirmedFiles(t *testing.T) {
+	fileRepo := &testutil.MockFileRepository{Sweepable: []entity.File{sweepableFile("pending", false)}}
+	storage := &testutil.MockStorageProvider{StatErr: errors.New("storage down")}
+	uc := usecase.NewFileSweepUseCase(fileRepo, storage, &testutil.MockTransaction{}, "test-bucket", time.Hour)
+
+	result, err := uc.SweepFiles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Skipped != 1 || result.Deleted != 0 || fileRepo.DeletedByIDs != nil {
+		t.Errorf("expected file to be left for the next sweep, got %+v", result)
+	}
+}
+
+func TestSweepFiles_DeleteObjectsFails_KeepsFiles(t *testing.T) {
+	fileRepo := &testutil.MockFileRepository{Sweepable: []entity.File{sweepableFile("unreferenced", true)}}
+	storageErr := errors.New("storage down")
+	storage := &testutil.MockStorageProvider{DeleteErr: storageErr}
+	uc := usecase.NewFileSweepUseCase(fileRepo, storage, &testutil.MockTransaction{}, "test-bucket", time.Hour)
+
+	_, err := uc.SweepFiles(context.Background())
+	if !errors.Is(err, storageErr) {
+		t.Fatalf("expected storage error, got %v", err)
+	}
+	if fileRepo.DeletedByIDs != nil {
+		t.Errorf("expected files to be kept, got deleted %v", fileRepo.DeletedByIDs)
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type followUseCase struct {
+	followRepo output.FollowRepository
+	userRepo   output.UserRepository
+	reviewRepo output.ReviewRepository
+}
+
+// NewFollowUseCase は FollowUseCase の実装を生成します
+func NewFollowUseCase(
+	followRepo output.FollowRepository,
+	userRepo output.UserRepository,
+	reviewRepo output.ReviewRepository,
+) input.FollowUseCase {
+	return &followUseCase{
+		followRepo: followRepo,
+		userRepo:   userRepo,
+		reviewRepo: reviewRepo,
+	}
+}
+
+// Follow はユーザーをフォローします。フォロー済みの場合は何もしません
+func (uc *followUseCase) Follow(ctx context.Context, follower entity.User, followeeID string) error {
+	if err := validateNotEmpty(follower.UserID, followeeID); err != nil {
+		return err
+	}
+	if follower.UserID == followeeID {
+		return ErrCannotFollowSelf
+	}
+	if _, err := mustFindActiveUser(ctx, uc.userRepo, followeeID); err != nil {
+		return err
+	}
+
+	return uc.followRepo.Create(ctx, follower.UserID, followeeID)
+}
+
+// Unfollow はフォローを解除します。フォローしていない場合は何もしません
+func (uc *followUseCase) Unfollow(ctx context.Context, follower entity.User, followeeID string) error {
+	if err := validateNotEmpty(follower.UserID, followeeID); err != nil {
+		return err
+	}
+
+	return uc.followRepo.Delete(ctx, follower.UserID, followeeID)
+}
+
+// ListFollowers はユーザーのフォロワーを新しい順に返します
+func (uc *followUseCase) ListFollowers(ctx context.Context, userID string, query input.ListFollowsQuery) (*input.FollowPage, error) {
+	listQuery, err := uc.followListQuery(ctx, userID, query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := uc.followRepo.ListFollowers(ctx, userID, listQuery)
+	if err != nil {
+		return nil, err
+	}
+	return &input.FollowPage{Follows: page.Follows, NextCursor: page.NextCursor}, nil
+}
+
+// ListFollowing はユーザーがフォローしているユーザーを新しい順に返します
+func (uc *followUseCase) ListFollowing(ctx context.Context, userID string, query input.ListFollowsQuery) (*input.FollowPage, error) {
+	listQuery, err := uc.followListQuery(ctx, userID, query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := uc.followRepo.ListFollowing(ctx, userID, listQuery)
+	if err != nil {
+		return nil, err
+	}
+	return &input.FollowPage{Follows: page.Follows, NextCursor: page.NextCursor}, nil
+}
+
+func (uc *followUseCase) followListQuery(ctx context.Context, userID string, query input.ListFollowsQuery) (output.FollowListQuery, error) {
+	limit, err := normalizeLimit(query.Limit, constants.DefaultFollowListLimit, constants.MaxFollowListLimit)
+	if err != nil {
+		return output.FollowListQuery{}, err
+	}
+	if _, err := mustFindActiveUser(ctx, uc.userRepo, userID); err != nil {
+		return output.FollowListQuery{}, err
+	}
+	return output.FollowListQuery{Limit: limit, Cursor: query.Cursor}, nil
+}
+
+// GetFeed はフォロー中のユーザーのレビューとお気に入りの店舗のレビューを新しい順に返します
+func (uc *followUseCase) GetFeed(ctx context.Context, user entity.User, query input.FeedQuery) (*input.ReviewPage, error) {
+	if user.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	limit, err := normalizeLimit(query.Limit, constants.DefaultFeedLimit, constants.MaxFeedLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := uc.reviewRepo.FindFeed(ctx, output.ReviewFeedQuery{
+		UserID: user.UserID,
+		Limit:  limit,
+		Cursor: query.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &input.ReviewPage{Reviews: page.Reviews, NextCursor: page.NextCursor}, nil
+}
@@ -0,0 +1,166 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func newFollowTestUseCase() (input.FollowUseCase, *testutil.MockFollowRepository, *testutil.MockUserRepository, *testutil.MockReviewRepository) {
+	followRepo := &testutil.MockFollowRepository{}
+	userRepo := &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-2"}}
+	reviewRepo := &testutil.MockReviewRepository{}
+	return usecase.NewFollowUseCase(followRepo, userRepo, reviewRepo), followRepo, userRepo, reviewRepo
+}
+
+// --- Follow Tests ---
+
+func TestFollow_Success(t *testing.T) {
+	uc, followRepo, _, _ := newFollowTestUseCase()
+
+	if err := uc.Follow(context.Background(), entity.User{UserID: "user-1"}, "user-2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if followRepo.CreateCalledWith.FollowerID != "user-1" || followRepo.CreateCalledWith.FolloweeID != "user-2" {
+		t.Errorf("unexpected follow: %+v", followRepo.CreateCalledWith)
+	}
+}
+
+func TestFollow_Self(t *testing.T) {
+	uc, followRepo, _, _ := newFollowTestUseCase()
+
+	err := uc.Follow(context.Background(), entity.User{UserID: "user-1"}, "user-1")
+	if !errors.Is(err, usecase.ErrCannotFollowSelf) {
+		t.Fatalf("expected ErrCannotFollowSelf, got %v", err)
+	}
+	if followRepo.CreateCalledWith.FollowerID != "" {
+		t.Error("expected no follow to be created")
+	}
+}
+
+func TestFollow_FolloweeNotFound(t *testing.T) {
+	uc, _, userRepo, _ := newFollowTestUseCase()
+	userRepo.FindByIDErr = apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+
+	err := uc.Follow(context.Background(), entity.User{UserID: "user-1"}, "missing")
+	if !errors.Is(err, usecase.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestFollow_FolloweeDeleted(t *testing.T) {
+	uc, followRepo, userRepo, _ := newFollowTestUseCase()
+	deletedAt := time.Now()
+	userRepo.FindByIDResult.DeletedAt = &deletedAt
+
+	err := uc.Follow(context.Background(), entity.User{UserID: "user-1"}, "user-2")
+	if !errors.Is(err, usecase.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+	if followRepo.CreateCalledWith.FollowerID != "" {
+		t.Error("expected no follow to be created")
+	}
+}
+
+func TestUnfollow_Success(t *testing.T) {
+	uc, followRepo, _, _ := newFollowTestUseCase()
+
+	if err := uc.Unfollow(context.Background(), entity.User{UserID: "user-1"}, "user-2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if followRepo.DeleteCalledWith.FollowerID != "user-1" || followRepo.DeleteCalledWith.FolloweeID != "user-2" {
+		t.Errorf("unexpected unfollow: %+v", followRepo.DeleteCalledWith)
+	}
+}
+
+// --- ListFollowers / ListFollowing Tests ---
+
+func TestListFollowers_DefaultLimit(t *testing.T) {
+	uc, followRepo, _, _ := newFollowTestUseCase()
+	followRepo.FollowersResult = &output.FollowPage{
+		Follows:    []entity.Follow{{FollowerID: "user-3", FolloweeID: "user-2"}},
+		NextCursor: "next",
+	}
+
+	page, err := uc.ListFollowers(context.Background(), "user-2", input.ListFollowsQuery{Cursor: "abc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Follows) != 1 || page.NextCursor != "next" {
+		t.Errorf("unexpected page: %+v", page)
+	}
+	want := output.FollowListQuery{Limit: constants.DefaultFollowListLimit, Cursor: "abc"}
+	if followRepo.ListCalledWith.UserID != "user-2" || followRepo.ListCalledWith.Query != want {
+		t.Errorf("unexpected query: %+v", followRepo.ListCalledWith)
+	}
+}
+
+func TestListFollowing_ClampsLimit(t *testing.T) {
+	uc, followRepo, _, _ := newFollowTestUseCase()
+
+	if _, err := uc.ListFollowing(context.Background(), "user-2", input.ListFollowsQuery{Limit: 1000}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if followRepo.ListCalledWith.Query.Limit != constants.MaxFollowListLimit {
+		t.Errorf("expected limit %d, got %d", constants.MaxFollowListLimit, followRepo.ListCalledWith.Query.Limit)
+	}
+}
+
+func TestListFollowers_InvalidLimit(t *testing.T) {
+	uc, _, _, _ := newFollowTestUseCase()
+
+	_, err := uc.ListFollowers(context.Background(), "user-2", input.ListFollowsQuery{Limit: -1})
+	if !errors.Is(err, usecase.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestListFollowing_UserNotFound(t *testing.T) {
+	uc, _, userRepo, _ := newFollowTestUseCase()
+	userRepo.FindByIDErr = apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+
+	_, err := uc.ListFollowing(context.Background(), "missing", input.ListFollowsQuery{})
+	if !errors.Is(err, usecase.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+// --- GetFeed Tests ---
+
+func TestGetFeed_Success(t *testing.T) {
+	uc, _, _, reviewRepo := newFollowTestUseCase()
+	reviewRepo.FeedResult = &output.ReviewPage{
+		Reviews:    []entity.Review{{ReviewID: "review-1", LikedByMe: true}},
+		NextCursor: "next",
+	}
+
+	page, err := uc.GetFeed(context.Background(), entity.User{UserID: "user-1"}, input.FeedQuery{Cursor: "abc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Reviews) != 1 || !page.Reviews[0].LikedByMe || page.NextCursor != "next" {
+		t.Errorf("unexpected page: %+v", page)
+	}
+	want := output.ReviewFeedQuery{UserID: "user-1", Limit: constants.DefaultFeedLimit, Cursor: "abc"}
+	if reviewRepo.FeedCalledWith != want {
+		t.Errorf("expected query %+v, got %+v", want, reviewRepo.FeedCalledWith)
+	}
+}
+
+func TestGetFeed_Unauthorized(t *testing.T) {
+	uc, _, _, _ := newFollowTestUseCase()
+
+	_, err := uc.GetFeed(context.Background(), entity.User{}, input.FeedQuery{})
+	if !errors.Is(err, usecase.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+}
@@ -21,6 +21,20 @@ func IsValidRole(role string) bool {
 	return validRoles[role]
 }
 
+// normalizeLimit は一覧の件数指定を既定値・上限に丸めます。負の値は ErrInvalidInput になります
+func normalizeLimit(limit, defaultLimit, maxLimit int) (int, error) {
+	if limit < 0 {
+		return 0, ErrInvalidInput
+	}
+	if limit == 0 {
+		return defaultLimit, nil
+	}
+	if limit > maxLimit {
+		return maxLimit, nil
+	}
+	return limit, nil
+}
+
 // mustFindStore retrieves a store by ID and returns ErrStoreNotFound if not found.
 func mustFindStore(ctx context.Context, repo output.StoreRepository, storeID string) (*entity.Store, error) {
 	store, err := repo.FindByID(ctx, storeID)
@@ -33,6 +47,41 @@ func mustFindStore(ctx context.Context, repo output.StoreRepository, storeID str
 	return store, nil
 }
 
+// viewerOf は公開範囲の判定に使う閲覧者を返します。ゼロ値のユーザーは未ログインの閲覧者になります
+func viewerOf(user entity.User) output.Viewer {
+	return output.Viewer{UserID: user.UserID, IsAdmin: user.Role == role.Admin}
+}
+
+// ensureStoreVisible checks if the viewer can see the store and returns ErrStoreNotFound if not.
+func ensureStoreVisible(ctx context.Context, repo output.StoreRepository, storeID string, viewer entity.User) error {
+	visible, err := repo.IsVisible(ctx, storeID, viewerOf(viewer))
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return ErrStoreNotFound
+	}
+	return nil
+}
+
+// ensureCanManageStore は actor が店舗を管理できるか確認します。admin は常に許可されます。
+func ensureCanManageStore(ctx context.Context, repo output.StoreOwnerRepository, storeID string, actor entity.User) error {
+	if actor.Role == role.Admin {
+		return nil
+	}
+	if actor.UserID == "" {
+		return ErrUnauthorized
+	}
+	owns, err := repo.IsOwner(ctx, storeID, actor.UserID)
+	if err != nil {
+		return err
+	}
+	if !owns {
+		return ErrForbidden
+	}
+	return nil
+}
+
 // mustFindUser retrieves a user by ID and returns ErrUserNotFound if not found.
 func mustFindUser(ctx context.Context, repo output.UserRepository, userID string) (entity.User, error) {
 	user, err := repo.FindByID(ctx, userID)
@@ -45,6 +94,18 @@ func mustFindUser(ctx context.Context, repo output.UserRepository, userID string
 	return user, nil
 }
 
+// mustFindActiveUser retrieves a user by ID and returns ErrUserNotFound if not found or if the user deleted their account.
+func mustFindActiveUser(ctx context.Context, repo output.UserRepository, userID string) (entity.User, error) {
+	user, err := mustFindUser(ctx, repo, userID)
+	if err != nil {
+		return entity.User{}, err
+	}
+	if user.IsDeleted() {
+		return entity.User{}, ErrUserNotFound
+	}
+	return user, nil
+}
+
 // ensureStoreExists checks if a store exists and returns ErrStoreNotFound if not.
 func ensureStoreExists(ctx context.Context, repo output.StoreRepository, storeID string) error {
 	_, err := mustFindStore(ctx, repo, storeID)
@@ -69,10 +130,16 @@ func mustFindReview(ctx context.Context, repo output.ReviewRepository, reviewID
 	return review, nil
 }
 
-// ensureReviewExists checks if a review exists and returns ErrReviewNotFound if not.
-func ensureReviewExists(ctx context.Context, repo output.ReviewRepository, reviewID string) error {
-	_, err := mustFindReview(ctx, repo, reviewID)
-	return err
+// ensureReviewVisible checks if the review exists and the viewer can see it, and returns ErrReviewNotFound if not.
+func ensureReviewVisible(ctx context.Context, repo output.ReviewRepository, reviewID string, viewer entity.User) error {
+	visible, err := repo.IsVisible(ctx, reviewID, viewerOf(viewer))
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return ErrReviewNotFound
+	}
+	return nil
 }
 
 // mustFindReport retrieves a report by ID and returns ErrReportNotFound if not found.
@@ -87,10 +154,28 @@ func mustFindReport(ctx context.Context, repo output.ReportRepository, reportID
 	return report, nil
 }
 
-// ensureReportExists checks if a report exists and returns ErrReportNotFound if not.
-func ensureReportExists(ctx context.Context, repo output.ReportRepository, reportID int64) error {
-	_, err := mustFindReport(ctx, repo, reportID)
-	return err
+// mustFindClaim retrieves a store claim by ID and returns ErrClaimNotFound if not found.
+func mustFindClaim(ctx context.Context, repo output.StoreClaimRepository, claimID string) (*entity.StoreClaim, error) {
+	claim, err := repo.FindByID(ctx, claimID)
+	if err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return nil, ErrClaimNotFound
+		}
+		return nil, err
+	}
+	return claim, nil
+}
+
+// mustFindStoreEdit retrieves a store edit by ID and returns ErrStoreEditNotFound if not found.
+func mustFindStoreEdit(ctx context.Context, repo output.StoreEditRepository, editID string) (*entity.StoreEdit, error) {
+	edit, err := repo.FindByID(ctx, editID)
+	if err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return nil, ErrStoreEditNotFound
+		}
+		return nil, err
+	}
+	return edit, nil
 }
 
 // validateNotEmpty checks if any of the provided strings are empty.
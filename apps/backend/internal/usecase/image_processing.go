@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type imageProcessingUseCase struct {
+	fileRepo  output.FileRepository
+	storage   output.StorageProvider
+	processor output.ImageProcessor
+	bucket    string
+}
+
+// NewImageProcessingUseCase は ImageProcessingUseCase の実装を生成します
+func NewImageProcessingUseCase(
+	fileRepo output.FileRepository,
+	storage output.StorageProvider,
+	processor output.ImageProcessor,
+	bucket string,
+) input.ImageProcessingUseCase {
+	return &imageProcessingUseCase{
+		fileRepo:  fileRepo,
+		storage:   storage,
+		processor: processor,
+		bucket:    bucket,
+	}
+}
+
+// imageVariantWidths は派生ファイルを作る幅
+var imageVariantWidths = []int{
+	constants.ImageVariantSmallWidth,
+	constants.ImageVariantMediumWidth,
+	constants.ImageVariantLargeWidth,
+}
+
+// ProcessFiles は指定したファイルの画像を加工します。存在しないファイルは読み飛ばし、加工に失敗したファイルは記録して次へ進みます
+func (uc *imageProcessingUseCase) ProcessFiles(ctx context.Context, fileIDs []string) (*input.ImageProcessingResult, error) {
+	result := &input.ImageProcessingResult{}
+	for _, fileID := range fileIDs {
+		file, err := uc.fileRepo.FindByID(ctx, fileID)
+		if errors.Is(err, entity.ErrNotFound) {
+			result.Skipped++
+			continue
+		}
+		if err != nil {
+			return result, err
+		}
+		if err := uc.processOrRecordFailure(ctx, *file, result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// ProcessPending はレビューに添付された未加工の画像を古い順に加工します。残りは次回に回します。
+// 加工に失敗したファイルは失敗した回数を記録して次へ進み、MaxImageProcessingAttempts 回失敗したファイルは以後選びません
+func (uc *imageProcessingUseCase) ProcessPending(ctx context.Context) (*input.ImageProcessingResult, error) {
+	result := &input.ImageProcessingResult{}
+	files, err := uc.fileRepo.FindUnprocessedReviewImages(ctx, constants.MaxImageProcessingAttempts, constants.ImageProcessingBatchSize)
+	if err != nil {
+		return result, err
+	}
+	for _, file := range files {
+		if err := uc.processOrRecordFailure(ctx, file, result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// processOrRecordFailure はファイルを加工し、失敗した場合は失敗した回数を記録します。
+// 1つのファイルの失敗で残りのファイルを止めないよう、ctx が終了した場合だけエラーを返します
+func (uc *imageProcessingUseCase) processOrRecordFailure(ctx context.Context, file entity.File, result *input.ImageProcessingResult) error {
+	err := uc.process(ctx, file, result)
+	if err == nil {
+		return nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	result.Failed++
+	slog.ErrorContext(ctx, "failed to process image", "file_id", file.FileID, "attempt", file.ProcessingAttempts+1, "error", err)
+	if err := uc.fileRepo.RecordProcessingFailure(ctx, file.FileID); err != nil {
+		slog.ErrorContext(ctx, "failed to record image processing failure", "file_id", file.FileID, "error", err)
+	}
+	return nil
+}
+
+// process は画像の EXIF を除いて向きを補正した画像で元のオブジェクトを置き換え、派生ファイルを保存します。
+// 画像として読めないファイルは派生ファイルなしで加工済みにして、繰り返し処理しないようにします
+func (uc *imageProcessingUseCase) process(ctx context.Context, file entity.File, result *input.ImageProcessingResult) error {
+	if file.IsDeleted || file.ProcessedAt != nil || file.ProcessingAttempts >= constants.MaxImageProcessingAttempts ||
+		!isProcessableImage(file) {
+		result.Skipped++
+		return nil
+	}
+
+	content, err := uc.download(ctx, file.ObjectKey)
+	switch {
+	case errors.Is(err, output.ErrObjectNotFound) && file.UploadedAt == nil:
+		// まだアップロードされていない。アップロード後の定期処理で加工する。
+		// アップロード済みなのにオブジェクトがない場合は失敗として扱う
+		result.Skipped++
+		return nil
+	case errors.Is(err, output.ErrUnsupportedImage):
+		result.Unsupported++
+		return uc.fileRepo.MarkProcessed(ctx, file.FileID, output.ProcessedFile{}, time.Now())
+	case err != nil:
+		return err
+	}
+
+	processed, err := uc.processor.Process(content, imageVariantWidths)
+	if errors.Is(err, output.ErrUnsupportedImage) {
+		result.Unsupported++
+		return uc.fileRepo.MarkProcessed(ctx, file.FileID, output.ProcessedFile{}, time.Now())
+	}
+	if err != nil {
+		return err
+	}
+
+	var stored output.ProcessedFile
+	for _, variant := range processed.Variants {
+		objectKey := variantObjectKey(file.ObjectKey, variant)
+		if err := uc.storage.PutObject(ctx, uc.bucket, objectKey, variant.ContentType, variant.Content); err != nil {
+			return err
+		}
+		stored.Variants = append(stored.Variants, entity.FileVariant{
+			Width:       variant.Width,
+			Height:      variant.Height,
+			Format:      variant.Format,
+			ContentType: variant.ContentType,
+			ObjectKey:   objectKey,
+			FileSize:    int64(len(variant.Content)),
+		})
+	}
+	if original := processed.Original; original != nil {
+		if err := uc.storage.PutObject(ctx, uc.bucket, file.ObjectKey, original.ContentType, original.Content); err != nil {
+			return err
+		}
+		stored.Original = &output.ObjectInfo{Size: int64(len(original.Content)), ContentType: original.ContentType}
+	}
+
+	if err := uc.fileRepo.MarkProcessed(ctx, file.FileID, stored, time.Now()); err != nil {
+		return err
+	}
+	result.Processed++
+	return nil
+}
+
+// download はオブジェクトを読み込みます。MaxImageProcessingBytes を超える場合は ErrUnsupportedImage を返します
+func (uc *imageProcessingUseCase) download(ctx context.Context, objectKey string) ([]byte, error) {
+	body, err := uc.storage.GetObject(ctx, uc.bucket, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, io.LimitReader(body, constants.MaxImageProcessingBytes+1)); err != nil {
+		return nil, err
+	}
+	if buf.Len() > constants.MaxImageProcessingBytes {
+		return nil, fmt.Errorf("%w: larger than %d bytes", output.ErrUnsupportedImage, constants.MaxImageProcessingBytes)
+	}
+	return buf.Bytes(), nil
+}
+
+// isProcessableImage は加工の対象になる画像かを返します。証拠書類は公開しないため加工しない
+func isProcessableImage(file entity.File) bool {
+	if file.FileKind == constants.FileKindClaimEvidence {
+		return false
+	}
+	return file.ContentType == nil || strings.HasPrefix(normalizeContentType(*file.ContentType), "image/")
+}
+
+// variantObjectKey は派生ファイルのオブジェクトキー（例: reviews/…/<uuid>_600w.webp）を返します
+func variantObjectKey(objectKey string, variant output.EncodedImage) string {
+	return fmt.Sprintf("%s_%dw.%s", objectKey, variant.Width, variant.Format)
+}
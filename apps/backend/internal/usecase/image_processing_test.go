@@ -0,0 +1,315 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// stubImageProcessor は Result を返し、渡された内容を記録する
+type stubImageProcessor struct {
+	Result     *output.ProcessedImage
+	Err        error
+	CalledWith [][]byte
+	Widths     []int
+}
+
+func (p *stubImageProcessor) Process(content []byte, widths []int) (*output.ProcessedImage, error) {
+	p.CalledWith = append(p.CalledWith, content)
+	p.Widths = widths
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	return p.Result, nil
+}
+
+func processedImage() *output.ProcessedImage {
+	return &output.ProcessedImage{
+		Original: &output.EncodedImage{Width: 800, Height: 600, Format: constants.ImageFormatJPEG, ContentType: "image/jpeg", Content: []byte("clean")},
+		Variants: []output.EncodedImage{
+			{Width: 200, Height: 150, Format: constants.ImageFormatJPEG, ContentType: "image/jpeg", Content: []byte("small-jpeg")},
+			{Width: 200, Height: 150, Format: constants.ImageFormatWebP, ContentType: "image/webp", Content: []byte("small-webp")},
+		},
+	}
+}
+
+func reviewImage(id string) entity.File {
+	contentType := "image/jpeg"
+	return entity.File{FileID: id, ObjectKey: "reviews/" + id, ContentType: &contentType}
+}
+
+func TestProcessPending_StoresVariantsAndCleanOriginal(t *testing.T) {
+	fileRepo := &testutil.MockFileRepository{Unprocessed: []entity.File{reviewImage("file-1")}}
+	storage := &testutil.MockStorageProvider{ObjectContents: map[string][]byte{"reviews/file-1": []byte("raw")}}
+	processor := &stubImageProcessor{Result: processedImage()}
+	uc := usecase.NewImageProcessingUseCase(fileRepo, storage, processor, "test-bucket")
+
+	result, err := uc.ProcessPending(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Processed != 1 || result.Unsupported != 0 || result.Skipped != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(processor.CalledWith) != 1 || string(processor.CalledWith[0]) != "raw" {
+		t.Errorf("expected the uploaded object to be processed, got %q", processor.CalledWith)
+	}
+	if got := processor.Widths; len(got) != 3 || got[0] != constants.ImageVariantSmallWidth || got[2] != constants.ImageVariantLargeWidth {
+		t.Errorf("unexpected widths %v", got)
+	}
+	for key, want := range map[string]string{
+		"reviews/file-1":           "clean",
+		"reviews/file-1_200w.jpeg": "small-jpeg",
+		"reviews/file-1_200w.webp": "small-webp",
+	} {
+		if got := string(storage.PutObjects[key]); got != want {
+			t.Errorf("expected %s to contain %q, got %q", key, want, got)
+		}
+	}
+
+	stored, ok := fileRepo.MarkProcessedCalledWith["file-1"]
+	if !ok {
+		t.Fatal("expected file-1 to be marked as processed")
+	}
+	if stored.Original == nil || stored.Original.Size != int64(len("clean")) || stored.Original.ContentType != "image/jpeg" {
+		t.Errorf("unexpected original %+v", stored.Original)
+	}
+	if len(stored.Variants) != 2 || stored.Variants[1].ObjectKey != "reviews/file-1_200w.webp" || stored.Variants[1].FileSize != int64(len("small-webp")) {
+		t.Errorf("unexpected variants %+v", stored.Variants)
+	}
+}
+
+func TestProcessPending_UnsupportedImageIsMarkedWithoutVariants(t *testing.T) {
+	fileRepo := &testutil.MockFileRepository{Unprocessed: []entity.File{reviewImage("file-1")}}
+	storage := &testutil.MockStorageProvider{ObjectContents: map[string][]byte{"reviews/file-1": []byte("not an image")}}
+	processor := &stubImageProcessor{Err: output.ErrUnsupportedImage}
+	uc := usecase.NewImageProcessingUseCase(fileRepo, storage, processor, "test-bucket")
+
+	result, err := uc.ProcessPending(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Unsupported != 1 || result.Processed != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	stored, ok := fileRepo.MarkProcessedCalledWith["file-1"]
+	if !ok || stored.Original != nil || len(stored.Variants) != 0 {
+		t.Errorf("expected file-1 to be marked as processed without variants, got %+v (%v)", stored, ok)
+	}
+	if len(storage.PutObjects) != 0 {
+		t.Errorf("expected nothing to be stored, got %v", storage.PutObjects)
+	}
+}
+
+func TestProcessPending_TooLargeImageIsNotDecoded(t *testing.T) {
+	fileRepo := &testutil.MockFileRepository{Unprocessed: []entity.File{reviewImage("file-1")}}
+	storage := &testutil.MockStorageProvider{ObjectContents: map[string][]byte{
+		"reviews/file-1": make([]byte, constants.MaxImageProcessingBytes+1),
+	}}
+	processor := &stubImageProcessor{Result: processedImage()}
+	uc := usecase.NewImageProcessingUseCase(fileRepo, storage, processor, "test-bucket")
+
+	result, err := uc.ProcessPending(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Unsupported != 1 || len(processor.CalledWith) != 0 {
+		t.Errorf("expected the image to be skipped as unsupported, got %+v", result)
+	}
+	if _, ok := fileRepo.MarkProcessedCalledWith["file-1"]; !ok {
+		t.Error("expected file-1 to be marked as processed")
+	}
+}
+
+func TestProcessFiles_SkipsFilesThatCannotBeProcessed(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    *entity.File
+		findErr error
+	}{
+		{name: "not found", findErr: entity.ErrNotFound},
+		{name: "not uploaded yet", file: func() *entity.File {
+			f := reviewImage("file-1")
+			f.ObjectKey = "reviews/missing"
+			return &f
+		}()},
+		{name: "already processed", file: func() *entity.File {
+			f := reviewImage("file-1")
+			processedAt := time.Now()
+			f.ProcessedAt = &processedAt
+			return &f
+		}()},
+		{name: "claim evidence", file: func() *entity.File {
+			f := reviewImage("file-1")
+			f.FileKind = constants.FileKindClaimEvidence
+			return &f
+		}()},
+		{name: "not an image", file: func() *entity.File {
+			f := reviewImage("file-1")
+			contentType := "application/pdf"
+			f.ContentType = &contentType
+			return &f
+		}()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fileRepo := &testutil.MockFileRepository{FindByIDResult: tt.file, FindByIDErr: tt.findErr}
+			storage := &testutil.MockStorageProvider{ObjectContents: map[string][]byte{"reviews/file-1": []byte("raw")}}
+			processor := &stubImageProcessor{Result: processedImage()}
+			uc := usecase.NewImageProcessingUseCase(fileRepo, storage, processor, "test-bucket")
+
+			result, err := uc.ProcessFiles(context.Background(), []string{"file-1"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Skipped != 1 || result.Processed != 0 {
+				t.Errorf("unexpected result: %+v", result)
+			}
+			if len(processor.CalledWith) != 0 || len(fileRepo.MarkProcessedCalledWith) != 0 {
+				t.Error("expected the file not to be processed")
+			}
+		})
+	}
+}
+
+func TestProcessFiles_StorageError(t *testing.T) {
+	file := reviewImage("file-1")
+	fileRepo := &testutil.MockFileRepository{FindByIDResult: &file}
+	storage := &testutil.MockStorageProvider{ObjectContents: map[string][]byte{"reviews/file-1": []byte("raw")}, PutErr: errors.New("storage down")}
+	uc := usecase.NewImageProcessingUseCase(fileRepo, storage, &stubImageProcessor{Result: processedImage()}, "test-bucket")
+
+	result, err := uc.ProcessFiles(context.Background(), []string{"file-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Failed != 1 || result.Processed != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(fileRepo.MarkProcessedCalledWith) != 0 {
+		t.Error("expected the file to stay unprocessed so that it is retried")
+	}
+	if len(fileRepo.ProcessingFailures) != 1 || fileRepo.ProcessingFailures[0] != "file-1" {
+		t.Errorf("expected the failure of file-1 to be recorded, got %v", fileRepo.ProcessingFailures)
+	}
+}
+
+func TestProcessPending_ContinuesAfterFailedFile(t *testing.T) {
+	uploadedAt := time.Now()
+	broken := reviewImage("file-1")
+	broken.UploadedAt = &uploadedAt
+	good := reviewImage("file-2")
+	good.UploadedAt = &uploadedAt
+	fileRepo := &testutil.MockFileRepository{Unprocessed: []entity.File{broken, good}}
+	// file-1 は確認済みなのに Storage にオブジェクトがない
+	storage := &testutil.MockStorageProvider{ObjectContents: map[string][]byte{"reviews/file-2": []byte("raw")}}
+	uc := usecase.NewImageProcessingUseCase(fileRepo, storage, &stubImageProcessor{Result: processedImage()}, "test-bucket")
+
+	result, err := uc.ProcessPending(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Failed != 1 || result.Processed != 1 || result.Skipped != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if _, ok := fileRepo.MarkProcessedCalledWith["file-2"]; !ok {
+		t.Error("expected file-2 to be processed after file-1 failed")
+	}
+	if _, ok := fileRepo.MarkProcessedCalledWith["file-1"]; ok {
+		t.Error("expected file-1 to stay unprocessed")
+	}
+	if len(fileRepo.ProcessingFailures) != 1 || fileRepo.ProcessingFailures[0] != "file-1" {
+		t.Errorf("expected the failure of file-1 to be recorded, got %v", fileRepo.ProcessingFailures)
+	}
+}
+
+func TestProcessPending_StopsPickingFileAfterMaxAttempts(t *testing.T) {
+	uploadedAt := time.Now()
+	broken := reviewImage("file-1")
+	broken.UploadedAt = &uploadedAt
+	fileRepo := &testutil.MockFileRepository{Unprocessed: []entity.File{broken}}
+	storage := &testutil.MockStorageProvider{}
+	uc := usecase.NewImageProcessingUseCase(fileRepo, storage, &stubImageProcessor{Result: processedImage()}, "test-bucket")
+
+	for range constants.MaxImageProcessingAttempts + 1 {
+		if _, err := uc.ProcessPending(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if got := len(fileRepo.ProcessingFailures); got != constants.MaxImageProcessingAttempts {
+		t.Errorf("expected %d attempts, got %d", constants.MaxImageProcessingAttempts, got)
+	}
+}
+
+func TestProcessPending_ReturnsWhenContextIsDone(t *testing.T) {
+	fileRepo := &testutil.MockFileRepository{Unprocessed: []entity.File{reviewImage("file-1"), reviewImage("file-2")}}
+	storage := &testutil.MockStorageProvider{GetErr: context.Canceled}
+	uc := usecase.NewImageProcessingUseCase(fileRepo, storage, &stubImageProcessor{Result: processedImage()}, "test-bucket")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := uc.ProcessPending(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(fileRepo.ProcessingFailures) != 0 {
+		t.Errorf("expected no failure to be recorded on shutdown, got %v", fileRepo.ProcessingFailures)
+	}
+}
+
+type recordingImageQueue struct {
+	enqueued [][]string
+}
+
+func (q *recordingImageQueue) Enqueue(fileIDs []string) {
+	q.enqueued = append(q.enqueued, fileIDs)
+}
+
+func TestImageProcessingReviewUseCase_EnqueuesAttachedFiles(t *testing.T) {
+	inner := &testutil.MockReviewUseCase{}
+	queue := &recordingImageQueue{}
+	uc := usecase.NewImageProcessingReviewUseCase(inner, queue)
+
+	if err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{FileIDs: []string{"file-1", "file-2"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.Update(context.Background(), entity.User{UserID: "user-1"}, "review-1", input.UpdateReview{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !inner.CreateCalled {
+		t.Error("expected the inner use case to be called")
+	}
+	if len(queue.enqueued) != 1 || len(queue.enqueued[0]) != 2 || queue.enqueued[0][0] != "file-1" {
+		t.Errorf("expected only the attached files to be enqueued, got %v", queue.enqueued)
+	}
+}
+
+func TestImageProcessingReviewUseCase_DoesNotEnqueueOnError(t *testing.T) {
+	createErr := errors.New("db error")
+	inner := &testutil.MockReviewUseCase{CreateErr: createErr}
+	queue := &recordingImageQueue{}
+	uc := usecase.NewImageProcessingReviewUseCase(inner, queue)
+
+	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{FileIDs: []string{"file-1"}})
+	if !errors.Is(err, createErr) {
+		t.Fatalf("expected create error, got %v", err)
+	}
+	if len(queue.enqueued) != 0 {
+		t.Errorf("expected nothing to be enqueued, got %v", queue.enqueued)
+	}
+}
@@ -0,0 +1,26 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// AccountUseCase defines inbound port for account deletion and data export.
+type AccountUseCase interface {
+	DeleteAccount(ctx context.Context, user entity.User) error
+	ExportAccount(ctx context.Context, user entity.User) (*AccountExport, error)
+}
+
+// AccountExport is the data stored about a user, returned by the data export.
+type AccountExport struct {
+	User       entity.User
+	Reviews    []entity.Review
+	Favorites  []entity.Favorite
+	Likes      []entity.ReviewLike
+	Reports    []entity.Report
+	Files      []entity.File
+	Visits     []entity.Visit
+	ExportedAt time.Time
+}
@@ -0,0 +1,16 @@
+package input
+
+import "context"
+
+// AccountPurgeUseCase defines inbound port for retrying the cleanup of deleted accounts.
+type AccountPurgeUseCase interface {
+	PurgeDeletedAccounts(ctx context.Context) (*AccountPurgeResult, error)
+}
+
+// AccountPurgeResult reports what a purge did.
+type AccountPurgeResult struct {
+	// Purged is the number of deleted users whose auth user and uploaded files were removed.
+	Purged int
+	// Failed is the number of deleted users left for the next purge because a removal failed.
+	Failed int
+}
@@ -9,6 +9,6 @@ import (
 // AdminUseCase defines inbound port for admin operations.
 type AdminUseCase interface {
 	GetPendingStores(ctx context.Context) ([]entity.Store, error)
-	ApproveStore(ctx context.Context, storeID string) error
-	RejectStore(ctx context.Context, storeID string) error
+	SetStoreVisibility(ctx context.Context, admin entity.User, storeID string, visibility string) (*entity.Store, error)
+	SetReviewVisibility(ctx context.Context, admin entity.User, reviewID string, visibility string) (*entity.Review, error)
 }
@@ -0,0 +1,46 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// AdminUserUseCase defines inbound port for admin user management.
+type AdminUserUseCase interface {
+	ListUsers(ctx context.Context, query ListUsersQuery) (*UserPage, error)
+	SuspendUser(ctx context.Context, admin entity.User, userID string, input SuspendUserInput) (*entity.User, error)
+	UnsuspendUser(ctx context.Context, admin entity.User, userID string, input UnsuspendUserInput) (*entity.User, error)
+	ChangeUserRole(ctx context.Context, admin entity.User, userID string, input ChangeUserRoleInput) (*entity.User, error)
+	GetModerationHistory(ctx context.Context, userID string) ([]entity.UserModerationEvent, error)
+}
+
+// ListUsersQuery carries the search, role filter and cursor of the admin user listing.
+type ListUsersQuery struct {
+	Query  string
+	Role   string
+	Limit  int
+	Cursor string
+}
+
+// UserPage is a single page of the admin user listing.
+type UserPage struct {
+	Users      []entity.User
+	NextCursor string
+}
+
+// SuspendUserInput suspends a user until Until, or indefinitely when Until is nil.
+type SuspendUserInput struct {
+	Until  *time.Time
+	Reason string
+}
+
+type UnsuspendUserInput struct {
+	Reason *string
+}
+
+type ChangeUserRoleInput struct {
+	Role   string
+	Reason *string
+}
@@ -0,0 +1,32 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// AuditLogUseCase defines inbound port for browsing the audit log.
+type AuditLogUseCase interface {
+	ListAuditLogs(ctx context.Context, query ListAuditLogsQuery) (*AuditLogPage, error)
+}
+
+// ListAuditLogsQuery carries the filters and cursor of the audit log listing.
+// Empty strings and nil times mean no filter. From is inclusive and To is exclusive.
+type ListAuditLogsQuery struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	Cursor     string
+}
+
+// AuditLogPage is a single page of the audit log listing.
+type AuditLogPage struct {
+	Logs       []entity.AuditLog
+	NextCursor string
+}
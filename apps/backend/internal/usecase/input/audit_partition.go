@@ -0,0 +1,8 @@
+package input
+
+import "context"
+
+// AuditPartitionUseCase defines inbound port for keeping the monthly audit log partitions ahead of time.
+type AuditPartitionUseCase interface {
+	CreateAuditLogPartitions(ctx context.Context) error
+}
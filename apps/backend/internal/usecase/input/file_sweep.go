@@ -0,0 +1,18 @@
+package input
+
+import "context"
+
+// FileSweepUseCase defines inbound port for cleaning up abandoned uploads.
+type FileSweepUseCase interface {
+	SweepFiles(ctx context.Context) (*FileSweepResult, error)
+}
+
+// FileSweepResult reports what a sweep did.
+type FileSweepResult struct {
+	// Confirmed is the number of unconfirmed files whose object was found in storage and marked as uploaded.
+	Confirmed int
+	// Deleted is the number of files that were soft-deleted and removed from storage.
+	Deleted int
+	// Skipped is the number of files left for the next sweep because storage could not be checked.
+	Skipped int
+}
@@ -0,0 +1,40 @@
+package input
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// FollowUseCase defines inbound port for following users and the review feed.
+type FollowUseCase interface {
+	Follow(ctx context.Context, follower entity.User, followeeID string) error
+	Unfollow(ctx context.Context, follower entity.User, followeeID string) error
+	ListFollowers(ctx context.Context, userID string, query ListFollowsQuery) (*FollowPage, error)
+	ListFollowing(ctx context.Context, userID string, query ListFollowsQuery) (*FollowPage, error)
+	GetFeed(ctx context.Context, user entity.User, query FeedQuery) (*ReviewPage, error)
+}
+
+// ListFollowsQuery carries the cursor of the follower and following listings.
+type ListFollowsQuery struct {
+	Limit  int
+	Cursor string
+}
+
+// FollowPage is a single page of a follower or following listing.
+type FollowPage struct {
+	Follows    []entity.Follow
+	NextCursor string
+}
+
+// FeedQuery carries the cursor of the review feed.
+type FeedQuery struct {
+	Limit  int
+	Cursor string
+}
+
+// ReviewPage is a single page of reviews.
+type ReviewPage struct {
+	Reviews    []entity.Review
+	NextCursor string
+}
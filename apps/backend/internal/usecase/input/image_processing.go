@@ -0,0 +1,25 @@
+package input
+
+import "context"
+
+// ImageProcessingUseCase defines inbound port for stripping metadata from uploaded images and creating their variants.
+type ImageProcessingUseCase interface {
+	// ProcessFiles processes the images of the given files.
+	ProcessFiles(ctx context.Context, fileIDs []string) (*ImageProcessingResult, error)
+	// ProcessPending processes the images attached to reviews that have not been processed yet.
+	ProcessPending(ctx context.Context) (*ImageProcessingResult, error)
+}
+
+// ImageProcessingResult reports what image processing did.
+type ImageProcessingResult struct {
+	// Processed is the number of images whose metadata was stripped and whose variants were stored.
+	Processed int
+	// Unsupported is the number of files marked as processed without variants because they could not be decoded.
+	Unsupported int
+	// Skipped is the number of files left as they are: deleted, already processed, not images, not uploaded yet
+	// or failed too many times.
+	Skipped int
+	// Failed is the number of files that could not be processed this time. Their failures are counted so that
+	// files failing repeatedly stop being picked up.
+	Failed int
+}
@@ -1,10 +1,17 @@
 package input
 
-import "context"
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
 
 // MediaUseCase defines inbound port for media uploads.
 type MediaUseCase interface {
 	CreateReviewUploads(ctx context.Context, storeID string, userID string, files []UploadFileInput) ([]SignedUploadFile, error)
+	CreateClaimUploads(ctx context.Context, storeID string, userID string, files []UploadFileInput) ([]SignedUploadFile, error)
+	CreateStorePhotoUploads(ctx context.Context, actor entity.User, storeID string, files []UploadFileInput) ([]SignedUploadFile, error)
+	CompleteUpload(ctx context.Context, actor entity.User, fileID string) (*entity.File, error)
 }
 
 type UploadFileInput struct {
@@ -14,9 +21,12 @@ type UploadFileInput struct {
 }
 
 type SignedUploadFile struct {
-	FileID      string
-	ObjectKey   string
-	Path        string
-	Token       string
-	ContentType string
+	FileID    string
+	ObjectKey string
+	Path      string
+	Token     string
+	// UploadURL は内容を PUT で送る先。UploadHeaders をすべて付けて送る
+	UploadURL     string
+	UploadHeaders map[string]string
+	ContentType   string
 }
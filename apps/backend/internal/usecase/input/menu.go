@@ -8,12 +8,32 @@ import (
 
 // MenuUseCase defines inbound port for menu operations.
 type MenuUseCase interface {
-	GetMenusByStoreID(ctx context.Context, storeID string) ([]entity.Menu, error)
-	CreateMenu(ctx context.Context, storeID string, input CreateMenuInput) (*entity.Menu, error)
+	GetMenusByStoreID(ctx context.Context, viewer entity.User, storeID string) ([]entity.Menu, error)
+	CreateMenu(ctx context.Context, actor entity.User, storeID string, input CreateMenuInput) (*entity.Menu, error)
+	UpdateMenu(ctx context.Context, actor entity.User, storeID string, menuID string, input UpdateMenuInput) (*entity.Menu, error)
+	DeleteMenu(ctx context.Context, actor entity.User, storeID string, menuID string) error
+	ReorderMenus(ctx context.Context, actor entity.User, storeID string, menuIDs []string) ([]entity.Menu, error)
 }
 
+// CreateMenuInput describes a new menu. IsAvailable defaults to true when nil.
 type CreateMenuInput struct {
 	Name        string
 	Price       *int
 	Description *string
+	Section     *string
+	IsAvailable *bool
+	ImageFileID *string
+	DietaryTags []string
+}
+
+// UpdateMenuInput describes a partial menu update. Nil fields are left unchanged.
+// An empty Section or ImageFileID clears the value, and a non-nil DietaryTags replaces all tags.
+type UpdateMenuInput struct {
+	Name        *string
+	Price       *int
+	Description *string
+	Section     *string
+	IsAvailable *bool
+	ImageFileID *string
+	DietaryTags []string
 }
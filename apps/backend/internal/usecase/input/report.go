@@ -16,13 +16,35 @@ const (
 type CreateReportInput struct {
 	UserID     string
 	TargetType string
-	TargetID   int64
+	TargetID   string
 	Reason     string
 }
 
+// HandleReportInput carries the admin's decision on a report.
+// Enforcement is only allowed when resolving and must match the target type.
+type HandleReportInput struct {
+	Action      HandleReportAction
+	Enforcement *string
+	Note        *string
+}
+
+// ListReportsQuery describes the admin report listing. Empty filters match everything.
+type ListReportsQuery struct {
+	Status     string
+	TargetType string
+	Limit      int
+	Cursor     string
+}
+
+// ReportPage is a single page of the report listing.
+type ReportPage struct {
+	Reports    []entity.Report
+	NextCursor string
+}
+
 // ReportUseCase defines inbound port for report operations.
 type ReportUseCase interface {
 	CreateReport(ctx context.Context, input CreateReportInput) (*entity.Report, error)
-	GetAllReports(ctx context.Context) ([]entity.Report, error)
-	HandleReport(ctx context.Context, reportID int64, action HandleReportAction) error
+	ListReports(ctx context.Context, query ListReportsQuery) (*ReportPage, error)
+	HandleReport(ctx context.Context, admin entity.User, reportID int64, input HandleReportInput) (*entity.Report, error)
 }
@@ -8,8 +8,11 @@ import (
 
 // ReviewUseCase defines inbound port for review operations.
 type ReviewUseCase interface {
-	GetReviewsByStoreID(ctx context.Context, storeID string, sort string, viewerID string) ([]entity.Review, error)
+	GetReviewsByStoreID(ctx context.Context, storeID string, sort string, viewer entity.User) ([]entity.Review, error)
 	Create(ctx context.Context, storeID string, userID string, input CreateReview) error
+	Update(ctx context.Context, actor entity.User, reviewID string, input UpdateReview) error
+	Delete(ctx context.Context, actor entity.User, reviewID string) error
+	GetRatingSummary(ctx context.Context, storeID string, viewer entity.User) (*entity.RatingSummary, error)
 	LikeReview(ctx context.Context, reviewID string, userID string) error
 	UnlikeReview(ctx context.Context, reviewID string, userID string) error
 }
@@ -29,3 +32,12 @@ type CreateReview struct {
 	Content       *string        `json:"content"`
 	FileIDs       []string       `json:"file_ids"`
 }
+
+// UpdateReview は編集後のレビュー内容です。メニュー・ファイルの紐付けも指定した内容で置き換えます
+type UpdateReview struct {
+	MenuIDs       []string       `json:"menu_ids"`
+	Rating        int            `json:"rating"`
+	RatingDetails *RatingDetails `json:"rating_details,omitempty"`
+	Content       *string        `json:"content"`
+	FileIDs       []string       `json:"file_ids"`
+}
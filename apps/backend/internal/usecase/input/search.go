@@ -0,0 +1,28 @@
+package input
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// SearchUseCase defines inbound port for keyword search.
+type SearchUseCase interface {
+	Search(ctx context.Context, query SearchQuery) (*SearchPage, error)
+}
+
+// SearchQuery represents the query parameters of a keyword search.
+// An empty Type searches both stores and reviews.
+type SearchQuery struct {
+	Q      string
+	Type   string
+	Limit  int
+	Cursor string
+}
+
+// SearchPage is a single page of search results ordered by relevance.
+// NextCursor is empty when there are no more results.
+type SearchPage struct {
+	Results    []entity.SearchResult
+	NextCursor string
+}
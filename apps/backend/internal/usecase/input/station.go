@@ -7,5 +7,14 @@ import (
 )
 
 type StationUseCase interface {
-	ListStations(ctx context.Context) ([]entity.Station, error)
+	ListStations(ctx context.Context, query StationQuery) ([]entity.Station, error)
+	GroupStationsByKind(ctx context.Context, query StationQuery) ([]entity.StationGroup, error)
+	FindNearestStations(ctx context.Context, lat, lng float64, limit int) ([]entity.Station, error)
+}
+
+// StationQuery represents the station list filters.
+// Q is a prefix matched against both name and kana; katakana is accepted for kana.
+type StationQuery struct {
+	Q    string
+	Kind string
 }
@@ -5,39 +5,83 @@ import (
 	"time"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
 
 // StoreUseCase defines inbound port for store operations.
 type StoreUseCase interface {
-	GetAllStores(ctx context.Context) ([]entity.Store, error)
-	GetStoreByID(ctx context.Context, id string) (*entity.Store, error)
-	CreateStore(ctx context.Context, input CreateStoreInput) (*entity.Store, error)
-	UpdateStore(ctx context.Context, id string, input UpdateStoreInput) (*entity.Store, error)
-	DeleteStore(ctx context.Context, id string) error
+	GetAllStores(ctx context.Context, viewer entity.User) ([]entity.Store, error)
+	ListStores(ctx context.Context, viewer entity.User, query ListStoresQuery) (*StorePage, error)
+	FindNearbyStores(ctx context.Context, viewer entity.User, query NearbyStoresQuery) ([]entity.Store, error)
+	GetStoreByID(ctx context.Context, viewer entity.User, id string) (*entity.Store, error)
+	ListOwnedStores(ctx context.Context, userID string) ([]entity.Store, error)
+	CreateStore(ctx context.Context, actor entity.User, input CreateStoreInput) (*entity.Store, error)
+	UpdateStore(ctx context.Context, actor entity.User, id string, input UpdateStoreInput) (*entity.Store, error)
+	DeleteStore(ctx context.Context, actor entity.User, id string) error
 }
 
+// ListStoresQuery represents the query parameters for a store listing.
+// Nil filters are not applied.
+type ListStoresQuery struct {
+	Limit       int
+	Cursor      string
+	Category    *string
+	Budget      *string
+	Tag         *string
+	MinRating   *float64
+	OpenedAfter *time.Time
+	IsApproved  *bool
+	// OpenNow keeps only the stores open at the time of the request.
+	OpenNow bool
+	Sort    string
+}
+
+// NearbyStoresQuery represents a radius search around either a point or a station.
+// Exactly one of (Latitude, Longitude) and StationID must be set.
+type NearbyStoresQuery struct {
+	Latitude     *float64
+	Longitude    *float64
+	StationID    *int64
+	RadiusMeters int
+	Limit        int
+	OpenNow      bool
+}
+
+// StorePage is an alias to output.StorePage to avoid type duplication.
+type StorePage = output.StorePage
+
 type CreateStoreInput struct {
-	Name            string
-	Address         string
-	ThumbnailFileID *string
-	OpenedAt        *time.Time
-	Description     *string
-	OpeningHours    *string
+	Name         string
+	NameKana     *string
+	Address      string
+	OpenedAt     *time.Time
+	Description  *string
+	OpeningHours *string
+	// OpeningSchedule is the structured opening hours. When nil, it is parsed from OpeningHours on a best-effort basis.
+	OpeningSchedule *entity.OpeningSchedule
 	Latitude        float64
 	Longitude       float64
 	GoogleMapURL    *string
 	PlaceID         string
+	Tags            []string
 }
 
+// UpdateStoreInput holds the fields to change. Nil fields are left as they are;
+// an empty NameKana clears the reading.
 type UpdateStoreInput struct {
-	Name            *string
-	Address         *string
-	ThumbnailFileID *string
-	OpenedAt        *time.Time
-	Description     *string
-	OpeningHours    *string
+	Name         *string
+	NameKana     *string
+	Address      *string
+	OpenedAt     *time.Time
+	Description  *string
+	OpeningHours *string
+	// OpeningSchedule replaces the structured opening hours when non-nil; an empty schedule removes them.
+	// When only OpeningHours is given, the schedule is parsed again from the new text.
+	OpeningSchedule *entity.OpeningSchedule
 	Latitude        *float64
 	Longitude       *float64
 	GoogleMapURL    *string
 	PlaceID         *string
+	// Tags replaces every tag of the store when non-nil. An empty slice removes all tags.
+	Tags []string
 }
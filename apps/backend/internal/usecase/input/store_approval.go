@@ -0,0 +1,21 @@
+package input
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// StoreApprovalUseCase defines inbound port for the store approval workflow.
+type StoreApprovalUseCase interface {
+	SubmitStore(ctx context.Context, actor entity.User, storeID string, input StoreApprovalInput) (*entity.Store, error)
+	GetApprovalHistory(ctx context.Context, actor entity.User, storeID string) ([]entity.StoreApprovalEvent, error)
+	ApproveStore(ctx context.Context, admin entity.User, storeID string, input StoreApprovalInput) (*entity.Store, error)
+	RejectStore(ctx context.Context, admin entity.User, storeID string, input StoreApprovalInput) (*entity.Store, error)
+}
+
+// StoreApprovalInput carries the free-text reason recorded with a transition.
+// It is required when rejecting a store and optional otherwise.
+type StoreApprovalInput struct {
+	Reason *string
+}
@@ -0,0 +1,28 @@
+package input
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// StoreClaimUseCase defines inbound port for store claim operations.
+type StoreClaimUseCase interface {
+	SubmitClaim(ctx context.Context, actor entity.User, storeID string, input SubmitStoreClaimInput) (*entity.StoreClaim, error)
+	ListClaims(ctx context.Context, status string) ([]entity.StoreClaim, error)
+	GetClaim(ctx context.Context, claimID string) (*entity.StoreClaim, error)
+	ApproveClaim(ctx context.Context, reviewer entity.User, claimID string, input ReviewStoreClaimInput) (*entity.StoreClaim, error)
+	DenyClaim(ctx context.Context, reviewer entity.User, claimID string, input ReviewStoreClaimInput) (*entity.StoreClaim, error)
+}
+
+// SubmitStoreClaimInput carries the evidence attached to a claim.
+// FileIDs must reference files uploaded by the claimant through the claim upload endpoint.
+type SubmitStoreClaimInput struct {
+	Note    string
+	FileIDs []string
+}
+
+// ReviewStoreClaimInput carries the admin's optional comment on approval or denial.
+type ReviewStoreClaimInput struct {
+	Note *string
+}
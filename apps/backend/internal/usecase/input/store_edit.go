@@ -0,0 +1,20 @@
+package input
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// StoreEditUseCase defines inbound port for reviewing store edits awaiting approval.
+type StoreEditUseCase interface {
+	ListStoreEdits(ctx context.Context, status string) ([]entity.StoreEdit, error)
+	GetStoreEdit(ctx context.Context, editID string) (*entity.StoreEdit, error)
+	ApproveStoreEdit(ctx context.Context, reviewer entity.User, editID string, input ReviewStoreEditInput) (*entity.StoreEdit, error)
+	RejectStoreEdit(ctx context.Context, reviewer entity.User, editID string, input ReviewStoreEditInput) (*entity.StoreEdit, error)
+}
+
+// ReviewStoreEditInput carries the admin's optional comment on approval or rejection.
+type ReviewStoreEditInput struct {
+	Note *string
+}
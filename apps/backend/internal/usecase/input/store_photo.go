@@ -0,0 +1,22 @@
+package input
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// StorePhotoUseCase defines inbound port for store gallery operations.
+type StorePhotoUseCase interface {
+	ListStorePhotos(ctx context.Context, viewer entity.User, storeID string) ([]entity.StorePhoto, error)
+	UpdateStorePhoto(ctx context.Context, actor entity.User, storeID string, fileID string, input UpdateStorePhotoInput) (*entity.StorePhoto, error)
+	ReorderStorePhotos(ctx context.Context, actor entity.User, storeID string, fileIDs []string) ([]entity.StorePhoto, error)
+	SetStoreCover(ctx context.Context, actor entity.User, storeID string, fileID string) (*entity.Store, error)
+	DeleteStorePhoto(ctx context.Context, actor entity.User, storeID string, fileID string) error
+}
+
+// UpdateStorePhotoInput describes a partial photo update. Nil fields are left unchanged and an empty value clears the field.
+type UpdateStorePhotoInput struct {
+	Caption *string
+	AltText *string
+}
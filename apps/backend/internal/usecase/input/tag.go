@@ -0,0 +1,13 @@
+package input
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// TagUseCase defines inbound port for store tag operations.
+type TagUseCase interface {
+	ListTags(ctx context.Context) ([]entity.TagCount, error)
+	ListStoresByTag(ctx context.Context, tag string, query ListStoresQuery) (*StorePage, error)
+}
@@ -13,7 +13,16 @@ type UserUseCase interface {
 	EnsureUser(ctx context.Context, input EnsureUserInput) (entity.User, error)
 	UpdateUser(ctx context.Context, userID string, input UpdateUserInput) (entity.User, error)
 	UpdateUserRole(ctx context.Context, userID string, role string) error
-	GetUserReviews(ctx context.Context, userID string) ([]entity.Review, error)
+	GetUserReviews(ctx context.Context, viewer entity.User, userID string) ([]entity.Review, error)
+	GetPublicProfile(ctx context.Context, userID string) (*PublicUserProfile, error)
+}
+
+// PublicUserProfile is the part of a user shown to anyone, with their reviewer stats.
+type PublicUserProfile struct {
+	User entity.User
+	// IconFile is the uploaded icon referenced by User.IconFileID, if any.
+	IconFile *entity.File
+	Stats    entity.ReviewerStats
 }
 
 type EnsureUserInput struct {
@@ -0,0 +1,33 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// VisitUseCase defines inbound port for the user's store visits.
+type VisitUseCase interface {
+	MarkVisited(ctx context.Context, user entity.User, storeID string, in MarkVisitInput) (*entity.Visit, error)
+	DeleteVisit(ctx context.Context, user entity.User, visitID int64) error
+	ListMyVisits(ctx context.Context, user entity.User, query ListVisitsQuery) (*VisitPage, error)
+	GetMyVisitCounts(ctx context.Context, user entity.User) (*entity.VisitCounts, error)
+}
+
+// MarkVisitInput holds the visit to record. A nil VisitedOn means today in the service time zone.
+type MarkVisitInput struct {
+	VisitedOn *time.Time
+	Note      *string
+}
+
+// ListVisitsQuery represents the query parameters for the visit history. A nil StoreID lists every store.
+type ListVisitsQuery struct {
+	StoreID *string
+	Limit   int
+	Cursor  string
+}
+
+// VisitPage is an alias to output.VisitPage to avoid type duplication.
+type VisitPage = output.VisitPage
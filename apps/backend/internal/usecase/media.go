@@ -2,8 +2,11 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"mime"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -18,13 +21,18 @@ import (
 // MediaUseCase はアップロード処理に関するビジネスロジックを提供します
 type MediaUseCase interface {
 	CreateReviewUploads(ctx context.Context, storeID string, userID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error)
+	CreateClaimUploads(ctx context.Context, storeID string, userID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error)
+	CreateStorePhotoUploads(ctx context.Context, actor entity.User, storeID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error)
+	CompleteUpload(ctx context.Context, actor entity.User, fileID string) (*entity.File, error)
 }
 
 type mediaUseCase struct {
-	storage   output.StorageProvider
-	fileRepo  output.FileRepository
-	storeRepo output.StoreRepository
-	bucket    string
+	storage        output.StorageProvider
+	fileRepo       output.FileRepository
+	storeRepo      output.StoreRepository
+	storeOwnerRepo output.StoreOwnerRepository
+	photoRepo      output.StorePhotoRepository
+	bucket         string
 }
 
 // allowedContentTypes は許可されたContent-Typeのホワイトリスト
@@ -35,9 +43,12 @@ var allowedContentTypes = map[string]bool{
 	"image/webp": true,
 }
 
-// isAllowedContentType はContent-Typeが許可されているかを確認します
-func isAllowedContentType(contentType string) bool {
-	return allowedContentTypes[strings.ToLower(contentType)]
+// allowedEvidenceContentTypes は証拠書類として許可する Content-Type（画像と PDF）
+var allowedEvidenceContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+	"application/pdf": true,
 }
 
 // NewMediaUseCase は MediaUseCase の実装を生成します
@@ -45,17 +56,155 @@ func NewMediaUseCase(
 	storage output.StorageProvider,
 	fileRepo output.FileRepository,
 	storeRepo output.StoreRepository,
+	storeOwnerRepo output.StoreOwnerRepository,
+	photoRepo output.StorePhotoRepository,
 	bucket string,
 ) MediaUseCase {
 	return &mediaUseCase{
-		storage:   storage,
-		fileRepo:  fileRepo,
-		storeRepo: storeRepo,
-		bucket:    bucket,
+		storage:        storage,
+		fileRepo:       fileRepo,
+		storeRepo:      storeRepo,
+		storeOwnerRepo: storeOwnerRepo,
+		photoRepo:      photoRepo,
+		bucket:         bucket,
 	}
 }
 
 func (uc *mediaUseCase) CreateReviewUploads(ctx context.Context, storeID string, userID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error) {
+	return uc.createUploads(ctx, storeID, userID, files, uploadSpec{
+		fileKind:    constants.TargetTypeReview,
+		keyPrefix:   "reviews",
+		allowed:     allowedContentTypes,
+		invalidType: ErrInvalidContentType,
+		link:        uc.fileRepo.LinkToStore,
+	})
+}
+
+// CreateClaimUploads はオーナー申請の証拠書類用の署名付きアップロード URL を発行します。
+// 証拠書類は店舗画像として公開されないよう store_files には紐付けません。
+func (uc *mediaUseCase) CreateClaimUploads(ctx context.Context, storeID string, userID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error) {
+	return uc.createUploads(ctx, storeID, userID, files, uploadSpec{
+		fileKind:    constants.FileKindClaimEvidence,
+		keyPrefix:   "claims",
+		allowed:     allowedEvidenceContentTypes,
+		invalidType: ErrInvalidEvidenceContentType,
+	})
+}
+
+// CreateStorePhotoUploads は店舗のギャラリーの写真用の署名付きアップロード URL を発行し、写真をギャラリーの末尾に追加します。
+// admin 以外は店舗のオーナーである必要があります
+func (uc *mediaUseCase) CreateStorePhotoUploads(ctx context.Context, actor entity.User, storeID string, files []input.UploadFileInput) ([]input.SignedUploadFile, error) {
+	if actor.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	if err := ensureStoreExists(ctx, uc.storeRepo, storeID); err != nil {
+		return nil, err
+	}
+	if err := ensureCanManageStore(ctx, uc.storeOwnerRepo, storeID, actor); err != nil {
+		return nil, err
+	}
+	count, err := uc.photoRepo.CountByStoreID(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if count+len(files) > constants.MaxStorePhotos {
+		return nil, ErrTooManyStorePhotos
+	}
+
+	return uc.createUploads(ctx, storeID, actor.UserID, files, uploadSpec{
+		fileKind:    constants.FileKindStoreImage,
+		keyPrefix:   "stores",
+		allowed:     allowedContentTypes,
+		invalidType: ErrInvalidContentType,
+		link:        uc.photoRepo.Add,
+	})
+}
+
+// CompleteUpload はクライアントからのアップロード完了の通知を受け、Storage にオブジェクトがあることを確認して
+// 実際のサイズと Content-Type を記録します。確認済みのファイルはそのまま返します。
+// アップロードしたユーザー本人のみ実行でき、オブジェクトがない場合は ErrUploadNotFound を返します
+func (uc *mediaUseCase) CompleteUpload(ctx context.Context, actor entity.User, fileID string) (*entity.File, error) {
+	if actor.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	file, err := uc.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return nil, ErrFileNotFound
+		}
+		return nil, err
+	}
+	if file.IsDeleted {
+		return nil, ErrFileNotFound
+	}
+	if file.CreatedBy == nil || *file.CreatedBy != actor.UserID {
+		return nil, ErrForbidden
+	}
+	if file.UploadedAt != nil {
+		return file, nil
+	}
+
+	info, err := uc.storage.StatObject(ctx, uc.bucket, file.ObjectKey)
+	if err != nil {
+		if errors.Is(err, output.ErrObjectNotFound) {
+			return nil, ErrUploadNotFound
+		}
+		return nil, err
+	}
+	// 署名付き URL の発行時に申告された形式と違うファイルがアップロードされた場合は確認済みにしない（掃除の対象になる）
+	if info.ContentType != "" {
+		allowed, invalidType := contentTypesForKind(file.FileKind)
+		if !allowed[normalizeContentType(info.ContentType)] {
+			return nil, invalidType
+		}
+	}
+
+	uploadedAt := time.Now()
+	if err := uc.fileRepo.MarkUploaded(ctx, file.FileID, *info, uploadedAt); err != nil {
+		return nil, err
+	}
+	file.FileSize = &info.Size
+	if info.ContentType != "" {
+		file.ContentType = &info.ContentType
+	}
+	file.UploadedAt = &uploadedAt
+	return file, nil
+}
+
+// contentTypesForKind はファイル種別ごとに許可する Content-Type と、許可されない場合のエラーを返します
+func contentTypesForKind(fileKind string) (map[string]bool, error) {
+	if fileKind == constants.FileKindClaimEvidence {
+		return allowedEvidenceContentTypes, ErrInvalidEvidenceContentType
+	}
+	return allowedContentTypes, ErrInvalidContentType
+}
+
+// normalizeContentType は "image/jpeg; charset=binary" のようなパラメータ付きの Content-Type からメディアタイプだけを取り出します
+func normalizeContentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+	return mediaType
+}
+
+// uploadSpec はアップロード種別ごとの差分を表します
+type uploadSpec struct {
+	fileKind    string
+	keyPrefix   string
+	allowed     map[string]bool
+	invalidType error
+	// link はファイルを店舗に紐付けます。nil の場合は紐付けません
+	link func(ctx context.Context, storeID string, fileID string) error
+}
+
+func (uc *mediaUseCase) createUploads(
+	ctx context.Context,
+	storeID string,
+	userID string,
+	files []input.UploadFileInput,
+	spec uploadSpec,
+) ([]input.SignedUploadFile, error) {
 	if storeID == "" || userID == "" || len(files) == 0 {
 		return nil, ErrInvalidInput
 	}
@@ -73,15 +222,15 @@ func (uc *mediaUseCase) CreateReviewUploads(ctx context.Context, storeID string,
 		if fileName == "" || contentType == "" {
 			return nil, ErrInvalidInput
 		}
-		if !isAllowedContentType(contentType) {
-			return nil, ErrInvalidContentType
+		if !spec.allowed[strings.ToLower(contentType)] {
+			return nil, spec.invalidType
 		}
 
-		objectKey := fmt.Sprintf("reviews/%s/%s/%s", storeID, userID, uuid.NewString())
+		objectKey := fmt.Sprintf("%s/%s/%s/%s", spec.keyPrefix, storeID, userID, uuid.NewString())
 
 		createdBy := userID
 		record := entity.File{
-			FileKind:    constants.TargetTypeReview,
+			FileKind:    spec.fileKind,
 			FileName:    fileName,
 			FileSize:    file.FileSize,
 			ObjectKey:   objectKey,
@@ -92,8 +241,10 @@ func (uc *mediaUseCase) CreateReviewUploads(ctx context.Context, storeID string,
 		if err := uc.fileRepo.Create(ctx, &record); err != nil {
 			return nil, err
 		}
-		if err := uc.fileRepo.LinkToStore(ctx, storeID, record.FileID); err != nil {
-			return nil, err
+		if spec.link != nil {
+			if err := spec.link(ctx, storeID, record.FileID); err != nil {
+				return nil, err
+			}
 		}
 
 		signed, err := uc.storage.CreateSignedUpload(ctx, uc.bucket, objectKey, contentType, config.SignedURLTTL, false)
@@ -102,11 +253,13 @@ func (uc *mediaUseCase) CreateReviewUploads(ctx context.Context, storeID string,
 		}
 
 		results = append(results, input.SignedUploadFile{
-			FileID:      record.FileID,
-			ObjectKey:   objectKey,
-			Path:        signed.Path,
-			Token:       signed.Token,
-			ContentType: contentType,
+			FileID:        record.FileID,
+			ObjectKey:     objectKey,
+			Path:          signed.Path,
+			Token:         signed.Token,
+			UploadURL:     signed.URL,
+			UploadHeaders: signed.Headers,
+			ContentType:   contentType,
 		})
 	}
 
@@ -3,10 +3,12 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
@@ -27,7 +29,7 @@ func TestCreateReviewUploads_Success(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 	result, err := uc.CreateReviewUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
 		{FileName: "image.jpg", ContentType: "image/jpeg"},
@@ -76,7 +78,7 @@ func TestCreateReviewUploads_InvalidInput(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			storeRepo := &testutil.MockStoreRepository{}
 
-			uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+			uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 			_, err := uc.CreateReviewUploads(context.Background(), tt.storeID, tt.userID, tt.files)
 			if !errors.Is(err, usecase.ErrInvalidInput) {
@@ -93,7 +95,7 @@ func TestCreateReviewUploads_StoreNotFound(t *testing.T) {
 		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
 	}
 
-	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 	_, err := uc.CreateReviewUploads(context.Background(), "nonexistent", "user-1", []input.UploadFileInput{
 		{FileName: "image.jpg", ContentType: "image/jpeg"},
@@ -117,7 +119,7 @@ func TestCreateReviewUploads_InvalidContentType(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-			uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+			uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 			_, err := uc.CreateReviewUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
 				{FileName: "file.txt", ContentType: contentType},
@@ -147,7 +149,7 @@ func TestCreateReviewUploads_ValidContentTypes(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-			uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+			uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 			result, err := uc.CreateReviewUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
 				{FileName: "image.jpg", ContentType: contentType},
@@ -177,7 +179,7 @@ func TestCreateReviewUploads_InvalidFileName(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-			uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+			uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 			_, err := uc.CreateReviewUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
 				{FileName: tt.fileName, ContentType: "image/jpeg"},
@@ -194,7 +196,7 @@ func TestCreateReviewUploads_EmptyContentType(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 	_, err := uc.CreateReviewUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
 		{FileName: "image.jpg", ContentType: ""},
@@ -210,7 +212,7 @@ func TestCreateReviewUploads_FileRepoCreateError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{CreateErr: createErr}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 	_, err := uc.CreateReviewUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
 		{FileName: "image.jpg", ContentType: "image/jpeg"},
@@ -226,7 +228,7 @@ func TestCreateReviewUploads_LinkToStoreError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{LinkToStoreErr: linkErr}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 	_, err := uc.CreateReviewUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
 		{FileName: "image.jpg", ContentType: "image/jpeg"},
@@ -242,7 +244,7 @@ func TestCreateReviewUploads_StorageProviderError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 	_, err := uc.CreateReviewUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
 		{FileName: "image.jpg", ContentType: "image/jpeg"},
@@ -261,7 +263,7 @@ func TestCreateReviewUploads_MultipleFiles(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 	result, err := uc.CreateReviewUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
 		{FileName: "image1.jpg", ContentType: "image/jpeg"},
@@ -282,7 +284,7 @@ func TestCreateReviewUploads_StoreRepoError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	storeRepo := &testutil.MockStoreRepository{FindByIDErr: dbErr}
 
-	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, "test-bucket")
+	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
 
 	_, err := uc.CreateReviewUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
 		{FileName: "image.jpg", ContentType: "image/jpeg"},
@@ -291,3 +293,247 @@ func TestCreateReviewUploads_StoreRepoError(t *testing.T) {
 		t.Errorf("expected database error, got %v", err)
 	}
 }
+
+// --- CreateClaimUploads Tests ---
+
+func TestCreateClaimUploads_DoesNotLinkToStore(t *testing.T) {
+	storage := &testutil.MockStorageProvider{
+		CreateSignedUploadResult: &output.SignedUpload{Path: "/path/to/doc", Token: "test-token"},
+	}
+	fileRepo := &testutil.MockFileRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewMediaUseCase(storage, fileRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
+
+	result, err := uc.CreateClaimUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
+		{FileName: "license.pdf", ContentType: "application/pdf"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(result))
+	}
+	if fileRepo.LinkToStoreCalled {
+		t.Error("claim evidence must not be linked to the store")
+	}
+	if fileRepo.CreateCalledWith.FileKind != constants.FileKindClaimEvidence {
+		t.Errorf("expected file kind %q, got %q", constants.FileKindClaimEvidence, fileRepo.CreateCalledWith.FileKind)
+	}
+	if !strings.HasPrefix(result[0].ObjectKey, "claims/store-1/user-1/") {
+		t.Errorf("unexpected object key %q", result[0].ObjectKey)
+	}
+}
+
+func TestCreateClaimUploads_InvalidContentType(t *testing.T) {
+	uc := usecase.NewMediaUseCase(
+		&testutil.MockStorageProvider{},
+		&testutil.MockFileRepository{},
+		&testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}},
+		&testutil.MockStoreOwnerRepository{},
+		&testutil.MockStorePhotoRepository{},
+		"test-bucket",
+	)
+
+	_, err := uc.CreateClaimUploads(context.Background(), "store-1", "user-1", []input.UploadFileInput{
+		{FileName: "notes.txt", ContentType: "text/plain"},
+	})
+	if !errors.Is(err, usecase.ErrInvalidEvidenceContentType) {
+		t.Errorf("expected ErrInvalidEvidenceContentType, got %v", err)
+	}
+}
+
+// --- CreateStorePhotoUploads Tests ---
+
+func TestCreateStorePhotoUploads_OwnerAddsToGallery(t *testing.T) {
+	storage := &testutil.MockStorageProvider{
+		CreateSignedUploadResult: &output.SignedUpload{Path: "/path/to/photo", Token: "test-token"},
+	}
+	fileRepo := &testutil.MockFileRepository{}
+	photoRepo := &testutil.MockStorePhotoRepository{}
+	owners := &testutil.MockStoreOwnerRepository{Owners: map[string][]string{"store-1": {"owner-1"}}}
+
+	uc := usecase.NewMediaUseCase(storage, fileRepo, &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}, owners, photoRepo, "test-bucket")
+
+	result, err := uc.CreateStorePhotoUploads(context.Background(), entity.User{UserID: "owner-1", Role: "owner"}, "store-1", []input.UploadFileInput{
+		{FileName: "interior.jpg", ContentType: "image/jpeg"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 || !strings.HasPrefix(result[0].ObjectKey, "stores/store-1/owner-1/") {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if fileRepo.CreateCalledWith.FileKind != constants.FileKindStoreImage {
+		t.Errorf("expected file kind %q, got %q", constants.FileKindStoreImage, fileRepo.CreateCalledWith.FileKind)
+	}
+	if len(photoRepo.AddedFileIDs) != 1 {
+		t.Errorf("expected the photo to be added to the gallery, got %v", photoRepo.AddedFileIDs)
+	}
+	if fileRepo.LinkToStoreCalled {
+		t.Error("gallery photos must be linked through the gallery")
+	}
+}
+
+func TestCreateStorePhotoUploads_NotOwner(t *testing.T) {
+	uc := usecase.NewMediaUseCase(
+		&testutil.MockStorageProvider{},
+		&testutil.MockFileRepository{},
+		&testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}},
+		&testutil.MockStoreOwnerRepository{},
+		&testutil.MockStorePhotoRepository{},
+		"test-bucket",
+	)
+
+	_, err := uc.CreateStorePhotoUploads(context.Background(), entity.User{UserID: "owner-2", Role: "owner"}, "store-1", []input.UploadFileInput{
+		{FileName: "interior.jpg", ContentType: "image/jpeg"},
+	})
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestCreateStorePhotoUploads_TooManyPhotos(t *testing.T) {
+	uc := usecase.NewMediaUseCase(
+		&testutil.MockStorageProvider{},
+		&testutil.MockFileRepository{},
+		&testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}},
+		&testutil.MockStoreOwnerRepository{},
+		&testutil.MockStorePhotoRepository{CountResult: constants.MaxStorePhotos},
+		"test-bucket",
+	)
+
+	_, err := uc.CreateStorePhotoUploads(context.Background(), entity.User{UserID: "admin-1", Role: "admin"}, "store-1", []input.UploadFileInput{
+		{FileName: "interior.jpg", ContentType: "image/jpeg"},
+	})
+	if !errors.Is(err, usecase.ErrTooManyStorePhotos) {
+		t.Errorf("expected ErrTooManyStorePhotos, got %v", err)
+	}
+}
+
+// --- CompleteUpload Tests ---
+
+func newPendingUpload(kind string) *entity.File {
+	declared := "image/jpeg"
+	return &entity.File{
+		FileID:      "file-1",
+		FileKind:    kind,
+		ObjectKey:   "reviews/store-1/user-1/file-1",
+		ContentType: &declared,
+		CreatedBy:   testutil.StringPtr("user-1"),
+	}
+}
+
+func TestCompleteUpload_RecordsStoredObject(t *testing.T) {
+	fileRepo := &testutil.MockFileRepository{FindByIDResult: newPendingUpload(constants.TargetTypeReview)}
+	storage := &testutil.MockStorageProvider{ObjectsByKey: map[string]output.ObjectInfo{
+		"reviews/store-1/user-1/file-1": {Size: 4096, ContentType: "image/png"},
+	}}
+	uc := usecase.NewMediaUseCase(storage, fileRepo, &testutil.MockStoreRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
+
+	file, err := uc.CompleteUpload(context.Background(), entity.User{UserID: "user-1"}, "file-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if file.UploadedAt == nil || file.FileSize == nil || *file.FileSize != 4096 || *file.ContentType != "image/png" {
+		t.Errorf("expected stored size and content type to be recorded, got %+v", file)
+	}
+	if len(fileRepo.MarkUploadedCalledWith) != 1 || fileRepo.MarkUploadedInfo.Size != 4096 {
+		t.Errorf("expected upload to be marked, got %v %+v", fileRepo.MarkUploadedCalledWith, fileRepo.MarkUploadedInfo)
+	}
+}
+
+func TestCompleteUpload_AlreadyConfirmed(t *testing.T) {
+	pending := newPendingUpload(constants.TargetTypeReview)
+	uploadedAt := time.Now()
+	pending.UploadedAt = &uploadedAt
+	fileRepo := &testutil.MockFileRepository{FindByIDResult: pending}
+	uc := usecase.NewMediaUseCase(&testutil.MockStorageProvider{StatErr: errors.New("should not be called")}, fileRepo,
+		&testutil.MockStoreRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
+
+	file, err := uc.CompleteUpload(context.Background(), entity.User{UserID: "user-1"}, "file-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if file.UploadedAt != &uploadedAt || fileRepo.MarkUploadedCalledWith != nil {
+		t.Error("expected confirmed file to be returned as is")
+	}
+}
+
+func TestCompleteUpload_Errors(t *testing.T) {
+	deleted := newPendingUpload(constants.TargetTypeReview)
+	deleted.IsDeleted = true
+
+	tests := []struct {
+		name    string
+		actor   entity.User
+		file    *entity.File
+		objects map[string]output.ObjectInfo
+		want    error
+	}{
+		{
+			name:  "not logged in",
+			actor: entity.User{},
+			file:  newPendingUpload(constants.TargetTypeReview),
+			want:  usecase.ErrUnauthorized,
+		},
+		{
+			name:  "unknown file",
+			actor: entity.User{UserID: "user-1"},
+			want:  usecase.ErrFileNotFound,
+		},
+		{
+			name:  "deleted file",
+			actor: entity.User{UserID: "user-1"},
+			file:  deleted,
+			want:  usecase.ErrFileNotFound,
+		},
+		{
+			name:  "uploaded by another user",
+			actor: entity.User{UserID: "user-2"},
+			file:  newPendingUpload(constants.TargetTypeReview),
+			want:  usecase.ErrForbidden,
+		},
+		{
+			name:  "object not uploaded",
+			actor: entity.User{UserID: "user-1"},
+			file:  newPendingUpload(constants.TargetTypeReview),
+			want:  usecase.ErrUploadNotFound,
+		},
+		{
+			name:    "photo is not an image",
+			actor:   entity.User{UserID: "user-1"},
+			file:    newPendingUpload(constants.FileKindStoreImage),
+			objects: map[string]output.ObjectInfo{"reviews/store-1/user-1/file-1": {Size: 10, ContentType: "application/pdf"}},
+			want:    usecase.ErrInvalidContentType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fileRepo := &testutil.MockFileRepository{FindByIDResult: tt.file}
+			storage := &testutil.MockStorageProvider{ObjectsByKey: tt.objects}
+			uc := usecase.NewMediaUseCase(storage, fileRepo, &testutil.MockStoreRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
+
+			_, err := uc.CompleteUpload(context.Background(), tt.actor, "file-1")
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+			if fileRepo.MarkUploadedCalledWith != nil {
+				t.Error("expected upload not to be marked")
+			}
+		})
+	}
+}
+
+func TestCompleteUpload_EvidenceAllowsPDF(t *testing.T) {
+	fileRepo := &testutil.MockFileRepository{FindByIDResult: newPendingUpload(constants.FileKindClaimEvidence)}
+	storage := &testutil.MockStorageProvider{ObjectsByKey: map[string]output.ObjectInfo{
+		"reviews/store-1/user-1/file-1": {Size: 10, ContentType: "application/pdf; charset=binary"},
+	}}
+	uc := usecase.NewMediaUseCase(storage, fileRepo, &testutil.MockStoreRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStorePhotoRepository{}, "test-bucket")
+
+	if _, err := uc.CompleteUpload(context.Background(), entity.User{UserID: "user-1"}, "file-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
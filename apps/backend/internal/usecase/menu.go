@@ -2,7 +2,10 @@ package usecase
 
 import (
 	"context"
+	"strings"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
@@ -10,33 +13,73 @@ import (
 
 // MenuUseCase はメニューに関するビジネスロジックを提供します
 type MenuUseCase interface {
-	GetMenusByStoreID(ctx context.Context, storeID string) ([]entity.Menu, error)
-	CreateMenu(ctx context.Context, storeID string, input input.CreateMenuInput) (*entity.Menu, error)
+	GetMenusByStoreID(ctx context.Context, viewer entity.User, storeID string) ([]entity.Menu, error)
+	CreateMenu(ctx context.Context, actor entity.User, storeID string, input input.CreateMenuInput) (*entity.Menu, error)
+	UpdateMenu(ctx context.Context, actor entity.User, storeID string, menuID string, input input.UpdateMenuInput) (*entity.Menu, error)
+	DeleteMenu(ctx context.Context, actor entity.User, storeID string, menuID string) error
+	ReorderMenus(ctx context.Context, actor entity.User, storeID string, menuIDs []string) ([]entity.Menu, error)
 }
 
 type menuUseCase struct {
-	menuRepo  output.MenuRepository
-	storeRepo output.StoreRepository
+	menuRepo       output.MenuRepository
+	storeRepo      output.StoreRepository
+	storeOwnerRepo output.StoreOwnerRepository
+	fileRepo       output.FileRepository
+	transaction    output.Transaction
 }
 
 // NewMenuUseCase は MenuUseCase の実装を生成します
-func NewMenuUseCase(menuRepo output.MenuRepository, storeRepo output.StoreRepository) MenuUseCase {
+func NewMenuUseCase(
+	menuRepo output.MenuRepository,
+	storeRepo output.StoreRepository,
+	storeOwnerRepo output.StoreOwnerRepository,
+	fileRepo output.FileRepository,
+	transaction output.Transaction,
+) MenuUseCase {
 	return &menuUseCase{
-		menuRepo:  menuRepo,
-		storeRepo: storeRepo,
+		menuRepo:       menuRepo,
+		storeRepo:      storeRepo,
+		storeOwnerRepo: storeOwnerRepo,
+		fileRepo:       fileRepo,
+		transaction:    transaction,
 	}
 }
 
-func (uc *menuUseCase) GetMenusByStoreID(ctx context.Context, storeID string) ([]entity.Menu, error) {
-	if err := ensureStoreExists(ctx, uc.storeRepo, storeID); err != nil {
+// validMenuSections mirrors the menus_section_check constraint.
+var validMenuSections = map[string]bool{
+	constants.MenuSectionDrink:   true,
+	constants.MenuSectionFood:    true,
+	constants.MenuSectionDessert: true,
+	constants.MenuSectionOther:   true,
+}
+
+var validDietaryTags = map[string]bool{
+	constants.DietaryTagVegetarian:        true,
+	constants.DietaryTagVegan:             true,
+	constants.DietaryTagGlutenFree:        true,
+	constants.DietaryTagHalal:             true,
+	constants.DietaryTagContainsEgg:       true,
+	constants.DietaryTagContainsMilk:      true,
+	constants.DietaryTagContainsWheat:     true,
+	constants.DietaryTagContainsBuckwheat: true,
+	constants.DietaryTagContainsPeanut:    true,
+	constants.DietaryTagContainsShrimp:    true,
+	constants.DietaryTagContainsCrab:      true,
+	constants.DietaryTagContainsWalnut:    true,
+}
+
+// GetMenusByStoreID は閲覧者が見られる店舗のメニューを返します
+func (uc *menuUseCase) GetMenusByStoreID(ctx context.Context, viewer entity.User, storeID string) ([]entity.Menu, error) {
+	if err := ensureStoreVisible(ctx, uc.storeRepo, storeID, viewer); err != nil {
 		return nil, err
 	}
 
 	return uc.menuRepo.FindByStoreID(ctx, storeID)
 }
 
-func (uc *menuUseCase) CreateMenu(ctx context.Context, storeID string, in input.CreateMenuInput) (*entity.Menu, error) {
-	if err := ensureStoreExists(ctx, uc.storeRepo, storeID); err != nil {
+// CreateMenu はメニューを登録します。admin 以外は店舗のオーナーである必要があります
+func (uc *menuUseCase) CreateMenu(ctx context.Context, actor entity.User, storeID string, in input.CreateMenuInput) (*entity.Menu, error) {
+	if err := uc.ensureCanManageMenus(ctx, actor, storeID); err != nil {
 		return nil, err
 	}
 
@@ -49,11 +92,238 @@ func (uc *menuUseCase) CreateMenu(ctx context.Context, storeID string, in input.
 		Name:        in.Name,
 		Price:       in.Price,
 		Description: in.Description,
+		Section:     in.Section,
+		IsAvailable: in.IsAvailable == nil || *in.IsAvailable,
+		ImageFileID: in.ImageFileID,
+		DietaryTags: in.DietaryTags,
+	}
+	if err := validateMenu(menu); err != nil {
+		return nil, err
+	}
+	if err := uc.ensureStorePhoto(ctx, storeID, menu.ImageFileID); err != nil {
+		return nil, err
+	}
+
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+	if err := uc.transaction.StartTransaction(func(tx interface{}) error {
+		return uc.menuRepo.CreateInTx(ctx, tx, menu)
+	}); err != nil {
+		return nil, err
+	}
+
+	return menu, nil
+}
+
+// UpdateMenu はメニューを部分更新します。admin 以外は店舗のオーナーである必要があります
+func (uc *menuUseCase) UpdateMenu(
+	ctx context.Context,
+	actor entity.User,
+	storeID string,
+	menuID string,
+	in input.UpdateMenuInput,
+) (*entity.Menu, error) {
+	if err := uc.ensureCanManageMenus(ctx, actor, storeID); err != nil {
+		return nil, err
+	}
+	menu, err := uc.mustFindStoreMenu(ctx, storeID, menuID)
+	if err != nil {
+		return nil, err
+	}
+
+	applyMenuUpdates(menu, in)
+	if err := validateNotEmpty(menu.Name); err != nil {
+		return nil, err
+	}
+	if err := validateMenu(menu); err != nil {
+		return nil, err
+	}
+	// 既に設定済みの写真は再検証しない
+	if in.ImageFileID != nil {
+		if err := uc.ensureStorePhoto(ctx, storeID, menu.ImageFileID); err != nil {
+			return nil, err
+		}
+	}
+
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+	if err := uc.transaction.StartTransaction(func(tx interface{}) error {
+		return uc.menuRepo.UpdateInTx(ctx, tx, menu)
+	}); err != nil {
+		return nil, err
+	}
+
+	return uc.mustFindStoreMenu(ctx, storeID, menuID)
+}
+
+func applyMenuUpdates(menu *entity.Menu, in input.UpdateMenuInput) {
+	if in.Name != nil {
+		menu.Name = *in.Name
+	}
+	if in.Price != nil {
+		menu.Price = in.Price
+	}
+	if in.Description != nil {
+		menu.Description = in.Description
+	}
+	if in.Section != nil {
+		menu.Section = emptyToNil(*in.Section)
+	}
+	if in.IsAvailable != nil {
+		menu.IsAvailable = *in.IsAvailable
+	}
+	if in.ImageFileID != nil {
+		menu.ImageFileID = emptyToNil(*in.ImageFileID)
+		menu.ImageFile = nil
+	}
+	if in.DietaryTags != nil {
+		menu.DietaryTags = in.DietaryTags
+	}
+}
+
+func emptyToNil(value string) *string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return &value
+}
+
+// DeleteMenu はメニューを削除します。削除済みメニューは一覧から消えますが、既存レビューからは参照できます
+func (uc *menuUseCase) DeleteMenu(ctx context.Context, actor entity.User, storeID string, menuID string) error {
+	if err := uc.ensureCanManageMenus(ctx, actor, storeID); err != nil {
+		return err
+	}
+	if _, err := uc.mustFindStoreMenu(ctx, storeID, menuID); err != nil {
+		return err
+	}
+
+	if err := uc.menuRepo.Delete(ctx, menuID); err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return ErrMenuNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ReorderMenus は店舗のメニューを menuIDs の順に並べ替えます。menuIDs には店舗の全メニューを1回ずつ含める必要があります
+func (uc *menuUseCase) ReorderMenus(ctx context.Context, actor entity.User, storeID string, menuIDs []string) ([]entity.Menu, error) {
+	if err := uc.ensureCanManageMenus(ctx, actor, storeID); err != nil {
+		return nil, err
 	}
 
-	if err := uc.menuRepo.Create(ctx, menu); err != nil {
+	current, err := uc.menuRepo.FindByStoreID(ctx, storeID)
+	if err != nil {
 		return nil, err
 	}
+	if !isMenuPermutation(current, menuIDs) {
+		return nil, ErrInvalidMenuOrder
+	}
+
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+	if err := uc.transaction.StartTransaction(func(tx interface{}) error {
+		return uc.menuRepo.ReorderInTx(ctx, tx, storeID, menuIDs)
+	}); err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return nil, ErrInvalidMenuOrder
+		}
+		return nil, err
+	}
+
+	return uc.menuRepo.FindByStoreID(ctx, storeID)
+}
+
+func isMenuPermutation(menus []entity.Menu, menuIDs []string) bool {
+	if len(menus) != len(menuIDs) {
+		return false
+	}
+	remaining := make(map[string]bool, len(menus))
+	for _, menu := range menus {
+		remaining[menu.MenuID] = true
+	}
+	for _, id := range menuIDs {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
+}
 
+func (uc *menuUseCase) ensureCanManageMenus(ctx context.Context, actor entity.User, storeID string) error {
+	if err := ensureStoreExists(ctx, uc.storeRepo, storeID); err != nil {
+		return err
+	}
+	return ensureCanManageStore(ctx, uc.storeOwnerRepo, storeID, actor)
+}
+
+// mustFindStoreMenu は店舗に属する削除されていないメニューを返します
+func (uc *menuUseCase) mustFindStoreMenu(ctx context.Context, storeID string, menuID string) (*entity.Menu, error) {
+	menu, err := uc.menuRepo.FindByID(ctx, menuID)
+	if err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return nil, ErrMenuNotFound
+		}
+		return nil, err
+	}
+	if menu.StoreID != storeID {
+		return nil, ErrMenuNotFound
+	}
 	return menu, nil
 }
+
+// validateMenu は価格・区分・タグを検証し、タグの重複を取り除きます
+func validateMenu(menu *entity.Menu) error {
+	if menu.Price != nil && *menu.Price < 0 {
+		return ErrInvalidInput
+	}
+	if menu.Section != nil && !validMenuSections[*menu.Section] {
+		return ErrInvalidMenuSection
+	}
+
+	tags, err := normalizeDietaryTags(menu.DietaryTags)
+	if err != nil {
+		return err
+	}
+	menu.DietaryTags = tags
+	return nil
+}
+
+// ensureStorePhoto は写真が店舗に紐付いた削除されていないファイルであることを確認します
+func (uc *menuUseCase) ensureStorePhoto(ctx context.Context, storeID string, fileID *string) error {
+	if fileID == nil {
+		return nil
+	}
+	files, err := uc.fileRepo.FindByStoreAndIDs(ctx, storeID, []string{*fileID})
+	if err != nil {
+		return err
+	}
+	if len(files) != 1 {
+		return ErrInvalidFileIDs
+	}
+	return nil
+}
+
+func normalizeDietaryTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return []string{}, nil
+	}
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		normalized := strings.ToLower(strings.TrimSpace(tag))
+		if !validDietaryTags[normalized] {
+			return nil, ErrInvalidDietaryTag
+		}
+		if _, ok := seen[normalized]; ok {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		result = append(result, normalized)
+	}
+	return result, nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
@@ -22,9 +23,9 @@ func TestGetMenusByStoreID_Success(t *testing.T) {
 	menuRepo := &testutil.MockMenuRepository{FindByStoreIDResult: menus}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMenuUseCase(menuRepo, storeRepo)
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
 
-	result, err := uc.GetMenusByStoreID(context.Background(), "store-1")
+	result, err := uc.GetMenusByStoreID(context.Background(), entity.User{}, "store-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -39,9 +40,9 @@ func TestGetMenusByStoreID_StoreNotFound(t *testing.T) {
 		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
 	}
 
-	uc := usecase.NewMenuUseCase(menuRepo, storeRepo)
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
 
-	_, err := uc.GetMenusByStoreID(context.Background(), "nonexistent")
+	_, err := uc.GetMenusByStoreID(context.Background(), entity.User{}, "nonexistent")
 	if !errors.Is(err, usecase.ErrStoreNotFound) {
 		t.Errorf("expected ErrStoreNotFound, got %v", err)
 	}
@@ -51,9 +52,9 @@ func TestGetMenusByStoreID_EmptyMenus(t *testing.T) {
 	menuRepo := &testutil.MockMenuRepository{FindByStoreIDResult: []entity.Menu{}}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMenuUseCase(menuRepo, storeRepo)
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
 
-	result, err := uc.GetMenusByStoreID(context.Background(), "store-1")
+	result, err := uc.GetMenusByStoreID(context.Background(), entity.User{}, "store-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -67,9 +68,9 @@ func TestGetMenusByStoreID_RepositoryError(t *testing.T) {
 	menuRepo := &testutil.MockMenuRepository{FindByStoreIDErr: dbErr}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMenuUseCase(menuRepo, storeRepo)
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
 
-	_, err := uc.GetMenusByStoreID(context.Background(), "store-1")
+	_, err := uc.GetMenusByStoreID(context.Background(), entity.User{}, "store-1")
 	if !errors.Is(err, dbErr) {
 		t.Errorf("expected database error, got %v", err)
 	}
@@ -81,9 +82,9 @@ func TestCreateMenu_Success(t *testing.T) {
 	menuRepo := &testutil.MockMenuRepository{}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMenuUseCase(menuRepo, storeRepo)
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
 
-	result, err := uc.CreateMenu(context.Background(), "store-1", input.CreateMenuInput{
+	result, err := uc.CreateMenu(context.Background(), testAdmin, "store-1", input.CreateMenuInput{
 		Name: "New Menu",
 	})
 	if err != nil {
@@ -97,15 +98,32 @@ func TestCreateMenu_Success(t *testing.T) {
 	}
 }
 
+func TestCreateMenu_NonOwnerForbidden(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.CreateMenu(context.Background(), testOwner, "store-1", input.CreateMenuInput{
+		Name: "New Menu",
+	})
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+	if menuRepo.CreateCalled {
+		t.Error("expected menu not to be created")
+	}
+}
+
 func TestCreateMenu_StoreNotFound(t *testing.T) {
 	menuRepo := &testutil.MockMenuRepository{}
 	storeRepo := &testutil.MockStoreRepository{
 		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
 	}
 
-	uc := usecase.NewMenuUseCase(menuRepo, storeRepo)
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
 
-	_, err := uc.CreateMenu(context.Background(), "nonexistent", input.CreateMenuInput{
+	_, err := uc.CreateMenu(context.Background(), testAdmin, "nonexistent", input.CreateMenuInput{
 		Name: "New Menu",
 	})
 	if !errors.Is(err, usecase.ErrStoreNotFound) {
@@ -117,9 +135,9 @@ func TestCreateMenu_InvalidInput_EmptyName(t *testing.T) {
 	menuRepo := &testutil.MockMenuRepository{}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMenuUseCase(menuRepo, storeRepo)
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
 
-	_, err := uc.CreateMenu(context.Background(), "store-1", input.CreateMenuInput{
+	_, err := uc.CreateMenu(context.Background(), testAdmin, "store-1", input.CreateMenuInput{
 		Name: "",
 	})
 	if !errors.Is(err, usecase.ErrInvalidInput) {
@@ -131,11 +149,11 @@ func TestCreateMenu_WithOptionalFields(t *testing.T) {
 	menuRepo := &testutil.MockMenuRepository{}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMenuUseCase(menuRepo, storeRepo)
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
 
 	price := 1000
 	description := "A delicious menu item"
-	result, err := uc.CreateMenu(context.Background(), "store-1", input.CreateMenuInput{
+	result, err := uc.CreateMenu(context.Background(), testAdmin, "store-1", input.CreateMenuInput{
 		Name:        "New Menu",
 		Price:       &price,
 		Description: &description,
@@ -156,12 +174,295 @@ func TestCreateMenu_CreateError(t *testing.T) {
 	menuRepo := &testutil.MockMenuRepository{CreateErr: createErr}
 	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
 
-	uc := usecase.NewMenuUseCase(menuRepo, storeRepo)
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
 
-	_, err := uc.CreateMenu(context.Background(), "store-1", input.CreateMenuInput{
+	_, err := uc.CreateMenu(context.Background(), testAdmin, "store-1", input.CreateMenuInput{
 		Name: "New Menu",
 	})
 	if !errors.Is(err, createErr) {
 		t.Errorf("expected create error, got %v", err)
 	}
 }
+
+func TestCreateMenu_DefaultsAndNormalizesTags(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	section := constants.MenuSectionDrink
+	result, err := uc.CreateMenu(context.Background(), testAdmin, "store-1", input.CreateMenuInput{
+		Name:        "Latte",
+		Section:     &section,
+		DietaryTags: []string{" Vegetarian ", "contains_milk", "vegetarian"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.IsAvailable {
+		t.Error("expected menu to be available by default")
+	}
+	expected := []string{"vegetarian", "contains_milk"}
+	if len(result.DietaryTags) != len(expected) {
+		t.Fatalf("expected tags %v, got %v", expected, result.DietaryTags)
+	}
+	for i, tag := range expected {
+		if result.DietaryTags[i] != tag {
+			t.Errorf("expected tags %v, got %v", expected, result.DietaryTags)
+		}
+	}
+}
+
+func TestCreateMenu_InvalidAttributes(t *testing.T) {
+	negative := -1
+	section := "alcohol"
+	fileID := "file-1"
+	tests := []struct {
+		name     string
+		input    input.CreateMenuInput
+		expected error
+	}{
+		{"negative price", input.CreateMenuInput{Name: "Menu", Price: &negative}, usecase.ErrInvalidInput},
+		{"unknown section", input.CreateMenuInput{Name: "Menu", Section: &section}, usecase.ErrInvalidMenuSection},
+		{"unknown dietary tag", input.CreateMenuInput{Name: "Menu", DietaryTags: []string{"keto"}}, usecase.ErrInvalidDietaryTag},
+		{"photo not linked to store", input.CreateMenuInput{Name: "Menu", ImageFileID: &fileID}, usecase.ErrInvalidFileIDs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			menuRepo := &testutil.MockMenuRepository{}
+			storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+			uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+			_, err := uc.CreateMenu(context.Background(), testAdmin, "store-1", tt.input)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, err)
+			}
+			if menuRepo.CreateCalled {
+				t.Error("expected menu not to be created")
+			}
+		})
+	}
+}
+
+func TestCreateMenu_WithStorePhoto(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+	fileRepo := &testutil.MockFileRepository{FindByStoreAndIDsResult: []entity.File{{FileID: "file-1"}}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, fileRepo, &testutil.MockTransaction{})
+
+	fileID := "file-1"
+	result, err := uc.CreateMenu(context.Background(), testAdmin, "store-1", input.CreateMenuInput{
+		Name:        "Menu",
+		ImageFileID: &fileID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ImageFileID == nil || *result.ImageFileID != fileID {
+		t.Errorf("expected ImageFileID %s, got %v", fileID, result.ImageFileID)
+	}
+	if fileRepo.FindByStoreAndIDsCalledWith.StoreID != "store-1" {
+		t.Errorf("expected photo to be checked against store-1, got %s", fileRepo.FindByStoreAndIDsCalledWith.StoreID)
+	}
+}
+
+// --- UpdateMenu Tests ---
+
+func TestUpdateMenu_AppliesPartialUpdate(t *testing.T) {
+	price := 500
+	section := constants.MenuSectionFood
+	fileID := "file-1"
+	menuRepo := &testutil.MockMenuRepository{FindByIDResult: &entity.Menu{
+		MenuID:      "menu-1",
+		StoreID:     "store-1",
+		Name:        "Toast",
+		Price:       &price,
+		Section:     &section,
+		IsAvailable: true,
+		ImageFileID: &fileID,
+		DietaryTags: []string{"contains_wheat"},
+	}}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+	ownerRepo := &testutil.MockStoreOwnerRepository{Owners: map[string][]string{"store-1": {testOwner.UserID}}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, ownerRepo, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	soldOut := false
+	empty := ""
+	_, err := uc.UpdateMenu(context.Background(), testOwner, "store-1", "menu-1", input.UpdateMenuInput{
+		IsAvailable: &soldOut,
+		ImageFileID: &empty,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated := menuRepo.UpdateCalledWith
+	if updated == nil {
+		t.Fatal("expected menu to be updated")
+	}
+	if updated.IsAvailable {
+		t.Error("expected menu to be marked sold out")
+	}
+	if updated.ImageFileID != nil {
+		t.Errorf("expected photo to be cleared, got %v", *updated.ImageFileID)
+	}
+	if updated.Name != "Toast" || updated.Price == nil || *updated.Price != price {
+		t.Errorf("expected untouched fields to be kept, got %+v", updated)
+	}
+	if len(updated.DietaryTags) != 1 || updated.DietaryTags[0] != "contains_wheat" {
+		t.Errorf("expected tags to be kept, got %v", updated.DietaryTags)
+	}
+}
+
+func TestUpdateMenu_MenuOfAnotherStore(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{FindByIDResult: &entity.Menu{MenuID: "menu-1", StoreID: "store-2", Name: "Toast"}}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	name := "Renamed"
+	_, err := uc.UpdateMenu(context.Background(), testAdmin, "store-1", "menu-1", input.UpdateMenuInput{Name: &name})
+	if !errors.Is(err, usecase.ErrMenuNotFound) {
+		t.Errorf("expected ErrMenuNotFound, got %v", err)
+	}
+	if menuRepo.UpdateCalled {
+		t.Error("expected menu not to be updated")
+	}
+}
+
+func TestUpdateMenu_NotFound(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound)}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.UpdateMenu(context.Background(), testAdmin, "store-1", "menu-1", input.UpdateMenuInput{})
+	if !errors.Is(err, usecase.ErrMenuNotFound) {
+		t.Errorf("expected ErrMenuNotFound, got %v", err)
+	}
+}
+
+func TestUpdateMenu_EmptyName(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{FindByIDResult: &entity.Menu{MenuID: "menu-1", StoreID: "store-1", Name: "Toast"}}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	empty := ""
+	_, err := uc.UpdateMenu(context.Background(), testAdmin, "store-1", "menu-1", input.UpdateMenuInput{Name: &empty})
+	if !errors.Is(err, usecase.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestUpdateMenu_NonOwnerForbidden(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{FindByIDResult: &entity.Menu{MenuID: "menu-1", StoreID: "store-1", Name: "Toast"}}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.UpdateMenu(context.Background(), testOwner, "store-1", "menu-1", input.UpdateMenuInput{})
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+}
+
+// --- DeleteMenu Tests ---
+
+func TestDeleteMenu_Success(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{FindByIDResult: &entity.Menu{MenuID: "menu-1", StoreID: "store-1"}}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+	ownerRepo := &testutil.MockStoreOwnerRepository{Owners: map[string][]string{"store-1": {testOwner.UserID}}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, ownerRepo, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	if err := uc.DeleteMenu(context.Background(), testOwner, "store-1", "menu-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if menuRepo.DeleteCalledWith != "menu-1" {
+		t.Errorf("expected menu-1 to be deleted, got %q", menuRepo.DeleteCalledWith)
+	}
+}
+
+func TestDeleteMenu_NonOwnerForbidden(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{FindByIDResult: &entity.Menu{MenuID: "menu-1", StoreID: "store-1"}}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	err := uc.DeleteMenu(context.Background(), testOwner, "store-1", "menu-1")
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+	if menuRepo.DeleteCalled {
+		t.Error("expected menu not to be deleted")
+	}
+}
+
+func TestDeleteMenu_AlreadyDeleted(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound)}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	err := uc.DeleteMenu(context.Background(), testAdmin, "store-1", "menu-1")
+	if !errors.Is(err, usecase.ErrMenuNotFound) {
+		t.Errorf("expected ErrMenuNotFound, got %v", err)
+	}
+}
+
+// --- ReorderMenus Tests ---
+
+func TestReorderMenus_Success(t *testing.T) {
+	menuRepo := &testutil.MockMenuRepository{FindByStoreIDResult: []entity.Menu{
+		{MenuID: "menu-1", StoreID: "store-1"},
+		{MenuID: "menu-2", StoreID: "store-1"},
+	}}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.ReorderMenus(context.Background(), testAdmin, "store-1", []string{"menu-2", "menu-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !menuRepo.ReorderCalled {
+		t.Fatal("expected menus to be reordered")
+	}
+	if got := menuRepo.ReorderCalledWith.MenuIDs; len(got) != 2 || got[0] != "menu-2" || got[1] != "menu-1" {
+		t.Errorf("expected order [menu-2 menu-1], got %v", got)
+	}
+}
+
+func TestReorderMenus_InvalidOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		menuIDs []string
+	}{
+		{"missing menu", []string{"menu-1"}},
+		{"duplicated menu", []string{"menu-1", "menu-1"}},
+		{"unknown menu", []string{"menu-1", "menu-3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			menuRepo := &testutil.MockMenuRepository{FindByStoreIDResult: []entity.Menu{
+				{MenuID: "menu-1", StoreID: "store-1"},
+				{MenuID: "menu-2", StoreID: "store-1"},
+			}}
+			storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+			uc := usecase.NewMenuUseCase(menuRepo, storeRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockFileRepository{}, &testutil.MockTransaction{})
+
+			_, err := uc.ReorderMenus(context.Background(), testAdmin, "store-1", tt.menuIDs)
+			if !errors.Is(err, usecase.ErrInvalidMenuOrder) {
+				t.Errorf("expected ErrInvalidMenuOrder, got %v", err)
+			}
+			if menuRepo.ReorderCalled {
+				t.Error("expected menus not to be reordered")
+			}
+		})
+	}
+}
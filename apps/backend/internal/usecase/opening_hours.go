@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/hours"
+)
+
+// resolveOpeningSchedule は保存する構造化された営業時間を決めます。
+// schedule があればそれを検証して使い、なければ自由記述の営業時間 text から読み取れる範囲で推測します（読み取れない場合は nil）
+func resolveOpeningSchedule(schedule *entity.OpeningSchedule, text *string) (*entity.OpeningSchedule, error) {
+	if schedule != nil {
+		return normalizeOpeningSchedule(*schedule)
+	}
+	if text == nil {
+		return nil, nil
+	}
+	parsed, ok := hours.Parse(*text)
+	if !ok {
+		return nil, nil
+	}
+	return parsed, nil
+}
+
+// normalizeOpeningSchedule は営業時間を検証し、時間帯・日付を昇順に並べます。
+// 終了が開始以前の時間帯は翌日までの営業として扱います。何も登録されていない場合は nil を返します
+func normalizeOpeningSchedule(schedule entity.OpeningSchedule) (*entity.OpeningSchedule, error) {
+	var result entity.OpeningSchedule
+	for d, intervals := range schedule.Weekly {
+		normalized, err := normalizeOpeningIntervals(intervals)
+		if err != nil {
+			return nil, err
+		}
+		result.Weekly[d] = normalized
+	}
+
+	if len(schedule.Special) > constants.MaxSpecialHours {
+		return nil, ErrInvalidOpeningHours
+	}
+	seen := make(map[string]struct{}, len(schedule.Special))
+	for _, special := range schedule.Special {
+		date := hours.Date(special.Date)
+		key := date.Format("2006-01-02")
+		if _, ok := seen[key]; ok {
+			return nil, ErrInvalidOpeningHours
+		}
+		seen[key] = struct{}{}
+		intervals, err := normalizeOpeningIntervals(special.Intervals)
+		if err != nil {
+			return nil, err
+		}
+		result.Special = append(result.Special, entity.SpecialHours{Date: date, Intervals: intervals})
+	}
+	sort.Slice(result.Special, func(i, j int) bool { return result.Special[i].Date.Before(result.Special[j].Date) })
+
+	if len(schedule.Closures) > constants.MaxStoreClosures {
+		return nil, ErrInvalidOpeningHours
+	}
+	for _, closure := range schedule.Closures {
+		from, to := hours.Date(closure.From), hours.Date(closure.To)
+		if to.Before(from) || to.Sub(from).Hours()/24 >= constants.MaxStoreClosureDays {
+			return nil, ErrInvalidOpeningHours
+		}
+		var note *string
+		if closure.Note != nil {
+			if trimmed := strings.TrimSpace(*closure.Note); trimmed != "" {
+				note = &trimmed
+			}
+		}
+		result.Closures = append(result.Closures, entity.StoreClosure{From: from, To: to, Note: note})
+	}
+	sort.Slice(result.Closures, func(i, j int) bool { return result.Closures[i].From.Before(result.Closures[j].From) })
+
+	if result.IsEmpty() {
+		return nil, nil
+	}
+	return &result, nil
+}
+
+// normalizeOpeningIntervals は1日分の営業時間帯を検証して開始順に並べます。重なる時間帯は ErrInvalidOpeningHours になります
+func normalizeOpeningIntervals(intervals []entity.OpeningInterval) ([]entity.OpeningInterval, error) {
+	if len(intervals) == 0 {
+		return nil, nil
+	}
+	if len(intervals) > constants.MaxOpeningIntervalsPerDay {
+		return nil, ErrInvalidOpeningHours
+	}
+	result := make([]entity.OpeningInterval, len(intervals))
+	for i, interval := range intervals {
+		if interval.Close <= interval.Open {
+			interval.Close += entity.MinutesPerDay
+		}
+		if interval.Open < 0 || interval.Open >= entity.MinutesPerDay || interval.Close-interval.Open > entity.MinutesPerDay {
+			return nil, ErrInvalidOpeningHours
+		}
+		result[i] = interval
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Open < result[j].Open })
+	for i := 1; i < len(result); i++ {
+		if result[i].Open < result[i-1].Close {
+			return nil, ErrInvalidOpeningHours
+		}
+	}
+	return result, nil
+}
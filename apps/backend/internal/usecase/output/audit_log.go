@@ -0,0 +1,37 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// AuditLogQuery describes filters and the cursor for the audit log listing.
+// From is inclusive and To is exclusive.
+type AuditLogQuery struct {
+	ActorID    *string
+	Action     *string
+	TargetType *string
+	TargetID   *string
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	Cursor     string
+}
+
+// AuditLogPage is a single page of the audit log listing.
+// NextCursor is empty when there are no more results.
+type AuditLogPage struct {
+	Logs       []entity.AuditLog
+	NextCursor string
+}
+
+// AuditLogRepository abstracts audit log persistence boundary.
+type AuditLogRepository interface {
+	Create(ctx context.Context, log *entity.AuditLog) error
+	// List returns the logs matching the query, newest first.
+	List(ctx context.Context, query AuditLogQuery) (*AuditLogPage, error)
+	// CreatePartition creates the monthly partition that contains month if it does not exist yet.
+	CreatePartition(ctx context.Context, month time.Time) error
+}
@@ -8,8 +8,10 @@ import (
 
 // FavoriteRepository abstracts favorite persistence boundary.
 type FavoriteRepository interface {
-	FindByUserID(ctx context.Context, userID string) ([]entity.Favorite, error)
+	// FindByUserID returns the user's favorites whose store the viewer can see.
+	FindByUserID(ctx context.Context, userID string, viewer Viewer) ([]entity.Favorite, error)
 	FindByUserAndStore(ctx context.Context, userID string, storeID string) (*entity.Favorite, error)
 	Create(ctx context.Context, favorite *entity.Favorite) error
 	Delete(ctx context.Context, userID string, storeID string) error
+	DeleteByUserIDInTx(ctx context.Context, tx interface{}, userID string) error
 }
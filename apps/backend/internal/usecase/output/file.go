@@ -2,6 +2,7 @@ package output
 
 import (
 	"context"
+	"time"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 )
@@ -9,6 +10,33 @@ import (
 // FileRepository abstracts file persistence boundary.
 type FileRepository interface {
 	FindByStoreAndIDs(ctx context.Context, storeID string, fileIDs []string) ([]entity.File, error)
+	FindByCreatorAndIDs(ctx context.Context, userID string, fileIDs []string) ([]entity.File, error)
 	Create(ctx context.Context, file *entity.File) error
 	LinkToStore(ctx context.Context, storeID string, fileID string) error
+	// FindByCreator returns the files uploaded by the user that are not deleted, newest first.
+	FindByCreator(ctx context.Context, userID string) ([]entity.File, error)
+	// UnlinkByCreatorInTx unlinks the files uploaded by the user from stores and reviews without deleting them.
+	UnlinkByCreatorInTx(ctx context.Context, tx interface{}, userID string) error
+	FindByID(ctx context.Context, fileID string) (*entity.File, error)
+	// MarkUploaded records the size and content type of the stored object and marks the upload as confirmed.
+	MarkUploaded(ctx context.Context, fileID string, info ObjectInfo, uploadedAt time.Time) error
+	// FindSweepable returns files created before the given time that are not deleted and are either
+	// unconfirmed or not referenced by any store, review or user, oldest first.
+	FindSweepable(ctx context.Context, createdBefore time.Time, limit int) ([]entity.File, error)
+	// DeleteByIDsInTx marks the files as deleted and removes every reference to them.
+	DeleteByIDsInTx(ctx context.Context, tx interface{}, fileIDs []string) error
+	// FindUnprocessedReviewImages returns confirmed files attached to reviews whose images have not been processed yet
+	// and have failed fewer than maxAttempts times, oldest first.
+	FindUnprocessedReviewImages(ctx context.Context, maxAttempts, limit int) ([]entity.File, error)
+	// MarkProcessed records the result of image processing and marks the file as processed.
+	MarkProcessed(ctx context.Context, fileID string, result ProcessedFile, processedAt time.Time) error
+	// RecordProcessingFailure counts a failed attempt to process the image of the file.
+	RecordProcessingFailure(ctx context.Context, fileID string) error
+}
+
+// ProcessedFile is the result of processing an image.
+type ProcessedFile struct {
+	// Original is the stored object that replaced the uploaded original, or nil if the original was kept as is.
+	Original *ObjectInfo
+	Variants []entity.FileVariant
 }
@@ -0,0 +1,33 @@
+package output
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// FollowListQuery describes the cursor for the follower and following listings.
+type FollowListQuery struct {
+	Limit  int
+	Cursor string
+}
+
+// FollowPage is a single page of a follower or following listing.
+// NextCursor is empty when there are no more results.
+type FollowPage struct {
+	Follows    []entity.Follow
+	NextCursor string
+}
+
+// FollowRepository abstracts follow persistence boundary.
+type FollowRepository interface {
+	// Create follows the user. Following an already followed user does nothing.
+	Create(ctx context.Context, followerID string, followeeID string) error
+	Delete(ctx context.Context, followerID string, followeeID string) error
+	// ListFollowers returns the users following the user, most recent follow first. Deleted users are skipped.
+	ListFollowers(ctx context.Context, userID string, query FollowListQuery) (*FollowPage, error)
+	// ListFollowing returns the users the user follows, most recent follow first. Deleted users are skipped.
+	ListFollowing(ctx context.Context, userID string, query FollowListQuery) (*FollowPage, error)
+	// DeleteByUserIDInTx removes the follows of the user in both directions.
+	DeleteByUserIDInTx(ctx context.Context, tx interface{}, userID string) error
+}
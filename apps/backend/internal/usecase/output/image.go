@@ -0,0 +1,36 @@
+package output
+
+import "errors"
+
+// ErrUnsupportedImage is returned by ImageProcessor when the content cannot be decoded as a supported image.
+var ErrUnsupportedImage = errors.New("unsupported image")
+
+// ImageProcessor represents the image processing boundary.
+type ImageProcessor interface {
+	// Process decodes the image, applies its EXIF orientation and returns the image without metadata
+	// together with variants scaled down to each of the widths.
+	Process(content []byte, widths []int) (*ProcessedImage, error)
+}
+
+// ProcessedImage is the result of ImageProcessor.Process.
+type ProcessedImage struct {
+	// Original is the full-size image re-encoded without metadata in the uploaded format,
+	// or nil if the uploaded content can be kept as is (e.g. GIF, which carries no EXIF).
+	Original *EncodedImage
+	Variants []EncodedImage
+}
+
+// EncodedImage is an encoded image with its dimensions.
+type EncodedImage struct {
+	Width       int
+	Height      int
+	Format      string
+	ContentType string
+	Content     []byte
+}
+
+// ImageProcessingQueue hands files over to the background image processing.
+type ImageProcessingQueue interface {
+	// Enqueue requests processing of the files without blocking. Files that cannot be queued are picked up later.
+	Enqueue(fileIDs []string)
+}
@@ -7,8 +7,15 @@ import (
 )
 
 // MenuRepository abstracts menu persistence boundary.
+// Deleted menus are kept as rows so that reviews referencing them stay intact;
+// FindByStoreID and FindByStoreAndIDs only return menus that have not been deleted.
 type MenuRepository interface {
 	FindByStoreID(ctx context.Context, storeID string) ([]entity.Menu, error)
 	FindByStoreAndIDs(ctx context.Context, storeID string, menuIDs []string) ([]entity.Menu, error)
-	Create(ctx context.Context, menu *entity.Menu) error
+	FindByID(ctx context.Context, menuID string) (*entity.Menu, error)
+	CreateInTx(ctx context.Context, tx interface{}, menu *entity.Menu) error
+	UpdateInTx(ctx context.Context, tx interface{}, menu *entity.Menu) error
+	ReorderInTx(ctx context.Context, tx interface{}, storeID string, menuIDs []string) error
+	Delete(ctx context.Context, menuID string) error
+	DeleteInTx(ctx context.Context, tx interface{}, menuID string) error
 }
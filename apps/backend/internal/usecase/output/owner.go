@@ -7,6 +7,12 @@ type OwnerAuthAdmin interface {
 	UpdateUser(ctx context.Context, userID string, input AuthUserUpdate) error
 }
 
+// AccountAuthAdmin deletes Supabase Auth users when they delete their account.
+type AccountAuthAdmin interface {
+	// DeleteUser deletes the auth user. It succeeds when the user no longer exists.
+	DeleteUser(ctx context.Context, userID string) error
+}
+
 // AuthUserUpdate describes metadata updates for Supabase admin API.
 type AuthUserUpdate struct {
 	Password     string
@@ -2,14 +2,41 @@ package output
 
 import (
 	"context"
+	"errors"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 )
 
+// ErrReportAlreadyHandled is returned when the pending reports of a target were handled concurrently.
+var ErrReportAlreadyHandled = errors.New("report already handled")
+
+// ReportListQuery describes filters and the cursor for the admin report listing.
+type ReportListQuery struct {
+	Status     *string
+	TargetType *string
+	Limit      int
+	Cursor     string
+}
+
+// ReportPage is a single page of the report listing.
+// NextCursor is empty when there are no more results.
+type ReportPage struct {
+	Reports    []entity.Report
+	NextCursor string
+}
+
 // ReportRepository abstracts report persistence boundary.
 type ReportRepository interface {
-	FindAll(ctx context.Context) ([]entity.Report, error)
+	// List returns one report per target and status, newest first, with ReportCount set
+	// to the number of reports collapsed into it.
+	List(ctx context.Context, query ReportListQuery) (*ReportPage, error)
 	FindByID(ctx context.Context, reportID int64) (*entity.Report, error)
+	// FindByUserID returns the reports submitted by the user, newest first.
+	FindByUserID(ctx context.Context, userID string) ([]entity.Report, error)
+	HasPending(ctx context.Context, userID, targetType, targetID string) (bool, error)
 	Create(ctx context.Context, report *entity.Report) error
-	UpdateStatus(ctx context.Context, reportID int64, status string) error
+	// ResolveInTx records the result on every pending report of the same target as report
+	// and returns the number of reports updated.
+	// It returns ErrReportAlreadyHandled when no pending report is left.
+	ResolveInTx(ctx context.Context, tx interface{}, report *entity.Report) (int, error)
 }
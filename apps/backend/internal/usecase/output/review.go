@@ -24,12 +24,56 @@ type CreateReview struct {
 	FileIDs       []string
 }
 
+// UpdateReview replaces the editable fields of a review, including its linked menus and files.
+type UpdateReview struct {
+	MenuIDs       []string
+	Rating        int
+	RatingDetails *RatingDetails
+	Content       *string
+	FileIDs       []string
+}
+
+// ReviewFeedQuery describes the cursor for the personalized review feed of a user.
+type ReviewFeedQuery struct {
+	UserID string
+	Limit  int
+	Cursor string
+}
+
+// ReviewPage is a single page of reviews.
+// NextCursor is empty when there are no more results.
+type ReviewPage struct {
+	Reviews    []entity.Review
+	NextCursor string
+}
+
 // ReviewRepository abstracts review persistence boundary.
 type ReviewRepository interface {
-	FindByStoreID(ctx context.Context, storeID string, sort string, viewerID string) ([]entity.Review, error)
+	// FindByStoreID returns the reviews of the store that the viewer can see.
+	FindByStoreID(ctx context.Context, storeID string, sort string, viewer Viewer) ([]entity.Review, error)
+	// FindByID returns the review regardless of its visibility.
 	FindByID(ctx context.Context, reviewID string) (*entity.Review, error)
-	FindByUserID(ctx context.Context, userID string) ([]entity.Review, error)
+	// IsVisible reports whether the review exists and the viewer can see it.
+	IsVisible(ctx context.Context, reviewID string, viewer Viewer) (bool, error)
+	// FindByIDs returns the reviews with their likes, menus and files. The order is unspecified.
+	FindByIDs(ctx context.Context, reviewIDs []string) ([]entity.Review, error)
+	// FindByUserID returns the reviews written by the user that the viewer can see.
+	FindByUserID(ctx context.Context, userID string, viewer Viewer) ([]entity.Review, error)
+	// FindFeed returns reviews by the users the user follows and reviews on the stores the user has favorited,
+	// newest first. The user's own reviews are not included.
+	FindFeed(ctx context.Context, query ReviewFeedQuery) (*ReviewPage, error)
+	// FindReviewerStats aggregates the reviews written by the user that are visible to the public.
+	FindReviewerStats(ctx context.Context, userID string) (*entity.ReviewerStats, error)
 	CreateInTx(ctx context.Context, tx interface{}, review CreateReview) error
+	// UpdateInTx rewrites the review and relinks its menus and files.
+	// Files that are no longer linked are marked as deleted.
+	UpdateInTx(ctx context.Context, tx interface{}, reviewID string, review UpdateReview) error
+	// DeleteInTx deletes the review and marks its attached files as deleted.
+	DeleteInTx(ctx context.Context, tx interface{}, reviewID string) error
+	UpdateVisibilityInTx(ctx context.Context, tx interface{}, reviewID string, visibility string) error
 	AddLike(ctx context.Context, reviewID string, userID string) error
 	RemoveLike(ctx context.Context, reviewID string, userID string) error
+	// FindLikesByUserID returns the likes given by the user, newest first.
+	FindLikesByUserID(ctx context.Context, userID string) ([]entity.ReviewLike, error)
+	DeleteLikesByUserIDInTx(ctx context.Context, tx interface{}, userID string) error
 }
@@ -0,0 +1,30 @@
+package output
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// SearchQuery describes a keyword search.
+// Query is already normalized with search.Normalize. An empty Type searches every type.
+type SearchQuery struct {
+	Query  string
+	Type   string
+	Limit  int
+	Cursor string
+}
+
+// SearchPage is a single page of search hits ordered by score.
+// NextCursor is empty when there are no more results.
+type SearchPage struct {
+	Hits       []entity.SearchHit
+	NextCursor string
+}
+
+// SearchRepository abstracts the search engine so that it can be swapped
+// (e.g. pg_trgm today, a dedicated search service later).
+// It only returns identifiers and scores; the caller loads the entities.
+type SearchRepository interface {
+	Search(ctx context.Context, query SearchQuery) (*SearchPage, error)
+}
@@ -6,6 +6,18 @@ import (
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 )
 
+// StationSearchQuery filters stations. Empty fields are not applied.
+// NamePrefix matches name, KanaPrefix matches kana; a station matching either is returned.
+type StationSearchQuery struct {
+	NamePrefix string
+	KanaPrefix string
+	Kind       string
+}
+
 type StationRepository interface {
 	FindAll(ctx context.Context) ([]entity.Station, error)
+	FindByID(ctx context.Context, id int64) (*entity.Station, error)
+	Search(ctx context.Context, query StationSearchQuery) ([]entity.Station, error)
+	// FindNearest returns up to limit stations ordered by distance, with DistanceMeters set.
+	FindNearest(ctx context.Context, lat, lng float64, limit int) ([]entity.Station, error)
 }
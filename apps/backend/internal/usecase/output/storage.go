@@ -2,9 +2,23 @@ package output
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net/url"
 	"time"
 )
 
+var (
+	// ErrObjectNotFound is returned by StatObject when the object does not exist in the bucket.
+	ErrObjectNotFound = errors.New("storage object not found")
+	// ErrInvalidSignedURL is returned when a signed URL has a wrong signature or has expired.
+	ErrInvalidSignedURL = errors.New("signed url is invalid or expired")
+	// ErrContentTypeMismatch is returned when an upload does not have the content type the URL was signed for.
+	ErrContentTypeMismatch = errors.New("content type does not match the signed url")
+	// ErrObjectExists is returned when an upload signed without upsert targets an existing object.
+	ErrObjectExists = errors.New("storage object already exists")
+)
+
 // StorageProvider represents an external object storage boundary.
 type StorageProvider interface {
 	CreateSignedUpload(
@@ -14,12 +28,35 @@ type StorageProvider interface {
 		upsert bool,
 	) (*SignedUpload, error)
 	CreateSignedDownload(ctx context.Context, bucket, objectPath string, expiresIn time.Duration) (*SignedDownload, error)
+	// StatObject returns the stored size and content type of an object, or ErrObjectNotFound.
+	StatObject(ctx context.Context, bucket, objectPath string) (*ObjectInfo, error)
+	// DeleteObjects removes objects from the bucket. Objects that do not exist are ignored.
+	DeleteObjects(ctx context.Context, bucket string, objectPaths []string) error
+	// GetObject opens the content of an object, or returns ErrObjectNotFound. The caller must close the reader.
+	GetObject(ctx context.Context, bucket, objectPath string) (io.ReadCloser, error)
+	// PutObject stores the content as the object, replacing an existing object at the same path.
+	PutObject(ctx context.Context, bucket, objectPath, contentType string, content []byte) error
+}
+
+// SignedObjectStore serves the signed URLs of a StorageProvider that has no object server of its own,
+// such as the local filesystem storage.
+type SignedObjectStore interface {
+	// ReceiveSignedUpload verifies a signed upload URL and stores the content.
+	// It returns ErrInvalidSignedURL, ErrContentTypeMismatch or ErrObjectExists when the upload is rejected.
+	ReceiveSignedUpload(ctx context.Context, bucket, objectPath string, query url.Values, contentType string, content io.Reader) error
+	// OpenSignedDownload verifies a signed download URL and opens the object.
+	// It returns ErrInvalidSignedURL or ErrObjectNotFound. The caller must close the reader.
+	OpenSignedDownload(ctx context.Context, bucket, objectPath string, query url.Values) (io.ReadCloser, *ObjectInfo, error)
 }
 
+// SignedUpload is a URL the client uploads an object to with PUT, sending Headers along with the content.
 type SignedUpload struct {
-	Bucket      string
-	Path        string
-	Token       string
+	Bucket string
+	Path   string
+	Token  string
+	URL    string
+	// Headers are the headers the upload must carry, such as the Content-Type the URL was signed for.
+	Headers     map[string]string
 	ExpiresIn   time.Duration
 	ContentType string
 	Upsert      bool
@@ -31,3 +68,8 @@ type SignedDownload struct {
 	URL       string
 	ExpiresIn time.Duration
 }
+
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
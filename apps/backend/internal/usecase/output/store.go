@@ -2,16 +2,86 @@ package output
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 )
 
+// ErrStoreApprovalChanged is returned when the approval status of a store was changed concurrently.
+var ErrStoreApprovalChanged = errors.New("store approval status changed")
+
+// StoreListQuery describes filters, ordering and the cursor for a store listing.
+// IsApproved filters on whether the store is published; stores the Viewer cannot see are never returned.
+type StoreListQuery struct {
+	Limit       int
+	Cursor      string
+	Category    *string
+	Budget      *string
+	Tag         *string
+	MinRating   *float64
+	OpenedAfter *time.Time
+	// OpenAt keeps only the stores open at that time according to their structured opening hours.
+	OpenAt     *time.Time
+	IsApproved *bool
+	Sort       string
+	Viewer     Viewer
+}
+
+// StorePage is a single page of a store listing.
+// NextCursor is empty when there are no more results.
+type StorePage struct {
+	Stores     []entity.Store
+	NextCursor string
+}
+
+// StoreNearbyQuery describes a radius search around a point.
+type StoreNearbyQuery struct {
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+	Limit        int
+	OpenAt       *time.Time
+	Viewer       Viewer
+}
+
 // StoreRepository abstracts store persistence boundary.
 type StoreRepository interface {
-	FindAll(ctx context.Context) ([]entity.Store, error)
+	FindAll(ctx context.Context, viewer Viewer) ([]entity.Store, error)
+	List(ctx context.Context, query StoreListQuery) (*StorePage, error)
+	// FindNearby returns stores within the radius ordered by distance, with DistanceMeters set.
+	FindNearby(ctx context.Context, query StoreNearbyQuery) ([]entity.Store, error)
+	// FindByID returns the store regardless of its visibility. Use FindVisibleByID on public read paths.
 	FindByID(ctx context.Context, id string) (*entity.Store, error)
+	// FindVisibleByID returns the store only when the viewer can see it, with the reviews the viewer can see.
+	FindVisibleByID(ctx context.Context, id string, viewer Viewer) (*entity.Store, error)
+	// FindByIDs returns the stores with their thumbnails, tags and active menus. The order is unspecified.
+	FindByIDs(ctx context.Context, ids []string) ([]entity.Store, error)
+	// FindPending returns the stores awaiting approval (submitted or resubmitted).
 	FindPending(ctx context.Context) ([]entity.Store, error)
+	// IsVisible reports whether the store exists and the viewer can see it.
+	IsVisible(ctx context.Context, id string, viewer Viewer) (bool, error)
+	FindByOwner(ctx context.Context, userID string) ([]entity.Store, error)
 	Create(ctx context.Context, store *entity.Store) error
+	CreateInTx(ctx context.Context, tx interface{}, store *entity.Store) error
+	// Update stores the editable fields of the store. Visibility and approval status are left untouched.
 	Update(ctx context.Context, store *entity.Store) error
+	UpdateInTx(ctx context.Context, tx interface{}, store *entity.Store) error
+	// UpdateApprovalInTx stores the approval status and visibility of the store when its current
+	// approval status is still from. It returns ErrStoreApprovalChanged otherwise.
+	UpdateApprovalInTx(ctx context.Context, tx interface{}, store *entity.Store, from string) error
+	// UpdateVisibilityInTx stores only the visibility of the store when its current approval status is
+	// still approvalStatus. It returns ErrStoreApprovalChanged otherwise.
+	UpdateVisibilityInTx(ctx context.Context, tx interface{}, storeID string, visibility string, approvalStatus string) error
 	Delete(ctx context.Context, id string) error
+	// FindWithUnparsedOpeningHours returns the stores with free-text opening hours and no structured schedule.
+	FindWithUnparsedOpeningHours(ctx context.Context) ([]entity.Store, error)
+	// UpdateOpeningSchedule replaces only the structured opening hours of the store.
+	UpdateOpeningSchedule(ctx context.Context, storeID string, schedule *entity.OpeningSchedule) error
+}
+
+// StoreOwnerRepository manages the store_owners relationship.
+type StoreOwnerRepository interface {
+	IsOwner(ctx context.Context, storeID, userID string) (bool, error)
+	AddInTx(ctx context.Context, tx interface{}, storeID, userID string) error
 }
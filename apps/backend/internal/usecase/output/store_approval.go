@@ -0,0 +1,14 @@
+package output
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// StoreApprovalEventRepository records the approval status transitions of stores.
+type StoreApprovalEventRepository interface {
+	CreateInTx(ctx context.Context, tx interface{}, event *entity.StoreApprovalEvent) error
+	// FindByStoreID returns the events of the store, oldest first.
+	FindByStoreID(ctx context.Context, storeID string) ([]entity.StoreApprovalEvent, error)
+}
@@ -0,0 +1,22 @@
+package output
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// ErrClaimAlreadyReviewed is returned when a claim was reviewed concurrently.
+var ErrClaimAlreadyReviewed = errors.New("claim already reviewed")
+
+// StoreClaimRepository abstracts store claim persistence boundary.
+type StoreClaimRepository interface {
+	List(ctx context.Context, status *string) ([]entity.StoreClaim, error)
+	FindByID(ctx context.Context, claimID string) (*entity.StoreClaim, error)
+	HasPending(ctx context.Context, storeID, userID string) (bool, error)
+	CreateInTx(ctx context.Context, tx interface{}, claim *entity.StoreClaim, fileIDs []string) error
+	// UpdateReviewInTx records the review result of a pending claim.
+	// It returns ErrClaimAlreadyReviewed when the claim is no longer pending.
+	UpdateReviewInTx(ctx context.Context, tx interface{}, claim *entity.StoreClaim) error
+}
@@ -0,0 +1,26 @@
+package output
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// ErrStoreEditAlreadyReviewed is returned when a store edit was reviewed concurrently.
+var ErrStoreEditAlreadyReviewed = errors.New("store edit already reviewed")
+
+// StoreEditRepository abstracts persistence of store edits awaiting admin approval.
+type StoreEditRepository interface {
+	List(ctx context.Context, status *string) ([]entity.StoreEdit, error)
+	FindByID(ctx context.Context, editID string) (*entity.StoreEdit, error)
+	// FindPendingByStoreID returns the pending edit of the store, or a NotFound error when there is none.
+	FindPendingByStoreID(ctx context.Context, storeID string) (*entity.StoreEdit, error)
+	CreateInTx(ctx context.Context, tx interface{}, edit *entity.StoreEdit) error
+	// UpdateChangesInTx replaces the proposed values of a pending edit.
+	// It returns ErrStoreEditAlreadyReviewed when the edit is no longer pending.
+	UpdateChangesInTx(ctx context.Context, tx interface{}, edit *entity.StoreEdit) error
+	// UpdateReviewInTx records the review result of a pending edit.
+	// It returns ErrStoreEditAlreadyReviewed when the edit is no longer pending.
+	UpdateReviewInTx(ctx context.Context, tx interface{}, edit *entity.StoreEdit) error
+}
@@ -0,0 +1,23 @@
+package output
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// StorePhotoRepository abstracts the store gallery persistence boundary.
+// The gallery is the store_files rows whose file is a store image that has not been deleted;
+// the cover is the photo referenced by stores.thumbnail_file_id.
+type StorePhotoRepository interface {
+	FindByStoreID(ctx context.Context, storeID string) ([]entity.StorePhoto, error)
+	FindByID(ctx context.Context, storeID string, fileID string) (*entity.StorePhoto, error)
+	CountByStoreID(ctx context.Context, storeID string) (int, error)
+	// Add links an uploaded file to the end of the gallery.
+	Add(ctx context.Context, storeID string, fileID string) error
+	UpdateDetails(ctx context.Context, photo *entity.StorePhoto) error
+	ReorderInTx(ctx context.Context, tx interface{}, storeID string, fileIDs []string) error
+	SetCoverInTx(ctx context.Context, tx interface{}, storeID string, fileID string) error
+	// DeleteInTx marks the file as deleted and clears the cover and menu images that referenced it.
+	DeleteInTx(ctx context.Context, tx interface{}, storeID string, fileID string) error
+}
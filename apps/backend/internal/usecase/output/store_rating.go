@@ -0,0 +1,20 @@
+package output
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// StoreRatingRepository abstracts the persistence of per-store rating aggregates.
+type StoreRatingRepository interface {
+	FindByStoreID(ctx context.Context, storeID string) (*entity.RatingSummary, error)
+	// RecomputeInTx recalculates the aggregates of one store from its reviews.
+	// It must run in the same transaction as the review write that changed them.
+	// Like RecomputeAll, a store without published or previously counted reviews keeps its average_rating.
+	RecomputeInTx(ctx context.Context, tx interface{}, storeID string) error
+	// RecomputeAll recalculates the aggregates of every store that has published reviews or previously counted ones
+	// and returns the number of stores updated. Stores without reviews keep their average_rating, and stores
+	// whose reviews are all gone fall back to their seed rating.
+	RecomputeAll(ctx context.Context) (int64, error)
+}
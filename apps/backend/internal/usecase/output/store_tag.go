@@ -0,0 +1,16 @@
+package output
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// StoreTagRepository manages the store_tags relationship.
+// Tags are expected to be normalized before they reach the repository.
+type StoreTagRepository interface {
+	// ReplaceInTx replaces every tag of the store with tags.
+	ReplaceInTx(ctx context.Context, tx interface{}, storeID string, tags []string) error
+	// CountByTag returns how many approved stores carry each tag, most used first.
+	CountByTag(ctx context.Context) ([]entity.TagCount, error)
+}
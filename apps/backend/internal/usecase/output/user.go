@@ -2,10 +2,27 @@ package output
 
 import (
 	"context"
+	"time"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 )
 
+// UserListQuery describes filters and the cursor for the admin user listing.
+type UserListQuery struct {
+	// Query matches part of the email or name, case-insensitively. Empty means no filter.
+	Query  string
+	Role   *string
+	Limit  int
+	Cursor string
+}
+
+// UserPage is a single page of the user listing.
+// NextCursor is empty when there are no more results.
+type UserPage struct {
+	Users      []entity.User
+	NextCursor string
+}
+
 // UserRepository abstracts user persistence boundary.
 type UserRepository interface {
 	FindByID(ctx context.Context, userID string) (entity.User, error)
@@ -15,4 +32,14 @@ type UserRepository interface {
 	UpdateInTx(ctx context.Context, tx interface{}, user entity.User) error
 	UpdateRole(ctx context.Context, userID string, role string) error
 	UpdateRoleInTx(ctx context.Context, tx interface{}, userID string, role string) error
+	// List returns users newest first.
+	List(ctx context.Context, query UserListQuery) (*UserPage, error)
+	// UpdateSuspensionInTx saves the suspension fields of the user. Nil fields are cleared.
+	UpdateSuspensionInTx(ctx context.Context, tx interface{}, user entity.User) error
+	// AnonymizeInTx overwrites the personal fields of the user and sets DeletedAt. Nil fields are cleared.
+	AnonymizeInTx(ctx context.Context, tx interface{}, user entity.User) error
+	// FindPurgePending returns deleted users whose auth user or uploaded files have not been removed yet, oldest deletion first.
+	FindPurgePending(ctx context.Context, limit int) ([]entity.User, error)
+	// MarkPurged records that the auth user and uploaded files of a deleted user were removed.
+	MarkPurged(ctx context.Context, userID string, purgedAt time.Time) error
 }
@@ -0,0 +1,14 @@
+package output
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// UserModerationEventRepository records admin actions taken on users.
+type UserModerationEventRepository interface {
+	CreateInTx(ctx context.Context, tx interface{}, event *entity.UserModerationEvent) error
+	// FindByUserID returns the events of the user, newest first.
+	FindByUserID(ctx context.Context, userID string) ([]entity.UserModerationEvent, error)
+}
@@ -0,0 +1,9 @@
+package output
+
+// Viewer identifies who is reading stores and reviews so that repositories can apply visibility rules.
+// Admins see everything; other viewers see published content plus the content they authored or manage.
+// The zero value is an anonymous viewer who only sees published content.
+type Viewer struct {
+	UserID  string
+	IsAdmin bool
+}
@@ -0,0 +1,42 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+)
+
+// VisitListQuery describes the filter and cursor for a visit history listing.
+// Only visits to stores the Viewer can see are returned.
+type VisitListQuery struct {
+	StoreID *string
+	Limit   int
+	Cursor  string
+	Viewer  Viewer
+}
+
+// VisitPage is a single page of a visit history listing.
+// NextCursor is empty when there are no more results.
+type VisitPage struct {
+	Visits     []entity.Visit
+	NextCursor string
+}
+
+// VisitRepository abstracts store visit persistence boundary.
+type VisitRepository interface {
+	// Save records the visit. A visit to the same store on the same day is updated instead;
+	// its note is kept when visit.Note is nil.
+	Save(ctx context.Context, visit *entity.Visit) error
+	// MarkVisitedInTx records a visit without a note unless the store was already visited that day.
+	MarkVisitedInTx(ctx context.Context, tx interface{}, userID string, storeID string, visitedOn time.Time) error
+	FindByID(ctx context.Context, visitID int64) (*entity.Visit, error)
+	Delete(ctx context.Context, visitID int64) error
+	// ListByUser returns the user's visits with the store, most recent visit first.
+	ListByUser(ctx context.Context, userID string, query VisitListQuery) (*VisitPage, error)
+	// CountByUser counts the user's visits and visited stores among the stores the viewer can see.
+	CountByUser(ctx context.Context, userID string, viewer Viewer) (*entity.VisitCounts, error)
+	// FindByUserID returns every visit of the user without the store, for the account export.
+	FindByUserID(ctx context.Context, userID string) ([]entity.Visit, error)
+	DeleteByUserIDInTx(ctx context.Context, tx interface{}, userID string) error
+}
@@ -2,7 +2,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
@@ -12,46 +15,97 @@ import (
 // ReportUseCase は通報に関するビジネスロジックを提供します
 type ReportUseCase interface {
 	CreateReport(ctx context.Context, input input.CreateReportInput) (*entity.Report, error)
-	GetAllReports(ctx context.Context) ([]entity.Report, error)
-	HandleReport(ctx context.Context, reportID int64, action input.HandleReportAction) error
+	ListReports(ctx context.Context, query input.ListReportsQuery) (*input.ReportPage, error)
+	HandleReport(ctx context.Context, admin entity.User, reportID int64, input input.HandleReportInput) (*entity.Report, error)
 }
 
 type reportUseCase struct {
-	reportRepo output.ReportRepository
-	userRepo   output.UserRepository
+	reportRepo  output.ReportRepository
+	userRepo    output.UserRepository
+	storeRepo   output.StoreRepository
+	reviewRepo  output.ReviewRepository
+	menuRepo    output.MenuRepository
+	ratingRepo  output.StoreRatingRepository
+	eventRepo   output.StoreApprovalEventRepository
+	transaction output.Transaction
 }
 
 // NewReportUseCase は ReportUseCase の実装を生成します
-func NewReportUseCase(reportRepo output.ReportRepository, userRepo output.UserRepository) ReportUseCase {
+func NewReportUseCase(
+	reportRepo output.ReportRepository,
+	userRepo output.UserRepository,
+	storeRepo output.StoreRepository,
+	reviewRepo output.ReviewRepository,
+	menuRepo output.MenuRepository,
+	ratingRepo output.StoreRatingRepository,
+	eventRepo output.StoreApprovalEventRepository,
+	transaction output.Transaction,
+) ReportUseCase {
 	return &reportUseCase{
-		reportRepo: reportRepo,
-		userRepo:   userRepo,
+		reportRepo:  reportRepo,
+		userRepo:    userRepo,
+		storeRepo:   storeRepo,
+		reviewRepo:  reviewRepo,
+		menuRepo:    menuRepo,
+		ratingRepo:  ratingRepo,
+		eventRepo:   eventRepo,
+		transaction: transaction,
 	}
 }
 
+// validReportStatuses は通報一覧の絞り込みに使えるステータス
+var validReportStatuses = map[string]bool{
+	constants.ReportStatusPending:  true,
+	constants.ReportStatusResolved: true,
+	constants.ReportStatusRejected: true,
+}
+
+// reportEnforcements は通報対象の種類ごとに実施できる措置
+var reportEnforcements = map[string]map[string]bool{
+	constants.TargetTypeReview: {
+		constants.ReportEnforcementDeleteReview: true,
+		constants.ReportEnforcementHideReview:   true,
+	},
+	constants.TargetTypeStore: {
+		constants.ReportEnforcementUnapproveStore: true,
+		constants.ReportEnforcementHideStore:      true,
+	},
+	constants.TargetTypeMenu: {constants.ReportEnforcementDeleteMenu: true},
+	constants.TargetTypeUser: {},
+}
+
+// CreateReport は通報を登録します。同じユーザーが同じ対象を対応待ちのまま重複して通報することはできません
 func (uc *reportUseCase) CreateReport(ctx context.Context, req input.CreateReportInput) (*entity.Report, error) {
 	if err := ensureUserExists(ctx, uc.userRepo, req.UserID); err != nil {
 		return nil, err
 	}
 
-	if err := validateNotEmpty(req.TargetType, req.Reason); err != nil {
+	if err := validateNotEmpty(req.TargetType, req.TargetID, req.Reason); err != nil {
 		return nil, err
 	}
 
-	validTargetTypes := map[string]bool{
-		constants.TargetTypeReview: true,
-		constants.TargetTypeStore:  true,
-	}
-	if !validTargetTypes[req.TargetType] {
+	if _, ok := reportEnforcements[req.TargetType]; !ok {
 		return nil, ErrInvalidTargetType
 	}
+	if err := uc.ensureTargetExists(ctx, req.TargetType, req.TargetID); err != nil {
+		return nil, err
+	}
+
+	pending, err := uc.reportRepo.HasPending(ctx, req.UserID, req.TargetType, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, ErrReportAlreadyPending
+	}
 
 	report := &entity.Report{
-		UserID:     req.UserID,
-		TargetType: req.TargetType,
-		TargetID:   req.TargetID,
-		Reason:     req.Reason,
-		Status:     constants.ReportStatusPending,
+		UserID:      req.UserID,
+		TargetType:  req.TargetType,
+		TargetID:    req.TargetID,
+		Reason:      req.Reason,
+		Status:      constants.ReportStatusPending,
+		ReportCount: 1,
 	}
 
 	if err := uc.reportRepo.Create(ctx, report); err != nil {
@@ -61,13 +115,62 @@ func (uc *reportUseCase) CreateReport(ctx context.Context, req input.CreateRepor
 	return report, nil
 }
 
-func (uc *reportUseCase) GetAllReports(ctx context.Context) ([]entity.Report, error) {
-	return uc.reportRepo.FindAll(ctx)
+// ensureTargetExists は通報対象が存在することを確認します。削除済みのメニューは対象にできません
+func (uc *reportUseCase) ensureTargetExists(ctx context.Context, targetType, targetID string) error {
+	var err error
+	switch targetType {
+	case constants.TargetTypeReview:
+		_, err = uc.reviewRepo.FindByID(ctx, targetID)
+	case constants.TargetTypeStore:
+		_, err = uc.storeRepo.FindByID(ctx, targetID)
+	case constants.TargetTypeUser:
+		_, err = uc.userRepo.FindByID(ctx, targetID)
+	case constants.TargetTypeMenu:
+		var menu *entity.Menu
+		menu, err = uc.menuRepo.FindByID(ctx, targetID)
+		if err == nil && (menu == nil || menu.IsDeleted()) {
+			return ErrReportTargetNotFound
+		}
+	}
+	if apperr.IsCode(err, apperr.CodeNotFound) {
+		return ErrReportTargetNotFound
+	}
+	return err
 }
 
-func (uc *reportUseCase) HandleReport(ctx context.Context, reportID int64, action input.HandleReportAction) error {
-	if err := ensureReportExists(ctx, uc.reportRepo, reportID); err != nil {
-		return err
+// ListReports は通報を対象ごとにまとめ、新しい順に返します
+func (uc *reportUseCase) ListReports(ctx context.Context, query input.ListReportsQuery) (*input.ReportPage, error) {
+	listQuery := output.ReportListQuery{Cursor: query.Cursor}
+	if query.Status != "" {
+		if !validReportStatuses[query.Status] {
+			return nil, ErrInvalidReportStatus
+		}
+		listQuery.Status = &query.Status
+	}
+	if query.TargetType != "" {
+		if _, ok := reportEnforcements[query.TargetType]; !ok {
+			return nil, ErrInvalidTargetType
+		}
+		listQuery.TargetType = &query.TargetType
+	}
+	limit, err := normalizeLimit(query.Limit, constants.DefaultReportListLimit, constants.MaxReportListLimit)
+	if err != nil {
+		return nil, err
+	}
+	listQuery.Limit = limit
+
+	page, err := uc.reportRepo.List(ctx, listQuery)
+	if err != nil {
+		return nil, err
+	}
+	return &input.ReportPage{Reports: page.Reports, NextCursor: page.NextCursor}, nil
+}
+
+// HandleReport は通報を解決または却下します。同じ対象への対応待ちの通報もまとめて同じ結果になります。
+// 解決時に措置が指定された場合は、ステータスの更新と同じトランザクションで対象に適用します
+func (uc *reportUseCase) HandleReport(ctx context.Context, admin entity.User, reportID int64, in input.HandleReportInput) (*entity.Report, error) {
+	if admin.UserID == "" {
+		return nil, ErrUnauthorized
 	}
 
 	// アクションのバリデーション
@@ -76,10 +179,133 @@ func (uc *reportUseCase) HandleReport(ctx context.Context, reportID int64, actio
 		input.HandleReportReject:  constants.ReportStatusRejected,
 	}
 
-	status, ok := validActions[action]
+	status, ok := validActions[in.Action]
 	if !ok {
-		return ErrInvalidAction
+		return nil, ErrInvalidAction
+	}
+
+	report, err := mustFindReport(ctx, uc.reportRepo, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != constants.ReportStatusPending {
+		return nil, ErrReportNotPending
 	}
 
-	return uc.reportRepo.UpdateStatus(ctx, reportID, status)
+	enforcement := trimmedOrNil(in.Enforcement)
+	if enforcement != nil && (status != constants.ReportStatusResolved || !reportEnforcements[report.TargetType][*enforcement]) {
+		return nil, ErrInvalidEnforcement
+	}
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+
+	now := time.Now()
+	report.Status = status
+	report.Enforcement = enforcement
+	report.ResolutionNote = trimmedOrNil(in.Note)
+	report.ResolvedBy = &admin.UserID
+	report.ResolvedAt = &now
+	report.UpdatedAt = now
+
+	err = uc.transaction.StartTransaction(func(tx interface{}) error {
+		count, err := uc.reportRepo.ResolveInTx(ctx, tx, report)
+		if err != nil {
+			return err
+		}
+		report.ReportCount = count
+		if enforcement == nil {
+			return nil
+		}
+		return uc.enforce(ctx, tx, admin, report, *enforcement)
+	})
+	if errors.Is(err, output.ErrReportAlreadyHandled) {
+		return nil, ErrReportNotPending
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// enforce は通報対象に措置を適用します
+func (uc *reportUseCase) enforce(ctx context.Context, tx interface{}, admin entity.User, report *entity.Report, enforcement string) error {
+	targetID := report.TargetID
+	switch enforcement {
+	case constants.ReportEnforcementDeleteReview:
+		review, err := mustFindReview(ctx, uc.reviewRepo, targetID)
+		if err != nil {
+			return err
+		}
+		if err := uc.reviewRepo.DeleteInTx(ctx, tx, targetID); err != nil {
+			return err
+		}
+		return uc.ratingRepo.RecomputeInTx(ctx, tx, review.StoreID)
+	case constants.ReportEnforcementHideReview:
+		review, err := mustFindReview(ctx, uc.reviewRepo, targetID)
+		if err != nil {
+			return err
+		}
+		if err := uc.reviewRepo.UpdateVisibilityInTx(ctx, tx, targetID, constants.VisibilityHidden); err != nil {
+			return err
+		}
+		return uc.ratingRepo.RecomputeInTx(ctx, tx, review.StoreID)
+	case constants.ReportEnforcementUnapproveStore:
+		return uc.unapproveStoreInTx(ctx, tx, admin, targetID, report.ResolutionNote)
+	case constants.ReportEnforcementHideStore:
+		return uc.setStoreVisibilityInTx(ctx, tx, targetID, constants.VisibilityHidden)
+	case constants.ReportEnforcementDeleteMenu:
+		if err := uc.menuRepo.DeleteInTx(ctx, tx, targetID); err != nil {
+			if apperr.IsCode(err, apperr.CodeNotFound) {
+				return ErrMenuNotFound
+			}
+			return err
+		}
+		return nil
+	}
+	return ErrInvalidEnforcement
+}
+
+// unapproveStoreInTx は承認済みの店舗を非公開の審査待ち（resubmitted）に戻し、審査履歴を記録します。
+// 承認前の店舗は公開状態だけを承認待ちに戻します
+func (uc *reportUseCase) unapproveStoreInTx(ctx context.Context, tx interface{}, admin entity.User, storeID string, reason *string) error {
+	store, err := mustFindStore(ctx, uc.storeRepo, storeID)
+	if err != nil {
+		return err
+	}
+	if store.ApprovalStatus != constants.StoreApprovalApproved {
+		return uc.setStoreVisibilityInTx(ctx, tx, storeID, constants.VisibilityPending)
+	}
+
+	store.ApprovalStatus = constants.StoreApprovalResubmitted
+	store.Visibility = constants.VisibilityPending
+	store.UpdatedAt = time.Now()
+	err = uc.storeRepo.UpdateApprovalInTx(ctx, tx, store, constants.StoreApprovalApproved)
+	if errors.Is(err, output.ErrStoreApprovalChanged) {
+		return ErrStoreApprovalTransition
+	}
+	if err != nil {
+		return err
+	}
+	return uc.eventRepo.CreateInTx(ctx, tx, &entity.StoreApprovalEvent{
+		StoreID:    storeID,
+		FromStatus: constants.StoreApprovalApproved,
+		ToStatus:   constants.StoreApprovalResubmitted,
+		ActorID:    &admin.UserID,
+		Reason:     reason,
+	})
+}
+
+// setStoreVisibilityInTx は店舗の公開状態だけを変更します
+func (uc *reportUseCase) setStoreVisibilityInTx(ctx context.Context, tx interface{}, storeID, visibility string) error {
+	store, err := mustFindStore(ctx, uc.storeRepo, storeID)
+	if err != nil {
+		return err
+	}
+	err = uc.storeRepo.UpdateVisibilityInTx(ctx, tx, storeID, visibility, store.ApprovalStatus)
+	if errors.Is(err, output.ErrStoreApprovalChanged) {
+		return ErrStoreApprovalTransition
+	}
+	return err
 }
@@ -6,54 +6,91 @@ import (
 	"testing"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
 
-// --- CreateReport Tests ---
+const (
+	testReportTargetID = "11111111-1111-1111-1111-111111111111"
+	testReportStoreID  = "22222222-2222-2222-2222-222222222222"
+)
 
-func TestCreateReport_Success(t *testing.T) {
-	reportRepo := &testutil.MockReportRepository{}
-	userRepo := &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-1"}}
+var testReportAdmin = entity.User{UserID: "admin-1", Role: "admin"}
+
+// reportTestDeps bundles the mocks used by the report use case.
+type reportTestDeps struct {
+	reportRepo  *testutil.MockReportRepository
+	userRepo    *testutil.MockUserRepository
+	storeRepo   *testutil.MockStoreRepository
+	reviewRepo  *testutil.MockReviewRepository
+	menuRepo    *testutil.MockMenuRepository
+	ratingRepo  *testutil.MockStoreRatingRepository
+	eventRepo   *testutil.MockStoreApprovalEventRepository
+	transaction *testutil.MockTransaction
+}
+
+func newReportTestDeps() *reportTestDeps {
+	return &reportTestDeps{
+		reportRepo: &testutil.MockReportRepository{},
+		userRepo:   &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-1"}},
+		storeRepo: &testutil.MockStoreRepository{
+			Stores: []entity.Store{{StoreID: testReportStoreID, Name: "Store", Visibility: constants.VisibilityPublished}},
+		},
+		reviewRepo: &testutil.MockReviewRepository{
+			FindByIDResult: &entity.Review{ReviewID: testReportTargetID, StoreID: testReportStoreID},
+		},
+		menuRepo:    &testutil.MockMenuRepository{FindByIDResult: &entity.Menu{MenuID: testReportTargetID}},
+		ratingRepo:  &testutil.MockStoreRatingRepository{},
+		eventRepo:   &testutil.MockStoreApprovalEventRepository{},
+		transaction: &testutil.MockTransaction{},
+	}
+}
 
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+func (d *reportTestDeps) useCase() usecase.ReportUseCase {
+	return usecase.NewReportUseCase(d.reportRepo, d.userRepo, d.storeRepo, d.reviewRepo, d.menuRepo, d.ratingRepo, d.eventRepo, d.transaction)
+}
 
-	result, err := uc.CreateReport(context.Background(), input.CreateReportInput{
+func validCreateReportInput() input.CreateReportInput {
+	return input.CreateReportInput{
 		UserID:     "user-1",
 		TargetType: "review",
-		TargetID:   123,
+		TargetID:   testReportTargetID,
 		Reason:     "Inappropriate content",
-	})
+	}
+}
+
+// --- CreateReport Tests ---
+
+func TestCreateReport_Success(t *testing.T) {
+	deps := newReportTestDeps()
+
+	result, err := deps.useCase().CreateReport(context.Background(), validCreateReportInput())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if result.UserID != "user-1" {
 		t.Errorf("expected UserID user-1, got %s", result.UserID)
 	}
-	if result.TargetType != "review" {
-		t.Errorf("expected TargetType review, got %s", result.TargetType)
+	if result.TargetType != "review" || result.TargetID != testReportTargetID {
+		t.Errorf("unexpected target: %s %s", result.TargetType, result.TargetID)
 	}
 	if result.Status != "pending" {
 		t.Errorf("expected Status pending, got %s", result.Status)
 	}
+	if deps.reviewRepo.FindByIDCalledWith != testReportTargetID {
+		t.Errorf("expected the reported review to be looked up, got %q", deps.reviewRepo.FindByIDCalledWith)
+	}
 }
 
 func TestCreateReport_UserNotFound(t *testing.T) {
-	reportRepo := &testutil.MockReportRepository{}
-	userRepo := &testutil.MockUserRepository{
-		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
-	}
-
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+	deps := newReportTestDeps()
+	deps.userRepo.FindByIDErr = apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
 
-	_, err := uc.CreateReport(context.Background(), input.CreateReportInput{
-		UserID:     "nonexistent",
-		TargetType: "review",
-		TargetID:   123,
-		Reason:     "Inappropriate content",
-	})
+	_, err := deps.useCase().CreateReport(context.Background(), validCreateReportInput())
 	if !errors.Is(err, usecase.ErrUserNotFound) {
 		t.Errorf("expected ErrUserNotFound, got %v", err)
 	}
@@ -61,37 +98,20 @@ func TestCreateReport_UserNotFound(t *testing.T) {
 
 func TestCreateReport_InvalidInput(t *testing.T) {
 	tests := []struct {
-		name  string
-		input input.CreateReportInput
+		name   string
+		modify func(*input.CreateReportInput)
 	}{
-		{
-			name: "empty target type",
-			input: input.CreateReportInput{
-				UserID:     "user-1",
-				TargetType: "",
-				TargetID:   123,
-				Reason:     "Inappropriate content",
-			},
-		},
-		{
-			name: "empty reason",
-			input: input.CreateReportInput{
-				UserID:     "user-1",
-				TargetType: "review",
-				TargetID:   123,
-				Reason:     "",
-			},
-		},
+		{name: "empty target type", modify: func(in *input.CreateReportInput) { in.TargetType = "" }},
+		{name: "empty target id", modify: func(in *input.CreateReportInput) { in.TargetID = "" }},
+		{name: "empty reason", modify: func(in *input.CreateReportInput) { in.Reason = "" }},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			reportRepo := &testutil.MockReportRepository{}
-			userRepo := &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-1"}}
+			in := validCreateReportInput()
+			tt.modify(&in)
 
-			uc := usecase.NewReportUseCase(reportRepo, userRepo)
-
-			_, err := uc.CreateReport(context.Background(), tt.input)
+			_, err := newReportTestDeps().useCase().CreateReport(context.Background(), in)
 			if !errors.Is(err, usecase.ErrInvalidInput) {
 				t.Errorf("expected ErrInvalidInput, got %v", err)
 			}
@@ -100,40 +120,29 @@ func TestCreateReport_InvalidInput(t *testing.T) {
 }
 
 func TestCreateReport_InvalidTargetType(t *testing.T) {
-	reportRepo := &testutil.MockReportRepository{}
-	userRepo := &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-1"}}
-
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+	in := validCreateReportInput()
+	in.TargetType = "invalid"
 
-	_, err := uc.CreateReport(context.Background(), input.CreateReportInput{
-		UserID:     "user-1",
-		TargetType: "invalid",
-		TargetID:   123,
-		Reason:     "Inappropriate content",
-	})
+	_, err := newReportTestDeps().useCase().CreateReport(context.Background(), in)
 	if !errors.Is(err, usecase.ErrInvalidTargetType) {
 		t.Errorf("expected ErrInvalidTargetType, got %v", err)
 	}
 }
 
 func TestCreateReport_ValidTargetTypes(t *testing.T) {
-	validTypes := []string{"review", "store"}
+	validTypes := []string{"review", "store", "user", "menu"}
 
 	for _, targetType := range validTypes {
 		t.Run(targetType, func(t *testing.T) {
-			reportRepo := &testutil.MockReportRepository{}
-			userRepo := &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-1"}}
-
-			uc := usecase.NewReportUseCase(reportRepo, userRepo)
+			in := validCreateReportInput()
+			in.TargetType = targetType
+			if targetType == "store" {
+				in.TargetID = testReportStoreID
+			}
 
-			result, err := uc.CreateReport(context.Background(), input.CreateReportInput{
-				UserID:     "user-1",
-				TargetType: targetType,
-				TargetID:   123,
-				Reason:     "Inappropriate content",
-			})
+			result, err := newReportTestDeps().useCase().CreateReport(context.Background(), in)
 			if err != nil {
-				t.Errorf("expected no error for target type %s, got %v", targetType, err)
+				t.Fatalf("expected no error for target type %s, got %v", targetType, err)
 			}
 			if result.TargetType != targetType {
 				t.Errorf("expected TargetType %s, got %s", targetType, result.TargetType)
@@ -142,68 +151,138 @@ func TestCreateReport_ValidTargetTypes(t *testing.T) {
 	}
 }
 
+func TestCreateReport_TargetNotFound(t *testing.T) {
+	notFound := apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+	tests := []struct {
+		name       string
+		targetType string
+		setup      func(*reportTestDeps)
+	}{
+		{name: "review", targetType: "review", setup: func(d *reportTestDeps) { d.reviewRepo.FindByIDErr = notFound }},
+		{name: "store", targetType: "store", setup: func(d *reportTestDeps) { d.storeRepo.FindByIDErr = notFound }},
+		{name: "menu", targetType: "menu", setup: func(d *reportTestDeps) { d.menuRepo.FindByIDErr = notFound }},
+		{name: "deleted menu", targetType: "menu", setup: func(d *reportTestDeps) {
+			deletedAt := testutil.TestTime
+			d.menuRepo.FindByIDResult = &entity.Menu{MenuID: testReportTargetID, DeletedAt: &deletedAt}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := newReportTestDeps()
+			tt.setup(deps)
+			in := validCreateReportInput()
+			in.TargetType = tt.targetType
+
+			_, err := deps.useCase().CreateReport(context.Background(), in)
+			if !errors.Is(err, usecase.ErrReportTargetNotFound) {
+				t.Errorf("expected ErrReportTargetNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCreateReport_AlreadyPending(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.Pending = true
+
+	_, err := deps.useCase().CreateReport(context.Background(), validCreateReportInput())
+	if !errors.Is(err, usecase.ErrReportAlreadyPending) {
+		t.Errorf("expected ErrReportAlreadyPending, got %v", err)
+	}
+	if deps.reportRepo.CreateCalled {
+		t.Error("expected no report to be created")
+	}
+}
+
 func TestCreateReport_CreateError(t *testing.T) {
 	createErr := errors.New("create error")
-	reportRepo := &testutil.MockReportRepository{CreateErr: createErr}
-	userRepo := &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-1"}}
-
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+	deps := newReportTestDeps()
+	deps.reportRepo.CreateErr = createErr
 
-	_, err := uc.CreateReport(context.Background(), input.CreateReportInput{
-		UserID:     "user-1",
-		TargetType: "review",
-		TargetID:   123,
-		Reason:     "Inappropriate content",
-	})
+	_, err := deps.useCase().CreateReport(context.Background(), validCreateReportInput())
 	if !errors.Is(err, createErr) {
 		t.Errorf("expected create error, got %v", err)
 	}
 }
 
-// --- GetAllReports Tests ---
+// --- ListReports Tests ---
 
-func TestGetAllReports_Success(t *testing.T) {
-	reports := []entity.Report{
-		{ReportID: 1, UserID: "user-1", TargetType: "review", Status: "pending"},
-		{ReportID: 2, UserID: "user-2", TargetType: "store", Status: "resolved"},
+func TestListReports_Success(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.ListResult = &output.ReportPage{
+		Reports: []entity.Report{
+			{ReportID: 2, TargetType: "review", Status: "pending", ReportCount: 3},
+			{ReportID: 1, TargetType: "store", Status: "resolved", ReportCount: 1},
+		},
+		NextCursor: "next",
 	}
-	reportRepo := &testutil.MockReportRepository{FindAllResult: reports}
-	userRepo := &testutil.MockUserRepository{}
-
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
 
-	result, err := uc.GetAllReports(context.Background())
+	page, err := deps.useCase().ListReports(context.Background(), input.ListReportsQuery{
+		Status:     "pending",
+		TargetType: "review",
+		Cursor:     "cursor",
+	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(result) != 2 {
-		t.Errorf("expected 2 reports, got %d", len(result))
+	if len(page.Reports) != 2 || page.Reports[0].ReportCount != 3 {
+		t.Errorf("unexpected reports: %+v", page.Reports)
+	}
+	if page.NextCursor != "next" {
+		t.Errorf("expected next cursor %q, got %q", "next", page.NextCursor)
+	}
+	query := deps.reportRepo.ListCalledWith
+	if query.Status == nil || *query.Status != "pending" || query.TargetType == nil || *query.TargetType != "review" {
+		t.Errorf("unexpected filters: %+v", query)
+	}
+	if query.Limit != constants.DefaultReportListLimit || query.Cursor != "cursor" {
+		t.Errorf("unexpected paging: limit=%d cursor=%q", query.Limit, query.Cursor)
 	}
 }
 
-func TestGetAllReports_EmptyReports(t *testing.T) {
-	reportRepo := &testutil.MockReportRepository{FindAllResult: []entity.Report{}}
-	userRepo := &testutil.MockUserRepository{}
-
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+func TestListReports_NoFilters(t *testing.T) {
+	deps := newReportTestDeps()
 
-	result, err := uc.GetAllReports(context.Background())
-	if err != nil {
+	if _, err := deps.useCase().ListReports(context.Background(), input.ListReportsQuery{Limit: 1000}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(result) != 0 {
-		t.Errorf("expected 0 reports, got %d", len(result))
+	query := deps.reportRepo.ListCalledWith
+	if query.Status != nil || query.TargetType != nil {
+		t.Errorf("expected no filters, got %+v", query)
+	}
+	if query.Limit != constants.MaxReportListLimit {
+		t.Errorf("expected limit clamped to %d, got %d", constants.MaxReportListLimit, query.Limit)
 	}
 }
 
-func TestGetAllReports_RepositoryError(t *testing.T) {
-	dbErr := errors.New("database error")
-	reportRepo := &testutil.MockReportRepository{FindAllErr: dbErr}
-	userRepo := &testutil.MockUserRepository{}
+func TestListReports_InvalidFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   input.ListReportsQuery
+		wantErr error
+	}{
+		{name: "status", query: input.ListReportsQuery{Status: "done"}, wantErr: usecase.ErrInvalidReportStatus},
+		{name: "target type", query: input.ListReportsQuery{TargetType: "photo"}, wantErr: usecase.ErrInvalidTargetType},
+		{name: "limit", query: input.ListReportsQuery{Limit: -1}, wantErr: usecase.ErrInvalidInput},
+	}
 
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newReportTestDeps().useCase().ListReports(context.Background(), tt.query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestListReports_RepositoryError(t *testing.T) {
+	dbErr := errors.New("database error")
+	deps := newReportTestDeps()
+	deps.reportRepo.ListErr = dbErr
 
-	_, err := uc.GetAllReports(context.Background())
+	_, err := deps.useCase().ListReports(context.Background(), input.ListReportsQuery{})
 	if !errors.Is(err, dbErr) {
 		t.Errorf("expected database error, got %v", err)
 	}
@@ -211,86 +290,313 @@ func TestGetAllReports_RepositoryError(t *testing.T) {
 
 // --- HandleReport Tests ---
 
+func pendingReport(targetType, targetID string) *entity.Report {
+	return &entity.Report{ReportID: 1, TargetType: targetType, TargetID: targetID, Status: "pending"}
+}
+
 func TestHandleReport_Resolve(t *testing.T) {
-	reportRepo := &testutil.MockReportRepository{
-		FindByIDResult: &entity.Report{ReportID: 1, Status: "pending"},
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDResult = pendingReport("review", testReportTargetID)
+	deps.reportRepo.ResolveCount = 3
+	note := "  confirmed spam  "
+
+	report, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+		Action: input.HandleReportResolve,
+		Note:   &note,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Status != "resolved" {
+		t.Errorf("expected status resolved, got %s", report.Status)
+	}
+	if report.ResolvedBy == nil || *report.ResolvedBy != testReportAdmin.UserID || report.ResolvedAt == nil {
+		t.Errorf("expected resolver to be recorded, got %+v", report)
+	}
+	if report.ResolutionNote == nil || *report.ResolutionNote != "confirmed spam" {
+		t.Errorf("expected trimmed note, got %v", report.ResolutionNote)
 	}
-	userRepo := &testutil.MockUserRepository{}
+	if report.ReportCount != 3 {
+		t.Errorf("expected 3 collapsed reports, got %d", report.ReportCount)
+	}
+	if !deps.transaction.StartTransactionCalled || !deps.reportRepo.ResolveCalled {
+		t.Error("expected the status to be updated in a transaction")
+	}
+	if deps.reviewRepo.DeleteInTxCalled {
+		t.Error("expected no enforcement without an enforcement action")
+	}
+}
 
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+func TestHandleReport_Reject(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDResult = pendingReport("review", testReportTargetID)
 
-	err := uc.HandleReport(context.Background(), 1, input.HandleReportResolve)
+	report, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+		Action: input.HandleReportReject,
+	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	if report.Status != "rejected" || report.Enforcement != nil {
+		t.Errorf("unexpected report: %+v", report)
+	}
 }
 
-func TestHandleReport_Reject(t *testing.T) {
-	reportRepo := &testutil.MockReportRepository{
-		FindByIDResult: &entity.Report{ReportID: 1, Status: "pending"},
+func TestHandleReport_EnforceDeleteReview(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDResult = pendingReport("review", testReportTargetID)
+	enforcement := constants.ReportEnforcementDeleteReview
+
+	report, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+		Action:      input.HandleReportResolve,
+		Enforcement: &enforcement,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Enforcement == nil || *report.Enforcement != enforcement {
+		t.Errorf("expected enforcement to be recorded, got %v", report.Enforcement)
+	}
+	if deps.reviewRepo.DeleteInTxCalledWith != testReportTargetID {
+		t.Errorf("expected review %s to be deleted, got %q", testReportTargetID, deps.reviewRepo.DeleteInTxCalledWith)
 	}
-	userRepo := &testutil.MockUserRepository{}
+	if len(deps.ratingRepo.RecomputeCalledWith) != 1 || deps.ratingRepo.RecomputeCalledWith[0] != testReportStoreID {
+		t.Errorf("expected store rating to be recomputed, got %v", deps.ratingRepo.RecomputeCalledWith)
+	}
+}
 
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+func TestHandleReport_EnforceHideReview(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDResult = pendingReport("review", testReportTargetID)
+	enforcement := constants.ReportEnforcementHideReview
 
-	err := uc.HandleReport(context.Background(), 1, input.HandleReportReject)
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+		Action:      input.HandleReportResolve,
+		Enforcement: &enforcement,
+	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	if deps.reviewRepo.DeleteInTxCalled {
+		t.Error("expected hidden review not to be deleted")
+	}
+	if deps.reviewRepo.UpdateVisibilityWith.ReviewID != testReportTargetID || deps.reviewRepo.UpdateVisibilityWith.Visibility != constants.VisibilityHidden {
+		t.Errorf("expected review to be hidden, got %+v", deps.reviewRepo.UpdateVisibilityWith)
+	}
+	if len(deps.ratingRepo.RecomputeCalledWith) != 1 || deps.ratingRepo.RecomputeCalledWith[0] != testReportStoreID {
+		t.Errorf("expected store rating to be recomputed, got %v", deps.ratingRepo.RecomputeCalledWith)
+	}
 }
 
-func TestHandleReport_ReportNotFound(t *testing.T) {
-	reportRepo := &testutil.MockReportRepository{
-		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
+func TestHandleReport_EnforceHideStore(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDResult = pendingReport("store", testReportStoreID)
+	enforcement := constants.ReportEnforcementHideStore
+
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+		Action:      input.HandleReportResolve,
+		Enforcement: &enforcement,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.storeRepo.VisibilityWith.Visibility != constants.VisibilityHidden {
+		t.Errorf("expected store to be hidden, got %+v", deps.storeRepo.VisibilityWith)
 	}
-	userRepo := &testutil.MockUserRepository{}
+}
 
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+func TestHandleReport_EnforceUnapproveStore(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.storeRepo.Stores[0].ApprovalStatus = constants.StoreApprovalApproved
+	deps.reportRepo.FindByIDResult = pendingReport("store", testReportStoreID)
+	enforcement := constants.ReportEnforcementUnapproveStore
+	note := "misleading photos"
+
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+		Action:      input.HandleReportResolve,
+		Enforcement: &enforcement,
+		Note:        &note,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updated := deps.storeRepo.ApprovalWith.Store
+	if updated == nil || deps.storeRepo.ApprovalWith.From != constants.StoreApprovalApproved {
+		t.Fatalf("expected the approval to be updated from approved, got %+v", deps.storeRepo.ApprovalWith)
+	}
+	if updated.ApprovalStatus != constants.StoreApprovalResubmitted || updated.Visibility != constants.VisibilityPending {
+		t.Errorf("expected store to await approval again, got %s/%s", updated.ApprovalStatus, updated.Visibility)
+	}
+	if len(deps.eventRepo.Created) != 1 {
+		t.Fatalf("expected 1 approval event, got %d", len(deps.eventRepo.Created))
+	}
+	event := deps.eventRepo.Created[0]
+	if event.FromStatus != constants.StoreApprovalApproved || event.ToStatus != constants.StoreApprovalResubmitted ||
+		event.ActorID == nil || *event.ActorID != testReportAdmin.UserID || event.Reason == nil || *event.Reason != note {
+		t.Errorf("unexpected approval event: %+v", event)
+	}
+}
 
-	err := uc.HandleReport(context.Background(), 999, input.HandleReportResolve)
-	if !errors.Is(err, usecase.ErrReportNotFound) {
-		t.Errorf("expected ErrReportNotFound, got %v", err)
+func TestHandleReport_EnforceUnapproveStore_NotApproved(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.storeRepo.Stores[0].ApprovalStatus = constants.StoreApprovalSubmitted
+	deps.reportRepo.FindByIDResult = pendingReport("store", testReportStoreID)
+	enforcement := constants.ReportEnforcementUnapproveStore
+
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+		Action:      input.HandleReportResolve,
+		Enforcement: &enforcement,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.storeRepo.VisibilityWith.Visibility != constants.VisibilityPending {
+		t.Errorf("expected store to be back to pending, got %+v", deps.storeRepo.VisibilityWith)
+	}
+	if deps.storeRepo.ApprovalWith.Store != nil || len(deps.eventRepo.Created) != 0 {
+		t.Error("expected the approval status of a store awaiting approval to be left as is")
 	}
 }
 
-func TestHandleReport_InvalidAction(t *testing.T) {
-	reportRepo := &testutil.MockReportRepository{
-		FindByIDResult: &entity.Report{ReportID: 1, Status: "pending"},
+func TestHandleReport_EnforceUnapproveStore_ApprovalChanged(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.storeRepo.Stores[0].ApprovalStatus = constants.StoreApprovalApproved
+	deps.storeRepo.ApprovalErr = output.ErrStoreApprovalChanged
+	deps.reportRepo.FindByIDResult = pendingReport("store", testReportStoreID)
+	enforcement := constants.ReportEnforcementUnapproveStore
+
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+		Action:      input.HandleReportResolve,
+		Enforcement: &enforcement,
+	})
+	if !errors.Is(err, usecase.ErrStoreApprovalTransition) {
+		t.Fatalf("expected ErrStoreApprovalTransition, got %v", err)
+	}
+	if len(deps.eventRepo.Created) != 0 {
+		t.Error("expected no approval event")
 	}
-	userRepo := &testutil.MockUserRepository{}
+}
 
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+func TestHandleReport_EnforceDeleteMenu(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDResult = pendingReport("menu", testReportTargetID)
+	enforcement := constants.ReportEnforcementDeleteMenu
 
-	err := uc.HandleReport(context.Background(), 1, "invalid_action")
-	if !errors.Is(err, usecase.ErrInvalidAction) {
-		t.Errorf("expected ErrInvalidAction, got %v", err)
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+		Action:      input.HandleReportResolve,
+		Enforcement: &enforcement,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.menuRepo.DeleteCalledWith != testReportTargetID {
+		t.Errorf("expected menu %s to be deleted, got %q", testReportTargetID, deps.menuRepo.DeleteCalledWith)
+	}
+}
+
+func TestHandleReport_InvalidEnforcement(t *testing.T) {
+	tests := []struct {
+		name        string
+		targetType  string
+		action      input.HandleReportAction
+		enforcement string
+	}{
+		{name: "mismatched target", targetType: "store", action: input.HandleReportResolve, enforcement: constants.ReportEnforcementDeleteReview},
+		{name: "user target", targetType: "user", action: input.HandleReportResolve, enforcement: constants.ReportEnforcementDeleteReview},
+		{name: "unknown", targetType: "review", action: input.HandleReportResolve, enforcement: "ban_forever"},
+		{name: "on reject", targetType: "review", action: input.HandleReportReject, enforcement: constants.ReportEnforcementDeleteReview},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := newReportTestDeps()
+			deps.reportRepo.FindByIDResult = pendingReport(tt.targetType, testReportTargetID)
+			enforcement := tt.enforcement
+
+			_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+				Action:      tt.action,
+				Enforcement: &enforcement,
+			})
+			if !errors.Is(err, usecase.ErrInvalidEnforcement) {
+				t.Errorf("expected ErrInvalidEnforcement, got %v", err)
+			}
+			if deps.reportRepo.ResolveCalled {
+				t.Error("expected the report not to be updated")
+			}
+		})
+	}
+}
+
+func TestHandleReport_EnforcementErrorAbortsTransaction(t *testing.T) {
+	deleteErr := errors.New("delete failed")
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDResult = pendingReport("review", testReportTargetID)
+	deps.reviewRepo.DeleteInTxErr = deleteErr
+	enforcement := constants.ReportEnforcementDeleteReview
+
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{
+		Action:      input.HandleReportResolve,
+		Enforcement: &enforcement,
+	})
+	if !errors.Is(err, deleteErr) {
+		t.Errorf("expected delete error, got %v", err)
+	}
+}
+
+func TestHandleReport_NotPending(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDResult = &entity.Report{ReportID: 1, TargetType: "review", TargetID: testReportTargetID, Status: "resolved"}
+
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{Action: input.HandleReportReject})
+	if !errors.Is(err, usecase.ErrReportNotPending) {
+		t.Errorf("expected ErrReportNotPending, got %v", err)
 	}
 }
 
-func TestHandleReport_UpdateStatusError(t *testing.T) {
-	updateErr := errors.New("update error")
-	reportRepo := &testutil.MockReportRepository{
-		FindByIDResult:  &entity.Report{ReportID: 1, Status: "pending"},
-		UpdateStatusErr: updateErr,
+func TestHandleReport_HandledConcurrently(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDResult = pendingReport("review", testReportTargetID)
+	deps.reportRepo.ResolveErr = output.ErrReportAlreadyHandled
+
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{Action: input.HandleReportResolve})
+	if !errors.Is(err, usecase.ErrReportNotPending) {
+		t.Errorf("expected ErrReportNotPending, got %v", err)
+	}
+}
+
+func TestHandleReport_ReportNotFound(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDErr = apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 999, input.HandleReportInput{Action: input.HandleReportResolve})
+	if !errors.Is(err, usecase.ErrReportNotFound) {
+		t.Errorf("expected ErrReportNotFound, got %v", err)
 	}
-	userRepo := &testutil.MockUserRepository{}
+}
 
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+func TestHandleReport_InvalidAction(t *testing.T) {
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDResult = pendingReport("review", testReportTargetID)
 
-	err := uc.HandleReport(context.Background(), 1, input.HandleReportResolve)
-	if !errors.Is(err, updateErr) {
-		t.Errorf("expected update error, got %v", err)
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{Action: "invalid_action"})
+	if !errors.Is(err, usecase.ErrInvalidAction) {
+		t.Errorf("expected ErrInvalidAction, got %v", err)
+	}
+}
+
+func TestHandleReport_Unauthenticated(t *testing.T) {
+	_, err := newReportTestDeps().useCase().HandleReport(context.Background(), entity.User{}, 1, input.HandleReportInput{Action: input.HandleReportResolve})
+	if !errors.Is(err, usecase.ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
 	}
 }
 
 func TestHandleReport_RepositoryError(t *testing.T) {
 	dbErr := errors.New("database error")
-	reportRepo := &testutil.MockReportRepository{FindByIDErr: dbErr}
-	userRepo := &testutil.MockUserRepository{}
-
-	uc := usecase.NewReportUseCase(reportRepo, userRepo)
+	deps := newReportTestDeps()
+	deps.reportRepo.FindByIDErr = dbErr
 
-	err := uc.HandleReport(context.Background(), 1, input.HandleReportResolve)
+	_, err := deps.useCase().HandleReport(context.Background(), testReportAdmin, 1, input.HandleReportInput{Action: input.HandleReportResolve})
 	if !errors.Is(err, dbErr) {
 		t.Errorf("expected database error, got %v", err)
 	}
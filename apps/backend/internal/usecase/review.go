@@ -2,9 +2,13 @@ package usecase
 
 import (
 	"context"
+	"time"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/hours"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/role"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
@@ -14,6 +18,8 @@ type reviewUseCase struct {
 	storeRepo   output.StoreRepository
 	menuRepo    output.MenuRepository
 	fileRepo    output.FileRepository
+	ratingRepo  output.StoreRatingRepository
+	visitRepo   output.VisitRepository
 	transaction output.Transaction
 }
 
@@ -23,6 +29,8 @@ func NewReviewUseCase(
 	storeRepo output.StoreRepository,
 	menuRepo output.MenuRepository,
 	fileRepo output.FileRepository,
+	ratingRepo output.StoreRatingRepository,
+	visitRepo output.VisitRepository,
 	transaction output.Transaction,
 ) input.ReviewUseCase {
 	return &reviewUseCase{
@@ -30,100 +38,224 @@ func NewReviewUseCase(
 		storeRepo:   storeRepo,
 		menuRepo:    menuRepo,
 		fileRepo:    fileRepo,
+		ratingRepo:  ratingRepo,
+		visitRepo:   visitRepo,
 		transaction: transaction,
 	}
 }
 
-func (uc *reviewUseCase) GetReviewsByStoreID(ctx context.Context, storeID string, sort string, viewerID string) ([]entity.Review, error) {
-	if err := ensureStoreExists(ctx, uc.storeRepo, storeID); err != nil {
+// GetReviewsByStoreID は閲覧者が見られる店舗のレビューのうち、閲覧者が見られるものを返します
+func (uc *reviewUseCase) GetReviewsByStoreID(ctx context.Context, storeID string, sort string, viewer entity.User) ([]entity.Review, error) {
+	if err := ensureStoreVisible(ctx, uc.storeRepo, storeID, viewer); err != nil {
 		return nil, err
 	}
 
-	return uc.reviewRepo.FindByStoreID(ctx, storeID, normalizeReviewSort(sort), viewerID)
+	return uc.reviewRepo.FindByStoreID(ctx, storeID, normalizeReviewSort(sort), viewerOf(viewer))
 }
 
+// Create はレビューを投稿し、店舗をその日に行った店舗として記録します
 func (uc *reviewUseCase) Create(ctx context.Context, storeID string, userID string, input input.CreateReview) error {
 	if err := validateNotEmpty(storeID, userID); err != nil {
 		return err
 	}
 
-	if err := ensureStoreExists(ctx, uc.storeRepo, storeID); err != nil {
+	// 投稿者から見えない店舗にはレビューできない
+	if err := ensureStoreVisible(ctx, uc.storeRepo, storeID, entity.User{UserID: userID}); err != nil {
 		return err
 	}
 
-	if input.Rating < 1 || input.Rating > 5 {
-		return ErrInvalidRating
+	content, err := uc.validateReviewContent(ctx, storeID, reviewContent{
+		Rating:        input.Rating,
+		RatingDetails: input.RatingDetails,
+		MenuIDs:       input.MenuIDs,
+		FileIDs:       input.FileIDs,
+	})
+	if err != nil {
+		return err
 	}
 
-	if err := validateRatingDetails(input.RatingDetails); err != nil {
-		return err
+	if uc.transaction == nil {
+		return output.ErrInvalidTransaction
 	}
 
-	menuIDs := dedupeStrings(input.MenuIDs)
-	if len(menuIDs) > 0 {
-		menus, err := uc.menuRepo.FindByStoreAndIDs(ctx, storeID, menuIDs)
-		if err != nil {
+	return uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.reviewRepo.CreateInTx(ctx, tx, output.CreateReview{
+			StoreID:       storeID,
+			UserID:        userID,
+			Rating:        input.Rating,
+			RatingDetails: toOutputRatingDetails(input.RatingDetails),
+			Content:       input.Content,
+			MenuIDs:       content.MenuIDs,
+			FileIDs:       content.FileIDs,
+		}); err != nil {
 			return err
 		}
-		if len(menus) != len(menuIDs) {
-			return ErrInvalidInput
+		// レビューを書いた店舗は行ったことのある店舗として扱う
+		if err := uc.visitRepo.MarkVisitedInTx(ctx, tx, userID, storeID, hours.Date(time.Now())); err != nil {
+			return err
 		}
+		return uc.ratingRepo.RecomputeInTx(ctx, tx, storeID)
+	})
+}
+
+// Update はレビューを書き換えます。編集できるのは投稿者本人のみです
+func (uc *reviewUseCase) Update(ctx context.Context, actor entity.User, reviewID string, in input.UpdateReview) error {
+	if err := validateNotEmpty(reviewID, actor.UserID); err != nil {
+		return err
+	}
+	review, err := mustFindReview(ctx, uc.reviewRepo, reviewID)
+	if err != nil {
+		return err
+	}
+	if review.UserID != actor.UserID {
+		return ErrForbidden
 	}
 
-	fileIDs := dedupeStrings(input.FileIDs)
-	if len(fileIDs) > 0 {
-		files, err := uc.fileRepo.FindByStoreAndIDs(ctx, storeID, fileIDs)
-		if err != nil {
-			return err
-		}
-		if len(files) != len(fileIDs) {
-			return ErrInvalidFileIDs
-		}
+	content, err := uc.validateReviewContent(ctx, review.StoreID, reviewContent{
+		Rating:        in.Rating,
+		RatingDetails: in.RatingDetails,
+		MenuIDs:       in.MenuIDs,
+		FileIDs:       in.FileIDs,
+	})
+	if err != nil {
+		return err
 	}
 
 	if uc.transaction == nil {
 		return output.ErrInvalidTransaction
 	}
 
-	var ratingDetails *output.RatingDetails
-	if input.RatingDetails != nil {
-		ratingDetails = &output.RatingDetails{
-			Taste:       input.RatingDetails.Taste,
-			Atmosphere:  input.RatingDetails.Atmosphere,
-			Service:     input.RatingDetails.Service,
-			Speed:       input.RatingDetails.Speed,
-			Cleanliness: input.RatingDetails.Cleanliness,
+	return uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.reviewRepo.UpdateInTx(ctx, tx, reviewID, output.UpdateReview{
+			Rating:        in.Rating,
+			RatingDetails: toOutputRatingDetails(in.RatingDetails),
+			Content:       in.Content,
+			MenuIDs:       content.MenuIDs,
+			FileIDs:       content.FileIDs,
+		}); err != nil {
+			return err
 		}
+		return uc.ratingRepo.RecomputeInTx(ctx, tx, review.StoreID)
+	})
+}
+
+// Delete はレビューを削除し、添付ファイルを論理削除します。投稿者本人と admin が実行できます
+func (uc *reviewUseCase) Delete(ctx context.Context, actor entity.User, reviewID string) error {
+	if err := validateNotEmpty(reviewID); err != nil {
+		return err
+	}
+	review, err := mustFindReview(ctx, uc.reviewRepo, reviewID)
+	if err != nil {
+		return err
+	}
+	if actor.Role != role.Admin && (actor.UserID == "" || review.UserID != actor.UserID) {
+		return ErrForbidden
+	}
+
+	if uc.transaction == nil {
+		return output.ErrInvalidTransaction
 	}
 
 	return uc.transaction.StartTransaction(func(tx interface{}) error {
-		return uc.reviewRepo.CreateInTx(ctx, tx, output.CreateReview{
-			StoreID:       storeID,
-			UserID:        userID,
-			Rating:        input.Rating,
-			RatingDetails: ratingDetails,
-			Content:       input.Content,
-			MenuIDs:       menuIDs,
-			FileIDs:       fileIDs,
-		})
+		if err := uc.reviewRepo.DeleteInTx(ctx, tx, reviewID); err != nil {
+			return err
+		}
+		return uc.ratingRepo.RecomputeInTx(ctx, tx, review.StoreID)
 	})
 }
 
+// reviewContent は作成・更新で共通して検証するレビューの内容です
+type reviewContent struct {
+	Rating        int
+	RatingDetails *input.RatingDetails
+	MenuIDs       []string
+	FileIDs       []string
+}
+
+// validateReviewContent は評価値と、メニュー・ファイルが店舗に属することを検証し、
+// 重複を除いたメニューID・ファイルIDを返します
+func (uc *reviewUseCase) validateReviewContent(ctx context.Context, storeID string, content reviewContent) (reviewContent, error) {
+	if content.Rating < 1 || content.Rating > 5 {
+		return reviewContent{}, ErrInvalidRating
+	}
+
+	if err := validateRatingDetails(content.RatingDetails); err != nil {
+		return reviewContent{}, err
+	}
+
+	content.MenuIDs = dedupeStrings(content.MenuIDs)
+	if len(content.MenuIDs) > 0 {
+		menus, err := uc.menuRepo.FindByStoreAndIDs(ctx, storeID, content.MenuIDs)
+		if err != nil {
+			return reviewContent{}, err
+		}
+		if len(menus) != len(content.MenuIDs) {
+			return reviewContent{}, ErrInvalidInput
+		}
+	}
+
+	content.FileIDs = dedupeStrings(content.FileIDs)
+	if len(content.FileIDs) > 0 {
+		files, err := uc.fileRepo.FindByStoreAndIDs(ctx, storeID, content.FileIDs)
+		if err != nil {
+			return reviewContent{}, err
+		}
+		if len(files) != len(content.FileIDs) {
+			return reviewContent{}, ErrInvalidFileIDs
+		}
+	}
+
+	return content, nil
+}
+
+func toOutputRatingDetails(rd *input.RatingDetails) *output.RatingDetails {
+	if rd == nil {
+		return nil
+	}
+	return &output.RatingDetails{
+		Taste:       rd.Taste,
+		Atmosphere:  rd.Atmosphere,
+		Service:     rd.Service,
+		Speed:       rd.Speed,
+		Cleanliness: rd.Cleanliness,
+	}
+}
+
+// GetRatingSummary は閲覧者が見られる店舗の評価集計（平均・件数・星別件数・項目別平均）を返します
+func (uc *reviewUseCase) GetRatingSummary(ctx context.Context, storeID string, viewer entity.User) (*entity.RatingSummary, error) {
+	if err := validateNotEmpty(storeID); err != nil {
+		return nil, err
+	}
+	if err := ensureStoreVisible(ctx, uc.storeRepo, storeID, viewer); err != nil {
+		return nil, err
+	}
+	summary, err := uc.ratingRepo.FindByStoreID(ctx, storeID)
+	if err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return nil, ErrStoreNotFound
+		}
+		return nil, err
+	}
+	return summary, nil
+}
+
+// LikeReview はレビューにいいねします。ユーザーから見えないレビューにはいいねできません
 func (uc *reviewUseCase) LikeReview(ctx context.Context, reviewID string, userID string) error {
 	if err := validateNotEmpty(reviewID, userID); err != nil {
 		return err
 	}
-	if err := ensureReviewExists(ctx, uc.reviewRepo, reviewID); err != nil {
+	if err := ensureReviewVisible(ctx, uc.reviewRepo, reviewID, entity.User{UserID: userID}); err != nil {
 		return err
 	}
 	return uc.reviewRepo.AddLike(ctx, reviewID, userID)
 }
 
+// UnlikeReview はレビューのいいねを取り消します。ユーザーから見えないレビューは見つからないものとして扱います
 func (uc *reviewUseCase) UnlikeReview(ctx context.Context, reviewID string, userID string) error {
 	if err := validateNotEmpty(reviewID, userID); err != nil {
 		return err
 	}
-	if err := ensureReviewExists(ctx, uc.reviewRepo, reviewID); err != nil {
+	if err := ensureReviewVisible(ctx, uc.reviewRepo, reviewID, entity.User{UserID: userID}); err != nil {
 		return err
 	}
 	return uc.reviewRepo.RemoveLike(ctx, reviewID, userID)
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type imageProcessingReviewUseCase struct {
+	input.ReviewUseCase
+	queue output.ImageProcessingQueue
+}
+
+// NewImageProcessingReviewUseCase はレビューの投稿・編集のあとに、添付された画像の加工を依頼する ReviewUseCase を返します
+func NewImageProcessingReviewUseCase(inner input.ReviewUseCase, queue output.ImageProcessingQueue) input.ReviewUseCase {
+	return &imageProcessingReviewUseCase{ReviewUseCase: inner, queue: queue}
+}
+
+func (uc *imageProcessingReviewUseCase) Create(ctx context.Context, storeID string, userID string, in input.CreateReview) error {
+	if err := uc.ReviewUseCase.Create(ctx, storeID, userID, in); err != nil {
+		return err
+	}
+	uc.enqueue(in.FileIDs)
+	return nil
+}
+
+func (uc *imageProcessingReviewUseCase) Update(ctx context.Context, actor entity.User, reviewID string, in input.UpdateReview) error {
+	if err := uc.ReviewUseCase.Update(ctx, actor, reviewID, in); err != nil {
+		return err
+	}
+	uc.enqueue(in.FileIDs)
+	return nil
+}
+
+// enqueue は加工を依頼します。加工済みのファイルは加工のときに読み飛ばされる
+func (uc *imageProcessingReviewUseCase) enqueue(fileIDs []string) {
+	if len(fileIDs) == 0 {
+		return
+	}
+	uc.queue.Enqueue(fileIDs)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/role"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
@@ -26,9 +27,9 @@ func TestGetReviewsByStoreID_Success(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
-	result, err := uc.GetReviewsByStoreID(context.Background(), "store-1", "", "")
+	result, err := uc.GetReviewsByStoreID(context.Background(), "store-1", "", entity.User{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -46,14 +47,45 @@ func TestGetReviewsByStoreID_StoreNotFound(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
-	_, err := uc.GetReviewsByStoreID(context.Background(), "nonexistent", "", "")
+	_, err := uc.GetReviewsByStoreID(context.Background(), "nonexistent", "", entity.User{})
 	if !errors.Is(err, usecase.ErrStoreNotFound) {
 		t.Errorf("expected ErrStoreNotFound, got %v", err)
 	}
 }
 
+func TestGetReviewsByStoreID_PassesViewer(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.GetReviewsByStoreID(context.Background(), "store-1", "", entity.User{UserID: "admin-1", Role: "admin"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	viewer := reviewRepo.FindByStoreIDCalledWith.Viewer
+	if viewer.UserID != "admin-1" || !viewer.IsAdmin {
+		t.Errorf("unexpected viewer: %+v", viewer)
+	}
+}
+
+func TestGetReviewsByStoreID_StoreNotVisible(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}, NotVisible: true}
+
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.GetReviewsByStoreID(context.Background(), "store-1", "", entity.User{})
+	if !errors.Is(err, usecase.ErrStoreNotFound) {
+		t.Errorf("expected ErrStoreNotFound, got %v", err)
+	}
+	if reviewRepo.FindByStoreIDCalled {
+		t.Error("expected reviews not to be fetched")
+	}
+}
+
 func TestGetReviewsByStoreID_SortOptions(t *testing.T) {
 	tests := []struct {
 		name string
@@ -74,9 +106,9 @@ func TestGetReviewsByStoreID_SortOptions(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			txn := &testutil.MockTransaction{}
 
-			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
-			_, err := uc.GetReviewsByStoreID(context.Background(), "store-1", tt.sort, "")
+			_, err := uc.GetReviewsByStoreID(context.Background(), "store-1", tt.sort, entity.User{})
 			if err != nil {
 				t.Errorf("unexpected error: %v", err)
 			}
@@ -92,9 +124,9 @@ func TestGetReviewsByStoreID_RepositoryError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
-	_, err := uc.GetReviewsByStoreID(context.Background(), "store-1", "", "")
+	_, err := uc.GetReviewsByStoreID(context.Background(), "store-1", "", entity.User{})
 	if !errors.Is(err, dbErr) {
 		t.Errorf("expected database error, got %v", err)
 	}
@@ -109,7 +141,7 @@ func TestCreate_Success(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 		Rating: 5,
@@ -119,6 +151,35 @@ func TestCreate_Success(t *testing.T) {
 	}
 }
 
+func TestCreate_MarksStoreVisited(t *testing.T) {
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+	visitRepo := &testutil.MockVisitRepository{}
+
+	uc := usecase.NewReviewUseCase(&testutil.MockReviewRepository{}, storeRepo, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, &testutil.MockStoreRatingRepository{}, visitRepo, &testutil.MockTransaction{})
+
+	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{Rating: 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	marked := visitRepo.MarkVisitedCalledWith
+	if marked.UserID != "user-1" || marked.StoreID != "store-1" || marked.VisitedOn.IsZero() {
+		t.Errorf("expected store-1 to be marked visited by user-1, got %+v", marked)
+	}
+}
+
+func TestCreate_MarkVisitedError(t *testing.T) {
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+	visitErr := errors.New("visit error")
+	visitRepo := &testutil.MockVisitRepository{MarkVisitedErr: visitErr}
+
+	uc := usecase.NewReviewUseCase(&testutil.MockReviewRepository{}, storeRepo, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, &testutil.MockStoreRatingRepository{}, visitRepo, &testutil.MockTransaction{})
+
+	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{Rating: 4})
+	if !errors.Is(err, visitErr) {
+		t.Errorf("expected visit error, got %v", err)
+	}
+}
+
 func TestCreate_InvalidInput(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -138,7 +199,7 @@ func TestCreate_InvalidInput(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			txn := &testutil.MockTransaction{}
 
-			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 			err := uc.Create(context.Background(), tt.storeID, tt.userID, input.CreateReview{
 				Rating: 5,
@@ -159,7 +220,7 @@ func TestCreate_StoreNotFound(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.Create(context.Background(), "nonexistent", "user-1", input.CreateReview{
 		Rating: 5,
@@ -188,7 +249,7 @@ func TestCreate_InvalidRating(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			txn := &testutil.MockTransaction{}
 
-			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 			err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 				Rating: tt.rating,
@@ -209,7 +270,7 @@ func TestCreate_ValidRatings(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			txn := &testutil.MockTransaction{}
 
-			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 			err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 				Rating: rating,
@@ -293,7 +354,7 @@ func TestCreate_InvalidRatingDetails(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			txn := &testutil.MockTransaction{}
 
-			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 			err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 				Rating:        5,
@@ -374,7 +435,7 @@ func TestCreate_ValidRatingDetails(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			txn := &testutil.MockTransaction{}
 
-			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 			err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 				Rating:        5,
@@ -396,7 +457,7 @@ func TestCreate_InvalidMenuIDs(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 		Rating:  5,
@@ -416,7 +477,7 @@ func TestCreate_InvalidFileIDs(t *testing.T) {
 	}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 		Rating:  5,
@@ -433,7 +494,7 @@ func TestCreate_TransactionNil(t *testing.T) {
 	menuRepo := &testutil.MockMenuRepository{}
 	fileRepo := &testutil.MockFileRepository{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, nil)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, nil)
 
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 		Rating: 5,
@@ -459,7 +520,7 @@ func TestCreate_WithMenusAndFiles(t *testing.T) {
 	}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 		Rating:  5,
@@ -479,7 +540,7 @@ func TestCreate_TransactionError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 		Rating: 5,
@@ -500,7 +561,7 @@ func TestLikeReview_Success(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.LikeReview(context.Background(), "review-1", "user-1")
 	if err != nil {
@@ -527,7 +588,7 @@ func TestLikeReview_InvalidInput(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			txn := &testutil.MockTransaction{}
 
-			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 			err := uc.LikeReview(context.Background(), tt.reviewID, tt.userID)
 			if !errors.Is(err, usecase.ErrInvalidInput) {
@@ -546,7 +607,7 @@ func TestLikeReview_ReviewNotFound(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.LikeReview(context.Background(), "nonexistent", "user-1")
 	if !errors.Is(err, usecase.ErrReviewNotFound) {
@@ -554,6 +615,26 @@ func TestLikeReview_ReviewNotFound(t *testing.T) {
 	}
 }
 
+func TestLikeReview_ReviewNotVisible(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{
+		FindByIDResult: &entity.Review{ReviewID: "review-1"},
+		NotVisible:     true,
+	}
+
+	uc := usecase.NewReviewUseCase(reviewRepo, &testutil.MockStoreRepository{}, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, &testutil.MockTransaction{})
+
+	err := uc.LikeReview(context.Background(), "review-1", "user-1")
+	if !errors.Is(err, usecase.ErrReviewNotFound) {
+		t.Errorf("expected ErrReviewNotFound, got %v", err)
+	}
+	if reviewRepo.VisibleViewer.UserID != "user-1" {
+		t.Errorf("expected visibility to be checked for user-1, got %+v", reviewRepo.VisibleViewer)
+	}
+	if reviewRepo.AddLikeCalled {
+		t.Error("expected like not to be added")
+	}
+}
+
 func TestLikeReview_AddLikeError(t *testing.T) {
 	likeErr := errors.New("add like error")
 	reviewRepo := &testutil.MockReviewRepository{
@@ -565,7 +646,7 @@ func TestLikeReview_AddLikeError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.LikeReview(context.Background(), "review-1", "user-1")
 	if !errors.Is(err, likeErr) {
@@ -584,7 +665,7 @@ func TestUnlikeReview_Success(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.UnlikeReview(context.Background(), "review-1", "user-1")
 	if err != nil {
@@ -610,7 +691,7 @@ func TestUnlikeReview_InvalidInput(t *testing.T) {
 			fileRepo := &testutil.MockFileRepository{}
 			txn := &testutil.MockTransaction{}
 
-			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+			uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 			err := uc.UnlikeReview(context.Background(), tt.reviewID, tt.userID)
 			if !errors.Is(err, usecase.ErrInvalidInput) {
@@ -629,7 +710,7 @@ func TestUnlikeReview_ReviewNotFound(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.UnlikeReview(context.Background(), "nonexistent", "user-1")
 	if !errors.Is(err, usecase.ErrReviewNotFound) {
@@ -637,6 +718,23 @@ func TestUnlikeReview_ReviewNotFound(t *testing.T) {
 	}
 }
 
+func TestUnlikeReview_ReviewNotVisible(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{
+		FindByIDResult: &entity.Review{ReviewID: "review-1"},
+		NotVisible:     true,
+	}
+
+	uc := usecase.NewReviewUseCase(reviewRepo, &testutil.MockStoreRepository{}, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, &testutil.MockTransaction{})
+
+	err := uc.UnlikeReview(context.Background(), "review-1", "user-1")
+	if !errors.Is(err, usecase.ErrReviewNotFound) {
+		t.Errorf("expected ErrReviewNotFound, got %v", err)
+	}
+	if reviewRepo.RemoveLikeCalled {
+		t.Error("expected like not to be removed")
+	}
+}
+
 func TestUnlikeReview_RemoveLikeError(t *testing.T) {
 	unlikeErr := errors.New("remove like error")
 	reviewRepo := &testutil.MockReviewRepository{
@@ -648,7 +746,7 @@ func TestUnlikeReview_RemoveLikeError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.UnlikeReview(context.Background(), "review-1", "user-1")
 	if !errors.Is(err, unlikeErr) {
@@ -668,7 +766,7 @@ func TestLikeReview_RepositoryError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.LikeReview(context.Background(), "review-1", "user-1")
 	if !errors.Is(err, dbErr) {
@@ -686,7 +784,7 @@ func TestUnlikeReview_RepositoryError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.UnlikeReview(context.Background(), "review-1", "user-1")
 	if !errors.Is(err, dbErr) {
@@ -704,7 +802,7 @@ func TestCreate_MenuRepoError(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 		Rating:  5,
@@ -725,7 +823,7 @@ func TestCreate_FileRepoError(t *testing.T) {
 	}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
 		Rating:  5,
@@ -747,7 +845,7 @@ func TestCreate_DeduplicatesMenuIDs(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	// Pass duplicate menu IDs - should be deduplicated to 1
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
@@ -770,7 +868,7 @@ func TestCreate_DeduplicatesFileIDs(t *testing.T) {
 	}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	// Pass duplicate file IDs - should be deduplicated to 1
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
@@ -793,7 +891,7 @@ func TestCreate_SkipsEmptyMenuIDs(t *testing.T) {
 	fileRepo := &testutil.MockFileRepository{}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	// Pass menu IDs with empty strings - should be filtered out
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
@@ -816,7 +914,7 @@ func TestCreate_SkipsEmptyFileIDs(t *testing.T) {
 	}
 	txn := &testutil.MockTransaction{}
 
-	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, txn)
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, menuRepo, fileRepo, &testutil.MockStoreRatingRepository{}, &testutil.MockVisitRepository{}, txn)
 
 	// Pass file IDs with empty strings - should be filtered out
 	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{
@@ -827,3 +925,239 @@ func TestCreate_SkipsEmptyFileIDs(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+// --- Rating aggregate Tests ---
+
+func TestCreate_RecomputesStoreRating(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+	ratingRepo := &testutil.MockStoreRatingRepository{}
+	txn := &testutil.MockTransaction{}
+
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, ratingRepo, &testutil.MockVisitRepository{}, txn)
+
+	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{Rating: 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ratingRepo.RecomputeCalledWith) != 1 || ratingRepo.RecomputeCalledWith[0] != "store-1" {
+		t.Errorf("expected rating recompute for store-1, got %v", ratingRepo.RecomputeCalledWith)
+	}
+}
+
+func TestCreate_RecomputeError(t *testing.T) {
+	recomputeErr := errors.New("recompute failed")
+	reviewRepo := &testutil.MockReviewRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+	ratingRepo := &testutil.MockStoreRatingRepository{RecomputeErr: recomputeErr}
+	txn := &testutil.MockTransaction{}
+
+	uc := usecase.NewReviewUseCase(reviewRepo, storeRepo, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, ratingRepo, &testutil.MockVisitRepository{}, txn)
+
+	err := uc.Create(context.Background(), "store-1", "user-1", input.CreateReview{Rating: 4})
+	if !errors.Is(err, recomputeErr) {
+		t.Errorf("expected recompute error, got %v", err)
+	}
+}
+
+func TestGetRatingSummary_Success(t *testing.T) {
+	summary := &entity.RatingSummary{StoreID: "store-1", AverageRating: 4.5, ReviewCount: 2}
+	ratingRepo := &testutil.MockStoreRatingRepository{Summary: summary}
+
+	uc := usecase.NewReviewUseCase(&testutil.MockReviewRepository{}, &testutil.MockStoreRepository{}, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, ratingRepo, &testutil.MockVisitRepository{}, &testutil.MockTransaction{})
+
+	result, err := uc.GetRatingSummary(context.Background(), "store-1", entity.User{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ReviewCount != 2 || result.AverageRating != 4.5 {
+		t.Errorf("unexpected summary: %+v", result)
+	}
+}
+
+func TestGetRatingSummary_StoreNotFound(t *testing.T) {
+	ratingRepo := &testutil.MockStoreRatingRepository{
+		FindErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
+	}
+
+	uc := usecase.NewReviewUseCase(&testutil.MockReviewRepository{}, &testutil.MockStoreRepository{}, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, ratingRepo, &testutil.MockVisitRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.GetRatingSummary(context.Background(), "missing", entity.User{})
+	if !errors.Is(err, usecase.ErrStoreNotFound) {
+		t.Errorf("expected ErrStoreNotFound, got %v", err)
+	}
+}
+
+func TestGetRatingSummary_StoreNotVisible(t *testing.T) {
+	storeRepo := &testutil.MockStoreRepository{NotVisible: true}
+	ratingRepo := &testutil.MockStoreRatingRepository{Summary: &entity.RatingSummary{StoreID: "store-1"}}
+
+	uc := usecase.NewReviewUseCase(&testutil.MockReviewRepository{}, storeRepo, &testutil.MockMenuRepository{}, &testutil.MockFileRepository{}, ratingRepo, &testutil.MockVisitRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.GetRatingSummary(context.Background(), "store-1", entity.User{UserID: "user-1", Role: role.User})
+	if !errors.Is(err, usecase.ErrStoreNotFound) {
+		t.Errorf("expected ErrStoreNotFound, got %v", err)
+	}
+	if storeRepo.VisibleViewer.UserID != "user-1" || storeRepo.VisibleViewer.IsAdmin {
+		t.Errorf("expected visibility to be checked for the viewer, got %+v", storeRepo.VisibleViewer)
+	}
+}
+
+// --- Update / Delete Tests ---
+
+var testReviewAuthor = entity.User{UserID: "user-1", Role: role.User}
+
+func newEditableReviewUseCase(reviewRepo *testutil.MockReviewRepository, menuRepo *testutil.MockMenuRepository, ratingRepo *testutil.MockStoreRatingRepository) input.ReviewUseCase {
+	return usecase.NewReviewUseCase(reviewRepo, &testutil.MockStoreRepository{}, menuRepo, &testutil.MockFileRepository{}, ratingRepo, &testutil.MockVisitRepository{}, &testutil.MockTransaction{})
+}
+
+func TestUpdate_Success(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{
+		FindByIDResult: &entity.Review{ReviewID: "review-1", StoreID: "store-1", UserID: "user-1"},
+	}
+	menuRepo := &testutil.MockMenuRepository{FindByStoreAndIDsResult: []entity.Menu{{MenuID: "menu-1"}}}
+	ratingRepo := &testutil.MockStoreRatingRepository{}
+	uc := newEditableReviewUseCase(reviewRepo, menuRepo, ratingRepo)
+
+	content := "fixed typo"
+	taste := 4
+	err := uc.Update(context.Background(), testReviewAuthor, "review-1", input.UpdateReview{
+		Rating:        3,
+		RatingDetails: &input.RatingDetails{Taste: &taste},
+		Content:       &content,
+		MenuIDs:       []string{"menu-1", "menu-1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := reviewRepo.UpdateInTxCalledWith
+	if got.ReviewID != "review-1" || got.Review.Rating != 3 || *got.Review.Content != content {
+		t.Errorf("unexpected update: %+v", got)
+	}
+	if len(got.Review.MenuIDs) != 1 {
+		t.Errorf("expected deduped menu IDs, got %v", got.Review.MenuIDs)
+	}
+	if got.Review.RatingDetails == nil || *got.Review.RatingDetails.Taste != 4 {
+		t.Errorf("expected rating details to be passed through, got %+v", got.Review.RatingDetails)
+	}
+	if len(ratingRepo.RecomputeCalledWith) != 1 || ratingRepo.RecomputeCalledWith[0] != "store-1" {
+		t.Errorf("expected rating recompute for store-1, got %v", ratingRepo.RecomputeCalledWith)
+	}
+}
+
+func TestUpdate_NotAuthor(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{
+		FindByIDResult: &entity.Review{ReviewID: "review-1", StoreID: "store-1", UserID: "someone-else"},
+	}
+	uc := newEditableReviewUseCase(reviewRepo, &testutil.MockMenuRepository{}, &testutil.MockStoreRatingRepository{})
+
+	// admin であっても他人のレビューは編集できない
+	for _, actor := range []entity.User{testReviewAuthor, testAdmin} {
+		err := uc.Update(context.Background(), actor, "review-1", input.UpdateReview{Rating: 4})
+		if !errors.Is(err, usecase.ErrForbidden) {
+			t.Errorf("expected ErrForbidden for %s, got %v", actor.UserID, err)
+		}
+	}
+	if reviewRepo.UpdateInTxCalled {
+		t.Error("expected UpdateInTx not to be called")
+	}
+}
+
+func TestUpdate_ReviewNotFound(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{
+		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
+	}
+	uc := newEditableReviewUseCase(reviewRepo, &testutil.MockMenuRepository{}, &testutil.MockStoreRatingRepository{})
+
+	err := uc.Update(context.Background(), testReviewAuthor, "missing", input.UpdateReview{Rating: 4})
+	if !errors.Is(err, usecase.ErrReviewNotFound) {
+		t.Errorf("expected ErrReviewNotFound, got %v", err)
+	}
+}
+
+func TestUpdate_ValidatesLikeCreate(t *testing.T) {
+	invalidTaste := 6
+	tests := []struct {
+		name    string
+		in      input.UpdateReview
+		menus   []entity.Menu
+		wantErr error
+	}{
+		{"invalid rating", input.UpdateReview{Rating: 0}, nil, usecase.ErrInvalidRating},
+		{"invalid rating details", input.UpdateReview{Rating: 4, RatingDetails: &input.RatingDetails{Taste: &invalidTaste}}, nil, usecase.ErrInvalidRatingDetails},
+		{"menu from another store", input.UpdateReview{Rating: 4, MenuIDs: []string{"menu-x"}}, []entity.Menu{}, usecase.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewRepo := &testutil.MockReviewRepository{
+				FindByIDResult: &entity.Review{ReviewID: "review-1", StoreID: "store-1", UserID: "user-1"},
+			}
+			menuRepo := &testutil.MockMenuRepository{FindByStoreAndIDsResult: tt.menus}
+			uc := newEditableReviewUseCase(reviewRepo, menuRepo, &testutil.MockStoreRatingRepository{})
+
+			err := uc.Update(context.Background(), testReviewAuthor, "review-1", tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if reviewRepo.UpdateInTxCalled {
+				t.Error("expected UpdateInTx not to be called")
+			}
+		})
+	}
+}
+
+func TestDelete_AuthorAndAdmin(t *testing.T) {
+	for _, actor := range []entity.User{testReviewAuthor, testAdmin} {
+		t.Run(actor.UserID, func(t *testing.T) {
+			reviewRepo := &testutil.MockReviewRepository{
+				FindByIDResult: &entity.Review{ReviewID: "review-1", StoreID: "store-1", UserID: "user-1"},
+			}
+			ratingRepo := &testutil.MockStoreRatingRepository{}
+			uc := newEditableReviewUseCase(reviewRepo, &testutil.MockMenuRepository{}, ratingRepo)
+
+			if err := uc.Delete(context.Background(), actor, "review-1"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if reviewRepo.DeleteInTxCalledWith != "review-1" {
+				t.Errorf("expected DeleteInTx for review-1, got %q", reviewRepo.DeleteInTxCalledWith)
+			}
+			if len(ratingRepo.RecomputeCalledWith) != 1 || ratingRepo.RecomputeCalledWith[0] != "store-1" {
+				t.Errorf("expected rating recompute for store-1, got %v", ratingRepo.RecomputeCalledWith)
+			}
+		})
+	}
+}
+
+func TestDelete_Forbidden(t *testing.T) {
+	reviewRepo := &testutil.MockReviewRepository{
+		FindByIDResult: &entity.Review{ReviewID: "review-1", StoreID: "store-1", UserID: "someone-else"},
+	}
+	uc := newEditableReviewUseCase(reviewRepo, &testutil.MockMenuRepository{}, &testutil.MockStoreRatingRepository{})
+
+	err := uc.Delete(context.Background(), testOwner, "review-1")
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+	if reviewRepo.DeleteInTxCalled {
+		t.Error("expected DeleteInTx not to be called")
+	}
+}
+
+func TestDelete_RepositoryError(t *testing.T) {
+	deleteErr := errors.New("delete failed")
+	reviewRepo := &testutil.MockReviewRepository{
+		FindByIDResult: &entity.Review{ReviewID: "review-1", StoreID: "store-1", UserID: "user-1"},
+		DeleteInTxErr:  deleteErr,
+	}
+	ratingRepo := &testutil.MockStoreRatingRepository{}
+	uc := newEditableReviewUseCase(reviewRepo, &testutil.MockMenuRepository{}, ratingRepo)
+
+	err := uc.Delete(context.Background(), testReviewAuthor, "review-1")
+	if !errors.Is(err, deleteErr) {
+		t.Errorf("expected delete error, got %v", err)
+	}
+	if len(ratingRepo.RecomputeCalledWith) != 0 {
+		t.Error("expected no recompute after failed delete")
+	}
+}
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/search"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// ハイライトを返すフィールド名。レスポンスの JSON フィールド名に合わせる
+const (
+	searchFieldName        = "name"
+	searchFieldNameKana    = "name_kana"
+	searchFieldTags        = "tags"
+	searchFieldMenus       = "menus"
+	searchFieldAddress     = "address"
+	searchFieldDescription = "description"
+	searchFieldContent     = "content"
+)
+
+// SearchUseCase は店舗・レビューのキーワード検索を提供します
+type SearchUseCase interface {
+	Search(ctx context.Context, query input.SearchQuery) (*input.SearchPage, error)
+}
+
+type searchUseCase struct {
+	searchRepo output.SearchRepository
+	storeRepo  output.StoreRepository
+	reviewRepo output.ReviewRepository
+}
+
+// NewSearchUseCase は SearchUseCase の実装を生成します
+func NewSearchUseCase(
+	searchRepo output.SearchRepository,
+	storeRepo output.StoreRepository,
+	reviewRepo output.ReviewRepository,
+) SearchUseCase {
+	return &searchUseCase{
+		searchRepo: searchRepo,
+		storeRepo:  storeRepo,
+		reviewRepo: reviewRepo,
+	}
+}
+
+// Search は検索語を正規化して検索し、一致した店舗・レビューをハイライト付きで関連度順に返します
+func (uc *searchUseCase) Search(ctx context.Context, query input.SearchQuery) (*input.SearchPage, error) {
+	normalized := search.Normalize(query.Q)
+	if normalized == "" || utf8.RuneCountInString(normalized) > constants.MaxSearchQueryLength {
+		return nil, ErrInvalidSearchQuery
+	}
+	if query.Type != "" && query.Type != constants.SearchTypeStore && query.Type != constants.SearchTypeReview {
+		return nil, ErrInvalidInput
+	}
+	limit, err := normalizeLimit(query.Limit, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := uc.searchRepo.Search(ctx, output.SearchQuery{
+		Query:  normalized,
+		Type:   query.Type,
+		Limit:  limit,
+		Cursor: query.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := uc.loadResults(ctx, page.Hits, strings.Fields(normalized))
+	if err != nil {
+		return nil, err
+	}
+	return &input.SearchPage{Results: results, NextCursor: page.NextCursor}, nil
+}
+
+// loadResults は検索結果の店舗・レビューを読み込み、ヒット順に組み立てます。
+// 検索後に削除された店舗・レビューは結果から除きます
+func (uc *searchUseCase) loadResults(ctx context.Context, hits []entity.SearchHit, terms []string) ([]entity.SearchResult, error) {
+	var storeIDs, reviewIDs []string
+	for _, hit := range hits {
+		switch hit.Type {
+		case constants.SearchTypeStore:
+			storeIDs = append(storeIDs, hit.ID)
+		case constants.SearchTypeReview:
+			reviewIDs = append(reviewIDs, hit.ID)
+		}
+	}
+
+	reviewByID := map[string]entity.Review{}
+	if len(reviewIDs) > 0 {
+		reviews, err := uc.reviewRepo.FindByIDs(ctx, reviewIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, review := range reviews {
+			reviewByID[review.ReviewID] = review
+			storeIDs = append(storeIDs, review.StoreID)
+		}
+	}
+
+	storeByID := map[string]entity.Store{}
+	if len(storeIDs) > 0 {
+		stores, err := uc.storeRepo.FindByIDs(ctx, dedupeStrings(storeIDs))
+		if err != nil {
+			return nil, err
+		}
+		for _, store := range stores {
+			storeByID[store.StoreID] = store
+		}
+	}
+
+	results := make([]entity.SearchResult, 0, len(hits))
+	for _, hit := range hits {
+		result := entity.SearchResult{Type: hit.Type, Score: hit.Score}
+		switch hit.Type {
+		case constants.SearchTypeStore:
+			store, ok := storeByID[hit.ID]
+			if !ok {
+				continue
+			}
+			result.Highlights = storeHighlights(store, terms)
+			// メニューはハイライトにだけ使い、レスポンスには含めない
+			store.Menus = nil
+			result.Store = &store
+		case constants.SearchTypeReview:
+			review, ok := reviewByID[hit.ID]
+			if !ok {
+				continue
+			}
+			store, ok := storeByID[review.StoreID]
+			if !ok {
+				continue
+			}
+			store.Menus = nil
+			result.Store = &store
+			result.Review = &review
+			result.Highlights = appendHighlight(nil, searchFieldContent, review.Content, terms)
+		default:
+			continue
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// storeHighlights は店舗のフィールドのうち検索語に一致したものの抜粋を返します
+func storeHighlights(store entity.Store, terms []string) []entity.SearchHighlight {
+	var highlights []entity.SearchHighlight
+	highlights = appendHighlight(highlights, searchFieldName, &store.Name, terms)
+	highlights = appendHighlight(highlights, searchFieldNameKana, store.NameKana, terms)
+	for i := range store.Tags {
+		highlights = appendHighlight(highlights, searchFieldTags, &store.Tags[i], terms)
+	}
+	for i := range store.Menus {
+		highlights = appendHighlight(highlights, searchFieldMenus, &store.Menus[i].Name, terms)
+	}
+	highlights = appendHighlight(highlights, searchFieldAddress, &store.Address, terms)
+	highlights = appendHighlight(highlights, searchFieldDescription, store.Description, terms)
+	return highlights
+}
+
+func appendHighlight(highlights []entity.SearchHighlight, field string, text *string, terms []string) []entity.SearchHighlight {
+	if text == nil {
+		return highlights
+	}
+	segments, ok := search.Snippet(*text, terms, constants.SearchSnippetLength)
+	if !ok {
+		return highlights
+	}
+	return append(highlights, entity.SearchHighlight{Field: field, Segments: segments})
+}
@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+func newSearchUseCase(searchRepo *testutil.MockSearchRepository, storeRepo *testutil.MockStoreRepository, reviewRepo *testutil.MockReviewRepository) usecase.SearchUseCase {
+	return usecase.NewSearchUseCase(searchRepo, storeRepo, reviewRepo)
+}
+
+func TestSearch_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		q    string
+	}{
+		{name: "empty", q: ""},
+		{name: "blank", q: " 　 "},
+		{name: "too long", q: strings.Repeat("あ", constants.MaxSearchQueryLength+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			searchRepo := &testutil.MockSearchRepository{}
+			uc := newSearchUseCase(searchRepo, &testutil.MockStoreRepository{}, &testutil.MockReviewRepository{})
+
+			if _, err := uc.Search(context.Background(), input.SearchQuery{Q: tt.q}); !errors.Is(err, usecase.ErrInvalidSearchQuery) {
+				t.Errorf("expected ErrInvalidSearchQuery, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSearch_InvalidType(t *testing.T) {
+	uc := newSearchUseCase(&testutil.MockSearchRepository{}, &testutil.MockStoreRepository{}, &testutil.MockReviewRepository{})
+
+	if _, err := uc.Search(context.Background(), input.SearchQuery{Q: "cafe", Type: "menu"}); !errors.Is(err, usecase.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestSearch_NormalizesQueryAndClampsLimit(t *testing.T) {
+	searchRepo := &testutil.MockSearchRepository{}
+	uc := newSearchUseCase(searchRepo, &testutil.MockStoreRepository{}, &testutil.MockReviewRepository{})
+
+	_, err := uc.Search(context.Background(), input.SearchQuery{
+		Q:      " カフェ　ＴＯＫＹＯ ",
+		Type:   constants.SearchTypeStore,
+		Limit:  constants.MaxSearchLimit + 10,
+		Cursor: "cursor",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := output.SearchQuery{Query: "かふぇ tokyo", Type: constants.SearchTypeStore, Limit: constants.MaxSearchLimit, Cursor: "cursor"}
+	if searchRepo.SearchCalledWith != want {
+		t.Errorf("expected query %+v, got %+v", want, searchRepo.SearchCalledWith)
+	}
+}
+
+func TestSearch_DefaultLimit(t *testing.T) {
+	searchRepo := &testutil.MockSearchRepository{}
+	uc := newSearchUseCase(searchRepo, &testutil.MockStoreRepository{}, &testutil.MockReviewRepository{})
+
+	if _, err := uc.Search(context.Background(), input.SearchQuery{Q: "cafe"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if searchRepo.SearchCalledWith.Limit != constants.DefaultSearchLimit {
+		t.Errorf("expected default limit %d, got %d", constants.DefaultSearchLimit, searchRepo.SearchCalledWith.Limit)
+	}
+}
+
+func TestSearch_RepositoryError(t *testing.T) {
+	searchErr := errors.New("db error")
+	uc := newSearchUseCase(&testutil.MockSearchRepository{SearchErr: searchErr}, &testutil.MockStoreRepository{}, &testutil.MockReviewRepository{})
+
+	if _, err := uc.Search(context.Background(), input.SearchQuery{Q: "cafe"}); !errors.Is(err, searchErr) {
+		t.Errorf("expected search error, got %v", err)
+	}
+}
+
+func TestSearch_HydratesHitsInOrder(t *testing.T) {
+	description := "駅前の静かなカフェ"
+	content := "ここのカフェオレが好き"
+	searchRepo := &testutil.MockSearchRepository{
+		Page: &output.SearchPage{
+			Hits: []entity.SearchHit{
+				{Type: constants.SearchTypeReview, ID: "review-1", Score: 0.9},
+				{Type: constants.SearchTypeStore, ID: "store-1", Score: 0.8},
+				{Type: constants.SearchTypeStore, ID: "deleted-store", Score: 0.7},
+			},
+			NextCursor: "next",
+		},
+	}
+	storeRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{
+			{
+				StoreID:     "store-1",
+				Name:        "Cafe Blue",
+				Address:     "東京都渋谷区",
+				Description: &description,
+				Tags:        []string{"カフェ"},
+				Menus:       []entity.Menu{{Name: "カフェラテ"}},
+			},
+			{StoreID: "store-2", Name: "Ramen"},
+		},
+	}
+	reviewRepo := &testutil.MockReviewRepository{
+		FindByIDsResult: []entity.Review{{ReviewID: "review-1", StoreID: "store-2", Content: &content}},
+	}
+	uc := newSearchUseCase(searchRepo, storeRepo, reviewRepo)
+
+	page, err := uc.Search(context.Background(), input.SearchQuery{Q: "かふぇ"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.NextCursor != "next" {
+		t.Errorf("expected next cursor %q, got %q", "next", page.NextCursor)
+	}
+	if len(page.Results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(page.Results))
+	}
+
+	review := page.Results[0]
+	if review.Type != constants.SearchTypeReview || review.Review == nil || review.Review.ReviewID != "review-1" {
+		t.Fatalf("expected review-1 first, got %+v", review)
+	}
+	if review.Store == nil || review.Store.StoreID != "store-2" {
+		t.Errorf("expected review to carry its store, got %+v", review.Store)
+	}
+	if len(review.Highlights) != 1 || review.Highlights[0].Field != "content" {
+		t.Errorf("expected content highlight, got %+v", review.Highlights)
+	}
+
+	store := page.Results[1]
+	if store.Type != constants.SearchTypeStore || store.Store == nil || store.Store.StoreID != "store-1" {
+		t.Fatalf("expected store-1 second, got %+v", store)
+	}
+	if store.Store.Menus != nil {
+		t.Errorf("expected menus to be dropped from the result, got %+v", store.Store.Menus)
+	}
+	var fields []string
+	for _, h := range store.Highlights {
+		fields = append(fields, h.Field)
+	}
+	if strings.Join(fields, ",") != "tags,menus,description" {
+		t.Errorf("unexpected highlight fields: %v", fields)
+	}
+}
+
+func TestSearch_ReviewLoadError(t *testing.T) {
+	loadErr := errors.New("db error")
+	searchRepo := &testutil.MockSearchRepository{
+		Page: &output.SearchPage{Hits: []entity.SearchHit{{Type: constants.SearchTypeReview, ID: "review-1"}}},
+	}
+	uc := newSearchUseCase(searchRepo, &testutil.MockStoreRepository{}, &testutil.MockReviewRepository{FindByIDsErr: loadErr})
+
+	if _, err := uc.Search(context.Background(), input.SearchQuery{Q: "cafe"}); !errors.Is(err, loadErr) {
+		t.Errorf("expected load error, got %v", err)
+	}
+}
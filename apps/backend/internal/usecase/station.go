@@ -2,8 +2,11 @@ package usecase
 
 import (
 	"context"
+	"strings"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/search"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
@@ -16,6 +19,52 @@ func NewStationUseCase(repo output.StationRepository) input.StationUseCase {
 	return &stationUseCase{repo: repo}
 }
 
-func (u *stationUseCase) ListStations(ctx context.Context) ([]entity.Station, error) {
-	return u.repo.FindAll(ctx)
+func (u *stationUseCase) ListStations(ctx context.Context, query input.StationQuery) ([]entity.Station, error) {
+	q := strings.TrimSpace(query.Q)
+	kind := strings.TrimSpace(query.Kind)
+	if q == "" && kind == "" {
+		return u.repo.FindAll(ctx)
+	}
+	return u.repo.Search(ctx, output.StationSearchQuery{
+		NamePrefix: q,
+		KanaPrefix: search.KatakanaToHiragana(q),
+		Kind:       kind,
+	})
+}
+
+// GroupStationsByKind は駅を区分け(kind)ごとにまとめます。グループの順序は最初に現れた駅の順です
+func (u *stationUseCase) GroupStationsByKind(ctx context.Context, query input.StationQuery) ([]entity.StationGroup, error) {
+	stations, err := u.ListStations(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := []entity.StationGroup{}
+	index := map[string]int{}
+	for _, s := range stations {
+		i, ok := index[s.Kind]
+		if !ok {
+			i = len(groups)
+			index[s.Kind] = i
+			groups = append(groups, entity.StationGroup{Kind: s.Kind})
+		}
+		groups[i].Stations = append(groups[i].Stations, s)
+	}
+	return groups, nil
+}
+
+func (u *stationUseCase) FindNearestStations(ctx context.Context, lat, lng float64, limit int) ([]entity.Station, error) {
+	if !isValidLatitude(lat) || !isValidLongitude(lng) {
+		return nil, ErrInvalidCoordinates
+	}
+	if limit < 0 {
+		return nil, ErrInvalidInput
+	}
+	if limit == 0 {
+		limit = constants.DefaultNearestStationLimit
+	}
+	if limit > constants.MaxNearestStationLimit {
+		limit = constants.MaxNearestStationLimit
+	}
+	return u.repo.FindNearest(ctx, lat, lng, limit)
 }
@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+// --- ListStations Tests ---
+
+func TestListStations_NoFilterUsesFindAll(t *testing.T) {
+	mockRepo := &testutil.MockStationRepository{
+		Stations: []entity.Station{{ID: 1, Name: "三ノ宮駅(ＪＲ)"}},
+	}
+	uc := usecase.NewStationUseCase(mockRepo)
+
+	stations, err := uc.ListStations(context.Background(), input.StationQuery{Q: "  "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stations) != 1 {
+		t.Errorf("expected 1 station, got %d", len(stations))
+	}
+	if !mockRepo.FindAllCalled || mockRepo.SearchCalled {
+		t.Error("expected FindAll to be used when no filter is given")
+	}
+}
+
+func TestListStations_KatakanaQueryMatchesKana(t *testing.T) {
+	mockRepo := &testutil.MockStationRepository{}
+	uc := usecase.NewStationUseCase(mockRepo)
+
+	_, err := uc.ListStations(context.Background(), input.StationQuery{Q: "サンノミヤ", Kind: "三宮・元町"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := mockRepo.SearchCalledWith
+	if got.NamePrefix != "サンノミヤ" {
+		t.Errorf("expected name prefix to be kept as is, got %q", got.NamePrefix)
+	}
+	if got.KanaPrefix != "さんのみや" {
+		t.Errorf("expected kana prefix converted to hiragana, got %q", got.KanaPrefix)
+	}
+	if got.Kind != "三宮・元町" {
+		t.Errorf("expected kind filter, got %q", got.Kind)
+	}
+}
+
+// --- GroupStationsByKind Tests ---
+
+func TestGroupStationsByKind(t *testing.T) {
+	mockRepo := &testutil.MockStationRepository{
+		Stations: []entity.Station{
+			{ID: 1, Name: "三ノ宮駅(ＪＲ)", Kind: "三宮・元町"},
+			{ID: 2, Name: "須磨駅", Kind: "須磨"},
+			{ID: 3, Name: "元町駅〔ＪＲ〕", Kind: "三宮・元町"},
+		},
+	}
+	uc := usecase.NewStationUseCase(mockRepo)
+
+	groups, err := uc.GroupStationsByKind(context.Background(), input.StationQuery{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(groups))
+	}
+	if groups[0].Kind != "三宮・元町" || len(groups[0].Stations) != 2 {
+		t.Errorf("unexpected first group: %+v", groups[0])
+	}
+	if groups[1].Kind != "須磨" || len(groups[1].Stations) != 1 {
+		t.Errorf("unexpected second group: %+v", groups[1])
+	}
+}
+
+func TestGroupStationsByKind_RepositoryError(t *testing.T) {
+	dbErr := errors.New("database error")
+	uc := usecase.NewStationUseCase(&testutil.MockStationRepository{FindAllErr: dbErr})
+
+	_, err := uc.GroupStationsByKind(context.Background(), input.StationQuery{})
+	if !errors.Is(err, dbErr) {
+		t.Errorf("expected database error, got %v", err)
+	}
+}
+
+// --- FindNearestStations Tests ---
+
+func TestFindNearestStations_DefaultAndClampLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{name: "default", limit: 0, want: constants.DefaultNearestStationLimit},
+		{name: "clamped", limit: 500, want: constants.MaxNearestStationLimit},
+		{name: "as is", limit: 3, want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &testutil.MockStationRepository{}
+			uc := usecase.NewStationUseCase(mockRepo)
+
+			if _, err := uc.FindNearestStations(context.Background(), 34.69, 135.19, tt.limit); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mockRepo.FindNearestCalledWith.Limit != tt.want {
+				t.Errorf("expected limit %d, got %d", tt.want, mockRepo.FindNearestCalledWith.Limit)
+			}
+		})
+	}
+}
+
+func TestFindNearestStations_InvalidInput(t *testing.T) {
+	mockRepo := &testutil.MockStationRepository{}
+	uc := usecase.NewStationUseCase(mockRepo)
+
+	if _, err := uc.FindNearestStations(context.Background(), 95, 135, 5); !errors.Is(err, usecase.ErrInvalidCoordinates) {
+		t.Errorf("expected ErrInvalidCoordinates, got %v", err)
+	}
+	if _, err := uc.FindNearestStations(context.Background(), 34, 135, -1); !errors.Is(err, usecase.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+	if mockRepo.FindNearestCalled {
+		t.Error("repository should not be called for invalid input")
+	}
+}
@@ -2,43 +2,240 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"math"
 	"time"
+	"unicode/utf8"
 
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/role"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/search"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/tag"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
 
 // StoreUseCase はストアに関するビジネスロジックを提供します
 type StoreUseCase interface {
-	GetAllStores(ctx context.Context) ([]entity.Store, error)
-	GetStoreByID(ctx context.Context, id string) (*entity.Store, error)
-	CreateStore(ctx context.Context, input input.CreateStoreInput) (*entity.Store, error)
-	UpdateStore(ctx context.Context, id string, input input.UpdateStoreInput) (*entity.Store, error)
-	DeleteStore(ctx context.Context, id string) error
+	GetAllStores(ctx context.Context, viewer entity.User) ([]entity.Store, error)
+	ListStores(ctx context.Context, viewer entity.User, query input.ListStoresQuery) (*input.StorePage, error)
+	FindNearbyStores(ctx context.Context, viewer entity.User, query input.NearbyStoresQuery) ([]entity.Store, error)
+	GetStoreByID(ctx context.Context, viewer entity.User, id string) (*entity.Store, error)
+	ListOwnedStores(ctx context.Context, userID string) ([]entity.Store, error)
+	CreateStore(ctx context.Context, actor entity.User, input input.CreateStoreInput) (*entity.Store, error)
+	UpdateStore(ctx context.Context, actor entity.User, id string, input input.UpdateStoreInput) (*entity.Store, error)
+	DeleteStore(ctx context.Context, actor entity.User, id string) error
 }
 
 type storeUseCase struct {
-	storeRepo output.StoreRepository
+	storeRepo      output.StoreRepository
+	stationRepo    output.StationRepository
+	storeOwnerRepo output.StoreOwnerRepository
+	storeTagRepo   output.StoreTagRepository
+	storeEditRepo  output.StoreEditRepository
+	transaction    output.Transaction
 }
 
 // NewStoreUseCase は StoreUseCase の実装を生成します
-func NewStoreUseCase(storeRepo output.StoreRepository) StoreUseCase {
+func NewStoreUseCase(
+	storeRepo output.StoreRepository,
+	stationRepo output.StationRepository,
+	storeOwnerRepo output.StoreOwnerRepository,
+	storeTagRepo output.StoreTagRepository,
+	storeEditRepo output.StoreEditRepository,
+	transaction output.Transaction,
+) StoreUseCase {
 	return &storeUseCase{
-		storeRepo: storeRepo,
+		storeRepo:      storeRepo,
+		stationRepo:    stationRepo,
+		storeOwnerRepo: storeOwnerRepo,
+		storeTagRepo:   storeTagRepo,
+		storeEditRepo:  storeEditRepo,
+		transaction:    transaction,
 	}
 }
 
-func (uc *storeUseCase) GetAllStores(ctx context.Context) ([]entity.Store, error) {
-	return uc.storeRepo.FindAll(ctx)
+// GetAllStores は閲覧者が見られる店舗をすべて返します
+func (uc *storeUseCase) GetAllStores(ctx context.Context, viewer entity.User) ([]entity.Store, error) {
+	return uc.storeRepo.FindAll(ctx, viewerOf(viewer))
 }
 
-func (uc *storeUseCase) GetStoreByID(ctx context.Context, id string) (*entity.Store, error) {
-	return mustFindStore(ctx, uc.storeRepo, id)
+// ListStores は閲覧者が見られる店舗を一覧します。未公開の店舗は admin と店舗のオーナーにだけ含まれます
+func (uc *storeUseCase) ListStores(ctx context.Context, viewer entity.User, query input.ListStoresQuery) (*input.StorePage, error) {
+	listQuery, err := buildStoreListQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	listQuery.Viewer = viewerOf(viewer)
+	if query.OpenNow {
+		now := time.Now()
+		listQuery.OpenAt = &now
+	}
+	return uc.storeRepo.List(ctx, listQuery)
+}
+
+// buildStoreListQuery は一覧クエリを検証し、リポジトリ向けのクエリに変換します
+func buildStoreListQuery(query input.ListStoresQuery) (output.StoreListQuery, error) {
+	limit, err := normalizeLimit(query.Limit, constants.DefaultStoreListLimit, constants.MaxStoreListLimit)
+	if err != nil {
+		return output.StoreListQuery{}, err
+	}
+	if query.Budget != nil && !validBudgets[*query.Budget] {
+		return output.StoreListQuery{}, ErrInvalidInput
+	}
+	if query.MinRating != nil && (*query.MinRating < 0 || *query.MinRating > 5 || math.IsNaN(*query.MinRating)) {
+		return output.StoreListQuery{}, ErrInvalidInput
+	}
+	sort, err := normalizeStoreSort(query.Sort)
+	if err != nil {
+		return output.StoreListQuery{}, err
+	}
+	if query.Tag != nil {
+		normalized := tag.Normalize(*query.Tag)
+		query.Tag = &normalized
+	}
+
+	return output.StoreListQuery{
+		Limit:       limit,
+		Cursor:      query.Cursor,
+		Category:    query.Category,
+		Budget:      query.Budget,
+		Tag:         query.Tag,
+		MinRating:   query.MinRating,
+		OpenedAfter: query.OpenedAfter,
+		IsApproved:  query.IsApproved,
+		Sort:        sort,
+	}, nil
 }
 
-func (uc *storeUseCase) CreateStore(ctx context.Context, in input.CreateStoreInput) (*entity.Store, error) {
+// validBudgets mirrors the stores_budget_check constraint.
+var validBudgets = map[string]bool{
+	"$":   true,
+	"$$":  true,
+	"$$$": true,
+}
+
+// normalizeStoreSort は並び順を検証し、未指定なら新着順にします
+func normalizeStoreSort(sort string) (string, error) {
+	switch sort {
+	case "":
+		return constants.StoreSortByNew, nil
+	case constants.StoreSortByNew, constants.StoreSortByRating, constants.StoreSortByOpened:
+		return sort, nil
+	default:
+		return "", ErrInvalidInput
+	}
+}
+
+// FindNearbyStores は指定地点または駅から半径内の店舗を距離順に返します
+func (uc *storeUseCase) FindNearbyStores(ctx context.Context, viewer entity.User, query input.NearbyStoresQuery) ([]entity.Store, error) {
+	if query.RadiusMeters < 0 {
+		return nil, ErrInvalidInput
+	}
+	limit, err := normalizeLimit(query.Limit, constants.DefaultStoreListLimit, constants.MaxStoreListLimit)
+	if err != nil {
+		return nil, err
+	}
+	lat, lng, err := uc.resolveNearbyOrigin(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	radius := query.RadiusMeters
+	if radius == 0 {
+		radius = constants.DefaultNearbyRadiusMeters
+	}
+	if radius > constants.MaxNearbyRadiusMeters {
+		radius = constants.MaxNearbyRadiusMeters
+	}
+
+	nearbyQuery := output.StoreNearbyQuery{
+		Latitude:     lat,
+		Longitude:    lng,
+		RadiusMeters: float64(radius),
+		Limit:        limit,
+		Viewer:       viewerOf(viewer),
+	}
+	if query.OpenNow {
+		now := time.Now()
+		nearbyQuery.OpenAt = &now
+	}
+	stores, err := uc.storeRepo.FindNearby(ctx, nearbyQuery)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stores {
+		if stores[i].DistanceMeters != nil {
+			stores[i].DistanceMinutes = walkingMinutes(*stores[i].DistanceMeters)
+		}
+	}
+	return stores, nil
+}
+
+// resolveNearbyOrigin は検索の中心座標を決定します。駅指定の場合は駅の座標を使います
+func (uc *storeUseCase) resolveNearbyOrigin(ctx context.Context, query input.NearbyStoresQuery) (float64, float64, error) {
+	hasPoint := query.Latitude != nil || query.Longitude != nil
+	if hasPoint == (query.StationID != nil) {
+		return 0, 0, ErrInvalidInput
+	}
+	if query.StationID != nil {
+		station, err := uc.stationRepo.FindByID(ctx, *query.StationID)
+		if err != nil {
+			if apperr.IsCode(err, apperr.CodeNotFound) {
+				return 0, 0, ErrStationNotFound
+			}
+			return 0, 0, err
+		}
+		if station.Lat == nil || station.Lng == nil {
+			return 0, 0, ErrInvalidCoordinates
+		}
+		return *station.Lat, *station.Lng, nil
+	}
+	if query.Latitude == nil || query.Longitude == nil {
+		return 0, 0, ErrInvalidCoordinates
+	}
+	if !isValidLatitude(*query.Latitude) || !isValidLongitude(*query.Longitude) {
+		return 0, 0, ErrInvalidCoordinates
+	}
+	return *query.Latitude, *query.Longitude, nil
+}
+
+// walkingMinutes は距離(m)を徒歩分数に換算します（端数切り上げ、最低1分）
+func walkingMinutes(meters float64) int {
+	minutes := int(math.Ceil(meters / constants.WalkingMetersPerMinute))
+	if minutes < 1 {
+		return 1
+	}
+	return minutes
+}
+
+// GetStoreByID は閲覧者が見られる店舗を返します。見られない店舗は存在しないものとして扱います
+func (uc *storeUseCase) GetStoreByID(ctx context.Context, viewer entity.User, id string) (*entity.Store, error) {
+	store, err := uc.storeRepo.FindVisibleByID(ctx, id, viewerOf(viewer))
+	if err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return nil, ErrStoreNotFound
+		}
+		return nil, err
+	}
+	return store, nil
+}
+
+// ListOwnedStores は userID がオーナーとして管理している店舗を返します
+func (uc *storeUseCase) ListOwnedStores(ctx context.Context, userID string) ([]entity.Store, error) {
+	if err := validateNotEmpty(userID); err != nil {
+		return nil, err
+	}
+	return uc.storeRepo.FindByOwner(ctx, userID)
+}
+
+// CreateStore は店舗を作成し、作成者をその店舗のオーナーとして登録します
+func (uc *storeUseCase) CreateStore(ctx context.Context, actor entity.User, in input.CreateStoreInput) (*entity.Store, error) {
+	if actor.UserID == "" {
+		return nil, ErrUnauthorized
+	}
 	if err := validateNotEmpty(in.Name, in.Address, in.PlaceID); err != nil {
 		return nil, err
 	}
@@ -48,52 +245,185 @@ func (uc *storeUseCase) CreateStore(ctx context.Context, in input.CreateStoreInp
 	if !isValidLongitude(in.Longitude) {
 		return nil, ErrInvalidCoordinates
 	}
-	if in.ThumbnailFileID == nil {
-		return nil, ErrInvalidInput
+	tags, err := normalizeStoreTags(in.Tags)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := resolveOpeningSchedule(in.OpeningSchedule, in.OpeningHours)
+	if err != nil {
+		return nil, err
 	}
 
 	store := &entity.Store{
 		Name:            in.Name,
+		NameKana:        normalizeNameKana(in.NameKana),
 		Address:         in.Address,
-		ThumbnailFileID: in.ThumbnailFileID,
 		OpenedAt:        in.OpenedAt,
 		Description:     in.Description,
 		OpeningHours:    in.OpeningHours,
+		OpeningSchedule: schedule,
 		Latitude:        in.Latitude,
 		Longitude:       in.Longitude,
 		GoogleMapURL:    in.GoogleMapURL,
 		PlaceID:         in.PlaceID,
-		IsApproved:      false,
+		Visibility:      constants.VisibilityPending,
+		ApprovalStatus:  constants.StoreApprovalDraft,
 	}
 
-	if err := uc.storeRepo.Create(ctx, store); err != nil {
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+	if err := uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.storeRepo.CreateInTx(ctx, tx, store); err != nil {
+			return err
+		}
+		if err := uc.storeOwnerRepo.AddInTx(ctx, tx, store.StoreID, actor.UserID); err != nil {
+			return err
+		}
+		return uc.storeTagRepo.ReplaceInTx(ctx, tx, store.StoreID, tags)
+	}); err != nil {
 		return nil, err
 	}
 
+	store.Tags = tags
 	return store, nil
 }
 
-func (uc *storeUseCase) UpdateStore(ctx context.Context, id string, in input.UpdateStoreInput) (*entity.Store, error) {
+// UpdateStore は店舗を更新します。admin 以外は店舗のオーナーである必要があります。
+// 承認済みの店舗では、オーナーによる重要な項目の変更は反映せず管理者の承認待ちとして保留し、PendingEdit に設定して返します
+func (uc *storeUseCase) UpdateStore(ctx context.Context, actor entity.User, id string, in input.UpdateStoreInput) (*entity.Store, error) {
 	store, err := mustFindStore(ctx, uc.storeRepo, id)
 	if err != nil {
 		return nil, err
 	}
+	if err := ensureCanManageStore(ctx, uc.storeOwnerRepo, id, actor); err != nil {
+		return nil, err
+	}
+	if err := validateStoreUpdateInput(in); err != nil {
+		return nil, err
+	}
 
+	in, edit, err := uc.stageSensitiveUpdates(ctx, actor, store, in)
+	if err != nil {
+		return nil, err
+	}
 	if err := applyStoreUpdates(store, in); err != nil {
 		return nil, err
 	}
+	var tags []string
+	if in.Tags != nil {
+		if tags, err = normalizeStoreTags(in.Tags); err != nil {
+			return nil, err
+		}
+	}
+
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+	err = uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.storeRepo.UpdateInTx(ctx, tx, store); err != nil {
+			return err
+		}
+		if err := saveStoreEditInTx(ctx, uc.storeEditRepo, tx, edit); err != nil {
+			return err
+		}
+		if in.Tags == nil {
+			return nil
+		}
+		return uc.storeTagRepo.ReplaceInTx(ctx, tx, id, tags)
+	})
+	if errors.Is(err, output.ErrStoreEditAlreadyReviewed) {
+		return nil, ErrStoreEditNotPending
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.storeRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	updated.PendingEdit = edit
+	return updated, nil
+}
+
+// stageSensitiveUpdates は承認済みの店舗に対するオーナーの重要な項目の変更を入力から取り除き、承認待ちの変更として返します
+func (uc *storeUseCase) stageSensitiveUpdates(
+	ctx context.Context,
+	actor entity.User,
+	store *entity.Store,
+	in input.UpdateStoreInput,
+) (input.UpdateStoreInput, *entity.StoreEdit, error) {
+	if !needsStoreEditApproval(actor, store) {
+		return in, nil, nil
+	}
+
+	proposed := entity.StoreEdit{
+		Name:      in.Name,
+		Address:   in.Address,
+		Latitude:  in.Latitude,
+		Longitude: in.Longitude,
+		PlaceID:   in.PlaceID,
+	}
+	in.Name, in.Address, in.Latitude, in.Longitude, in.PlaceID = nil, nil, nil, nil, nil
+	edit, err := stageStoreEdit(ctx, uc.storeEditRepo, actor, store, proposed)
+	return in, edit, err
+}
+
+// needsStoreEditApproval は actor による店舗の重要な項目の変更に管理者の承認が必要かを返します。
+// admin の変更と未承認の店舗の変更はそのまま反映します
+func needsStoreEditApproval(actor entity.User, store *entity.Store) bool {
+	return actor.Role != role.Admin && store.ApprovalStatus == constants.StoreApprovalApproved
+}
 
-	if err := uc.storeRepo.Update(ctx, store); err != nil {
+// stageStoreEdit は店舗に対する変更の提案を承認待ちの変更として返します。承認待ちの変更が既にある場合はそこへまとめます。
+// 提案が店舗の現在の内容と変わらない場合は nil を返します
+func stageStoreEdit(
+	ctx context.Context,
+	editRepo output.StoreEditRepository,
+	actor entity.User,
+	store *entity.Store,
+	proposed entity.StoreEdit,
+) (*entity.StoreEdit, error) {
+	if len(proposed.Diff(*store)) == 0 {
+		return nil, nil
+	}
+
+	edit, err := editRepo.FindPendingByStoreID(ctx, store.StoreID)
+	if apperr.IsCode(err, apperr.CodeNotFound) {
+		edit, err = &entity.StoreEdit{StoreID: store.StoreID, Status: constants.StoreEditStatusPending}, nil
+	}
+	if err != nil {
 		return nil, err
 	}
+	edit.Merge(proposed)
+	edit.UserID = actor.UserID
+	edit.UpdatedAt = time.Now()
+	return edit, nil
+}
 
-	return uc.storeRepo.FindByID(ctx, id)
+// saveStoreEditInTx は承認待ちの変更を保存します。edit が nil の場合は何もしません
+func saveStoreEditInTx(ctx context.Context, editRepo output.StoreEditRepository, tx interface{}, edit *entity.StoreEdit) error {
+	if edit == nil {
+		return nil
+	}
+	if edit.EditID == "" {
+		return editRepo.CreateInTx(ctx, tx, edit)
+	}
+	return editRepo.UpdateChangesInTx(ctx, tx, edit)
 }
 
 func applyStoreUpdates(store *entity.Store, in input.UpdateStoreInput) error {
 	if err := validateStoreUpdateInput(in); err != nil {
 		return err
 	}
+	if in.OpeningSchedule != nil || in.OpeningHours != nil {
+		schedule, err := resolveOpeningSchedule(in.OpeningSchedule, in.OpeningHours)
+		if err != nil {
+			return err
+		}
+		store.OpeningSchedule = schedule
+	}
 
 	applyBasicFields(store, in)
 	applyOptionalFields(store, in)
@@ -115,6 +445,39 @@ func validateStoreUpdateInput(in input.UpdateStoreInput) error {
 	return nil
 }
 
+// normalizeStoreTags はタグを正規化し、重複を取り除きます。空のタグ・長すぎるタグ・上限を超える件数は ErrInvalidTag になります
+func normalizeStoreTags(tags []string) ([]string, error) {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, raw := range tags {
+		normalized := tag.Normalize(raw)
+		if normalized == "" || utf8.RuneCountInString(normalized) > constants.MaxTagLength {
+			return nil, ErrInvalidTag
+		}
+		if _, ok := seen[normalized]; ok {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		result = append(result, normalized)
+	}
+	if len(result) > constants.MaxStoreTags {
+		return nil, ErrInvalidTag
+	}
+	return result, nil
+}
+
+// normalizeNameKana は店舗名の読みを検索と同じ規則で正規化します（カタカナはひらがなになります）。空の場合は nil を返します
+func normalizeNameKana(kana *string) *string {
+	if kana == nil {
+		return nil
+	}
+	normalized := search.Normalize(*kana)
+	if normalized == "" {
+		return nil
+	}
+	return &normalized
+}
+
 func isValidLatitude(lat float64) bool {
 	return lat >= -90.0 && lat <= 90.0 && !math.IsNaN(lat) && !math.IsInf(lat, 0)
 }
@@ -142,8 +505,8 @@ func applyBasicFields(store *entity.Store, in input.UpdateStoreInput) {
 }
 
 func applyOptionalFields(store *entity.Store, in input.UpdateStoreInput) {
-	if in.ThumbnailFileID != nil {
-		store.ThumbnailFileID = in.ThumbnailFileID
+	if in.NameKana != nil {
+		store.NameKana = normalizeNameKana(in.NameKana)
 	}
 	if in.OpenedAt != nil {
 		store.OpenedAt = in.OpenedAt
@@ -159,7 +522,7 @@ func applyOptionalFields(store *entity.Store, in input.UpdateStoreInput) {
 	}
 }
 
-func (uc *storeUseCase) DeleteStore(ctx context.Context, id string) error {
+func (uc *storeUseCase) DeleteStore(ctx context.Context, actor entity.User, id string) error {
 	if err := ensureStoreExists(ctx, uc.storeRepo, id); err != nil {
 		return err
 	}
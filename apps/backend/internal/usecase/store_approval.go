@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// StoreApprovalUseCase は店舗の審査（申請・承認・却下）に関するビジネスロジックを提供します
+type StoreApprovalUseCase interface {
+	SubmitStore(ctx context.Context, actor entity.User, storeID string, input input.StoreApprovalInput) (*entity.Store, error)
+	GetApprovalHistory(ctx context.Context, actor entity.User, storeID string) ([]entity.StoreApprovalEvent, error)
+	ApproveStore(ctx context.Context, admin entity.User, storeID string, input input.StoreApprovalInput) (*entity.Store, error)
+	RejectStore(ctx context.Context, admin entity.User, storeID string, input input.StoreApprovalInput) (*entity.Store, error)
+}
+
+type storeApprovalUseCase struct {
+	storeRepo      output.StoreRepository
+	storeOwnerRepo output.StoreOwnerRepository
+	eventRepo      output.StoreApprovalEventRepository
+	transaction    output.Transaction
+}
+
+// NewStoreApprovalUseCase は StoreApprovalUseCase の実装を生成します
+func NewStoreApprovalUseCase(
+	storeRepo output.StoreRepository,
+	storeOwnerRepo output.StoreOwnerRepository,
+	eventRepo output.StoreApprovalEventRepository,
+	transaction output.Transaction,
+) StoreApprovalUseCase {
+	return &storeApprovalUseCase{
+		storeRepo:      storeRepo,
+		storeOwnerRepo: storeOwnerRepo,
+		eventRepo:      eventRepo,
+		transaction:    transaction,
+	}
+}
+
+// submitTransitions は審査申請できる状態と申請後の状態
+var submitTransitions = map[string]string{
+	constants.StoreApprovalDraft:    constants.StoreApprovalSubmitted,
+	constants.StoreApprovalRejected: constants.StoreApprovalResubmitted,
+}
+
+// SubmitStore は店舗を審査に出します。却下された店舗の場合は再申請になります
+func (uc *storeApprovalUseCase) SubmitStore(ctx context.Context, actor entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	store, err := mustFindStore(ctx, uc.storeRepo, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureCanManageStore(ctx, uc.storeOwnerRepo, storeID, actor); err != nil {
+		return nil, err
+	}
+	to, ok := submitTransitions[store.ApprovalStatus]
+	if !ok {
+		return nil, ErrStoreApprovalTransition
+	}
+	return uc.transition(ctx, actor, store, to, store.Visibility, trimmedOrNil(in.Reason))
+}
+
+// GetApprovalHistory は店舗の審査履歴を古い順に返します
+func (uc *storeApprovalUseCase) GetApprovalHistory(ctx context.Context, actor entity.User, storeID string) ([]entity.StoreApprovalEvent, error) {
+	if err := ensureStoreExists(ctx, uc.storeRepo, storeID); err != nil {
+		return nil, err
+	}
+	if err := ensureCanManageStore(ctx, uc.storeOwnerRepo, storeID, actor); err != nil {
+		return nil, err
+	}
+	return uc.eventRepo.FindByStoreID(ctx, storeID)
+}
+
+// ApproveStore は審査中の店舗を承認して公開します
+func (uc *storeApprovalUseCase) ApproveStore(ctx context.Context, admin entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	if admin.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	store, err := uc.findAwaitingApproval(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.transition(ctx, admin, store, constants.StoreApprovalApproved, constants.VisibilityPublished, trimmedOrNil(in.Reason))
+}
+
+// RejectStore は審査中の店舗を却下します。却下理由は必須です
+func (uc *storeApprovalUseCase) RejectStore(ctx context.Context, admin entity.User, storeID string, in input.StoreApprovalInput) (*entity.Store, error) {
+	if admin.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	reason := trimmedOrNil(in.Reason)
+	if reason == nil {
+		return nil, ErrRejectionReasonRequired
+	}
+	store, err := uc.findAwaitingApproval(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.transition(ctx, admin, store, constants.StoreApprovalRejected, store.Visibility, reason)
+}
+
+func (uc *storeApprovalUseCase) findAwaitingApproval(ctx context.Context, storeID string) (*entity.Store, error) {
+	store, err := mustFindStore(ctx, uc.storeRepo, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if !store.IsAwaitingApproval() {
+		return nil, ErrStoreApprovalTransition
+	}
+	return store, nil
+}
+
+// transition は審査状態を変更し、同じトランザクションで履歴を記録します
+func (uc *storeApprovalUseCase) transition(
+	ctx context.Context,
+	actor entity.User,
+	store *entity.Store,
+	to string,
+	visibility string,
+	reason *string,
+) (*entity.Store, error) {
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+
+	from := store.ApprovalStatus
+	store.ApprovalStatus = to
+	store.Visibility = visibility
+	store.UpdatedAt = time.Now()
+
+	event := &entity.StoreApprovalEvent{
+		StoreID:    store.StoreID,
+		FromStatus: from,
+		ToStatus:   to,
+		ActorID:    &actor.UserID,
+		Reason:     reason,
+	}
+	err := uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.storeRepo.UpdateApprovalInTx(ctx, tx, store, from); err != nil {
+			return err
+		}
+		return uc.eventRepo.CreateInTx(ctx, tx, event)
+	})
+	if errors.Is(err, output.ErrStoreApprovalChanged) {
+		return nil, ErrStoreApprovalTransition
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
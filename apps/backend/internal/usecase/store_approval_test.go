@@ -0,0 +1,276 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type approvalTestDeps struct {
+	storeRepo *testutil.MockStoreRepository
+	ownerRepo *testutil.MockStoreOwnerRepository
+	eventRepo *testutil.MockStoreApprovalEventRepository
+}
+
+func newApprovalTestDeps(status string) approvalTestDeps {
+	return approvalTestDeps{
+		storeRepo: &testutil.MockStoreRepository{Store: &entity.Store{
+			StoreID:        "store-1",
+			Visibility:     constants.VisibilityPending,
+			ApprovalStatus: status,
+		}},
+		ownerRepo: &testutil.MockStoreOwnerRepository{Owners: map[string][]string{"store-1": {testOwner.UserID}}},
+		eventRepo: &testutil.MockStoreApprovalEventRepository{},
+	}
+}
+
+func (d approvalTestDeps) useCase() usecase.StoreApprovalUseCase {
+	return usecase.NewStoreApprovalUseCase(d.storeRepo, d.ownerRepo, d.eventRepo, &testutil.MockTransaction{})
+}
+
+// --- SubmitStore Tests ---
+
+func TestSubmitStore_Transitions(t *testing.T) {
+	tests := []struct {
+		from string
+		want string
+	}{
+		{constants.StoreApprovalDraft, constants.StoreApprovalSubmitted},
+		{constants.StoreApprovalRejected, constants.StoreApprovalResubmitted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from, func(t *testing.T) {
+			deps := newApprovalTestDeps(tt.from)
+
+			store, err := deps.useCase().SubmitStore(context.Background(), testOwner, "store-1", input.StoreApprovalInput{Reason: testutil.StringPtr(" fixed the address ")})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if store.ApprovalStatus != tt.want {
+				t.Errorf("expected status %s, got %s", tt.want, store.ApprovalStatus)
+			}
+			if store.Visibility != constants.VisibilityPending {
+				t.Errorf("expected visibility to stay pending, got %s", store.Visibility)
+			}
+			if deps.storeRepo.ApprovalWith.From != tt.from {
+				t.Errorf("expected conditional update from %s, got %s", tt.from, deps.storeRepo.ApprovalWith.From)
+			}
+			if len(deps.eventRepo.Created) != 1 {
+				t.Fatalf("expected 1 event, got %d", len(deps.eventRepo.Created))
+			}
+			event := deps.eventRepo.Created[0]
+			if event.FromStatus != tt.from || event.ToStatus != tt.want {
+				t.Errorf("expected event %s -> %s, got %s -> %s", tt.from, tt.want, event.FromStatus, event.ToStatus)
+			}
+			if event.ActorID == nil || *event.ActorID != testOwner.UserID {
+				t.Errorf("expected actor %s, got %v", testOwner.UserID, event.ActorID)
+			}
+			if event.Reason == nil || *event.Reason != "fixed the address" {
+				t.Errorf("expected trimmed reason, got %v", event.Reason)
+			}
+		})
+	}
+}
+
+func TestSubmitStore_InvalidTransition(t *testing.T) {
+	for _, status := range []string{constants.StoreApprovalSubmitted, constants.StoreApprovalResubmitted, constants.StoreApprovalApproved} {
+		t.Run(status, func(t *testing.T) {
+			deps := newApprovalTestDeps(status)
+
+			_, err := deps.useCase().SubmitStore(context.Background(), testOwner, "store-1", input.StoreApprovalInput{})
+			if !errors.Is(err, usecase.ErrStoreApprovalTransition) {
+				t.Errorf("expected ErrStoreApprovalTransition, got %v", err)
+			}
+			if len(deps.eventRepo.Created) != 0 {
+				t.Errorf("expected no event to be recorded")
+			}
+		})
+	}
+}
+
+func TestSubmitStore_NotOwner(t *testing.T) {
+	deps := newApprovalTestDeps(constants.StoreApprovalDraft)
+	other := entity.User{UserID: "owner-2", Role: testOwner.Role}
+
+	_, err := deps.useCase().SubmitStore(context.Background(), other, "store-1", input.StoreApprovalInput{})
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestSubmitStore_StoreNotFound(t *testing.T) {
+	deps := newApprovalTestDeps(constants.StoreApprovalDraft)
+	deps.storeRepo.FindByIDErr = apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+
+	_, err := deps.useCase().SubmitStore(context.Background(), testOwner, "missing", input.StoreApprovalInput{})
+	if !errors.Is(err, usecase.ErrStoreNotFound) {
+		t.Errorf("expected ErrStoreNotFound, got %v", err)
+	}
+}
+
+func TestSubmitStore_ChangedConcurrently(t *testing.T) {
+	deps := newApprovalTestDeps(constants.StoreApprovalDraft)
+	deps.storeRepo.ApprovalErr = output.ErrStoreApprovalChanged
+
+	_, err := deps.useCase().SubmitStore(context.Background(), testOwner, "store-1", input.StoreApprovalInput{})
+	if !errors.Is(err, usecase.ErrStoreApprovalTransition) {
+		t.Errorf("expected ErrStoreApprovalTransition, got %v", err)
+	}
+}
+
+// --- ApproveStore Tests ---
+
+func TestApproveStore_Success(t *testing.T) {
+	for _, status := range []string{constants.StoreApprovalSubmitted, constants.StoreApprovalResubmitted} {
+		t.Run(status, func(t *testing.T) {
+			deps := newApprovalTestDeps(status)
+
+			store, err := deps.useCase().ApproveStore(context.Background(), testAdmin, "store-1", input.StoreApprovalInput{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if store.ApprovalStatus != constants.StoreApprovalApproved {
+				t.Errorf("expected status approved, got %s", store.ApprovalStatus)
+			}
+			if store.Visibility != constants.VisibilityPublished {
+				t.Errorf("expected store to be published, got %s", store.Visibility)
+			}
+			event := deps.eventRepo.Created[0]
+			if event.ActorID == nil || *event.ActorID != testAdmin.UserID {
+				t.Errorf("expected admin %s to be recorded, got %v", testAdmin.UserID, event.ActorID)
+			}
+			if event.Reason != nil {
+				t.Errorf("expected no reason, got %q", *event.Reason)
+			}
+		})
+	}
+}
+
+func TestApproveStore_NotAwaitingApproval(t *testing.T) {
+	for _, status := range []string{constants.StoreApprovalDraft, constants.StoreApprovalApproved, constants.StoreApprovalRejected} {
+		t.Run(status, func(t *testing.T) {
+			deps := newApprovalTestDeps(status)
+
+			_, err := deps.useCase().ApproveStore(context.Background(), testAdmin, "store-1", input.StoreApprovalInput{})
+			if !errors.Is(err, usecase.ErrStoreApprovalTransition) {
+				t.Errorf("expected ErrStoreApprovalTransition, got %v", err)
+			}
+		})
+	}
+}
+
+func TestApproveStore_NotFound(t *testing.T) {
+	deps := newApprovalTestDeps(constants.StoreApprovalSubmitted)
+	deps.storeRepo.FindByIDErr = apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+
+	_, err := deps.useCase().ApproveStore(context.Background(), testAdmin, "missing", input.StoreApprovalInput{})
+	if !errors.Is(err, usecase.ErrStoreNotFound) {
+		t.Errorf("expected ErrStoreNotFound, got %v", err)
+	}
+}
+
+func TestApproveStore_EventError(t *testing.T) {
+	dbErr := errors.New("insert failed")
+	deps := newApprovalTestDeps(constants.StoreApprovalSubmitted)
+	deps.eventRepo.CreateErr = dbErr
+
+	_, err := deps.useCase().ApproveStore(context.Background(), testAdmin, "store-1", input.StoreApprovalInput{})
+	if !errors.Is(err, dbErr) {
+		t.Errorf("expected insert error, got %v", err)
+	}
+}
+
+// --- RejectStore Tests ---
+
+func TestRejectStore_Success(t *testing.T) {
+	deps := newApprovalTestDeps(constants.StoreApprovalSubmitted)
+
+	store, err := deps.useCase().RejectStore(context.Background(), testAdmin, "store-1", input.StoreApprovalInput{Reason: testutil.StringPtr("photos are missing")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.ApprovalStatus != constants.StoreApprovalRejected {
+		t.Errorf("expected status rejected, got %s", store.ApprovalStatus)
+	}
+	if store.Visibility != constants.VisibilityPending {
+		t.Errorf("expected visibility to stay pending, got %s", store.Visibility)
+	}
+	event := deps.eventRepo.Created[0]
+	if event.Reason == nil || *event.Reason != "photos are missing" {
+		t.Errorf("expected reason to be recorded, got %v", event.Reason)
+	}
+}
+
+func TestRejectStore_ReasonRequired(t *testing.T) {
+	for name, reason := range map[string]*string{"missing": nil, "blank": testutil.StringPtr("   ")} {
+		t.Run(name, func(t *testing.T) {
+			deps := newApprovalTestDeps(constants.StoreApprovalSubmitted)
+
+			_, err := deps.useCase().RejectStore(context.Background(), testAdmin, "store-1", input.StoreApprovalInput{Reason: reason})
+			if !errors.Is(err, usecase.ErrRejectionReasonRequired) {
+				t.Errorf("expected ErrRejectionReasonRequired, got %v", err)
+			}
+			if deps.storeRepo.ApprovalWith.Store != nil {
+				t.Errorf("expected store not to be updated")
+			}
+		})
+	}
+}
+
+func TestRejectStore_NotAwaitingApproval(t *testing.T) {
+	deps := newApprovalTestDeps(constants.StoreApprovalApproved)
+
+	_, err := deps.useCase().RejectStore(context.Background(), testAdmin, "store-1", input.StoreApprovalInput{Reason: testutil.StringPtr("reason")})
+	if !errors.Is(err, usecase.ErrStoreApprovalTransition) {
+		t.Errorf("expected ErrStoreApprovalTransition, got %v", err)
+	}
+}
+
+// --- GetApprovalHistory Tests ---
+
+func TestGetApprovalHistory_Success(t *testing.T) {
+	deps := newApprovalTestDeps(constants.StoreApprovalRejected)
+	deps.eventRepo.Events = []entity.StoreApprovalEvent{
+		{EventID: 1, StoreID: "store-1", FromStatus: constants.StoreApprovalDraft, ToStatus: constants.StoreApprovalSubmitted},
+		{EventID: 2, StoreID: "store-1", FromStatus: constants.StoreApprovalSubmitted, ToStatus: constants.StoreApprovalRejected},
+	}
+
+	events, err := deps.useCase().GetApprovalHistory(context.Background(), testOwner, "store-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Errorf("expected 2 events, got %d", len(events))
+	}
+	if deps.eventRepo.FindCalledID != "store-1" {
+		t.Errorf("expected history of store-1, got %q", deps.eventRepo.FindCalledID)
+	}
+}
+
+func TestGetApprovalHistory_Admin(t *testing.T) {
+	deps := newApprovalTestDeps(constants.StoreApprovalDraft)
+	deps.ownerRepo.Owners = nil
+
+	if _, err := deps.useCase().GetApprovalHistory(context.Background(), testAdmin, "store-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestGetApprovalHistory_NotOwner(t *testing.T) {
+	deps := newApprovalTestDeps(constants.StoreApprovalDraft)
+	deps.ownerRepo.Owners = nil
+
+	_, err := deps.useCase().GetApprovalHistory(context.Background(), testOwner, "store-1")
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+}
@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// StoreClaimUseCase は既存店舗のオーナー申請に関するビジネスロジックを提供します
+type StoreClaimUseCase interface {
+	SubmitClaim(ctx context.Context, actor entity.User, storeID string, input input.SubmitStoreClaimInput) (*entity.StoreClaim, error)
+	ListClaims(ctx context.Context, status string) ([]entity.StoreClaim, error)
+	GetClaim(ctx context.Context, claimID string) (*entity.StoreClaim, error)
+	ApproveClaim(ctx context.Context, reviewer entity.User, claimID string, input input.ReviewStoreClaimInput) (*entity.StoreClaim, error)
+	DenyClaim(ctx context.Context, reviewer entity.User, claimID string, input input.ReviewStoreClaimInput) (*entity.StoreClaim, error)
+}
+
+type storeClaimUseCase struct {
+	claimRepo      output.StoreClaimRepository
+	storeRepo      output.StoreRepository
+	storeOwnerRepo output.StoreOwnerRepository
+	fileRepo       output.FileRepository
+	transaction    output.Transaction
+}
+
+// NewStoreClaimUseCase は StoreClaimUseCase の実装を生成します
+func NewStoreClaimUseCase(
+	claimRepo output.StoreClaimRepository,
+	storeRepo output.StoreRepository,
+	storeOwnerRepo output.StoreOwnerRepository,
+	fileRepo output.FileRepository,
+	transaction output.Transaction,
+) StoreClaimUseCase {
+	return &storeClaimUseCase{
+		claimRepo:      claimRepo,
+		storeRepo:      storeRepo,
+		storeOwnerRepo: storeOwnerRepo,
+		fileRepo:       fileRepo,
+		transaction:    transaction,
+	}
+}
+
+// validClaimStatuses は申請一覧の絞り込みに使えるステータス
+var validClaimStatuses = map[string]bool{
+	constants.ClaimStatusPending:  true,
+	constants.ClaimStatusApproved: true,
+	constants.ClaimStatusDenied:   true,
+}
+
+// SubmitClaim は店舗の管理権限を申請します。証拠書類は申請者本人がアップロードしたものに限ります
+func (uc *storeClaimUseCase) SubmitClaim(ctx context.Context, actor entity.User, storeID string, in input.SubmitStoreClaimInput) (*entity.StoreClaim, error) {
+	if actor.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	note := strings.TrimSpace(in.Note)
+	fileIDs := dedupeStrings(in.FileIDs)
+	if note == "" || len(fileIDs) == 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := ensureStoreExists(ctx, uc.storeRepo, storeID); err != nil {
+		return nil, err
+	}
+
+	owns, err := uc.storeOwnerRepo.IsOwner(ctx, storeID, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if owns {
+		return nil, ErrAlreadyStoreOwner
+	}
+	pending, err := uc.claimRepo.HasPending(ctx, storeID, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, ErrClaimAlreadyPending
+	}
+
+	if err := uc.ensureEvidenceFiles(ctx, actor.UserID, fileIDs); err != nil {
+		return nil, err
+	}
+
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+	claim := &entity.StoreClaim{
+		StoreID: storeID,
+		UserID:  actor.UserID,
+		Note:    note,
+		Status:  constants.ClaimStatusPending,
+	}
+	if err := uc.transaction.StartTransaction(func(tx interface{}) error {
+		return uc.claimRepo.CreateInTx(ctx, tx, claim, fileIDs)
+	}); err != nil {
+		return nil, err
+	}
+
+	return uc.claimRepo.FindByID(ctx, claim.ClaimID)
+}
+
+// ensureEvidenceFiles は全ファイルが申請者のアップロードした証拠書類であることを確認します
+func (uc *storeClaimUseCase) ensureEvidenceFiles(ctx context.Context, userID string, fileIDs []string) error {
+	files, err := uc.fileRepo.FindByCreatorAndIDs(ctx, userID, fileIDs)
+	if err != nil {
+		return err
+	}
+	if len(files) != len(fileIDs) {
+		return ErrInvalidFileIDs
+	}
+	for _, file := range files {
+		if file.FileKind != constants.FileKindClaimEvidence {
+			return ErrInvalidFileIDs
+		}
+	}
+	return nil
+}
+
+// ListClaims は申請一覧を新しい順に返します。status が空の場合は全件を返します
+func (uc *storeClaimUseCase) ListClaims(ctx context.Context, status string) ([]entity.StoreClaim, error) {
+	if status == "" {
+		return uc.claimRepo.List(ctx, nil)
+	}
+	if !validClaimStatuses[status] {
+		return nil, ErrInvalidClaimStatus
+	}
+	return uc.claimRepo.List(ctx, &status)
+}
+
+func (uc *storeClaimUseCase) GetClaim(ctx context.Context, claimID string) (*entity.StoreClaim, error) {
+	return mustFindClaim(ctx, uc.claimRepo, claimID)
+}
+
+// ApproveClaim は申請を承認し、申請者を店舗のオーナーとして登録します
+func (uc *storeClaimUseCase) ApproveClaim(ctx context.Context, reviewer entity.User, claimID string, in input.ReviewStoreClaimInput) (*entity.StoreClaim, error) {
+	return uc.reviewClaim(ctx, reviewer, claimID, in, constants.ClaimStatusApproved)
+}
+
+// DenyClaim は申請を却下します
+func (uc *storeClaimUseCase) DenyClaim(ctx context.Context, reviewer entity.User, claimID string, in input.ReviewStoreClaimInput) (*entity.StoreClaim, error) {
+	return uc.reviewClaim(ctx, reviewer, claimID, in, constants.ClaimStatusDenied)
+}
+
+func (uc *storeClaimUseCase) reviewClaim(
+	ctx context.Context,
+	reviewer entity.User,
+	claimID string,
+	in input.ReviewStoreClaimInput,
+	status string,
+) (*entity.StoreClaim, error) {
+	if reviewer.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	claim, err := mustFindClaim(ctx, uc.claimRepo, claimID)
+	if err != nil {
+		return nil, err
+	}
+	if claim.Status != constants.ClaimStatusPending {
+		return nil, ErrClaimNotPending
+	}
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+
+	now := time.Now()
+	claim.Status = status
+	claim.ReviewedBy = &reviewer.UserID
+	claim.ReviewNote = trimmedOrNil(in.Note)
+	claim.ReviewedAt = &now
+	claim.UpdatedAt = now
+
+	err = uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.claimRepo.UpdateReviewInTx(ctx, tx, claim); err != nil {
+			return err
+		}
+		if status != constants.ClaimStatusApproved {
+			return nil
+		}
+		return uc.storeOwnerRepo.AddInTx(ctx, tx, claim.StoreID, claim.UserID)
+	})
+	if errors.Is(err, output.ErrClaimAlreadyReviewed) {
+		return nil, ErrClaimNotPending
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return claim, nil
+}
+
+// trimmedOrNil は空白のみの文字列を nil として扱います
+func trimmedOrNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
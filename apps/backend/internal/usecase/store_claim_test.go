@@ -0,0 +1,240 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type claimTestDeps struct {
+	claimRepo *testutil.MockStoreClaimRepository
+	storeRepo *testutil.MockStoreRepository
+	ownerRepo *testutil.MockStoreOwnerRepository
+	fileRepo  *testutil.MockFileRepository
+}
+
+func newClaimTestDeps() claimTestDeps {
+	return claimTestDeps{
+		claimRepo: &testutil.MockStoreClaimRepository{},
+		storeRepo: &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}},
+		ownerRepo: &testutil.MockStoreOwnerRepository{},
+		fileRepo: &testutil.MockFileRepository{
+			FindByCreatorResult: []entity.File{{FileID: "file-1", FileKind: constants.FileKindClaimEvidence}},
+		},
+	}
+}
+
+func (d claimTestDeps) useCase() usecase.StoreClaimUseCase {
+	return usecase.NewStoreClaimUseCase(d.claimRepo, d.storeRepo, d.ownerRepo, d.fileRepo, &testutil.MockTransaction{})
+}
+
+var validClaimInput = input.SubmitStoreClaimInput{Note: " I run this shop ", FileIDs: []string{"file-1", "file-1"}}
+
+// --- SubmitClaim Tests ---
+
+func TestSubmitClaim_Success(t *testing.T) {
+	deps := newClaimTestDeps()
+
+	claim, err := deps.useCase().SubmitClaim(context.Background(), testOwner, "store-1", validClaimInput)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if claim.Status != constants.ClaimStatusPending {
+		t.Errorf("expected status pending, got %s", claim.Status)
+	}
+	if claim.Note != "I run this shop" {
+		t.Errorf("expected trimmed note, got %q", claim.Note)
+	}
+	if got := deps.claimRepo.CreateCalledWith.FileIDs; len(got) != 1 || got[0] != "file-1" {
+		t.Errorf("expected deduplicated file IDs, got %v", got)
+	}
+	if deps.fileRepo.FindByCreatorCalledWith.UserID != testOwner.UserID {
+		t.Errorf("expected files to be looked up for %q, got %q", testOwner.UserID, deps.fileRepo.FindByCreatorCalledWith.UserID)
+	}
+}
+
+func TestSubmitClaim_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input input.SubmitStoreClaimInput
+	}{
+		{name: "blank note", input: input.SubmitStoreClaimInput{Note: "  ", FileIDs: []string{"file-1"}}},
+		{name: "no evidence", input: input.SubmitStoreClaimInput{Note: "note"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := newClaimTestDeps()
+			_, err := deps.useCase().SubmitClaim(context.Background(), testOwner, "store-1", tt.input)
+			if !errors.Is(err, usecase.ErrInvalidInput) {
+				t.Errorf("expected ErrInvalidInput, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSubmitClaim_StoreNotFound(t *testing.T) {
+	deps := newClaimTestDeps()
+	deps.storeRepo.FindByIDErr = apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+
+	_, err := deps.useCase().SubmitClaim(context.Background(), testOwner, "missing", validClaimInput)
+	if !errors.Is(err, usecase.ErrStoreNotFound) {
+		t.Errorf("expected ErrStoreNotFound, got %v", err)
+	}
+}
+
+func TestSubmitClaim_AlreadyOwner(t *testing.T) {
+	deps := newClaimTestDeps()
+	deps.ownerRepo.Owners = map[string][]string{"store-1": {testOwner.UserID}}
+
+	_, err := deps.useCase().SubmitClaim(context.Background(), testOwner, "store-1", validClaimInput)
+	if !errors.Is(err, usecase.ErrAlreadyStoreOwner) {
+		t.Errorf("expected ErrAlreadyStoreOwner, got %v", err)
+	}
+}
+
+func TestSubmitClaim_AlreadyPending(t *testing.T) {
+	deps := newClaimTestDeps()
+	deps.claimRepo.Pending = true
+
+	_, err := deps.useCase().SubmitClaim(context.Background(), testOwner, "store-1", validClaimInput)
+	if !errors.Is(err, usecase.ErrClaimAlreadyPending) {
+		t.Errorf("expected ErrClaimAlreadyPending, got %v", err)
+	}
+}
+
+func TestSubmitClaim_InvalidEvidence(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []entity.File
+	}{
+		{name: "file not owned by claimant", files: nil},
+		{name: "file is not claim evidence", files: []entity.File{{FileID: "file-1", FileKind: constants.TargetTypeReview}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := newClaimTestDeps()
+			deps.fileRepo.FindByCreatorResult = tt.files
+
+			_, err := deps.useCase().SubmitClaim(context.Background(), testOwner, "store-1", validClaimInput)
+			if !errors.Is(err, usecase.ErrInvalidFileIDs) {
+				t.Errorf("expected ErrInvalidFileIDs, got %v", err)
+			}
+			if deps.claimRepo.CreateCalledWith.Claim != nil {
+				t.Error("expected claim not to be created")
+			}
+		})
+	}
+}
+
+// --- ListClaims Tests ---
+
+func TestListClaims_StatusFilter(t *testing.T) {
+	deps := newClaimTestDeps()
+	uc := deps.useCase()
+
+	if _, err := uc.ListClaims(context.Background(), ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.claimRepo.ListCalledWith != nil {
+		t.Errorf("expected no status filter, got %v", *deps.claimRepo.ListCalledWith)
+	}
+
+	if _, err := uc.ListClaims(context.Background(), constants.ClaimStatusPending); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.claimRepo.ListCalledWith == nil || *deps.claimRepo.ListCalledWith != constants.ClaimStatusPending {
+		t.Errorf("expected pending filter, got %v", deps.claimRepo.ListCalledWith)
+	}
+
+	if _, err := uc.ListClaims(context.Background(), "unknown"); !errors.Is(err, usecase.ErrInvalidClaimStatus) {
+		t.Errorf("expected ErrInvalidClaimStatus, got %v", err)
+	}
+}
+
+// --- ApproveClaim / DenyClaim Tests ---
+
+func TestApproveClaim_RegistersOwner(t *testing.T) {
+	deps := newClaimTestDeps()
+	deps.claimRepo.Claim = &entity.StoreClaim{ClaimID: "claim-1", StoreID: "store-1", UserID: "owner-2", Status: constants.ClaimStatusPending}
+
+	note := " verified by phone "
+	claim, err := deps.useCase().ApproveClaim(context.Background(), testAdmin, "claim-1", input.ReviewStoreClaimInput{Note: &note})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if claim.Status != constants.ClaimStatusApproved {
+		t.Errorf("expected status approved, got %s", claim.Status)
+	}
+	if claim.ReviewedBy == nil || *claim.ReviewedBy != testAdmin.UserID {
+		t.Errorf("expected reviewer %q, got %v", testAdmin.UserID, claim.ReviewedBy)
+	}
+	if claim.ReviewNote == nil || *claim.ReviewNote != "verified by phone" {
+		t.Errorf("expected trimmed review note, got %v", claim.ReviewNote)
+	}
+	if deps.ownerRepo.AddCalledWith.StoreID != "store-1" || deps.ownerRepo.AddCalledWith.UserID != "owner-2" {
+		t.Errorf("unexpected owner registration: %+v", deps.ownerRepo.AddCalledWith)
+	}
+}
+
+func TestDenyClaim_DoesNotRegisterOwner(t *testing.T) {
+	deps := newClaimTestDeps()
+	deps.claimRepo.Claim = &entity.StoreClaim{ClaimID: "claim-1", StoreID: "store-1", UserID: "owner-2", Status: constants.ClaimStatusPending}
+
+	claim, err := deps.useCase().DenyClaim(context.Background(), testAdmin, "claim-1", input.ReviewStoreClaimInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if claim.Status != constants.ClaimStatusDenied {
+		t.Errorf("expected status denied, got %s", claim.Status)
+	}
+	if deps.ownerRepo.AddCalled {
+		t.Error("expected owner not to be registered")
+	}
+}
+
+func TestApproveClaim_NotPending(t *testing.T) {
+	deps := newClaimTestDeps()
+	deps.claimRepo.Claim = &entity.StoreClaim{ClaimID: "claim-1", Status: constants.ClaimStatusDenied}
+
+	_, err := deps.useCase().ApproveClaim(context.Background(), testAdmin, "claim-1", input.ReviewStoreClaimInput{})
+	if !errors.Is(err, usecase.ErrClaimNotPending) {
+		t.Errorf("expected ErrClaimNotPending, got %v", err)
+	}
+	if deps.claimRepo.UpdateCalled {
+		t.Error("expected claim not to be updated")
+	}
+}
+
+func TestApproveClaim_ConcurrentReview(t *testing.T) {
+	deps := newClaimTestDeps()
+	deps.claimRepo.Claim = &entity.StoreClaim{ClaimID: "claim-1", StoreID: "store-1", UserID: "owner-2", Status: constants.ClaimStatusPending}
+	deps.claimRepo.UpdateErr = output.ErrClaimAlreadyReviewed
+
+	_, err := deps.useCase().ApproveClaim(context.Background(), testAdmin, "claim-1", input.ReviewStoreClaimInput{})
+	if !errors.Is(err, usecase.ErrClaimNotPending) {
+		t.Errorf("expected ErrClaimNotPending, got %v", err)
+	}
+	if deps.ownerRepo.AddCalled {
+		t.Error("expected owner not to be registered")
+	}
+}
+
+func TestApproveClaim_NotFound(t *testing.T) {
+	deps := newClaimTestDeps()
+	deps.claimRepo.FindByIDErr = apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+
+	_, err := deps.useCase().ApproveClaim(context.Background(), testAdmin, "missing", input.ReviewStoreClaimInput{})
+	if !errors.Is(err, usecase.ErrClaimNotFound) {
+		t.Errorf("expected ErrClaimNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// StoreEditUseCase は承認待ちの店舗の変更の審査に関するビジネスロジックを提供します
+type StoreEditUseCase interface {
+	ListStoreEdits(ctx context.Context, status string) ([]entity.StoreEdit, error)
+	GetStoreEdit(ctx context.Context, editID string) (*entity.StoreEdit, error)
+	ApproveStoreEdit(ctx context.Context, reviewer entity.User, editID string, input input.ReviewStoreEditInput) (*entity.StoreEdit, error)
+	RejectStoreEdit(ctx context.Context, reviewer entity.User, editID string, input input.ReviewStoreEditInput) (*entity.StoreEdit, error)
+}
+
+type storeEditUseCase struct {
+	editRepo    output.StoreEditRepository
+	storeRepo   output.StoreRepository
+	transaction output.Transaction
+}
+
+// NewStoreEditUseCase は StoreEditUseCase の実装を生成します
+func NewStoreEditUseCase(
+	editRepo output.StoreEditRepository,
+	storeRepo output.StoreRepository,
+	transaction output.Transaction,
+) StoreEditUseCase {
+	return &storeEditUseCase{
+		editRepo:    editRepo,
+		storeRepo:   storeRepo,
+		transaction: transaction,
+	}
+}
+
+// validStoreEditStatuses は変更申請一覧の絞り込みに使えるステータス
+var validStoreEditStatuses = map[string]bool{
+	constants.StoreEditStatusPending:  true,
+	constants.StoreEditStatusApproved: true,
+	constants.StoreEditStatusRejected: true,
+}
+
+// ListStoreEdits は変更申請を新しい順に、現在の店舗との差分付きで返します。status が空の場合は全件を返します
+func (uc *storeEditUseCase) ListStoreEdits(ctx context.Context, status string) ([]entity.StoreEdit, error) {
+	var filter *string
+	if status != "" {
+		if !validStoreEditStatuses[status] {
+			return nil, ErrInvalidStoreEditStatus
+		}
+		filter = &status
+	}
+	edits, err := uc.editRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(edits) == 0 {
+		return edits, nil
+	}
+
+	storeIDs := make([]string, 0, len(edits))
+	for _, edit := range edits {
+		storeIDs = append(storeIDs, edit.StoreID)
+	}
+	stores, err := uc.storeRepo.FindByIDs(ctx, dedupeStrings(storeIDs))
+	if err != nil {
+		return nil, err
+	}
+	storeByID := make(map[string]entity.Store, len(stores))
+	for _, store := range stores {
+		storeByID[store.StoreID] = store
+	}
+	for i := range edits {
+		if store, ok := storeByID[edits[i].StoreID]; ok {
+			edits[i].Changes = edits[i].Diff(store)
+		}
+	}
+	return edits, nil
+}
+
+// GetStoreEdit は変更申請を現在の店舗との差分付きで返します
+func (uc *storeEditUseCase) GetStoreEdit(ctx context.Context, editID string) (*entity.StoreEdit, error) {
+	edit, err := mustFindStoreEdit(ctx, uc.editRepo, editID)
+	if err != nil {
+		return nil, err
+	}
+	store, err := mustFindStore(ctx, uc.storeRepo, edit.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	edit.Changes = edit.Diff(*store)
+	return edit, nil
+}
+
+// ApproveStoreEdit は変更申請を承認し、同じトランザクションで店舗に反映します
+func (uc *storeEditUseCase) ApproveStoreEdit(ctx context.Context, reviewer entity.User, editID string, in input.ReviewStoreEditInput) (*entity.StoreEdit, error) {
+	return uc.reviewStoreEdit(ctx, reviewer, editID, in, constants.StoreEditStatusApproved)
+}
+
+// RejectStoreEdit は変更申請を却下します。店舗は変更しません
+func (uc *storeEditUseCase) RejectStoreEdit(ctx context.Context, reviewer entity.User, editID string, in input.ReviewStoreEditInput) (*entity.StoreEdit, error) {
+	return uc.reviewStoreEdit(ctx, reviewer, editID, in, constants.StoreEditStatusRejected)
+}
+
+func (uc *storeEditUseCase) reviewStoreEdit(
+	ctx context.Context,
+	reviewer entity.User,
+	editID string,
+	in input.ReviewStoreEditInput,
+	status string,
+) (*entity.StoreEdit, error) {
+	if reviewer.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	edit, err := mustFindStoreEdit(ctx, uc.editRepo, editID)
+	if err != nil {
+		return nil, err
+	}
+	if edit.Status != constants.StoreEditStatusPending {
+		return nil, ErrStoreEditNotPending
+	}
+	store, err := mustFindStore(ctx, uc.storeRepo, edit.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	// 差分は反映前の店舗と比べた内容として返す
+	edit.Changes = edit.Diff(*store)
+	if status == constants.StoreEditStatusApproved {
+		if err := applyStoreUpdates(store, input.UpdateStoreInput{
+			Name:      edit.Name,
+			Address:   edit.Address,
+			Latitude:  edit.Latitude,
+			Longitude: edit.Longitude,
+			PlaceID:   edit.PlaceID,
+		}); err != nil {
+			return nil, err
+		}
+		if edit.ThumbnailFileID != nil {
+			store.ThumbnailFileID = edit.ThumbnailFileID
+		}
+	}
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+
+	now := time.Now()
+	edit.Status = status
+	edit.ReviewedBy = &reviewer.UserID
+	edit.ReviewNote = trimmedOrNil(in.Note)
+	edit.ReviewedAt = &now
+	edit.UpdatedAt = now
+
+	err = uc.transaction.StartTransaction(func(tx interface{}) error {
+		if err := uc.editRepo.UpdateReviewInTx(ctx, tx, edit); err != nil {
+			return err
+		}
+		if status != constants.StoreEditStatusApproved {
+			return nil
+		}
+		return uc.storeRepo.UpdateInTx(ctx, tx, store)
+	})
+	if errors.Is(err, output.ErrStoreEditAlreadyReviewed) {
+		return nil, ErrStoreEditNotPending
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return edit, nil
+}
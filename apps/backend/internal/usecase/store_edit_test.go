@@ -0,0 +1,291 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type storeEditTestDeps struct {
+	storeRepo *testutil.MockStoreRepository
+	ownerRepo *testutil.MockStoreOwnerRepository
+	editRepo  *testutil.MockStoreEditRepository
+}
+
+func newStoreEditTestDeps(approvalStatus string) storeEditTestDeps {
+	return storeEditTestDeps{
+		storeRepo: &testutil.MockStoreRepository{Store: &entity.Store{
+			StoreID:        "store-1",
+			Name:           "Old Name",
+			Address:        "Old Address",
+			PlaceID:        "place-1",
+			ApprovalStatus: approvalStatus,
+		}},
+		ownerRepo: &testutil.MockStoreOwnerRepository{Owners: map[string][]string{"store-1": {testOwner.UserID}}},
+		editRepo:  &testutil.MockStoreEditRepository{},
+	}
+}
+
+func (d storeEditTestDeps) storeUseCase() usecase.StoreUseCase {
+	return usecase.NewStoreUseCase(d.storeRepo, &testutil.MockStationRepository{}, d.ownerRepo, &testutil.MockStoreTagRepository{}, d.editRepo, &testutil.MockTransaction{})
+}
+
+func (d storeEditTestDeps) editUseCase() usecase.StoreEditUseCase {
+	return usecase.NewStoreEditUseCase(d.editRepo, d.storeRepo, &testutil.MockTransaction{})
+}
+
+// --- UpdateStore staging Tests ---
+
+func TestUpdateStore_OwnerSensitiveEditOnApprovedStoreIsStaged(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+
+	store, err := deps.storeUseCase().UpdateStore(context.Background(), testOwner, "store-1", input.UpdateStoreInput{
+		Name:        testutil.StringPtr("New Name"),
+		Description: testutil.StringPtr("Now with terrace seats"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.Name != "Old Name" {
+		t.Errorf("expected name to stay until approved, got %q", store.Name)
+	}
+	if store.Description == nil || *store.Description != "Now with terrace seats" {
+		t.Errorf("expected non-sensitive field to be applied, got %v", store.Description)
+	}
+	created := deps.editRepo.Created
+	if created == nil {
+		t.Fatal("expected a pending edit to be created")
+	}
+	if created.Name == nil || *created.Name != "New Name" || created.UserID != testOwner.UserID {
+		t.Errorf("unexpected pending edit: %+v", created)
+	}
+	if created.Status != constants.StoreEditStatusPending {
+		t.Errorf("expected pending status, got %s", created.Status)
+	}
+	if store.PendingEdit != created {
+		t.Error("expected the pending edit to be returned with the store")
+	}
+}
+
+func TestUpdateStore_UnchangedSensitiveFieldsAreNotStaged(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+
+	store, err := deps.storeUseCase().UpdateStore(context.Background(), testOwner, "store-1", input.UpdateStoreInput{
+		Name:    testutil.StringPtr("Old Name"),
+		Address: testutil.StringPtr("Old Address"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.editRepo.Created != nil || store.PendingEdit != nil {
+		t.Error("expected no pending edit when nothing changes")
+	}
+}
+
+func TestUpdateStore_MergesIntoExistingPendingEdit(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+	deps.editRepo.Pending = &entity.StoreEdit{
+		EditID:  "edit-9",
+		StoreID: "store-1",
+		UserID:  "owner-2",
+		Name:    testutil.StringPtr("Earlier Name"),
+		Status:  constants.StoreEditStatusPending,
+	}
+
+	_, err := deps.storeUseCase().UpdateStore(context.Background(), testOwner, "store-1", input.UpdateStoreInput{
+		Address: testutil.StringPtr("New Address"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.editRepo.Created != nil {
+		t.Error("expected no second pending edit")
+	}
+	updated := deps.editRepo.Updated
+	if updated == nil || updated.EditID != "edit-9" {
+		t.Fatalf("expected edit-9 to be updated, got %+v", updated)
+	}
+	if *updated.Name != "Earlier Name" || *updated.Address != "New Address" || updated.UserID != testOwner.UserID {
+		t.Errorf("unexpected merged edit: %+v", updated)
+	}
+}
+
+func TestUpdateStore_PendingEditReviewedConcurrently(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+	deps.editRepo.Pending = &entity.StoreEdit{EditID: "edit-9", StoreID: "store-1", Status: constants.StoreEditStatusPending}
+	deps.editRepo.UpdateErr = output.ErrStoreEditAlreadyReviewed
+
+	_, err := deps.storeUseCase().UpdateStore(context.Background(), testOwner, "store-1", input.UpdateStoreInput{
+		Name: testutil.StringPtr("New Name"),
+	})
+	if !errors.Is(err, usecase.ErrStoreEditNotPending) {
+		t.Errorf("expected ErrStoreEditNotPending, got %v", err)
+	}
+}
+
+func TestUpdateStore_SensitiveEditAppliedDirectly(t *testing.T) {
+	tests := []struct {
+		name           string
+		actor          entity.User
+		approvalStatus string
+	}{
+		{"admin on approved store", testAdmin, constants.StoreApprovalApproved},
+		{"owner on draft store", testOwner, constants.StoreApprovalDraft},
+		{"owner on rejected store", testOwner, constants.StoreApprovalRejected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := newStoreEditTestDeps(tt.approvalStatus)
+
+			store, err := deps.storeUseCase().UpdateStore(context.Background(), tt.actor, "store-1", input.UpdateStoreInput{
+				Name: testutil.StringPtr("New Name"),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if store.Name != "New Name" {
+				t.Errorf("expected name to be applied, got %q", store.Name)
+			}
+			if deps.editRepo.Created != nil {
+				t.Error("expected no pending edit")
+			}
+		})
+	}
+}
+
+// --- ListStoreEdits / GetStoreEdit Tests ---
+
+func TestListStoreEdits_WithDiff(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+	deps.storeRepo.Stores = []entity.Store{*deps.storeRepo.Store}
+	deps.editRepo.Edits = []entity.StoreEdit{{
+		EditID:  "edit-1",
+		StoreID: "store-1",
+		Name:    testutil.StringPtr("New Name"),
+		Address: testutil.StringPtr("Old Address"),
+		Status:  constants.StoreEditStatusPending,
+	}}
+
+	edits, err := deps.editUseCase().ListStoreEdits(context.Background(), constants.StoreEditStatusPending)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.editRepo.ListStatus == nil || *deps.editRepo.ListStatus != constants.StoreEditStatusPending {
+		t.Errorf("expected pending filter, got %v", deps.editRepo.ListStatus)
+	}
+	changes := edits[0].Changes
+	if len(changes) != 1 {
+		t.Fatalf("expected 1 changed field, got %+v", changes)
+	}
+	if changes[0].Field != "name" || changes[0].Current != "Old Name" || changes[0].Proposed != "New Name" {
+		t.Errorf("unexpected change: %+v", changes[0])
+	}
+}
+
+func TestListStoreEdits_InvalidStatus(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+
+	_, err := deps.editUseCase().ListStoreEdits(context.Background(), "unknown")
+	if !errors.Is(err, usecase.ErrInvalidStoreEditStatus) {
+		t.Errorf("expected ErrInvalidStoreEditStatus, got %v", err)
+	}
+}
+
+func TestGetStoreEdit_NotFound(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+
+	_, err := deps.editUseCase().GetStoreEdit(context.Background(), "missing")
+	if !errors.Is(err, usecase.ErrStoreEditNotFound) {
+		t.Errorf("expected ErrStoreEditNotFound, got %v", err)
+	}
+}
+
+// --- ApproveStoreEdit / RejectStoreEdit Tests ---
+
+func TestApproveStoreEdit_AppliesChanges(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+	deps.editRepo.Edit = &entity.StoreEdit{
+		EditID:  "edit-1",
+		StoreID: "store-1",
+		Name:    testutil.StringPtr("New Name"),
+		PlaceID: testutil.StringPtr("place-2"),
+		Status:  constants.StoreEditStatusPending,
+	}
+
+	edit, err := deps.editUseCase().ApproveStoreEdit(context.Background(), testAdmin, "edit-1", input.ReviewStoreEditInput{Note: testutil.StringPtr(" looks good ")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if edit.Status != constants.StoreEditStatusApproved {
+		t.Errorf("expected approved, got %s", edit.Status)
+	}
+	if edit.ReviewedBy == nil || *edit.ReviewedBy != testAdmin.UserID || edit.ReviewedAt == nil {
+		t.Errorf("expected reviewer to be recorded, got %+v", edit)
+	}
+	if edit.ReviewNote == nil || *edit.ReviewNote != "looks good" {
+		t.Errorf("expected trimmed note, got %v", edit.ReviewNote)
+	}
+	if len(edit.Changes) != 2 {
+		t.Errorf("expected diff against the store before approval, got %+v", edit.Changes)
+	}
+	updated := deps.storeRepo.UpdateCalledWith
+	if updated == nil || updated.Name != "New Name" || updated.PlaceID != "place-2" || updated.Address != "Old Address" {
+		t.Errorf("expected edit to be applied to the store, got %+v", updated)
+	}
+}
+
+func TestRejectStoreEdit_KeepsStore(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+	deps.editRepo.Edit = &entity.StoreEdit{
+		EditID:  "edit-1",
+		StoreID: "store-1",
+		Name:    testutil.StringPtr("New Name"),
+		Status:  constants.StoreEditStatusPending,
+	}
+
+	edit, err := deps.editUseCase().RejectStoreEdit(context.Background(), testAdmin, "edit-1", input.ReviewStoreEditInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if edit.Status != constants.StoreEditStatusRejected {
+		t.Errorf("expected rejected, got %s", edit.Status)
+	}
+	if deps.storeRepo.UpdateCalled {
+		t.Error("expected store not to be updated")
+	}
+	if deps.storeRepo.Store.Name != "Old Name" {
+		t.Errorf("expected store name to stay, got %q", deps.storeRepo.Store.Name)
+	}
+}
+
+func TestReviewStoreEdit_NotPending(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+	deps.editRepo.Edit = &entity.StoreEdit{EditID: "edit-1", StoreID: "store-1", Status: constants.StoreEditStatusRejected}
+
+	_, err := deps.editUseCase().ApproveStoreEdit(context.Background(), testAdmin, "edit-1", input.ReviewStoreEditInput{})
+	if !errors.Is(err, usecase.ErrStoreEditNotPending) {
+		t.Errorf("expected ErrStoreEditNotPending, got %v", err)
+	}
+}
+
+func TestReviewStoreEdit_ReviewedConcurrently(t *testing.T) {
+	deps := newStoreEditTestDeps(constants.StoreApprovalApproved)
+	deps.editRepo.Edit = &entity.StoreEdit{EditID: "edit-1", StoreID: "store-1", Name: testutil.StringPtr("New Name"), Status: constants.StoreEditStatusPending}
+	deps.editRepo.ReviewErr = output.ErrStoreEditAlreadyReviewed
+
+	_, err := deps.editUseCase().ApproveStoreEdit(context.Background(), testAdmin, "edit-1", input.ReviewStoreEditInput{})
+	if !errors.Is(err, usecase.ErrStoreEditNotPending) {
+		t.Errorf("expected ErrStoreEditNotPending, got %v", err)
+	}
+	if deps.storeRepo.UpdateCalled {
+		t.Error("expected store not to be updated")
+	}
+}
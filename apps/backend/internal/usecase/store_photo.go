@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// StorePhotoUseCase は店舗のギャラリーに関するビジネスロジックを提供します
+type StorePhotoUseCase interface {
+	ListStorePhotos(ctx context.Context, viewer entity.User, storeID string) ([]entity.StorePhoto, error)
+	UpdateStorePhoto(ctx context.Context, actor entity.User, storeID string, fileID string, input input.UpdateStorePhotoInput) (*entity.StorePhoto, error)
+	ReorderStorePhotos(ctx context.Context, actor entity.User, storeID string, fileIDs []string) ([]entity.StorePhoto, error)
+	SetStoreCover(ctx context.Context, actor entity.User, storeID string, fileID string) (*entity.Store, error)
+	DeleteStorePhoto(ctx context.Context, actor entity.User, storeID string, fileID string) error
+}
+
+type storePhotoUseCase struct {
+	photoRepo      output.StorePhotoRepository
+	storeRepo      output.StoreRepository
+	storeOwnerRepo output.StoreOwnerRepository
+	storeEditRepo  output.StoreEditRepository
+	transaction    output.Transaction
+}
+
+// NewStorePhotoUseCase は StorePhotoUseCase の実装を生成します
+func NewStorePhotoUseCase(
+	photoRepo output.StorePhotoRepository,
+	storeRepo output.StoreRepository,
+	storeOwnerRepo output.StoreOwnerRepository,
+	storeEditRepo output.StoreEditRepository,
+	transaction output.Transaction,
+) StorePhotoUseCase {
+	return &storePhotoUseCase{
+		photoRepo:      photoRepo,
+		storeRepo:      storeRepo,
+		storeOwnerRepo: storeOwnerRepo,
+		storeEditRepo:  storeEditRepo,
+		transaction:    transaction,
+	}
+}
+
+// ListStorePhotos は閲覧者が見られる店舗のギャラリーを表示順に返します
+func (uc *storePhotoUseCase) ListStorePhotos(ctx context.Context, viewer entity.User, storeID string) ([]entity.StorePhoto, error) {
+	if err := ensureStoreVisible(ctx, uc.storeRepo, storeID, viewer); err != nil {
+		return nil, err
+	}
+	return uc.photoRepo.FindByStoreID(ctx, storeID)
+}
+
+// UpdateStorePhoto は写真のキャプションと代替テキストを更新します。admin 以外は店舗のオーナーである必要があります
+func (uc *storePhotoUseCase) UpdateStorePhoto(
+	ctx context.Context,
+	actor entity.User,
+	storeID string,
+	fileID string,
+	in input.UpdateStorePhotoInput,
+) (*entity.StorePhoto, error) {
+	if err := uc.ensureCanManagePhotos(ctx, actor, storeID); err != nil {
+		return nil, err
+	}
+	photo, err := uc.mustFindStorePhoto(ctx, storeID, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Caption != nil {
+		if photo.Caption, err = normalizeStorePhotoText(*in.Caption, constants.MaxStorePhotoCaptionLength); err != nil {
+			return nil, err
+		}
+	}
+	if in.AltText != nil {
+		if photo.AltText, err = normalizeStorePhotoText(*in.AltText, constants.MaxStorePhotoAltTextLength); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.photoRepo.UpdateDetails(ctx, photo); err != nil {
+		return nil, err
+	}
+	return photo, nil
+}
+
+// ReorderStorePhotos はギャラリーの写真を fileIDs の順に並べ替えます。fileIDs はギャラリーのすべての写真をちょうど1回ずつ含む必要があります
+func (uc *storePhotoUseCase) ReorderStorePhotos(ctx context.Context, actor entity.User, storeID string, fileIDs []string) ([]entity.StorePhoto, error) {
+	if err := uc.ensureCanManagePhotos(ctx, actor, storeID); err != nil {
+		return nil, err
+	}
+
+	current, err := uc.photoRepo.FindByStoreID(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if !isStorePhotoPermutation(current, fileIDs) {
+		return nil, ErrInvalidStorePhotoOrder
+	}
+
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+	if err := uc.transaction.StartTransaction(func(tx interface{}) error {
+		return uc.photoRepo.ReorderInTx(ctx, tx, storeID, fileIDs)
+	}); err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return nil, ErrInvalidStorePhotoOrder
+		}
+		return nil, err
+	}
+
+	return uc.photoRepo.FindByStoreID(ctx, storeID)
+}
+
+// SetStoreCover はギャラリーの写真を店舗のカバー画像にします。
+// 承認済みの店舗ではオーナーによるカバー画像の変更は店舗の重要な項目の変更と同じく管理者の承認待ちとし、PendingEdit に設定して返します
+func (uc *storePhotoUseCase) SetStoreCover(ctx context.Context, actor entity.User, storeID string, fileID string) (*entity.Store, error) {
+	store, err := mustFindStore(ctx, uc.storeRepo, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureCanManageStore(ctx, uc.storeOwnerRepo, storeID, actor); err != nil {
+		return nil, err
+	}
+	if _, err := uc.mustFindStorePhoto(ctx, storeID, fileID); err != nil {
+		return nil, err
+	}
+
+	var edit *entity.StoreEdit
+	if needsStoreEditApproval(actor, store) {
+		if edit, err = stageStoreEdit(ctx, uc.storeEditRepo, actor, store, entity.StoreEdit{ThumbnailFileID: &fileID}); err != nil {
+			return nil, err
+		}
+	}
+
+	if uc.transaction == nil {
+		return nil, output.ErrInvalidTransaction
+	}
+	if err := uc.transaction.StartTransaction(func(tx interface{}) error {
+		if needsStoreEditApproval(actor, store) {
+			return saveStoreEditInTx(ctx, uc.storeEditRepo, tx, edit)
+		}
+		return uc.photoRepo.SetCoverInTx(ctx, tx, storeID, fileID)
+	}); err != nil {
+		if errors.Is(err, output.ErrStoreEditAlreadyReviewed) {
+			return nil, ErrStoreEditNotPending
+		}
+		return nil, err
+	}
+
+	updated, err := uc.storeRepo.FindByID(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	updated.PendingEdit = edit
+	return updated, nil
+}
+
+// DeleteStorePhoto はギャラリーの写真を論理削除します。カバー画像だった場合、店舗のカバー画像は未設定になります
+func (uc *storePhotoUseCase) DeleteStorePhoto(ctx context.Context, actor entity.User, storeID string, fileID string) error {
+	if err := uc.ensureCanManagePhotos(ctx, actor, storeID); err != nil {
+		return err
+	}
+	if _, err := uc.mustFindStorePhoto(ctx, storeID, fileID); err != nil {
+		return err
+	}
+
+	if uc.transaction == nil {
+		return output.ErrInvalidTransaction
+	}
+	err := uc.transaction.StartTransaction(func(tx interface{}) error {
+		return uc.photoRepo.DeleteInTx(ctx, tx, storeID, fileID)
+	})
+	if apperr.IsCode(err, apperr.CodeNotFound) {
+		return ErrStorePhotoNotFound
+	}
+	return err
+}
+
+func (uc *storePhotoUseCase) ensureCanManagePhotos(ctx context.Context, actor entity.User, storeID string) error {
+	if err := ensureStoreExists(ctx, uc.storeRepo, storeID); err != nil {
+		return err
+	}
+	return ensureCanManageStore(ctx, uc.storeOwnerRepo, storeID, actor)
+}
+
+// mustFindStorePhoto は店舗のギャラリーの削除されていない写真を返します
+func (uc *storePhotoUseCase) mustFindStorePhoto(ctx context.Context, storeID string, fileID string) (*entity.StorePhoto, error) {
+	photo, err := uc.photoRepo.FindByID(ctx, storeID, fileID)
+	if err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return nil, ErrStorePhotoNotFound
+		}
+		return nil, err
+	}
+	return photo, nil
+}
+
+// normalizeStorePhotoText は前後の空白を取り除きます。空の場合は nil（未設定）を返します
+func normalizeStorePhotoText(value string, maxLength int) (*string, error) {
+	trimmed := strings.TrimSpace(value)
+	if utf8.RuneCountInString(trimmed) > maxLength {
+		return nil, ErrInvalidStorePhotoText
+	}
+	if trimmed == "" {
+		return nil, nil
+	}
+	return &trimmed, nil
+}
+
+func isStorePhotoPermutation(photos []entity.StorePhoto, fileIDs []string) bool {
+	if len(photos) != len(fileIDs) {
+		return false
+	}
+	remaining := make(map[string]bool, len(photos))
+	for _, photo := range photos {
+		remaining[photo.File.FileID] = true
+	}
+	for _, id := range fileIDs {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
+}
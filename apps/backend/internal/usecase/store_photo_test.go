@@ -0,0 +1,228 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+type storePhotoTestDeps struct {
+	photoRepo *testutil.MockStorePhotoRepository
+	storeRepo *testutil.MockStoreRepository
+	editRepo  *testutil.MockStoreEditRepository
+	tx        *testutil.MockTransaction
+}
+
+func newStorePhotoTestDeps(approval string) *storePhotoTestDeps {
+	return &storePhotoTestDeps{
+		photoRepo: &testutil.MockStorePhotoRepository{Photos: []entity.StorePhoto{
+			{StoreID: "store-1", File: entity.File{FileID: "file-1"}, SortOrder: 0, IsCover: true},
+			{StoreID: "store-1", File: entity.File{FileID: "file-2"}, SortOrder: 1},
+		}},
+		storeRepo: &testutil.MockStoreRepository{Store: &entity.Store{
+			StoreID:         "store-1",
+			ThumbnailFileID: testutil.StringPtr("file-1"),
+			ApprovalStatus:  approval,
+		}},
+		editRepo: &testutil.MockStoreEditRepository{},
+		tx:       &testutil.MockTransaction{},
+	}
+}
+
+func (d *storePhotoTestDeps) useCase() usecase.StorePhotoUseCase {
+	owners := &testutil.MockStoreOwnerRepository{Owners: map[string][]string{"store-1": {testOwner.UserID}}}
+	return usecase.NewStorePhotoUseCase(d.photoRepo, d.storeRepo, owners, d.editRepo, d.tx)
+}
+
+func TestUpdateStorePhoto_TrimsAndClearsText(t *testing.T) {
+	deps := newStorePhotoTestDeps(constants.StoreApprovalDraft)
+	deps.photoRepo.Photos[1].AltText = testutil.StringPtr("old alt text")
+
+	photo, err := deps.useCase().UpdateStorePhoto(context.Background(), testOwner, "store-1", "file-2", input.UpdateStorePhotoInput{
+		Caption: testutil.StringPtr("  テラス席  "),
+		AltText: testutil.StringPtr(""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if photo.Caption == nil || *photo.Caption != "テラス席" {
+		t.Errorf("expected trimmed caption, got %v", photo.Caption)
+	}
+	if photo.AltText != nil {
+		t.Errorf("expected alt text to be cleared, got %q", *photo.AltText)
+	}
+	if deps.photoRepo.UpdateCalledWith == nil || deps.photoRepo.UpdateCalledWith.File.FileID != "file-2" {
+		t.Errorf("expected file-2 to be updated, got %+v", deps.photoRepo.UpdateCalledWith)
+	}
+}
+
+func TestUpdateStorePhoto_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		actor  entity.User
+		fileID string
+		input  input.UpdateStorePhotoInput
+		want   error
+	}{
+		{
+			name:   "caption too long",
+			actor:  testOwner,
+			fileID: "file-1",
+			input:  input.UpdateStorePhotoInput{Caption: testutil.StringPtr(strings.Repeat("あ", constants.MaxStorePhotoCaptionLength+1))},
+			want:   usecase.ErrInvalidStorePhotoText,
+		},
+		{
+			name:   "photo not in gallery",
+			actor:  testOwner,
+			fileID: "file-9",
+			want:   usecase.ErrStorePhotoNotFound,
+		},
+		{
+			name:   "not an owner",
+			actor:  entity.User{UserID: "owner-2", Role: testOwner.Role},
+			fileID: "file-1",
+			want:   usecase.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := newStorePhotoTestDeps(constants.StoreApprovalDraft)
+
+			_, err := deps.useCase().UpdateStorePhoto(context.Background(), tt.actor, "store-1", tt.fileID, tt.input)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+			if deps.photoRepo.UpdateCalledWith != nil {
+				t.Error("expected photo not to be updated")
+			}
+		})
+	}
+}
+
+func TestReorderStorePhotos_Success(t *testing.T) {
+	deps := newStorePhotoTestDeps(constants.StoreApprovalDraft)
+
+	_, err := deps.useCase().ReorderStorePhotos(context.Background(), testOwner, "store-1", []string{"file-2", "file-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := deps.photoRepo.ReorderCalledWith; len(got) != 2 || got[0] != "file-2" || got[1] != "file-1" {
+		t.Errorf("unexpected order %v", got)
+	}
+}
+
+func TestReorderStorePhotos_NotPermutation(t *testing.T) {
+	tests := map[string][]string{
+		"missing photo":   {"file-1"},
+		"duplicate photo": {"file-1", "file-1"},
+		"unknown photo":   {"file-1", "file-9"},
+	}
+	for name, fileIDs := range tests {
+		t.Run(name, func(t *testing.T) {
+			deps := newStorePhotoTestDeps(constants.StoreApprovalDraft)
+
+			_, err := deps.useCase().ReorderStorePhotos(context.Background(), testOwner, "store-1", fileIDs)
+			if !errors.Is(err, usecase.ErrInvalidStorePhotoOrder) {
+				t.Errorf("expected ErrInvalidStorePhotoOrder, got %v", err)
+			}
+			if deps.photoRepo.ReorderCalledWith != nil {
+				t.Error("expected gallery not to be reordered")
+			}
+		})
+	}
+}
+
+func TestSetStoreCover_AppliesImmediatelyBeforeApproval(t *testing.T) {
+	deps := newStorePhotoTestDeps(constants.StoreApprovalDraft)
+
+	store, err := deps.useCase().SetStoreCover(context.Background(), testOwner, "store-1", "file-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.photoRepo.CoverCalledWith != "file-2" {
+		t.Errorf("expected file-2 to become the cover, got %q", deps.photoRepo.CoverCalledWith)
+	}
+	if store.PendingEdit != nil || deps.editRepo.Created != nil {
+		t.Error("expected no pending edit for a store that is not approved")
+	}
+}
+
+func TestSetStoreCover_OwnerOfApprovedStoreIsStaged(t *testing.T) {
+	deps := newStorePhotoTestDeps(constants.StoreApprovalApproved)
+
+	store, err := deps.useCase().SetStoreCover(context.Background(), testOwner, "store-1", "file-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.photoRepo.CoverCalledWith != "" {
+		t.Errorf("expected cover not to change before approval, got %q", deps.photoRepo.CoverCalledWith)
+	}
+	created := deps.editRepo.Created
+	if created == nil || created.ThumbnailFileID == nil || *created.ThumbnailFileID != "file-2" {
+		t.Fatalf("expected a pending cover change, got %+v", created)
+	}
+	if store.PendingEdit != created {
+		t.Error("expected the pending edit to be returned with the store")
+	}
+}
+
+func TestSetStoreCover_AdminAppliesImmediately(t *testing.T) {
+	deps := newStorePhotoTestDeps(constants.StoreApprovalApproved)
+
+	if _, err := deps.useCase().SetStoreCover(context.Background(), testAdmin, "store-1", "file-2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.photoRepo.CoverCalledWith != "file-2" || deps.editRepo.Created != nil {
+		t.Errorf("expected admin change to apply immediately, got cover %q edit %+v", deps.photoRepo.CoverCalledWith, deps.editRepo.Created)
+	}
+}
+
+func TestSetStoreCover_PhotoNotInGallery(t *testing.T) {
+	deps := newStorePhotoTestDeps(constants.StoreApprovalDraft)
+
+	_, err := deps.useCase().SetStoreCover(context.Background(), testOwner, "store-1", "review-photo")
+	if !errors.Is(err, usecase.ErrStorePhotoNotFound) {
+		t.Errorf("expected ErrStorePhotoNotFound, got %v", err)
+	}
+}
+
+func TestDeleteStorePhoto_Success(t *testing.T) {
+	deps := newStorePhotoTestDeps(constants.StoreApprovalApproved)
+
+	if err := deps.useCase().DeleteStorePhoto(context.Background(), testOwner, "store-1", "file-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deps.photoRepo.DeleteCalledWith != "file-1" {
+		t.Errorf("expected file-1 to be deleted, got %q", deps.photoRepo.DeleteCalledWith)
+	}
+	if !deps.tx.StartTransactionCalled {
+		t.Error("expected deletion to run in a transaction")
+	}
+}
+
+func TestDeleteStorePhoto_NotFound(t *testing.T) {
+	deps := newStorePhotoTestDeps(constants.StoreApprovalDraft)
+
+	err := deps.useCase().DeleteStorePhoto(context.Background(), testOwner, "store-1", "file-9")
+	if !errors.Is(err, usecase.ErrStorePhotoNotFound) {
+		t.Errorf("expected ErrStorePhotoNotFound, got %v", err)
+	}
+}
+
+func TestListStorePhotos_HiddenStore(t *testing.T) {
+	deps := newStorePhotoTestDeps(constants.StoreApprovalDraft)
+	deps.storeRepo.NotVisible = true
+
+	_, err := deps.useCase().ListStorePhotos(context.Background(), entity.User{}, "store-1")
+	if !errors.Is(err, usecase.ErrStoreNotFound) {
+		t.Errorf("expected ErrStoreNotFound, got %v", err)
+	}
+}
@@ -3,21 +3,29 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
 	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/role"
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
 
 const (
-	testFileID  = "file-1"
 	testNewName = "New Name"
 )
 
+var (
+	testOwner = entity.User{UserID: "owner-1", Role: role.Owner}
+	testAdmin = entity.User{UserID: "admin-1", Role: role.Admin}
+)
+
 // --- GetAllStores Tests ---
 
 func TestGetAllStores_Success(t *testing.T) {
@@ -28,9 +36,9 @@ func TestGetAllStores_Success(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
-	stores, err := uc.GetAllStores(context.Background())
+	stores, err := uc.GetAllStores(context.Background(), entity.User{})
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -45,9 +53,9 @@ func TestGetAllStores_Empty(t *testing.T) {
 		Stores: []entity.Store{},
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
-	stores, err := uc.GetAllStores(context.Background())
+	stores, err := uc.GetAllStores(context.Background(), entity.User{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -63,15 +71,214 @@ func TestGetAllStores_RepositoryError(t *testing.T) {
 		FindAllErr: dbErr,
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.GetAllStores(context.Background(), entity.User{})
+
+	if !errors.Is(err, dbErr) {
+		t.Errorf("expected database error, got %v", err)
+	}
+}
+
+// --- ListStores Tests ---
+
+func TestListStores_Defaults(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{
+		Page: &output.StorePage{
+			Stores:     []entity.Store{{StoreID: "store-1"}},
+			NextCursor: "next",
+		},
+	}
+
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	page, err := uc.ListStores(context.Background(), entity.User{}, input.ListStoresQuery{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.NextCursor != "next" || len(page.Stores) != 1 {
+		t.Errorf("unexpected page: %+v", page)
+	}
+	if mockRepo.ListCalledWith.Limit != constants.DefaultStoreListLimit {
+		t.Errorf("expected default limit %d, got %d", constants.DefaultStoreListLimit, mockRepo.ListCalledWith.Limit)
+	}
+	if mockRepo.ListCalledWith.Sort != constants.StoreSortByNew {
+		t.Errorf("expected sort %q, got %q", constants.StoreSortByNew, mockRepo.ListCalledWith.Sort)
+	}
+}
+
+func TestListStores_ClampsLimit(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.ListStores(context.Background(), entity.User{}, input.ListStoresQuery{Limit: 1000, Sort: constants.StoreSortByRating})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mockRepo.ListCalledWith.Limit != constants.MaxStoreListLimit {
+		t.Errorf("expected limit %d, got %d", constants.MaxStoreListLimit, mockRepo.ListCalledWith.Limit)
+	}
+	if mockRepo.ListCalledWith.Sort != constants.StoreSortByRating {
+		t.Errorf("expected sort %q, got %q", constants.StoreSortByRating, mockRepo.ListCalledWith.Sort)
+	}
+}
+
+func TestListStores_InvalidInput(t *testing.T) {
+	badBudget := "$$$$"
+	lowRating := -1.0
+	highRating := 5.5
+
+	tests := []struct {
+		name  string
+		query input.ListStoresQuery
+	}{
+		{name: "negative limit", query: input.ListStoresQuery{Limit: -1}},
+		{name: "invalid budget", query: input.ListStoresQuery{Budget: &badBudget}},
+		{name: "min rating below range", query: input.ListStoresQuery{MinRating: &lowRating}},
+		{name: "min rating above range", query: input.ListStoresQuery{MinRating: &highRating}},
+		{name: "unknown sort", query: input.ListStoresQuery{Sort: "unknown"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &testutil.MockStoreRepository{}
+			uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+			_, err := uc.ListStores(context.Background(), entity.User{}, tt.query)
+			if !errors.Is(err, usecase.ErrInvalidInput) {
+				t.Errorf("expected ErrInvalidInput, got %v", err)
+			}
+			if mockRepo.ListCalled {
+				t.Error("repository should not be called for invalid input")
+			}
+		})
+	}
+}
 
-	_, err := uc.GetAllStores(context.Background())
+func TestListStores_RepositoryError(t *testing.T) {
+	dbErr := errors.New("database error")
+	mockRepo := &testutil.MockStoreRepository{ListErr: dbErr}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
+	_, err := uc.ListStores(context.Background(), entity.User{}, input.ListStoresQuery{})
 	if !errors.Is(err, dbErr) {
 		t.Errorf("expected database error, got %v", err)
 	}
 }
 
+// --- FindNearbyStores Tests ---
+
+func TestFindNearbyStores_ByPoint(t *testing.T) {
+	near, far := 120.0, 400.0
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{
+			{StoreID: "store-1", DistanceMeters: &near},
+			{StoreID: "store-2", DistanceMeters: &far},
+		},
+	}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	lat, lng := 34.69, 135.19
+	stores, err := uc.FindNearbyStores(context.Background(), entity.User{}, input.NearbyStoresQuery{Latitude: &lat, Longitude: &lng})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := mockRepo.FindNearbyWith
+	if got.Latitude != lat || got.Longitude != lng {
+		t.Errorf("unexpected origin: %+v", got)
+	}
+	if got.RadiusMeters != constants.DefaultNearbyRadiusMeters {
+		t.Errorf("expected default radius %d, got %v", constants.DefaultNearbyRadiusMeters, got.RadiusMeters)
+	}
+	if got.Limit != constants.DefaultStoreListLimit {
+		t.Errorf("expected default limit %d, got %d", constants.DefaultStoreListLimit, got.Limit)
+	}
+	// 120m -> 2分, 400m -> 5分 (80m/分, 切り上げ)
+	if stores[0].DistanceMinutes != 2 || stores[1].DistanceMinutes != 5 {
+		t.Errorf("unexpected walking minutes: %d, %d", stores[0].DistanceMinutes, stores[1].DistanceMinutes)
+	}
+}
+
+func TestFindNearbyStores_ByStation(t *testing.T) {
+	lat, lng := 34.694839, 135.194942
+	mockStationRepo := &testutil.MockStationRepository{
+		Station: &entity.Station{ID: 1, Lat: &lat, Lng: &lng},
+	}
+	mockRepo := &testutil.MockStoreRepository{}
+	uc := usecase.NewStoreUseCase(mockRepo, mockStationRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	stationID := int64(1)
+	_, err := uc.FindNearbyStores(context.Background(), entity.User{}, input.NearbyStoresQuery{StationID: &stationID, RadiusMeters: 99999, Limit: 1000})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mockStationRepo.FindByIDCalledWith != stationID {
+		t.Errorf("expected station %d, got %d", stationID, mockStationRepo.FindByIDCalledWith)
+	}
+	got := mockRepo.FindNearbyWith
+	if got.Latitude != lat || got.Longitude != lng {
+		t.Errorf("expected station coordinates, got %+v", got)
+	}
+	if got.RadiusMeters != constants.MaxNearbyRadiusMeters {
+		t.Errorf("expected radius clamped to %d, got %v", constants.MaxNearbyRadiusMeters, got.RadiusMeters)
+	}
+	if got.Limit != constants.MaxStoreListLimit {
+		t.Errorf("expected limit clamped to %d, got %d", constants.MaxStoreListLimit, got.Limit)
+	}
+}
+
+func TestFindNearbyStores_StationNotFound(t *testing.T) {
+	mockStationRepo := &testutil.MockStationRepository{
+		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
+	}
+	mockRepo := &testutil.MockStoreRepository{}
+	uc := usecase.NewStoreUseCase(mockRepo, mockStationRepo, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	stationID := int64(999)
+	_, err := uc.FindNearbyStores(context.Background(), entity.User{}, input.NearbyStoresQuery{StationID: &stationID})
+	if !errors.Is(err, usecase.ErrStationNotFound) {
+		t.Errorf("expected ErrStationNotFound, got %v", err)
+	}
+	if mockRepo.FindNearbyCalled {
+		t.Error("repository should not be called when station is missing")
+	}
+}
+
+func TestFindNearbyStores_InvalidInput(t *testing.T) {
+	lat, lng := 35.0, 135.0
+	badLat := 91.0
+	stationID := int64(1)
+
+	tests := []struct {
+		name    string
+		query   input.NearbyStoresQuery
+		wantErr error
+	}{
+		{name: "no origin", query: input.NearbyStoresQuery{}, wantErr: usecase.ErrInvalidInput},
+		{name: "point and station", query: input.NearbyStoresQuery{Latitude: &lat, Longitude: &lng, StationID: &stationID}, wantErr: usecase.ErrInvalidInput},
+		{name: "missing longitude", query: input.NearbyStoresQuery{Latitude: &lat}, wantErr: usecase.ErrInvalidCoordinates},
+		{name: "latitude out of range", query: input.NearbyStoresQuery{Latitude: &badLat, Longitude: &lng}, wantErr: usecase.ErrInvalidCoordinates},
+		{name: "negative radius", query: input.NearbyStoresQuery{Latitude: &lat, Longitude: &lng, RadiusMeters: -1}, wantErr: usecase.ErrInvalidInput},
+		{name: "negative limit", query: input.NearbyStoresQuery{Latitude: &lat, Longitude: &lng, Limit: -1}, wantErr: usecase.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &testutil.MockStoreRepository{}
+			uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+			_, err := uc.FindNearbyStores(context.Background(), entity.User{}, tt.query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if mockRepo.FindNearbyCalled {
+				t.Error("repository should not be called for invalid input")
+			}
+		})
+	}
+}
+
 // --- GetStoreByID Tests ---
 
 func TestGetStoreByID_Success(t *testing.T) {
@@ -81,9 +288,9 @@ func TestGetStoreByID_Success(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
-	store, err := uc.GetStoreByID(context.Background(), "store-1")
+	store, err := uc.GetStoreByID(context.Background(), entity.User{}, "store-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -100,24 +307,42 @@ func TestGetStoreByID_NotFound(t *testing.T) {
 		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
-	_, err := uc.GetStoreByID(context.Background(), "nonexistent")
+	_, err := uc.GetStoreByID(context.Background(), entity.User{}, "nonexistent")
 
 	if !errors.Is(err, usecase.ErrStoreNotFound) {
 		t.Errorf("expected ErrStoreNotFound, got %v", err)
 	}
 }
 
+func TestGetStoreByID_NotVisible(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{
+		Stores:     []entity.Store{{StoreID: "store-1", Visibility: constants.VisibilityHidden}},
+		NotVisible: true,
+	}
+
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.GetStoreByID(context.Background(), entity.User{UserID: "user-1", Role: "user"}, "store-1")
+
+	if !errors.Is(err, usecase.ErrStoreNotFound) {
+		t.Errorf("expected ErrStoreNotFound, got %v", err)
+	}
+	if mockRepo.VisibleViewer.UserID != "user-1" || mockRepo.VisibleViewer.IsAdmin {
+		t.Errorf("unexpected viewer: %+v", mockRepo.VisibleViewer)
+	}
+}
+
 func TestGetStoreByID_RepositoryError(t *testing.T) {
 	dbErr := errors.New("database error")
 	mockRepo := &testutil.MockStoreRepository{
 		FindByIDErr: dbErr,
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
-	_, err := uc.GetStoreByID(context.Background(), "store-1")
+	_, err := uc.GetStoreByID(context.Background(), entity.User{}, "store-1")
 
 	if !errors.Is(err, dbErr) {
 		t.Errorf("expected database error, got %v", err)
@@ -131,18 +356,17 @@ func TestCreateStore_Success(t *testing.T) {
 		Stores: []entity.Store{},
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	req := input.CreateStoreInput{
-		Name:            "Test Store",
-		Address:         "Test Address",
-		ThumbnailFileID: testutil.StringPtr(testFileID),
-		Latitude:        35.6812,
-		Longitude:       139.7671,
-		PlaceID:         "ChIJRUjlH92OAGAR6otTD3tUcrg",
+		Name:      "Test Store",
+		Address:   "Test Address",
+		Latitude:  35.6812,
+		Longitude: 139.7671,
+		PlaceID:   "ChIJRUjlH92OAGAR6otTD3tUcrg",
 	}
 
-	store, err := uc.CreateStore(context.Background(), req)
+	store, err := uc.CreateStore(context.Background(), testOwner, req)
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -156,9 +380,70 @@ func TestCreateStore_Success(t *testing.T) {
 	}
 }
 
+func TestCreateStore_RegistersOwner(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{}
+	ownerRepo := &testutil.MockStoreOwnerRepository{}
+	tx := &testutil.MockTransaction{}
+
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, ownerRepo, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, tx)
+
+	store, err := uc.CreateStore(context.Background(), testOwner, input.CreateStoreInput{
+		Name:    "Test Store",
+		Address: "Test Address",
+		PlaceID: "place-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !tx.StartTransactionCalled {
+		t.Error("expected store creation to run in a transaction")
+	}
+	if ownerRepo.AddCalledWith.StoreID != store.StoreID || ownerRepo.AddCalledWith.UserID != testOwner.UserID {
+		t.Errorf("unexpected owner registration: %+v", ownerRepo.AddCalledWith)
+	}
+}
+
+func TestCreateStore_OwnerRegistrationError(t *testing.T) {
+	addErr := errors.New("insert failed")
+	uc := usecase.NewStoreUseCase(
+		&testutil.MockStoreRepository{},
+		&testutil.MockStationRepository{},
+		&testutil.MockStoreOwnerRepository{AddErr: addErr},
+		&testutil.MockStoreTagRepository{},
+		&testutil.MockStoreEditRepository{},
+		&testutil.MockTransaction{},
+	)
+
+	_, err := uc.CreateStore(context.Background(), testOwner, input.CreateStoreInput{
+		Name:    "Test Store",
+		Address: "Test Address",
+		PlaceID: "place-1",
+	})
+	if !errors.Is(err, addErr) {
+		t.Errorf("expected insert error, got %v", err)
+	}
+}
+
+func TestCreateStore_Unauthenticated(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.CreateStore(context.Background(), entity.User{}, input.CreateStoreInput{
+		Name:    "Test Store",
+		Address: "Test Address",
+		PlaceID: "place-1",
+	})
+	if !errors.Is(err, usecase.ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+	if mockRepo.CreateCalled {
+		t.Error("expected store not to be created")
+	}
+}
+
 func TestCreateStore_InvalidInput(t *testing.T) {
 	mockRepo := &testutil.MockStoreRepository{}
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	tests := []struct {
 		name  string
@@ -175,27 +460,15 @@ func TestCreateStore_InvalidInput(t *testing.T) {
 			},
 			want: usecase.ErrInvalidInput,
 		},
-		{
-			name: "missing thumbnail",
-			input: input.CreateStoreInput{
-				Name:      "Test Store",
-				Address:   "Test Address",
-				Latitude:  35.6812,
-				Longitude: 139.7671,
-				PlaceID:   "ChIJRUjlH92OAGAR6otTD3tUcrg",
-			},
-			want: usecase.ErrInvalidInput,
-		},
 		{
 			name: "invalid coordinates",
 			input: func() input.CreateStoreInput {
 				return input.CreateStoreInput{
-					Name:            "Test Store",
-					Address:         "Test Address",
-					ThumbnailFileID: testutil.StringPtr(testFileID),
-					Latitude:        91.0, // Invalid: latitude must be between -90 and 90
-					Longitude:       139.7671,
-					PlaceID:         "ChIJRUjlH92OAGAR6otTD3tUcrg",
+					Name:      "Test Store",
+					Address:   "Test Address",
+					Latitude:  91.0, // Invalid: latitude must be between -90 and 90
+					Longitude: 139.7671,
+					PlaceID:   "ChIJRUjlH92OAGAR6otTD3tUcrg",
 				}
 			}(),
 			want: usecase.ErrInvalidCoordinates,
@@ -204,12 +477,11 @@ func TestCreateStore_InvalidInput(t *testing.T) {
 			name: "empty place id",
 			input: func() input.CreateStoreInput {
 				return input.CreateStoreInput{
-					Name:            "Test Store",
-					Address:         "Test Address",
-					ThumbnailFileID: testutil.StringPtr(testFileID),
-					Latitude:        35.6812,
-					Longitude:       139.7671,
-					PlaceID:         "",
+					Name:      "Test Store",
+					Address:   "Test Address",
+					Latitude:  35.6812,
+					Longitude: 139.7671,
+					PlaceID:   "",
 				}
 			}(),
 			want: usecase.ErrInvalidInput,
@@ -218,7 +490,7 @@ func TestCreateStore_InvalidInput(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := uc.CreateStore(context.Background(), tt.input)
+			_, err := uc.CreateStore(context.Background(), testOwner, tt.input)
 			if !errors.Is(err, tt.want) {
 				t.Errorf("expected error %v, got %v", tt.want, err)
 			}
@@ -232,18 +504,17 @@ func TestCreateStore_RepositoryError(t *testing.T) {
 		CreateErr: createErr,
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	req := input.CreateStoreInput{
-		Name:            "Test Store",
-		Address:         "Test Address",
-		ThumbnailFileID: testutil.StringPtr(testFileID),
-		Latitude:        35.6812,
-		Longitude:       139.7671,
-		PlaceID:         "ChIJRUjlH92OAGAR6otTD3tUcrg",
+		Name:      "Test Store",
+		Address:   "Test Address",
+		Latitude:  35.6812,
+		Longitude: 139.7671,
+		PlaceID:   "ChIJRUjlH92OAGAR6otTD3tUcrg",
 	}
 
-	_, err := uc.CreateStore(context.Background(), req)
+	_, err := uc.CreateStore(context.Background(), testOwner, req)
 
 	if !errors.Is(err, createErr) {
 		t.Errorf("expected create error, got %v", err)
@@ -259,10 +530,10 @@ func TestUpdateStore_Success(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	newName := testNewName
-	store, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
 		Name: &newName,
 	})
 	if err != nil {
@@ -273,6 +544,42 @@ func TestUpdateStore_Success(t *testing.T) {
 	}
 }
 
+func TestUpdateStore_OwnerAllowed(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1"}},
+	}
+	ownerRepo := &testutil.MockStoreOwnerRepository{Owners: map[string][]string{"store-1": {testOwner.UserID}}}
+
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, ownerRepo, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	newName := testNewName
+	store, err := uc.UpdateStore(context.Background(), testOwner, "store-1", input.UpdateStoreInput{Name: &newName})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.Name != newName {
+		t.Errorf("expected Name '%s', got '%s'", newName, store.Name)
+	}
+}
+
+func TestUpdateStore_NonOwnerForbidden(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1"}},
+	}
+	ownerRepo := &testutil.MockStoreOwnerRepository{Owners: map[string][]string{"store-1": {"someone-else"}}}
+
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, ownerRepo, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	newName := testNewName
+	_, err := uc.UpdateStore(context.Background(), testOwner, "store-1", input.UpdateStoreInput{Name: &newName})
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+	if mockRepo.UpdateCalled {
+		t.Error("expected store not to be updated")
+	}
+}
+
 func TestUpdateStore_PartialUpdate(t *testing.T) {
 	originalAddress := "Original Address"
 	mockRepo := &testutil.MockStoreRepository{
@@ -281,10 +588,10 @@ func TestUpdateStore_PartialUpdate(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	newLat := 36.0
-	store, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
 		Latitude: &newLat,
 	})
 	if err != nil {
@@ -303,10 +610,10 @@ func TestUpdateStore_NotFound(t *testing.T) {
 		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	newName := testNewName
-	_, err := uc.UpdateStore(context.Background(), "nonexistent", input.UpdateStoreInput{
+	_, err := uc.UpdateStore(context.Background(), testAdmin, "nonexistent", input.UpdateStoreInput{
 		Name: &newName,
 	})
 
@@ -322,10 +629,10 @@ func TestUpdateStore_EmptyPlaceID(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	emptyPlaceID := ""
-	_, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
+	_, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
 		PlaceID: &emptyPlaceID,
 	})
 
@@ -341,10 +648,10 @@ func TestUpdateStore_UpdateError(t *testing.T) {
 		UpdateErr: updateErr,
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	newName := testNewName
-	_, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
+	_, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
 		Name: &newName,
 	})
 
@@ -359,10 +666,10 @@ func TestUpdateStore_FindByIDError(t *testing.T) {
 		FindByIDErr: dbErr,
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	newName := testNewName
-	_, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
+	_, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
 		Name: &newName,
 	})
 
@@ -378,9 +685,9 @@ func TestDeleteStore_Success(t *testing.T) {
 		Stores: []entity.Store{{StoreID: "store-1", Name: "Test Store"}},
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
-	err := uc.DeleteStore(context.Background(), "store-1")
+	err := uc.DeleteStore(context.Background(), testAdmin, "store-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -391,9 +698,9 @@ func TestDeleteStore_NotFound(t *testing.T) {
 		FindByIDErr: apperr.New(apperr.CodeNotFound, entity.ErrNotFound),
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
-	err := uc.DeleteStore(context.Background(), "nonexistent")
+	err := uc.DeleteStore(context.Background(), testAdmin, "nonexistent")
 
 	if !errors.Is(err, usecase.ErrStoreNotFound) {
 		t.Errorf("expected ErrStoreNotFound, got %v", err)
@@ -407,9 +714,9 @@ func TestDeleteStore_DeleteError(t *testing.T) {
 		DeleteErr: deleteErr,
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
-	err := uc.DeleteStore(context.Background(), "store-1")
+	err := uc.DeleteStore(context.Background(), testAdmin, "store-1")
 
 	if !errors.Is(err, deleteErr) {
 		t.Errorf("expected delete error, got %v", err)
@@ -422,9 +729,9 @@ func TestDeleteStore_FindByIDError(t *testing.T) {
 		FindByIDErr: dbErr,
 	}
 
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
-	err := uc.DeleteStore(context.Background(), "store-1")
+	err := uc.DeleteStore(context.Background(), testAdmin, "store-1")
 
 	if !errors.Is(err, dbErr) {
 		t.Errorf("expected database error, got %v", err)
@@ -435,7 +742,7 @@ func TestDeleteStore_FindByIDError(t *testing.T) {
 
 func TestCreateStore_InvalidLongitude(t *testing.T) {
 	mockRepo := &testutil.MockStoreRepository{}
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	tests := []struct {
 		name      string
@@ -448,15 +755,14 @@ func TestCreateStore_InvalidLongitude(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			req := input.CreateStoreInput{
-				Name:            "Test Store",
-				Address:         "Test Address",
-				ThumbnailFileID: testutil.StringPtr(testFileID),
-				Latitude:        35.6812,
-				Longitude:       tt.longitude,
-				PlaceID:         "ChIJRUjlH92OAGAR6otTD3tUcrg",
+				Name:      "Test Store",
+				Address:   "Test Address",
+				Latitude:  35.6812,
+				Longitude: tt.longitude,
+				PlaceID:   "ChIJRUjlH92OAGAR6otTD3tUcrg",
 			}
 
-			_, err := uc.CreateStore(context.Background(), req)
+			_, err := uc.CreateStore(context.Background(), testOwner, req)
 			if !errors.Is(err, usecase.ErrInvalidCoordinates) {
 				t.Errorf("expected ErrInvalidCoordinates for longitude %f, got %v", tt.longitude, err)
 			}
@@ -466,7 +772,7 @@ func TestCreateStore_InvalidLongitude(t *testing.T) {
 
 func TestCreateStore_InvalidLatitude(t *testing.T) {
 	mockRepo := &testutil.MockStoreRepository{}
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	tests := []struct {
 		name     string
@@ -479,15 +785,14 @@ func TestCreateStore_InvalidLatitude(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			req := input.CreateStoreInput{
-				Name:            "Test Store",
-				Address:         "Test Address",
-				ThumbnailFileID: testutil.StringPtr(testFileID),
-				Latitude:        tt.latitude,
-				Longitude:       139.7671,
-				PlaceID:         "ChIJRUjlH92OAGAR6otTD3tUcrg",
+				Name:      "Test Store",
+				Address:   "Test Address",
+				Latitude:  tt.latitude,
+				Longitude: 139.7671,
+				PlaceID:   "ChIJRUjlH92OAGAR6otTD3tUcrg",
 			}
 
-			_, err := uc.CreateStore(context.Background(), req)
+			_, err := uc.CreateStore(context.Background(), testOwner, req)
 			if !errors.Is(err, usecase.ErrInvalidCoordinates) {
 				t.Errorf("expected ErrInvalidCoordinates for latitude %f, got %v", tt.latitude, err)
 			}
@@ -497,18 +802,17 @@ func TestCreateStore_InvalidLatitude(t *testing.T) {
 
 func TestCreateStore_EmptyAddress(t *testing.T) {
 	mockRepo := &testutil.MockStoreRepository{}
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	req := input.CreateStoreInput{
-		Name:            "Test Store",
-		Address:         "",
-		ThumbnailFileID: testutil.StringPtr(testFileID),
-		Latitude:        35.6812,
-		Longitude:       139.7671,
-		PlaceID:         "ChIJRUjlH92OAGAR6otTD3tUcrg",
+		Name:      "Test Store",
+		Address:   "",
+		Latitude:  35.6812,
+		Longitude: 139.7671,
+		PlaceID:   "ChIJRUjlH92OAGAR6otTD3tUcrg",
 	}
 
-	_, err := uc.CreateStore(context.Background(), req)
+	_, err := uc.CreateStore(context.Background(), testOwner, req)
 	if !errors.Is(err, usecase.ErrInvalidInput) {
 		t.Errorf("expected ErrInvalidInput for empty address, got %v", err)
 	}
@@ -522,10 +826,10 @@ func TestUpdateStore_InvalidLatitude(t *testing.T) {
 			{StoreID: "store-1", Name: "Test Store", PlaceID: "place-1"},
 		},
 	}
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	invalidLat := 91.0
-	_, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
+	_, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
 		Latitude: &invalidLat,
 	})
 
@@ -540,10 +844,10 @@ func TestUpdateStore_InvalidLongitude(t *testing.T) {
 			{StoreID: "store-1", Name: "Test Store", PlaceID: "place-1"},
 		},
 	}
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	invalidLng := 181.0
-	_, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
+	_, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
 		Longitude: &invalidLng,
 	})
 
@@ -560,7 +864,7 @@ func TestUpdateStore_AllBasicFields(t *testing.T) {
 			{StoreID: "store-1", Name: "Old Name", Address: "Old Address", PlaceID: "old-place-id", Latitude: 35.0, Longitude: 139.0},
 		},
 	}
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	newName := "New Name"
 	newAddress := "New Address"
@@ -568,7 +872,7 @@ func TestUpdateStore_AllBasicFields(t *testing.T) {
 	newLat := 36.0
 	newLng := 140.0
 
-	store, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
 		Name:      &newName,
 		Address:   &newAddress,
 		PlaceID:   &newPlaceID,
@@ -601,27 +905,22 @@ func TestUpdateStore_AllOptionalFields(t *testing.T) {
 			{StoreID: "store-1", Name: "Test Store", PlaceID: "place-1"},
 		},
 	}
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
-	newThumbnail := "new-thumbnail-id"
 	newOpenedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
 	newDescription := "New Description"
 	newOpeningHours := "9:00-21:00"
 	newGoogleMapURL := "https://maps.google.com/test"
 
-	store, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
-		ThumbnailFileID: &newThumbnail,
-		OpenedAt:        &newOpenedAt,
-		Description:     &newDescription,
-		OpeningHours:    &newOpeningHours,
-		GoogleMapURL:    &newGoogleMapURL,
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
+		OpenedAt:     &newOpenedAt,
+		Description:  &newDescription,
+		OpeningHours: &newOpeningHours,
+		GoogleMapURL: &newGoogleMapURL,
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if store.ThumbnailFileID == nil || *store.ThumbnailFileID != newThumbnail {
-		t.Errorf("expected ThumbnailFileID %s, got %v", newThumbnail, store.ThumbnailFileID)
-	}
 	if store.OpenedAt == nil || !store.OpenedAt.Equal(newOpenedAt) {
 		t.Errorf("expected OpenedAt %v, got %v", newOpenedAt, store.OpenedAt)
 	}
@@ -642,10 +941,10 @@ func TestUpdateStore_OnlyAddress(t *testing.T) {
 			{StoreID: "store-1", Name: "Test Store", Address: "Old Address", PlaceID: "place-1"},
 		},
 	}
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	newAddress := "Updated Address"
-	store, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
 		Address: &newAddress,
 	})
 	if err != nil {
@@ -665,10 +964,10 @@ func TestUpdateStore_OnlyLongitude(t *testing.T) {
 			{StoreID: "store-1", Name: "Test Store", PlaceID: "place-1", Latitude: 35.0, Longitude: 139.0},
 		},
 	}
-	uc := usecase.NewStoreUseCase(mockRepo)
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
 
 	newLng := 140.0
-	store, err := uc.UpdateStore(context.Background(), "store-1", input.UpdateStoreInput{
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{
 		Longitude: &newLng,
 	})
 	if err != nil {
@@ -681,3 +980,388 @@ func TestUpdateStore_OnlyLongitude(t *testing.T) {
 		t.Errorf("expected Latitude to remain 35.0, got %f", store.Latitude)
 	}
 }
+
+// --- ListOwnedStores Tests ---
+
+func TestListOwnedStores_Success(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1"}},
+	}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	stores, err := uc.ListOwnedStores(context.Background(), testOwner.UserID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stores) != 1 {
+		t.Errorf("expected 1 store, got %d", len(stores))
+	}
+	if mockRepo.FindByOwnerWith != testOwner.UserID {
+		t.Errorf("expected FindByOwner called with %q, got %q", testOwner.UserID, mockRepo.FindByOwnerWith)
+	}
+}
+
+func TestListOwnedStores_EmptyUserID(t *testing.T) {
+	uc := usecase.NewStoreUseCase(&testutil.MockStoreRepository{}, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.ListOwnedStores(context.Background(), "")
+	if !errors.Is(err, usecase.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+// --- Store tag Tests ---
+
+func TestListStores_NormalizesTag(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.ListStores(context.Background(), entity.User{}, input.ListStoresQuery{Tag: testutil.StringPtr(" ＷｉＦｉ ")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mockRepo.ListCalledWith.Tag == nil || *mockRepo.ListCalledWith.Tag != "wifi" {
+		t.Errorf("expected normalized tag %q, got %v", "wifi", mockRepo.ListCalledWith.Tag)
+	}
+}
+
+func TestCreateStore_NormalizesTags(t *testing.T) {
+	tagRepo := &testutil.MockStoreTagRepository{}
+	uc := usecase.NewStoreUseCase(&testutil.MockStoreRepository{}, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, tagRepo, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	store, err := uc.CreateStore(context.Background(), testOwner, input.CreateStoreInput{
+		Name:    "Test Store",
+		Address: "Test Address",
+		PlaceID: "place-1",
+		Tags:    []string{" Wi-Fi ", "ｗｉ－ｆｉ", "Quiet  Space"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"wi-fi", "quiet space"}
+	if !tagRepo.ReplaceCalled || tagRepo.ReplaceCalledWith.StoreID != store.StoreID {
+		t.Fatalf("expected tags to be saved for %q, got %+v", store.StoreID, tagRepo.ReplaceCalledWith)
+	}
+	if len(tagRepo.ReplaceCalledWith.Tags) != len(expected) {
+		t.Fatalf("expected tags %v, got %v", expected, tagRepo.ReplaceCalledWith.Tags)
+	}
+	for i := range expected {
+		if tagRepo.ReplaceCalledWith.Tags[i] != expected[i] || store.Tags[i] != expected[i] {
+			t.Errorf("expected tag %q at %d, got %q / %q", expected[i], i, tagRepo.ReplaceCalledWith.Tags[i], store.Tags[i])
+		}
+	}
+}
+
+func TestCreateStore_InvalidTags(t *testing.T) {
+	tooMany := make([]string, constants.MaxStoreTags+1)
+	for i := range tooMany {
+		tooMany[i] = string(rune('a' + i))
+	}
+	tests := []struct {
+		name string
+		tags []string
+	}{
+		{"blank tag", []string{"wifi", "   "}},
+		{"too long", []string{strings.Repeat("あ", constants.MaxTagLength+1)}},
+		{"too many", tooMany},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storeRepo := &testutil.MockStoreRepository{}
+			uc := usecase.NewStoreUseCase(storeRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+			_, err := uc.CreateStore(context.Background(), testOwner, input.CreateStoreInput{
+				Name:    "Test Store",
+				Address: "Test Address",
+				PlaceID: "place-1",
+				Tags:    tt.tags,
+			})
+			if !errors.Is(err, usecase.ErrInvalidTag) {
+				t.Errorf("expected ErrInvalidTag, got %v", err)
+			}
+			if storeRepo.CreateCalled {
+				t.Error("expected store not to be created")
+			}
+		})
+	}
+}
+
+func TestUpdateStore_ReplacesTags(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1", Tags: []string{"old"}}},
+	}
+	ownerRepo := &testutil.MockStoreOwnerRepository{Owners: map[string][]string{"store-1": {testOwner.UserID}}}
+	tagRepo := &testutil.MockStoreTagRepository{}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, ownerRepo, tagRepo, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.UpdateStore(context.Background(), testOwner, "store-1", input.UpdateStoreInput{Tags: []string{"Terrace"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !tagRepo.ReplaceCalled || len(tagRepo.ReplaceCalledWith.Tags) != 1 || tagRepo.ReplaceCalledWith.Tags[0] != "terrace" {
+		t.Errorf("expected tags to be replaced with [terrace], got %+v", tagRepo.ReplaceCalledWith)
+	}
+}
+
+func TestUpdateStore_EmptyTagsRemovesAll(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1", Tags: []string{"old"}}},
+	}
+	tagRepo := &testutil.MockStoreTagRepository{}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, tagRepo, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{Tags: []string{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !tagRepo.ReplaceCalled || len(tagRepo.ReplaceCalledWith.Tags) != 0 {
+		t.Errorf("expected all tags to be removed, got %+v", tagRepo.ReplaceCalledWith)
+	}
+}
+
+func TestUpdateStore_NilTagsKeepsTags(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1"}},
+	}
+	tagRepo := &testutil.MockStoreTagRepository{}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, tagRepo, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	newName := testNewName
+	if _, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{Name: &newName}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tagRepo.ReplaceCalled {
+		t.Error("expected tags not to be touched")
+	}
+}
+
+func TestUpdateStore_TagReplaceError(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1"}},
+	}
+	replaceErr := errors.New("insert failed")
+	tagRepo := &testutil.MockStoreTagRepository{ReplaceErr: replaceErr}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, tagRepo, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	_, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{Tags: []string{"wifi"}})
+	if !errors.Is(err, replaceErr) {
+		t.Errorf("expected replace error, got %v", err)
+	}
+}
+
+func TestUpdateStore_NormalizesNameKana(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1"}},
+	}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	kana := " カフェ　ブルー "
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{NameKana: &kana})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.NameKana == nil || *store.NameKana != "かふぇ ぶるー" {
+		t.Errorf("expected normalized name_kana, got %v", store.NameKana)
+	}
+}
+
+func TestUpdateStore_EmptyNameKanaClears(t *testing.T) {
+	oldKana := "かふぇ"
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1", NameKana: &oldKana}},
+	}
+	uc := usecase.NewStoreUseCase(mockRepo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+
+	empty := " "
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{NameKana: &empty})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.NameKana != nil {
+		t.Errorf("expected name_kana to be cleared, got %q", *store.NameKana)
+	}
+}
+
+// --- Opening Hours Tests ---
+
+func newOpeningHoursTestUseCase(repo *testutil.MockStoreRepository) usecase.StoreUseCase {
+	return usecase.NewStoreUseCase(repo, &testutil.MockStationRepository{}, &testutil.MockStoreOwnerRepository{}, &testutil.MockStoreTagRepository{}, &testutil.MockStoreEditRepository{}, &testutil.MockTransaction{})
+}
+
+func newOpeningHoursCreateInput(schedule *entity.OpeningSchedule, text *string) input.CreateStoreInput {
+	return input.CreateStoreInput{
+		Name:            "Test Store",
+		Address:         "Test Address",
+		Latitude:        35.6812,
+		Longitude:       139.7671,
+		PlaceID:         "place-1",
+		OpeningHours:    text,
+		OpeningSchedule: schedule,
+	}
+}
+
+func TestCreateStore_NormalizesOpeningSchedule(t *testing.T) {
+	uc := newOpeningHoursTestUseCase(&testutil.MockStoreRepository{})
+
+	var schedule entity.OpeningSchedule
+	schedule.Weekly[time.Friday] = []entity.OpeningInterval{{Open: 18 * 60, Close: 2 * 60}, {Open: 11 * 60, Close: 14 * 60}}
+	note := " 改装工事 "
+	schedule.Closures = []entity.StoreClosure{{
+		From: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC),
+		Note: &note,
+	}}
+
+	store, err := uc.CreateStore(context.Background(), testOwner, newOpeningHoursCreateInput(&schedule, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := store.OpeningSchedule
+	if got == nil {
+		t.Fatal("expected opening schedule to be saved")
+	}
+	want := []entity.OpeningInterval{{Open: 11 * 60, Close: 14 * 60}, {Open: 18 * 60, Close: 26 * 60}}
+	if len(got.Weekly[time.Friday]) != 2 || got.Weekly[time.Friday][0] != want[0] || got.Weekly[time.Friday][1] != want[1] {
+		t.Errorf("expected sorted overnight intervals %v, got %v", want, got.Weekly[time.Friday])
+	}
+	if len(got.Closures) != 1 || got.Closures[0].Note == nil || *got.Closures[0].Note != "改装工事" {
+		t.Errorf("expected trimmed closure note, got %+v", got.Closures)
+	}
+}
+
+func TestCreateStore_ParsesOpeningHoursText(t *testing.T) {
+	uc := newOpeningHoursTestUseCase(&testutil.MockStoreRepository{})
+
+	text := "月〜金 11:00〜22:00 土日定休"
+	store, err := uc.CreateStore(context.Background(), testOwner, newOpeningHoursCreateInput(nil, &text))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.OpeningSchedule == nil {
+		t.Fatal("expected opening schedule to be parsed from the text")
+	}
+	if len(store.OpeningSchedule.Weekly[time.Monday]) != 1 || len(store.OpeningSchedule.Weekly[time.Sunday]) != 0 {
+		t.Errorf("unexpected parsed schedule: %+v", store.OpeningSchedule.Weekly)
+	}
+	if store.OpeningHours == nil || *store.OpeningHours != text {
+		t.Errorf("expected free-text opening hours to be kept, got %v", store.OpeningHours)
+	}
+}
+
+func TestCreateStore_InvalidOpeningSchedule(t *testing.T) {
+	date := time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)
+	weekly := func(intervals ...entity.OpeningInterval) *entity.OpeningSchedule {
+		var schedule entity.OpeningSchedule
+		schedule.Weekly[time.Monday] = intervals
+		return &schedule
+	}
+	tooMany := make([]entity.OpeningInterval, constants.MaxOpeningIntervalsPerDay+1)
+	for i := range tooMany {
+		tooMany[i] = entity.OpeningInterval{Open: i * 60, Close: i*60 + 30}
+	}
+	closures := make([]entity.StoreClosure, constants.MaxStoreClosures+1)
+	for i := range closures {
+		closures[i] = entity.StoreClosure{From: date, To: date}
+	}
+
+	tests := []struct {
+		name     string
+		schedule *entity.OpeningSchedule
+	}{
+		{"overlapping intervals", weekly(entity.OpeningInterval{Open: 600, Close: 900}, entity.OpeningInterval{Open: 840, Close: 1200})},
+		{"open after midnight", weekly(entity.OpeningInterval{Open: 25 * 60, Close: 27 * 60})},
+		{"longer than a day", weekly(entity.OpeningInterval{Open: 600, Close: 2 * 1440})},
+		{"too many intervals", weekly(tooMany...)},
+		{"duplicate special date", &entity.OpeningSchedule{Special: []entity.SpecialHours{{Date: date}, {Date: date}}}},
+		{"closure ends before it starts", &entity.OpeningSchedule{Closures: []entity.StoreClosure{{From: date, To: date.AddDate(0, 0, -1)}}}},
+		{"closure too long", &entity.OpeningSchedule{Closures: []entity.StoreClosure{{From: date, To: date.AddDate(2, 0, 0)}}}},
+		{"too many closures", &entity.OpeningSchedule{Closures: closures}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &testutil.MockStoreRepository{}
+			uc := newOpeningHoursTestUseCase(repo)
+
+			_, err := uc.CreateStore(context.Background(), testOwner, newOpeningHoursCreateInput(tt.schedule, nil))
+			if !errors.Is(err, usecase.ErrInvalidOpeningHours) {
+				t.Fatalf("expected ErrInvalidOpeningHours, got %v", err)
+			}
+			if repo.CreateCalled {
+				t.Error("expected store not to be created")
+			}
+		})
+	}
+}
+
+func TestUpdateStore_EmptyOpeningScheduleClears(t *testing.T) {
+	var existing entity.OpeningSchedule
+	existing.Weekly[time.Monday] = []entity.OpeningInterval{{Open: 660, Close: 1320}}
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1", OpeningSchedule: &existing}},
+	}
+	uc := newOpeningHoursTestUseCase(mockRepo)
+
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{OpeningSchedule: &entity.OpeningSchedule{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.OpeningSchedule != nil {
+		t.Errorf("expected opening schedule to be cleared, got %+v", store.OpeningSchedule)
+	}
+}
+
+func TestUpdateStore_OpeningHoursTextReplacesSchedule(t *testing.T) {
+	var existing entity.OpeningSchedule
+	existing.Weekly[time.Monday] = []entity.OpeningInterval{{Open: 660, Close: 1320}}
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1", OpeningSchedule: &existing}},
+	}
+	uc := newOpeningHoursTestUseCase(mockRepo)
+
+	// 読み取れない記述に変わった場合は、古い営業時間を残さない
+	text := "不定休のためSNSをご確認ください"
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{OpeningHours: &text})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.OpeningSchedule != nil {
+		t.Errorf("expected stale opening schedule to be removed, got %+v", store.OpeningSchedule)
+	}
+}
+
+func TestUpdateStore_KeepsOpeningScheduleWhenOmitted(t *testing.T) {
+	var existing entity.OpeningSchedule
+	existing.Weekly[time.Monday] = []entity.OpeningInterval{{Open: 660, Close: 1320}}
+	mockRepo := &testutil.MockStoreRepository{
+		Stores: []entity.Store{{StoreID: "store-1", Name: "Old Name", PlaceID: "place-1", OpeningSchedule: &existing}},
+	}
+	uc := newOpeningHoursTestUseCase(mockRepo)
+
+	newName := testNewName
+	store, err := uc.UpdateStore(context.Background(), testAdmin, "store-1", input.UpdateStoreInput{Name: &newName})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.OpeningSchedule == nil {
+		t.Error("expected opening schedule to be kept")
+	}
+}
+
+func TestListStores_OpenNowSetsOpenAt(t *testing.T) {
+	mockRepo := &testutil.MockStoreRepository{}
+	uc := newOpeningHoursTestUseCase(mockRepo)
+
+	if _, err := uc.ListStores(context.Background(), entity.User{}, input.ListStoresQuery{OpenNow: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if openAt := mockRepo.ListCalledWith.OpenAt; openAt == nil || time.Since(*openAt) > time.Minute {
+		t.Errorf("expected OpenAt to be the current time, got %v", openAt)
+	}
+
+	if _, err := uc.ListStores(context.Background(), entity.User{}, input.ListStoresQuery{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mockRepo.ListCalledWith.OpenAt != nil {
+		t.Errorf("expected no OpenAt without open_now, got %v", mockRepo.ListCalledWith.OpenAt)
+	}
+}
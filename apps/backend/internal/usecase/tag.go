@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/tag"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+// TagUseCase は店舗タグに関するビジネスロジックを提供します
+type TagUseCase interface {
+	ListTags(ctx context.Context) ([]entity.TagCount, error)
+	ListStoresByTag(ctx context.Context, tag string, query input.ListStoresQuery) (*input.StorePage, error)
+}
+
+type tagUseCase struct {
+	storeTagRepo output.StoreTagRepository
+	storeRepo    output.StoreRepository
+}
+
+// NewTagUseCase は TagUseCase の実装を生成します
+func NewTagUseCase(storeTagRepo output.StoreTagRepository, storeRepo output.StoreRepository) TagUseCase {
+	return &tagUseCase{
+		storeTagRepo: storeTagRepo,
+		storeRepo:    storeRepo,
+	}
+}
+
+// ListTags は承認済み店舗で使われているタグを店舗数の多い順に返します
+func (uc *tagUseCase) ListTags(ctx context.Context) ([]entity.TagCount, error) {
+	return uc.storeTagRepo.CountByTag(ctx)
+}
+
+// ListStoresByTag は指定したタグを持つ承認済み店舗を一覧と同じページングで返します
+func (uc *tagUseCase) ListStoresByTag(ctx context.Context, rawTag string, query input.ListStoresQuery) (*input.StorePage, error) {
+	if tag.Normalize(rawTag) == "" {
+		return nil, ErrInvalidTag
+	}
+	approved := true
+	query.Tag = &rawTag
+	query.IsApproved = &approved
+
+	listQuery, err := buildStoreListQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	return uc.storeRepo.List(ctx, listQuery)
+}
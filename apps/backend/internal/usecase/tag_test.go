@@ -0,0 +1,79 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+func TestListTags_Success(t *testing.T) {
+	tagRepo := &testutil.MockStoreTagRepository{
+		Counts: []entity.TagCount{{Tag: "wifi", StoreCount: 3}, {Tag: "quiet", StoreCount: 1}},
+	}
+	uc := usecase.NewTagUseCase(tagRepo, &testutil.MockStoreRepository{})
+
+	counts, err := uc.ListTags(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(counts) != 2 || counts[0].Tag != "wifi" || counts[0].StoreCount != 3 {
+		t.Errorf("unexpected counts: %+v", counts)
+	}
+}
+
+func TestListTags_RepositoryError(t *testing.T) {
+	countErr := errors.New("db error")
+	uc := usecase.NewTagUseCase(&testutil.MockStoreTagRepository{CountErr: countErr}, &testutil.MockStoreRepository{})
+
+	if _, err := uc.ListTags(context.Background()); !errors.Is(err, countErr) {
+		t.Errorf("expected count error, got %v", err)
+	}
+}
+
+func TestListStoresByTag_ForcesApprovedAndNormalizesTag(t *testing.T) {
+	storeRepo := &testutil.MockStoreRepository{Stores: []entity.Store{{StoreID: "store-1"}}}
+	uc := usecase.NewTagUseCase(&testutil.MockStoreTagRepository{}, storeRepo)
+
+	notApproved := false
+	page, err := uc.ListStoresByTag(context.Background(), " ＷｉＦｉ ", input.ListStoresQuery{IsApproved: &notApproved})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Stores) != 1 {
+		t.Errorf("expected 1 store, got %d", len(page.Stores))
+	}
+	query := storeRepo.ListCalledWith
+	if query.Tag == nil || *query.Tag != "wifi" {
+		t.Errorf("expected tag %q, got %v", "wifi", query.Tag)
+	}
+	if query.IsApproved == nil || !*query.IsApproved {
+		t.Errorf("expected approved-only listing, got %v", query.IsApproved)
+	}
+	if query.Limit != constants.DefaultStoreListLimit {
+		t.Errorf("expected default limit %d, got %d", constants.DefaultStoreListLimit, query.Limit)
+	}
+	if query.Sort != constants.StoreSortByNew {
+		t.Errorf("expected sort %q, got %q", constants.StoreSortByNew, query.Sort)
+	}
+}
+
+func TestListStoresByTag_InvalidInput(t *testing.T) {
+	storeRepo := &testutil.MockStoreRepository{}
+	uc := usecase.NewTagUseCase(&testutil.MockStoreTagRepository{}, storeRepo)
+
+	if _, err := uc.ListStoresByTag(context.Background(), "   ", input.ListStoresQuery{}); !errors.Is(err, usecase.ErrInvalidTag) {
+		t.Errorf("expected ErrInvalidTag, got %v", err)
+	}
+	if _, err := uc.ListStoresByTag(context.Background(), "wifi", input.ListStoresQuery{Limit: -1}); !errors.Is(err, usecase.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+	if storeRepo.ListCalled {
+		t.Error("expected repository not to be called")
+	}
+}
@@ -16,13 +16,15 @@ import (
 type userUseCase struct {
 	userRepo   output.UserRepository
 	reviewRepo output.ReviewRepository
+	fileRepo   output.FileRepository
 }
 
 // NewUserUseCase は UserUseCase の実装を生成します
-func NewUserUseCase(userRepo output.UserRepository, reviewRepo output.ReviewRepository) input.UserUseCase {
+func NewUserUseCase(userRepo output.UserRepository, reviewRepo output.ReviewRepository, fileRepo output.FileRepository) input.UserUseCase {
 	return &userUseCase{
 		userRepo:   userRepo,
 		reviewRepo: reviewRepo,
+		fileRepo:   fileRepo,
 	}
 }
 
@@ -162,12 +164,38 @@ func (uc *userUseCase) UpdateUserRole(ctx context.Context, userID string, role s
 	return uc.userRepo.UpdateRole(ctx, userID, role)
 }
 
-func (uc *userUseCase) GetUserReviews(ctx context.Context, userID string) ([]entity.Review, error) {
+// GetUserReviews はユーザーのレビューのうち閲覧者が見られるものを返します
+func (uc *userUseCase) GetUserReviews(ctx context.Context, viewer entity.User, userID string) ([]entity.Review, error) {
 	if err := ensureUserExists(ctx, uc.userRepo, userID); err != nil {
 		return nil, err
 	}
 
-	return uc.reviewRepo.FindByUserID(ctx, userID)
+	return uc.reviewRepo.FindByUserID(ctx, userID, viewerOf(viewer))
+}
+
+// GetPublicProfile はユーザーの公開プロフィールとレビューの集計値を返します。退会済みのユーザーは見つからない扱いです
+func (uc *userUseCase) GetPublicProfile(ctx context.Context, userID string) (*input.PublicUserProfile, error) {
+	user, err := mustFindActiveUser(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := uc.reviewRepo.FindReviewerStats(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &input.PublicUserProfile{User: user, Stats: *stats}
+	if user.IconFileID != nil {
+		files, err := uc.fileRepo.FindByCreatorAndIDs(ctx, userID, []string{*user.IconFileID})
+		if err != nil {
+			return nil, err
+		}
+		if len(files) > 0 {
+			profile.IconFile = &files[0]
+		}
+	}
+	return profile, nil
 }
 
 func deriveNameFromEmail(email string) string {
@@ -11,6 +11,7 @@ import (
 	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
 	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
 )
 
 const (
@@ -26,7 +27,7 @@ func TestFindByID_Success(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: expected}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	result, err := uc.FindByID(context.Background(), "user-1")
 	if err != nil {
@@ -46,7 +47,7 @@ func TestFindByID_NotFound(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	_, err := uc.FindByID(context.Background(), "nonexistent")
 	if !errors.Is(err, usecase.ErrUserNotFound) {
@@ -59,7 +60,7 @@ func TestFindByID_RepositoryError(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDErr: expectedErr}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	_, err := uc.FindByID(context.Background(), "user-1")
 	if !errors.Is(err, expectedErr) {
@@ -78,7 +79,7 @@ func TestEnsureUser_ExistingUser_NoProviderUpdate(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID:   "user-1",
@@ -105,7 +106,7 @@ func TestEnsureUser_ExistingUser_ProviderUpdate(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID:   "user-1",
@@ -132,7 +133,7 @@ func TestEnsureUser_ExistingUser_ProfileUpdate(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID:   "user-1",
@@ -173,7 +174,7 @@ func TestEnsureUser_ExistingUser_NoProfileOverride(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID:   "user-1",
@@ -206,7 +207,7 @@ func TestEnsureUser_NewUser_Success(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID:   "new-user-1",
@@ -237,7 +238,7 @@ func TestEnsureUser_NewUser_UsesProvidedProfile(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID:   "new-user-2",
@@ -269,7 +270,7 @@ func TestEnsureUser_InvalidInput_EmptyUserID(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	_, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID: "",
@@ -286,7 +287,7 @@ func TestEnsureUser_InvalidInput_EmptyEmail(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	_, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID: "user-1",
@@ -303,7 +304,7 @@ func TestEnsureUser_InvalidRole_DefaultsToUser(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID: "user-1",
@@ -336,7 +337,7 @@ func TestEnsureUser_RaceCondition_CreateFails(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(customRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(customRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID:   "user-1",
@@ -424,6 +425,26 @@ func (m *raceConditionMockUserRepo) UpdateRoleInTx(ctx context.Context, tx inter
 	return nil
 }
 
+func (m *raceConditionMockUserRepo) List(ctx context.Context, query output.UserListQuery) (*output.UserPage, error) {
+	return &output.UserPage{}, nil
+}
+
+func (m *raceConditionMockUserRepo) UpdateSuspensionInTx(ctx context.Context, tx interface{}, user entity.User) error {
+	return nil
+}
+
+func (m *raceConditionMockUserRepo) AnonymizeInTx(ctx context.Context, tx interface{}, user entity.User) error {
+	return nil
+}
+
+func (m *raceConditionMockUserRepo) FindPurgePending(ctx context.Context, limit int) ([]entity.User, error) {
+	return nil, nil
+}
+
+func (m *raceConditionMockUserRepo) MarkPurged(ctx context.Context, userID string, purgedAt time.Time) error {
+	return nil
+}
+
 // TestEnsureUser_RaceCondition_UpdateProviderFails tests the scenario where:
 // 1. First FindByID returns not found (user doesn't exist)
 // 2. Create fails due to race condition (another process created the user)
@@ -447,7 +468,7 @@ func TestEnsureUser_RaceCondition_UpdateProviderFails(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(customRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(customRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID:   "user-1",
@@ -518,6 +539,26 @@ func (m *raceConditionUpdateFailMockUserRepo) UpdateRoleInTx(ctx context.Context
 	return nil
 }
 
+func (m *raceConditionUpdateFailMockUserRepo) List(ctx context.Context, query output.UserListQuery) (*output.UserPage, error) {
+	return &output.UserPage{}, nil
+}
+
+func (m *raceConditionUpdateFailMockUserRepo) UpdateSuspensionInTx(ctx context.Context, tx interface{}, user entity.User) error {
+	return nil
+}
+
+func (m *raceConditionUpdateFailMockUserRepo) AnonymizeInTx(ctx context.Context, tx interface{}, user entity.User) error {
+	return nil
+}
+
+func (m *raceConditionUpdateFailMockUserRepo) FindPurgePending(ctx context.Context, limit int) ([]entity.User, error) {
+	return nil, nil
+}
+
+func (m *raceConditionUpdateFailMockUserRepo) MarkPurged(ctx context.Context, userID string, purgedAt time.Time) error {
+	return nil
+}
+
 // --- shouldUpdateProvider Logic Tests ---
 // Since shouldUpdateProvider is an internal function, we test its logic indirectly
 // through EnsureUser by checking whether Update is called in various scenarios.
@@ -592,7 +633,7 @@ func TestShouldUpdateProvider_Logic(t *testing.T) {
 			userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 			reviewRepo := &testutil.MockReviewRepository{}
 
-			uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+			uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 			_, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 				UserID:   "user-1",
@@ -622,7 +663,7 @@ func TestUpdateUser_Success(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	newName := testNewName
 	result, err := uc.UpdateUser(context.Background(), "user-1", input.UpdateUserInput{
@@ -642,7 +683,7 @@ func TestUpdateUser_NotFound(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	newName := testNewName
 	_, err := uc.UpdateUser(context.Background(), "nonexistent", input.UpdateUserInput{
@@ -664,7 +705,7 @@ func TestUpdateUser_PartialUpdate(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	newName := "Updated Name"
 	result, err := uc.UpdateUser(context.Background(), "user-1", input.UpdateUserInput{
@@ -689,7 +730,7 @@ func TestUpdateUser_RepositoryError(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	newName := testNewName
 	_, err := uc.UpdateUser(context.Background(), "user-1", input.UpdateUserInput{
@@ -707,7 +748,7 @@ func TestUpdateUserRole_Success(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	err := uc.UpdateUserRole(context.Background(), "user-1", "admin")
 	if err != nil {
@@ -731,7 +772,7 @@ func TestUpdateUserRole_InvalidRole(t *testing.T) {
 			userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 			reviewRepo := &testutil.MockReviewRepository{}
 
-			uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+			uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 			err := uc.UpdateUserRole(context.Background(), "user-1", tt.role)
 			if !errors.Is(err, usecase.ErrInvalidRole) {
@@ -750,7 +791,7 @@ func TestUpdateUserRole_ValidRoles(t *testing.T) {
 			userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 			reviewRepo := &testutil.MockReviewRepository{}
 
-			uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+			uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 			err := uc.UpdateUserRole(context.Background(), "user-1", role)
 			if err != nil {
@@ -766,7 +807,7 @@ func TestUpdateUserRole_UserNotFound(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	err := uc.UpdateUserRole(context.Background(), "nonexistent", "admin")
 	if !errors.Is(err, usecase.ErrUserNotFound) {
@@ -785,9 +826,9 @@ func TestGetUserReviews_Success(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{FindByUserIDResult: reviews}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
-	result, err := uc.GetUserReviews(context.Background(), "user-1")
+	result, err := uc.GetUserReviews(context.Background(), entity.User{}, "user-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -802,9 +843,9 @@ func TestGetUserReviews_UserNotFound(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
-	_, err := uc.GetUserReviews(context.Background(), "nonexistent")
+	_, err := uc.GetUserReviews(context.Background(), entity.User{}, "nonexistent")
 	if !errors.Is(err, usecase.ErrUserNotFound) {
 		t.Errorf("expected ErrUserNotFound, got %v", err)
 	}
@@ -815,9 +856,9 @@ func TestGetUserReviews_EmptyReviews(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{FindByUserIDResult: []entity.Review{}}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
-	result, err := uc.GetUserReviews(context.Background(), "user-1")
+	result, err := uc.GetUserReviews(context.Background(), entity.User{}, "user-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -831,9 +872,9 @@ func TestGetUserReviews_RepositoryError(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{FindByUserIDErr: errors.New("database error")}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
-	_, err := uc.GetUserReviews(context.Background(), "user-1")
+	_, err := uc.GetUserReviews(context.Background(), entity.User{}, "user-1")
 	if err == nil {
 		t.Error("expected error from repository")
 	}
@@ -854,7 +895,7 @@ func TestEnsureUser_ProviderUpdate_UpdateError(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	_, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID:   "user-1",
@@ -873,7 +914,7 @@ func TestEnsureUser_FindByID_NonNotFoundError(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	_, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 		UserID:   "user-1",
@@ -896,7 +937,7 @@ func TestUpdateUser_AllFields(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	newName := "New Name"
 	newPhone := "090-1234-5678"
@@ -945,7 +986,7 @@ func TestUpdateUser_OnlyIconURL(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	newIconURL := "https://example.com/new-icon.png"
 	result, err := uc.UpdateUser(context.Background(), "user-1", input.UpdateUserInput{
@@ -971,7 +1012,7 @@ func TestUpdateUser_OnlyIconFileID(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	newIconFileID := "file-456"
 	result, err := uc.UpdateUser(context.Background(), "user-1", input.UpdateUserInput{
@@ -994,7 +1035,7 @@ func TestUpdateUser_OnlyGender(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	newGender := testGenderFemale
 	result, err := uc.UpdateUser(context.Background(), "user-1", input.UpdateUserInput{
@@ -1017,7 +1058,7 @@ func TestUpdateUser_OnlyBirthday(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	newBirthday := time.Date(2000, 12, 25, 0, 0, 0, 0, time.UTC)
 	result, err := uc.UpdateUser(context.Background(), "user-1", input.UpdateUserInput{
@@ -1042,7 +1083,7 @@ func TestUpdateUser_EmptyPhoneClearsValue(t *testing.T) {
 	userRepo := &testutil.MockUserRepository{FindByIDResult: existingUser}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	emptyPhone := ""
 	result, err := uc.UpdateUser(context.Background(), "user-1", input.UpdateUserInput{
@@ -1088,7 +1129,7 @@ func TestEnsureUser_DeriveNameFromEmail_EdgeCases(t *testing.T) {
 			}
 			reviewRepo := &testutil.MockReviewRepository{}
 
-			uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+			uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 			result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
 				UserID:   "new-user",
@@ -1116,7 +1157,7 @@ func TestUpdateUserRole_RepositoryError(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	err := uc.UpdateUserRole(context.Background(), "user-1", "admin")
 	if !errors.Is(err, repoErr) {
@@ -1131,7 +1172,7 @@ func TestUpdateUserRole_FindByID_NonNotFoundError(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	err := uc.UpdateUserRole(context.Background(), "user-1", "admin")
 	if !errors.Is(err, dbErr) {
@@ -1148,9 +1189,9 @@ func TestGetUserReviews_FindByID_NonNotFoundError(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(userRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
 
-	_, err := uc.GetUserReviews(context.Background(), "user-1")
+	_, err := uc.GetUserReviews(context.Background(), entity.User{}, "user-1")
 	if !errors.Is(err, dbErr) {
 		t.Errorf("expected database error, got %v", err)
 	}
@@ -1177,7 +1218,7 @@ func TestEnsureUser_RaceCondition_NoProviderUpdateNeeded(t *testing.T) {
 	}
 	reviewRepo := &testutil.MockReviewRepository{}
 
-	uc := usecase.NewUserUseCase(customRepo, reviewRepo)
+	uc := usecase.NewUserUseCase(customRepo, reviewRepo, &testutil.MockFileRepository{})
 
 	// Provider is same as existing - shouldUpdateProvider should return false
 	result, err := uc.EnsureUser(context.Background(), input.EnsureUserInput{
@@ -1238,3 +1279,76 @@ func (m *raceConditionNoUpdateMockUserRepo) UpdateInTx(ctx context.Context, tx i
 func (m *raceConditionNoUpdateMockUserRepo) UpdateRoleInTx(ctx context.Context, tx interface{}, userID string, role string) error {
 	return nil
 }
+
+func (m *raceConditionNoUpdateMockUserRepo) List(ctx context.Context, query output.UserListQuery) (*output.UserPage, error) {
+	return &output.UserPage{}, nil
+}
+
+func (m *raceConditionNoUpdateMockUserRepo) UpdateSuspensionInTx(ctx context.Context, tx interface{}, user entity.User) error {
+	return nil
+}
+
+func (m *raceConditionNoUpdateMockUserRepo) AnonymizeInTx(ctx context.Context, tx interface{}, user entity.User) error {
+	return nil
+}
+
+func (m *raceConditionNoUpdateMockUserRepo) FindPurgePending(ctx context.Context, limit int) ([]entity.User, error) {
+	return nil, nil
+}
+
+func (m *raceConditionNoUpdateMockUserRepo) MarkPurged(ctx context.Context, userID string, purgedAt time.Time) error {
+	return nil
+}
+
+// --- GetPublicProfile Tests ---
+
+func TestGetPublicProfile_Success(t *testing.T) {
+	iconFileID := "file-1"
+	userRepo := &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-1", Name: "Taro", IconFileID: &iconFileID}}
+	reviewRepo := &testutil.MockReviewRepository{StatsResult: &entity.ReviewerStats{
+		ReviewCount:   3,
+		TopCategories: []entity.CategoryCount{{Category: "カフェ・喫茶", ReviewCount: 3}},
+	}}
+	fileRepo := &testutil.MockFileRepository{FindByCreatorResult: []entity.File{{FileID: iconFileID, ObjectKey: "icons/file-1.png"}}}
+
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, fileRepo)
+
+	profile, err := uc.GetPublicProfile(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile.User.Name != "Taro" || profile.Stats.ReviewCount != 3 || len(profile.Stats.TopCategories) != 1 {
+		t.Errorf("unexpected profile: %+v", profile)
+	}
+	if profile.IconFile == nil || profile.IconFile.ObjectKey != "icons/file-1.png" {
+		t.Errorf("expected icon file, got %+v", profile.IconFile)
+	}
+	if got := fileRepo.FindByCreatorCalledWith; got.UserID != "user-1" || len(got.FileIDs) != 1 || got.FileIDs[0] != iconFileID {
+		t.Errorf("unexpected icon lookup: %+v", got)
+	}
+}
+
+func TestGetPublicProfile_DeletedUser(t *testing.T) {
+	deletedAt := time.Now()
+	userRepo := &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-1", DeletedAt: &deletedAt}}
+
+	uc := usecase.NewUserUseCase(userRepo, &testutil.MockReviewRepository{}, &testutil.MockFileRepository{})
+
+	_, err := uc.GetPublicProfile(context.Background(), "user-1")
+	if !errors.Is(err, usecase.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestGetPublicProfile_StatsError(t *testing.T) {
+	expectedErr := errors.New("database error")
+	userRepo := &testutil.MockUserRepository{FindByIDResult: entity.User{UserID: "user-1"}}
+	reviewRepo := &testutil.MockReviewRepository{FindStatsErr: expectedErr}
+
+	uc := usecase.NewUserUseCase(userRepo, reviewRepo, &testutil.MockFileRepository{})
+
+	_, err := uc.GetPublicProfile(context.Background(), "user-1")
+	if !errors.Is(err, expectedErr) {
+		t.Errorf("expected %v, got %v", expectedErr, err)
+	}
+}
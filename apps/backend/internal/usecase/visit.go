@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/hours"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/output"
+)
+
+type visitUseCase struct {
+	visitRepo output.VisitRepository
+	storeRepo output.StoreRepository
+}
+
+// NewVisitUseCase は VisitUseCase の実装を生成します
+func NewVisitUseCase(visitRepo output.VisitRepository, storeRepo output.StoreRepository) input.VisitUseCase {
+	return &visitUseCase{
+		visitRepo: visitRepo,
+		storeRepo: storeRepo,
+	}
+}
+
+// MarkVisited は店舗に行った記録を残します。同じ日に記録済みの場合はメモを更新します
+func (uc *visitUseCase) MarkVisited(ctx context.Context, user entity.User, storeID string, in input.MarkVisitInput) (*entity.Visit, error) {
+	if user.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	if err := validateNotEmpty(storeID); err != nil {
+		return nil, err
+	}
+
+	today := hours.Date(time.Now())
+	visitedOn := today
+	if in.VisitedOn != nil {
+		visitedOn = time.Date(in.VisitedOn.Year(), in.VisitedOn.Month(), in.VisitedOn.Day(), 0, 0, 0, 0, time.UTC)
+		if visitedOn.After(today) {
+			return nil, ErrInvalidVisitDate
+		}
+	}
+	note := trimmedOrNil(in.Note)
+	if note != nil && utf8.RuneCountInString(*note) > constants.MaxVisitNoteLength {
+		return nil, ErrVisitNoteTooLong
+	}
+
+	if err := ensureStoreVisible(ctx, uc.storeRepo, storeID, user); err != nil {
+		return nil, err
+	}
+
+	visit := &entity.Visit{
+		UserID:    user.UserID,
+		StoreID:   storeID,
+		VisitedOn: visitedOn,
+		Note:      note,
+	}
+	if err := uc.visitRepo.Save(ctx, visit); err != nil {
+		return nil, err
+	}
+	return visit, nil
+}
+
+// DeleteVisit は訪問記録を削除します。削除できるのは本人の記録のみで、他人の記録は見つからない扱いにします
+func (uc *visitUseCase) DeleteVisit(ctx context.Context, user entity.User, visitID int64) error {
+	if user.UserID == "" {
+		return ErrUnauthorized
+	}
+
+	visit, err := uc.visitRepo.FindByID(ctx, visitID)
+	if err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return ErrVisitNotFound
+		}
+		return err
+	}
+	if visit.UserID != user.UserID {
+		return ErrVisitNotFound
+	}
+
+	return uc.visitRepo.Delete(ctx, visitID)
+}
+
+// ListMyVisits は自分の訪問履歴を訪問日の新しい順に返します
+func (uc *visitUseCase) ListMyVisits(ctx context.Context, user entity.User, query input.ListVisitsQuery) (*input.VisitPage, error) {
+	if user.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+	limit, err := normalizeLimit(query.Limit, constants.DefaultVisitListLimit, constants.MaxVisitListLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.visitRepo.ListByUser(ctx, user.UserID, output.VisitListQuery{
+		StoreID: query.StoreID,
+		Limit:   limit,
+		Cursor:  query.Cursor,
+		Viewer:  viewerOf(user),
+	})
+}
+
+// GetMyVisitCounts は自分の訪問記録の件数と、行ったことのある店舗の数を返します
+func (uc *visitUseCase) GetMyVisitCounts(ctx context.Context, user entity.User) (*entity.VisitCounts, error) {
+	if user.UserID == "" {
+		return nil, ErrUnauthorized
+	}
+
+	return uc.visitRepo.CountByUser(ctx, user.UserID, viewerOf(user))
+}
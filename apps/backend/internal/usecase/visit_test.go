@@ -0,0 +1,203 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TeamH04/team-production/apps/backend/internal/apperr"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/constants"
+	"github.com/TeamH04/team-production/apps/backend/internal/domain/entity"
+	"github.com/TeamH04/team-production/apps/backend/internal/handlers/testutil"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase"
+	"github.com/TeamH04/team-production/apps/backend/internal/usecase/input"
+)
+
+func newVisitTestUseCase() (input.VisitUseCase, *testutil.MockVisitRepository, *testutil.MockStoreRepository) {
+	visitRepo := &testutil.MockVisitRepository{}
+	storeRepo := &testutil.MockStoreRepository{Store: &entity.Store{StoreID: "store-1"}}
+	return usecase.NewVisitUseCase(visitRepo, storeRepo), visitRepo, storeRepo
+}
+
+// --- MarkVisited Tests ---
+
+func TestMarkVisited_Success(t *testing.T) {
+	uc, visitRepo, _ := newVisitTestUseCase()
+	visitedOn := time.Date(2026, 3, 1, 19, 30, 0, 0, time.UTC)
+	note := "  カウンター席が良かった  "
+
+	visit, err := uc.MarkVisited(context.Background(), entity.User{UserID: "user-1"}, "store-1", input.MarkVisitInput{
+		VisitedOn: &visitedOn,
+		Note:      &note,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if visit.VisitID != 1 || visit.UserID != "user-1" || visit.StoreID != "store-1" {
+		t.Errorf("unexpected visit: %+v", visit)
+	}
+	if !visit.VisitedOn.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected visit date to be truncated to the day, got %v", visit.VisitedOn)
+	}
+	if visit.Note == nil || *visit.Note != "カウンター席が良かった" {
+		t.Errorf("expected trimmed note, got %v", visit.Note)
+	}
+	if visitRepo.SaveCalledWith != visit {
+		t.Error("expected visit to be saved")
+	}
+}
+
+func TestMarkVisited_DefaultsToToday(t *testing.T) {
+	uc, visitRepo, _ := newVisitTestUseCase()
+
+	if _, err := uc.MarkVisited(context.Background(), entity.User{UserID: "user-1"}, "store-1", input.MarkVisitInput{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	jst := time.FixedZone(constants.ServiceTimeZone, constants.ServiceUTCOffsetSeconds)
+	now := time.Now().In(jst)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if got := visitRepo.SaveCalledWith.VisitedOn; !got.Equal(today) {
+		t.Errorf("expected visit date %v, got %v", today, got)
+	}
+	if visitRepo.SaveCalledWith.Note != nil {
+		t.Errorf("expected no note, got %v", *visitRepo.SaveCalledWith.Note)
+	}
+}
+
+func TestMarkVisited_FutureDate(t *testing.T) {
+	uc, visitRepo, _ := newVisitTestUseCase()
+	future := time.Now().AddDate(0, 0, 2)
+
+	_, err := uc.MarkVisited(context.Background(), entity.User{UserID: "user-1"}, "store-1", input.MarkVisitInput{VisitedOn: &future})
+	if !errors.Is(err, usecase.ErrInvalidVisitDate) {
+		t.Fatalf("expected ErrInvalidVisitDate, got %v", err)
+	}
+	if visitRepo.SaveCalledWith != nil {
+		t.Error("expected visit not to be saved")
+	}
+}
+
+func TestMarkVisited_NoteTooLong(t *testing.T) {
+	uc, _, _ := newVisitTestUseCase()
+	note := strings.Repeat("あ", constants.MaxVisitNoteLength+1)
+
+	_, err := uc.MarkVisited(context.Background(), entity.User{UserID: "user-1"}, "store-1", input.MarkVisitInput{Note: &note})
+	if !errors.Is(err, usecase.ErrVisitNoteTooLong) {
+		t.Fatalf("expected ErrVisitNoteTooLong, got %v", err)
+	}
+}
+
+func TestMarkVisited_StoreNotVisible(t *testing.T) {
+	uc, visitRepo, storeRepo := newVisitTestUseCase()
+	storeRepo.NotVisible = true
+
+	_, err := uc.MarkVisited(context.Background(), entity.User{UserID: "user-1"}, "store-1", input.MarkVisitInput{})
+	if !errors.Is(err, usecase.ErrStoreNotFound) {
+		t.Fatalf("expected ErrStoreNotFound, got %v", err)
+	}
+	if visitRepo.SaveCalledWith != nil {
+		t.Error("expected visit not to be saved")
+	}
+}
+
+func TestMarkVisited_Unauthorized(t *testing.T) {
+	uc, _, _ := newVisitTestUseCase()
+
+	_, err := uc.MarkVisited(context.Background(), entity.User{}, "store-1", input.MarkVisitInput{})
+	if !errors.Is(err, usecase.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+// --- DeleteVisit Tests ---
+
+func TestDeleteVisit_Success(t *testing.T) {
+	uc, visitRepo, _ := newVisitTestUseCase()
+	visitRepo.FindByIDResult = &entity.Visit{VisitID: 7, UserID: "user-1"}
+
+	if err := uc.DeleteVisit(context.Background(), entity.User{UserID: "user-1"}, 7); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if visitRepo.DeleteCalledWith != 7 {
+		t.Errorf("expected visit 7 to be deleted, got %d", visitRepo.DeleteCalledWith)
+	}
+}
+
+func TestDeleteVisit_OtherUsersVisit(t *testing.T) {
+	uc, visitRepo, _ := newVisitTestUseCase()
+	visitRepo.FindByIDResult = &entity.Visit{VisitID: 7, UserID: "user-2"}
+
+	err := uc.DeleteVisit(context.Background(), entity.User{UserID: "user-1"}, 7)
+	if !errors.Is(err, usecase.ErrVisitNotFound) {
+		t.Fatalf("expected ErrVisitNotFound, got %v", err)
+	}
+	if visitRepo.DeleteCalledWith != 0 {
+		t.Error("expected visit not to be deleted")
+	}
+}
+
+func TestDeleteVisit_NotFound(t *testing.T) {
+	uc, visitRepo, _ := newVisitTestUseCase()
+	visitRepo.FindByIDErr = apperr.New(apperr.CodeNotFound, entity.ErrNotFound)
+
+	err := uc.DeleteVisit(context.Background(), entity.User{UserID: "user-1"}, 7)
+	if !errors.Is(err, usecase.ErrVisitNotFound) {
+		t.Fatalf("expected ErrVisitNotFound, got %v", err)
+	}
+}
+
+// --- ListMyVisits / GetMyVisitCounts Tests ---
+
+func TestListMyVisits_NormalizesLimit(t *testing.T) {
+	uc, visitRepo, _ := newVisitTestUseCase()
+	storeID := "store-1"
+
+	_, err := uc.ListMyVisits(context.Background(), entity.User{UserID: "user-1"}, input.ListVisitsQuery{
+		StoreID: &storeID,
+		Limit:   constants.MaxVisitListLimit + 50,
+		Cursor:  "cursor",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	called := visitRepo.ListCalledWith
+	if called.UserID != "user-1" || called.Query.Limit != constants.MaxVisitListLimit || called.Query.Cursor != "cursor" {
+		t.Errorf("unexpected list call: %+v", called)
+	}
+	if called.Query.StoreID == nil || *called.Query.StoreID != "store-1" || called.Query.Viewer.UserID != "user-1" {
+		t.Errorf("unexpected list query: %+v", called.Query)
+	}
+}
+
+func TestListMyVisits_NegativeLimit(t *testing.T) {
+	uc, _, _ := newVisitTestUseCase()
+
+	_, err := uc.ListMyVisits(context.Background(), entity.User{UserID: "user-1"}, input.ListVisitsQuery{Limit: -1})
+	if !errors.Is(err, usecase.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestGetMyVisitCounts_Success(t *testing.T) {
+	uc, visitRepo, _ := newVisitTestUseCase()
+	visitRepo.CountsResult = &entity.VisitCounts{VisitCount: 5, StoreCount: 3}
+
+	counts, err := uc.GetMyVisitCounts(context.Background(), entity.User{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if counts.VisitCount != 5 || counts.StoreCount != 3 || visitRepo.CountCalledWith != "user-1" {
+		t.Errorf("unexpected counts: %+v", counts)
+	}
+}
+
+func TestGetMyVisitCounts_Unauthorized(t *testing.T) {
+	uc, _, _ := newVisitTestUseCase()
+
+	_, err := uc.GetMyVisitCounts(context.Background(), entity.User{})
+	if !errors.Is(err, usecase.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.stores_geog_gist_idx;
+
+ALTER TABLE public.stores
+    DROP COLUMN IF EXISTS geog;
+
+COMMIT;
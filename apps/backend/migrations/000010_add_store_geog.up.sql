@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS postgis;
+
+-- 近隣検索用：緯度経度から自動生成される地理(Point)
+ALTER TABLE public.stores
+    ADD COLUMN IF NOT EXISTS geog geography(Point, 4326)
+      GENERATED ALWAYS AS (
+        CASE
+          WHEN latitude IS NULL OR longitude IS NULL THEN NULL
+          ELSE ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography
+        END
+      ) STORED;
+
+CREATE INDEX IF NOT EXISTS stores_geog_gist_idx ON public.stores USING GIST (geog);
+
+COMMIT;
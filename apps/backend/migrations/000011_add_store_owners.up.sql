@@ -0,0 +1,13 @@
+BEGIN;
+
+-- 店舗とその店舗を管理するオーナーの関連
+CREATE TABLE IF NOT EXISTS public.store_owners (
+    store_id UUID NOT NULL REFERENCES public.stores(store_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES public.users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (store_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS store_owners_user_id_idx ON public.store_owners(user_id);
+
+COMMIT;
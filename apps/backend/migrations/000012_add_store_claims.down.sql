@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.store_claim_files;
+DROP TABLE IF EXISTS public.store_claims;
+
+COMMIT;
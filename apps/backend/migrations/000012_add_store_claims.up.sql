@@ -0,0 +1,34 @@
+BEGIN;
+
+-- 既存店舗（インポート済み店舗など）の管理権限を求めるオーナー申請
+CREATE TABLE IF NOT EXISTS public.store_claims (
+    claim_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    store_id UUID NOT NULL REFERENCES public.stores(store_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES public.users(user_id) ON DELETE CASCADE,
+    note TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    reviewed_by UUID NULL REFERENCES public.users(user_id) ON DELETE SET NULL,
+    review_note TEXT,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT store_claims_status_check CHECK (status IN ('pending', 'approved', 'denied'))
+);
+
+-- 同じユーザーが同じ店舗に複数の審査待ち申請を持たないようにする
+CREATE UNIQUE INDEX IF NOT EXISTS store_claims_pending_uq
+    ON public.store_claims(store_id, user_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS store_claims_status_created_at_idx
+    ON public.store_claims(status, created_at DESC);
+
+-- 申請に添付された証拠書類
+CREATE TABLE IF NOT EXISTS public.store_claim_files (
+    claim_id UUID NOT NULL REFERENCES public.store_claims(claim_id) ON DELETE CASCADE,
+    file_id UUID NOT NULL REFERENCES public.files(file_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (claim_id, file_id)
+);
+
+CREATE INDEX IF NOT EXISTS store_claim_files_file_id_idx ON public.store_claim_files(file_id);
+
+COMMIT;
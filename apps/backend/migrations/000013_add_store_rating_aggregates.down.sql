@@ -0,0 +1,18 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.reviews_store_id_idx;
+
+ALTER TABLE public.stores
+    DROP COLUMN IF EXISTS avg_cleanliness,
+    DROP COLUMN IF EXISTS avg_speed,
+    DROP COLUMN IF EXISTS avg_service,
+    DROP COLUMN IF EXISTS avg_atmosphere,
+    DROP COLUMN IF EXISTS avg_taste,
+    DROP COLUMN IF EXISTS rating_count_5,
+    DROP COLUMN IF EXISTS rating_count_4,
+    DROP COLUMN IF EXISTS rating_count_3,
+    DROP COLUMN IF EXISTS rating_count_2,
+    DROP COLUMN IF EXISTS rating_count_1,
+    DROP COLUMN IF EXISTS review_count;
+
+COMMIT;
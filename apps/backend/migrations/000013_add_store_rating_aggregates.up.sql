@@ -0,0 +1,19 @@
+BEGIN;
+
+-- レビューから集計した評価値。average_rating と同様にレビューの作成・更新・削除時に再計算される
+ALTER TABLE public.stores
+    ADD COLUMN IF NOT EXISTS review_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_count_1 INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_count_2 INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_count_3 INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_count_4 INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_count_5 INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS avg_taste DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS avg_atmosphere DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS avg_service DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS avg_speed DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS avg_cleanliness DOUBLE PRECISION;
+
+CREATE INDEX IF NOT EXISTS reviews_store_id_idx ON public.reviews(store_id);
+
+COMMIT;
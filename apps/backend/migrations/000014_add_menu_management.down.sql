@@ -0,0 +1,17 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.menu_dietary_tags;
+
+DROP INDEX IF EXISTS public.menus_store_id_sort_order_idx;
+
+ALTER TABLE public.menus DROP CONSTRAINT IF EXISTS menus_section_check;
+
+ALTER TABLE public.menus
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS image_file_id,
+    DROP COLUMN IF EXISTS sort_order,
+    DROP COLUMN IF EXISTS is_available,
+    DROP COLUMN IF EXISTS section;
+
+COMMIT;
@@ -0,0 +1,37 @@
+BEGIN;
+
+-- メニューの区分・提供状況・表示順・写真。deleted_at はレビューから参照され続けるための論理削除
+ALTER TABLE public.menus
+    ADD COLUMN IF NOT EXISTS section TEXT,
+    ADD COLUMN IF NOT EXISTS is_available BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN IF NOT EXISTS sort_order INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS image_file_id UUID REFERENCES public.files(file_id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+ALTER TABLE public.menus
+    ADD CONSTRAINT menus_section_check
+    CHECK (section IS NULL OR section IN ('drink', 'food', 'dessert', 'other'));
+
+-- 既存メニューはこれまでの表示順（新しい順）をそのまま sort_order に引き継ぐ
+UPDATE public.menus AS m
+SET sort_order = ordered.position
+FROM (
+    SELECT menu_id, ROW_NUMBER() OVER (PARTITION BY store_id ORDER BY created_at DESC) - 1 AS position
+    FROM public.menus
+) AS ordered
+WHERE m.menu_id = ordered.menu_id;
+
+CREATE INDEX IF NOT EXISTS menus_store_id_sort_order_idx
+    ON public.menus(store_id, sort_order)
+    WHERE deleted_at IS NULL;
+
+-- 食事制限・アレルゲンのタグ
+CREATE TABLE IF NOT EXISTS public.menu_dietary_tags (
+    menu_id UUID NOT NULL REFERENCES public.menus(menu_id) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (menu_id, tag)
+);
+
+COMMIT;
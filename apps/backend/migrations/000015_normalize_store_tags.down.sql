@@ -0,0 +1,6 @@
+BEGIN;
+
+-- タグの正規化は元に戻せないため、インデックスのみ削除する
+DROP INDEX IF EXISTS public.store_tags_tag_idx;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- 既存タグをアプリケーションと同じ規則（NFKC・小文字化・空白の正規化）でそろえ、表記ゆれで重複したタグをまとめる
+INSERT INTO public.store_tags (store_id, tag, created_at)
+SELECT normalized.store_id, normalized.tag, MIN(normalized.created_at)
+FROM (
+    SELECT store_id,
+           lower(btrim(regexp_replace(normalize(tag, NFKC), '\s+', ' ', 'g'))) AS tag,
+           created_at
+    FROM public.store_tags
+) AS normalized
+WHERE normalized.tag <> ''
+GROUP BY normalized.store_id, normalized.tag
+ON CONFLICT (store_id, tag) DO NOTHING;
+
+DELETE FROM public.store_tags
+WHERE tag <> lower(btrim(regexp_replace(normalize(tag, NFKC), '\s+', ' ', 'g')))
+   OR btrim(tag) = '';
+
+-- タグ別の店舗一覧・件数集計用
+CREATE INDEX IF NOT EXISTS store_tags_tag_idx ON public.store_tags(tag);
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.reviews_content_trgm_idx;
+DROP INDEX IF EXISTS public.menus_name_trgm_idx;
+DROP INDEX IF EXISTS public.store_tags_tag_trgm_idx;
+DROP INDEX IF EXISTS public.stores_description_trgm_idx;
+DROP INDEX IF EXISTS public.stores_address_trgm_idx;
+DROP INDEX IF EXISTS public.stores_name_kana_trgm_idx;
+DROP INDEX IF EXISTS public.stores_name_trgm_idx;
+
+DROP FUNCTION IF EXISTS public.search_normalize(TEXT);
+
+ALTER TABLE public.stores
+    DROP COLUMN IF EXISTS name_kana;
+
+COMMIT;
@@ -0,0 +1,41 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 店舗名の読み（ひらがな）。stations.kana と同様に、漢字の店舗名をかなで検索できるようにする
+ALTER TABLE public.stores
+    ADD COLUMN IF NOT EXISTS name_kana TEXT;
+
+-- 検索用の正規化。アプリケーション側の search.Normalize と同じ規則
+-- （NFKC・空白の整理・小文字化・カタカナをひらがなに）で変換する
+CREATE OR REPLACE FUNCTION public.search_normalize(value TEXT)
+RETURNS TEXT
+LANGUAGE sql
+IMMUTABLE
+PARALLEL SAFE
+AS $$
+    SELECT translate(
+        lower(btrim(regexp_replace(normalize(coalesce(value, ''), NFKC), '\s+', ' ', 'g'))),
+        'ァアィイゥウェエォオカガキギクグケゲコゴサザシジスズセゼソゾタダチヂッツヅテデトドナニヌネノハバパヒビピフブプヘベペホボポマミムメモャヤュユョヨラリルレロヮワヰヱヲンヴヵヶ',
+        'ぁあぃいぅうぇえぉおかがきぎくぐけげこごさざしじすずせぜそぞただちぢっつづてでとどなにぬねのはばぱひびぴふぶぷへべぺほぼぽまみむめもゃやゅゆょよらりるれろゎわゐゑをんゔゕゖ'
+    )
+$$;
+
+-- 部分一致（LIKE）と類似度（<%）の検索用 trigram インデックス
+CREATE INDEX IF NOT EXISTS stores_name_trgm_idx
+    ON public.stores USING GIN (public.search_normalize(name) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS stores_name_kana_trgm_idx
+    ON public.stores USING GIN (public.search_normalize(name_kana) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS stores_address_trgm_idx
+    ON public.stores USING GIN (public.search_normalize(address) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS stores_description_trgm_idx
+    ON public.stores USING GIN (public.search_normalize(description) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS store_tags_tag_trgm_idx
+    ON public.store_tags USING GIN (public.search_normalize(tag) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS menus_name_trgm_idx
+    ON public.menus USING GIN (public.search_normalize(name) gin_trgm_ops)
+    WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS reviews_content_trgm_idx
+    ON public.reviews USING GIN (public.search_normalize(content) gin_trgm_ops);
+
+COMMIT;
@@ -0,0 +1,40 @@
+BEGIN;
+
+-- 通報。000001_init.down.sql でのみ参照されていたテーブルを、対象を UUID で指す形で作成する
+CREATE TABLE IF NOT EXISTS public.reports (
+    report_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES public.users(user_id) ON DELETE CASCADE,
+    target_type TEXT NOT NULL,
+    target_id UUID NOT NULL,
+    reason TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- 対応結果（実施した措置・メモ・対応した管理者）
+ALTER TABLE public.reports
+    ADD COLUMN IF NOT EXISTS enforcement TEXT,
+    ADD COLUMN IF NOT EXISTS resolution_note TEXT,
+    ADD COLUMN IF NOT EXISTS resolved_by UUID NULL REFERENCES public.users(user_id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS resolved_at TIMESTAMPTZ;
+
+ALTER TABLE public.reports
+    DROP CONSTRAINT IF EXISTS reports_target_type_check,
+    ADD CONSTRAINT reports_target_type_check CHECK (target_type IN ('review', 'store', 'user', 'menu')),
+    DROP CONSTRAINT IF EXISTS reports_status_check,
+    ADD CONSTRAINT reports_status_check CHECK (status IN ('pending', 'resolved', 'rejected')),
+    DROP CONSTRAINT IF EXISTS reports_enforcement_check,
+    ADD CONSTRAINT reports_enforcement_check
+        CHECK (enforcement IS NULL OR enforcement IN ('delete_review', 'unapprove_store', 'delete_menu'));
+
+-- 同じユーザーが同じ対象を対応待ちのまま重複して通報しないようにする
+CREATE UNIQUE INDEX IF NOT EXISTS reports_pending_uq
+    ON public.reports(user_id, target_type, target_id) WHERE status = 'pending';
+-- 対象ごとのまとめ・一括対応用
+CREATE INDEX IF NOT EXISTS reports_target_status_idx
+    ON public.reports(target_type, target_id, status);
+CREATE INDEX IF NOT EXISTS reports_status_report_id_idx
+    ON public.reports(status, report_id DESC);
+
+COMMIT;
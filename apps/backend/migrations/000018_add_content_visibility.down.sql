@@ -0,0 +1,32 @@
+BEGIN;
+
+ALTER TABLE public.reports
+    DROP CONSTRAINT IF EXISTS reports_enforcement_check;
+
+-- 非表示の措置は旧バージョンに対応するものがないため記録を外す
+UPDATE public.reports
+SET enforcement = NULL
+WHERE enforcement IN ('hide_review', 'hide_store');
+
+ALTER TABLE public.reports
+    ADD CONSTRAINT reports_enforcement_check
+        CHECK (enforcement IS NULL OR enforcement IN ('delete_review', 'unapprove_store', 'delete_menu'));
+
+DROP INDEX IF EXISTS public.reviews_store_visibility_idx;
+DROP INDEX IF EXISTS public.stores_visibility_idx;
+
+ALTER TABLE public.reviews
+    DROP CONSTRAINT IF EXISTS reviews_visibility_check,
+    DROP COLUMN IF EXISTS visibility;
+
+ALTER TABLE public.stores
+    ADD COLUMN IF NOT EXISTS is_approved BOOLEAN DEFAULT FALSE;
+
+UPDATE public.stores
+SET is_approved = (visibility = 'published');
+
+ALTER TABLE public.stores
+    DROP CONSTRAINT IF EXISTS stores_visibility_check,
+    DROP COLUMN IF EXISTS visibility;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- 店舗とレビューの公開状態（published: 公開 / pending: 承認待ち / hidden: 非表示）
+ALTER TABLE public.stores
+    ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'pending';
+
+-- is_approved は visibility に統合する
+UPDATE public.stores
+SET visibility = CASE WHEN is_approved THEN 'published' ELSE 'pending' END;
+
+ALTER TABLE public.stores
+    DROP COLUMN IF EXISTS is_approved,
+    DROP CONSTRAINT IF EXISTS stores_visibility_check,
+    ADD CONSTRAINT stores_visibility_check CHECK (visibility IN ('published', 'pending', 'hidden'));
+
+ALTER TABLE public.reviews
+    ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'published',
+    DROP CONSTRAINT IF EXISTS reviews_visibility_check,
+    ADD CONSTRAINT reviews_visibility_check CHECK (visibility IN ('published', 'pending', 'hidden'));
+
+CREATE INDEX IF NOT EXISTS stores_visibility_idx ON public.stores (visibility);
+CREATE INDEX IF NOT EXISTS reviews_store_visibility_idx ON public.reviews (store_id, visibility);
+
+-- 非表示・承認待ちを措置として選べるようにする
+ALTER TABLE public.reports
+    DROP CONSTRAINT IF EXISTS reports_enforcement_check,
+    ADD CONSTRAINT reports_enforcement_check
+        CHECK (enforcement IS NULL OR enforcement IN (
+            'delete_review', 'hide_review', 'unapprove_store', 'hide_store', 'delete_menu'
+        ));
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.store_approval_events;
+
+DROP INDEX IF EXISTS public.stores_approval_status_idx;
+
+ALTER TABLE public.stores
+    DROP CONSTRAINT IF EXISTS stores_approval_status_check,
+    DROP COLUMN IF EXISTS approval_status;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- 店舗の審査状態（draft → submitted → approved / rejected → resubmitted）
+ALTER TABLE public.stores
+    ADD COLUMN IF NOT EXISTS approval_status TEXT NOT NULL DEFAULT 'draft';
+
+-- 既存の店舗は公開済みなら承認済み、それ以外は審査待ちとして扱う
+UPDATE public.stores
+SET approval_status = CASE WHEN visibility = 'published' THEN 'approved' ELSE 'submitted' END;
+
+ALTER TABLE public.stores
+    DROP CONSTRAINT IF EXISTS stores_approval_status_check,
+    ADD CONSTRAINT stores_approval_status_check
+        CHECK (approval_status IN ('draft', 'submitted', 'approved', 'rejected', 'resubmitted'));
+
+CREATE INDEX IF NOT EXISTS stores_approval_status_idx ON public.stores (approval_status);
+
+-- 審査状態の遷移履歴。操作した管理者（申請時はオーナー）と理由を残す
+CREATE TABLE IF NOT EXISTS public.store_approval_events (
+    event_id BIGSERIAL PRIMARY KEY,
+    store_id UUID NOT NULL REFERENCES public.stores(store_id) ON DELETE CASCADE,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    actor_id UUID REFERENCES public.users(user_id) ON DELETE SET NULL,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS store_approval_events_store_idx
+    ON public.store_approval_events (store_id, created_at);
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+-- 承認済み店舗の重要な項目（店舗名・住所・座標・place_id・サムネイル）への変更は、管理者の承認まで保留する
+CREATE TABLE IF NOT EXISTS public.store_edits (
+    edit_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    store_id UUID NOT NULL REFERENCES public.stores(store_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES public.users(user_id) ON DELETE CASCADE,
+    -- NULL の項目は変更しない
+    name TEXT,
+    address TEXT,
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    place_id TEXT,
+    thumbnail_file_id UUID REFERENCES public.files(file_id) ON DELETE SET NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    reviewed_by UUID NULL REFERENCES public.users(user_id) ON DELETE SET NULL,
+    review_note TEXT,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT store_edits_status_check CHECK (status IN ('pending', 'approved', 'rejected'))
+);
+
+-- 店舗ごとに承認待ちの変更は1件にまとめる
+CREATE UNIQUE INDEX IF NOT EXISTS store_edits_pending_uq
+    ON public.store_edits(store_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS store_edits_status_created_at_idx
+    ON public.store_edits(status, created_at DESC);
+
+COMMIT;
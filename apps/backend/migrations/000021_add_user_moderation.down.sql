@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.user_moderation_events;
+
+DROP INDEX IF EXISTS public.users_role_idx;
+
+ALTER TABLE public.users
+    DROP COLUMN IF EXISTS suspension_reason,
+    DROP COLUMN IF EXISTS suspended_until,
+    DROP COLUMN IF EXISTS suspended_at;
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN;
+
+-- 管理者による利用停止。suspended_until が NULL の場合は無期限（ban）
+ALTER TABLE public.users
+    ADD COLUMN IF NOT EXISTS suspended_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS suspension_reason TEXT;
+
+CREATE INDEX IF NOT EXISTS users_role_idx ON public.users (role);
+
+-- 管理者がユーザーに対して行った操作の履歴。操作した管理者と理由を残す
+CREATE TABLE IF NOT EXISTS public.user_moderation_events (
+    event_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES public.users(user_id) ON DELETE CASCADE,
+    admin_id UUID REFERENCES public.users(user_id) ON DELETE SET NULL,
+    action TEXT NOT NULL CHECK (action IN ('suspend', 'ban', 'unsuspend', 'change_role')),
+    reason TEXT,
+    from_role TEXT,
+    to_role TEXT,
+    suspended_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS user_moderation_events_user_idx
+    ON public.user_moderation_events (user_id, created_at);
+
+COMMIT;
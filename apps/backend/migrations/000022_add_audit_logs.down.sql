@@ -0,0 +1,8 @@
+BEGIN;
+
+-- パーティションは親テーブルと一緒に削除される
+DROP TABLE IF EXISTS public.audit_logs;
+
+DROP FUNCTION IF EXISTS public.create_audit_log_partition(DATE);
+
+COMMIT;
@@ -0,0 +1,49 @@
+BEGIN;
+
+-- 管理者・オーナーによる更新操作の監査ログ。created_at で月ごとにパーティション分割する
+-- 古いログは該当月のパーティションを DETACH / DROP して削除する
+-- ユーザーが削除されてもログは残すため、actor_id に外部キーは張らない
+CREATE TABLE IF NOT EXISTS public.audit_logs (
+    audit_id BIGSERIAL,
+    actor_id UUID,
+    actor_role TEXT NOT NULL,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id TEXT NOT NULL,
+    before JSONB,
+    after JSONB,
+    request_id TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (audit_id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE INDEX IF NOT EXISTS audit_logs_created_idx ON public.audit_logs (created_at DESC, audit_id DESC);
+CREATE INDEX IF NOT EXISTS audit_logs_actor_idx ON public.audit_logs (actor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS audit_logs_target_idx ON public.audit_logs (target_type, target_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS audit_logs_action_idx ON public.audit_logs (action, created_at DESC);
+
+-- 指定した月のパーティション（audit_logs_yYYYYmMM）を作成する。定期ジョブから先の月を作成する
+CREATE OR REPLACE FUNCTION public.create_audit_log_partition(month DATE)
+RETURNS VOID
+LANGUAGE plpgsql
+AS $$
+DECLARE
+    start_at DATE := date_trunc('month', month)::DATE;
+    end_at DATE := (date_trunc('month', month) + INTERVAL '1 month')::DATE;
+    partition_name TEXT := format('audit_logs_y%sm%s', to_char(start_at, 'YYYY'), to_char(start_at, 'MM'));
+BEGIN
+    EXECUTE format(
+        'CREATE TABLE IF NOT EXISTS public.%I PARTITION OF public.audit_logs FOR VALUES FROM (%L) TO (%L)',
+        partition_name, start_at, end_at
+    );
+END;
+$$;
+
+-- 今月から12か月分のパーティションを作成する
+SELECT public.create_audit_log_partition((date_trunc('month', NOW()) + make_interval(months => m))::DATE)
+FROM generate_series(0, 11) AS m;
+
+-- パーティションの作成が遅れても書き込みに失敗しないようにする
+CREATE TABLE IF NOT EXISTS public.audit_logs_default PARTITION OF public.audit_logs DEFAULT;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- 退会したユーザー。行は匿名化して残し、レビューなどからの参照を保つ
+ALTER TABLE public.users
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.reviews_user_created_idx;
+
+DROP TABLE IF EXISTS public.user_follows;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+-- ユーザー間のフォロー関係。follower_id が followee_id をフォローしている
+CREATE TABLE IF NOT EXISTS public.user_follows (
+    follower_id UUID NOT NULL REFERENCES public.users(user_id) ON DELETE CASCADE,
+    followee_id UUID NOT NULL REFERENCES public.users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (follower_id, followee_id),
+    CONSTRAINT user_follows_not_self CHECK (follower_id <> followee_id)
+);
+
+-- フォロワー一覧とフォロー中一覧を新しい順に取得するため
+CREATE INDEX IF NOT EXISTS user_follows_followee_idx ON public.user_follows (followee_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS user_follows_follower_idx ON public.user_follows (follower_id, created_at DESC);
+
+-- フィードでフォロー中のユーザーのレビューを新しい順に取得するため
+CREATE INDEX IF NOT EXISTS reviews_user_created_idx ON public.reviews (user_id, created_at DESC);
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+-- ユーザーが店舗に行った記録。同じ店舗・同じ日の訪問は 1 件にまとめる
+CREATE TABLE IF NOT EXISTS public.store_visits (
+    visit_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES public.users(user_id) ON DELETE CASCADE,
+    store_id UUID NOT NULL REFERENCES public.stores(store_id) ON DELETE CASCADE,
+    visited_on DATE NOT NULL,
+    note TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT store_visits_user_store_day_key UNIQUE (user_id, store_id, visited_on)
+);
+
+-- 訪問履歴を訪問日の新しい順に取得するため
+CREATE INDEX IF NOT EXISTS store_visits_user_visited_idx ON public.store_visits (user_id, visited_on DESC, visit_id DESC);
+
+-- 店舗一覧の visited_by_me を店舗ごとに判定するため
+CREATE INDEX IF NOT EXISTS store_visits_store_user_idx ON public.store_visits (store_id, user_id);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.store_opening_hours;
+
+ALTER TABLE public.stores
+    DROP COLUMN IF EXISTS opening_schedule;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- 構造化した営業時間（曜日ごとの営業時間帯・特定日の営業時間・休業期間）。opening_hours は元の自由記述として残す。
+-- 既存の opening_hours は make opening-hours-backfill（go run ./cmd/parse-opening-hours）で可能な範囲で変換する
+ALTER TABLE public.stores
+    ADD COLUMN IF NOT EXISTS opening_schedule JSONB;
+
+-- 「営業中」の絞り込み用に opening_schedule を展開した行。店舗の保存時にアプリケーションが作り直す。
+-- weekday の行は曜日ごとの営業時間帯、service_date の行はその日の営業時間で、曜日の行より優先する。
+-- 時刻は Asia/Tokyo の 0:00 からの分数で、日付をまたぐ場合 close_minute は 1440 を超える。
+-- open_minute が NULL の service_date の行はその日を休業とする
+CREATE TABLE IF NOT EXISTS public.store_opening_hours (
+    opening_hour_id BIGSERIAL PRIMARY KEY,
+    store_id UUID NOT NULL REFERENCES public.stores(store_id) ON DELETE CASCADE,
+    weekday SMALLINT CHECK (weekday BETWEEN 0 AND 6),
+    service_date DATE,
+    open_minute INTEGER CHECK (open_minute >= 0 AND open_minute < 1440),
+    close_minute INTEGER,
+    CONSTRAINT store_opening_hours_day_check CHECK ((weekday IS NULL) <> (service_date IS NULL)),
+    CONSTRAINT store_opening_hours_interval_check CHECK (
+        (open_minute IS NULL AND close_minute IS NULL AND service_date IS NOT NULL)
+        OR (close_minute > open_minute AND close_minute <= open_minute + 1440)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS store_opening_hours_weekday_idx ON public.store_opening_hours (weekday, store_id)
+    WHERE weekday IS NOT NULL;
+CREATE INDEX IF NOT EXISTS store_opening_hours_date_idx ON public.store_opening_hours (service_date, store_id)
+    WHERE service_date IS NOT NULL;
+CREATE INDEX IF NOT EXISTS store_opening_hours_store_idx ON public.store_opening_hours (store_id);
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+-- file_kind の store_image への統一は元に戻さない
+
+DROP INDEX IF EXISTS public.store_files_store_id_sort_order_idx;
+
+ALTER TABLE public.store_files
+    DROP CONSTRAINT IF EXISTS store_files_alt_text_length_check,
+    DROP CONSTRAINT IF EXISTS store_files_caption_length_check,
+    DROP COLUMN IF EXISTS alt_text,
+    DROP COLUMN IF EXISTS caption,
+    DROP COLUMN IF EXISTS sort_order;
+
+COMMIT;
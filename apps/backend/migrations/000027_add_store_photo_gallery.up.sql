@@ -0,0 +1,47 @@
+BEGIN;
+
+-- 店舗のギャラリー（表示順・キャプション・代替テキスト）。ギャラリーは store_files のうち file_kind が store_image のファイル
+ALTER TABLE public.store_files
+    ADD COLUMN IF NOT EXISTS sort_order INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS caption TEXT,
+    ADD COLUMN IF NOT EXISTS alt_text TEXT;
+
+ALTER TABLE public.store_files
+    ADD CONSTRAINT store_files_caption_length_check
+    CHECK (caption IS NULL OR char_length(caption) <= 200),
+    ADD CONSTRAINT store_files_alt_text_length_check
+    CHECK (alt_text IS NULL OR char_length(alt_text) <= 200);
+
+-- カバー画像はギャラリーの写真から選ぶため、サムネイルのファイルを店舗画像としてギャラリーに含める
+UPDATE public.files
+SET file_kind = 'store_image'
+WHERE file_kind = 'store_thumbnail'
+   OR file_id IN (SELECT thumbnail_file_id FROM public.stores WHERE thumbnail_file_id IS NOT NULL);
+
+INSERT INTO public.store_files (store_id, file_id)
+SELECT store_id, thumbnail_file_id
+FROM public.stores
+WHERE thumbnail_file_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+-- 既存の写真はカバー画像を先頭に、紐付けた順で並べる
+UPDATE public.store_files AS sf
+SET sort_order = ordered.position
+FROM (
+    SELECT
+        sf2.store_id,
+        sf2.file_id,
+        ROW_NUMBER() OVER (
+            PARTITION BY sf2.store_id
+            ORDER BY (sf2.file_id = s.thumbnail_file_id) IS TRUE DESC, sf2.created_at, sf2.file_id
+        ) - 1 AS position
+    FROM public.store_files AS sf2
+    JOIN public.stores AS s ON s.store_id = sf2.store_id
+) AS ordered
+WHERE sf.store_id = ordered.store_id
+  AND sf.file_id = ordered.file_id;
+
+CREATE INDEX IF NOT EXISTS store_files_store_id_sort_order_idx
+    ON public.store_files(store_id, sort_order);
+
+COMMIT;
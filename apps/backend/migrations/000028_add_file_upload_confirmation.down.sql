@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.store_edits_thumbnail_file_id_idx;
+DROP INDEX IF EXISTS public.users_icon_file_id_idx;
+DROP INDEX IF EXISTS public.menus_image_file_id_idx;
+DROP INDEX IF EXISTS public.stores_thumbnail_file_id_idx;
+DROP INDEX IF EXISTS public.files_active_created_at_idx;
+
+ALTER TABLE public.files
+    DROP COLUMN IF EXISTS uploaded_at;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- Storage にオブジェクトがあることを確認した日時。署名付き URL の発行時点では NULL
+ALTER TABLE public.files
+    ADD COLUMN IF NOT EXISTS uploaded_at TIMESTAMPTZ;
+
+-- 既存のファイルはアップロード済みとして扱う
+UPDATE public.files
+SET uploaded_at = created_at
+WHERE uploaded_at IS NULL;
+
+-- 未確認・未参照のファイルを掃除するジョブが古い順に走査する
+CREATE INDEX IF NOT EXISTS files_active_created_at_idx
+    ON public.files(created_at)
+    WHERE is_deleted = false;
+
+-- 参照されていないファイルの判定に使う
+CREATE INDEX IF NOT EXISTS stores_thumbnail_file_id_idx ON public.stores(thumbnail_file_id);
+CREATE INDEX IF NOT EXISTS menus_image_file_id_idx ON public.menus(image_file_id);
+CREATE INDEX IF NOT EXISTS users_icon_file_id_idx ON public.users(icon_file_id);
+CREATE INDEX IF NOT EXISTS store_edits_thumbnail_file_id_idx ON public.store_edits(thumbnail_file_id);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.files_unprocessed_created_at_idx;
+
+ALTER TABLE public.files
+    DROP COLUMN IF EXISTS variants;
+
+ALTER TABLE public.files
+    DROP COLUMN IF EXISTS processed_at;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- 画像の加工（EXIF の除去と派生ファイルの作成）を終えた日時。未加工の場合は NULL
+ALTER TABLE public.files
+    ADD COLUMN IF NOT EXISTS processed_at TIMESTAMPTZ;
+
+-- 縮小・変換した派生ファイル（幅・高さ・形式・オブジェクトキーなど）の配列
+ALTER TABLE public.files
+    ADD COLUMN IF NOT EXISTS variants JSONB;
+
+-- 未加工の画像を定期的に古い順に処理する。既存のレビュー画像もこの処理で加工される
+CREATE INDEX IF NOT EXISTS files_unprocessed_created_at_idx
+    ON public.files(created_at)
+    WHERE processed_at IS NULL AND is_deleted = false;
+
+COMMIT;
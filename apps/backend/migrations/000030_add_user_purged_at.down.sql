@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.users_purge_pending_idx;
+
+ALTER TABLE public.users
+    DROP COLUMN IF EXISTS purged_at;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+-- 退会後に認証ユーザーとアップロードしたファイルを削除し終えた日時。
+-- 退会済みで NULL の行は削除に失敗したもので、定期的に再試行する
+ALTER TABLE public.users
+    ADD COLUMN IF NOT EXISTS purged_at TIMESTAMPTZ;
+
+-- 既存の退会済みユーザーは退会時に認証ユーザーを削除済み
+UPDATE public.users
+    SET purged_at = deleted_at
+    WHERE deleted_at IS NOT NULL AND purged_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS users_purge_pending_idx
+    ON public.users(deleted_at)
+    WHERE deleted_at IS NOT NULL AND purged_at IS NULL;
+
+COMMIT;
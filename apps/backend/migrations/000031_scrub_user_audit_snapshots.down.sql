@@ -0,0 +1,5 @@
+BEGIN;
+
+-- 取り除いた個人情報は復元できないため、何もしない
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- 監査ログは退会後も残るため、ユーザーの変更前後の状態から個人情報を取り除く
+-- 以降はロールと利用停止の状態だけを記録する
+UPDATE public.audit_logs
+SET
+    before = before - 'name' - 'email' - 'phone' - 'suspension_reason',
+    after = after - 'name' - 'email' - 'phone' - 'suspension_reason'
+WHERE target_type = 'user';
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE public.files
+    DROP COLUMN IF EXISTS processing_attempts;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- 画像の加工に失敗した回数。上限に達したファイルは定期処理で選ばず、1つの壊れたファイルが毎回の処理を止めないようにする
+ALTER TABLE public.files
+    ADD COLUMN IF NOT EXISTS processing_attempts INTEGER NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE public.stores
+    DROP COLUMN IF EXISTS seed_average_rating;
+
+COMMIT;
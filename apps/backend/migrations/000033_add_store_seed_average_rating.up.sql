@@ -0,0 +1,17 @@
+BEGIN;
+
+-- シードデータなどで登録された、レビューによらない評価。公開中のレビューがなくなった店舗の average_rating はこの値に戻す
+ALTER TABLE public.stores
+    ADD COLUMN IF NOT EXISTS seed_average_rating DOUBLE PRECISION;
+
+-- まだレビューが集計されていない店舗の average_rating をシードとして残す
+UPDATE public.stores s
+SET seed_average_rating = s.average_rating
+WHERE s.review_count = 0
+  AND s.average_rating <> 0
+  AND NOT EXISTS (
+      SELECT 1 FROM public.reviews r
+      WHERE r.store_id = s.store_id AND r.visibility = 'published'
+  );
+
+COMMIT;